@@ -1,6 +1,7 @@
 package autopilot
 
 import (
+	"errors"
 	"net"
 	"sync"
 	"sync/atomic"
@@ -140,6 +141,10 @@ type Agent struct {
 	// time.
 	chanOpenFailures chan *chanOpenFailureUpdate
 
+	// statusRequests is a channel where requests for a snapshot of the
+	// agent's state will be sent.
+	statusRequests chan *statusRequest
+
 	// totalBalance is the total number of satoshis the backing wallet is
 	// known to control at any given instance. This value will be updated
 	// when the agent receives external balance update signals.
@@ -163,6 +168,7 @@ func New(cfg Config, initialState []Channel) (*Agent, error) {
 		nodeUpdates:        make(chan *nodeUpdates, 1),
 		chanOpenFailures:   make(chan *chanOpenFailureUpdate, 1),
 		pendingOpenUpdates: make(chan *chanPendingOpenUpdate, 1),
+		statusRequests:     make(chan *statusRequest),
 	}
 
 	for _, c := range initialState {
@@ -202,6 +208,47 @@ func (a *Agent) Stop() error {
 	return nil
 }
 
+// ErrAgentNotActive is returned when the status of an agent that isn't
+// running is requested.
+var ErrAgentNotActive = errors.New("autopilot agent isn't active")
+
+// Status is a snapshot of the state of the agent, along with the attachment
+// directives it would currently attempt to execute.
+type Status struct {
+	// NumChannels is the number of open channels of the backing node.
+	NumChannels uint32
+
+	// NumPendingOpens is the number of channels the agent initiated that
+	// aren't open yet.
+	NumPendingOpens uint32
+
+	// NumPendingConns is the number of nodes the agent is attempting to
+	// connect to.
+	NumPendingConns uint32
+
+	// TotalBalance is the balance of the backing wallet, as last seen by
+	// the agent.
+	TotalBalance btcutil.Amount
+
+	// NeedMoreChans is true if the heuristic wants additional channels to
+	// be opened.
+	NeedMoreChans bool
+
+	// AvailableFunds is the amount the heuristic would commit to new
+	// channels.
+	AvailableFunds btcutil.Amount
+
+	// Proposals are the attachment directives the heuristic currently
+	// selects. They are only populated if NeedMoreChans is true.
+	Proposals []AttachmentDirective
+}
+
+// statusRequest is a request for a snapshot of the agent's state.
+type statusRequest struct {
+	resp chan *Status
+	err  chan error
+}
+
 // balanceUpdate is a type of external state update that reflects an
 // increase/decrease in the funds currently available to the wallet.
 type balanceUpdate struct {
@@ -300,6 +347,72 @@ func (a *Agent) OnChannelClose(closedChans ...lnwire.ShortChannelID) {
 	}()
 }
 
+// Status returns a snapshot of the state of the agent, along with the
+// attachment directives its heuristic currently proposes. The proposals are
+// computed anew, and aren't acted upon by this call.
+func (a *Agent) Status() (*Status, error) {
+	if atomic.LoadUint32(&a.started) == 0 ||
+		atomic.LoadUint32(&a.stopped) == 1 {
+
+		return nil, ErrAgentNotActive
+	}
+
+	req := &statusRequest{
+		resp: make(chan *Status, 1),
+		err:  make(chan error, 1),
+	}
+
+	select {
+	case a.statusRequests <- req:
+	case <-a.quit:
+		return nil, ErrAgentNotActive
+	}
+
+	select {
+	case status := <-req.resp:
+		return status, nil
+	case err := <-req.err:
+		return nil, err
+	case <-a.quit:
+		return nil, ErrAgentNotActive
+	}
+}
+
+// status queries the heuristic for the attachment directives it would select
+// given the passed channels and nodes to skip, and returns them along with a
+// snapshot of the agent's state.
+func (a *Agent) status(totalChans []Channel, numPendingOpens,
+	numPendingConns int, nodesToSkip map[NodeID]struct{}) (*Status,
+	error) {
+
+	status := &Status{
+		NumChannels:     uint32(len(a.chanState)),
+		NumPendingOpens: uint32(numPendingOpens),
+		NumPendingConns: uint32(numPendingConns),
+		TotalBalance:    a.totalBalance,
+	}
+
+	availableFunds, numChans, needMore := a.cfg.Heuristic.NeedMoreChans(
+		totalChans, a.totalBalance,
+	)
+	if !needMore {
+		return status, nil
+	}
+
+	proposals, err := a.cfg.Heuristic.Select(
+		a.cfg.Self, a.cfg.Graph, availableFunds, numChans, nodesToSkip,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	status.NeedMoreChans = true
+	status.AvailableFunds = availableFunds
+	status.Proposals = proposals
+
+	return status, nil
+}
+
 // mergeNodeMaps merges the Agent's set of nodes that it already has active
 // channels open to, with the other sets of nodes that should be removed from
 // consideration during heuristic selection. This ensures that the Agent doesn't
@@ -454,6 +567,32 @@ func (a *Agent) controller() {
 			log.Infof("Node updates received, assessing " +
 				"need for more channels")
 
+		// A snapshot of our state was requested. We'll reply with the
+		// directives we would currently execute, without acting upon
+		// them.
+		case req := <-a.statusRequests:
+			pendingMtx.Lock()
+			totalChans := mergeChanState(pendingOpens, a.chanState)
+			nodesToSkip := mergeNodeMaps(pendingOpens,
+				pendingConns, a.chanState.ConnectedNodes(),
+				failedNodes,
+			)
+			numPendingOpens := len(pendingOpens)
+			numPendingConns := len(pendingConns)
+			pendingMtx.Unlock()
+
+			status, err := a.status(
+				totalChans, numPendingOpens, numPendingConns,
+				nodesToSkip,
+			)
+			if err != nil {
+				req.err <- err
+				continue
+			}
+
+			req.resp <- status
+			continue
+
 		// The agent has been signalled to exit, so we'll bail out
 		// immediately.
 		case <-a.quit:
@@ -1361,3 +1361,463 @@ func TestAgentSkipPendingConns(t *testing.T) {
 		t.Fatalf("agent have attempted connection")
 	}
 }
+
+// genLineGraph creates a graph of numNodes nodes connected in a line, and
+// returns their keys in order.
+func genLineGraph(graph testGraph, numNodes int) ([]*btcec.PublicKey, error) {
+	keys := make([]*btcec.PublicKey, numNodes)
+	for i := range keys {
+		key, err := randKey()
+		if err != nil {
+			return nil, err
+		}
+		keys[i] = key
+	}
+
+	for i := 1; i < numNodes; i++ {
+		_, _, err := graph.addRandChannel(
+			keys[i-1], keys[i], btcutil.SatoshiPerBitcoin,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return keys, nil
+}
+
+// TestBetweennessCentrality asserts that the betweenness centrality of the
+// nodes of a synthetic line graph is computed correctly.
+func TestBetweennessCentrality(t *testing.T) {
+	t.Parallel()
+
+	for _, graph := range chanGraphs {
+		success := t.Run(graph.name, func(t1 *testing.T) {
+			graph, cleanup, err := graph.genFunc()
+			if err != nil {
+				t1.Fatalf("unable to create graph: %v", err)
+			}
+			if cleanup != nil {
+				defer cleanup()
+			}
+
+			// In a line of five nodes, the middle node lies on
+			// four shortest paths, its neighbours on three each,
+			// and the ends on none.
+			keys, err := genLineGraph(graph, 5)
+			if err != nil {
+				t1.Fatalf("unable to generate graph: %v", err)
+			}
+
+			candidates := make(map[NodeID]struct{})
+			for _, key := range keys {
+				candidates[NewNodeID(key)] = struct{}{}
+			}
+
+			scores, err := NewBetweennessCentrality().NodeScores(
+				graph, candidates,
+			)
+			if err != nil {
+				t1.Fatalf("unable to score nodes: %v", err)
+			}
+
+			expected := []float64{0, 0.75, 1, 0.75, 0}
+			for i, key := range keys {
+				score := scores[NewNodeID(key)]
+				if score != expected[i] {
+					t1.Fatalf("expected score %v for "+
+						"node %d, got %v", expected[i],
+						i, score)
+				}
+			}
+		})
+		if !success {
+			break
+		}
+	}
+}
+
+// TestScoredAttachmentSelect asserts that the ScoredAttachment heuristic
+// selects the highest scored nodes, skipping unscored ones and the ones it's
+// told to ignore.
+func TestScoredAttachmentSelect(t *testing.T) {
+	t.Parallel()
+
+	const (
+		minChanSize = 0
+		maxChanSize = btcutil.Amount(btcutil.SatoshiPerBitcoin)
+		chanLimit   = 3
+		threshold   = 0.5
+	)
+
+	for _, graph := range chanGraphs {
+		success := t.Run(graph.name, func(t1 *testing.T) {
+			graph, cleanup, err := graph.genFunc()
+			if err != nil {
+				t1.Fatalf("unable to create graph: %v", err)
+			}
+			if cleanup != nil {
+				defer cleanup()
+			}
+
+			self, err := randKey()
+			if err != nil {
+				t1.Fatalf("unable to generate self key: %v",
+					err)
+			}
+
+			keys, err := genLineGraph(graph, 5)
+			if err != nil {
+				t1.Fatalf("unable to generate graph: %v", err)
+			}
+
+			heuristic := NewScoredAttachment(
+				minChanSize, maxChanSize, chanLimit, threshold,
+				NewBetweennessCentrality(),
+			)
+
+			// Although we ask for three channels, only the three
+			// inner nodes of the line have a positive score. With
+			// the middle node skipped, only its neighbours remain.
+			skipNodes := map[NodeID]struct{}{
+				NewNodeID(keys[2]): {},
+			}
+			const walletFunds = btcutil.SatoshiPerBitcoin * 10
+			directives, err := heuristic.Select(
+				self, graph, walletFunds, 3, skipNodes,
+			)
+			if err != nil {
+				t1.Fatalf("unable to select attachment "+
+					"directives: %v", err)
+			}
+
+			if len(directives) != 2 {
+				t1.Fatalf("expected 2 directives, got %v",
+					len(directives))
+			}
+			for _, directive := range directives {
+				switch directive.NodeID {
+				case NewNodeID(keys[1]), NewNodeID(keys[3]):
+				default:
+					t1.Fatalf("attached to unexpected "+
+						"node: %x", directive.NodeID[:])
+				}
+
+				if directive.ChanAmt != maxChanSize {
+					t1.Fatalf("expected channel size %v, "+
+						"got %v", maxChanSize,
+						directive.ChanAmt)
+				}
+			}
+		})
+		if !success {
+			break
+		}
+	}
+}
+
+// TestSpiderPathScorer asserts that nodes are scored by the amount sent over
+// the Spider paths through them, and unused nodes aren't scored.
+func TestSpiderPathScorer(t *testing.T) {
+	t.Parallel()
+
+	graph := newMemChannelGraph()
+	keys, err := genLineGraph(graph, 3)
+	if err != nil {
+		t.Fatalf("unable to generate graph: %v", err)
+	}
+
+	usage := map[NodeID]btcutil.Amount{
+		NewNodeID(keys[0]): 1000,
+		NewNodeID(keys[1]): 4000,
+	}
+	scorer := NewSpiderPathScorer(func() map[NodeID]btcutil.Amount {
+		return usage
+	})
+
+	candidates := make(map[NodeID]struct{})
+	for _, key := range keys {
+		candidates[NewNodeID(key)] = struct{}{}
+	}
+
+	scores, err := scorer.NodeScores(graph, candidates)
+	if err != nil {
+		t.Fatalf("unable to score nodes: %v", err)
+	}
+
+	if len(scores) != 2 {
+		t.Fatalf("expected 2 scored nodes, got %v", len(scores))
+	}
+	if scores[NewNodeID(keys[0])] != 0.25 {
+		t.Fatalf("expected score 0.25, got %v",
+			scores[NewNodeID(keys[0])])
+	}
+	if scores[NewNodeID(keys[1])] != 1 {
+		t.Fatalf("expected score 1, got %v",
+			scores[NewNodeID(keys[1])])
+	}
+}
+
+// mockScorer is a NodeScorer returning a fixed set of scores.
+type mockScorer struct {
+	scores map[NodeID]float64
+}
+
+func (m *mockScorer) Name() string {
+	return "mock"
+}
+
+func (m *mockScorer) NodeScores(g ChannelGraph,
+	candidates map[NodeID]struct{}) (map[NodeID]float64, error) {
+
+	return m.scores, nil
+}
+
+var _ NodeScorer = (*mockScorer)(nil)
+
+// TestWeightedCombScorer asserts that the WeightedCombScorer takes the
+// weighted average of the scores of its scorers, and rejects invalid weights.
+func TestWeightedCombScorer(t *testing.T) {
+	t.Parallel()
+
+	var node1, node2, node3 NodeID
+	node1[0], node2[0], node3[0] = 1, 2, 3
+
+	scorer1 := &mockScorer{
+		scores: map[NodeID]float64{node1: 1, node2: 0.5},
+	}
+	scorer2 := &mockScorer{
+		scores: map[NodeID]float64{node2: 1, node3: 1},
+	}
+
+	comb, err := NewWeightedCombScorer(
+		WeightedScorer{Scorer: scorer1, Weight: 3},
+		WeightedScorer{Scorer: scorer2, Weight: 1},
+	)
+	if err != nil {
+		t.Fatalf("unable to create scorer: %v", err)
+	}
+
+	// Only the first two nodes are candidates, so the third one mustn't
+	// be scored.
+	candidates := map[NodeID]struct{}{
+		node1: {},
+		node2: {},
+	}
+	scores, err := comb.NodeScores(newMemChannelGraph(), candidates)
+	if err != nil {
+		t.Fatalf("unable to score nodes: %v", err)
+	}
+
+	expected := map[NodeID]float64{
+		node1: 0.75,
+		node2: 0.625,
+	}
+	if len(scores) != len(expected) {
+		t.Fatalf("expected %v scores, got %v", len(expected),
+			len(scores))
+	}
+	for nID, score := range expected {
+		if scores[nID] != score {
+			t.Fatalf("expected score %v, got %v", score,
+				scores[nID])
+		}
+	}
+
+	_, err = NewWeightedCombScorer(
+		WeightedScorer{Scorer: scorer1, Weight: 0},
+	)
+	if err == nil {
+		t.Fatalf("expected zero weights to be rejected")
+	}
+
+	_, err = NewWeightedCombScorer(
+		WeightedScorer{Scorer: scorer1, Weight: 1},
+		WeightedScorer{Scorer: scorer2, Weight: -1},
+	)
+	if err == nil {
+		t.Fatalf("expected negative weights to be rejected")
+	}
+}
+
+// TestExternalScorer asserts that the ExternalScorer returns the scores
+// pushed to it for the candidates only, and rejects out of range scores.
+func TestExternalScorer(t *testing.T) {
+	t.Parallel()
+
+	graph := newMemChannelGraph()
+	keys, err := genLineGraph(graph, 3)
+	if err != nil {
+		t.Fatalf("unable to generate graph: %v", err)
+	}
+
+	scorer := NewExternalScorer()
+	err = scorer.SetNodeScores(map[NodeID]float64{
+		NewNodeID(keys[0]): 0.5,
+		NewNodeID(keys[1]): 1,
+	})
+	if err != nil {
+		t.Fatalf("unable to set scores: %v", err)
+	}
+
+	// Only the first and last nodes are candidates, so the score of the
+	// middle node shouldn't be returned.
+	candidates := map[NodeID]struct{}{
+		NewNodeID(keys[0]): {},
+		NewNodeID(keys[2]): {},
+	}
+	scores, err := scorer.NodeScores(graph, candidates)
+	if err != nil {
+		t.Fatalf("unable to score nodes: %v", err)
+	}
+	if len(scores) != 1 {
+		t.Fatalf("expected 1 scored node, got %v", len(scores))
+	}
+	if scores[NewNodeID(keys[0])] != 0.5 {
+		t.Fatalf("expected score 0.5, got %v",
+			scores[NewNodeID(keys[0])])
+	}
+
+	// A score outside of [0, 1] should be rejected, leaving the previous
+	// scores in place.
+	err = scorer.SetNodeScores(map[NodeID]float64{
+		NewNodeID(keys[2]): 1.5,
+	})
+	if err == nil {
+		t.Fatalf("expected out of range score to be rejected")
+	}
+	scores, err = scorer.NodeScores(graph, candidates)
+	if err != nil {
+		t.Fatalf("unable to score nodes: %v", err)
+	}
+	if len(scores) != 1 {
+		t.Fatalf("expected 1 scored node, got %v", len(scores))
+	}
+}
+
+// TestAgentStatus asserts that the agent reports the directives its heuristic
+// proposes when its status is requested, without executing them.
+func TestAgentStatus(t *testing.T) {
+	t.Parallel()
+
+	self, err := randKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	heuristic := &mockHeuristic{
+		moreChansResps: make(chan moreChansResp),
+		directiveResps: make(chan []AttachmentDirective),
+	}
+	chanController := &mockChanController{
+		openChanSignals: make(chan openChanIntent),
+	}
+	memGraph, _, _ := newMemChanGraph()
+
+	const walletBalance = btcutil.SatoshiPerBitcoin * 2
+	testCfg := Config{
+		Self:           self,
+		Heuristic:      heuristic,
+		ChanController: chanController,
+		WalletBalance: func() (btcutil.Amount, error) {
+			return walletBalance, nil
+		},
+		ConnectToPeer: func(*btcec.PublicKey, []net.Addr) (bool, error) {
+			return false, nil
+		},
+		DisconnectPeer: func(*btcec.PublicKey) error {
+			return nil
+		},
+		Graph:           memGraph,
+		MaxPendingOpens: 10,
+	}
+	agent, err := New(testCfg, nil)
+	if err != nil {
+		t.Fatalf("unable to create agent: %v", err)
+	}
+	heuristic.quit = agent.quit
+
+	// The status can't be queried before the agent is started.
+	if _, err := agent.Status(); err != ErrAgentNotActive {
+		t.Fatalf("expected ErrAgentNotActive, got %v", err)
+	}
+
+	if err := agent.Start(); err != nil {
+		t.Fatalf("unable to start agent: %v", err)
+	}
+	defer agent.Stop()
+
+	// We'll send an initial "no" response to advance the agent past its
+	// initial check.
+	select {
+	case heuristic.moreChansResps <- moreChansResp{false, 0, 0}:
+	case <-time.After(time.Second * 10):
+		t.Fatalf("heuristic wasn't queried in time")
+	}
+
+	type statusResult struct {
+		status *Status
+		err    error
+	}
+	results := make(chan statusResult, 1)
+	go func() {
+		status, err := agent.Status()
+		results <- statusResult{status, err}
+	}()
+
+	// The heuristic will now be asked for its proposals, and we'll answer
+	// with a single directive.
+	const chanAmt = btcutil.SatoshiPerBitcoin
+	select {
+	case heuristic.moreChansResps <- moreChansResp{true, 1, chanAmt}:
+	case <-time.After(time.Second * 10):
+		t.Fatalf("heuristic wasn't queried in time")
+	}
+
+	nodeKey, err := randKey()
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	directive := AttachmentDirective{
+		NodeKey: nodeKey,
+		NodeID:  NewNodeID(nodeKey),
+		ChanAmt: chanAmt,
+	}
+	select {
+	case heuristic.directiveResps <- []AttachmentDirective{directive}:
+	case <-time.After(time.Second * 10):
+		t.Fatalf("heuristic wasn't queried in time")
+	}
+
+	var result statusResult
+	select {
+	case result = <-results:
+	case <-time.After(time.Second * 10):
+		t.Fatalf("status wasn't returned in time")
+	}
+	if result.err != nil {
+		t.Fatalf("unable to query status: %v", result.err)
+	}
+
+	status := result.status
+	if status.TotalBalance != walletBalance {
+		t.Fatalf("expected balance %v, got %v", walletBalance,
+			status.TotalBalance)
+	}
+	if !status.NeedMoreChans || status.AvailableFunds != chanAmt {
+		t.Fatalf("expected %v of available funds, got %v",
+			chanAmt, status.AvailableFunds)
+	}
+	if len(status.Proposals) != 1 ||
+		status.Proposals[0].NodeID != directive.NodeID {
+
+		t.Fatalf("expected proposal %v, got %v", directive,
+			status.Proposals)
+	}
+
+	// The proposal shouldn't have been executed.
+	select {
+	case <-chanController.openChanSignals:
+		t.Fatalf("channel opened for status request")
+	case <-time.After(time.Millisecond * 100):
+	}
+}
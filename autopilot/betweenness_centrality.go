@@ -0,0 +1,177 @@
+package autopilot
+
+// BetweennessCentrality is a NodeScorer that scores nodes by their
+// betweenness centrality: the number of shortest paths between all other pairs
+// of nodes in the channel graph that pass through them. Nodes with a high
+// centrality are well positioned to route payments, so attaching to them
+// shortens our own paths to the rest of the network.
+type BetweennessCentrality struct{}
+
+// NewBetweennessCentrality creates a new BetweennessCentrality scorer.
+func NewBetweennessCentrality() *BetweennessCentrality {
+	return &BetweennessCentrality{}
+}
+
+// A compile time assertion to ensure BetweennessCentrality meets the
+// NodeScorer interface.
+var _ NodeScorer = (*BetweennessCentrality)(nil)
+
+// Name returns the name of the scorer.
+//
+// NOTE: This is a part of the NodeScorer interface.
+func (b *BetweennessCentrality) Name() string {
+	return "betweenness"
+}
+
+// NodeScores computes the betweenness centrality of all nodes within the
+// graph, and scores each candidate by its centrality divided by the highest
+// centrality among the candidates.
+//
+// NOTE: This is a part of the NodeScorer interface.
+func (b *BetweennessCentrality) NodeScores(g ChannelGraph,
+	candidates map[NodeID]struct{}) (map[NodeID]float64, error) {
+
+	nodeIDs, adjacency, err := undirectedAdjacency(g)
+	if err != nil {
+		return nil, err
+	}
+
+	centrality := betweennessCentrality(adjacency)
+
+	values := make(map[NodeID]float64)
+	for i, nID := range nodeIDs {
+		if _, ok := candidates[nID]; !ok {
+			continue
+		}
+
+		values[nID] = centrality[i]
+	}
+
+	return normalizeScores(values), nil
+}
+
+// undirectedAdjacency returns the channel graph as an undirected adjacency
+// list, with nodes identified by their index into the returned slice of node
+// IDs. Parallel channels between the same pair of nodes are collapsed into a
+// single edge.
+func undirectedAdjacency(g ChannelGraph) ([]NodeID, [][]int, error) {
+	var (
+		nodeIDs   []NodeID
+		indexes   = make(map[NodeID]int)
+		neighbors []map[int]struct{}
+	)
+
+	index := func(nID NodeID) int {
+		if i, ok := indexes[nID]; ok {
+			return i
+		}
+
+		i := len(nodeIDs)
+		indexes[nID] = i
+		nodeIDs = append(nodeIDs, nID)
+		neighbors = append(neighbors, make(map[int]struct{}))
+		return i
+	}
+
+	err := g.ForEachNode(func(node Node) error {
+		i := index(NodeID(node.PubKey()))
+
+		return node.ForEachChannel(func(edge ChannelEdge) error {
+			j := index(NodeID(edge.Peer.PubKey()))
+			if i == j {
+				return nil
+			}
+
+			neighbors[i][j] = struct{}{}
+			neighbors[j][i] = struct{}{}
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	adjacency := make([][]int, len(nodeIDs))
+	for i, peers := range neighbors {
+		for j := range peers {
+			adjacency[i] = append(adjacency[i], j)
+		}
+	}
+
+	return nodeIDs, adjacency, nil
+}
+
+// betweennessCentrality computes the betweenness centrality of each node of
+// the given unweighted, undirected graph using Brandes' algorithm, which runs
+// a breadth first search from every node, and accumulates the dependencies of
+// the source on all other nodes while backtracking.
+func betweennessCentrality(adjacency [][]int) []float64 {
+	numNodes := len(adjacency)
+	centrality := make([]float64, numNodes)
+
+	var (
+		stack = make([]int, 0, numNodes)
+		queue = make([]int, 0, numNodes)
+		preds = make([][]int, numNodes)
+		sigma = make([]float64, numNodes)
+		dist  = make([]int, numNodes)
+		delta = make([]float64, numNodes)
+	)
+
+	for source := 0; source < numNodes; source++ {
+		stack = stack[:0]
+		queue = queue[:0]
+		for i := 0; i < numNodes; i++ {
+			preds[i] = preds[i][:0]
+			sigma[i] = 0
+			dist[i] = -1
+			delta[i] = 0
+		}
+
+		sigma[source] = 1
+		dist[source] = 0
+		queue = append(queue, source)
+
+		// First, we'll count the shortest paths from the source to
+		// each node, and record their predecessors on those paths.
+		for len(queue) > 0 {
+			v := queue[0]
+			queue = queue[1:]
+			stack = append(stack, v)
+
+			for _, w := range adjacency[v] {
+				if dist[w] < 0 {
+					dist[w] = dist[v] + 1
+					queue = append(queue, w)
+				}
+
+				if dist[w] == dist[v]+1 {
+					sigma[w] += sigma[v]
+					preds[w] = append(preds[w], v)
+				}
+			}
+		}
+
+		// Then, visiting the nodes in order of decreasing distance,
+		// we'll propagate the fraction of paths through each node
+		// back to its predecessors.
+		for i := len(stack) - 1; i >= 0; i-- {
+			w := stack[i]
+			for _, v := range preds[w] {
+				delta[v] += sigma[v] / sigma[w] * (1 + delta[w])
+			}
+
+			if w != source {
+				centrality[w] += delta[w]
+			}
+		}
+	}
+
+	// As the graph is undirected, each path was counted from both of its
+	// ends.
+	for i := range centrality {
+		centrality[i] /= 2
+	}
+
+	return centrality
+}
@@ -0,0 +1,79 @@
+package autopilot
+
+import (
+	"fmt"
+	"sync"
+)
+
+// ExternalScorer is a NodeScorer whose scores are provided by an external
+// process, rather than computed from the channel graph. This allows
+// attachment strategies that aren't compiled into the binary to drive the
+// agent, by pushing their scores whenever they change.
+type ExternalScorer struct {
+	sync.RWMutex
+
+	// scores are the latest scores pushed by the external process.
+	scores map[NodeID]float64
+}
+
+// NewExternalScorer creates a new ExternalScorer, which initially doesn't
+// score any node.
+func NewExternalScorer() *ExternalScorer {
+	return &ExternalScorer{
+		scores: make(map[NodeID]float64),
+	}
+}
+
+// A compile time assertion to ensure ExternalScorer meets the NodeScorer
+// interface.
+var _ NodeScorer = (*ExternalScorer)(nil)
+
+// Name returns the name of the scorer.
+//
+// NOTE: This is a part of the NodeScorer interface.
+func (e *ExternalScorer) Name() string {
+	return "externalscore"
+}
+
+// SetNodeScores replaces the current set of scores with the passed one. Each
+// score must lie in the range [0, 1]. Nodes that aren't part of the new set
+// no longer receive a score.
+func (e *ExternalScorer) SetNodeScores(scores map[NodeID]float64) error {
+	newScores := make(map[NodeID]float64, len(scores))
+	for nID, score := range scores {
+		if score < 0 || score > 1 {
+			return fmt.Errorf("score %v of node %x isn't within "+
+				"[0, 1]", score, nID[:])
+		}
+
+		newScores[nID] = score
+	}
+
+	e.Lock()
+	e.scores = newScores
+	e.Unlock()
+
+	return nil
+}
+
+// NodeScores returns the latest external score of each candidate. Candidates
+// that weren't scored externally don't receive a score.
+//
+// NOTE: This is a part of the NodeScorer interface.
+func (e *ExternalScorer) NodeScores(g ChannelGraph,
+	candidates map[NodeID]struct{}) (map[NodeID]float64, error) {
+
+	e.RLock()
+	defer e.RUnlock()
+
+	scores := make(map[NodeID]float64)
+	for nID, score := range e.scores {
+		if _, ok := candidates[nID]; !ok {
+			continue
+		}
+
+		scores[nID] = score
+	}
+
+	return scores, nil
+}
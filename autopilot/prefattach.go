@@ -61,16 +61,26 @@ var _ AttachmentHeuristic = (*ConstrainedPrefAttachment)(nil)
 func (p *ConstrainedPrefAttachment) NeedMoreChans(channels []Channel,
 	funds btcutil.Amount) (btcutil.Amount, uint32, bool) {
 
+	return needMoreChans(channels, funds, p.chanLimit, p.threshold)
+}
+
+// needMoreChans returns the amount of funds and number of channels that
+// should be added to reach the given channel limit and fraction of funds
+// allocated to channels, or false if no more channels are needed. It is shared
+// by all heuristics constrained this way.
+func needMoreChans(channels []Channel, funds btcutil.Amount,
+	chanLimit uint16, threshold float64) (btcutil.Amount, uint32, bool) {
+
 	// If we're already over our maximum allowed number of channels, then
 	// we'll instruct the controller not to create any more channels.
-	if len(channels) >= int(p.chanLimit) {
+	if len(channels) >= int(chanLimit) {
 		return 0, 0, false
 	}
 
 	// The number of additional channels that should be opened is the
 	// difference between the channel limit, and the number of channels we
 	// already have open.
-	numAdditionalChans := uint32(p.chanLimit) - uint32(len(channels))
+	numAdditionalChans := uint32(chanLimit) - uint32(len(channels))
 
 	// First, we'll tally up the total amount of funds that are currently
 	// present within the set of active channels.
@@ -90,14 +100,14 @@ func (p *ConstrainedPrefAttachment) NeedMoreChans(channels []Channel,
 	// If this fraction is below our threshold, then we'll return true, to
 	// indicate the controller should call Select to obtain a candidate set
 	// of channels to attempt to open.
-	needMore := fundsFraction < p.threshold
+	needMore := fundsFraction < threshold
 	if !needMore {
 		return 0, 0, false
 	}
 
 	// Now that we know we need more funds, we'll compute the amount of
 	// additional funds we should allocate towards channels.
-	targetAllocation := btcutil.Amount(float64(totalFunds) * p.threshold)
+	targetAllocation := btcutil.Amount(float64(totalFunds) * threshold)
 	fundsAvailable := targetAllocation - totalChanAllocation
 	return fundsAvailable, numAdditionalChans, true
 }
@@ -258,14 +268,27 @@ func (p *ConstrainedPrefAttachment) Select(self *btcec.PublicKey, g ChannelGraph
 		visited[NodeID(pubBytes)] = struct{}{}
 	}
 
+	return allocateFunds(
+		directives, fundsAvailable, p.minChanSize, p.maxChanSize,
+	)
+}
+
+// allocateFunds distributes the available funds across the given directives,
+// allocating at most maxChanSize to each. If the funds don't suffice for all
+// of them, the directives are funded greedily in order, and the ones that
+// can't be funded above minChanSize are dropped.
+func allocateFunds(directives []AttachmentDirective,
+	fundsAvailable, minChanSize,
+	maxChanSize btcutil.Amount) ([]AttachmentDirective, error) {
+
 	numSelectedNodes := int64(len(directives))
 	switch {
 	// If we have enough available funds to distribute the maximum channel
 	// size for each of the selected peers to attach to, then we'll
 	// allocate the maximum amount to each peer.
-	case int64(fundsAvailable) >= numSelectedNodes*int64(p.maxChanSize):
+	case int64(fundsAvailable) >= numSelectedNodes*int64(maxChanSize):
 		for i := 0; i < int(numSelectedNodes); i++ {
-			directives[i].ChanAmt = p.maxChanSize
+			directives[i].ChanAmt = maxChanSize
 		}
 
 		return directives, nil
@@ -273,14 +296,14 @@ func (p *ConstrainedPrefAttachment) Select(self *btcec.PublicKey, g ChannelGraph
 	// Otherwise, we'll greedily allocate our funds to the channels
 	// successively until we run out of available funds, or can't create a
 	// channel above the min channel size.
-	case int64(fundsAvailable) < numSelectedNodes*int64(p.maxChanSize):
+	case int64(fundsAvailable) < numSelectedNodes*int64(maxChanSize):
 		i := 0
-		for fundsAvailable > p.minChanSize {
+		for fundsAvailable > minChanSize {
 			// We'll attempt to allocate the max channel size
 			// initially. If we don't have enough funds to do this,
 			// then we'll allocate the remainder of the funds
 			// available to the channel.
-			delta := p.maxChanSize
+			delta := maxChanSize
 			if fundsAvailable-delta < 0 {
 				delta = fundsAvailable
 			}
@@ -0,0 +1,244 @@
+package autopilot
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil"
+)
+
+// NodeScorer is an interface that assigns scores to the nodes of the channel
+// graph, rating how desirable it is to open a channel to them. It is used by
+// the ScoredAttachment heuristic to pick its attachment candidates.
+type NodeScorer interface {
+	// Name returns the name the scorer is referred to by in the config.
+	Name() string
+
+	// NodeScores assigns a score in the range [0, 1] to each of the
+	// passed candidate nodes, a higher score marking a more desirable
+	// node. Candidates which aren't assigned a score are considered
+	// unsuitable.
+	NodeScores(g ChannelGraph,
+		candidates map[NodeID]struct{}) (map[NodeID]float64, error)
+}
+
+// ScoredAttachment is an implementation of the AttachmentHeuristic interface
+// that attaches to the nodes rated highest by a NodeScorer. Like the
+// ConstrainedPrefAttachment heuristic, it commits a set fraction of our funds
+// to channels, within bounds on the number and size of the channels. Unlike
+// it, the selection is deterministic: given the same graph, the same nodes
+// are picked.
+type ScoredAttachment struct {
+	minChanSize btcutil.Amount
+	maxChanSize btcutil.Amount
+
+	chanLimit uint16
+
+	threshold float64
+
+	scorer NodeScorer
+}
+
+// NewScoredAttachment creates a new instance of a ScoredAttachment heuristic
+// given bounds on allowed channel sizes, an allocation amount which is
+// interpreted as a percentage of funds that is to be committed to channels at
+// all times, and the scorer used to rate the candidate nodes.
+func NewScoredAttachment(minChanSize, maxChanSize btcutil.Amount,
+	chanLimit uint16, allocation float64,
+	scorer NodeScorer) *ScoredAttachment {
+
+	return &ScoredAttachment{
+		minChanSize: minChanSize,
+		maxChanSize: maxChanSize,
+		chanLimit:   chanLimit,
+		threshold:   allocation,
+		scorer:      scorer,
+	}
+}
+
+// A compile time assertion to ensure ScoredAttachment meets the
+// AttachmentHeuristic interface.
+var _ AttachmentHeuristic = (*ScoredAttachment)(nil)
+
+// NeedMoreChans is a predicate that should return true if, given the passed
+// parameters, and its internal state, more channels should be opened within
+// the channel graph. If the heuristic decides that we do indeed need more
+// channels, then the second argument returned will represent the amount of
+// additional funds to be used towards creating channels.
+//
+// NOTE: This is a part of the AttachmentHeuristic interface.
+func (s *ScoredAttachment) NeedMoreChans(channels []Channel,
+	funds btcutil.Amount) (btcutil.Amount, uint32, bool) {
+
+	return needMoreChans(channels, funds, s.chanLimit, s.threshold)
+}
+
+// scoredNode is a candidate node along with its score.
+type scoredNode struct {
+	node  Node
+	score float64
+}
+
+// Select returns a candidate set of attachment directives that should be
+// executed based on the current internal state, the state of the channel
+// graph, the set of nodes we should exclude, and the amount of funds
+// available. The nodes with the highest score are selected, ties being broken
+// by public key.
+//
+// NOTE: This is a part of the AttachmentHeuristic interface.
+func (s *ScoredAttachment) Select(self *btcec.PublicKey, g ChannelGraph,
+	fundsAvailable btcutil.Amount, numNewChans uint32,
+	skipNodes map[NodeID]struct{}) ([]AttachmentDirective, error) {
+
+	var directives []AttachmentDirective
+
+	if fundsAvailable < s.minChanSize {
+		return directives, nil
+	}
+
+	// We'll gather all nodes we could attach to, skipping ourselves and
+	// the nodes we were told to ignore.
+	selfID := NewNodeID(self)
+	nodes := make(map[NodeID]Node)
+	candidates := make(map[NodeID]struct{})
+	err := g.ForEachNode(func(node Node) error {
+		nID := NodeID(node.PubKey())
+		if nID == selfID {
+			return nil
+		}
+		if _, ok := skipNodes[nID]; ok {
+			return nil
+		}
+
+		nodes[nID] = node
+		candidates[nID] = struct{}{}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(candidates) == 0 {
+		return directives, nil
+	}
+
+	scores, err := s.scorer.NodeScores(g, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := make([]scoredNode, 0, len(scores))
+	for nID, score := range scores {
+		node, ok := nodes[nID]
+		if !ok || score <= 0 {
+			continue
+		}
+
+		ranked = append(ranked, scoredNode{node: node, score: score})
+	}
+
+	sort.Slice(ranked, func(i, j int) bool {
+		if ranked[i].score != ranked[j].score {
+			return ranked[i].score > ranked[j].score
+		}
+
+		iKey, jKey := ranked[i].node.PubKey(), ranked[j].node.PubKey()
+		return bytes.Compare(iKey[:], jKey[:]) < 0
+	})
+
+	if uint32(len(ranked)) > numNewChans {
+		ranked = ranked[:numNewChans]
+	}
+
+	log.Debugf("Heuristic %v selected %v candidates", s.scorer.Name(),
+		len(ranked))
+
+	for _, candidate := range ranked {
+		pubBytes := candidate.node.PubKey()
+		pub, err := btcec.ParsePubKey(pubBytes[:], btcec.S256())
+		if err != nil {
+			return nil, err
+		}
+
+		directives = append(directives, AttachmentDirective{
+			NodeKey: pub,
+			NodeID:  NewNodeID(pub),
+			Addrs:   candidate.node.Addrs(),
+		})
+	}
+
+	return allocateFunds(
+		directives, fundsAvailable, s.minChanSize, s.maxChanSize,
+	)
+}
+
+// DegreeScorer is a NodeScorer that scores nodes by their number of channels,
+// relative to the best connected candidate. It is the deterministic
+// counterpart of preferential attachment, and mostly useful as part of a
+// WeightedCombScorer.
+type DegreeScorer struct{}
+
+// NewDegreeScorer creates a new DegreeScorer.
+func NewDegreeScorer() *DegreeScorer {
+	return &DegreeScorer{}
+}
+
+// A compile time assertion to ensure DegreeScorer meets the NodeScorer
+// interface.
+var _ NodeScorer = (*DegreeScorer)(nil)
+
+// Name returns the name of the scorer.
+//
+// NOTE: This is a part of the NodeScorer interface.
+func (d *DegreeScorer) Name() string {
+	return "degree"
+}
+
+// NodeScores scores each candidate by its number of channels, divided by the
+// number of channels of the best connected candidate.
+//
+// NOTE: This is a part of the NodeScorer interface.
+func (d *DegreeScorer) NodeScores(g ChannelGraph,
+	candidates map[NodeID]struct{}) (map[NodeID]float64, error) {
+
+	degrees := make(map[NodeID]float64)
+	err := g.ForEachNode(func(node Node) error {
+		nID := NodeID(node.PubKey())
+		if _, ok := candidates[nID]; !ok {
+			return nil
+		}
+
+		return node.ForEachChannel(func(_ ChannelEdge) error {
+			degrees[nID]++
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return normalizeScores(degrees), nil
+}
+
+// normalizeScores divides all values by the largest one, such that the
+// highest scored node ends up with a score of 1.
+func normalizeScores(values map[NodeID]float64) map[NodeID]float64 {
+	var max float64
+	for _, value := range values {
+		if value > max {
+			max = value
+		}
+	}
+
+	scores := make(map[NodeID]float64, len(values))
+	if max == 0 {
+		return scores
+	}
+
+	for nID, value := range values {
+		scores[nID] = value / max
+	}
+
+	return scores
+}
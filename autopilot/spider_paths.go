@@ -0,0 +1,54 @@
+package autopilot
+
+import "github.com/btcsuite/btcutil"
+
+// SpiderPathScorer is a NodeScorer that scores nodes by how much we sent over
+// the Spider payment paths traversing them. A direct channel to a node on our
+// busiest paths shortens those paths, and takes load off the channels of the
+// intermediaries before it.
+type SpiderPathScorer struct {
+	// pathUsage returns the total amount sent over our Spider paths
+	// through each node.
+	pathUsage func() map[NodeID]btcutil.Amount
+}
+
+// NewSpiderPathScorer creates a new SpiderPathScorer, which learns about the
+// usage of our Spider paths through the passed closure.
+func NewSpiderPathScorer(
+	pathUsage func() map[NodeID]btcutil.Amount) *SpiderPathScorer {
+
+	return &SpiderPathScorer{
+		pathUsage: pathUsage,
+	}
+}
+
+// A compile time assertion to ensure SpiderPathScorer meets the NodeScorer
+// interface.
+var _ NodeScorer = (*SpiderPathScorer)(nil)
+
+// Name returns the name of the scorer.
+//
+// NOTE: This is a part of the NodeScorer interface.
+func (s *SpiderPathScorer) Name() string {
+	return "spider"
+}
+
+// NodeScores scores each candidate by the amount sent over Spider paths
+// through it, divided by the amount sent through the most used candidate.
+// Candidates that aren't on any path we used don't receive a score.
+//
+// NOTE: This is a part of the NodeScorer interface.
+func (s *SpiderPathScorer) NodeScores(g ChannelGraph,
+	candidates map[NodeID]struct{}) (map[NodeID]float64, error) {
+
+	values := make(map[NodeID]float64)
+	for nID, amt := range s.pathUsage() {
+		if _, ok := candidates[nID]; !ok || amt <= 0 {
+			continue
+		}
+
+		values[nID] = float64(amt)
+	}
+
+	return normalizeScores(values), nil
+}
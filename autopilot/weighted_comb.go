@@ -0,0 +1,106 @@
+package autopilot
+
+import (
+	"fmt"
+	"strings"
+)
+
+// WeightedScorer is a NodeScorer along with the weight its scores carry
+// within a WeightedCombScorer.
+type WeightedScorer struct {
+	// Scorer is the scorer to combine.
+	Scorer NodeScorer
+
+	// Weight is the relative weight of the scores of the scorer.
+	Weight float64
+}
+
+// WeightedCombScorer is a NodeScorer that combines the scores of several
+// scorers into a single score, by taking their weighted average.
+type WeightedCombScorer struct {
+	scorers []WeightedScorer
+}
+
+// NewWeightedCombScorer creates a new WeightedCombScorer from the given
+// scorers. The weights are normalized to sum up to one, so only their
+// relative size matters. Weights can't be negative, and at least one of them
+// must be positive.
+func NewWeightedCombScorer(
+	scorers ...WeightedScorer) (*WeightedCombScorer, error) {
+
+	var totalWeight float64
+	for _, s := range scorers {
+		if s.Weight < 0 {
+			return nil, fmt.Errorf("weight of scorer %v is "+
+				"negative: %v", s.Scorer.Name(), s.Weight)
+		}
+
+		totalWeight += s.Weight
+	}
+
+	if totalWeight == 0 {
+		return nil, fmt.Errorf("at least one scorer must have a " +
+			"positive weight")
+	}
+
+	normalized := make([]WeightedScorer, 0, len(scorers))
+	for _, s := range scorers {
+		if s.Weight == 0 {
+			continue
+		}
+
+		normalized = append(normalized, WeightedScorer{
+			Scorer: s.Scorer,
+			Weight: s.Weight / totalWeight,
+		})
+	}
+
+	return &WeightedCombScorer{
+		scorers: normalized,
+	}, nil
+}
+
+// A compile time assertion to ensure WeightedCombScorer meets the NodeScorer
+// interface.
+var _ NodeScorer = (*WeightedCombScorer)(nil)
+
+// Name returns the name of the scorer, which lists the combined scorers along
+// with their weights.
+//
+// NOTE: This is a part of the NodeScorer interface.
+func (w *WeightedCombScorer) Name() string {
+	names := make([]string, 0, len(w.scorers))
+	for _, s := range w.scorers {
+		names = append(names, fmt.Sprintf("%v:%.2f", s.Scorer.Name(),
+			s.Weight))
+	}
+
+	return fmt.Sprintf("weighted(%v)", strings.Join(names, ","))
+}
+
+// NodeScores scores each candidate by the weighted average of the scores
+// assigned by the combined scorers. A candidate that isn't scored by one of
+// the scorers contributes a score of zero for it.
+//
+// NOTE: This is a part of the NodeScorer interface.
+func (w *WeightedCombScorer) NodeScores(g ChannelGraph,
+	candidates map[NodeID]struct{}) (map[NodeID]float64, error) {
+
+	combined := make(map[NodeID]float64)
+	for _, s := range w.scorers {
+		scores, err := s.Scorer.NodeScores(g, candidates)
+		if err != nil {
+			return nil, err
+		}
+
+		for nID, score := range scores {
+			if _, ok := candidates[nID]; !ok {
+				continue
+			}
+
+			combined[nID] += s.Weight * score
+		}
+	}
+
+	return combined, nil
+}
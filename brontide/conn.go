@@ -23,6 +23,10 @@ type Conn struct {
 	noise *Machine
 
 	readBuf bytes.Buffer
+
+	// handshakeDuration is the time it took to complete the handshake of
+	// the connection.
+	handshakeDuration time.Duration
 }
 
 // A compile-time assertion to ensure that Conn meets the net.Conn interface.
@@ -47,15 +51,23 @@ func Dial(localPriv *btcec.PrivateKey, netAddr *lnwire.NetAddress,
 		noise: NewBrontideMachine(true, localPriv, netAddr.IdentityKey),
 	}
 
+	// handshakeFailed closes the connection after the handshake failed
+	// during the given act, logging the reason.
+	handshakeFailed := func(act string, err error) (*Conn, error) {
+		logHandshakeFailure(ipAddr, act, err)
+		b.conn.Close()
+		return nil, err
+	}
+
+	handshakeStart := time.Now()
+
 	// Initiate the handshake by sending the first act to the receiver.
 	actOne, err := b.noise.GenActOne()
 	if err != nil {
-		b.conn.Close()
-		return nil, err
+		return handshakeFailed("act one", err)
 	}
 	if _, err := conn.Write(actOne[:]); err != nil {
-		b.conn.Close()
-		return nil, err
+		return handshakeFailed("act one", err)
 	}
 
 	// We'll ensure that we get ActTwo from the remote peer in a timely
@@ -69,26 +81,24 @@ func Dial(localPriv *btcec.PrivateKey, netAddr *lnwire.NetAddress,
 	// secrecy.
 	var actTwo [ActTwoSize]byte
 	if _, err := io.ReadFull(conn, actTwo[:]); err != nil {
-		b.conn.Close()
-		return nil, err
+		return handshakeFailed("act two", err)
 	}
 	if err := b.noise.RecvActTwo(actTwo); err != nil {
-		b.conn.Close()
-		return nil, err
+		return handshakeFailed("act two", err)
 	}
 
 	// Finally, complete the handshake by sending over our encrypted static
 	// key and execute the final ECDH operation.
 	actThree, err := b.noise.GenActThree()
 	if err != nil {
-		b.conn.Close()
-		return nil, err
+		return handshakeFailed("act three", err)
 	}
 	if _, err := conn.Write(actThree[:]); err != nil {
-		b.conn.Close()
-		return nil, err
+		return handshakeFailed("act three", err)
 	}
 
+	b.handshakeDuration = time.Since(handshakeStart)
+
 	// We'll reset the deadline as it's no longer critical beyond the
 	// initial handshake.
 	conn.SetReadDeadline(time.Time{})
@@ -96,6 +106,13 @@ func Dial(localPriv *btcec.PrivateKey, netAddr *lnwire.NetAddress,
 	return b, nil
 }
 
+// logHandshakeFailure logs the reason the handshake with the remote address
+// failed during the given act.
+func logHandshakeFailure(remoteAddr, act string, err error) {
+	log.Debugf("Handshake with %v failed during %v: %v", remoteAddr, act,
+		err)
+}
+
 // ReadNextMessage uses the connection in a message-oriented instructing it to
 // read the next _full_ message with the brontide stream. This function will
 // block until the read succeeds.
@@ -113,6 +113,16 @@ func (l *Listener) doHandshake(conn net.Conn) {
 		noise: NewBrontideMachine(false, l.localStatic, nil),
 	}
 
+	// handshakeFailed closes the connection after the handshake failed
+	// during the given act, logging and returning the reason.
+	handshakeFailed := func(act string, err error) {
+		logHandshakeFailure(remoteAddr, act, err)
+		brontideConn.conn.Close()
+		l.rejectConn(rejectedConnErr(err, remoteAddr))
+	}
+
+	handshakeStart := time.Now()
+
 	// We'll ensure that we get ActOne from the remote peer in a timely
 	// manner. If they don't respond within 1s, then we'll kill the
 	// connection.
@@ -123,13 +133,11 @@ func (l *Listener) doHandshake(conn net.Conn) {
 	// this portion will fail with a non-nil error.
 	var actOne [ActOneSize]byte
 	if _, err := io.ReadFull(conn, actOne[:]); err != nil {
-		brontideConn.conn.Close()
-		l.rejectConn(rejectedConnErr(err, remoteAddr))
+		handshakeFailed("act one", err)
 		return
 	}
 	if err := brontideConn.noise.RecvActOne(actOne); err != nil {
-		brontideConn.conn.Close()
-		l.rejectConn(rejectedConnErr(err, remoteAddr))
+		handshakeFailed("act one", err)
 		return
 	}
 
@@ -137,13 +145,11 @@ func (l *Listener) doHandshake(conn net.Conn) {
 	// key for the session along with an authenticating tag.
 	actTwo, err := brontideConn.noise.GenActTwo()
 	if err != nil {
-		brontideConn.conn.Close()
-		l.rejectConn(rejectedConnErr(err, remoteAddr))
+		handshakeFailed("act two", err)
 		return
 	}
 	if _, err := conn.Write(actTwo[:]); err != nil {
-		brontideConn.conn.Close()
-		l.rejectConn(rejectedConnErr(err, remoteAddr))
+		handshakeFailed("act two", err)
 		return
 	}
 
@@ -163,16 +169,16 @@ func (l *Listener) doHandshake(conn net.Conn) {
 	// sides have mutually authenticated each other.
 	var actThree [ActThreeSize]byte
 	if _, err := io.ReadFull(conn, actThree[:]); err != nil {
-		brontideConn.conn.Close()
-		l.rejectConn(rejectedConnErr(err, remoteAddr))
+		handshakeFailed("act three", err)
 		return
 	}
 	if err := brontideConn.noise.RecvActThree(actThree); err != nil {
-		brontideConn.conn.Close()
-		l.rejectConn(rejectedConnErr(err, remoteAddr))
+		handshakeFailed("act three", err)
 		return
 	}
 
+	brontideConn.handshakeDuration = time.Since(handshakeStart)
+
 	// We'll reset the deadline as it's no longer critical beyond the
 	// initial handshake.
 	conn.SetReadDeadline(time.Time{})
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"sync/atomic"
 	"time"
 
 	"golang.org/x/crypto/chacha20poly1305"
@@ -74,6 +75,10 @@ type cipherState struct {
 	// TODO(roasbeef): this should actually be 96 bit
 	nonce uint64
 
+	// rotations is the number of times the key of this cipherState has
+	// been rotated. It must be accessed atomically.
+	rotations uint64
+
 	// secretKey is the shared symmetric key which will be used to
 	// instantiate the cipher.
 	//
@@ -164,6 +169,13 @@ func (c *cipherState) rotateKey() {
 	h.Read(nextKey[:])
 
 	c.InitializeKey(nextKey)
+	atomic.AddUint64(&c.rotations, 1)
+}
+
+// keyRotations returns the number of times the key of this cipherState has
+// been rotated.
+func (c *cipherState) keyRotations() uint64 {
+	return atomic.LoadUint64(&c.rotations)
 }
 
 // symmetricState encapsulates a cipherState object and houses the ephemeral
@@ -346,6 +358,15 @@ func EphemeralGenerator(gen func() (*btcec.PrivateKey, error)) func(*Machine) {
 //   <- e, ee
 //   -> s, se
 type Machine struct {
+	// The following counters track the traffic of the connection once the
+	// handshake has completed. They must be accessed atomically, and are
+	// kept at the start of the struct to ensure their 64-bit alignment.
+	bytesSent       uint64
+	bytesRecv       uint64
+	msgsSent        uint64
+	msgsRecv        uint64
+	decryptFailures uint64
+
 	sendCipher cipherState
 	recvCipher cipherState
 
@@ -712,8 +733,14 @@ func (b *Machine) WriteMessage(w io.Writer, p []byte) error {
 	// single packet, as any fragmentation should have taken place at a
 	// higher level.
 	cipherText := b.sendCipher.Encrypt(nil, nil, p)
-	_, err := w.Write(cipherText)
-	return err
+	if _, err := w.Write(cipherText); err != nil {
+		return err
+	}
+
+	atomic.AddUint64(&b.bytesSent, uint64(len(cipherLen)+len(cipherText)))
+	atomic.AddUint64(&b.msgsSent, 1)
+
+	return nil
 }
 
 // ReadMessage attempts to read the next message from the passed io.Reader. In
@@ -728,6 +755,7 @@ func (b *Machine) ReadMessage(r io.Reader) ([]byte, error) {
 		nil, nil, b.nextCipherHeader[:],
 	)
 	if err != nil {
+		atomic.AddUint64(&b.decryptFailures, 1)
 		return nil, err
 	}
 
@@ -739,5 +767,18 @@ func (b *Machine) ReadMessage(r io.Reader) ([]byte, error) {
 	}
 
 	// TODO(roasbeef): modify to let pass in slice
-	return b.recvCipher.Decrypt(nil, nil, b.nextCipherText[:pktLen])
+	plaintext, err := b.recvCipher.Decrypt(
+		nil, nil, b.nextCipherText[:pktLen],
+	)
+	if err != nil {
+		atomic.AddUint64(&b.decryptFailures, 1)
+		return nil, err
+	}
+
+	atomic.AddUint64(
+		&b.bytesRecv, uint64(len(b.nextCipherHeader))+uint64(pktLen),
+	)
+	atomic.AddUint64(&b.msgsRecv, 1)
+
+	return plaintext, nil
 }
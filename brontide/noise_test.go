@@ -140,6 +140,76 @@ func TestConnectionCorrectness(t *testing.T) {
 	}
 }
 
+// TestConnStats ensures that the counters of a connection track the messages
+// and bytes it carries, along with the rotations of its keys.
+func TestConnStats(t *testing.T) {
+	localConn, remoteConn, cleanUp, err := establishTestConnection()
+	if err != nil {
+		t.Fatalf("unable to establish test connection: %v", err)
+	}
+	defer cleanUp()
+
+	local := localConn.(*Conn)
+	remote := remoteConn.(*Conn)
+
+	// Each message is encrypted twice, once for its length prefix and once
+	// for its body, so sending half the rotation interval of messages
+	// rotates the key once.
+	const numMsgs = keyRotationInterval / 2
+	msg := []byte("hello")
+	readBuf := make([]byte, len(msg))
+	for i := 0; i < numMsgs; i++ {
+		if _, err := local.Write(msg); err != nil {
+			t.Fatalf("local conn failed to write: %v", err)
+		}
+		if _, err := remote.Read(readBuf); err != nil {
+			t.Fatalf("remote conn failed to read: %v", err)
+		}
+	}
+
+	msgSize := uint64(lengthHeaderSize + macSize + len(msg) + macSize)
+	localStats := local.Stats()
+	if localStats.MsgsSent != numMsgs ||
+		localStats.BytesSent != numMsgs*msgSize {
+
+		t.Fatalf("unexpected sent messages and bytes: %v, %v",
+			localStats.MsgsSent, localStats.BytesSent)
+	}
+	if localStats.SendKeyRotations != 1 {
+		t.Fatalf("expected 1 send key rotation, got %v",
+			localStats.SendKeyRotations)
+	}
+	if localStats.HandshakeDuration <= 0 {
+		t.Fatalf("expected handshake duration to be recorded")
+	}
+
+	remoteStats := remote.Stats()
+	if remoteStats.MsgsRecv != numMsgs ||
+		remoteStats.BytesRecv != numMsgs*msgSize {
+
+		t.Fatalf("unexpected received messages and bytes: %v, %v",
+			remoteStats.MsgsRecv, remoteStats.BytesRecv)
+	}
+	if remoteStats.RecvKeyRotations != 1 {
+		t.Fatalf("expected 1 receive key rotation, got %v",
+			remoteStats.RecvKeyRotations)
+	}
+	if remoteStats.HandshakeDuration <= 0 {
+		t.Fatalf("expected handshake duration to be recorded")
+	}
+
+	// Finally, a message that fails to decrypt should be counted as a
+	// decryption failure.
+	garbage := make([]byte, lengthHeaderSize+macSize)
+	_, err = remote.noise.ReadMessage(bytes.NewReader(garbage))
+	if err == nil {
+		t.Fatalf("expected garbage message to fail to decrypt")
+	}
+	if failures := remote.Stats().DecryptFailures; failures != 1 {
+		t.Fatalf("expected 1 decryption failure, got %v", failures)
+	}
+}
+
 // TestConecurrentHandshakes verifies the listener's ability to not be blocked
 // by other pending handshakes. This is tested by opening multiple tcp
 // connections with the listener, without completing any of the brontide acts.
@@ -0,0 +1,58 @@
+package brontide
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+// ConnStats is a snapshot of the counters of a brontide connection, which
+// describe the traffic it has carried since the handshake completed.
+type ConnStats struct {
+	// BytesSent is the number of bytes written to the connection,
+	// including the encrypted length prefixes and MACs.
+	BytesSent uint64
+
+	// BytesRecv is the number of bytes read from the connection,
+	// including the encrypted length prefixes and MACs.
+	BytesRecv uint64
+
+	// MsgsSent is the number of brontide messages written to the
+	// connection. Writes larger than the maximum message size are split
+	// into several messages.
+	MsgsSent uint64
+
+	// MsgsRecv is the number of brontide messages read from the
+	// connection.
+	MsgsRecv uint64
+
+	// SendKeyRotations is the number of times the key used to encrypt
+	// outgoing messages has been rotated.
+	SendKeyRotations uint64
+
+	// RecvKeyRotations is the number of times the key used to decrypt
+	// incoming messages has been rotated.
+	RecvKeyRotations uint64
+
+	// DecryptFailures is the number of incoming messages whose length
+	// prefix or body failed to decrypt.
+	DecryptFailures uint64
+
+	// HandshakeDuration is the time it took to complete the three act
+	// handshake of the connection.
+	HandshakeDuration time.Duration
+}
+
+// Stats returns a snapshot of the counters of the connection. It's safe to
+// call concurrently with reads and writes.
+func (c *Conn) Stats() *ConnStats {
+	return &ConnStats{
+		BytesSent:         atomic.LoadUint64(&c.noise.bytesSent),
+		BytesRecv:         atomic.LoadUint64(&c.noise.bytesRecv),
+		MsgsSent:          atomic.LoadUint64(&c.noise.msgsSent),
+		MsgsRecv:          atomic.LoadUint64(&c.noise.msgsRecv),
+		SendKeyRotations:  c.noise.sendCipher.keyRotations(),
+		RecvKeyRotations:  c.noise.recvCipher.keyRotations(),
+		DecryptFailures:   atomic.LoadUint64(&c.noise.decryptFailures),
+		HandshakeDuration: c.handshakeDuration,
+	}
+}
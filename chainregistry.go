@@ -362,9 +362,6 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			if err != nil {
 				return nil, nil, err
 			}
-			if err := cc.feeEstimator.Start(); err != nil {
-				return nil, nil, err
-			}
 		} else if cfg.Litecoin.Active {
 			ltndLog.Infof("Initializing litecoind backed fee estimator")
 
@@ -379,9 +376,6 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			if err != nil {
 				return nil, nil, err
 			}
-			if err := cc.feeEstimator.Start(); err != nil {
-				return nil, nil, err
-			}
 		}
 	case "btcd", "ltcd":
 		// Otherwise, we'll be speaking directly via RPC to a node.
@@ -484,15 +478,49 @@ func newChainControlFromConfig(cfg *config, chanDB *channeldb.DB,
 			if err != nil {
 				return nil, nil, err
 			}
-			if err := cc.feeEstimator.Start(); err != nil {
-				return nil, nil, err
-			}
 		}
 	default:
 		return nil, nil, fmt.Errorf("unknown node type: %s",
 			homeChainConfig.Node)
 	}
 
+	// Our backend's fee estimator is combined with any HTTP/JSON fee
+	// sources specified, taking the median of their estimates, such that
+	// all subsystems share the same, bounded, fee rates. If any live fee
+	// source is available, we'll no longer use a static fee estimate.
+	var feeSources []lnwallet.FeeEstimator
+	_, isStatic := cc.feeEstimator.(lnwallet.StaticFeeEstimator)
+	if !isStatic || len(cfg.FeeURLs) == 0 {
+		feeSources = append(feeSources, cc.feeEstimator)
+	}
+	for _, feeURL := range cfg.FeeURLs {
+		ltndLog.Infof("Initializing web API fee estimator for %v",
+			feeURL)
+
+		// The API is queried through our configured network, such
+		// that it's reached over Tor if it's active.
+		feeSources = append(feeSources, lnwallet.NewWebAPIEstimator(
+			lnwallet.SparseConfFeeSource{URL: feeURL},
+			cfg.net.Dial,
+		))
+	}
+
+	// If all of our fee sources fail, we'll fall back to a fee rate of 25
+	// sat/byte.
+	maxFeeRate := lnwallet.SatPerKVByte(cfg.MaxFeeRate * 1000)
+	fallBackFeeRate := lnwallet.SatPerKVByte(25 * 1000)
+	cc.feeEstimator, err = lnwallet.NewMedianFeeEstimator(
+		lnwallet.FeePerKwFloor, maxFeeRate.FeePerKWeight(),
+		fallBackFeeRate.FeePerKWeight(), feeSources...,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := cc.feeEstimator.Start(); err != nil {
+		return nil, nil, err
+	}
+	walletConfig.FeeEstimator = cc.feeEstimator
+
 	wc, err := btcwallet.New(*walletConfig)
 	if err != nil {
 		fmt.Printf("unable to create wallet controller: %v\n", err)
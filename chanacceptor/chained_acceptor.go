@@ -0,0 +1,92 @@
+package chanacceptor
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// ChainedAcceptor consults a set of ChannelAcceptors, and only accepts a
+// channel once all of them have accepted it.
+type ChainedAcceptor struct {
+	// acceptorID is incremented atomically to hand out a unique ID to each
+	// acceptor added to the chain.
+	acceptorID uint64
+
+	// acceptors holds the acceptors of the chain in the order they were
+	// added, which is the order in which they're consulted.
+	acceptorsMtx sync.RWMutex
+	acceptors    []chainedAcceptorEntry
+}
+
+// chainedAcceptorEntry is an acceptor within a ChainedAcceptor, along with the
+// ID it was added under.
+type chainedAcceptorEntry struct {
+	id       uint64
+	acceptor ChannelAcceptor
+}
+
+// NewChainedAcceptor creates a new ChainedAcceptor without any acceptors,
+// which accepts all channels.
+func NewChainedAcceptor() *ChainedAcceptor {
+	return &ChainedAcceptor{}
+}
+
+// AddAcceptor adds an acceptor to the chain, and returns the ID which can be
+// used to remove it again.
+func (c *ChainedAcceptor) AddAcceptor(acceptor ChannelAcceptor) uint64 {
+	id := atomic.AddUint64(&c.acceptorID, 1)
+
+	c.acceptorsMtx.Lock()
+	c.acceptors = append(c.acceptors, chainedAcceptorEntry{
+		id:       id,
+		acceptor: acceptor,
+	})
+	c.acceptorsMtx.Unlock()
+
+	return id
+}
+
+// RemoveAcceptor removes the acceptor with the given ID from the chain,
+// preserving the order of the remaining acceptors.
+func (c *ChainedAcceptor) RemoveAcceptor(id uint64) {
+	c.acceptorsMtx.Lock()
+	defer c.acceptorsMtx.Unlock()
+
+	for i, entry := range c.acceptors {
+		if entry.id != id {
+			continue
+		}
+
+		// We'll copy the remaining acceptors into a new slice, such
+		// that a concurrent Accept call iterating over the old one
+		// isn't affected.
+		acceptors := make(
+			[]chainedAcceptorEntry, 0, len(c.acceptors)-1,
+		)
+		acceptors = append(acceptors, c.acceptors[:i]...)
+		c.acceptors = append(acceptors, c.acceptors[i+1:]...)
+		return
+	}
+}
+
+// Accept consults every acceptor in the chain in the order they were added,
+// and returns the error of the first acceptor which rejects the channel.
+//
+// NOTE: Part of the ChannelAcceptor interface.
+func (c *ChainedAcceptor) Accept(req *ChannelAcceptRequest) error {
+	c.acceptorsMtx.RLock()
+	acceptors := c.acceptors
+	c.acceptorsMtx.RUnlock()
+
+	for _, entry := range acceptors {
+		if err := entry.acceptor.Accept(req); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// A compile time check to ensure ChainedAcceptor implements the
+// ChannelAcceptor interface.
+var _ ChannelAcceptor = (*ChainedAcceptor)(nil)
@@ -0,0 +1,81 @@
+package chanacceptor
+
+import (
+	"reflect"
+	"testing"
+)
+
+// recordingAcceptor is a ChannelAcceptor which records that it was consulted,
+// and returns a fixed error.
+type recordingAcceptor struct {
+	name      string
+	consulted *[]string
+	err       error
+}
+
+func (r *recordingAcceptor) Accept(*ChannelAcceptRequest) error {
+	*r.consulted = append(*r.consulted, r.name)
+	return r.err
+}
+
+// TestChainedAcceptorOrder asserts that the ChainedAcceptor consults its
+// acceptors in the order they were added, stops at the first rejection, and
+// preserves the order of the remaining acceptors once one is removed.
+func TestChainedAcceptorOrder(t *testing.T) {
+	t.Parallel()
+
+	var consulted []string
+	newAcceptor := func(name string, err error) *recordingAcceptor {
+		return &recordingAcceptor{
+			name:      name,
+			consulted: &consulted,
+			err:       err,
+		}
+	}
+
+	chain := NewChainedAcceptor()
+	req := &ChannelAcceptRequest{}
+
+	// Without any acceptors, all channels are accepted.
+	if err := chain.Accept(req); err != nil {
+		t.Fatalf("expected channel to be accepted: %v", err)
+	}
+
+	rejectErr := NewRejectError("no thanks")
+	var ids []uint64
+	for _, acceptor := range []*recordingAcceptor{
+		newAcceptor("a", nil),
+		newAcceptor("b", nil),
+		newAcceptor("c", rejectErr),
+		newAcceptor("d", nil),
+		newAcceptor("e", nil),
+	} {
+		ids = append(ids, chain.AddAcceptor(acceptor))
+	}
+
+	assertAccept := func(expErr error, expConsulted ...string) {
+		t.Helper()
+
+		consulted = nil
+		if err := chain.Accept(req); err != expErr {
+			t.Fatalf("expected error %v, got %v", expErr, err)
+		}
+		if !reflect.DeepEqual(consulted, expConsulted) {
+			t.Fatalf("expected acceptors %v to be consulted, got %v",
+				expConsulted, consulted)
+		}
+	}
+
+	// The acceptors should be consulted in order until the rejecting one.
+	assertAccept(rejectErr, "a", "b", "c")
+
+	// Once the rejecting acceptor and the first one are removed, the
+	// remaining ones should still be consulted in order.
+	chain.RemoveAcceptor(ids[2])
+	chain.RemoveAcceptor(ids[0])
+	assertAccept(nil, "b", "d", "e")
+
+	// Acceptors added later on are consulted last.
+	chain.AddAcceptor(newAcceptor("f", nil))
+	assertAccept(nil, "b", "d", "e", "f")
+}
@@ -0,0 +1,46 @@
+package chanacceptor
+
+import (
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// ChannelAcceptRequest is a request to open a channel sent to us by a remote
+// peer, which is handed to a ChannelAcceptor before it's accepted.
+type ChannelAcceptRequest struct {
+	// Node is the public key of the node requesting to open the channel.
+	Node *btcec.PublicKey
+
+	// OpenChanMsg is the open_channel message sent by the node.
+	OpenChanMsg *lnwire.OpenChannel
+}
+
+// RejectError is returned by a ChannelAcceptor when it rejects a channel.
+// Unlike other errors, its reason is sent to the remote peer, so it must not
+// contain any sensitive information.
+type RejectError struct {
+	// Reason is a human readable reason for rejecting the channel.
+	Reason string
+}
+
+// NewRejectError creates a new RejectError with the given reason.
+func NewRejectError(reason string) *RejectError {
+	return &RejectError{Reason: reason}
+}
+
+// Error returns the reason the channel was rejected.
+//
+// NOTE: Part of the error interface.
+func (e *RejectError) Error() string {
+	return "channel rejected: " + e.Reason
+}
+
+// ChannelAcceptor is an interface which decides whether an inbound channel
+// request is accepted.
+type ChannelAcceptor interface {
+	// Accept returns nil if the channel should be accepted. If the
+	// channel is rejected, a RejectError describing why is returned.
+	// Any other error indicates that a decision couldn't be made, in
+	// which case the channel is rejected as well.
+	Accept(req *ChannelAcceptRequest) error
+}
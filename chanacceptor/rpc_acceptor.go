@@ -0,0 +1,109 @@
+package chanacceptor
+
+import (
+	"errors"
+	"time"
+)
+
+// DefaultAcceptTimeout is the default duration an RPCAcceptor waits for the
+// client to decide on a channel before rejecting it.
+const DefaultAcceptTimeout = 15 * time.Second
+
+// ErrAcceptorExiting is returned when a channel is handed to an RPCAcceptor
+// whose client has gone away.
+var ErrAcceptorExiting = errors.New("channel acceptor exiting")
+
+// RPCRequest is a channel request handed to the client of an RPCAcceptor,
+// which must respond with its decision.
+type RPCRequest struct {
+	*ChannelAcceptRequest
+
+	response chan error
+
+	done chan struct{}
+}
+
+// Accept signals that the client accepts the channel.
+func (r *RPCRequest) Accept() {
+	r.response <- nil
+}
+
+// Reject signals that the client rejects the channel for the given reason.
+func (r *RPCRequest) Reject(reason string) {
+	r.response <- NewRejectError(reason)
+}
+
+// Done returns a channel which is closed once the request no longer awaits a
+// decision, as it was either decided on, timed out, or the acceptor exited.
+func (r *RPCRequest) Done() <-chan struct{} {
+	return r.done
+}
+
+// RPCAcceptor is a ChannelAcceptor which delegates the decision on each
+// channel to an RPC client. To fail safe, channels are rejected if the client
+// doesn't decide on them in time, or goes away before doing so.
+type RPCAcceptor struct {
+	requests chan *RPCRequest
+
+	timeout time.Duration
+
+	quit chan struct{}
+}
+
+// NewRPCAcceptor creates a new RPCAcceptor which waits up to the given
+// timeout for a decision. The quit channel should be closed once the client
+// goes away.
+func NewRPCAcceptor(timeout time.Duration,
+	quit chan struct{}) *RPCAcceptor {
+
+	return &RPCAcceptor{
+		requests: make(chan *RPCRequest),
+		timeout:  timeout,
+		quit:     quit,
+	}
+}
+
+// Requests returns the channel over which the channel requests to decide on
+// are sent to the client.
+func (r *RPCAcceptor) Requests() <-chan *RPCRequest {
+	return r.requests
+}
+
+// Accept hands the channel request to the client, and waits for its decision.
+//
+// NOTE: Part of the ChannelAcceptor interface.
+func (r *RPCAcceptor) Accept(req *ChannelAcceptRequest) error {
+	rpcReq := &RPCRequest{
+		ChannelAcceptRequest: req,
+		response:             make(chan error, 1),
+		done:                 make(chan struct{}),
+	}
+	defer close(rpcReq.done)
+
+	timeout := time.After(r.timeout)
+
+	select {
+	case r.requests <- rpcReq:
+	case <-timeout:
+		log.Warnf("Timed out handing channel request %x to acceptor",
+			req.OpenChanMsg.PendingChannelID)
+		return NewRejectError("acceptor timeout")
+	case <-r.quit:
+		return ErrAcceptorExiting
+	}
+
+	select {
+	case err := <-rpcReq.response:
+		return err
+	case <-timeout:
+		log.Warnf("Timed out waiting for acceptor to decide on "+
+			"channel request %x", req.OpenChanMsg.PendingChannelID)
+		return NewRejectError("acceptor timeout")
+	case <-r.quit:
+		return ErrAcceptorExiting
+	}
+}
+
+// A compile time check to ensure RPCAcceptor implements the ChannelAcceptor
+// interface.
+var _ ChannelAcceptor = (*RPCAcceptor)(nil)
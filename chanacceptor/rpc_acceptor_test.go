@@ -0,0 +1,82 @@
+package chanacceptor
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestRPCAcceptor asserts that the RPCAcceptor returns the decision of its
+// client, and fails safe by rejecting channels the client doesn't decide on.
+func TestRPCAcceptor(t *testing.T) {
+	t.Parallel()
+
+	alice, _ := newTestNode(t)
+	req := &ChannelAcceptRequest{
+		Node:        alice,
+		OpenChanMsg: &lnwire.OpenChannel{FundingAmount: 1000},
+	}
+
+	quit := make(chan struct{})
+	acceptor := NewRPCAcceptor(100*time.Millisecond, quit)
+
+	// accept runs the acceptor in the background, as it blocks until the
+	// client decides.
+	accept := func() chan error {
+		errChan := make(chan error, 1)
+		go func() {
+			errChan <- acceptor.Accept(req)
+		}()
+		return errChan
+	}
+
+	receive := func() *RPCRequest {
+		select {
+		case rpcReq := <-acceptor.Requests():
+			return rpcReq
+		case <-time.After(time.Second):
+			t.Fatalf("request not sent to client")
+		}
+		return nil
+	}
+
+	// The client accepts the first channel, and rejects the second one.
+	errChan := accept()
+	receive().Accept()
+	if err := <-errChan; err != nil {
+		t.Fatalf("expected channel to be accepted, got %v", err)
+	}
+
+	errChan = accept()
+	receive().Reject("no thanks")
+	err := <-errChan
+	rejectErr, ok := err.(*RejectError)
+	if !ok || rejectErr.Reason != "no thanks" {
+		t.Fatalf("expected rejection with reason, got %v", err)
+	}
+
+	// If the client doesn't decide in time, the channel is rejected, and
+	// the request signals that it's no longer awaiting a decision.
+	errChan = accept()
+	rpcReq := receive()
+	select {
+	case <-rpcReq.Done():
+		t.Fatalf("request done before timing out")
+	default:
+	}
+	if _, ok := (<-errChan).(*RejectError); !ok {
+		t.Fatalf("expected channel to be rejected after timeout")
+	}
+	select {
+	case <-rpcReq.Done():
+	case <-time.After(time.Second):
+		t.Fatalf("request not done after timing out")
+	}
+
+	// Once the client goes away, all channels are rejected.
+	close(quit)
+	if err := acceptor.Accept(req); err != ErrAcceptorExiting {
+		t.Fatalf("expected ErrAcceptorExiting, got %v", err)
+	}
+}
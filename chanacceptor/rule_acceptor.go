@@ -0,0 +1,110 @@
+package chanacceptor
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// Rules is the set of static rules an inbound channel must satisfy to be
+// accepted by the RuleAcceptor. The zero value accepts all channels.
+type Rules struct {
+	// AllowList, if non-empty, is the set of nodes which are allowed to
+	// open channels to us. Channels from all other nodes are rejected.
+	AllowList map[[33]byte]struct{}
+
+	// DenyList is the set of nodes which aren't allowed to open channels
+	// to us.
+	DenyList map[[33]byte]struct{}
+
+	// MinCapacity is the minimum capacity of an inbound channel.
+	MinCapacity btcutil.Amount
+
+	// MaxChansPerPeer is the maximum number of channels, including pending
+	// ones, a single node may have open with us. Zero means no limit.
+	MaxChansPerPeer int
+
+	// RequirePrivate rejects all channels which are to be announced to
+	// the network.
+	RequirePrivate bool
+
+	// RequirePublic rejects all channels which aren't to be announced to
+	// the network.
+	RequirePublic bool
+}
+
+// RuleAcceptor is a ChannelAcceptor which decides whether a channel is
+// accepted based on a static set of rules.
+type RuleAcceptor struct {
+	rules Rules
+
+	// numChannels returns the number of channels, including pending ones,
+	// we currently have with the given node.
+	numChannels func(*btcec.PublicKey) (int, error)
+}
+
+// NewRuleAcceptor creates a new RuleAcceptor which enforces the given rules.
+// The numChannels closure is used to determine how many channels we already
+// have with a node.
+func NewRuleAcceptor(rules Rules,
+	numChannels func(*btcec.PublicKey) (int, error)) *RuleAcceptor {
+
+	return &RuleAcceptor{
+		rules:       rules,
+		numChannels: numChannels,
+	}
+}
+
+// Accept checks the channel against the rules of the acceptor.
+//
+// NOTE: Part of the ChannelAcceptor interface.
+func (r *RuleAcceptor) Accept(req *ChannelAcceptRequest) error {
+	var node [33]byte
+	copy(node[:], req.Node.SerializeCompressed())
+
+	if len(r.rules.AllowList) != 0 {
+		if _, ok := r.rules.AllowList[node]; !ok {
+			return NewRejectError("node not allowed")
+		}
+	}
+	if _, ok := r.rules.DenyList[node]; ok {
+		return NewRejectError("node not allowed")
+	}
+
+	msg := req.OpenChanMsg
+	if msg.FundingAmount < r.rules.MinCapacity {
+		return NewRejectError(fmt.Sprintf("channel capacity %v is "+
+			"below minimum of %v", msg.FundingAmount,
+			r.rules.MinCapacity))
+	}
+
+	public := msg.ChannelFlags&lnwire.FFAnnounceChannel != 0
+	switch {
+	case r.rules.RequirePrivate && public:
+		return NewRejectError("only private channels are accepted")
+
+	case r.rules.RequirePublic && !public:
+		return NewRejectError("only public channels are accepted")
+	}
+
+	if r.rules.MaxChansPerPeer > 0 {
+		numChans, err := r.numChannels(req.Node)
+		if err != nil {
+			return err
+		}
+
+		if numChans >= r.rules.MaxChansPerPeer {
+			return NewRejectError(fmt.Sprintf("maximum of %v "+
+				"channels per peer reached",
+				r.rules.MaxChansPerPeer))
+		}
+	}
+
+	return nil
+}
+
+// A compile time check to ensure RuleAcceptor implements the ChannelAcceptor
+// interface.
+var _ ChannelAcceptor = (*RuleAcceptor)(nil)
@@ -0,0 +1,194 @@
+package chanacceptor
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+func newTestNode(t *testing.T) (*btcec.PublicKey, [33]byte) {
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	var node [33]byte
+	copy(node[:], priv.PubKey().SerializeCompressed())
+
+	return priv.PubKey(), node
+}
+
+// TestRuleAcceptor asserts that the RuleAcceptor only accepts channels which
+// satisfy all of its rules, and rejects all others with a RejectError.
+func TestRuleAcceptor(t *testing.T) {
+	t.Parallel()
+
+	alice, aliceNode := newTestNode(t)
+	bob, bobNode := newTestNode(t)
+	carol, _ := newTestNode(t)
+
+	numChans := map[*btcec.PublicKey]int{
+		alice: 0,
+		bob:   2,
+		carol: 0,
+	}
+	numChannels := func(node *btcec.PublicKey) (int, error) {
+		return numChans[node], nil
+	}
+
+	newRequest := func(node *btcec.PublicKey, amt btcutil.Amount,
+		public bool) *ChannelAcceptRequest {
+
+		msg := &lnwire.OpenChannel{
+			FundingAmount: amt,
+		}
+		if public {
+			msg.ChannelFlags = lnwire.FFAnnounceChannel
+		}
+
+		return &ChannelAcceptRequest{
+			Node:        node,
+			OpenChanMsg: msg,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		rules  Rules
+		req    *ChannelAcceptRequest
+		accept bool
+	}{
+		{
+			name:   "no rules",
+			req:    newRequest(alice, 1000, true),
+			accept: true,
+		},
+		{
+			name: "allowed node",
+			rules: Rules{
+				AllowList: map[[33]byte]struct{}{
+					aliceNode: {},
+				},
+			},
+			req:    newRequest(alice, 1000, true),
+			accept: true,
+		},
+		{
+			name: "node not on allow list",
+			rules: Rules{
+				AllowList: map[[33]byte]struct{}{
+					aliceNode: {},
+				},
+			},
+			req:    newRequest(carol, 1000, true),
+			accept: false,
+		},
+		{
+			name: "denied node",
+			rules: Rules{
+				DenyList: map[[33]byte]struct{}{
+					bobNode: {},
+				},
+			},
+			req:    newRequest(bob, 1000, true),
+			accept: false,
+		},
+		{
+			name:   "below min capacity",
+			rules:  Rules{MinCapacity: 2000},
+			req:    newRequest(alice, 1000, true),
+			accept: false,
+		},
+		{
+			name:   "at min capacity",
+			rules:  Rules{MinCapacity: 1000},
+			req:    newRequest(alice, 1000, true),
+			accept: true,
+		},
+		{
+			name:   "public channel when private required",
+			rules:  Rules{RequirePrivate: true},
+			req:    newRequest(alice, 1000, true),
+			accept: false,
+		},
+		{
+			name:   "private channel when private required",
+			rules:  Rules{RequirePrivate: true},
+			req:    newRequest(alice, 1000, false),
+			accept: true,
+		},
+		{
+			name:   "private channel when public required",
+			rules:  Rules{RequirePublic: true},
+			req:    newRequest(alice, 1000, false),
+			accept: false,
+		},
+		{
+			name:   "max channels per peer reached",
+			rules:  Rules{MaxChansPerPeer: 2},
+			req:    newRequest(bob, 1000, true),
+			accept: false,
+		},
+		{
+			name:   "below max channels per peer",
+			rules:  Rules{MaxChansPerPeer: 2},
+			req:    newRequest(alice, 1000, true),
+			accept: true,
+		},
+	}
+
+	for _, test := range tests {
+		acceptor := NewRuleAcceptor(test.rules, numChannels)
+		err := acceptor.Accept(test.req)
+
+		switch {
+		case test.accept && err != nil:
+			t.Fatalf("%v: expected channel to be accepted, got %v",
+				test.name, err)
+
+		case !test.accept:
+			if _, ok := err.(*RejectError); !ok {
+				t.Fatalf("%v: expected RejectError, got %v",
+					test.name, err)
+			}
+		}
+	}
+}
+
+// TestChainedAcceptor asserts that the ChainedAcceptor only accepts channels
+// which are accepted by all of its acceptors.
+func TestChainedAcceptor(t *testing.T) {
+	t.Parallel()
+
+	alice, aliceNode := newTestNode(t)
+	req := &ChannelAcceptRequest{
+		Node:        alice,
+		OpenChanMsg: &lnwire.OpenChannel{FundingAmount: 1000},
+	}
+
+	chained := NewChainedAcceptor()
+	if err := chained.Accept(req); err != nil {
+		t.Fatalf("empty chain should accept channel, got %v", err)
+	}
+
+	chained.AddAcceptor(NewRuleAcceptor(Rules{MinCapacity: 500}, nil))
+	if err := chained.Accept(req); err != nil {
+		t.Fatalf("expected channel to be accepted, got %v", err)
+	}
+
+	denyID := chained.AddAcceptor(NewRuleAcceptor(Rules{
+		DenyList: map[[33]byte]struct{}{
+			aliceNode: {},
+		},
+	}, nil))
+	if _, ok := chained.Accept(req).(*RejectError); !ok {
+		t.Fatalf("expected channel to be rejected")
+	}
+
+	chained.RemoveAcceptor(denyID)
+	if err := chained.Accept(req); err != nil {
+		t.Fatalf("expected channel to be accepted, got %v", err)
+	}
+}
@@ -75,6 +75,14 @@ var (
 	// can't be found.
 	ErrEdgeNotFound = fmt.Errorf("edge not found")
 
+	// ErrZombieEdge is an error returned when we attempt to look up an edge
+	// but it is marked as a zombie within the zombie index.
+	ErrZombieEdge = fmt.Errorf("edge marked as zombie")
+
+	// ErrZombieEdgeNotFound is returned when we attempt to mark an edge as
+	// live that isn't marked as a zombie within the zombie index.
+	ErrZombieEdgeNotFound = fmt.Errorf("edge not found in zombie index")
+
 	// ErrEdgeAlreadyExist is returned when edge with specific
 	// channel id can't be added because it already exist.
 	ErrEdgeAlreadyExist = fmt.Errorf("edge already exist")
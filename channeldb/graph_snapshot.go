@@ -0,0 +1,758 @@
+package channeldb
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"io"
+	"io/ioutil"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/tor"
+)
+
+// SnapshotFormat is the encoding used to write a GraphSnapshot.
+type SnapshotFormat uint8
+
+const (
+	// SnapshotFormatJSON encodes the snapshot as human readable JSON.
+	SnapshotFormatJSON SnapshotFormat = iota
+
+	// SnapshotFormatBinary encodes the snapshot in a compact binary
+	// format, prefixed by snapshotMagic.
+	SnapshotFormatBinary
+)
+
+// String returns a human readable version of the format.
+func (f SnapshotFormat) String() string {
+	switch f {
+	case SnapshotFormatJSON:
+		return "json"
+	case SnapshotFormatBinary:
+		return "binary"
+	default:
+		return "unknown"
+	}
+}
+
+var (
+	// snapshotMagic is the prefix of a binary encoded graph snapshot. It
+	// allows DecodeGraphSnapshot to tell the two formats apart, as a JSON
+	// snapshot always starts with an opening brace.
+	snapshotMagic = [4]byte{'L', 'N', 'G', 'S'}
+)
+
+const (
+	// snapshotVersion is the version of the binary snapshot format.
+	snapshotVersion uint16 = 0
+)
+
+// GraphSnapshot is a self-contained copy of the channel graph, which can be
+// written to a file and loaded into a fresh database later on. This allows
+// path finding and channel placement to be studied offline against a real
+// topology. Signatures and opaque data aren't part of a snapshot, so a graph
+// loaded from one can't be re-announced to the network.
+//
+// The fields mirror the ones returned by the DescribeGraph RPC, such that
+// snapshots can be created both from a database and by an RPC client.
+type GraphSnapshot struct {
+	// SourceNode is the hex encoded pubkey of the node the graph was seen
+	// from. It is empty if the source node is unknown.
+	SourceNode string `json:"source_node,omitempty"`
+
+	// Nodes are the vertexes of the graph.
+	Nodes []SnapshotNode `json:"nodes"`
+
+	// Edges are the channels of the graph.
+	Edges []SnapshotEdge `json:"edges"`
+}
+
+// SnapshotNode is a node within a GraphSnapshot.
+type SnapshotNode struct {
+	// PubKey is the hex encoded identity pubkey of the node.
+	PubKey string `json:"pub_key"`
+
+	// Alias is the alias the node advertised.
+	Alias string `json:"alias"`
+
+	// Color is the color the node advertised, as #rrggbb.
+	Color string `json:"color"`
+
+	// LastUpdate is the unix timestamp of the latest node announcement.
+	// It is zero if no announcement was received for the node.
+	LastUpdate int64 `json:"last_update"`
+
+	// Addresses are the advertised addresses of the node, as host:port.
+	Addresses []string `json:"addresses"`
+}
+
+// SnapshotEdge is a channel within a GraphSnapshot.
+type SnapshotEdge struct {
+	// ChannelID is the short channel ID of the channel.
+	ChannelID uint64 `json:"channel_id"`
+
+	// ChanPoint is the funding outpoint of the channel, as txid:index.
+	ChanPoint string `json:"chan_point"`
+
+	// Node1Pub is the hex encoded pubkey of the first node.
+	Node1Pub string `json:"node1_pub"`
+
+	// Node2Pub is the hex encoded pubkey of the second node.
+	Node2Pub string `json:"node2_pub"`
+
+	// Capacity is the capacity of the channel in satoshis.
+	Capacity int64 `json:"capacity"`
+
+	// Node1Policy is the routing policy of the first node, if known.
+	Node1Policy *SnapshotPolicy `json:"node1_policy"`
+
+	// Node2Policy is the routing policy of the second node, if known.
+	Node2Policy *SnapshotPolicy `json:"node2_policy"`
+}
+
+// SnapshotPolicy is the routing policy of one of the ends of a SnapshotEdge.
+type SnapshotPolicy struct {
+	// TimeLockDelta is the number of blocks subtracted from the expiry of
+	// forwarded HTLCs.
+	TimeLockDelta uint16 `json:"time_lock_delta"`
+
+	// MinHTLC is the smallest HTLC in millisatoshis that is forwarded.
+	MinHTLC int64 `json:"min_htlc"`
+
+	// FeeBaseMSat is the base fee in millisatoshis charged per HTLC.
+	FeeBaseMSat int64 `json:"fee_base_msat"`
+
+	// FeeRateMilliMSat is the fee charged per millionth of the forwarded
+	// amount.
+	FeeRateMilliMSat int64 `json:"fee_rate_milli_msat"`
+
+	// Disabled is true if the channel is disabled in this direction.
+	Disabled bool `json:"disabled"`
+
+	// LastUpdate is the unix timestamp of the latest channel update.
+	LastUpdate int64 `json:"last_update"`
+}
+
+// Snapshot creates a GraphSnapshot of all nodes and channels within the
+// graph.
+func (c *ChannelGraph) Snapshot() (*GraphSnapshot, error) {
+	snapshot := &GraphSnapshot{}
+
+	sourceNode, err := c.SourceNode()
+	switch {
+	case err == nil:
+		snapshot.SourceNode = hex.EncodeToString(
+			sourceNode.PubKeyBytes[:],
+		)
+
+	case err != ErrSourceNodeNotSet && err != ErrGraphNotFound:
+		return nil, err
+	}
+
+	err = c.ForEachNode(nil, func(_ *bolt.Tx, node *LightningNode) error {
+		snapshotNode := SnapshotNode{
+			PubKey: hex.EncodeToString(node.PubKeyBytes[:]),
+		}
+		if node.HaveNodeAnnouncement {
+			snapshotNode.Alias = node.Alias
+			snapshotNode.Color = fmt.Sprintf("#%02x%02x%02x",
+				node.Color.R, node.Color.G, node.Color.B)
+			snapshotNode.LastUpdate = node.LastUpdate.Unix()
+		}
+		for _, addr := range node.Addresses {
+			snapshotNode.Addresses = append(
+				snapshotNode.Addresses, addr.String(),
+			)
+		}
+
+		snapshot.Nodes = append(snapshot.Nodes, snapshotNode)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.ForEachChannel(func(edgeInfo *ChannelEdgeInfo,
+		policy1, policy2 *ChannelEdgePolicy) error {
+
+		node1 := edgeInfo.NodeKey1Bytes
+		node2 := edgeInfo.NodeKey2Bytes
+		snapshot.Edges = append(snapshot.Edges, SnapshotEdge{
+			ChannelID:   edgeInfo.ChannelID,
+			ChanPoint:   edgeInfo.ChannelPoint.String(),
+			Node1Pub:    hex.EncodeToString(node1[:]),
+			Node2Pub:    hex.EncodeToString(node2[:]),
+			Capacity:    int64(edgeInfo.Capacity),
+			Node1Policy: newSnapshotPolicy(policy1),
+			Node2Policy: newSnapshotPolicy(policy2),
+		})
+		return nil
+	})
+	if err != nil && err != ErrGraphNoEdgesFound {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// newSnapshotPolicy converts the passed edge policy to a SnapshotPolicy. A
+// nil policy results in a nil SnapshotPolicy.
+func newSnapshotPolicy(policy *ChannelEdgePolicy) *SnapshotPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &SnapshotPolicy{
+		TimeLockDelta:    policy.TimeLockDelta,
+		MinHTLC:          int64(policy.MinHTLC),
+		FeeBaseMSat:      int64(policy.FeeBaseMSat),
+		FeeRateMilliMSat: int64(policy.FeeProportionalMillionths),
+		Disabled:         policy.Flags&lnwire.ChanUpdateDisabled != 0,
+		LastUpdate:       policy.LastUpdate.Unix(),
+	}
+}
+
+// LoadSnapshot adds all nodes and channels of the passed snapshot to the
+// graph. If the snapshot has a source node, it is set as the source node of
+// the graph. Channels that already exist within the graph are left untouched,
+// but their policies are overwritten by the ones of the snapshot.
+func (c *ChannelGraph) LoadSnapshot(snapshot *GraphSnapshot) error {
+	for _, snapshotNode := range snapshot.Nodes {
+		node, err := snapshotNode.lightningNode()
+		if err != nil {
+			return err
+		}
+
+		if err := c.AddLightningNode(node); err != nil {
+			return err
+		}
+	}
+
+	if snapshot.SourceNode != "" {
+		pubKey, err := parseSnapshotPubKey(snapshot.SourceNode)
+		if err != nil {
+			return err
+		}
+
+		sourceNode := &LightningNode{
+			PubKeyBytes: pubKey,
+			Features: lnwire.NewFeatureVector(
+				nil, lnwire.GlobalFeatures,
+			),
+		}
+
+		// If the source node is part of the snapshot, we'll use its
+		// announced information instead.
+		for _, snapshotNode := range snapshot.Nodes {
+			if snapshotNode.PubKey != snapshot.SourceNode {
+				continue
+			}
+
+			sourceNode, err = snapshotNode.lightningNode()
+			if err != nil {
+				return err
+			}
+		}
+
+		if err := c.SetSourceNode(sourceNode); err != nil {
+			return err
+		}
+	}
+
+	for _, edge := range snapshot.Edges {
+		if err := c.loadSnapshotEdge(&edge); err != nil {
+			return fmt.Errorf("unable to load channel %v: %v",
+				edge.ChannelID, err)
+		}
+	}
+
+	return nil
+}
+
+// loadSnapshotEdge adds the passed snapshot edge, along with its policies, to
+// the graph.
+func (c *ChannelGraph) loadSnapshotEdge(edge *SnapshotEdge) error {
+	node1, err := parseSnapshotPubKey(edge.Node1Pub)
+	if err != nil {
+		return err
+	}
+	node2, err := parseSnapshotPubKey(edge.Node2Pub)
+	if err != nil {
+		return err
+	}
+	chanPoint, err := parseSnapshotChanPoint(edge.ChanPoint)
+	if err != nil {
+		return err
+	}
+
+	// As the bitcoin keys of the channel aren't part of the snapshot,
+	// we'll use the node keys in their place.
+	edgeInfo := &ChannelEdgeInfo{
+		ChannelID:        edge.ChannelID,
+		NodeKey1Bytes:    node1,
+		NodeKey2Bytes:    node2,
+		BitcoinKey1Bytes: node1,
+		BitcoinKey2Bytes: node2,
+		ChannelPoint:     *chanPoint,
+		Capacity:         btcutil.Amount(edge.Capacity),
+	}
+	err = c.AddChannelEdge(edgeInfo)
+	if err != nil && err != ErrEdgeAlreadyExist {
+		return err
+	}
+
+	policies := []*SnapshotPolicy{edge.Node1Policy, edge.Node2Policy}
+	for i, policy := range policies {
+		if policy == nil {
+			continue
+		}
+
+		var flags lnwire.ChanUpdateFlag
+		if i == 1 {
+			flags |= lnwire.ChanUpdateDirection
+		}
+		if policy.Disabled {
+			flags |= lnwire.ChanUpdateDisabled
+		}
+
+		err := c.UpdateEdgePolicy(&ChannelEdgePolicy{
+			ChannelID:     edge.ChannelID,
+			LastUpdate:    time.Unix(policy.LastUpdate, 0),
+			Flags:         flags,
+			TimeLockDelta: policy.TimeLockDelta,
+			MinHTLC:       lnwire.MilliSatoshi(policy.MinHTLC),
+			FeeBaseMSat:   lnwire.MilliSatoshi(policy.FeeBaseMSat),
+			FeeProportionalMillionths: lnwire.MilliSatoshi(
+				policy.FeeRateMilliMSat,
+			),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// lightningNode converts the snapshot node to a LightningNode.
+func (n *SnapshotNode) lightningNode() (*LightningNode, error) {
+	pubKey, err := parseSnapshotPubKey(n.PubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	node := &LightningNode{
+		PubKeyBytes: pubKey,
+		Features: lnwire.NewFeatureVector(
+			nil, lnwire.GlobalFeatures,
+		),
+	}
+
+	// Nodes we never received an announcement for only consist of their
+	// pubkey.
+	if n.LastUpdate == 0 {
+		return node, nil
+	}
+
+	nodeColor, err := parseSnapshotColor(n.Color)
+	if err != nil {
+		return nil, err
+	}
+
+	node.HaveNodeAnnouncement = true
+	node.LastUpdate = time.Unix(n.LastUpdate, 0)
+	node.Alias = n.Alias
+	node.Color = nodeColor
+	for _, addrStr := range n.Addresses {
+		addr, err := parseSnapshotAddr(addrStr)
+		if err != nil {
+			return nil, err
+		}
+
+		node.Addresses = append(node.Addresses, addr)
+	}
+
+	return node, nil
+}
+
+// OpenSnapshotGraph creates a throwaway channel graph containing the nodes and
+// channels of the passed snapshot. The graph is backed by a database within a
+// temporary directory, which is removed by the returned cleanup closure.
+func OpenSnapshotGraph(snapshot *GraphSnapshot) (*ChannelGraph, func(),
+	error) {
+
+	tempDir, err := ioutil.TempDir("", "graphsnapshot")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	db, err := Open(tempDir)
+	if err != nil {
+		os.RemoveAll(tempDir)
+		return nil, nil, err
+	}
+
+	cleanUp := func() {
+		db.Close()
+		os.RemoveAll(tempDir)
+	}
+
+	graph := db.ChannelGraph()
+	if err := graph.LoadSnapshot(snapshot); err != nil {
+		cleanUp()
+		return nil, nil, err
+	}
+
+	return graph, cleanUp, nil
+}
+
+// Encode writes the snapshot to the passed writer using the given format.
+func (s *GraphSnapshot) Encode(w io.Writer, format SnapshotFormat) error {
+	switch format {
+	case SnapshotFormatJSON:
+		b, err := json.MarshalIndent(s, "", "    ")
+		if err != nil {
+			return err
+		}
+
+		_, err = w.Write(b)
+		return err
+
+	case SnapshotFormatBinary:
+		return s.encodeBinary(w)
+
+	default:
+		return fmt.Errorf("unknown snapshot format: %v", format)
+	}
+}
+
+// encodeBinary writes the snapshot in the binary format. The pubkeys,
+// outpoints and colors of the snapshot are stored in their raw form, so they
+// must be valid.
+func (s *GraphSnapshot) encodeBinary(w io.Writer) error {
+	if _, err := w.Write(snapshotMagic[:]); err != nil {
+		return err
+	}
+
+	var sourceNode []byte
+	if s.SourceNode != "" {
+		pubKey, err := parseSnapshotPubKey(s.SourceNode)
+		if err != nil {
+			return err
+		}
+		sourceNode = pubKey[:]
+	}
+
+	err := WriteElements(w, snapshotVersion, sourceNode,
+		uint32(len(s.Nodes)),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range s.Nodes {
+		pubKey, err := parseSnapshotPubKey(node.PubKey)
+		if err != nil {
+			return err
+		}
+
+		// The color is only set for nodes that have been announced.
+		var nodeColor color.RGBA
+		if node.LastUpdate != 0 {
+			nodeColor, err = parseSnapshotColor(node.Color)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = WriteElements(w, pubKey[:], uint64(node.LastUpdate),
+			[]byte(node.Alias),
+			[]byte{nodeColor.R, nodeColor.G, nodeColor.B},
+			uint16(len(node.Addresses)),
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, addr := range node.Addresses {
+			if err := WriteElement(w, []byte(addr)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := WriteElement(w, uint32(len(s.Edges))); err != nil {
+		return err
+	}
+
+	for _, edge := range s.Edges {
+		node1, err := parseSnapshotPubKey(edge.Node1Pub)
+		if err != nil {
+			return err
+		}
+		node2, err := parseSnapshotPubKey(edge.Node2Pub)
+		if err != nil {
+			return err
+		}
+		chanPoint, err := parseSnapshotChanPoint(edge.ChanPoint)
+		if err != nil {
+			return err
+		}
+
+		err = WriteElements(w, edge.ChannelID, *chanPoint, node1[:],
+			node2[:], btcutil.Amount(edge.Capacity),
+		)
+		if err != nil {
+			return err
+		}
+
+		policies := []*SnapshotPolicy{
+			edge.Node1Policy, edge.Node2Policy,
+		}
+		for _, policy := range policies {
+			if err := WriteElement(w, policy != nil); err != nil {
+				return err
+			}
+			if policy == nil {
+				continue
+			}
+
+			err := WriteElements(w, policy.TimeLockDelta,
+				uint64(policy.MinHTLC),
+				uint64(policy.FeeBaseMSat),
+				uint64(policy.FeeRateMilliMSat),
+				policy.Disabled, uint64(policy.LastUpdate),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// DecodeGraphSnapshot reads a snapshot from the passed reader. The format of
+// the snapshot is detected automatically.
+func DecodeGraphSnapshot(r io.Reader) (*GraphSnapshot, error) {
+	br := bufio.NewReader(r)
+
+	magic, err := br.Peek(len(snapshotMagic))
+	if err == nil && bytes.Equal(magic, snapshotMagic[:]) {
+		br.Discard(len(snapshotMagic))
+		return decodeBinarySnapshot(br)
+	}
+
+	snapshot := &GraphSnapshot{}
+	if err := json.NewDecoder(br).Decode(snapshot); err != nil {
+		return nil, fmt.Errorf("unable to decode snapshot: %v", err)
+	}
+
+	return snapshot, nil
+}
+
+// decodeBinarySnapshot reads a snapshot in the binary format, following its
+// magic prefix.
+func decodeBinarySnapshot(r io.Reader) (*GraphSnapshot, error) {
+	var (
+		version    uint16
+		sourceNode []byte
+		numNodes   uint32
+	)
+	err := ReadElements(r, &version, &sourceNode, &numNodes)
+	if err != nil {
+		return nil, err
+	}
+
+	if version != snapshotVersion {
+		return nil, fmt.Errorf("unknown snapshot version: %v", version)
+	}
+
+	snapshot := &GraphSnapshot{}
+	if len(sourceNode) != 0 {
+		snapshot.SourceNode = hex.EncodeToString(sourceNode)
+	}
+
+	for i := uint32(0); i < numNodes; i++ {
+		var (
+			pubKey, alias, nodeColor []byte
+			lastUpdate               uint64
+			numAddrs                 uint16
+		)
+		err := ReadElements(r, &pubKey, &lastUpdate, &alias,
+			&nodeColor, &numAddrs,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		node := SnapshotNode{
+			PubKey:     hex.EncodeToString(pubKey),
+			Alias:      string(alias),
+			LastUpdate: int64(lastUpdate),
+		}
+		if node.LastUpdate != 0 {
+			node.Color = "#" + hex.EncodeToString(nodeColor)
+		}
+
+		for j := uint16(0); j < numAddrs; j++ {
+			var addr []byte
+			if err := ReadElement(r, &addr); err != nil {
+				return nil, err
+			}
+
+			node.Addresses = append(node.Addresses, string(addr))
+		}
+
+		snapshot.Nodes = append(snapshot.Nodes, node)
+	}
+
+	var numEdges uint32
+	if err := ReadElement(r, &numEdges); err != nil {
+		return nil, err
+	}
+
+	for i := uint32(0); i < numEdges; i++ {
+		var (
+			edge         SnapshotEdge
+			chanPoint    wire.OutPoint
+			node1, node2 []byte
+			capacity     btcutil.Amount
+		)
+		err := ReadElements(r, &edge.ChannelID, &chanPoint, &node1,
+			&node2, &capacity,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		edge.ChanPoint = chanPoint.String()
+		edge.Node1Pub = hex.EncodeToString(node1)
+		edge.Node2Pub = hex.EncodeToString(node2)
+		edge.Capacity = int64(capacity)
+
+		policies := []**SnapshotPolicy{
+			&edge.Node1Policy, &edge.Node2Policy,
+		}
+		for _, policy := range policies {
+			var havePolicy bool
+			if err := ReadElement(r, &havePolicy); err != nil {
+				return nil, err
+			}
+			if !havePolicy {
+				continue
+			}
+
+			var (
+				p                             SnapshotPolicy
+				minHTLC, feeBase, feeRate, ts uint64
+			)
+			err := ReadElements(r, &p.TimeLockDelta, &minHTLC,
+				&feeBase, &feeRate, &p.Disabled, &ts,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			p.MinHTLC = int64(minHTLC)
+			p.FeeBaseMSat = int64(feeBase)
+			p.FeeRateMilliMSat = int64(feeRate)
+			p.LastUpdate = int64(ts)
+			*policy = &p
+		}
+
+		snapshot.Edges = append(snapshot.Edges, edge)
+	}
+
+	return snapshot, nil
+}
+
+// parseSnapshotPubKey decodes a hex encoded pubkey of a snapshot.
+func parseSnapshotPubKey(pubStr string) ([33]byte, error) {
+	var pubKey [33]byte
+
+	pubBytes, err := hex.DecodeString(pubStr)
+	if err != nil {
+		return pubKey, err
+	}
+	if len(pubBytes) != len(pubKey) {
+		return pubKey, fmt.Errorf("invalid pubkey length: %v",
+			len(pubBytes))
+	}
+
+	copy(pubKey[:], pubBytes)
+	return pubKey, nil
+}
+
+// parseSnapshotChanPoint parses an outpoint of a snapshot in the form
+// txid:index.
+func parseSnapshotChanPoint(chanPoint string) (*wire.OutPoint, error) {
+	parts := strings.Split(chanPoint, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid channel point: %v", chanPoint)
+	}
+
+	txid, err := chainhash.NewHashFromStr(parts[0])
+	if err != nil {
+		return nil, err
+	}
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	return wire.NewOutPoint(txid, uint32(index)), nil
+}
+
+// parseSnapshotColor parses a color of a snapshot in the form #rrggbb.
+func parseSnapshotColor(colorStr string) (color.RGBA, error) {
+	colorBytes, err := hex.DecodeString(strings.TrimPrefix(colorStr, "#"))
+	if err != nil || len(colorBytes) != 3 {
+		return color.RGBA{}, fmt.Errorf("invalid color: %v", colorStr)
+	}
+
+	return color.RGBA{
+		R: colorBytes[0],
+		G: colorBytes[1],
+		B: colorBytes[2],
+	}, nil
+}
+
+// parseSnapshotAddr parses an address of a snapshot in the form host:port.
+// The host is either an IP address or an onion service.
+func parseSnapshotAddr(addr string) (net.Addr, error) {
+	host, portStr, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if tor.IsOnionHost(host) {
+		port, err := strconv.Atoi(portStr)
+		if err != nil {
+			return nil, err
+		}
+
+		return &tor.OnionAddr{
+			OnionService: host,
+			Port:         port,
+		}, nil
+	}
+
+	if net.ParseIP(host) == nil {
+		return nil, fmt.Errorf("invalid address: %v", addr)
+	}
+
+	return net.ResolveTCPAddr("tcp", addr)
+}
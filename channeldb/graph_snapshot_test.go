@@ -0,0 +1,130 @@
+package channeldb
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestGraphSnapshot asserts that a snapshot of the graph survives being
+// encoded and decoded in both formats, and that loading it into a fresh graph
+// recreates the original graph.
+func TestGraphSnapshot(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+
+	graph := db.ChannelGraph()
+
+	// We'll create a line of three nodes, the first of which is our own.
+	var nodes []*LightningNode
+	for i := 0; i < 3; i++ {
+		node, err := createTestVertex(db)
+		if err != nil {
+			t.Fatalf("unable to create test node: %v", err)
+		}
+		node.Alias = string('a' + rune(i))
+
+		if err := graph.AddLightningNode(node); err != nil {
+			t.Fatalf("unable to add node: %v", err)
+		}
+
+		nodes = append(nodes, node)
+	}
+	if err := graph.SetSourceNode(nodes[0]); err != nil {
+		t.Fatalf("unable to set source node: %v", err)
+	}
+
+	// The first channel has a policy in both directions, while the second
+	// only has a disabled policy for its first node.
+	edge1, _ := createEdge(100, 0, 0, 0, nodes[0], nodes[1])
+	edge2, _ := createEdge(101, 0, 0, 1, nodes[1], nodes[2])
+	for _, edge := range []*ChannelEdgeInfo{&edge1, &edge2} {
+		if err := graph.AddChannelEdge(edge); err != nil {
+			t.Fatalf("unable to add edge: %v", err)
+		}
+	}
+
+	policies := []*ChannelEdgePolicy{
+		randEdgePolicy(edge1.ChannelID, edge1.ChannelPoint, db),
+		randEdgePolicy(edge1.ChannelID, edge1.ChannelPoint, db),
+		randEdgePolicy(edge2.ChannelID, edge2.ChannelPoint, db),
+	}
+	policies[1].Flags = lnwire.ChanUpdateDirection
+	policies[2].Flags = lnwire.ChanUpdateDisabled
+	for _, policy := range policies {
+		policy.SigBytes = testSig.Serialize()
+		if err := graph.UpdateEdgePolicy(policy); err != nil {
+			t.Fatalf("unable to update edge: %v", err)
+		}
+	}
+
+	snapshot, err := graph.Snapshot()
+	if err != nil {
+		t.Fatalf("unable to create snapshot: %v", err)
+	}
+
+	if len(snapshot.Nodes) != 3 || len(snapshot.Edges) != 2 {
+		t.Fatalf("expected 3 nodes and 2 edges, got %v and %v",
+			len(snapshot.Nodes), len(snapshot.Edges))
+	}
+	for _, edge := range snapshot.Edges {
+		if edge.ChannelID != edge2.ChannelID {
+			continue
+		}
+
+		if edge.Node1Policy == nil || !edge.Node1Policy.Disabled {
+			t.Fatalf("expected disabled policy, got %v",
+				spew.Sdump(edge.Node1Policy))
+		}
+		if edge.Node2Policy != nil {
+			t.Fatalf("expected no policy, got %v",
+				spew.Sdump(edge.Node2Policy))
+		}
+	}
+
+	formats := []SnapshotFormat{SnapshotFormatJSON, SnapshotFormatBinary}
+	for _, format := range formats {
+		var b bytes.Buffer
+		if err := snapshot.Encode(&b, format); err != nil {
+			t.Fatalf("unable to encode %v snapshot: %v", format,
+				err)
+		}
+
+		decoded, err := DecodeGraphSnapshot(&b)
+		if err != nil {
+			t.Fatalf("unable to decode %v snapshot: %v", format,
+				err)
+		}
+		if !reflect.DeepEqual(snapshot, decoded) {
+			t.Fatalf("%v snapshot mismatch: expected %v, got %v",
+				format, spew.Sdump(snapshot),
+				spew.Sdump(decoded))
+		}
+
+		// Loading the snapshot into a fresh graph should result in
+		// the same snapshot being taken of it.
+		snapshotGraph, cleanUpGraph, err := OpenSnapshotGraph(decoded)
+		if err != nil {
+			t.Fatalf("unable to load %v snapshot: %v", format, err)
+		}
+
+		loaded, err := snapshotGraph.Snapshot()
+		cleanUpGraph()
+		if err != nil {
+			t.Fatalf("unable to create snapshot: %v", err)
+		}
+		if !reflect.DeepEqual(snapshot, loaded) {
+			t.Fatalf("loaded %v snapshot mismatch: expected %v, "+
+				"got %v", format, spew.Sdump(snapshot),
+				spew.Sdump(loaded))
+		}
+	}
+}
@@ -1699,6 +1699,16 @@ func TestFilterKnownChanIDs(t *testing.T) {
 		chanIDs = append(chanIDs, chanID.ToUint64())
 	}
 
+	// We'll also mark a channel as a zombie, which should be treated as
+	// known when filtering.
+	const zombieChanID = 98
+	err = graph.MarkEdgeZombie(
+		zombieChanID, node1.PubKeyBytes, node2.PubKeyBytes,
+	)
+	if err != nil {
+		t.Fatalf("unable to mark edge zombie: %v", err)
+	}
+
 	queryCases := []struct {
 		queryIDs []uint64
 
@@ -1723,6 +1733,13 @@ func TestFilterKnownChanIDs(t *testing.T) {
 			queryIDs: append(chanIDs, []uint64{99, 101}...),
 			resp:     []uint64{99, 101},
 		},
+
+		// If we query for a zombie chan ID, it shouldn't be returned
+		// as we've already pruned it.
+		{
+			queryIDs: []uint64{zombieChanID, 99},
+			resp:     []uint64{99},
+		},
 	}
 
 	for _, queryCase := range queryCases {
@@ -2438,6 +2455,140 @@ func TestNodePruningUpdateIndexDeletion(t *testing.T) {
 	}
 }
 
+// TestGraphZombieIndex ensures that we can mark edges correctly as zombie/live
+// within the graph, and that stale edges deleted from the graph are marked as
+// zombies while closed ones aren't.
+func TestGraphZombieIndex(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test database: %v", err)
+	}
+
+	graph := db.ChannelGraph()
+
+	node1, err := createTestVertex(db)
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+	node2, err := createTestVertex(db)
+	if err != nil {
+		t.Fatalf("unable to create test node: %v", err)
+	}
+
+	assertZombie := func(chanID uint64, expZombie bool) {
+		t.Helper()
+
+		isZombie, pubKey1, pubKey2 := graph.IsZombieEdge(chanID)
+		if isZombie != expZombie {
+			t.Fatalf("expected zombie status %v for chan_id=%v, "+
+				"got %v", expZombie, chanID, isZombie)
+		}
+		if !isZombie {
+			return
+		}
+		if pubKey1 != node1.PubKeyBytes {
+			t.Fatalf("expected node key 1 %x, got %x",
+				node1.PubKeyBytes, pubKey1)
+		}
+		if pubKey2 != node2.PubKeyBytes {
+			t.Fatalf("expected node key 2 %x, got %x",
+				node2.PubKeyBytes, pubKey2)
+		}
+	}
+	assertNumZombies := func(expNumZombies uint64) {
+		t.Helper()
+
+		numZombies, err := graph.NumZombies()
+		if err != nil {
+			t.Fatalf("unable to query number of zombies: %v", err)
+		}
+		if numZombies != expNumZombies {
+			t.Fatalf("expected %v zombies, got %v", expNumZombies,
+				numZombies)
+		}
+	}
+
+	// We'll start by adding two edges to the graph.
+	edge1, chanID1 := createEdge(100, 0, 0, 0, node1, node2)
+	if err := graph.AddChannelEdge(&edge1); err != nil {
+		t.Fatalf("unable to add edge: %v", err)
+	}
+	edge2, chanID2 := createEdge(101, 0, 0, 1, node1, node2)
+	if err := graph.AddChannelEdge(&edge2); err != nil {
+		t.Fatalf("unable to add edge: %v", err)
+	}
+	assertZombie(chanID1.ToUint64(), false)
+	assertNumZombies(0)
+
+	// Deleting the first edge, as the router does when pruning stale
+	// channels, should mark it as a zombie.
+	if err := graph.DeleteChannelEdge(&edge1.ChannelPoint); err != nil {
+		t.Fatalf("unable to delete edge: %v", err)
+	}
+	assertZombie(chanID1.ToUint64(), true)
+	assertNumZombies(1)
+
+	// Looking up the zombie edge should fail with ErrZombieEdge, along
+	// with the node keys of the channel.
+	info, e1, e2, err := graph.FetchChannelEdgesByID(chanID1.ToUint64())
+	if err != ErrZombieEdge {
+		t.Fatalf("expected ErrZombieEdge, got: %v", err)
+	}
+	if info.NodeKey1Bytes != node1.PubKeyBytes ||
+		info.NodeKey2Bytes != node2.PubKeyBytes {
+
+		t.Fatalf("zombie edge info doesn't include node keys")
+	}
+	if e1 != nil || e2 != nil {
+		t.Fatalf("expected no policies for zombie edge")
+	}
+
+	// On the other hand, closed channels pruned from the graph shouldn't
+	// be marked as zombies.
+	var blockHash chainhash.Hash
+	copy(blockHash[:], bytes.Repeat([]byte{1}, 32))
+	_, err = graph.PruneGraph(
+		[]*wire.OutPoint{&edge2.ChannelPoint}, &blockHash, 102,
+	)
+	if err != nil {
+		t.Fatalf("unable to prune graph: %v", err)
+	}
+	assertZombie(chanID2.ToUint64(), false)
+	assertNumZombies(1)
+
+	// Marking the zombie edge as live should remove it from the index,
+	// after which it should no longer be possible to mark it as live.
+	if err := graph.MarkEdgeLive(chanID1.ToUint64()); err != nil {
+		t.Fatalf("unable to mark edge as live: %v", err)
+	}
+	assertZombie(chanID1.ToUint64(), false)
+	assertNumZombies(0)
+
+	err = graph.MarkEdgeLive(chanID1.ToUint64())
+	if err != ErrZombieEdgeNotFound {
+		t.Fatalf("expected ErrZombieEdgeNotFound, got: %v", err)
+	}
+
+	_, _, _, err = graph.FetchChannelEdgesByID(chanID1.ToUint64())
+	if err != ErrEdgeNotFound {
+		t.Fatalf("expected ErrEdgeNotFound, got: %v", err)
+	}
+
+	// Finally, we'll mark the edge as a zombie directly, and ensure it's
+	// reflected within the index.
+	err = graph.MarkEdgeZombie(
+		chanID1.ToUint64(), node1.PubKeyBytes, node2.PubKeyBytes,
+	)
+	if err != nil {
+		t.Fatalf("unable to mark edge as zombie: %v", err)
+	}
+	assertZombie(chanID1.ToUint64(), true)
+	assertNumZombies(1)
+}
+
 // compareNodes is used to compare two LightningNodes while excluding the
 // Features struct, which cannot be compared as the semantics for reserializing
 // the featuresMap have not been defined.
@@ -376,6 +376,49 @@ func TestDuplicateSettleInvoice(t *testing.T) {
 	}
 }
 
+// TestDeleteInvoice tests that a deleted invoice can no longer be looked up,
+// and that deleting an unknown invoice fails.
+func TestDeleteInvoice(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	invoice, err := randInvoice(lnwire.NewMSatFromSatoshis(1000))
+	if err != nil {
+		t.Fatalf("unable to create invoice: %v", err)
+	}
+	if _, err := db.AddInvoice(invoice); err != nil {
+		t.Fatalf("unable to add invoice %v", err)
+	}
+
+	payHash := sha256.Sum256(invoice.Terms.PaymentPreimage[:])
+	if _, err := db.LookupInvoice(payHash); err != nil {
+		t.Fatalf("unable to find invoice: %v", err)
+	}
+
+	if err := db.DeleteInvoice(payHash); err != nil {
+		t.Fatalf("unable to delete invoice: %v", err)
+	}
+	if _, err := db.LookupInvoice(payHash); err != ErrInvoiceNotFound {
+		t.Fatalf("expected ErrInvoiceNotFound after delete, got %v",
+			err)
+	}
+
+	// Deleting the invoice once more should fail, as it's gone.
+	if err := db.DeleteInvoice(payHash); err != ErrInvoiceNotFound {
+		t.Fatalf("expected ErrInvoiceNotFound, got %v", err)
+	}
+
+	// With the invoice gone, its payment hash may be used again.
+	if _, err := db.AddInvoice(invoice); err != nil {
+		t.Fatalf("unable to add invoice again: %v", err)
+	}
+}
+
 // TestQueryInvoices ensures that we can properly query the invoice database for
 // invoices using different types of queries.
 func TestQueryInvoices(t *testing.T) {
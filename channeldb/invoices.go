@@ -596,6 +596,36 @@ func (d *DB) SettleInvoice(paymentHash [32]byte,
 	return settledInvoice, nil
 }
 
+// DeleteInvoice removes the invoice corresponding to the passed payment hash
+// from the database, such that incoming HTLCs paying to the hash can no longer
+// be settled. If an invoice matching the passed payment hash doesn't exist
+// within the database, then the action will fail with a "not found" error.
+func (d *DB) DeleteInvoice(paymentHash [32]byte) error {
+	return d.Update(func(tx *bolt.Tx) error {
+		invoices := tx.Bucket(invoiceBucket)
+		if invoices == nil {
+			return ErrNoInvoicesCreated
+		}
+		invoiceIndex := invoices.Bucket(invoiceIndexBucket)
+		if invoiceIndex == nil {
+			return ErrNoInvoicesCreated
+		}
+
+		invoiceNum := invoiceIndex.Get(paymentHash[:])
+		if invoiceNum == nil {
+			return ErrInvoiceNotFound
+		}
+
+		// We'll remove the invoice itself along with its entry within
+		// the payment hash index, so the hash may be reused.
+		if err := invoices.Delete(invoiceNum); err != nil {
+			return err
+		}
+
+		return invoiceIndex.Delete(paymentHash[:])
+	})
+}
+
 // InvoicesSettledSince can be used by callers to catch up any settled invoices
 // they missed within the settled invoice time series. We'll return all known
 // settled invoice that have a settle index higher than the passed
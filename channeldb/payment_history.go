@@ -0,0 +1,711 @@
+package channeldb
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/coreos/bbolt"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// paymentHistoryBucket is the name of the top-level bucket that stores
+	// the lifecycle of every payment sent by the daemon. Within this
+	// bucket, each payment is stored within its own sub-bucket keyed by
+	// its payment hash:
+	//
+	// payment-history
+	//    |
+	//    |-- <payment-hash>
+	//    |      |-- payment-info-key: <target, amount, creation time>
+	//    |      |-- payment-state-key: <payment status>
+	//    |      |-- payment-attempts
+	//    |             |-- <attempt id>: <attempt>
+	//    |             |-- ...
+	//    |
+	//    |-- <payment-hash>
+	//    ...
+	paymentHistoryBucket = []byte("payment-history")
+
+	// paymentInfoKey is the key under which the creation info of a
+	// payment is stored within its history bucket.
+	paymentInfoKey = []byte("payment-info-key")
+
+	// paymentStateKey is the key under which the current status of a
+	// payment is stored within its history bucket.
+	paymentStateKey = []byte("payment-state-key")
+
+	// paymentAttemptsBucket is the name of the sub-bucket of a payment's
+	// history bucket that stores each of the attempts made to complete the
+	// payment, keyed by a monotonically increasing attempt ID.
+	paymentAttemptsBucket = []byte("payment-attempts")
+
+	// ErrPaymentHistoryNotFound is returned when the history of a payment
+	// hash is requested but cannot be found.
+	ErrPaymentHistoryNotFound = errors.New("payment history not found")
+
+	// ErrPaymentHistoryCompleted is returned when attempting to start
+	// tracking a payment which has already completed successfully.
+	ErrPaymentHistoryCompleted = errors.New("payment has already " +
+		"completed")
+
+	// ErrPaymentAttemptNotFound is returned when attempting to resolve an
+	// attempt which cannot be found within the history of a payment.
+	ErrPaymentAttemptNotFound = errors.New("payment attempt not found")
+
+	// ErrPaymentAttemptResolved is returned when attempting to resolve an
+	// attempt which has already been resolved.
+	ErrPaymentAttemptResolved = errors.New("payment attempt already " +
+		"resolved")
+)
+
+// AttemptState describes the state of a single attempt to complete a payment.
+type AttemptState byte
+
+const (
+	// AttemptInFlight is the state of an attempt whose HTLC has been sent,
+	// but hasn't been resolved yet.
+	AttemptInFlight AttemptState = 0
+
+	// AttemptSucceeded is the state of an attempt whose HTLC was settled
+	// by the destination.
+	AttemptSucceeded AttemptState = 1
+
+	// AttemptFailed is the state of an attempt whose HTLC was failed
+	// back.
+	AttemptFailed AttemptState = 2
+)
+
+// String returns a human readable representation of the attempt state.
+func (s AttemptState) String() string {
+	switch s {
+	case AttemptInFlight:
+		return "In Flight"
+	case AttemptSucceeded:
+		return "Succeeded"
+	case AttemptFailed:
+		return "Failed"
+	default:
+		return "Unknown"
+	}
+}
+
+// AttemptHop describes a single hop within the route of a payment attempt.
+type AttemptHop struct {
+	// PubKey is the compressed public key of the node that this hop leads
+	// to.
+	PubKey [33]byte
+
+	// ChannelID is the short channel ID of the channel used to reach the
+	// node.
+	ChannelID uint64
+
+	// AmtToForward is the amount that the node this hop leads to is
+	// instructed to forward.
+	AmtToForward lnwire.MilliSatoshi
+
+	// Fee is the fee that the node this hop leads to is paid for
+	// forwarding the payment.
+	Fee lnwire.MilliSatoshi
+
+	// OutgoingTimeLock is the time-lock value that the node this hop leads
+	// to is instructed to use on its outgoing HTLC.
+	OutgoingTimeLock uint32
+}
+
+// PaymentAttempt records a single attempt to complete a payment across a
+// particular route, along with its outcome.
+type PaymentAttempt struct {
+	// AttemptID uniquely identifies the attempt within the history of the
+	// payment. It is assigned by the database when the attempt is
+	// registered.
+	AttemptID uint64
+
+	// State is the current state of the attempt.
+	State AttemptState
+
+	// Hops is the route taken by the attempt, excluding our own node.
+	Hops []AttemptHop
+
+	// TotalAmount is the amount of the HTLC extended to the first hop,
+	// including all fees.
+	TotalAmount lnwire.MilliSatoshi
+
+	// TotalFees is the sum of the fees paid to each hop of the route.
+	TotalFees lnwire.MilliSatoshi
+
+	// TotalTimeLock is the time-lock of the HTLC extended to the first
+	// hop.
+	TotalTimeLock uint32
+
+	// AttemptTime is the time at which the attempt was dispatched.
+	AttemptTime time.Time
+
+	// ResolveTime is the time at which the attempt was either settled or
+	// failed. This is the zero time for attempts still in flight.
+	ResolveTime time.Time
+
+	// Preimage is the preimage revealed by a successful attempt.
+	Preimage [32]byte
+
+	// FailureSourceIdx is the index of the node that reported the failure
+	// of a failed attempt within the route, where zero denotes our own
+	// node, and i denotes the node reached by the i-th hop.
+	FailureSourceIdx uint32
+
+	// FailureCode is the onion failure code reported for a failed attempt.
+	// A zero code indicates that the attempt failed locally without an
+	// onion failure, for instance because no route could be dispatched.
+	FailureCode lnwire.FailCode
+}
+
+// PaymentHistory describes the full lifecycle of an outgoing payment: what
+// was being paid, its current status, and every attempt made to complete it.
+type PaymentHistory struct {
+	// PaymentHash is the payment hash of the payment.
+	PaymentHash [32]byte
+
+	// Target is the compressed public key of the destination of the
+	// payment.
+	Target [33]byte
+
+	// Amount is the amount being paid to the destination, excluding fees.
+	Amount lnwire.MilliSatoshi
+
+	// CreationTime is the time at which the payment was first initiated.
+	CreationTime time.Time
+
+	// Status is the current status of the payment as a whole.
+	Status PaymentStatus
+
+	// Attempts is the set of attempts made to complete the payment, in
+	// the order they were made.
+	Attempts []*PaymentAttempt
+}
+
+// Terminal returns true if the payment will not be attempted any further,
+// either because it succeeded or because it was abandoned.
+func (p *PaymentHistory) Terminal() bool {
+	return p.Status == StatusCompleted || p.Status == StatusFailed
+}
+
+// InitPaymentHistory starts tracking the lifecycle of a payment, marking it as
+// in flight. If the payment was previously tracked and failed, it's moved back
+// in flight and any new attempts are appended to its existing history. An
+// ErrPaymentHistoryCompleted error is returned if the payment already
+// completed successfully.
+func (db *DB) InitPaymentHistory(paymentHash [32]byte, target [33]byte,
+	amt lnwire.MilliSatoshi) (*PaymentHistory, error) {
+
+	var history *PaymentHistory
+	err := db.Batch(func(tx *bolt.Tx) error {
+		// Reset the history, to avoid carrying over the result of a
+		// previous execution of the batched db transaction.
+		history = nil
+
+		histories, err := tx.CreateBucketIfNotExists(
+			paymentHistoryBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		payment, err := histories.CreateBucketIfNotExists(
+			paymentHash[:],
+		)
+		if err != nil {
+			return err
+		}
+
+		// If the payment is already being tracked, then we'll only
+		// reopen it if it isn't completed.
+		if payment.Get(paymentInfoKey) != nil {
+			var status PaymentStatus
+			err := status.FromBytes(payment.Get(paymentStateKey))
+			if err != nil {
+				return err
+			}
+			if status == StatusCompleted {
+				return ErrPaymentHistoryCompleted
+			}
+		} else {
+			var b bytes.Buffer
+			err := serializePaymentInfo(
+				&b, target, amt, time.Now(),
+			)
+			if err != nil {
+				return err
+			}
+			err = payment.Put(paymentInfoKey, b.Bytes())
+			if err != nil {
+				return err
+			}
+		}
+
+		err = payment.Put(paymentStateKey, StatusInFlight.Bytes())
+		if err != nil {
+			return err
+		}
+
+		history, err = fetchPaymentHistory(payment, paymentHash)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// RegisterPaymentAttempt records a new in-flight attempt within the history of
+// the payment. The ID assigned to the attempt is populated within the passed
+// attempt, and the updated history is returned.
+func (db *DB) RegisterPaymentAttempt(paymentHash [32]byte,
+	attempt *PaymentAttempt) (*PaymentHistory, error) {
+
+	var history *PaymentHistory
+	err := db.Update(func(tx *bolt.Tx) error {
+		payment, err := fetchPaymentHistoryBucket(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		attempts, err := payment.CreateBucketIfNotExists(
+			paymentAttemptsBucket,
+		)
+		if err != nil {
+			return err
+		}
+
+		attemptID, err := attempts.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		attempt.AttemptID = attemptID
+		attempt.State = AttemptInFlight
+
+		var b bytes.Buffer
+		if err := serializePaymentAttempt(&b, attempt); err != nil {
+			return err
+		}
+
+		var attemptKey [8]byte
+		byteOrder.PutUint64(attemptKey[:], attemptID)
+		if err := attempts.Put(attemptKey[:], b.Bytes()); err != nil {
+			return err
+		}
+
+		history, err = fetchPaymentHistory(payment, paymentHash)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// SettlePaymentAttempt marks the given in-flight attempt as succeeded, and the
+// payment as a whole as completed.
+func (db *DB) SettlePaymentAttempt(paymentHash [32]byte, attemptID uint64,
+	preimage [32]byte) (*PaymentHistory, error) {
+
+	return db.resolvePaymentAttempt(
+		paymentHash, attemptID, StatusCompleted,
+		func(attempt *PaymentAttempt) {
+			attempt.State = AttemptSucceeded
+			attempt.Preimage = preimage
+		},
+	)
+}
+
+// FailPaymentAttempt marks the given in-flight attempt as failed, recording
+// the node that reported the failure along with its failure code. The payment
+// as a whole remains in flight, as further attempts may follow.
+func (db *DB) FailPaymentAttempt(paymentHash [32]byte, attemptID uint64,
+	sourceIdx uint32, code lnwire.FailCode) (*PaymentHistory, error) {
+
+	return db.resolvePaymentAttempt(
+		paymentHash, attemptID, StatusInFlight,
+		func(attempt *PaymentAttempt) {
+			attempt.State = AttemptFailed
+			attempt.FailureSourceIdx = sourceIdx
+			attempt.FailureCode = code
+		},
+	)
+}
+
+// resolvePaymentAttempt applies the resolution to the given in-flight attempt,
+// stamping its resolve time, and transitions the payment to the passed status.
+func (db *DB) resolvePaymentAttempt(paymentHash [32]byte, attemptID uint64,
+	status PaymentStatus,
+	resolve func(*PaymentAttempt)) (*PaymentHistory, error) {
+
+	var history *PaymentHistory
+	err := db.Update(func(tx *bolt.Tx) error {
+		payment, err := fetchPaymentHistoryBucket(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		attempts := payment.Bucket(paymentAttemptsBucket)
+		if attempts == nil {
+			return ErrPaymentAttemptNotFound
+		}
+
+		var attemptKey [8]byte
+		byteOrder.PutUint64(attemptKey[:], attemptID)
+		attemptBytes := attempts.Get(attemptKey[:])
+		if attemptBytes == nil {
+			return ErrPaymentAttemptNotFound
+		}
+
+		attempt, err := deserializePaymentAttempt(
+			bytes.NewReader(attemptBytes),
+		)
+		if err != nil {
+			return err
+		}
+		if attempt.State != AttemptInFlight {
+			return ErrPaymentAttemptResolved
+		}
+
+		resolve(attempt)
+		attempt.ResolveTime = time.Now()
+
+		var b bytes.Buffer
+		if err := serializePaymentAttempt(&b, attempt); err != nil {
+			return err
+		}
+		if err := attempts.Put(attemptKey[:], b.Bytes()); err != nil {
+			return err
+		}
+
+		err = payment.Put(paymentStateKey, status.Bytes())
+		if err != nil {
+			return err
+		}
+
+		history, err = fetchPaymentHistory(payment, paymentHash)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// FailPaymentHistory marks the payment as failed, signalling that no further
+// attempts will be made to complete it.
+func (db *DB) FailPaymentHistory(paymentHash [32]byte) (*PaymentHistory,
+	error) {
+
+	return db.setPaymentStatus(paymentHash, StatusFailed)
+}
+
+// CompletePaymentHistory marks the payment as completed without settling any
+// of its attempts. This is used when the payment turns out to have been
+// completed already, such that none of the attempts tracked here succeeded.
+func (db *DB) CompletePaymentHistory(paymentHash [32]byte) (*PaymentHistory,
+	error) {
+
+	return db.setPaymentStatus(paymentHash, StatusCompleted)
+}
+
+// setPaymentStatus updates the status of the payment, and returns its
+// updated history.
+func (db *DB) setPaymentStatus(paymentHash [32]byte,
+	status PaymentStatus) (*PaymentHistory, error) {
+
+	var history *PaymentHistory
+	err := db.Update(func(tx *bolt.Tx) error {
+		payment, err := fetchPaymentHistoryBucket(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		err = payment.Put(paymentStateKey, status.Bytes())
+		if err != nil {
+			return err
+		}
+
+		history, err = fetchPaymentHistory(payment, paymentHash)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// FetchPaymentHistory returns the full history of the payment with the given
+// payment hash. If the payment isn't known, ErrPaymentHistoryNotFound is
+// returned.
+func (db *DB) FetchPaymentHistory(paymentHash [32]byte) (*PaymentHistory,
+	error) {
+
+	var history *PaymentHistory
+	err := db.View(func(tx *bolt.Tx) error {
+		payment, err := fetchPaymentHistoryBucket(tx, paymentHash)
+		if err != nil {
+			return err
+		}
+
+		history, err = fetchPaymentHistory(payment, paymentHash)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// FetchAllPaymentHistories returns the history of every payment tracked by
+// the database.
+func (db *DB) FetchAllPaymentHistories() ([]*PaymentHistory, error) {
+	var histories []*PaymentHistory
+	err := db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(paymentHistoryBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			// Each payment is stored within its own sub-bucket, so
+			// we'll skip anything else.
+			if v != nil || len(k) != 32 {
+				return nil
+			}
+
+			var paymentHash [32]byte
+			copy(paymentHash[:], k)
+
+			history, err := fetchPaymentHistory(
+				bucket.Bucket(k), paymentHash,
+			)
+			if err != nil {
+				return err
+			}
+
+			histories = append(histories, history)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return histories, nil
+}
+
+// fetchPaymentHistoryBucket returns the history bucket of the payment with the
+// given payment hash.
+func fetchPaymentHistoryBucket(tx *bolt.Tx,
+	paymentHash [32]byte) (*bolt.Bucket, error) {
+
+	histories := tx.Bucket(paymentHistoryBucket)
+	if histories == nil {
+		return nil, ErrPaymentHistoryNotFound
+	}
+
+	payment := histories.Bucket(paymentHash[:])
+	if payment == nil || payment.Get(paymentInfoKey) == nil {
+		return nil, ErrPaymentHistoryNotFound
+	}
+
+	return payment, nil
+}
+
+// fetchPaymentHistory reads the full history of a payment from its history
+// bucket.
+func fetchPaymentHistory(payment *bolt.Bucket,
+	paymentHash [32]byte) (*PaymentHistory, error) {
+
+	history := &PaymentHistory{
+		PaymentHash: paymentHash,
+	}
+
+	r := bytes.NewReader(payment.Get(paymentInfoKey))
+	err := deserializePaymentInfo(
+		r, &history.Target, &history.Amount, &history.CreationTime,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = history.Status.FromBytes(payment.Get(paymentStateKey))
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := payment.Bucket(paymentAttemptsBucket)
+	if attempts == nil {
+		return history, nil
+	}
+
+	// As the attempt IDs are stored in big endian, iterating over the
+	// bucket will return the attempts in the order they were made.
+	err = attempts.ForEach(func(k, v []byte) error {
+		attempt, err := deserializePaymentAttempt(bytes.NewReader(v))
+		if err != nil {
+			return err
+		}
+		attempt.AttemptID = byteOrder.Uint64(k)
+
+		history.Attempts = append(history.Attempts, attempt)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return history, nil
+}
+
+// serializeTime encodes the passed time as its unix nanosecond timestamp,
+// with the zero time being encoded as zero.
+func serializeTime(w io.Writer, t time.Time) error {
+	var ns uint64
+	if !t.IsZero() {
+		ns = uint64(t.UnixNano())
+	}
+
+	return WriteElement(w, ns)
+}
+
+// deserializeTime decodes a time encoded by serializeTime.
+func deserializeTime(r io.Reader, t *time.Time) error {
+	var ns uint64
+	if err := ReadElement(r, &ns); err != nil {
+		return err
+	}
+
+	if ns == 0 {
+		*t = time.Time{}
+	} else {
+		*t = time.Unix(0, int64(ns))
+	}
+
+	return nil
+}
+
+func serializePaymentInfo(w io.Writer, target [33]byte,
+	amt lnwire.MilliSatoshi, creationTime time.Time) error {
+
+	if _, err := w.Write(target[:]); err != nil {
+		return err
+	}
+	if err := WriteElement(w, amt); err != nil {
+		return err
+	}
+
+	return serializeTime(w, creationTime)
+}
+
+func deserializePaymentInfo(r io.Reader, target *[33]byte,
+	amt *lnwire.MilliSatoshi, creationTime *time.Time) error {
+
+	if _, err := io.ReadFull(r, target[:]); err != nil {
+		return err
+	}
+	if err := ReadElement(r, amt); err != nil {
+		return err
+	}
+
+	return deserializeTime(r, creationTime)
+}
+
+func serializePaymentAttempt(w io.Writer, a *PaymentAttempt) error {
+	if _, err := w.Write([]byte{byte(a.State)}); err != nil {
+		return err
+	}
+
+	err := WriteElements(
+		w, a.TotalAmount, a.TotalFees, a.TotalTimeLock,
+		uint16(len(a.Hops)),
+	)
+	if err != nil {
+		return err
+	}
+
+	for _, hop := range a.Hops {
+		if _, err := w.Write(hop.PubKey[:]); err != nil {
+			return err
+		}
+
+		err := WriteElements(
+			w, hop.ChannelID, hop.AmtToForward, hop.Fee,
+			hop.OutgoingTimeLock,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := serializeTime(w, a.AttemptTime); err != nil {
+		return err
+	}
+	if err := serializeTime(w, a.ResolveTime); err != nil {
+		return err
+	}
+
+	return WriteElements(
+		w, a.Preimage, a.FailureSourceIdx, uint16(a.FailureCode),
+	)
+}
+
+func deserializePaymentAttempt(r io.Reader) (*PaymentAttempt, error) {
+	a := &PaymentAttempt{}
+
+	var state [1]byte
+	if _, err := io.ReadFull(r, state[:]); err != nil {
+		return nil, err
+	}
+	a.State = AttemptState(state[0])
+
+	var numHops uint16
+	err := ReadElements(
+		r, &a.TotalAmount, &a.TotalFees, &a.TotalTimeLock, &numHops,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	a.Hops = make([]AttemptHop, numHops)
+	for i := range a.Hops {
+		hop := &a.Hops[i]
+		if _, err := io.ReadFull(r, hop.PubKey[:]); err != nil {
+			return nil, err
+		}
+
+		err := ReadElements(
+			r, &hop.ChannelID, &hop.AmtToForward, &hop.Fee,
+			&hop.OutgoingTimeLock,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := deserializeTime(r, &a.AttemptTime); err != nil {
+		return nil, err
+	}
+	if err := deserializeTime(r, &a.ResolveTime); err != nil {
+		return nil, err
+	}
+
+	var failureCode uint16
+	err = ReadElements(r, &a.Preimage, &a.FailureSourceIdx, &failureCode)
+	if err != nil {
+		return nil, err
+	}
+	a.FailureCode = lnwire.FailCode(failureCode)
+
+	return a, nil
+}
@@ -0,0 +1,248 @@
+package channeldb
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+func makeFakePaymentAttempt(numHops int) *PaymentAttempt {
+	attempt := &PaymentAttempt{
+		TotalAmount:   lnwire.NewMSatFromSatoshis(10000),
+		TotalFees:     lnwire.MilliSatoshi(1000 * (numHops - 1)),
+		TotalTimeLock: 500,
+		// Use single second precision to avoid false positive test
+		// failures due to the monotonic time component.
+		AttemptTime: time.Unix(time.Now().Unix(), 0),
+	}
+
+	for i := 0; i < numHops; i++ {
+		var hop AttemptHop
+		copy(hop.PubKey[:], bytes.Repeat([]byte{byte(i)}, 33))
+		hop.ChannelID = uint64(i + 1)
+		hop.AmtToForward = lnwire.NewMSatFromSatoshis(9000)
+		hop.Fee = 1000
+		hop.OutgoingTimeLock = uint32(400 - i*10)
+
+		attempt.Hops = append(attempt.Hops, hop)
+	}
+
+	return attempt
+}
+
+// TestPaymentHistoryLifecycle asserts that every attempt of a payment, along
+// with its outcome, is recorded within the history of the payment.
+func TestPaymentHistoryLifecycle(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	paymentHash := makeFakePaymentHash()
+
+	// Payments which have never been initiated shouldn't have a history.
+	_, err = db.FetchPaymentHistory(paymentHash)
+	if err != ErrPaymentHistoryNotFound {
+		t.Fatalf("expected ErrPaymentHistoryNotFound, got %v", err)
+	}
+
+	var target [33]byte
+	copy(target[:], bytes.Repeat([]byte{2}, 33))
+	amt := lnwire.NewMSatFromSatoshis(9000)
+
+	history, err := db.InitPaymentHistory(paymentHash, target, amt)
+	if err != nil {
+		t.Fatalf("unable to init payment history: %v", err)
+	}
+	if history.Status != StatusInFlight {
+		t.Fatalf("expected status %v, got %v", StatusInFlight,
+			history.Status)
+	}
+	if history.Target != target || history.Amount != amt {
+		t.Fatalf("payment info mismatch: %v", spew.Sdump(history))
+	}
+
+	// We'll now register a first attempt, which fails at the second node
+	// of the route.
+	first := makeFakePaymentAttempt(3)
+	if _, err := db.RegisterPaymentAttempt(paymentHash, first); err != nil {
+		t.Fatalf("unable to register attempt: %v", err)
+	}
+	history, err = db.FailPaymentAttempt(
+		paymentHash, first.AttemptID, 2,
+		lnwire.CodeTemporaryChannelFailure,
+	)
+	if err != nil {
+		t.Fatalf("unable to fail attempt: %v", err)
+	}
+	if history.Status != StatusInFlight {
+		t.Fatalf("expected status %v, got %v", StatusInFlight,
+			history.Status)
+	}
+
+	// An attempt can only be resolved once.
+	_, err = db.SettlePaymentAttempt(
+		paymentHash, first.AttemptID, [32]byte{},
+	)
+	if err != ErrPaymentAttemptResolved {
+		t.Fatalf("expected ErrPaymentAttemptResolved, got %v", err)
+	}
+
+	// The second attempt succeeds, which should complete the payment.
+	second := makeFakePaymentAttempt(2)
+	if _, err := db.RegisterPaymentAttempt(paymentHash, second); err != nil {
+		t.Fatalf("unable to register attempt: %v", err)
+	}
+	if second.AttemptID == first.AttemptID {
+		t.Fatalf("attempts share ID %v", first.AttemptID)
+	}
+	_, err = db.SettlePaymentAttempt(paymentHash, second.AttemptID, rev)
+	if err != nil {
+		t.Fatalf("unable to settle attempt: %v", err)
+	}
+
+	history, err = db.FetchPaymentHistory(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment history: %v", err)
+	}
+	if history.Status != StatusCompleted {
+		t.Fatalf("expected status %v, got %v", StatusCompleted,
+			history.Status)
+	}
+	if len(history.Attempts) != 2 {
+		t.Fatalf("expected 2 attempts, got %v", len(history.Attempts))
+	}
+
+	// The resolve times are stamped by the database, so we'll copy them
+	// over before comparing the attempts.
+	for _, attempt := range history.Attempts {
+		if attempt.ResolveTime.IsZero() {
+			t.Fatalf("attempt %v has no resolve time",
+				attempt.AttemptID)
+		}
+	}
+	first.State = AttemptFailed
+	first.FailureSourceIdx = 2
+	first.FailureCode = lnwire.CodeTemporaryChannelFailure
+	first.ResolveTime = history.Attempts[0].ResolveTime
+	second.State = AttemptSucceeded
+	second.Preimage = rev
+	second.ResolveTime = history.Attempts[1].ResolveTime
+
+	expected := []*PaymentAttempt{first, second}
+	if !reflect.DeepEqual(history.Attempts, expected) {
+		t.Fatalf("attempts mismatch: expected %v, got %v",
+			spew.Sdump(expected), spew.Sdump(history.Attempts))
+	}
+
+	// Now that the payment has completed, it shouldn't be possible to
+	// track it again.
+	_, err = db.InitPaymentHistory(paymentHash, target, amt)
+	if err != ErrPaymentHistoryCompleted {
+		t.Fatalf("expected ErrPaymentHistoryCompleted, got %v", err)
+	}
+}
+
+// TestPaymentHistoryFailed asserts that an abandoned payment is marked as
+// failed, and that it may be reattempted afterwards.
+func TestPaymentHistoryFailed(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	paymentHash := makeFakePaymentHash()
+	amt := lnwire.NewMSatFromSatoshis(9000)
+
+	_, err = db.InitPaymentHistory(paymentHash, [33]byte{}, amt)
+	if err != nil {
+		t.Fatalf("unable to init payment history: %v", err)
+	}
+	attempt := makeFakePaymentAttempt(1)
+	if _, err := db.RegisterPaymentAttempt(paymentHash, attempt); err != nil {
+		t.Fatalf("unable to register attempt: %v", err)
+	}
+	_, err = db.FailPaymentAttempt(
+		paymentHash, attempt.AttemptID, 1,
+		lnwire.CodeUnknownPaymentHash,
+	)
+	if err != nil {
+		t.Fatalf("unable to fail attempt: %v", err)
+	}
+
+	history, err := db.FailPaymentHistory(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to fail payment: %v", err)
+	}
+	if !history.Terminal() || history.Status != StatusFailed {
+		t.Fatalf("expected status %v, got %v", StatusFailed,
+			history.Status)
+	}
+
+	// Reattempting the payment should move it back in flight, while
+	// keeping the attempts made so far.
+	history, err = db.InitPaymentHistory(paymentHash, [33]byte{}, amt)
+	if err != nil {
+		t.Fatalf("unable to reinit payment history: %v", err)
+	}
+	if history.Status != StatusInFlight {
+		t.Fatalf("expected status %v, got %v", StatusInFlight,
+			history.Status)
+	}
+	if len(history.Attempts) != 1 {
+		t.Fatalf("expected 1 attempt, got %v", len(history.Attempts))
+	}
+
+	histories, err := db.FetchAllPaymentHistories()
+	if err != nil {
+		t.Fatalf("unable to fetch payment histories: %v", err)
+	}
+	if len(histories) != 1 || histories[0].PaymentHash != paymentHash {
+		t.Fatalf("unexpected payment histories: %v",
+			spew.Sdump(histories))
+	}
+}
+
+// TestPaymentHistoryCompleted asserts that a payment found to be paid already
+// can be marked as completed, after which it can't be reattempted.
+func TestPaymentHistoryCompleted(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	paymentHash := makeFakePaymentHash()
+	amt := lnwire.NewMSatFromSatoshis(9000)
+
+	_, err = db.InitPaymentHistory(paymentHash, [33]byte{}, amt)
+	if err != nil {
+		t.Fatalf("unable to init payment history: %v", err)
+	}
+
+	history, err := db.CompletePaymentHistory(paymentHash)
+	if err != nil {
+		t.Fatalf("unable to complete payment: %v", err)
+	}
+	if !history.Terminal() || history.Status != StatusCompleted {
+		t.Fatalf("expected status %v, got %v", StatusCompleted,
+			history.Status)
+	}
+
+	_, err = db.InitPaymentHistory(paymentHash, [33]byte{}, amt)
+	if err != ErrPaymentHistoryCompleted {
+		t.Fatalf("expected ErrPaymentHistoryCompleted, got %v", err)
+	}
+}
@@ -40,6 +40,11 @@ const (
 	// StatusCompleted is the status where a payment has been initiated and
 	// the payment was completed successfully.
 	StatusCompleted PaymentStatus = 2
+
+	// StatusFailed is the status where a payment has been initiated, but
+	// was abandoned after all attempts to complete it failed. This status
+	// is only used to track the lifecycle of a payment within its history.
+	StatusFailed PaymentStatus = 3
 )
 
 // Bytes returns status as slice of bytes.
@@ -54,7 +59,7 @@ func (ps *PaymentStatus) FromBytes(status []byte) error {
 	}
 
 	switch PaymentStatus(status[0]) {
-	case StatusGrounded, StatusInFlight, StatusCompleted:
+	case StatusGrounded, StatusInFlight, StatusCompleted, StatusFailed:
 		*ps = PaymentStatus(status[0])
 	default:
 		return errors.New("unknown payment status")
@@ -72,6 +77,8 @@ func (ps PaymentStatus) String() string {
 		return "In Flight"
 	case StatusCompleted:
 		return "Completed"
+	case StatusFailed:
+		return "Failed"
 	default:
 		return "Unknown"
 	}
@@ -0,0 +1,309 @@
+package channeldb
+
+import (
+	"bytes"
+	"io"
+	"net"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/coreos/bbolt"
+)
+
+var (
+	// peerAddrBookBucket is the name of the top-level bucket that stores
+	// the outcome of our connection attempts to the addresses of each
+	// peer, along with the backoff used to reconnect to the peer. Within
+	// this bucket, each peer is stored within its own sub-bucket keyed by
+	// its compressed public key:
+	//
+	// peer-addr-book
+	//    |
+	//    |-- <pubkey>
+	//    |      |-- peer-backoff-key: <backoff>
+	//    |      |-- peer-addrs
+	//    |             |-- <serialized addr>: <addr stats>
+	//    |             |-- ...
+	//    |
+	//    |-- <pubkey>
+	//    ...
+	peerAddrBookBucket = []byte("peer-addr-book")
+
+	// peerBackoffKey is the key under which the backoff used to reconnect
+	// to a peer is stored within its address book bucket.
+	peerBackoffKey = []byte("peer-backoff-key")
+
+	// peerAddrsBucket is the name of the sub-bucket of a peer's address
+	// book bucket that stores the statistics of each of its addresses,
+	// keyed by the serialized address.
+	peerAddrsBucket = []byte("peer-addrs")
+)
+
+// PeerAddrStats records the outcome of the connection attempts made to a
+// single address of a peer.
+type PeerAddrStats struct {
+	// Address is the address of the peer.
+	Address net.Addr
+
+	// Successes is the number of connection attempts to the address that
+	// succeeded.
+	Successes uint32
+
+	// Failures is the number of connection attempts to the address that
+	// failed.
+	Failures uint32
+
+	// Latency is the average time it took the successful connection
+	// attempts to the address to complete, including the handshake.
+	Latency time.Duration
+
+	// LastSeen is the time at which a connection attempt to the address
+	// last succeeded. This is the zero time if none ever did.
+	LastSeen time.Time
+
+	// LastAttempt is the time at which a connection to the address was
+	// last attempted.
+	LastAttempt time.Time
+}
+
+// PeerAddrBook is the set of addresses known for a peer, along with the
+// backoff used to reconnect to it.
+type PeerAddrBook struct {
+	// PubKey is the compressed public key of the peer.
+	PubKey [33]byte
+
+	// Backoff is the backoff used to reconnect to the peer the last time
+	// its connection was lost. A zero backoff means none was recorded.
+	Backoff time.Duration
+
+	// Addrs holds the statistics of each address we've attempted to
+	// connect to the peer at.
+	Addrs []*PeerAddrStats
+}
+
+// RecordPeerAddrSuccess records that a connection attempt to the address of
+// the peer succeeded after the passed latency.
+func (db *DB) RecordPeerAddrSuccess(pub *btcec.PublicKey, addr net.Addr,
+	latency time.Duration) error {
+
+	return db.updatePeerAddr(pub, addr, func(stats *PeerAddrStats) {
+		// Fold the latency of this attempt into the running average
+		// of the successful attempts.
+		stats.Successes++
+		stats.Latency += (latency - stats.Latency) /
+			time.Duration(stats.Successes)
+		stats.LastSeen = stats.LastAttempt
+	})
+}
+
+// RecordPeerAddrFailure records that a connection attempt to the address of
+// the peer failed.
+func (db *DB) RecordPeerAddrFailure(pub *btcec.PublicKey,
+	addr net.Addr) error {
+
+	return db.updatePeerAddr(pub, addr, func(stats *PeerAddrStats) {
+		stats.Failures++
+	})
+}
+
+// updatePeerAddr applies the update to the statistics of the address of the
+// peer, stamping the time of the attempt.
+func (db *DB) updatePeerAddr(pub *btcec.PublicKey, addr net.Addr,
+	update func(*PeerAddrStats)) error {
+
+	var addrKey bytes.Buffer
+	if err := serializeAddr(&addrKey, addr); err != nil {
+		return err
+	}
+
+	return db.Batch(func(tx *bolt.Tx) error {
+		peerBook, err := createPeerAddrBookBucket(tx, pub)
+		if err != nil {
+			return err
+		}
+
+		addrs, err := peerBook.CreateBucketIfNotExists(peerAddrsBucket)
+		if err != nil {
+			return err
+		}
+
+		stats := &PeerAddrStats{
+			Address: addr,
+		}
+		if v := addrs.Get(addrKey.Bytes()); v != nil {
+			r := bytes.NewReader(v)
+			err := deserializePeerAddrStats(r, stats)
+			if err != nil {
+				return err
+			}
+		}
+
+		stats.LastAttempt = time.Now()
+		update(stats)
+
+		var b bytes.Buffer
+		if err := serializePeerAddrStats(&b, stats); err != nil {
+			return err
+		}
+
+		return addrs.Put(addrKey.Bytes(), b.Bytes())
+	})
+}
+
+// PutPeerBackoff records the backoff used to reconnect to the peer, such that
+// it's carried over across restarts. A zero backoff clears the recorded one.
+func (db *DB) PutPeerBackoff(pub *btcec.PublicKey,
+	backoff time.Duration) error {
+
+	return db.Batch(func(tx *bolt.Tx) error {
+		peerBook, err := createPeerAddrBookBucket(tx, pub)
+		if err != nil {
+			return err
+		}
+
+		if backoff == 0 {
+			return peerBook.Delete(peerBackoffKey)
+		}
+
+		var b [8]byte
+		byteOrder.PutUint64(b[:], uint64(backoff))
+		return peerBook.Put(peerBackoffKey, b[:])
+	})
+}
+
+// FetchPeerAddrBook returns the address book of the peer. If the peer is
+// unknown, an empty address book is returned.
+func (db *DB) FetchPeerAddrBook(pub *btcec.PublicKey) (*PeerAddrBook,
+	error) {
+
+	book := &PeerAddrBook{}
+	copy(book.PubKey[:], pub.SerializeCompressed())
+
+	err := db.View(func(tx *bolt.Tx) error {
+		books := tx.Bucket(peerAddrBookBucket)
+		if books == nil {
+			return nil
+		}
+
+		peerBook := books.Bucket(book.PubKey[:])
+		if peerBook == nil {
+			return nil
+		}
+
+		return fetchPeerAddrBook(peerBook, book)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return book, nil
+}
+
+// FetchAllPeerAddrBooks returns the address book of every peer known to the
+// database.
+func (db *DB) FetchAllPeerAddrBooks() ([]*PeerAddrBook, error) {
+	var books []*PeerAddrBook
+	err := db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(peerAddrBookBucket)
+		if bucket == nil {
+			return nil
+		}
+
+		return bucket.ForEach(func(k, v []byte) error {
+			// Each peer is stored within its own sub-bucket, so
+			// we'll skip anything else.
+			if v != nil || len(k) != 33 {
+				return nil
+			}
+
+			book := &PeerAddrBook{}
+			copy(book.PubKey[:], k)
+
+			err := fetchPeerAddrBook(bucket.Bucket(k), book)
+			if err != nil {
+				return err
+			}
+
+			books = append(books, book)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return books, nil
+}
+
+// createPeerAddrBookBucket returns the address book bucket of the peer,
+// creating it if it doesn't exist yet.
+func createPeerAddrBookBucket(tx *bolt.Tx,
+	pub *btcec.PublicKey) (*bolt.Bucket, error) {
+
+	books, err := tx.CreateBucketIfNotExists(peerAddrBookBucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return books.CreateBucketIfNotExists(pub.SerializeCompressed())
+}
+
+// fetchPeerAddrBook reads the backoff and address statistics of a peer from
+// its address book bucket into the passed address book.
+func fetchPeerAddrBook(peerBook *bolt.Bucket, book *PeerAddrBook) error {
+	if v := peerBook.Get(peerBackoffKey); v != nil {
+		book.Backoff = time.Duration(byteOrder.Uint64(v))
+	}
+
+	addrs := peerBook.Bucket(peerAddrsBucket)
+	if addrs == nil {
+		return nil
+	}
+
+	return addrs.ForEach(func(k, v []byte) error {
+		addr, err := deserializeAddr(bytes.NewReader(k))
+		if err != nil {
+			return err
+		}
+
+		stats := &PeerAddrStats{
+			Address: addr,
+		}
+		err = deserializePeerAddrStats(bytes.NewReader(v), stats)
+		if err != nil {
+			return err
+		}
+
+		book.Addrs = append(book.Addrs, stats)
+		return nil
+	})
+}
+
+func serializePeerAddrStats(w io.Writer, stats *PeerAddrStats) error {
+	err := WriteElements(
+		w, stats.Successes, stats.Failures, uint64(stats.Latency),
+	)
+	if err != nil {
+		return err
+	}
+	if err := serializeTime(w, stats.LastSeen); err != nil {
+		return err
+	}
+
+	return serializeTime(w, stats.LastAttempt)
+}
+
+func deserializePeerAddrStats(r io.Reader, stats *PeerAddrStats) error {
+	var latency uint64
+	err := ReadElements(r, &stats.Successes, &stats.Failures, &latency)
+	if err != nil {
+		return err
+	}
+	stats.Latency = time.Duration(latency)
+
+	if err := deserializeTime(r, &stats.LastSeen); err != nil {
+		return err
+	}
+
+	return deserializeTime(r, &stats.LastAttempt)
+}
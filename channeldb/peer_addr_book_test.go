@@ -0,0 +1,129 @@
+package channeldb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+)
+
+// TestPeerAddrBook asserts that the outcome of connection attempts to each
+// address of a peer, along with its reconnection backoff, is recorded within
+// the address book of the peer.
+func TestPeerAddrBook(t *testing.T) {
+	t.Parallel()
+
+	db, cleanUp, err := makeTestDB()
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to make test db: %v", err)
+	}
+
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+	pub := priv.PubKey()
+
+	// Peers which have never been connected to should have an empty
+	// address book.
+	book, err := db.FetchPeerAddrBook(pub)
+	if err != nil {
+		t.Fatalf("unable to fetch address book: %v", err)
+	}
+	if len(book.Addrs) != 0 || book.Backoff != 0 {
+		t.Fatalf("expected empty address book, got %v", book)
+	}
+
+	// Record two successful attempts and a failed one to the first
+	// address, and a failed attempt to the second one.
+	err = db.RecordPeerAddrSuccess(pub, testAddr, 100*time.Millisecond)
+	if err != nil {
+		t.Fatalf("unable to record success: %v", err)
+	}
+	err = db.RecordPeerAddrSuccess(pub, testAddr, 300*time.Millisecond)
+	if err != nil {
+		t.Fatalf("unable to record success: %v", err)
+	}
+	if err := db.RecordPeerAddrFailure(pub, testAddr); err != nil {
+		t.Fatalf("unable to record failure: %v", err)
+	}
+	if err := db.RecordPeerAddrFailure(pub, anotherAddr); err != nil {
+		t.Fatalf("unable to record failure: %v", err)
+	}
+
+	if err := db.PutPeerBackoff(pub, time.Minute); err != nil {
+		t.Fatalf("unable to put backoff: %v", err)
+	}
+
+	book, err = db.FetchPeerAddrBook(pub)
+	if err != nil {
+		t.Fatalf("unable to fetch address book: %v", err)
+	}
+	if book.Backoff != time.Minute {
+		t.Fatalf("expected backoff of %v, got %v", time.Minute,
+			book.Backoff)
+	}
+	if len(book.Addrs) != 2 {
+		t.Fatalf("expected 2 addresses, got %v", len(book.Addrs))
+	}
+
+	for _, stats := range book.Addrs {
+		switch stats.Address.String() {
+		case testAddr.String():
+			if stats.Successes != 2 || stats.Failures != 1 {
+				t.Fatalf("expected 2 successes and 1 failure, "+
+					"got %v and %v", stats.Successes,
+					stats.Failures)
+			}
+			if stats.Latency != 200*time.Millisecond {
+				t.Fatalf("expected average latency of 200ms, "+
+					"got %v", stats.Latency)
+			}
+			if stats.LastSeen.IsZero() ||
+				stats.LastAttempt.Before(stats.LastSeen) {
+
+				t.Fatalf("unexpected last seen %v and last "+
+					"attempt %v", stats.LastSeen,
+					stats.LastAttempt)
+			}
+
+		case anotherAddr.String():
+			if stats.Successes != 0 || stats.Failures != 1 {
+				t.Fatalf("expected 0 successes and 1 failure, "+
+					"got %v and %v", stats.Successes,
+					stats.Failures)
+			}
+			if !stats.LastSeen.IsZero() {
+				t.Fatalf("expected address to never be seen")
+			}
+
+		default:
+			t.Fatalf("unexpected address %v", stats.Address)
+		}
+	}
+
+	// The address book should also be returned when fetching those of all
+	// peers.
+	books, err := db.FetchAllPeerAddrBooks()
+	if err != nil {
+		t.Fatalf("unable to fetch address books: %v", err)
+	}
+	if len(books) != 1 || books[0].PubKey != book.PubKey {
+		t.Fatalf("expected address book of peer to be returned")
+	}
+
+	// Finally, clearing the backoff should remove it from the address
+	// book, while leaving the addresses untouched.
+	if err := db.PutPeerBackoff(pub, 0); err != nil {
+		t.Fatalf("unable to clear backoff: %v", err)
+	}
+	book, err = db.FetchPeerAddrBook(pub)
+	if err != nil {
+		t.Fatalf("unable to fetch address book: %v", err)
+	}
+	if book.Backoff != 0 || len(book.Addrs) != 2 {
+		t.Fatalf("expected cleared backoff and 2 addresses, got %v "+
+			"and %v", book.Backoff, len(book.Addrs))
+	}
+}
@@ -15,16 +15,21 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/golang/protobuf/jsonpb"
 	"github.com/golang/protobuf/proto"
+	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/urfave/cli"
 	"golang.org/x/crypto/ssh/terminal"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	macaroon "gopkg.in/macaroon.v2"
 )
 
 // TODO(roasbeef): cli logic for supporting both positional and unix style
@@ -143,6 +148,85 @@ func newAddress(ctx *cli.Context) error {
 	return nil
 }
 
+// coinSelectionFlags are the flags of the commands that fund a transaction
+// from the outputs of the wallet, allowing the user to control which of the
+// outputs are spent.
+var coinSelectionFlags = []cli.Flag{
+	cli.StringSliceFlag{
+		Name: "utxos",
+		Usage: "(optional) an outpoint (txid:index) of a wallet " +
+			"output the transaction should be funded from, can " +
+			"be specified multiple times",
+	},
+	cli.StringFlag{
+		Name: "lock_id",
+		Usage: "(optional) the hex encoded 32 byte id under which " +
+			"any of the outputs passed via --utxos are leased, " +
+			"allowing them to be spent",
+	},
+	cli.StringFlag{
+		Name: "coin_selection_strategy",
+		Usage: "(optional) the strategy used to select the " +
+			"outputs the transaction is funded from, one of: " +
+			"default, largest, random or bnb",
+		Value: "default",
+	},
+}
+
+// parseOutPoint parses an outpoint of the form txid:index.
+func parseOutPoint(s string) (*lnrpc.OutPoint, error) {
+	split := strings.Split(s, ":")
+	if len(split) != 2 {
+		return nil, fmt.Errorf("expecting outpoint to be in format " +
+			"of: txid:index")
+	}
+
+	index, err := strconv.ParseUint(split[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode output index: %v",
+			err)
+	}
+
+	return &lnrpc.OutPoint{
+		TxidStr:     split[0],
+		OutputIndex: uint32(index),
+	}, nil
+}
+
+// parseCoinSelection parses the outpoints, the lock id they're leased under
+// and the coin selection strategy passed through the coinSelectionFlags.
+func parseCoinSelection(ctx *cli.Context) ([]*lnrpc.OutPoint, []byte,
+	lnrpc.CoinSelectionStrategy, error) {
+
+	var outpoints []*lnrpc.OutPoint
+	for _, utxo := range ctx.StringSlice("utxos") {
+		outpoint, err := parseOutPoint(utxo)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		outpoints = append(outpoints, outpoint)
+	}
+
+	var lockID []byte
+	if ctx.IsSet("lock_id") {
+		var err error
+		lockID, err = hex.DecodeString(ctx.String("lock_id"))
+		if err != nil {
+			return nil, nil, 0, fmt.Errorf("unable to decode lock "+
+				"id: %v", err)
+		}
+	}
+
+	strategyName := strings.ToUpper(ctx.String("coin_selection_strategy"))
+	strategy, ok := lnrpc.CoinSelectionStrategy_value[strategyName]
+	if !ok {
+		return nil, nil, 0, fmt.Errorf("unknown coin selection "+
+			"strategy: %v", ctx.String("coin_selection_strategy"))
+	}
+
+	return outpoints, lockID, lnrpc.CoinSelectionStrategy(strategy), nil
+}
+
 var sendCoinsCommand = cli.Command{
 	Name:      "sendcoins",
 	Category:  "On-chain",
@@ -154,9 +238,14 @@ var sendCoinsCommand = cli.Command{
 	Fees used when sending the transaction can be specified via the --conf_target, or
 	--sat_per_byte optional flags.
 
+	The outputs of the wallet the transaction is funded from can be chosen
+	via the --utxos flag, and the way inputs are selected among them via the
+	--coin_selection_strategy flag. Outputs leased via leaseoutput are only
+	spent if they're passed via --utxos, along with their --lock_id.
+
 	Positional arguments and flags can be used interchangeably but not at the same time!
 	`,
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		cli.StringFlag{
 			Name:  "addr",
 			Usage: "the BASE58 encoded bitcoin address to send coins to on-chain",
@@ -178,7 +267,7 @@ var sendCoinsCommand = cli.Command{
 				"sat/byte that should be used when crafting " +
 				"the transaction",
 		},
-	},
+	}, coinSelectionFlags...),
 	Action: actionDecorator(sendCoins),
 }
 
@@ -223,15 +312,23 @@ func sendCoins(ctx *cli.Context) error {
 		return fmt.Errorf("unable to decode amount: %v", err)
 	}
 
+	outpoints, lockID, strategy, err := parseCoinSelection(ctx)
+	if err != nil {
+		return err
+	}
+
 	ctxb := context.Background()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
 	req := &lnrpc.SendCoinsRequest{
-		Addr:       addr,
-		Amount:     amt,
-		TargetConf: int32(ctx.Int64("conf_target")),
-		SatPerByte: ctx.Int64("sat_per_byte"),
+		Addr:                  addr,
+		Amount:                amt,
+		TargetConf:            int32(ctx.Int64("conf_target")),
+		SatPerByte:            ctx.Int64("sat_per_byte"),
+		Outpoints:             outpoints,
+		LockId:                lockID,
+		CoinSelectionStrategy: strategy,
 	}
 	txid, err := client.SendCoins(ctxb, req)
 	if err != nil {
@@ -242,6 +339,225 @@ func sendCoins(ctx *cli.Context) error {
 	return nil
 }
 
+var estimateFeeCommand = cli.Command{
+	Name:      "estimatefee",
+	Category:  "On-chain",
+	Usage:     "Estimate on-chain fee rates for confirmation targets.",
+	ArgsUsage: "[conf_target...]",
+	Description: `
+	Returns the fee rates the wallet currently estimates for each of the
+	passed confirmation targets, expressed in blocks. These are the fee
+	rates used when opening channels and updating commitment fees.
+
+	If no confirmation targets are passed, a default set of targets is
+	used.
+	`,
+	Action: actionDecorator(estimateFee),
+}
+
+func estimateFee(ctx *cli.Context) error {
+	var targetConfs []uint32
+	for _, arg := range ctx.Args() {
+		targetConf, err := strconv.ParseUint(arg, 10, 32)
+		if err != nil {
+			return fmt.Errorf("unable to decode conf_target: %v",
+				err)
+		}
+		targetConfs = append(targetConfs, uint32(targetConf))
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.EstimateFee(ctxb, &lnrpc.EstimateFeeRequest{
+		TargetConfs: targetConfs,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var leaseOutputCommand = cli.Command{
+	Name:      "leaseoutput",
+	Category:  "On-chain",
+	Usage:     "Lease a wallet output, excluding it from coin selection.",
+	ArgsUsage: "id outpoint [expiration]",
+	Description: `
+	Lease the wallet output specified as txid:index under the hex encoded
+	32 byte lock id, excluding it from coin selection until the lease is
+	released or expires after expiration seconds. Leasing an output that is
+	already leased under the same id extends the lease.
+
+	If expiration isn't set, the lease expires after 10 minutes.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "id",
+			Usage: "the hex encoded 32 byte id to lease the " +
+				"output under",
+		},
+		cli.StringFlag{
+			Name: "outpoint",
+			Usage: "the outpoint (txid:index) of the output to " +
+				"lease",
+		},
+		cli.Uint64Flag{
+			Name: "expiration",
+			Usage: "(optional) the duration of the lease in " +
+				"seconds",
+		},
+	},
+	Action: actionDecorator(leaseOutput),
+}
+
+// parseLeaseArgs parses the lock id and the outpoint shared by the lease
+// related commands, returning the remaining positional arguments.
+func parseLeaseArgs(ctx *cli.Context) ([]byte, *lnrpc.OutPoint,
+	cli.Args, error) {
+
+	args := ctx.Args()
+
+	var idStr string
+	switch {
+	case ctx.IsSet("id"):
+		idStr = ctx.String("id")
+	case args.Present():
+		idStr = args.First()
+		args = args.Tail()
+	default:
+		return nil, nil, nil, fmt.Errorf("id argument missing")
+	}
+
+	id, err := hex.DecodeString(idStr)
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("unable to decode id: %v",
+			err)
+	}
+
+	var outpointStr string
+	switch {
+	case ctx.IsSet("outpoint"):
+		outpointStr = ctx.String("outpoint")
+	case args.Present():
+		outpointStr = args.First()
+		args = args.Tail()
+	default:
+		return nil, nil, nil, fmt.Errorf("outpoint argument missing")
+	}
+
+	outpoint, err := parseOutPoint(outpointStr)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return id, outpoint, args, nil
+}
+
+func leaseOutput(ctx *cli.Context) error {
+	id, outpoint, args, err := parseLeaseArgs(ctx)
+	if err != nil {
+		return err
+	}
+
+	var expiration uint64
+	switch {
+	case ctx.IsSet("expiration"):
+		expiration = ctx.Uint64("expiration")
+	case args.Present():
+		expiration, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode expiration: %v",
+				err)
+		}
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.LeaseOutput(ctxb, &lnrpc.LeaseOutputRequest{
+		Id:                id,
+		Outpoint:          outpoint,
+		ExpirationSeconds: expiration,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var releaseOutputCommand = cli.Command{
+	Name:      "releaseoutput",
+	Category:  "On-chain",
+	Usage:     "Release the lease on a wallet output.",
+	ArgsUsage: "id outpoint",
+	Description: `
+	Release the lease on the wallet output specified as txid:index, making
+	it eligible for coin selection once again. The hex encoded 32 byte lock
+	id must match the one the output was leased under.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "id",
+			Usage: "the hex encoded 32 byte id the output is " +
+				"leased under",
+		},
+		cli.StringFlag{
+			Name: "outpoint",
+			Usage: "the outpoint (txid:index) of the output to " +
+				"release",
+		},
+	},
+	Action: actionDecorator(releaseOutput),
+}
+
+func releaseOutput(ctx *cli.Context) error {
+	id, outpoint, _, err := parseLeaseArgs(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ReleaseOutput(ctxb, &lnrpc.ReleaseOutputRequest{
+		Id:       id,
+		Outpoint: outpoint,
+	})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var listLeasesCommand = cli.Command{
+	Name:     "listleases",
+	Category: "On-chain",
+	Usage:    "List all currently leased wallet outputs.",
+	Action:   actionDecorator(listLeases),
+}
+
+func listLeases(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	resp, err := client.ListLeases(ctxb, &lnrpc.ListLeasesRequest{})
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var sendManyCommand = cli.Command{
 	Name:      "sendmany",
 	Category:  "On-chain",
@@ -254,8 +570,13 @@ var sendManyCommand = cli.Command{
 	respectively in the following format:
 
 	    '{"ExampleAddr": NumCoinsInSatoshis, "SecondAddr": NumCoins}'
+
+	The outputs of the wallet the transaction is funded from can be chosen
+	via the --utxos flag, and the way inputs are selected among them via the
+	--coin_selection_strategy flag. Outputs leased via leaseoutput are only
+	spent if they're passed via --utxos, along with their --lock_id.
 	`,
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		cli.Int64Flag{
 			Name: "conf_target",
 			Usage: "(optional) the number of blocks that the transaction *should* " +
@@ -266,7 +587,7 @@ var sendManyCommand = cli.Command{
 			Usage: "(optional) a manual fee expressed in sat/byte that should be " +
 				"used when crafting the transaction",
 		},
-	},
+	}, coinSelectionFlags...),
 	Action: actionDecorator(sendMany),
 }
 
@@ -283,14 +604,22 @@ func sendMany(ctx *cli.Context) error {
 			"set, but not both")
 	}
 
+	outpoints, lockID, strategy, err := parseCoinSelection(ctx)
+	if err != nil {
+		return err
+	}
+
 	ctxb := context.Background()
 	client, cleanUp := getClient(ctx)
 	defer cleanUp()
 
 	txid, err := client.SendMany(ctxb, &lnrpc.SendManyRequest{
-		AddrToAmount: amountToAddr,
-		TargetConf:   int32(ctx.Int64("conf_target")),
-		SatPerByte:   ctx.Int64("sat_per_byte"),
+		AddrToAmount:          amountToAddr,
+		TargetConf:            int32(ctx.Int64("conf_target")),
+		SatPerByte:            ctx.Int64("sat_per_byte"),
+		Outpoints:             outpoints,
+		LockId:                lockID,
+		CoinSelectionStrategy: strategy,
 	})
 	if err != nil {
 		return err
@@ -408,9 +737,15 @@ var openChannelCommand = cli.Command{
 	a channelPoint (txid:vout) of the funding output is returned.
 
 	One can manually set the fee to be used for the funding transaction via either
-	the --conf_target or --sat_per_byte arguments. This is optional.`,
+	the --conf_target or --sat_per_byte arguments. This is optional.
+
+	The outputs of the wallet the funding transaction is funded from can be
+	chosen via the --utxos flag, and the way inputs are selected among them
+	via the --coin_selection_strategy flag. This is optional. Outputs leased
+	via leaseoutput are only spent if they're passed via --utxos, along with
+	their --lock_id.`,
 	ArgsUsage: "node-key local-amt push-amt",
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		cli.StringFlag{
 			Name: "node_key",
 			Usage: "the identity public key of the target node/peer " +
@@ -476,7 +811,7 @@ var openChannelCommand = cli.Command{
 				"transaction must satisfy",
 			Value: 1,
 		},
-	},
+	}, coinSelectionFlags...),
 	Action: actionDecorator(openChannel),
 }
 
@@ -495,12 +830,20 @@ func openChannel(ctx *cli.Context) error {
 		return nil
 	}
 
+	outpoints, lockID, strategy, err := parseCoinSelection(ctx)
+	if err != nil {
+		return err
+	}
+
 	req := &lnrpc.OpenChannelRequest{
-		TargetConf:     int32(ctx.Int64("conf_target")),
-		SatPerByte:     ctx.Int64("sat_per_byte"),
-		MinHtlcMsat:    ctx.Int64("min_htlc_msat"),
-		RemoteCsvDelay: uint32(ctx.Uint64("remote_csv_delay")),
-		MinConfs:       int32(ctx.Uint64("min_confs")),
+		TargetConf:            int32(ctx.Int64("conf_target")),
+		SatPerByte:            ctx.Int64("sat_per_byte"),
+		MinHtlcMsat:           ctx.Int64("min_htlc_msat"),
+		RemoteCsvDelay:        uint32(ctx.Uint64("remote_csv_delay")),
+		MinConfs:              int32(ctx.Uint64("min_confs")),
+		Outpoints:             outpoints,
+		LockId:                lockID,
+		CoinSelectionStrategy: strategy,
 	}
 
 	switch {
@@ -1051,6 +1394,50 @@ func listPeers(ctx *cli.Context) error {
 	return nil
 }
 
+var listPeerAddressesCommand = cli.Command{
+	Name:      "listpeeraddresses",
+	Category:  "Peers",
+	Usage:     "List the address book of our persistent peers.",
+	ArgsUsage: "[pub_key]",
+	Description: `
+	List the outcome of the connection attempts made to each address of the
+	persistent peers we've attempted to connect to, along with the score
+	used to pick the address to reconnect to a peer at, and the backoff
+	used to do so.
+
+	If a public key is given, only the address book of that peer is listed.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "pub_key",
+			Usage: "(optional) the 33-byte hex-encoded " +
+				"compressed public key of the peer",
+		},
+	},
+	Action: actionDecorator(listPeerAddresses),
+}
+
+func listPeerAddresses(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListPeerAddressesRequest{}
+	switch {
+	case ctx.IsSet("pub_key"):
+		req.PubKey = ctx.String("pub_key")
+	case ctx.Args().Present():
+		req.PubKey = ctx.Args().First()
+	}
+
+	resp, err := client.ListPeerAddresses(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
 var createCommand = cli.Command{
 	Name:     "create",
 	Category: "Startup",
@@ -1451,69 +1838,199 @@ func changePassword(ctx *cli.Context) error {
 	return nil
 }
 
-var walletBalanceCommand = cli.Command{
-	Name:     "walletbalance",
-	Category: "Wallet",
-	Usage:    "Compute and display the wallet's current balance.",
-	Action:   actionDecorator(walletBalance),
+var changeSeedPassphraseCommand = cli.Command{
+	Name:     "changeseedpassphrase",
+	Category: "Startup",
+	Usage:    "Change the passphrase of a cipher seed mnemonic.",
+	Description: `
+	The changeseedpassphrase command re-enciphers an existing 24-word cipher
+	seed mnemonic under a new passphrase. The seed itself is unchanged, so
+	the new mnemonic restores the same wallet, while the old passphrase no
+	longer deciphers it.
+
+	This command can be used while lnd is waiting for its wallet to be
+	created or unlocked. Make sure to write down the new mnemonic, as the
+	old mnemonic remains valid under the old passphrase.
+	`,
+	Action: actionDecorator(changeSeedPassphrase),
 }
 
-func walletBalance(ctx *cli.Context) error {
+func changeSeedPassphrase(ctx *cli.Context) error {
 	ctxb := context.Background()
-	client, cleanUp := getClient(ctx)
+	client, cleanUp := getWalletUnlockerClient(ctx)
 	defer cleanUp()
 
-	req := &lnrpc.WalletBalanceRequest{}
-	resp, err := client.WalletBalance(ctxb, req)
+	fmt.Printf("Input your 24-word mnemonic separated by spaces: ")
+	reader := bufio.NewReader(os.Stdin)
+	mnemonic, err := reader.ReadString('\n')
 	if err != nil {
 		return err
 	}
+	fmt.Println()
 
-	printRespJSON(resp)
-	return nil
-}
-
-var channelBalanceCommand = cli.Command{
-	Name:     "channelbalance",
-	Category: "Channels",
-	Usage: "Returns the sum of the total available channel balance across " +
-		"all open channels.",
-	Action: actionDecorator(channelBalance),
-}
-
-func channelBalance(ctx *cli.Context) error {
-	ctxb := context.Background()
-	client, cleanUp := getClient(ctx)
-	defer cleanUp()
+	mnemonic = strings.ToLower(strings.TrimSpace(mnemonic))
+	cipherSeedMnemonic := strings.Split(mnemonic, " ")
+	if len(cipherSeedMnemonic) != 24 {
+		return fmt.Errorf("wrong cipher seed mnemonic length: got %v "+
+			"words, expecting %v words", len(cipherSeedMnemonic),
+			24)
+	}
 
-	req := &lnrpc.ChannelBalanceRequest{}
-	resp, err := client.ChannelBalance(ctxb, req)
+	fmt.Printf("Input your current cipher seed passphrase (press enter " +
+		"if your seed doesn't have a passphrase): ")
+	currentPass, err := terminal.ReadPassword(int(syscall.Stdin))
 	if err != nil {
 		return err
 	}
+	fmt.Println()
 
-	printRespJSON(resp)
-	return nil
-}
-
-var getInfoCommand = cli.Command{
-	Name:   "getinfo",
-	Usage:  "Returns basic information related to the active daemon.",
-	Action: actionDecorator(getInfo),
-}
-
-func getInfo(ctx *cli.Context) error {
-	ctxb := context.Background()
-	client, cleanUp := getClient(ctx)
-	defer cleanUp()
-
-	req := &lnrpc.GetInfoRequest{}
-	resp, err := client.GetInfo(ctxb, req)
+	fmt.Printf("Input the new cipher seed passphrase (press enter to " +
+		"remove the passphrase): ")
+	newPass, err := terminal.ReadPassword(int(syscall.Stdin))
 	if err != nil {
 		return err
 	}
+	fmt.Println()
 
-	printRespJSON(resp)
+	fmt.Printf("Confirm the new cipher seed passphrase: ")
+	confirmPass, err := terminal.ReadPassword(int(syscall.Stdin))
+	if err != nil {
+		return err
+	}
+	fmt.Println()
+
+	if !bytes.Equal(newPass, confirmPass) {
+		return fmt.Errorf("passphrases don't match")
+	}
+
+	req := &lnrpc.ChangeSeedPassphraseRequest{
+		CipherSeedMnemonic:      cipherSeedMnemonic,
+		CurrentAezeedPassphrase: currentPass,
+		NewAezeedPassphrase:     newPass,
+	}
+	resp, err := client.ChangeSeedPassphrase(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("\n!!!YOU MUST WRITE DOWN THIS SEED TO BE ABLE TO " +
+		"RESTORE THE WALLET WITH THE NEW PASSPHRASE!!!\n")
+
+	fmt.Println("---------------BEGIN LND CIPHER SEED---------------")
+
+	numCols := 4
+	colWords := monowidthColumns(resp.CipherSeedMnemonic, numCols)
+	for i := 0; i < len(colWords); i += numCols {
+		fmt.Printf("%2d. %3s  %2d. %3s  %2d. %3s  %2d. %3s\n",
+			i+1, colWords[i], i+2, colWords[i+1], i+3,
+			colWords[i+2], i+4, colWords[i+3])
+	}
+
+	fmt.Println("---------------END LND CIPHER SEED-----------------")
+
+	return nil
+}
+
+var getRecoveryInfoCommand = cli.Command{
+	Name:     "getrecoveryinfo",
+	Category: "Wallet",
+	Usage:    "Track the progress of a wallet recovery rescan.",
+	Description: `
+	Displays the progress of the wallet's rescan for the funds of a
+	restored seed, i.e. when lnd was started with a non-zero recovery
+	window. An update is printed each time the rescan advances, until it
+	has finished.
+	`,
+	Action: actionDecorator(getRecoveryInfo),
+}
+
+func getRecoveryInfo(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	stream, err := client.GetRecoveryInfo(
+		ctxb, &lnrpc.GetRecoveryInfoRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	for {
+		info, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		printRespJSON(info)
+	}
+}
+
+var walletBalanceCommand = cli.Command{
+	Name:     "walletbalance",
+	Category: "Wallet",
+	Usage:    "Compute and display the wallet's current balance.",
+	Action:   actionDecorator(walletBalance),
+}
+
+func walletBalance(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.WalletBalanceRequest{}
+	resp, err := client.WalletBalance(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var channelBalanceCommand = cli.Command{
+	Name:     "channelbalance",
+	Category: "Channels",
+	Usage: "Returns the sum of the total available channel balance across " +
+		"all open channels.",
+	Action: actionDecorator(channelBalance),
+}
+
+func channelBalance(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ChannelBalanceRequest{}
+	resp, err := client.ChannelBalance(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+	return nil
+}
+
+var getInfoCommand = cli.Command{
+	Name:   "getinfo",
+	Usage:  "Returns basic information related to the active daemon.",
+	Action: actionDecorator(getInfo),
+}
+
+func getInfo(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.GetInfoRequest{}
+	resp, err := client.GetInfo(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
 	return nil
 }
 
@@ -2186,6 +2703,21 @@ var addInvoiceCommand = cli.Command{
 				"private channels in order to assist the " +
 				"payer in reaching you",
 		},
+		cli.BoolFlag{
+			Name: "require_payment_secret",
+			Usage: "reject payments that don't include the " +
+				"payment secret of the invoice",
+		},
+		cli.IntFlag{
+			Name: "spider_algo",
+			Usage: "the Spider routing algorithm the payer " +
+				"should use, as defined in routing/router.go",
+		},
+		cli.DurationFlag{
+			Name: "spider_deadline",
+			Usage: "the duration from now after which the payer " +
+				"should stop attempting the payment",
+		},
 	},
 	Action: actionDecorator(addInvoice),
 }
@@ -2245,6 +2777,13 @@ func addInvoice(ctx *cli.Context) error {
 		FallbackAddr:    ctx.String("fallback_addr"),
 		Expiry:          ctx.Int64("expiry"),
 		Private:         ctx.Bool("private"),
+
+		RequirePaymentSecret: ctx.Bool("require_payment_secret"),
+		SpiderAlgo:           int32(ctx.Int("spider_algo")),
+	}
+	if ctx.IsSet("spider_deadline") {
+		deadline := time.Now().Add(ctx.Duration("spider_deadline"))
+		invoice.SpiderDeadline = deadline.Unix()
 	}
 
 	resp, err := client.AddInvoice(context.Background(), invoice)
@@ -2408,6 +2947,128 @@ func describeGraph(ctx *cli.Context) error {
 	return nil
 }
 
+var exportGraphCommand = cli.Command{
+	Name:      "exportgraph",
+	Category:  "Peers",
+	Usage:     "Export a snapshot of the network graph to a file.",
+	ArgsUsage: "file",
+	Description: `
+	Write a snapshot of the channel graph known to the node to the given
+	file, or to stdout if no file is given. The snapshot can be loaded into
+	a fresh channel database later on, in order to study path finding and
+	channel placement against the real topology offline.
+
+	The snapshot is either written as JSON, or in a more compact binary
+	format.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "format",
+			Value: "json",
+			Usage: "the format of the snapshot, either json or " +
+				"binary",
+		},
+	},
+	Action: actionDecorator(exportGraph),
+}
+
+func exportGraph(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var format channeldb.SnapshotFormat
+	switch ctx.String("format") {
+	case "json":
+		format = channeldb.SnapshotFormatJSON
+	case "binary":
+		format = channeldb.SnapshotFormatBinary
+	default:
+		return fmt.Errorf("unknown snapshot format: %v",
+			ctx.String("format"))
+	}
+
+	info, err := client.GetInfo(ctxb, &lnrpc.GetInfoRequest{})
+	if err != nil {
+		return err
+	}
+	graph, err := client.DescribeGraph(ctxb, &lnrpc.ChannelGraphRequest{})
+	if err != nil {
+		return err
+	}
+
+	// Nodes we haven't received an announcement for are reported with the
+	// truncated unix timestamp of the zero time, while the snapshot
+	// denotes them with a zero timestamp.
+	noAnnouncement := uint32(time.Time{}.Unix())
+
+	snapshot := &channeldb.GraphSnapshot{
+		SourceNode: info.IdentityPubkey,
+	}
+	for _, node := range graph.Nodes {
+		snapshotNode := channeldb.SnapshotNode{
+			PubKey: node.PubKey,
+			Alias:  node.Alias,
+			Color:  node.Color,
+		}
+		if node.LastUpdate != noAnnouncement {
+			snapshotNode.LastUpdate = int64(node.LastUpdate)
+		}
+		for _, addr := range node.Addresses {
+			snapshotNode.Addresses = append(
+				snapshotNode.Addresses, addr.Addr,
+			)
+		}
+
+		snapshot.Nodes = append(snapshot.Nodes, snapshotNode)
+	}
+
+	// The graph only carries the time of the latest update of either of
+	// the policies of a channel, so we'll use it for both.
+	snapshotPolicy := func(policy *lnrpc.RoutingPolicy,
+		lastUpdate uint32) *channeldb.SnapshotPolicy {
+
+		if policy == nil {
+			return nil
+		}
+
+		return &channeldb.SnapshotPolicy{
+			TimeLockDelta:    uint16(policy.TimeLockDelta),
+			MinHTLC:          policy.MinHtlc,
+			FeeBaseMSat:      policy.FeeBaseMsat,
+			FeeRateMilliMSat: policy.FeeRateMilliMsat,
+			Disabled:         policy.Disabled,
+			LastUpdate:       int64(lastUpdate),
+		}
+	}
+	for _, edge := range graph.Edges {
+		snapshot.Edges = append(snapshot.Edges, channeldb.SnapshotEdge{
+			ChannelID: edge.ChannelId,
+			ChanPoint: edge.ChanPoint,
+			Node1Pub:  edge.Node1Pub,
+			Node2Pub:  edge.Node2Pub,
+			Capacity:  edge.Capacity,
+			Node1Policy: snapshotPolicy(
+				edge.Node1Policy, edge.LastUpdate,
+			),
+			Node2Policy: snapshotPolicy(
+				edge.Node2Policy, edge.LastUpdate,
+			),
+		})
+	}
+
+	if !ctx.Args().Present() {
+		return snapshot.Encode(os.Stdout, format)
+	}
+
+	f, err := os.Create(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return snapshot.Encode(f, format)
+}
+
 // normalizeFunc is a factory function which returns a function that normalizes
 // the capacity of edges within the graph. The value of the returned
 // function can be used to either plot the capacities, or to use a weight in a
@@ -3202,3 +3863,715 @@ func forwardingHistory(ctx *cli.Context) error {
 	printRespJSON(resp)
 	return nil
 }
+
+var rebalanceCommand = cli.Command{
+	Name:      "rebalance",
+	Category:  "Payments",
+	Usage:     "Move funds between two of our channels.",
+	ArgsUsage: "outgoing_chan_id incoming_chan_id amt",
+	Description: `
+	Move funds from one of our channels to another by sending a circular
+	payment to ourselves. The payment leaves through the channel specified
+	by --outgoing_chan_id, and returns through the channel specified by
+	--incoming_chan_id, shifting local balance from the former to the
+	latter.
+
+	The maximum fee paid for the rebalance can be limited using either the
+	--fee_limit or --fee_limit_percent flag. If neither is set, the fee is
+	limited to the amount being moved.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name:  "outgoing_chan_id",
+			Usage: "the channel to move funds out of",
+		},
+		cli.Uint64Flag{
+			Name:  "incoming_chan_id",
+			Usage: "the channel to move funds into",
+		},
+		cli.Int64Flag{
+			Name:  "amt, a",
+			Usage: "number of satoshis to move",
+		},
+		cli.Int64Flag{
+			Name: "fee_limit",
+			Usage: "maximum fee allowed in satoshis when rebalancing " +
+				"the channels",
+		},
+		cli.Int64Flag{
+			Name: "fee_limit_percent",
+			Usage: "percentage of the amount used as the maximum " +
+				"fee allowed when rebalancing the channels",
+		},
+	},
+	Action: actionDecorator(rebalance),
+}
+
+func rebalance(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		outgoingChanID, incomingChanID uint64
+		amt                            int64
+		err                            error
+	)
+	args := ctx.Args()
+
+	switch {
+	case ctx.IsSet("outgoing_chan_id"):
+		outgoingChanID = ctx.Uint64("outgoing_chan_id")
+	case args.Present():
+		outgoingChanID, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode outgoing_chan_id: "+
+				"%v", err)
+		}
+		args = args.Tail()
+	default:
+		return fmt.Errorf("outgoing_chan_id argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("incoming_chan_id"):
+		incomingChanID = ctx.Uint64("incoming_chan_id")
+	case args.Present():
+		incomingChanID, err = strconv.ParseUint(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode incoming_chan_id: "+
+				"%v", err)
+		}
+		args = args.Tail()
+	default:
+		return fmt.Errorf("incoming_chan_id argument missing")
+	}
+
+	switch {
+	case ctx.IsSet("amt"):
+		amt = ctx.Int64("amt")
+	case args.Present():
+		amt, err = strconv.ParseInt(args.First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode amt: %v", err)
+		}
+	default:
+		return fmt.Errorf("amt argument missing")
+	}
+
+	feeLimit, err := retrieveFeeLimit(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &lnrpc.RebalanceRequest{
+		OutgoingChanId: outgoingChanID,
+		IncomingChanId: incomingChanID,
+		Amt:            amt,
+		FeeLimit:       feeLimit,
+	}
+	resp, err := client.Rebalance(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		E string       `json:"payment_error"`
+		P string       `json:"payment_preimage"`
+		R *lnrpc.Route `json:"payment_route"`
+	}{
+		E: resp.PaymentError,
+		P: hex.EncodeToString(resp.PaymentPreimage),
+		R: resp.PaymentRoute,
+	})
+
+	return nil
+}
+
+var trackPaymentCommand = cli.Command{
+	Name:      "trackpayment",
+	Category:  "Payments",
+	Usage:     "Track the progress of an outgoing payment.",
+	ArgsUsage: "pay_hash",
+	Description: `
+	Display the full history of an outgoing payment, including every
+	attempt made to complete it. If the payment is still in flight, an
+	updated history is displayed each time it progresses, until it either
+	succeeds or fails.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "pay_hash",
+			Usage: "the hash of the payment to track",
+		},
+	},
+	Action: actionDecorator(trackPayment),
+}
+
+func trackPayment(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		payHash []byte
+		err     error
+	)
+
+	switch {
+	case ctx.IsSet("pay_hash"):
+		payHash, err = hex.DecodeString(ctx.String("pay_hash"))
+	case ctx.Args().Present():
+		payHash, err = hex.DecodeString(ctx.Args().First())
+	default:
+		return fmt.Errorf("pay_hash argument missing")
+	}
+	if err != nil {
+		return fmt.Errorf("unable to decode pay_hash: %v", err)
+	}
+
+	req := &lnrpc.TrackPaymentRequest{
+		PaymentHash: payHash,
+	}
+	stream, err := client.TrackPayment(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		update, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		printRespJSON(update)
+	}
+}
+
+var subscribeHtlcEventsCommand = cli.Command{
+	Name:     "subscribehtlcevents",
+	Category: "Payments",
+	Usage:    "Stream the HTLC events observed by the switch.",
+	Description: `
+	Display every HTLC event observed by the switch as it occurs. This
+	covers HTLCs as they are forwarded, added to their outgoing channel,
+	queued in the overflow queue, dropped after their deadline, settled
+	and failed.`,
+	Action: actionDecorator(subscribeHtlcEvents),
+}
+
+func subscribeHtlcEvents(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.SubscribeHtlcEventsRequest{}
+	stream, err := client.SubscribeHtlcEvents(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		printRespJSON(event)
+	}
+}
+
+var pendingSweepsCommand = cli.Command{
+	Name:     "pendingsweeps",
+	Category: "On-chain",
+	Usage:    "List the outputs waiting to be swept into the wallet.",
+	Description: `
+	List all outputs which are waiting to be swept back into the wallet by
+	the sweeper, along with the fee rate and txid of the latest sweep
+	transaction spending them.`,
+	Action: actionDecorator(pendingSweeps),
+}
+
+func pendingSweeps(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.PendingSweepsRequest{}
+	resp, err := client.PendingSweeps(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var sweepOutputsCommand = cli.Command{
+	Name:      "sweepoutputs",
+	Category:  "On-chain",
+	Usage:     "Sweep all pending outputs into the wallet right away.",
+	ArgsUsage: "[conf_target]",
+	Description: `
+	Immediately sweep all outputs which are waiting to be swept back into
+	the wallet, targeting confirmation within conf_target blocks. Outputs
+	which already are part of a sweep transaction have their fee bumped if
+	the target requires a higher fee.`,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"sweep transactions *should* confirm in, " +
+				"will be used for fee estimation",
+		},
+	},
+	Action: actionDecorator(sweepOutputs),
+}
+
+func sweepOutputs(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var (
+		confTarget int64
+		err        error
+	)
+	switch {
+	case ctx.IsSet("conf_target"):
+		confTarget = ctx.Int64("conf_target")
+	case ctx.Args().Present():
+		confTarget, err = strconv.ParseInt(ctx.Args().First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to decode conf_target: %v",
+				err)
+		}
+	}
+
+	req := &lnrpc.SweepOutputsRequest{
+		TargetConf: int32(confTarget),
+	}
+	resp, err := client.SweepOutputs(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var bumpFeeCommand = cli.Command{
+	Name:      "bumpfee",
+	Category:  "On-chain",
+	Usage:     "Bump the fee of an unconfirmed transaction.",
+	ArgsUsage: "outpoint",
+	Description: `
+	Bump the fee of the unconfirmed transaction creating the given output,
+	specified as txid:index. If the output is waiting to be swept, its
+	sweep transaction is replaced by one paying a higher fee. Otherwise, if
+	the output is an unconfirmed output of the wallet, it is spent by a
+	child transaction paying for its parent (CPFP). This requires all
+	outputs spent by the parent to belong to the wallet, such that its fee
+	is known.
+
+	If neither conf_target nor sat_per_byte is set, a pending sweep has its
+	fee rate bumped by the default increment.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name: "outpoint",
+			Usage: "the outpoint whose transaction should be " +
+				"bumped",
+		},
+		cli.Int64Flag{
+			Name: "conf_target",
+			Usage: "(optional) the number of blocks that the " +
+				"transaction *should* confirm in, will be " +
+				"used for fee estimation",
+		},
+		cli.Int64Flag{
+			Name: "sat_per_byte",
+			Usage: "(optional) a manual fee expressed in " +
+				"sat/byte that should be used",
+		},
+	},
+	Action: actionDecorator(bumpFee),
+}
+
+func bumpFee(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	var outpoint string
+	switch {
+	case ctx.IsSet("outpoint"):
+		outpoint = ctx.String("outpoint")
+	case ctx.Args().Present():
+		outpoint = ctx.Args().First()
+	default:
+		return fmt.Errorf("outpoint argument missing")
+	}
+
+	if ctx.IsSet("conf_target") && ctx.IsSet("sat_per_byte") {
+		return fmt.Errorf("either conf_target or sat_per_byte should " +
+			"be set, but not both")
+	}
+
+	req := &lnrpc.BumpFeeRequest{
+		Outpoint:   outpoint,
+		TargetConf: int32(ctx.Int64("conf_target")),
+		SatPerByte: ctx.Int64("sat_per_byte"),
+	}
+	resp, err := client.BumpFee(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var autopilotStatusCommand = cli.Command{
+	Name:     "autopilotstatus",
+	Category: "Autopilot",
+	Usage: "Display the status of the autopilot agent, along with the " +
+		"channels it proposes to open.",
+	Action: actionDecorator(autopilotStatus),
+}
+
+func autopilotStatus(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.AutopilotStatusRequest{}
+	resp, err := client.AutopilotStatus(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var setAutopilotCommand = cli.Command{
+	Name:     "setautopilot",
+	Category: "Autopilot",
+	Usage:    "Enable or disable the autopilot agent at runtime.",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "enable",
+			Usage: "enable the autopilot agent",
+		},
+		cli.BoolFlag{
+			Name:  "disable",
+			Usage: "disable the autopilot agent",
+		},
+	},
+	Action: actionDecorator(setAutopilot),
+}
+
+func setAutopilot(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	enable := ctx.Bool("enable")
+	if enable == ctx.Bool("disable") {
+		return fmt.Errorf("either --enable or --disable must be set")
+	}
+
+	req := &lnrpc.ModifyAutopilotStatusRequest{
+		Enable: enable,
+	}
+	resp, err := client.ModifyAutopilotStatus(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var setAutopilotScoresCommand = cli.Command{
+	Name:      "setautopilotscores",
+	Category:  "Autopilot",
+	Usage:     "Set the node scores of the externalscore heuristic.",
+	ArgsUsage: "scores-json-string",
+	Description: `
+	Replace the node scores used by the externalscore autopilot heuristic.
+	Nodes which aren't part of the new scores no longer receive a score.
+
+	The scores-json-string param decodes node pubkeys and their scores,
+	which must lie within [0, 1], in the following format:
+
+	    '{"ExamplePubKey": 0.5, "SecondPubKey": 1}'
+	`,
+	Action: actionDecorator(setAutopilotScores),
+}
+
+func setAutopilotScores(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if !ctx.Args().Present() {
+		return fmt.Errorf("scores argument missing")
+	}
+
+	var scores map[string]float64
+	jsonMap := ctx.Args().First()
+	if err := json.Unmarshal([]byte(jsonMap), &scores); err != nil {
+		return err
+	}
+
+	req := &lnrpc.SetAutopilotScoresRequest{
+		Scores: scores,
+	}
+	resp, err := client.SetAutopilotScores(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var bakeMacaroonCommand = cli.Command{
+	Name:      "bakemacaroon",
+	Category:  "Macaroons",
+	Usage:     "Bake a new macaroon with custom permissions.",
+	ArgsUsage: "permissions...",
+	Description: `
+	Bake a new macaroon that grants the given permissions, each of which
+	is an entity/action pair in the form entity:action. Only the pairs
+	required by any of the RPC calls may be granted, e.g. a macaroon that
+	only allows sending payments and querying their results is baked by:
+
+	    lncli bakemacaroon offchain:read offchain:write
+
+	The macaroon is baked with the root key of the given ID, which is
+	created if it doesn't exist yet. Deleting the root key through
+	deletemacaroonid revokes all macaroons baked with it. The default root
+	key, with ID 0, is shared with the macaroons lnd creates on startup.
+
+	The macaroon can be restricted further by caveats which are added
+	before it's printed: a set of allowed gRPC methods, given by their
+	full method names, e.g. /lnrpc.Lightning/SendPaymentSync, a maximum
+	amount per payment, a spend budget for all payments within a rolling
+	window, and a maximum number of calls per minute. Routing fees count
+	towards the payment limits, and the fee limit of a payment is reserved
+	within the spend budget until the payment completes.
+
+	Unless a file to save the macaroon to is given, the hex encoded
+	macaroon is printed.
+	`,
+	Flags: []cli.Flag{
+		cli.Uint64Flag{
+			Name: "root_key_id",
+			Usage: "the ID of the root key to bake the " +
+				"macaroon with",
+		},
+		cli.StringFlag{
+			Name:  "save_to",
+			Usage: "save the binary macaroon to the given file",
+		},
+		cli.StringSliceFlag{
+			Name: "allow_method",
+			Usage: "restrict the macaroon to the given full gRPC " +
+				"method name, can be specified multiple times",
+		},
+		cli.Uint64Flag{
+			Name: "max_payment_msat",
+			Usage: "the maximum amount in millisatoshis of a " +
+				"single payment sent with the macaroon",
+		},
+		cli.Uint64Flag{
+			Name: "spend_budget_msat",
+			Usage: "the maximum amount in millisatoshis of all " +
+				"payments sent with the macaroon within the " +
+				"spend budget window",
+		},
+		cli.DurationFlag{
+			Name:  "spend_budget_window",
+			Value: 24 * time.Hour,
+			Usage: "the rolling window of the spend budget",
+		},
+		cli.UintFlag{
+			Name: "rate_limit",
+			Usage: "the maximum number of calls per minute made " +
+				"with the macaroon",
+		},
+	},
+	Action: actionDecorator(bakeMacaroon),
+}
+
+func bakeMacaroon(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.NArg() == 0 {
+		cli.ShowCommandHelp(ctx, "bakemacaroon")
+		return nil
+	}
+
+	var permissions []*lnrpc.MacaroonPermission
+	for _, arg := range ctx.Args() {
+		parts := strings.Split(arg, ":")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return fmt.Errorf("invalid permission %q, expected "+
+				"entity:action", arg)
+		}
+
+		permissions = append(permissions, &lnrpc.MacaroonPermission{
+			Entity: parts[0],
+			Action: parts[1],
+		})
+	}
+
+	req := &lnrpc.BakeMacaroonRequest{
+		Permissions: permissions,
+		RootKeyId:   ctx.Uint64("root_key_id"),
+	}
+	resp, err := client.BakeMacaroon(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	// With the macaroon baked, we'll add the requested caveats to it.
+	var constraints []macaroons.Constraint
+	if ctx.IsSet("allow_method") {
+		methods := ctx.StringSlice("allow_method")
+		constraints = append(
+			constraints, macaroons.MethodsConstraint(methods...),
+		)
+	}
+	if ctx.IsSet("max_payment_msat") {
+		maxAmt := lnwire.MilliSatoshi(ctx.Uint64("max_payment_msat"))
+		constraints = append(
+			constraints, macaroons.MaxPaymentConstraint(maxAmt),
+		)
+	}
+	if ctx.IsSet("spend_budget_msat") {
+		budget := lnwire.MilliSatoshi(ctx.Uint64("spend_budget_msat"))
+		window := ctx.Duration("spend_budget_window")
+		constraints = append(
+			constraints,
+			macaroons.SpendBudgetConstraint(budget, window),
+		)
+	}
+	if ctx.IsSet("rate_limit") {
+		limit := uint32(ctx.Uint("rate_limit"))
+		constraints = append(
+			constraints, macaroons.RateLimitConstraint(limit),
+		)
+	}
+
+	macBytes, err := hex.DecodeString(resp.Macaroon)
+	if err != nil {
+		return err
+	}
+	if len(constraints) > 0 {
+		mac := &macaroon.Macaroon{}
+		if err := mac.UnmarshalBinary(macBytes); err != nil {
+			return fmt.Errorf("unable to decode macaroon: %v", err)
+		}
+
+		mac, err = macaroons.AddConstraints(mac, constraints...)
+		if err != nil {
+			return err
+		}
+
+		macBytes, err = mac.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		resp.Macaroon = hex.EncodeToString(macBytes)
+	}
+
+	if !ctx.IsSet("save_to") {
+		printRespJSON(resp)
+		return nil
+	}
+
+	savePath := cleanAndExpandPath(ctx.String("save_to"))
+	if err := ioutil.WriteFile(savePath, macBytes, 0600); err != nil {
+		return err
+	}
+	fmt.Printf("Macaroon saved to %v\n", savePath)
+
+	return nil
+}
+
+var listMacaroonIDsCommand = cli.Command{
+	Name:     "listmacaroonids",
+	Category: "Macaroons",
+	Usage:    "List the IDs of all macaroon root keys.",
+	Action:   actionDecorator(listMacaroonIDs),
+}
+
+func listMacaroonIDs(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	req := &lnrpc.ListMacaroonIDsRequest{}
+	resp, err := client.ListMacaroonIDs(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
+
+var deleteMacaroonIDCommand = cli.Command{
+	Name:      "deletemacaroonid",
+	Category:  "Macaroons",
+	Usage:     "Delete a macaroon root key, revoking its macaroons.",
+	ArgsUsage: "root_key_id",
+	Description: `
+	Delete the root key of the given ID, which revokes all macaroons that
+	were baked with it. The default root key, with ID 0, can't be deleted.
+	`,
+	Action: actionDecorator(deleteMacaroonID),
+}
+
+func deleteMacaroonID(ctx *cli.Context) error {
+	ctxb := context.Background()
+	client, cleanUp := getClient(ctx)
+	defer cleanUp()
+
+	if ctx.NArg() != 1 {
+		cli.ShowCommandHelp(ctx, "deletemacaroonid")
+		return nil
+	}
+
+	rootKeyID, err := strconv.ParseUint(ctx.Args().First(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("unable to decode root_key_id: %v", err)
+	}
+
+	req := &lnrpc.DeleteMacaroonIDRequest{
+		RootKeyId: rootKeyID,
+	}
+	resp, err := client.DeleteMacaroonID(ctxb, req)
+	if err != nil {
+		return err
+	}
+
+	printRespJSON(resp)
+
+	return nil
+}
@@ -0,0 +1,644 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/bech32"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/zpay32"
+	"github.com/urfave/cli"
+)
+
+// invoiceNetworks are the networks invoices can be handled offline for, keyed
+// by the name used by the network flag.
+var invoiceNetworks = map[string]*chaincfg.Params{
+	"mainnet": &chaincfg.MainNetParams,
+	"testnet": &chaincfg.TestNet3Params,
+	"regtest": &chaincfg.RegressionNetParams,
+	"simnet":  &chaincfg.SimNetParams,
+}
+
+var invoiceCommand = cli.Command{
+	Name:     "invoice",
+	Category: "Payments",
+	Usage:    "Decode, encode and verify invoices offline.",
+	Description: `
+	Handle BOLT-11 invoices without a connection to lnd, e.g. within CI
+	pipelines or on air-gapped machines. Invoices can only be handled for
+	the networks of the bitcoin chain.`,
+	Subcommands: []cli.Command{
+		invoiceDecodeCommand,
+		invoiceEncodeCommand,
+		invoiceVerifyCommand,
+	},
+}
+
+var invoiceDecodeCommand = cli.Command{
+	Name:      "decode",
+	Usage:     "Decode an invoice, listing all of its tagged fields.",
+	ArgsUsage: "pay_req",
+	Description: `
+	Decode the passed invoice, inferring its network from its prefix. Along
+	with the interpreted invoice, every tagged field is listed in the order
+	it appears, including the fields of unknown types which are otherwise
+	ignored. The data of each field is shown in hex, after converting it
+	from base32. Fields that don't fill whole bytes are padded with zero
+	bits.`,
+	Action: invoiceDecode,
+}
+
+// offlineHopHint is a hop hint of a decoded invoice.
+type offlineHopHint struct {
+	NodeID                    string `json:"node_id"`
+	ChanID                    uint64 `json:"chan_id"`
+	FeeBaseMsat               uint32 `json:"fee_base_msat"`
+	FeeProportionalMillionths uint32 `json:"fee_proportional_millionths"`
+	CltvExpiryDelta           uint16 `json:"cltv_expiry_delta"`
+}
+
+// offlineField is a raw tagged field of a decoded invoice.
+type offlineField struct {
+	Type   string `json:"type"`
+	Known  bool   `json:"known"`
+	Length int    `json:"length"`
+	Data   string `json:"data"`
+}
+
+// offlineInvoice is the output of the invoice decode command.
+type offlineInvoice struct {
+	Network         string             `json:"network"`
+	Destination     string             `json:"destination"`
+	PubKeyRecovered bool               `json:"pubkey_recovered"`
+	PaymentHash     string             `json:"payment_hash"`
+	AmtMsat         uint64             `json:"amt_msat"`
+	Timestamp       int64              `json:"timestamp"`
+	Expiry          int64              `json:"expiry"`
+	Expired         bool               `json:"expired"`
+	CltvExpiry      uint64             `json:"cltv_expiry"`
+	Description     string             `json:"description,omitempty"`
+	DescriptionHash string             `json:"description_hash,omitempty"`
+	FallbackAddr    string             `json:"fallback_addr,omitempty"`
+	RouteHints      [][]offlineHopHint `json:"route_hints,omitempty"`
+	Features        []uint32           `json:"features,omitempty"`
+	PaymentSecret   string             `json:"payment_secret,omitempty"`
+	SpiderAlgo      uint8              `json:"spider_algo,omitempty"`
+	SpiderDeadline  int64              `json:"spider_deadline,omitempty"`
+	Signature       string             `json:"signature"`
+	Fields          []offlineField     `json:"fields"`
+}
+
+func invoiceDecode(ctx *cli.Context) error {
+	if !ctx.Args().Present() {
+		cli.ShowCommandHelp(ctx, "decode")
+		return nil
+	}
+
+	raw, invoice, network, err := decodeInvoiceOffline(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	resp := offlineInvoice{
+		Network: network,
+		Destination: hex.EncodeToString(
+			invoice.Destination.SerializeCompressed(),
+		),
+		PubKeyRecovered: !hasField(raw, "n"),
+		PaymentHash:     hex.EncodeToString(invoice.PaymentHash[:]),
+		Timestamp:       invoice.Timestamp.Unix(),
+		Expiry:          int64(invoice.Expiry().Seconds()),
+		Expired:         invoiceExpired(invoice),
+		CltvExpiry:      invoice.MinFinalCLTVExpiry(),
+		Signature:       hex.EncodeToString(raw.Signature[:]),
+	}
+	if invoice.MilliSat != nil {
+		resp.AmtMsat = uint64(*invoice.MilliSat)
+	}
+	if invoice.Description != nil {
+		resp.Description = *invoice.Description
+	}
+	if invoice.DescriptionHash != nil {
+		resp.DescriptionHash = hex.EncodeToString(
+			invoice.DescriptionHash[:],
+		)
+	}
+	if invoice.FallbackAddr != nil {
+		resp.FallbackAddr = invoice.FallbackAddr.String()
+	}
+	for _, routeHint := range invoice.RouteHints {
+		hopHints := make([]offlineHopHint, 0, len(routeHint))
+		for _, hop := range routeHint {
+			hopHint := offlineHopHint{
+				NodeID: hex.EncodeToString(
+					hop.NodeID.SerializeCompressed(),
+				),
+				ChanID:          hop.ChannelID,
+				FeeBaseMsat:     hop.FeeBaseMSat,
+				CltvExpiryDelta: hop.CLTVExpiryDelta,
+			}
+			hopHint.FeeProportionalMillionths =
+				hop.FeeProportionalMillionths
+
+			hopHints = append(hopHints, hopHint)
+		}
+		resp.RouteHints = append(resp.RouteHints, hopHints)
+	}
+	if invoice.Features != nil {
+		for i := 0; i < invoice.Features.SerializeSize()*8; i++ {
+			if invoice.Features.IsSet(lnwire.FeatureBit(i)) {
+				resp.Features = append(resp.Features, uint32(i))
+			}
+		}
+	}
+	if invoice.PaymentSecret != nil {
+		resp.PaymentSecret = hex.EncodeToString(
+			invoice.PaymentSecret[:],
+		)
+	}
+	if invoice.SpiderHints != nil {
+		resp.SpiderAlgo = invoice.SpiderHints.Algo
+		deadline := invoice.SpiderHints.Deadline
+		if !deadline.IsZero() {
+			resp.SpiderDeadline = deadline.Unix()
+		}
+	}
+
+	for _, field := range raw.Fields {
+		// The padding bits completing the last group of a field aren't
+		// part of its data. Only fields that don't fill whole bytes,
+		// such as integers, need to be padded to be shown in hex.
+		data, err := bech32.ConvertBits(field.Data, 5, 8, false)
+		if err != nil {
+			data, err = bech32.ConvertBits(field.Data, 5, 8, true)
+		}
+		if err != nil {
+			return fmt.Errorf("unable to convert field %v: %v",
+				field.Name(), err)
+		}
+
+		resp.Fields = append(resp.Fields, offlineField{
+			Type:   field.Name(),
+			Known:  field.Known(),
+			Length: len(field.Data),
+			Data:   hex.EncodeToString(data),
+		})
+	}
+
+	printJSON(resp)
+	return nil
+}
+
+var invoiceVerifyCommand = cli.Command{
+	Name:      "verify",
+	Usage:     "Verify the checksum, signature and expiry of an invoice.",
+	ArgsUsage: "pay_req",
+	Description: `
+	Verify that the passed invoice is well formed and signed by its payee.
+	The pubkey of the payee is recovered from the signature, and checked
+	against the pubkey included within the invoice, if any, as well as the
+	expected pubkey, if passed. The command fails if any check fails, or
+	if the invoice has expired, unless expired invoices are allowed.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "pubkey",
+			Usage: "the hex-encoded pubkey of the expected payee",
+		},
+		cli.BoolFlag{
+			Name:  "allow_expired",
+			Usage: "don't fail verifying expired invoices",
+		},
+	},
+	Action: invoiceVerify,
+}
+
+func invoiceVerify(ctx *cli.Context) error {
+	if !ctx.Args().Present() {
+		cli.ShowCommandHelp(ctx, "verify")
+		return nil
+	}
+
+	// Decoding the invoice verifies its checksum, and its signature
+	// against the pubkey included within the invoice, if any.
+	raw, invoice, network, err := decodeInvoiceOffline(ctx.Args().First())
+	if err != nil {
+		return err
+	}
+
+	// Regardless of whether the invoice includes the pubkey of the payee,
+	// the signature must commit to it.
+	recovered, err := raw.RecoverPubKey()
+	if err != nil {
+		return fmt.Errorf("unable to recover pubkey from signature: "+
+			"%v", err)
+	}
+	if !recovered.IsEqual(invoice.Destination) {
+		return fmt.Errorf("pubkey recovered from signature %x doesn't "+
+			"match destination %x", recovered.SerializeCompressed(),
+			invoice.Destination.SerializeCompressed())
+	}
+
+	if ctx.IsSet("pubkey") {
+		expected, err := hex.DecodeString(ctx.String("pubkey"))
+		if err != nil {
+			return fmt.Errorf("unable to decode pubkey: %v", err)
+		}
+		pubKey, err := btcec.ParsePubKey(expected, btcec.S256())
+		if err != nil {
+			return fmt.Errorf("unable to parse pubkey: %v", err)
+		}
+
+		if !pubKey.IsEqual(invoice.Destination) {
+			return fmt.Errorf("invoice signed by %x, expected %x",
+				invoice.Destination.SerializeCompressed(),
+				expected)
+		}
+	}
+
+	expired := invoiceExpired(invoice)
+	if expired && !ctx.Bool("allow_expired") {
+		return fmt.Errorf("invoice expired at %v",
+			invoice.Timestamp.Add(invoice.Expiry()))
+	}
+
+	printJSON(struct {
+		Valid           bool   `json:"valid"`
+		Network         string `json:"network"`
+		Destination     string `json:"destination"`
+		PubKeyRecovered bool   `json:"pubkey_recovered"`
+		Expired         bool   `json:"expired"`
+	}{
+		Valid:   true,
+		Network: network,
+		Destination: hex.EncodeToString(
+			invoice.Destination.SerializeCompressed(),
+		),
+		PubKeyRecovered: !hasField(raw, "n"),
+		Expired:         expired,
+	})
+
+	return nil
+}
+
+var invoiceEncodeCommand = cli.Command{
+	Name:  "encode",
+	Usage: "Create and sign an invoice with a local key.",
+	Description: `
+	Create an invoice for the network selected by the global network flag,
+	signed with the private key read from the passed key file. The key file
+	must contain the hex-encoded 32 byte private key of the payee.
+
+	If neither a preimage nor a payment hash is passed, a random preimage
+	is generated and returned along with the invoice.`,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "key_file",
+			Usage: "the file containing the hex private key",
+		},
+		cli.StringFlag{
+			Name:  "preimage",
+			Usage: "the hex-encoded preimage of the payment hash",
+		},
+		cli.StringFlag{
+			Name:  "payment_hash",
+			Usage: "the hex-encoded payment hash of the invoice",
+		},
+		cli.Uint64Flag{
+			Name:  "amt_msat",
+			Usage: "the amount of the invoice in millisatoshis",
+		},
+		cli.StringFlag{
+			Name:  "description",
+			Usage: "a description of the payment",
+		},
+		cli.StringFlag{
+			Name:  "description_hash",
+			Usage: "the hex-encoded SHA-256 hash of a description",
+		},
+		cli.Int64Flag{
+			Name:  "expiry",
+			Usage: "the expiry of the invoice in seconds",
+		},
+		cli.Uint64Flag{
+			Name:  "cltv_expiry",
+			Usage: "the min final CLTV delta of the payment",
+		},
+		cli.StringFlag{
+			Name:  "fallback_addr",
+			Usage: "an on-chain fallback address",
+		},
+		cli.Int64Flag{
+			Name: "timestamp",
+			Usage: "the unix creation time of the invoice, " +
+				"defaults to now",
+		},
+		cli.BoolFlag{
+			Name:  "include_pubkey",
+			Usage: "include the pubkey of the payee as a field",
+		},
+		cli.StringFlag{
+			Name:  "payment_secret",
+			Usage: "the hex-encoded 32 byte payment secret",
+		},
+		cli.BoolFlag{
+			Name: "require_payment_secret",
+			Usage: "signal that payers must include the payment " +
+				"secret",
+		},
+		cli.UintFlag{
+			Name:  "spider_algo",
+			Usage: "the Spider routing algorithm to pay with",
+		},
+		cli.Int64Flag{
+			Name: "spider_deadline",
+			Usage: "the unix time after which the payer should " +
+				"stop attempting the payment",
+		},
+	},
+	Action: invoiceEncode,
+}
+
+func invoiceEncode(ctx *cli.Context) error {
+	if !ctx.IsSet("key_file") {
+		cli.ShowCommandHelp(ctx, "encode")
+		return nil
+	}
+
+	chain := strings.ToLower(ctx.GlobalString("chain"))
+	if chain != "bitcoin" {
+		return fmt.Errorf("invoices can only be encoded offline for " +
+			"the bitcoin chain")
+	}
+	network := strings.ToLower(ctx.GlobalString("network"))
+	params, ok := invoiceNetworks[network]
+	if !ok {
+		return fmt.Errorf("unknown network: %v", network)
+	}
+
+	privKey, err := readInvoiceKey(ctx.String("key_file"))
+	if err != nil {
+		return err
+	}
+
+	var (
+		preimage    []byte
+		paymentHash [32]byte
+	)
+	switch {
+	case ctx.IsSet("preimage") && ctx.IsSet("payment_hash"):
+		return fmt.Errorf("either a preimage or a payment hash may " +
+			"be passed, not both")
+
+	case ctx.IsSet("payment_hash"):
+		hash, err := hex.DecodeString(ctx.String("payment_hash"))
+		if err != nil {
+			return fmt.Errorf("unable to decode payment hash: %v",
+				err)
+		}
+		if len(hash) != 32 {
+			return fmt.Errorf("payment hash must be 32 bytes, "+
+				"is %v", len(hash))
+		}
+		copy(paymentHash[:], hash)
+
+	default:
+		if ctx.IsSet("preimage") {
+			preimage, err = hex.DecodeString(ctx.String("preimage"))
+			if err != nil {
+				return fmt.Errorf("unable to decode "+
+					"preimage: %v", err)
+			}
+			if len(preimage) != 32 {
+				return fmt.Errorf("preimage must be 32 "+
+					"bytes, is %v", len(preimage))
+			}
+		} else {
+			preimage = make([]byte, 32)
+			if _, err := rand.Read(preimage); err != nil {
+				return err
+			}
+		}
+		paymentHash = sha256.Sum256(preimage)
+	}
+
+	var options []func(*zpay32.Invoice)
+	if ctx.IsSet("amt_msat") {
+		amt := lnwire.MilliSatoshi(ctx.Uint64("amt_msat"))
+		options = append(options, zpay32.Amount(amt))
+	}
+	if ctx.IsSet("description_hash") {
+		hash, err := hex.DecodeString(ctx.String("description_hash"))
+		if err != nil {
+			return fmt.Errorf("unable to decode description "+
+				"hash: %v", err)
+		}
+		if len(hash) != 32 {
+			return fmt.Errorf("description hash must be 32 "+
+				"bytes, is %v", len(hash))
+		}
+
+		var descHash [32]byte
+		copy(descHash[:], hash)
+		options = append(options, zpay32.DescriptionHash(descHash))
+	} else {
+		options = append(
+			options, zpay32.Description(ctx.String("description")),
+		)
+	}
+	if ctx.IsSet("expiry") {
+		expiry := time.Duration(ctx.Int64("expiry")) * time.Second
+		options = append(options, zpay32.Expiry(expiry))
+	}
+	if ctx.IsSet("cltv_expiry") {
+		options = append(
+			options, zpay32.CLTVExpiry(ctx.Uint64("cltv_expiry")),
+		)
+	}
+	if ctx.IsSet("fallback_addr") {
+		addr, err := btcutil.DecodeAddress(
+			ctx.String("fallback_addr"), params,
+		)
+		if err != nil {
+			return fmt.Errorf("invalid fallback address: %v", err)
+		}
+		options = append(options, zpay32.FallbackAddr(addr))
+	}
+	if ctx.Bool("include_pubkey") {
+		options = append(options, zpay32.Destination(privKey.PubKey()))
+	}
+	if ctx.IsSet("payment_secret") {
+		secret, err := hex.DecodeString(ctx.String("payment_secret"))
+		if err != nil {
+			return fmt.Errorf("unable to decode payment secret: %v",
+				err)
+		}
+		if len(secret) != 32 {
+			return fmt.Errorf("payment secret must be 32 bytes, "+
+				"is %v", len(secret))
+		}
+
+		var paymentSecret [32]byte
+		copy(paymentSecret[:], secret)
+
+		feature := lnwire.PaymentSecretOptional
+		if ctx.Bool("require_payment_secret") {
+			feature = lnwire.PaymentSecretRequired
+		}
+		options = append(options,
+			zpay32.Features(lnwire.NewRawFeatureVector(feature)),
+			zpay32.PaymentSecret(paymentSecret),
+		)
+	}
+	if ctx.IsSet("spider_algo") || ctx.IsSet("spider_deadline") {
+		var deadline time.Time
+		if ctx.IsSet("spider_deadline") {
+			deadline = time.Unix(ctx.Int64("spider_deadline"), 0)
+		}
+		options = append(options, zpay32.SpiderPreferences(
+			uint8(ctx.Uint("spider_algo")), deadline,
+		))
+	}
+
+	timestamp := time.Now()
+	if ctx.IsSet("timestamp") {
+		timestamp = time.Unix(ctx.Int64("timestamp"), 0)
+	}
+
+	invoice, err := zpay32.NewInvoice(
+		params, paymentHash, timestamp, options...,
+	)
+	if err != nil {
+		return err
+	}
+
+	payReq, err := invoice.Encode(zpay32.MessageSigner{
+		SignCompact: func(hash []byte) ([]byte, error) {
+			return btcec.SignCompact(
+				btcec.S256(), privKey, hash, true,
+			)
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	printJSON(struct {
+		PayReq      string `json:"pay_req"`
+		PaymentHash string `json:"payment_hash"`
+		Preimage    string `json:"preimage,omitempty"`
+	}{
+		PayReq:      payReq,
+		PaymentHash: hex.EncodeToString(paymentHash[:]),
+		Preimage:    hex.EncodeToString(preimage),
+	})
+
+	return nil
+}
+
+// readInvoiceKey reads the hex-encoded private key used to sign invoices from
+// the passed file.
+func readInvoiceKey(path string) (*btcec.PrivateKey, error) {
+	keyHex, err := ioutil.ReadFile(cleanAndExpandPath(path))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read key file: %v", err)
+	}
+
+	keyBytes, err := hex.DecodeString(strings.TrimSpace(string(keyHex)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode key file: %v", err)
+	}
+	if len(keyBytes) != btcec.PrivKeyBytesLen {
+		return nil, fmt.Errorf("private key must be %v bytes, is %v",
+			btcec.PrivKeyBytesLen, len(keyBytes))
+	}
+
+	privKey, _ := btcec.PrivKeyFromBytes(btcec.S256(), keyBytes)
+	return privKey, nil
+}
+
+// decodeInvoiceOffline decodes the passed invoice, inferring its network from
+// the prefix of its human-readable part. Along with the decoded invoice, its
+// raw structure and the name of its network are returned.
+func decodeInvoiceOffline(payReq string) (*zpay32.RawInvoice,
+	*zpay32.Invoice, string, error) {
+
+	payReq = strings.TrimSpace(payReq)
+	if strings.HasPrefix(strings.ToLower(payReq), "lightning:") {
+		payReq = payReq[len("lightning:"):]
+	}
+
+	raw, err := zpay32.DecodeRaw(payReq)
+	if err != nil {
+		if e, ok := err.(*zpay32.ErrInvalidChecksum); ok {
+			return nil, nil, "", fmt.Errorf("invalid invoice "+
+				"checksum, the invoice was likely mistyped "+
+				"or truncated (expected checksum %q, got %q)",
+				e.Expected, e.Actual)
+		}
+
+		return nil, nil, "", fmt.Errorf("unable to decode invoice: %v",
+			err)
+	}
+
+	// As the prefixes of the networks overlap, e.g. bc and bcrt, we'll
+	// pick the network with the longest matching prefix.
+	networks := make([]string, 0, len(invoiceNetworks))
+	for network := range invoiceNetworks {
+		networks = append(networks, network)
+	}
+	sort.Strings(networks)
+
+	var (
+		network string
+		params  *chaincfg.Params
+	)
+	for _, name := range networks {
+		p := invoiceNetworks[name]
+		prefix := "ln" + p.Bech32HRPSegwit
+		if !strings.HasPrefix(raw.HRP, prefix) {
+			continue
+		}
+		if params == nil ||
+			len(p.Bech32HRPSegwit) > len(params.Bech32HRPSegwit) {
+
+			network, params = name, p
+		}
+	}
+	if params == nil {
+		return nil, nil, "", fmt.Errorf("unknown network of invoice "+
+			"with prefix %q", raw.HRP)
+	}
+
+	invoice, err := zpay32.Decode(payReq, params)
+	if err != nil {
+		return nil, nil, "", fmt.Errorf("invalid invoice: %v", err)
+	}
+
+	return raw, invoice, network, nil
+}
+
+// hasField returns whether the raw invoice contains a field of the passed
+// type.
+func hasField(raw *zpay32.RawInvoice, name string) bool {
+	for _, field := range raw.Fields {
+		if field.Name() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// invoiceExpired returns whether the invoice has expired.
+func invoiceExpired(invoice *zpay32.Invoice) bool {
+	return time.Now().After(invoice.Timestamp.Add(invoice.Expiry()))
+}
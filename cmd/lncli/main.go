@@ -256,16 +256,23 @@ func main() {
 		createCommand,
 		unlockCommand,
 		changePasswordCommand,
+		changeSeedPassphraseCommand,
 		newAddressCommand,
 		sendManyCommand,
 		sendCoinsCommand,
+		estimateFeeCommand,
+		leaseOutputCommand,
+		releaseOutputCommand,
+		listLeasesCommand,
 		connectCommand,
 		disconnectCommand,
 		openChannelCommand,
 		closeChannelCommand,
 		closeAllChannelsCommand,
 		listPeersCommand,
+		listPeerAddressesCommand,
 		walletBalanceCommand,
+		getRecoveryInfoCommand,
 		channelBalanceCommand,
 		getInfoCommand,
 		pendingChannelsCommand,
@@ -279,6 +286,7 @@ func main() {
 		closedChannelsCommand,
 		listPaymentsCommand,
 		describeGraphCommand,
+		exportGraphCommand,
 		getChanInfoCommand,
 		getNodeInfoCommand,
 		queryRoutesCommand,
@@ -292,6 +300,19 @@ func main() {
 		feeReportCommand,
 		updateChannelPolicyCommand,
 		forwardingHistoryCommand,
+		rebalanceCommand,
+		trackPaymentCommand,
+		subscribeHtlcEventsCommand,
+		pendingSweepsCommand,
+		sweepOutputsCommand,
+		bumpFeeCommand,
+		autopilotStatusCommand,
+		setAutopilotCommand,
+		setAutopilotScoresCommand,
+		bakeMacaroonCommand,
+		listMacaroonIDsCommand,
+		deleteMacaroonIDCommand,
+		invoiceCommand,
 	}
 
 	if err := app.Run(os.Args); err != nil {
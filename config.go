@@ -5,10 +5,12 @@
 package main
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net"
+	"net/url"
 	"os"
 	"os/user"
 	"path"
@@ -19,8 +21,11 @@ import (
 	"strings"
 	"time"
 
+	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcutil"
 	flags "github.com/jessevdk/go-flags"
+	"github.com/lightningnetwork/lnd/chanacceptor"
+	"github.com/lightningnetwork/lnd/discovery"
 	"github.com/lightningnetwork/lnd/htlcswitch/hodl"
 	"github.com/lightningnetwork/lnd/lncfg"
 	"github.com/lightningnetwork/lnd/lnwire"
@@ -43,6 +48,7 @@ const (
 	defaultLogFilename         = "lnd.log"
 	defaultRPCPort             = 10009
 	defaultRESTPort            = 8080
+	defaultRPCSocketPerms      = "0600"
 	defaultPeerPort            = 9735
 	defaultRPCHost             = "localhost"
 	defaultMaxPendingChannels  = 1
@@ -51,6 +57,7 @@ const (
 	defaultInactiveChanTimeout = 20 * time.Minute
 	defaultMaxLogFiles         = 3
 	defaultMaxLogFileSize      = 10
+	defaultMaxFeeRate          = 1000
 
 	defaultTorSOCKSPort            = 9050
 	defaultTorDNSHost              = "soa.nodes.lightning.directory"
@@ -61,6 +68,10 @@ const (
 
 	defaultBroadcastDelta = 10
 
+	defaultRebalanceInterval      = 10 * time.Minute
+	defaultRebalanceThreshold     = 0.3
+	defaultRebalanceMaxFeePercent = 1.0
+
 	// minTimeLockDelta is the minimum timelock we require for incoming
 	// HTLCs on our channels.
 	minTimeLockDelta = 4
@@ -138,13 +149,15 @@ type bitcoindConfig struct {
 }
 
 type autoPilotConfig struct {
-	Active         bool    `long:"active" description:"If the autopilot agent should be active or not."`
-	MaxChannels    int     `long:"maxchannels" description:"The maximum number of channels that should be created"`
-	Allocation     float64 `long:"allocation" description:"The percentage of total funds that should be committed to automatic channel establishment"`
-	MinChannelSize int64   `long:"minchansize" description:"The smallest channel that the autopilot agent should create"`
-	MaxChannelSize int64   `long:"maxchansize" description:"The largest channel that the autopilot agent should create"`
-	Private        bool    `long:"private" description:"Whether the channels created by the autopilot agent should be private or not. Private channels won't be announced to the network."`
-	MinConfs       int32   `long:"minconfs" description:"The minimum number of confirmations each of your inputs in funding transactions created by the autopilot agent must have."`
+	Active         bool               `long:"active" description:"If the autopilot agent should be active or not."`
+	MaxChannels    int                `long:"maxchannels" description:"The maximum number of channels that should be created"`
+	Allocation     float64            `long:"allocation" description:"The percentage of total funds that should be committed to automatic channel establishment"`
+	MinChannelSize int64              `long:"minchansize" description:"The smallest channel that the autopilot agent should create"`
+	MaxChannelSize int64              `long:"maxchansize" description:"The largest channel that the autopilot agent should create"`
+	Private        bool               `long:"private" description:"Whether the channels created by the autopilot agent should be private or not. Private channels won't be announced to the network."`
+	MinConfs       int32              `long:"minconfs" description:"The minimum number of confirmations each of your inputs in funding transactions created by the autopilot agent must have."`
+	Heuristic      string             `long:"heuristic" description:"The heuristic used to select the nodes to open channels to: prefattach (random, favoring well connected nodes), betweenness (the nodes with the highest betweenness centrality), spider (the nodes on our most used Spider paths), externalscore (the nodes scored highest through the SetAutopilotScores RPC) or weighted (a weighted combination of scores)"`
+	Weight         map[string]float64 `long:"weight" description:"The weight of a score within the weighted heuristic, as name:weight where name is one of degree, betweenness, spider or externalscore. May be specified multiple times"`
 }
 
 type torConfig struct {
@@ -156,6 +169,69 @@ type torConfig struct {
 	V2              bool   `long:"v2" description:"Automatically set up a v2 onion service to listen for inbound connections"`
 	V3              bool   `long:"v3" description:"Automatically set up a v3 onion service to listen for inbound connections"`
 	PrivateKeyPath  string `long:"privatekeypath" description:"The path to the private key of the onion service being created"`
+	EncryptKey      bool   `long:"encryptkey" description:"Encrypt the private key of the v3 onion service at rest with the wallet password"`
+	PeerIsolation   bool   `long:"peerisolation" description:"Enable Tor stream isolation per peer by deriving user credentials from the identity key of the peer being dialed"`
+	OnionOnly       bool   `long:"oniononly" description:"Only connect to peers through their onion services, refusing any clearnet connections and DNS bootstrapping"`
+}
+
+type rebalanceConfig struct {
+	Auto          bool          `long:"auto" description:"If the rebalancer should periodically even out the balances of our channels with circular payments"`
+	Interval      time.Duration `long:"interval" description:"How often the automatic rebalancer should inspect the balances of our channels"`
+	Threshold     float64       `long:"threshold" description:"How far a channel's ratio of local balance to capacity may deviate from an even split before it's automatically rebalanced"`
+	MaxFeePercent float64       `long:"maxfeepercent" description:"The maximum fee the automatic rebalancer will pay, as a percentage of the amount being moved"`
+}
+
+type chanAcceptorConfig struct {
+	AllowNode       []string      `long:"allownode" description:"Only accept inbound channels from the node with this hex encoded public key. May be specified multiple times"`
+	DenyNode        []string      `long:"denynode" description:"Reject inbound channels from the node with this hex encoded public key. May be specified multiple times"`
+	MinCapacity     int64         `long:"mincapacity" description:"The smallest capacity in satoshis of inbound channels to accept"`
+	MaxChansPerPeer int           `long:"maxchansperpeer" description:"The maximum number of channels, including pending ones, a single peer may have with us. 0 means no limit"`
+	RequirePrivate  bool          `long:"requireprivate" description:"Only accept inbound channels which won't be announced to the network"`
+	RequirePublic   bool          `long:"requirepublic" description:"Only accept inbound channels which will be announced to the network"`
+	Timeout         time.Duration `long:"timeout" description:"How long to wait for an RPC channel acceptor to decide on an inbound channel before rejecting it"`
+}
+
+// rules parses the channel acceptor options into the set of rules enforced
+// on inbound channels.
+func (c *chanAcceptorConfig) rules() (chanacceptor.Rules, error) {
+	parseNodes := func(keys []string) (map[[33]byte]struct{}, error) {
+		nodes := make(map[[33]byte]struct{}, len(keys))
+		for _, key := range keys {
+			keyBytes, err := hex.DecodeString(key)
+			if err != nil {
+				return nil, err
+			}
+			_, err = btcec.ParsePubKey(keyBytes, btcec.S256())
+			if err != nil {
+				return nil, err
+			}
+
+			var node [33]byte
+			copy(node[:], keyBytes)
+			nodes[node] = struct{}{}
+		}
+		return nodes, nil
+	}
+
+	allowList, err := parseNodes(c.AllowNode)
+	if err != nil {
+		return chanacceptor.Rules{}, fmt.Errorf("invalid "+
+			"chanacceptor.allownode: %v", err)
+	}
+	denyList, err := parseNodes(c.DenyNode)
+	if err != nil {
+		return chanacceptor.Rules{}, fmt.Errorf("invalid "+
+			"chanacceptor.denynode: %v", err)
+	}
+
+	return chanacceptor.Rules{
+		AllowList:       allowList,
+		DenyList:        denyList,
+		MinCapacity:     btcutil.Amount(c.MinCapacity),
+		MaxChansPerPeer: c.MaxChansPerPeer,
+		RequirePrivate:  c.RequirePrivate,
+		RequirePublic:   c.RequirePublic,
+	}, nil
 }
 
 type spiderConfig struct {
@@ -170,35 +246,41 @@ type spiderConfig struct {
 type config struct {
 	ShowVersion bool `short:"V" long:"version" description:"Display version information and exit"`
 
-	LndDir         string `long:"lnddir" description:"The base directory that contains lnd's data, logs, configuration file, etc."`
-	ConfigFile     string `long:"C" long:"configfile" description:"Path to configuration file"`
-	DataDir        string `short:"b" long:"datadir" description:"The directory to store lnd's data within"`
-	TLSCertPath    string `long:"tlscertpath" description:"Path to write the TLS certificate for lnd's RPC and REST services"`
-	TLSKeyPath     string `long:"tlskeypath" description:"Path to write the TLS private key for lnd's RPC and REST services"`
-	TLSExtraIP     string `long:"tlsextraip" description:"Adds an extra ip to the generated certificate"`
-	TLSExtraDomain string `long:"tlsextradomain" description:"Adds an extra domain to the generated certificate"`
-	NoMacaroons    bool   `long:"no-macaroons" description:"Disable macaroon authentication"`
-	AdminMacPath   string `long:"adminmacaroonpath" description:"Path to write the admin macaroon for lnd's RPC and REST services if it doesn't exist"`
-	ReadMacPath    string `long:"readonlymacaroonpath" description:"Path to write the read-only macaroon for lnd's RPC and REST services if it doesn't exist"`
-	InvoiceMacPath string `long:"invoicemacaroonpath" description:"Path to the invoice-only macaroon for lnd's RPC and REST services if it doesn't exist"`
-	LogDir         string `long:"logdir" description:"Directory to log output."`
-	MaxLogFiles    int    `long:"maxlogfiles" description:"Maximum logfiles to keep (0 for no rotation)"`
-	MaxLogFileSize int    `long:"maxlogfilesize" description:"Maximum logfile size in MB"`
+	LndDir          string   `long:"lnddir" description:"The base directory that contains lnd's data, logs, configuration file, etc."`
+	ConfigFile      string   `long:"C" long:"configfile" description:"Path to configuration file"`
+	DataDir         string   `short:"b" long:"datadir" description:"The directory to store lnd's data within"`
+	TLSCertPath     string   `long:"tlscertpath" description:"Path to write the TLS certificate for lnd's RPC and REST services"`
+	TLSKeyPath      string   `long:"tlskeypath" description:"Path to write the TLS private key for lnd's RPC and REST services"`
+	TLSExtraIPs     []string `long:"tlsextraip" description:"Adds an extra ip to the generated certificate -- may be specified multiple times, generated certificates are regenerated when the extra ips change"`
+	TLSExtraDomains []string `long:"tlsextradomain" description:"Adds an extra domain to the generated certificate -- may be specified multiple times, generated certificates are regenerated when the extra domains change"`
+	NoMacaroons     bool     `long:"no-macaroons" description:"Disable macaroon authentication"`
+	AdminMacPath    string   `long:"adminmacaroonpath" description:"Path to write the admin macaroon for lnd's RPC and REST services if it doesn't exist"`
+	ReadMacPath     string   `long:"readonlymacaroonpath" description:"Path to write the read-only macaroon for lnd's RPC and REST services if it doesn't exist"`
+	InvoiceMacPath  string   `long:"invoicemacaroonpath" description:"Path to the invoice-only macaroon for lnd's RPC and REST services if it doesn't exist"`
+	LogDir          string   `long:"logdir" description:"Directory to log output."`
+	MaxLogFiles     int      `long:"maxlogfiles" description:"Maximum logfiles to keep (0 for no rotation)"`
+	MaxLogFileSize  int      `long:"maxlogfilesize" description:"Maximum logfile size in MB"`
 
 	// We'll parse these 'raw' string arguments into real net.Addrs in the
 	// loadConfig function. We need to expose the 'raw' strings so the
 	// command line library can access them.
 	// Only the parsed net.Addrs should be used!
-	RawRPCListeners  []string `long:"rpclisten" description:"Add an interface/port/socket to listen for RPC connections"`
-	RawRESTListeners []string `long:"restlisten" description:"Add an interface/port/socket to listen for REST connections"`
-	RawListeners     []string `long:"listen" description:"Add an interface/port to listen for peer connections"`
-	RawExternalIPs   []string `long:"externalip" description:"Add an ip:port to the list of local addresses we claim to listen on to peers. If a port is not specified, the default (9735) will be used regardless of other parameters"`
-	RPCListeners     []net.Addr
-	RESTListeners    []net.Addr
-	Listeners        []net.Addr
-	ExternalIPs      []net.Addr
-	DisableListen    bool `long:"nolisten" description:"Disable listening for incoming peer connections"`
-	NAT              bool `long:"nat" description:"Toggle NAT traversal support (using either UPnP or NAT-PMP) to automatically advertise your external IP address to the network -- NOTE this does not support devices behind multiple NATs"`
+	RawRPCListeners    []string `long:"rpclisten" description:"Add an interface/port/socket to listen for RPC connections"`
+	RawRESTListeners   []string `long:"restlisten" description:"Add an interface/port/socket to listen for REST connections"`
+	RawListeners       []string `long:"listen" description:"Add an interface/port to listen for peer connections"`
+	RawExternalIPs     []string `long:"externalip" description:"Add an ip:port to the list of local addresses we claim to listen on to peers. If a port is not specified, the default (9735) will be used regardless of other parameters"`
+	RawRPCListenerTLS  []string `long:"rpclistentls" description:"Use a dedicated TLS certificate and key for an RPC listener, specified as <address>,<cert path>,<key path> -- they are generated if they don't exist"`
+	RawRESTListenerTLS []string `long:"restlistentls" description:"Use a dedicated TLS certificate and key for a REST listener, specified as <address>,<cert path>,<key path> -- they are generated if they don't exist"`
+	RPCSocketPerms     string   `long:"rpcsocketperms" description:"The permissions, in octal, of the socket files of RPC and REST listeners on Unix sockets"`
+	RPCUnixSocketAuth  bool     `long:"rpcunixsocketauth" description:"Authorize RPC calls made over Unix sockets by the permissions of the socket file instead of macaroons"`
+	RPCListeners       []net.Addr
+	RESTListeners      []net.Addr
+	RPCListenerTLS     []*lncfg.ListenerTLS
+	RESTListenerTLS    []*lncfg.ListenerTLS
+	Listeners          []net.Addr
+	ExternalIPs        []net.Addr
+	DisableListen      bool `long:"nolisten" description:"Disable listening for incoming peer connections"`
+	NAT                bool `long:"nat" description:"Toggle NAT traversal support (using either UPnP or NAT-PMP) to automatically advertise your external IP address to the network -- NOTE this does not support devices behind multiple NATs"`
 
 	DebugLevel string `short:"d" long:"debuglevel" description:"Logging level for all subsystems {trace, debug, info, warn, error, critical} -- You may also specify <subsystem>=<level>,<subsystem2>=<level>,... to set the log level for individual subsystems -- Use show to list available subsystems"`
 
@@ -226,6 +308,10 @@ type config struct {
 
 	Spider *spiderConfig `group:"Spider" namespace:"spider"`
 
+	Rebalance *rebalanceConfig `group:"Rebalance" namespace:"rebalance"`
+
+	ChanAcceptor *chanAcceptorConfig `group:"ChanAcceptor" namespace:"chanacceptor"`
+
 	Hodl *hodl.Config `group:"hodl" namespace:"hodl"`
 
 	NoNetBootstrap bool `long:"nobootstrap" description:"If true, then automatic network bootstrapping will not be attempted."`
@@ -241,8 +327,20 @@ type config struct {
 
 	NoChanUpdates bool `long:"nochanupdates" description:"If specified, lnd will not request real-time channel updates from connected peers. This option should be used by routing nodes to save bandwidth."`
 
+	NumGraphSyncPeers      int           `long:"numgraphsyncpeers" description:"The number of peers that we should receive new graph updates from. This option can be tuned to save bandwidth for light clients or routing nodes."`
+	HistoricalSyncInterval time.Duration `long:"historicalsyncinterval" description:"The polling interval between historical graph sync attempts. Each historical graph sync attempt ensures we reconcile with the remote peer's graph from the genesis block."`
+
+	PeerGossipRateLimit uint64 `long:"peergossipratelimit" description:"The maximum rate in bytes per second at which gossip messages are sent to each peer. Channel state messages always take precedence over gossip. 0 disables the cap."`
+	PeerProbeRateLimit  uint64 `long:"peerproberatelimit" description:"The maximum rate in bytes per second at which Spider probe messages are sent to each peer. Channel state and gossip messages always take precedence over probes. 0 disables the cap."`
+
+	FeeURLs    []string `long:"feeurl" description:"Add the URL of an HTTP/JSON fee estimation API to use as a fee source. The API must respond with a fee_by_block_target object mapping confirmation targets to fee rates in sat/kvbyte. If several fee sources are available, the median of their estimates is used."`
+	MaxFeeRate int64    `long:"maxfeerate" description:"The maximum fee rate in sat/vbyte that on-chain fee estimates are capped to."`
+
 	net tor.Net
 
+	// rpcSocketPerms is the parsed form of RPCSocketPerms.
+	rpcSocketPerms os.FileMode
+
 	Routing *routing.Conf `group:"routing" namespace:"routing"`
 }
 
@@ -262,6 +360,7 @@ func loadConfig() (*config, error) {
 		DebugLevel:     defaultLogLevel,
 		TLSCertPath:    defaultTLSCertPath,
 		TLSKeyPath:     defaultTLSKeyPath,
+		RPCSocketPerms: defaultRPCSocketPerms,
 		LogDir:         defaultLogDir,
 		MaxLogFiles:    defaultMaxLogFiles,
 		MaxLogFileSize: defaultMaxLogFileSize,
@@ -304,19 +403,31 @@ func loadConfig() (*config, error) {
 			Allocation:     0.6,
 			MinChannelSize: int64(minChanFundingSize),
 			MaxChannelSize: int64(maxFundingAmount),
+			Heuristic:      "prefattach",
 		},
-		TrickleDelay:        defaultTrickleDelay,
-		InactiveChanTimeout: defaultInactiveChanTimeout,
-		Alias:               defaultAlias,
-		Color:               defaultColor,
-		MinChanSize:         int64(minChanFundingSize),
+		TrickleDelay:           defaultTrickleDelay,
+		InactiveChanTimeout:    defaultInactiveChanTimeout,
+		NumGraphSyncPeers:      discovery.DefaultNumActiveSyncers,
+		HistoricalSyncInterval: discovery.DefaultHistoricalSyncInterval,
+		MaxFeeRate:             defaultMaxFeeRate,
+		Alias:                  defaultAlias,
+		Color:                  defaultColor,
+		MinChanSize:            int64(minChanFundingSize),
 		Tor: &torConfig{
 			SOCKS:   defaultTorSOCKS,
 			DNS:     defaultTorDNS,
 			Control: defaultTorControl,
 		},
 		Spider: &spiderConfig{},
-		net:    &tor.ClearNet{},
+		Rebalance: &rebalanceConfig{
+			Interval:      defaultRebalanceInterval,
+			Threshold:     defaultRebalanceThreshold,
+			MaxFeePercent: defaultRebalanceMaxFeePercent,
+		},
+		ChanAcceptor: &chanAcceptorConfig{
+			Timeout: chanacceptor.DefaultAcceptTimeout,
+		},
+		net: &tor.ClearNet{},
 	}
 
 	// Pre-parse the command line options to pick up an alternative config
@@ -447,6 +558,75 @@ func loadConfig() (*config, error) {
 		return nil, err
 	}
 
+	// Ensure the autopilot heuristic is known, and the weights of the
+	// weighted heuristic refer to known scores.
+	switch cfg.Autopilot.Heuristic {
+	case "prefattach", "betweenness", "spider", "externalscore",
+		"weighted":
+	default:
+		str := "%s: unknown autopilot.heuristic: %v"
+		err := fmt.Errorf(str, funcName, cfg.Autopilot.Heuristic)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	var totalWeight float64
+	for name, weight := range cfg.Autopilot.Weight {
+		switch name {
+		case "degree", "betweenness", "spider", "externalscore":
+		default:
+			str := "%s: unknown score in autopilot.weight: %v"
+			err := fmt.Errorf(str, funcName, name)
+			fmt.Fprintln(os.Stderr, err)
+			return nil, err
+		}
+
+		if weight < 0 {
+			str := "%s: autopilot.weight must be non-negative"
+			err := fmt.Errorf(str, funcName)
+			fmt.Fprintln(os.Stderr, err)
+			return nil, err
+		}
+		totalWeight += weight
+	}
+	if cfg.Autopilot.Heuristic == "weighted" && totalWeight == 0 {
+		str := "%s: the weighted autopilot heuristic requires a " +
+			"positive autopilot.weight"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
+	// Ensure the graph sync parameters are sane.
+	if cfg.NumGraphSyncPeers < 0 {
+		str := "%s: numgraphsyncpeers must be non-negative"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.HistoricalSyncInterval <= 0 {
+		str := "%s: historicalsyncinterval must be positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
+	// Ensure the fee estimation parameters are sane.
+	for _, feeURL := range cfg.FeeURLs {
+		u, err := url.Parse(feeURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+			str := "%s: feeurl %v must be a valid http(s) URL"
+			err := fmt.Errorf(str, funcName, feeURL)
+			fmt.Fprintln(os.Stderr, err)
+			return nil, err
+		}
+	}
+	if cfg.MaxFeeRate <= 0 {
+		str := "%s: maxfeerate must be positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
 	// Ensure that the specified values for the min and max channel size
 	// don't are within the bounds of the normal chan size constraints.
 	if cfg.Autopilot.MinChannelSize < int64(minChanFundingSize) {
@@ -456,6 +636,54 @@ func loadConfig() (*config, error) {
 		cfg.Autopilot.MaxChannelSize = int64(maxFundingAmount)
 	}
 
+	// Ensure that the rebalancer params are sane. A threshold of 0.5 or
+	// more would never be exceeded, as the ratio is measured from an even
+	// split of the channel.
+	if cfg.Rebalance.Interval <= 0 {
+		str := "%s: rebalance.interval must be positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.Rebalance.Threshold <= 0 || cfg.Rebalance.Threshold >= 0.5 {
+		str := "%s: rebalance.threshold must be between 0 and 0.5"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.Rebalance.MaxFeePercent < 0 || cfg.Rebalance.MaxFeePercent > 100 {
+		str := "%s: rebalance.maxfeepercent must be between 0 and 100"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
+	// Ensure that the channel acceptor rules are sane.
+	if _, err := cfg.ChanAcceptor.rules(); err != nil {
+		err := fmt.Errorf("%s: %v", funcName, err)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.ChanAcceptor.RequirePrivate && cfg.ChanAcceptor.RequirePublic {
+		str := "%s: chanacceptor.requireprivate and " +
+			"chanacceptor.requirepublic are mutually exclusive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.ChanAcceptor.MaxChansPerPeer < 0 {
+		str := "%s: chanacceptor.maxchansperpeer must be positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+	if cfg.ChanAcceptor.Timeout <= 0 {
+		str := "%s: chanacceptor.timeout must be positive"
+		err := fmt.Errorf(str, funcName)
+		fmt.Fprintln(os.Stderr, err)
+		return nil, err
+	}
+
 	// Validate the Tor config parameters.
 	socks, err := lncfg.ParseAddressString(
 		cfg.Tor.SOCKS, strconv.Itoa(defaultTorSOCKSPort),
@@ -503,6 +731,26 @@ func loadConfig() (*config, error) {
 		cfg.DisableListen = true
 	}
 
+	switch {
+	case cfg.Tor.StreamIsolation && cfg.Tor.PeerIsolation:
+		return nil, errors.New("either tor.streamisolation or " +
+			"tor.peerisolation can be set, but not both")
+	case !cfg.Tor.Active && (cfg.Tor.PeerIsolation || cfg.Tor.OnionOnly):
+		return nil, errors.New("tor.active must be set when enabling " +
+			"tor.peerisolation or tor.oniononly")
+	case cfg.Tor.EncryptKey && !cfg.Tor.V3:
+		return nil, errors.New("tor.encryptkey can only be set for " +
+			"v3 onion services")
+	case cfg.Tor.EncryptKey && cfg.NoSeedBackup:
+		// Without a seed backup, the wallet is encrypted with the
+		// default passphrase, which would offer no protection.
+		return nil, errors.New("tor.encryptkey cannot be set when " +
+			"noseedbackup is set")
+	case cfg.Tor.OnionOnly && (len(cfg.RawExternalIPs) > 0 || cfg.NAT):
+		return nil, errors.New("clearnet addresses cannot be " +
+			"advertised when tor.oniononly is set")
+	}
+
 	if cfg.Tor.PrivateKeyPath == "" {
 		switch {
 		case cfg.Tor.V2:
@@ -908,6 +1156,50 @@ func loadConfig() (*config, error) {
 		return nil, err
 	}
 
+	// Parse the dedicated TLS certificates and keys of the RPC and REST
+	// listeners, each of which must belong to one of the listeners.
+	cfg.RPCListenerTLS, err = parseListenerTLS(
+		cfg.RawRPCListenerTLS, cfg.RPCListeners,
+		strconv.Itoa(defaultRPCPort), cfg.net.ResolveTCPAddr,
+	)
+	if err != nil {
+		return nil, err
+	}
+	cfg.RESTListenerTLS, err = parseListenerTLS(
+		cfg.RawRESTListenerTLS, cfg.RESTListeners,
+		strconv.Itoa(defaultRESTPort), cfg.net.ResolveTCPAddr,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	perms, err := strconv.ParseUint(cfg.RPCSocketPerms, 8, 32)
+	if err != nil || os.FileMode(perms)&^os.ModePerm != 0 {
+		return nil, fmt.Errorf("invalid rpcsocketperms %q, expected "+
+			"octal file permissions such as %v", cfg.RPCSocketPerms,
+			defaultRPCSocketPerms)
+	}
+	cfg.rpcSocketPerms = os.FileMode(perms)
+
+	// The REST proxy connects to the gRPC server like any other client,
+	// so if calls over Unix sockets skip macaroon authentication, it has
+	// to connect to a TCP listener to prevent it from bypassing it on
+	// behalf of REST clients.
+	if cfg.RPCUnixSocketAuth && len(cfg.RESTListeners) > 0 {
+		var hasTCPListener bool
+		for _, listener := range cfg.RPCListeners {
+			if !lncfg.IsUnix(listener) {
+				hasTCPListener = true
+				break
+			}
+		}
+		if !hasTCPListener {
+			return nil, fmt.Errorf("rpcunixsocketauth requires " +
+				"at least one TCP rpclisten for the REST " +
+				"proxy")
+		}
+	}
+
 	// Remove the listening addresses specified if listening is disabled.
 	if cfg.DisableListen {
 		ltndLog.Infof("Listening on the p2p interface is disabled!")
@@ -972,6 +1264,49 @@ func loadConfig() (*config, error) {
 	return &cfg, nil
 }
 
+// parseListenerTLS parses the raw dedicated TLS configs of listeners, expanding
+// their paths. An error is returned if a config doesn't belong to any of the
+// passed listeners, or if a listener has more than one.
+func parseListenerTLS(rawConfigs []string, listeners []net.Addr,
+	defaultPort string,
+	resolver func(string, string) (*net.TCPAddr, error)) (
+	[]*lncfg.ListenerTLS, error) {
+
+	var configs []*lncfg.ListenerTLS
+	for _, raw := range rawConfigs {
+		config, err := lncfg.ParseListenerTLS(
+			raw, defaultPort, resolver,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if lncfg.FindListenerTLS(configs, config.Addr) != nil {
+			return nil, fmt.Errorf("listener %v has more than one "+
+				"TLS config", config.Addr)
+		}
+
+		known := []*lncfg.ListenerTLS{config}
+		var found bool
+		for _, listener := range listeners {
+			if lncfg.FindListenerTLS(known, listener) != nil {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("TLS config %q doesn't "+
+				"belong to any listener", raw)
+		}
+
+		config.CertPath = cleanAndExpandPath(config.CertPath)
+		config.KeyPath = cleanAndExpandPath(config.KeyPath)
+		configs = append(configs, config)
+	}
+
+	return configs, nil
+}
+
 // cleanAndExpandPath expands environment variables and leading ~ in the
 // passed path, cleans the result, and returns it.
 // This function is taken from https://github.com/btcsuite/btcd
@@ -39,6 +39,13 @@ func (c *ContractResolutions) IsEmpty() bool {
 		len(c.HtlcResolutions.OutgoingHTLCs) == 0
 }
 
+// BroadcastCommitment is the commitment transaction we broadcast when force
+// closing a channel, which we rebroadcast while it remains unconfirmed.
+type BroadcastCommitment struct {
+	// CommitTx is our broadcast commitment transaction.
+	CommitTx *wire.MsgTx
+}
+
 // ArbitratorLog is the primary source of persistent storage for the
 // ChannelArbitrator. The log stores the current state of the
 // ChannelArbitrator's internal state machine, any items that are required to
@@ -92,6 +99,15 @@ type ArbitratorLog interface {
 	// state machine forward.
 	FetchChainActions() (ChainActionMap, error)
 
+	// LogCommitment stores the commitment transaction we broadcast when
+	// force closing the channel, such that we're able to rebroadcast it
+	// across restarts until it confirms.
+	LogCommitment(*BroadcastCommitment) error
+
+	// FetchCommitment attempts to fetch the previously stored broadcast
+	// commitment transaction.
+	FetchCommitment() (*BroadcastCommitment, error)
+
 	// WipeHistory is to be called ONLY once *all* contracts have been
 	// fully resolved, and the channel closure if finalized. This method
 	// will delete all on-disk state within the persistent log.
@@ -258,6 +274,10 @@ var (
 	// actionsBucketKey is the key under the logScope that we'll use to
 	// store all chain actions once they're determined.
 	actionsBucketKey = []byte("chain-actions")
+
+	// commitmentKey is the key under the logScope that we'll use to store
+	// the commitment transaction we broadcast when force closing.
+	commitmentKey = []byte("broadcast-commitment")
 )
 
 var (
@@ -276,6 +296,10 @@ var (
 	// errNoActions is retuned when the log doesn't contain any stored
 	// chain actions.
 	errNoActions = fmt.Errorf("no chain actions exist")
+
+	// errNoCommitment is returned when the log doesn't contain a broadcast
+	// commitment transaction.
+	errNoCommitment = fmt.Errorf("no broadcast commitment exists")
 )
 
 // boltArbitratorLog is an implementation of the ArbitratorLog interface backed
@@ -801,6 +825,54 @@ func (b *boltArbitratorLog) FetchChainActions() (ChainActionMap, error) {
 	return actionsMap, nil
 }
 
+// LogCommitment stores the commitment transaction we broadcast when force
+// closing the channel, such that we're able to rebroadcast it across restarts
+// until it confirms.
+//
+// NOTE: Part of the ContractResolver interface.
+func (b *boltArbitratorLog) LogCommitment(c *BroadcastCommitment) error {
+	return b.db.Batch(func(tx *bolt.Tx) error {
+		scopeBucket, err := tx.CreateBucketIfNotExists(b.scopeKey[:])
+		if err != nil {
+			return err
+		}
+
+		var b bytes.Buffer
+		if err := c.CommitTx.Serialize(&b); err != nil {
+			return err
+		}
+
+		return scopeBucket.Put(commitmentKey, b.Bytes())
+	})
+}
+
+// FetchCommitment attempts to fetch the previously stored broadcast commitment
+// transaction.
+//
+// NOTE: Part of the ContractResolver interface.
+func (b *boltArbitratorLog) FetchCommitment() (*BroadcastCommitment, error) {
+	c := &BroadcastCommitment{}
+	err := b.db.View(func(tx *bolt.Tx) error {
+		scopeBucket := tx.Bucket(b.scopeKey[:])
+		if scopeBucket == nil {
+			return errScopeBucketNoExist
+		}
+
+		commitBytes := scopeBucket.Get(commitmentKey)
+		if commitBytes == nil {
+			return errNoCommitment
+		}
+
+		c.CommitTx = &wire.MsgTx{}
+		return c.CommitTx.Deserialize(bytes.NewReader(commitBytes))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
 // WipeHistory is to be called ONLY once *all* contracts have been fully
 // resolved, and the channel closure if finalized. This method will delete all
 // on-disk state within the persistent log.
@@ -844,6 +916,12 @@ func (b *boltArbitratorLog) WipeHistory() error {
 			return err
 		}
 
+		// We'll also delete the commitment transaction we broadcast,
+		// if any.
+		if err := scopeBucket.Delete(commitmentKey); err != nil {
+			return err
+		}
+
 		// Before we delta the enclosing bucket itself, we'll delta any
 		// chain actions that are still stored.
 		actionsBucket, err := scopeBucket.CreateBucketIfNotExists(
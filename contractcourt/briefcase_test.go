@@ -692,6 +692,61 @@ func TestChainActionStorage(t *testing.T) {
 	}
 }
 
+// TestCommitmentStorage tests that we're able to properly store the commitment
+// transaction we broadcast, and then retrieve it from disk.
+func TestCommitmentStorage(t *testing.T) {
+	t.Parallel()
+
+	testLog, cleanUp, err := newTestBoltArbLog(
+		testChainHash, testChanPoint1,
+	)
+	if err != nil {
+		t.Fatalf("unable to create test log: %v", err)
+	}
+	defer cleanUp()
+
+	// Fetching the commitment before it's been logged should fail.
+	_, err = testLog.FetchCommitment()
+	if err != errScopeBucketNoExist {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	commitTx := wire.NewMsgTx(2)
+	commitTx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: testChanPoint2,
+		Witness:          [][]byte{testPreimage[:]},
+	})
+	commitTx.AddTxOut(testSignDesc.Output)
+
+	commitment := &BroadcastCommitment{
+		CommitTx: commitTx,
+	}
+	if err := testLog.LogCommitment(commitment); err != nil {
+		t.Fatalf("unable to log commitment: %v", err)
+	}
+	diskCommitment, err := testLog.FetchCommitment()
+	if err != nil {
+		t.Fatalf("unable to fetch commitment: %v", err)
+	}
+
+	// The transaction is compared by its witness hash, as empty scripts
+	// may not be deserialized as nil.
+	diskTx := diskCommitment.CommitTx
+	if diskTx.WitnessHash() != commitTx.WitnessHash() {
+		t.Fatalf("commitment tx mismatch: expected %v, got %v",
+			spew.Sdump(commitTx), spew.Sdump(diskTx))
+	}
+
+	// Once the log is wiped, the commitment should no longer be found.
+	if err := testLog.WipeHistory(); err != nil {
+		t.Fatalf("unable to wipe log: %v", err)
+	}
+	_, err = testLog.FetchCommitment()
+	if err != errScopeBucketNoExist {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 // TestStateMutation tests that we're able to properly mutate the state of the
 // log, then retrieve that same mutated state from disk.
 func TestStateMutation(t *testing.T) {
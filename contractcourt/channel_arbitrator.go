@@ -207,6 +207,11 @@ type ChannelArbitrator struct {
 	// upon start up to decide which actions to take.
 	state ArbitratorState
 
+	// commitment is the commitment transaction we broadcast when going on
+	// chain. It is persisted within the log, and used to rebroadcast the
+	// commitment while it remains unconfirmed.
+	commitment *BroadcastCommitment
+
 	wg   sync.WaitGroup
 	quit chan struct{}
 }
@@ -256,6 +261,22 @@ func (c *ChannelArbitrator) Start() error {
 	log.Infof("ChannelArbitrator(%v): starting state=%v", c.cfg.ChanPoint,
 		c.state)
 
+	// If we broadcast our commitment before going down, we'll load it
+	// such that we're able to keep rebroadcasting it until it confirms.
+	if c.state == StateCommitmentBroadcasted {
+		c.commitment, err = c.log.FetchCommitment()
+		switch err {
+		case nil:
+		case errScopeBucketNoExist, errNoCommitment:
+			log.Warnf("ChannelArbitrator(%v): broadcast "+
+				"commitment not found, unable to rebroadcast "+
+				"it", c.cfg.ChanPoint)
+		default:
+			c.cfg.BlockEpochs.Cancel()
+			return err
+		}
+	}
+
 	_, bestHeight, err := c.cfg.ChainIO.GetBestBlock()
 	if err != nil {
 		c.cfg.BlockEpochs.Cancel()
@@ -551,6 +572,18 @@ func (c *ChannelArbitrator) stateStep(triggerHeight uint32,
 		}
 		closeTx = closeSummary.CloseTx
 
+		// Before broadcasting the commitment, we'll persist it, such
+		// that we're able to rebroadcast it across restarts until it
+		// confirms.
+		commitment := &BroadcastCommitment{
+			CommitTx: closeTx,
+		}
+		if err := c.log.LogCommitment(commitment); err != nil {
+			log.Errorf("ChannelArbitrator(%v): unable to log "+
+				"commitment: %v", c.cfg.ChanPoint, err)
+			return StateError, closeTx, err
+		}
+
 		// With the close transaction in hand, broadcast the
 		// transaction to the network, thereby entering the post
 		// channel resolution state.
@@ -570,6 +603,8 @@ func (c *ChannelArbitrator) stateStep(triggerHeight uint32,
 			}
 		}
 
+		c.commitment = commitment
+
 		if err := c.cfg.MarkCommitmentBroadcasted(); err != nil {
 			log.Errorf("ChannelArbitrator(%v): unable to "+
 				"mark commitment broadcasted: %v",
@@ -1364,6 +1399,49 @@ type signalUpdateMsg struct {
 	doneChan chan struct{}
 }
 
+// rebroadcastCommitment publishes our commitment transaction once again, as
+// long as it remains unconfirmed, and warns loudly once the deadline of one of
+// the HTLCs it carries is close.
+//
+// NOTE: The fee of the commitment can't be bumped. Replacing it (RBF) isn't
+// possible, as its fee rate was fixed with the last fee update signed by both
+// parties, and neither can a child pay for it (CPFP), as our output on our own
+// commitment is encumbered by a relative timelock, so it can't be spent before
+// the commitment confirms.
+func (c *ChannelArbitrator) rebroadcastCommitment(height uint32) {
+	if c.commitment == nil {
+		return
+	}
+	commitTx := c.commitment.CommitTx
+
+	err := c.cfg.PublishTx(commitTx)
+	if err != nil && err != lnwallet.ErrDoubleSpend {
+		log.Errorf("ChannelArbitrator(%v): unable to rebroadcast "+
+			"commitment %v: %v", c.cfg.ChanPoint, commitTx.TxHash(),
+			err)
+	}
+
+	htlcs := make([]channeldb.HTLC, 0, len(c.activeHTLCs.incomingHTLCs)+
+		len(c.activeHTLCs.outgoingHTLCs))
+	for _, htlc := range c.activeHTLCs.incomingHTLCs {
+		htlcs = append(htlcs, htlc)
+	}
+	for _, htlc := range c.activeHTLCs.outgoingHTLCs {
+		htlcs = append(htlcs, htlc)
+	}
+
+	for _, htlc := range htlcs {
+		if htlc.RefundTimeout > height+c.cfg.BroadcastDelta {
+			continue
+		}
+
+		log.Warnf("ChannelArbitrator(%v): commitment %v still "+
+			"unconfirmed at height %v, while htlc %x expires at "+
+			"height %v", c.cfg.ChanPoint, commitTx.TxHash(),
+			height, htlc.RHash[:], htlc.RefundTimeout)
+	}
+}
+
 // UpdateContractSignals updates the set of signals the ChannelArbitrator needs
 // to receive from a channel in real-time in order to keep in sync with the
 // latest state of the contract.
@@ -1416,6 +1494,13 @@ func (c *ChannelArbitrator) channelAttendant(bestHeight int32) {
 			}
 			bestHeight = blockEpoch.Height
 
+			// While our commitment remains unconfirmed, we'll make
+			// sure it doesn't get evicted from mempools.
+			if c.state == StateCommitmentBroadcasted {
+				c.rebroadcastCommitment(uint32(bestHeight))
+				continue
+			}
+
 			// If we're not in the default state, then we can
 			// ignore this signal as we're waiting for contract
 			// resolution.
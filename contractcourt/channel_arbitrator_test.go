@@ -20,6 +20,7 @@ type mockArbitratorLog struct {
 	failFetch       error
 	failCommit      bool
 	failCommitState ArbitratorState
+	commitment      *BroadcastCommitment
 }
 
 // A compile time check to ensure mockArbitratorLog meets the ArbitratorLog
@@ -82,6 +83,18 @@ func (b *mockArbitratorLog) FetchChainActions() (ChainActionMap, error) {
 	return actionsMap, nil
 }
 
+func (b *mockArbitratorLog) LogCommitment(c *BroadcastCommitment) error {
+	b.commitment = c
+	return nil
+}
+
+func (b *mockArbitratorLog) FetchCommitment() (*BroadcastCommitment, error) {
+	if b.commitment == nil {
+		return nil, errNoCommitment
+	}
+	return b.commitment, nil
+}
+
 func (b *mockArbitratorLog) WipeHistory() error {
 	return nil
 }
@@ -922,3 +935,173 @@ func TestChannelArbitratorEmptyResolutions(t *testing.T) {
 	}
 	chanArb.Stop()
 }
+
+// TestChannelArbitratorRebroadcastCommitment tests that the ChannelArbitrator
+// rebroadcasts our commitment on each new block while it remains unconfirmed.
+func TestChannelArbitratorRebroadcastCommitment(t *testing.T) {
+	log := &mockArbitratorLog{
+		state:     StateDefault,
+		newStates: make(chan ArbitratorState, 5),
+	}
+
+	chanArb, _, err := createTestChannelArbitrator(log)
+	if err != nil {
+		t.Fatalf("unable to create ChannelArbitrator: %v", err)
+	}
+
+	epochs := make(chan *chainntnfs.BlockEpoch)
+	chanArb.cfg.BlockEpochs.Epochs = epochs
+
+	published := make(chan *wire.MsgTx, 1)
+	chanArb.cfg.PublishTx = func(tx *wire.MsgTx) error {
+		published <- tx
+		return nil
+	}
+
+	if err := chanArb.Start(); err != nil {
+		t.Fatalf("unable to start ChannelArbitrator: %v", err)
+	}
+	defer chanArb.Stop()
+
+	errChan := make(chan error, 1)
+	respChan := make(chan *wire.MsgTx, 1)
+	chanArb.forceCloseReqs <- &forceCloseReq{
+		errResp: errChan,
+		closeTx: respChan,
+	}
+
+	assertStateTransitions(
+		t, log.newStates, StateBroadcastCommit,
+		StateCommitmentBroadcasted,
+	)
+
+	var commitTx *wire.MsgTx
+	select {
+	case commitTx = <-published:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("commitment not broadcast")
+	}
+
+	// With the commitment still unconfirmed, a new block should trigger
+	// a rebroadcast of the same transaction.
+	select {
+	case epochs <- &chainntnfs.BlockEpoch{Height: 100}:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("block epoch not received")
+	}
+
+	select {
+	case tx := <-published:
+		if tx.TxHash() != commitTx.TxHash() {
+			t.Fatalf("expected commitment %v to be rebroadcast, "+
+				"got %v", commitTx.TxHash(), tx.TxHash())
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("commitment not rebroadcast")
+	}
+}
+
+// TestChannelArbitratorCommitmentPersisted tests that the ChannelArbitrator
+// persists the commitment it broadcasts, such that it keeps rebroadcasting it
+// across restarts until it confirms.
+func TestChannelArbitratorCommitmentPersisted(t *testing.T) {
+	log := &mockArbitratorLog{
+		state:     StateDefault,
+		newStates: make(chan ArbitratorState, 5),
+	}
+
+	closeTx := wire.NewMsgTx(2)
+	closeTx.AddTxIn(&wire.TxIn{})
+	closeTx.AddTxOut(&wire.TxOut{Value: 100000, PkScript: make([]byte, 22)})
+
+	newArbitrator := func() (*ChannelArbitrator, chan *wire.MsgTx,
+		chan *chainntnfs.BlockEpoch) {
+
+		chanArb, _, err := createTestChannelArbitrator(log)
+		if err != nil {
+			t.Fatalf("unable to create ChannelArbitrator: %v", err)
+		}
+
+		epochs := make(chan *chainntnfs.BlockEpoch)
+		chanArb.cfg.BlockEpochs.Epochs = epochs
+
+		published := make(chan *wire.MsgTx, 1)
+		chanArb.cfg.PublishTx = func(tx *wire.MsgTx) error {
+			published <- tx
+			return nil
+		}
+
+		chanArb.cfg.ForceCloseChan = func() (
+			*lnwallet.LocalForceCloseSummary, error) {
+
+			return &lnwallet.LocalForceCloseSummary{
+				CloseTx:         closeTx,
+				HtlcResolutions: &lnwallet.HtlcResolutions{},
+			}, nil
+		}
+
+		if err := chanArb.Start(); err != nil {
+			t.Fatalf("unable to start ChannelArbitrator: %v", err)
+		}
+
+		return chanArb, published, epochs
+	}
+
+	sendEpoch := func(epochs chan *chainntnfs.BlockEpoch, height int32) {
+		select {
+		case epochs <- &chainntnfs.BlockEpoch{Height: height}:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("block epoch not received")
+		}
+	}
+
+	assertPublished := func(published chan *wire.MsgTx) {
+		select {
+		case tx := <-published:
+			if tx.TxHash() != closeTx.TxHash() {
+				t.Fatalf("expected commitment %v to be "+
+					"published, got %v", closeTx.TxHash(),
+					tx.TxHash())
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("commitment not published")
+		}
+	}
+
+	chanArb, published, epochs := newArbitrator()
+
+	errChan := make(chan error, 1)
+	respChan := make(chan *wire.MsgTx, 1)
+	chanArb.forceCloseReqs <- &forceCloseReq{
+		errResp: errChan,
+		closeTx: respChan,
+	}
+
+	assertStateTransitions(
+		t, log.newStates, StateBroadcastCommit,
+		StateCommitmentBroadcasted,
+	)
+	assertPublished(published)
+
+	// The commitment should have been persisted before it was broadcast.
+	if log.commitment == nil ||
+		log.commitment.CommitTx.TxHash() != closeTx.TxHash() {
+
+		t.Fatalf("commitment not logged")
+	}
+
+	sendEpoch(epochs, 100)
+	assertPublished(published)
+
+	if err := chanArb.Stop(); err != nil {
+		t.Fatalf("unable to stop ChannelArbitrator: %v", err)
+	}
+
+	// Finally, we'll restart the arbitrator, which should pick up the
+	// persisted commitment and keep rebroadcasting it.
+	chanArb, published, epochs = newArbitrator()
+	defer chanArb.Stop()
+
+	sendEpoch(epochs, 101)
+	assertPublished(published)
+}
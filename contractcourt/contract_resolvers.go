@@ -14,6 +14,7 @@ import (
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/sweep"
 )
 
 var (
@@ -1251,62 +1252,61 @@ func (c *commitSweepResolver) Resolve() (ContractResolver, error) {
 	// party broadcast the commitment transaction then we'll create it now.
 	case c.sweepTx == nil && !isLocalCommitTx:
 		// Now that the commitment transaction has confirmed, we'll
-		// craft a transaction to sweep this output into the wallet.
-		signDesc := c.commitResolution.SelfOutputSignDesc
-
-		// First, we'll estimate the total weight so we can compute
-		// fees properly. We'll use a lax estimate, as this output is
-		// in no immediate danger.
-		feePerKw, err := c.FeeEstimator.EstimateFeePerKW(6)
+		// hand the output to the sweeper, which sweeps it into the
+		// wallet. We'll use a lax confirmation target, as this output
+		// is in no immediate danger.
+		input := sweep.NewBaseInput(
+			&c.commitResolution.SelfOutPoint,
+			lnwallet.CommitmentNoDelay,
+			&c.commitResolution.SelfOutputSignDesc,
+		)
+		resultChan, err := c.Sweeper.SweepInput(
+			input, sweep.DefaultConfTarget, c.broadcastHeight,
+		)
 		if err != nil {
+			log.Errorf("%T(%v): unable to sweep commit output: %v",
+				c, c.chanPoint, err)
 			return nil, err
 		}
 
-		log.Debugf("%T(%v): using %v sat/kw for sweep tx", c,
-			c.chanPoint, int64(feePerKw))
-
-		totalWeight := (&lnwallet.TxWeightEstimator{}).
-			AddP2WKHInput().
-			AddP2WKHOutput().Weight()
-		totalFees := feePerKw.FeeForWeight(int64(totalWeight))
-		sweepAmt := signDesc.Output.Value - int64(totalFees)
+		log.Infof("%T(%v): waiting for commit output to be swept", c,
+			c.chanPoint)
 
-		c.sweepTx = wire.NewMsgTx(2)
-		c.sweepTx.AddTxIn(&wire.TxIn{
-			PreviousOutPoint: c.commitResolution.SelfOutPoint,
-		})
-		sweepAddr, err := c.NewSweepAddr()
-		if err != nil {
-			return nil, err
-		}
-		c.sweepTx.AddTxOut(&wire.TxOut{
-			PkScript: sweepAddr,
-			Value:    sweepAmt,
-		})
-
-		// With the transaction fully assembled, we can now generate a
-		// valid witness for the transaction.
-		signDesc.SigHashes = txscript.NewTxSigHashes(c.sweepTx)
-		c.sweepTx.TxIn[0].Witness, err = lnwallet.CommitSpendNoDelay(
-			c.Signer, &signDesc, c.sweepTx,
-		)
-		if err != nil {
-			return nil, err
-		}
+		// Once the output has been swept, we consider the spending
+		// transaction to be our sweep transaction.
+		select {
+		case result := <-resultChan:
+			switch result.Err {
+			case nil:
+
+			// The output was spent by a transaction the sweeper
+			// doesn't know about, such as our own sweep
+			// transaction broadcast before a restart. Either way
+			// the output is gone, so we'll wait for that
+			// transaction instead.
+			case sweep.ErrRemoteSpend:
+				log.Warnf("%T(%v): commit output spent by "+
+					"foreign tx %v", c, c.chanPoint,
+					result.Tx.TxHash())
+
+			default:
+				log.Errorf("%T(%v): unable to sweep commit "+
+					"output: %v", c, c.chanPoint,
+					result.Err)
+				return nil, result.Err
+			}
 
-		log.Infof("%T(%v): sweeping commit output with tx=%v", c,
-			c.chanPoint, spew.Sdump(c.sweepTx))
+			c.sweepTx = result.Tx
 
-		// Finally, we'll broadcast the sweep transaction to the
-		// network.
-		if err := c.PublishTx(c.sweepTx); err != nil {
-			log.Errorf("%T(%v): unable to publish sweep tx: %v",
-				c, c.chanPoint, err)
-			return nil, err
+		case <-c.Quit:
+			return nil, fmt.Errorf("quitting")
 		}
 
-		// With the sweep transaction confirmed, we'll now Checkpoint
-		// our state.
+		log.Infof("%T(%v): commit output swept by txid=%v", c,
+			c.chanPoint, c.sweepTx.TxHash())
+
+		// With the sweep transaction found, we'll now Checkpoint our
+		// state.
 		if err := c.Checkpoint(c); err != nil {
 			log.Errorf("unable to Checkpoint: %v", err)
 		}
@@ -22,6 +22,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/multimutex"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
 )
 
 var (
@@ -145,6 +146,22 @@ type Config struct {
 	// TODO(roasbeef): extract ann crafting + sign from fundingMgr into
 	// here?
 	AnnSigner lnwallet.MessageSigner
+
+	// NumActiveSyncers is the number of peers for which we should have
+	// active syncers with. After reaching NumActiveSyncers, any future
+	// gossip syncers will be passive.
+	NumActiveSyncers int
+
+	// RotateTicker is a ticker responsible for notifying the SyncManager
+	// when it should rotate its active syncers. A single active syncer
+	// with a chansSynced state will be exchanged for a passive syncer in
+	// order to ensure we don't keep syncing with the same peers.
+	RotateTicker ticker.Ticker
+
+	// HistoricalSyncTicker is a ticker responsible for notifying the
+	// SyncManager when it should attempt a historical sync with a random
+	// gossip syncer.
+	HistoricalSyncTicker ticker.Ticker
 }
 
 // AuthenticatedGossiper is a subsystem which is responsible for receiving
@@ -221,13 +238,13 @@ type AuthenticatedGossiper struct {
 	rejectMtx     sync.RWMutex
 	recentRejects map[uint64]struct{}
 
-	// peerSyncers keeps track of all the gossip syncers we're maintain for
-	// peers that understand this mode of operation. When we go to send out
-	// new updates, for all peers in the map, we'll send the messages
-	// directly to their gossiper, rather than broadcasting them. With this
-	// change, we ensure we filter out all updates properly.
-	syncerMtx   sync.RWMutex
-	peerSyncers map[routing.Vertex]*gossipSyncer
+	// syncMgr is a subsystem responsible for managing the gossip syncers
+	// for peers that understand this mode of operation. When we go to send
+	// out new updates, for all peers with a gossip syncer, we'll send the
+	// messages directly to their gossip syncer, rather than broadcasting
+	// them. With this change, we ensure we filter out all updates
+	// properly.
+	syncMgr *SyncManager
 
 	sync.Mutex
 }
@@ -251,7 +268,13 @@ func New(cfg Config, selfKey *btcec.PublicKey) (*AuthenticatedGossiper, error) {
 		waitingProofs:           storage,
 		channelMtx:              multimutex.NewMutex(),
 		recentRejects:           make(map[uint64]struct{}),
-		peerSyncers:             make(map[routing.Vertex]*gossipSyncer),
+		syncMgr: newSyncManager(&SyncManagerCfg{
+			ChainHash:            cfg.ChainHash,
+			ChanSeries:           cfg.ChanSeries,
+			NumActiveSyncers:     cfg.NumActiveSyncers,
+			RotateTicker:         cfg.RotateTicker,
+			HistoricalSyncTicker: cfg.HistoricalSyncTicker,
+		}),
 	}, nil
 }
 
@@ -440,6 +463,8 @@ func (d *AuthenticatedGossiper) Start() error {
 		return err
 	}
 
+	d.syncMgr.Start()
+
 	d.wg.Add(1)
 	go d.networkHandler()
 
@@ -456,11 +481,7 @@ func (d *AuthenticatedGossiper) Stop() {
 
 	d.blockEpochs.Cancel()
 
-	d.syncerMtx.RLock()
-	for _, syncer := range d.peerSyncers {
-		syncer.Stop()
-	}
-	d.syncerMtx.RUnlock()
+	d.syncMgr.Stop()
 
 	close(d.quit)
 	d.wg.Wait()
@@ -916,9 +937,7 @@ func (d *AuthenticatedGossiper) findGossipSyncer(pub *btcec.PublicKey) (
 	target := routing.NewVertex(pub)
 
 	// First, we'll try to find an existing gossiper for this peer.
-	d.syncerMtx.RLock()
-	syncer, ok := d.peerSyncers[target]
-	d.syncerMtx.RUnlock()
+	syncer, ok := d.syncMgr.GossipSyncer(target)
 
 	// If one exists, then we'll return it directly.
 	if ok {
@@ -1168,12 +1187,7 @@ func (d *AuthenticatedGossiper) networkHandler() {
 			// For the set of peers that have an active gossip
 			// syncers, we'll collect their pubkeys so we can avoid
 			// sending them the full message blast below.
-			d.syncerMtx.RLock()
-			syncerPeers := make(map[routing.Vertex]*gossipSyncer)
-			for peerPub, syncer := range d.peerSyncers {
-				syncerPeers[peerPub] = syncer
-			}
-			d.syncerMtx.RUnlock()
+			syncerPeers := d.syncMgr.GossipSyncers()
 
 			log.Infof("Broadcasting batch of %v new announcements",
 				len(announcementBatch))
@@ -1234,56 +1248,20 @@ func (d *AuthenticatedGossiper) networkHandler() {
 func (d *AuthenticatedGossiper) InitSyncState(syncPeer lnpeer.Peer,
 	recvUpdates bool) {
 
-	d.syncerMtx.Lock()
-	defer d.syncerMtx.Unlock()
-
-	// If we already have a syncer, then we'll exit early as we don't want
-	// to override it.
-	nodeID := routing.Vertex(syncPeer.PubKey())
-	if _, ok := d.peerSyncers[nodeID]; ok {
-		return
-	}
-
-	log.Infof("Creating new gossipSyncer for peer=%x", nodeID[:])
-
-	encoding := lnwire.EncodingSortedPlain
-	syncer := newGossiperSyncer(gossipSyncerCfg{
-		chainHash:       d.cfg.ChainHash,
-		syncChanUpdates: recvUpdates,
-		channelSeries:   d.cfg.ChanSeries,
-		encodingType:    encoding,
-		chunkSize:       encodingTypeToChunkSize[encoding],
-		sendToPeer: func(msgs ...lnwire.Message) error {
-			return syncPeer.SendMessage(false, msgs...)
-		},
-	})
-	copy(syncer.peerPub[:], nodeID[:])
-	d.peerSyncers[nodeID] = syncer
-
-	syncer.Start()
+	d.syncMgr.InitSyncState(syncPeer, recvUpdates)
 }
 
 // PruneSyncState is called by outside sub-systems once a peer that we were
 // previously connected to has been disconnected. In this case we can stop the
 // existing gossipSyncer assigned to the peer and free up resources.
 func (d *AuthenticatedGossiper) PruneSyncState(peer *btcec.PublicKey) {
-	d.syncerMtx.Lock()
-	defer d.syncerMtx.Unlock()
-
-	log.Infof("Removing gossipSyncer for peer=%x",
-		peer.SerializeCompressed())
-
-	vertex := routing.NewVertex(peer)
-	syncer, ok := d.peerSyncers[vertex]
-	if !ok {
-		return
-	}
-
-	syncer.Stop()
-
-	delete(d.peerSyncers, vertex)
+	d.syncMgr.PruneSyncState(routing.NewVertex(peer))
+}
 
-	return
+// SyncManager returns the gossiper's SyncManager instance, which manages the
+// gossip syncers of all peers.
+func (d *AuthenticatedGossiper) SyncManager() *SyncManager {
+	return d.syncMgr
 }
 
 // isRecentlyRejectedMsg returns true if we recently rejected a message, and
@@ -1929,6 +1907,46 @@ func (d *AuthenticatedGossiper) processNetworkAnnouncement(
 		chanInfo, _, _, err := d.cfg.Router.GetChannelByID(msg.ShortChannelID)
 		if err != nil {
 			switch err {
+			case channeldb.ErrZombieEdge:
+				// The channel was previously pruned as a
+				// zombie. As we've deemed the update as fresh
+				// above, it's able to resurrect the channel as
+				// long as it's been signed by the correct
+				// party, which we'll verify against the node
+				// keys stored within the zombie index.
+				err := validateZombieUpdate(chanInfo, msg)
+				if err != nil {
+					log.Debug(err)
+					nMsg.err <- err
+					return nil
+				}
+
+				// With the signature valid, we'll mark the
+				// edge as live and wait for its announcement
+				// to come through again.
+				err = d.cfg.Router.MarkEdgeLive(
+					msg.ShortChannelID,
+				)
+				if err != nil {
+					err := fmt.Errorf("unable to remove "+
+						"short_chan_id=%v from zombie "+
+						"index: %v", shortChanID, err)
+					log.Error(err)
+					nMsg.err <- err
+					return nil
+				}
+
+				log.Debugf("Removed edge with "+
+					"short_chan_id=%v from zombie index",
+					shortChanID)
+
+				// We'll fallthrough to stash the update until
+				// we receive its corresponding
+				// ChannelAnnouncement, as the edge needs to
+				// exist within the graph before the update
+				// can be applied.
+				fallthrough
+
 			case channeldb.ErrGraphNotFound:
 				fallthrough
 			case channeldb.ErrGraphNoEdgesFound:
@@ -2560,3 +2578,34 @@ func (d *AuthenticatedGossiper) updateChannel(info *channeldb.ChannelEdgeInfo,
 
 	return chanAnn, chanUpdate, err
 }
+
+// validateZombieUpdate determines whether the given ChannelUpdate for a
+// channel marked as a zombie has been signed by the node on the side of the
+// channel it claims to update, as recorded within the zombie index.
+func validateZombieUpdate(zombieInfo *channeldb.ChannelEdgeInfo,
+	msg *lnwire.ChannelUpdate) error {
+
+	// The least-significant bit in the flag on the channel update tells
+	// us which edge is being updated.
+	var (
+		pubKey *btcec.PublicKey
+		err    error
+	)
+	switch {
+	case msg.Flags&lnwire.ChanUpdateDirection == 0:
+		pubKey, err = zombieInfo.NodeKey1()
+	default:
+		pubKey, err = zombieInfo.NodeKey2()
+	}
+	if err != nil {
+		return fmt.Errorf("unable to parse node key of zombie "+
+			"short_chan_id=%v: %v", msg.ShortChannelID, err)
+	}
+
+	if err := routing.ValidateChannelUpdateAnn(pubKey, msg); err != nil {
+		return fmt.Errorf("unable to validate channel update for "+
+			"zombie short_chan_id=%v: %v", msg.ShortChannelID, err)
+	}
+
+	return nil
+}
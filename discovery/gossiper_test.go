@@ -29,6 +29,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
 )
 
 var (
@@ -62,6 +63,10 @@ var (
 	trickleDelay     = time.Millisecond * 100
 	retransmitDelay  = time.Hour * 1
 	proofMatureDelta uint32
+
+	// zombieExpiry is the duration after which the mock router no longer
+	// considers the policies of zombie channels as fresh.
+	zombieExpiry = time.Hour * 24 * 14
 )
 
 // makeTestDB creates a new instance of the ChannelDB for testing purposes. A
@@ -113,7 +118,10 @@ type mockGraphSource struct {
 	nodes      []*channeldb.LightningNode
 	infos      map[uint64]*channeldb.ChannelEdgeInfo
 	edges      map[uint64][]*channeldb.ChannelEdgePolicy
+	zombies    map[uint64][][33]byte
 	bestHeight uint32
+
+	mu sync.Mutex
 }
 
 func newMockRouter(height uint32) *mockGraphSource {
@@ -121,6 +129,7 @@ func newMockRouter(height uint32) *mockGraphSource {
 		bestHeight: height,
 		infos:      make(map[uint64]*channeldb.ChannelEdgeInfo),
 		edges:      make(map[uint64][]*channeldb.ChannelEdgePolicy),
+		zombies:    make(map[uint64][][33]byte),
 	}
 }
 
@@ -186,7 +195,18 @@ func (r *mockGraphSource) GetChannelByID(chanID lnwire.ShortChannelID) (
 
 	chanInfo, ok := r.infos[chanID.ToUint64()]
 	if !ok {
-		return nil, nil, nil, channeldb.ErrEdgeNotFound
+		r.mu.Lock()
+		pubKeys, isZombie := r.zombies[chanID.ToUint64()]
+		r.mu.Unlock()
+
+		if !isZombie {
+			return nil, nil, nil, channeldb.ErrEdgeNotFound
+		}
+
+		return &channeldb.ChannelEdgeInfo{
+			NodeKey1Bytes: pubKeys[0],
+			NodeKey2Bytes: pubKeys[1],
+		}, nil, nil, channeldb.ErrZombieEdge
 	}
 
 	edges := r.edges[chanID.ToUint64()]
@@ -217,8 +237,12 @@ func (r *mockGraphSource) IsStaleNode(nodePub routing.Vertex, timestamp time.Tim
 // IsKnownEdge returns true if the graph source already knows of the passed
 // channel ID.
 func (r *mockGraphSource) IsKnownEdge(chanID lnwire.ShortChannelID) bool {
+	r.mu.Lock()
+	_, isZombie := r.zombies[chanID.ToUint64()]
+	r.mu.Unlock()
+
 	_, ok := r.infos[chanID.ToUint64()]
-	return ok
+	return ok || isZombie
 }
 
 // IsStaleEdgePolicy returns true if the graph source has a channel edge for
@@ -226,6 +250,16 @@ func (r *mockGraphSource) IsKnownEdge(chanID lnwire.ShortChannelID) bool {
 func (r *mockGraphSource) IsStaleEdgePolicy(chanID lnwire.ShortChannelID,
 	timestamp time.Time, flags lnwire.ChanUpdateFlag) bool {
 
+	r.mu.Lock()
+	_, isZombie := r.zombies[chanID.ToUint64()]
+	r.mu.Unlock()
+
+	// Policies of zombie channels are only considered fresh if they're
+	// recent enough to resurrect the channel.
+	if isZombie {
+		return time.Since(timestamp) > zombieExpiry
+	}
+
 	edges, ok := r.edges[chanID.ToUint64()]
 	if !ok {
 		return false
@@ -244,6 +278,39 @@ func (r *mockGraphSource) IsStaleEdgePolicy(chanID lnwire.ShortChannelID,
 	}
 }
 
+// MarkEdgeLive clears an edge from our zombie index, deeming it as live.
+func (r *mockGraphSource) MarkEdgeLive(chanID lnwire.ShortChannelID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.zombies[chanID.ToUint64()]; !ok {
+		return channeldb.ErrZombieEdgeNotFound
+	}
+	delete(r.zombies, chanID.ToUint64())
+
+	return nil
+}
+
+// markEdgeZombie marks the edge as a zombie, as if it had been pruned by the
+// router.
+func (r *mockGraphSource) markEdgeZombie(chanID lnwire.ShortChannelID,
+	pubKey1, pubKey2 [33]byte) {
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.zombies[chanID.ToUint64()] = [][33]byte{pubKey1, pubKey2}
+}
+
+// isZombieEdge returns whether the edge is currently marked as a zombie.
+func (r *mockGraphSource) isZombieEdge(chanID lnwire.ShortChannelID) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	_, ok := r.zombies[chanID.ToUint64()]
+	return ok
+}
+
 type mockNotifier struct {
 	clientCounter uint32
 	epochClients  map[uint32]chan *chainntnfs.BlockEpoch
@@ -555,6 +622,13 @@ func createTestCtx(startHeight uint32) (*testCtx, func(), error) {
 		RetransmitDelay:  retransmitDelay,
 		ProofMatureDelta: proofMatureDelta,
 		DB:               db,
+		RotateTicker: ticker.MockNew(
+			DefaultSyncerRotationInterval,
+		),
+		HistoricalSyncTicker: ticker.MockNew(
+			DefaultHistoricalSyncInterval,
+		),
+		NumActiveSyncers: 3,
 	}, nodeKeyPub1)
 	if err != nil {
 		cleanUpDb()
@@ -1449,6 +1523,13 @@ func TestSignatureAnnouncementRetryAtStartup(t *testing.T) {
 		RetransmitDelay:  retransmitDelay,
 		ProofMatureDelta: proofMatureDelta,
 		DB:               ctx.gossiper.cfg.DB,
+		RotateTicker: ticker.MockNew(
+			DefaultSyncerRotationInterval,
+		),
+		HistoricalSyncTicker: ticker.MockNew(
+			DefaultHistoricalSyncInterval,
+		),
+		NumActiveSyncers: 3,
 	}, ctx.gossiper.selfKey)
 	if err != nil {
 		t.Fatalf("unable to recreate gossiper: %v", err)
@@ -2154,6 +2235,116 @@ func TestReceiveRemoteChannelUpdateFirst(t *testing.T) {
 	}
 }
 
+// TestProcessZombieEdgeNowLive ensures that announcements for channels marked
+// as zombies are ignored, and that a fresh ChannelUpdate signed by the correct
+// node resurrects the channel, allowing its announcement to be processed once
+// again.
+func TestProcessZombieEdgeNowLive(t *testing.T) {
+	t.Parallel()
+
+	ctx, cleanup, err := createTestCtx(0)
+	if err != nil {
+		t.Fatalf("can't create context: %v", err)
+	}
+	defer cleanup()
+
+	remotePeer := &mockPeer{nodeKeyPriv2.PubKey(), nil, nil}
+
+	chanAnn, err := createRemoteChannelAnnouncement(0)
+	if err != nil {
+		t.Fatalf("unable to create chan ann: %v", err)
+	}
+	chanID := chanAnn.ShortChannelID
+
+	// We'll start by marking the channel as a zombie, as if it had been
+	// pruned by the router.
+	ctx.router.markEdgeZombie(chanID, chanAnn.NodeID1, chanAnn.NodeID2)
+
+	processAnn := func(msg lnwire.Message) chan error {
+		t.Helper()
+
+		return ctx.gossiper.ProcessRemoteAnnouncement(msg, remotePeer)
+	}
+	assertProcessed := func(errChan chan error, expectErr bool) {
+		t.Helper()
+
+		select {
+		case err := <-errChan:
+			if expectErr && err == nil {
+				t.Fatalf("expected announcement to be rejected")
+			}
+			if !expectErr && err != nil {
+				t.Fatalf("unable to process announcement: %v",
+					err)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("did not process remote announcement")
+		}
+	}
+
+	// The channel announcement should be ignored without being added to
+	// the graph, as the channel is a zombie.
+	assertProcessed(processAnn(chanAnn), false)
+	if _, ok := ctx.router.infos[chanID.ToUint64()]; ok {
+		t.Fatalf("zombie channel announcement was added to the graph")
+	}
+
+	// A stale update for the channel shouldn't resurrect it.
+	staleTimestamp := time.Now().Add(-2 * zombieExpiry).Unix()
+	staleUpdate, err := createUpdateAnnouncement(
+		0, 0, nodeKeyPriv1, uint32(staleTimestamp),
+	)
+	if err != nil {
+		t.Fatalf("unable to create chan update: %v", err)
+	}
+	assertProcessed(processAnn(staleUpdate), false)
+	if !ctx.router.isZombieEdge(chanID) {
+		t.Fatalf("stale update resurrected zombie channel")
+	}
+
+	// Neither should a fresh update that isn't signed by the node on the
+	// side of the channel it claims to update.
+	timestamp := uint32(time.Now().Unix())
+	badUpdate, err := createUpdateAnnouncement(
+		0, 0, nodeKeyPriv2, timestamp,
+	)
+	if err != nil {
+		t.Fatalf("unable to create chan update: %v", err)
+	}
+	assertProcessed(processAnn(badUpdate), true)
+	if !ctx.router.isZombieEdge(chanID) {
+		t.Fatalf("update with invalid signature resurrected zombie " +
+			"channel")
+	}
+
+	// A fresh update signed by the correct node should mark the channel
+	// as live. As the edge itself no longer exists within the graph, the
+	// update should be held until the channel is announced once again.
+	freshUpdate, err := createUpdateAnnouncement(
+		0, 0, nodeKeyPriv1, timestamp,
+	)
+	if err != nil {
+		t.Fatalf("unable to create chan update: %v", err)
+	}
+	updateErr := processAnn(freshUpdate)
+	select {
+	case err := <-updateErr:
+		t.Fatalf("expected update to be held, got: %v", err)
+	case <-time.After(2 * trickleDelay):
+	}
+	if ctx.router.isZombieEdge(chanID) {
+		t.Fatalf("fresh update didn't resurrect zombie channel")
+	}
+
+	// Now that the channel is live again, its announcement should be
+	// added to the graph, followed by the update we held onto.
+	assertProcessed(processAnn(chanAnn), false)
+	if _, ok := ctx.router.infos[chanID.ToUint64()]; !ok {
+		t.Fatalf("resurrected channel wasn't added to the graph")
+	}
+	assertProcessed(updateErr, false)
+}
+
 // TestExtraDataChannelAnnouncementValidation tests that we're able to properly
 // validate a ChannelAnnouncement that includes opaque bytes that we don't
 // currently know of.
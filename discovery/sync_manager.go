@@ -0,0 +1,430 @@
+package discovery
+
+import (
+	prand "math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnpeer"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// DefaultNumActiveSyncers is the default number of peers we'll
+	// receive new graph updates from at any given time.
+	DefaultNumActiveSyncers = 3
+
+	// DefaultSyncerRotationInterval is the default interval at which we'll
+	// rotate a single active syncer for a passive one.
+	DefaultSyncerRotationInterval = 20 * time.Minute
+
+	// DefaultHistoricalSyncInterval is the default interval at which we'll
+	// perform a historical sync with a random peer to fill any gaps in our
+	// graph.
+	DefaultHistoricalSyncInterval = 20 * time.Minute
+)
+
+// SyncManagerCfg contains all of the dependencies required for the SyncManager
+// to carry out its duties.
+type SyncManagerCfg struct {
+	// ChainHash is a hash that indicates the specific network of the
+	// active chain.
+	ChainHash chainhash.Hash
+
+	// ChanSeries is an interface that provides access to a time series
+	// view of the current known channel graph. Each gossipSyncer will
+	// utilize this in order to create and respond to channel graph time
+	// series queries.
+	ChanSeries ChannelGraphTimeSeries
+
+	// NumActiveSyncers is the number of peers for which we should have
+	// active syncers with. After reaching NumActiveSyncers, any future
+	// gossip syncers will be passive.
+	NumActiveSyncers int
+
+	// RotateTicker is a ticker responsible for notifying the SyncManager
+	// when it should rotate its active syncers. A single active syncer
+	// with a chansSynced state will be exchanged for a passive syncer in
+	// order to ensure we don't keep syncing with the same peers.
+	RotateTicker ticker.Ticker
+
+	// HistoricalSyncTicker is a ticker responsible for notifying the
+	// SyncManager when it should attempt a historical sync with a random
+	// gossip syncer.
+	HistoricalSyncTicker ticker.Ticker
+}
+
+// SyncManager is a subsystem of the gossiper that manages the gossip syncers
+// for peers currently connected. When a new peer is connected, the manager
+// will create its accompanying gossip syncer and determine whether it should
+// have an ActiveSync or PassiveSync sync type based on how many other gossip
+// syncers are currently active. The set of active syncers is periodically
+// rotated, so that we don't keep receiving updates from the same peers. The
+// first gossip syncer registered with the SyncManager will attempt a
+// historical sync to ensure we have as much of the public channel graph as
+// possible, after which historical syncs are periodically performed with a
+// random peer to fill any gaps in our graph.
+type SyncManager struct {
+	started uint32
+	stopped uint32
+
+	cfg SyncManagerCfg
+
+	// historicalSynced is set once the first historical sync has been
+	// requested, which happens as soon as the first syncer is created.
+	historicalSynced bool
+
+	// activeSyncers is the set of all syncers for which we are currently
+	// receiving graph updates from. The number of possible active syncers
+	// is bounded by NumActiveSyncers.
+	activeSyncers map[routing.Vertex]*gossipSyncer
+
+	// inactiveSyncers is the set of all syncers for which we are not
+	// currently receiving new graph updates from.
+	inactiveSyncers map[routing.Vertex]*gossipSyncer
+
+	syncersMtx sync.RWMutex
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// newSyncManager constructs a new SyncManager backed by the given config.
+func newSyncManager(cfg *SyncManagerCfg) *SyncManager {
+	return &SyncManager{
+		cfg: *cfg,
+		activeSyncers: make(
+			map[routing.Vertex]*gossipSyncer, cfg.NumActiveSyncers,
+		),
+		inactiveSyncers: make(map[routing.Vertex]*gossipSyncer),
+		quit:            make(chan struct{}),
+	}
+}
+
+// Start starts the SyncManager in order to properly carry out its duties.
+func (m *SyncManager) Start() {
+	if !atomic.CompareAndSwapUint32(&m.started, 0, 1) {
+		return
+	}
+
+	m.wg.Add(1)
+	go m.syncerHandler()
+}
+
+// Stop stops the SyncManager from performing its duties, along with all of
+// the gossip syncers it manages.
+func (m *SyncManager) Stop() {
+	if !atomic.CompareAndSwapUint32(&m.stopped, 0, 1) {
+		return
+	}
+
+	close(m.quit)
+	m.wg.Wait()
+
+	m.syncersMtx.Lock()
+	defer m.syncersMtx.Unlock()
+
+	for _, syncer := range m.inactiveSyncers {
+		syncer.Stop()
+	}
+	for _, syncer := range m.activeSyncers {
+		syncer.Stop()
+	}
+}
+
+// syncerHandler is the SyncManager's main event loop responsible for rotating
+// the active syncers and performing periodic historical syncs.
+//
+// NOTE: This must be run as a goroutine.
+func (m *SyncManager) syncerHandler() {
+	defer m.wg.Done()
+
+	m.cfg.RotateTicker.Resume()
+	defer m.cfg.RotateTicker.Stop()
+
+	m.cfg.HistoricalSyncTicker.Resume()
+	defer m.cfg.HistoricalSyncTicker.Stop()
+
+	for {
+		select {
+		// Our RotateTicker has ticked, so we'll attempt to rotate a
+		// single active syncer with a passive one.
+		case <-m.cfg.RotateTicker.Ticks():
+			m.rotateActiveSyncerCandidate()
+
+		// Our HistoricalSyncTicker has ticked, so we'll randomly
+		// select a peer and force a historical sync with them.
+		case <-m.cfg.HistoricalSyncTicker.Ticks():
+			m.forceHistoricalSync()
+
+		case <-m.quit:
+			return
+		}
+	}
+}
+
+// rotateActiveSyncerCandidate rotates a single active syncer. In order to
+// achieve this, the active syncer must be in a chansSynced state, so that it
+// has completed its initial reconciliation with the remote peer.
+func (m *SyncManager) rotateActiveSyncerCandidate() {
+	m.syncersMtx.Lock()
+	defer m.syncersMtx.Unlock()
+
+	// If we don't have a passive syncer that may receive updates to
+	// rotate in, there's nothing to do.
+	newActiveSyncer := chooseRandomSyncer(
+		m.inactiveSyncers, canReceiveUpdates,
+	)
+	if newActiveSyncer == nil {
+		log.Debug("No eligible candidate to rotate active syncer")
+		return
+	}
+
+	// We'll choose an active syncer at random that's within a chansSynced
+	// state to rotate.
+	activeSyncer := chooseRandomSyncer(
+		m.activeSyncers, func(s *gossipSyncer) bool {
+			return s.SyncState() == chansSynced
+		},
+	)
+	if activeSyncer == nil {
+		log.Debug("No eligible active syncer to rotate")
+		return
+	}
+
+	log.Debugf("Rotating active GossipSyncer(%x) with GossipSyncer(%x)",
+		activeSyncer.peerPub[:], newActiveSyncer.peerPub[:])
+
+	activeSyncer.SetSyncType(PassiveSync)
+	delete(m.activeSyncers, activeSyncer.peerPub)
+	m.inactiveSyncers[activeSyncer.peerPub] = activeSyncer
+
+	newActiveSyncer.SetSyncType(ActiveSync)
+	delete(m.inactiveSyncers, newActiveSyncer.peerPub)
+	m.activeSyncers[newActiveSyncer.peerPub] = newActiveSyncer
+}
+
+// forceHistoricalSync chooses a syncer with a remote peer at random and forces
+// a historical sync with it.
+func (m *SyncManager) forceHistoricalSync() {
+	m.syncersMtx.RLock()
+	defer m.syncersMtx.RUnlock()
+
+	// We'll choose a random peer with whom we can perform a historical
+	// sync with, preferring passive syncers as to not further burden the
+	// active ones.
+	s := chooseRandomSyncer(m.inactiveSyncers, nil)
+	if s == nil {
+		s = chooseRandomSyncer(m.activeSyncers, nil)
+	}
+	if s == nil {
+		log.Debug("No eligible candidate for historical sync")
+		return
+	}
+
+	log.Debugf("Performing historical sync with GossipSyncer(%x)",
+		s.peerPub[:])
+
+	s.SyncHistorical()
+}
+
+// chooseRandomSyncer returns a random syncer of the passed set that satisfies
+// the given predicate, or nil if there isn't any. A nil predicate matches any
+// syncer.
+func chooseRandomSyncer(syncers map[routing.Vertex]*gossipSyncer,
+	predicate func(*gossipSyncer) bool) *gossipSyncer {
+
+	candidates := make([]*gossipSyncer, 0, len(syncers))
+	for _, s := range syncers {
+		if predicate != nil && !predicate(s) {
+			continue
+		}
+		candidates = append(candidates, s)
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	return candidates[prand.Intn(len(candidates))]
+}
+
+// canReceiveUpdates returns whether the passed syncer is allowed to receive
+// new graph updates from its remote peer, and is thus eligible to become an
+// active syncer.
+func canReceiveUpdates(s *gossipSyncer) bool {
+	return s.cfg.syncChanUpdates
+}
+
+// InitSyncState is called by outside sub-systems when a connection is
+// established to a new peer that understands how to perform channel range
+// queries. We'll allocate a new gossip syncer for it, and start any
+// goroutines needed to handle new queries. The gossip syncer becomes active
+// if we aren't receiving new graph updates from enough peers yet, and
+// passive otherwise. The recvUpdates bool indicates if we should ever receive
+// real-time updates from the remote peer once we've synced channel state.
+func (m *SyncManager) InitSyncState(peer lnpeer.Peer, recvUpdates bool) {
+	m.syncersMtx.Lock()
+	defer m.syncersMtx.Unlock()
+
+	// If we already have a syncer, then we'll exit early as we don't want
+	// to override it.
+	nodeID := routing.Vertex(peer.PubKey())
+	if _, ok := m.gossipSyncer(nodeID); ok {
+		return
+	}
+
+	log.Infof("Creating new gossipSyncer for peer=%x", nodeID[:])
+
+	encoding := lnwire.EncodingSortedPlain
+	s := newGossiperSyncer(gossipSyncerCfg{
+		chainHash:       m.cfg.ChainHash,
+		syncChanUpdates: recvUpdates,
+		channelSeries:   m.cfg.ChanSeries,
+		encodingType:    encoding,
+		chunkSize:       encodingTypeToChunkSize[encoding],
+		sendToPeer: func(msgs ...lnwire.Message) error {
+			return peer.SendMessage(false, msgs...)
+		},
+	})
+	copy(s.peerPub[:], nodeID[:])
+
+	// Only syncers that may receive updates count towards our active
+	// syncers, as the others wouldn't provide us with any.
+	if recvUpdates && len(m.activeSyncers) < m.cfg.NumActiveSyncers {
+		s.SetSyncType(ActiveSync)
+		m.activeSyncers[nodeID] = s
+	} else {
+		s.SetSyncType(PassiveSync)
+		m.inactiveSyncers[nodeID] = s
+	}
+
+	// If we haven't performed a historical sync yet, we'll do so with the
+	// first peer we connect to as part of its initial sync, in order to
+	// obtain as much of the public channel graph as possible.
+	if !m.historicalSynced {
+		s.markHistoricalSync()
+		m.historicalSynced = true
+	}
+
+	s.Start()
+}
+
+// PruneSyncState is called by outside sub-systems once a peer that we were
+// previously connected to has been disconnected. In this case we can stop the
+// existing gossipSyncer assigned to the peer and free up resources. If the
+// syncer was active, a passive one is chosen to take its place.
+func (m *SyncManager) PruneSyncState(peer routing.Vertex) {
+	m.syncersMtx.Lock()
+	defer m.syncersMtx.Unlock()
+
+	s, ok := m.gossipSyncer(peer)
+	if !ok {
+		return
+	}
+
+	log.Infof("Removing gossipSyncer for peer=%x", peer[:])
+
+	s.Stop()
+
+	// If it's a passive syncer, we can simply remove it.
+	if _, ok := m.inactiveSyncers[peer]; ok {
+		delete(m.inactiveSyncers, peer)
+		return
+	}
+
+	// Otherwise, we'll need to replace the active syncer with a passive
+	// one, if we have any.
+	delete(m.activeSyncers, peer)
+
+	newActiveSyncer := chooseRandomSyncer(
+		m.inactiveSyncers, canReceiveUpdates,
+	)
+	if newActiveSyncer == nil {
+		return
+	}
+
+	log.Debugf("Replacing active GossipSyncer(%x) with GossipSyncer(%x)",
+		peer[:], newActiveSyncer.peerPub[:])
+
+	newActiveSyncer.SetSyncType(ActiveSync)
+	delete(m.inactiveSyncers, newActiveSyncer.peerPub)
+	m.activeSyncers[newActiveSyncer.peerPub] = newActiveSyncer
+}
+
+// GossipSyncer returns the associated gossip syncer of a peer. The boolean
+// returned signals whether there exists a gossip syncer for the peer.
+func (m *SyncManager) GossipSyncer(peer routing.Vertex) (*gossipSyncer, bool) {
+	m.syncersMtx.RLock()
+	defer m.syncersMtx.RUnlock()
+
+	return m.gossipSyncer(peer)
+}
+
+// gossipSyncer returns the associated gossip syncer of a peer. The boolean
+// returned signals whether there exists a gossip syncer for the peer.
+//
+// NOTE: The syncersMtx must be held when calling this method.
+func (m *SyncManager) gossipSyncer(peer routing.Vertex) (*gossipSyncer, bool) {
+	syncer, ok := m.inactiveSyncers[peer]
+	if ok {
+		return syncer, true
+	}
+	syncer, ok = m.activeSyncers[peer]
+	if ok {
+		return syncer, true
+	}
+	return nil, false
+}
+
+// GossipSyncers returns all of the currently initialized gossip syncers.
+func (m *SyncManager) GossipSyncers() map[routing.Vertex]*gossipSyncer {
+	m.syncersMtx.RLock()
+	defer m.syncersMtx.RUnlock()
+
+	numSyncers := len(m.inactiveSyncers) + len(m.activeSyncers)
+	syncers := make(map[routing.Vertex]*gossipSyncer, numSyncers)
+
+	for _, syncer := range m.inactiveSyncers {
+		syncers[syncer.peerPub] = syncer
+	}
+	for _, syncer := range m.activeSyncers {
+		syncers[syncer.peerPub] = syncer
+	}
+
+	return syncers
+}
+
+// PeerSyncStatus describes the state of the gossip syncer of a single peer.
+type PeerSyncStatus struct {
+	// SyncType is the current sync type of the peer's gossip syncer.
+	SyncType SyncerType
+
+	// State is a human readable description of the current state of the
+	// peer's gossip syncer.
+	State string
+
+	// LastHistoricalSync is the time the last historical sync with the
+	// peer was started. It's the zero time if none was performed yet.
+	LastHistoricalSync time.Time
+}
+
+// SyncStatus returns the sync status of the gossip syncer of the passed peer.
+// The boolean returned signals whether there exists a gossip syncer for the
+// peer.
+func (m *SyncManager) SyncStatus(peer routing.Vertex) (*PeerSyncStatus, bool) {
+	s, ok := m.GossipSyncer(peer)
+	if !ok {
+		return nil, false
+	}
+
+	return &PeerSyncStatus{
+		SyncType:           s.SyncType(),
+		State:              s.SyncState().String(),
+		LastHistoricalSync: s.LastHistoricalSync(),
+	}, true
+}
@@ -0,0 +1,319 @@
+package discovery
+
+import (
+	"math"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+// latestKnownHeight is the height of the newest channel known to the sync
+// managers created within the tests.
+const latestKnownHeight = 1337
+
+// newTestSyncManager creates a new test SyncManager with the given number of
+// active syncers. The manager isn't started, so that its periodic duties can
+// be triggered manually.
+func newTestSyncManager(numActiveSyncers int) (*SyncManager,
+	*mockChannelGraphTimeSeries) {
+
+	chanSeries := newMockChannelGraphTimeSeries(
+		lnwire.ShortChannelID{BlockHeight: latestKnownHeight},
+	)
+
+	return newSyncManager(&SyncManagerCfg{
+		ChanSeries:       chanSeries,
+		NumActiveSyncers: numActiveSyncers,
+		RotateTicker: ticker.MockNew(
+			DefaultSyncerRotationInterval,
+		),
+		HistoricalSyncTicker: ticker.MockNew(
+			DefaultHistoricalSyncInterval,
+		),
+	}), chanSeries
+}
+
+// randPeer creates a random mock peer that records the messages sent to it.
+func randPeer(t *testing.T) *mockPeer {
+	t.Helper()
+
+	priv, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate key: %v", err)
+	}
+
+	return &mockPeer{
+		pk:       priv.PubKey(),
+		sentMsgs: make(chan lnwire.Message, 10),
+		quit:     make(chan struct{}),
+	}
+}
+
+// assertSyncType asserts that the gossip syncer of the peer has the expected
+// sync type.
+func assertSyncType(t *testing.T, m *SyncManager, peer *mockPeer,
+	syncType SyncerType) {
+
+	t.Helper()
+
+	s, ok := m.GossipSyncer(peer.PubKey())
+	if !ok {
+		t.Fatalf("gossip syncer for peer %x not found", peer.PubKey())
+	}
+	if s.SyncType() != syncType {
+		t.Fatalf("expected sync type %v for peer %x, got %v",
+			syncType, peer.PubKey(), s.SyncType())
+	}
+}
+
+// assertChanRangeQuery asserts that the peer was sent a QueryChannelRange
+// message starting at the given height.
+func assertChanRangeQuery(t *testing.T, peer *mockPeer, firstHeight uint32) {
+	t.Helper()
+
+	select {
+	case msg := <-peer.sentMsgs:
+		query, ok := msg.(*lnwire.QueryChannelRange)
+		if !ok {
+			t.Fatalf("expected QueryChannelRange, got %T", msg)
+		}
+		if query.FirstBlockHeight != firstHeight {
+			t.Fatalf("expected query from height %v, got %v",
+				firstHeight, query.FirstBlockHeight)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatalf("query channel range not sent")
+	}
+}
+
+// assertUpdateHorizon asserts that the peer was sent a GossipTimestampRange
+// message with the given timestamp range.
+func assertUpdateHorizon(t *testing.T, peer *mockPeer, timestampRange uint32) {
+	t.Helper()
+
+	select {
+	case msg := <-peer.sentMsgs:
+		horizon, ok := msg.(*lnwire.GossipTimestampRange)
+		if !ok {
+			t.Fatalf("expected GossipTimestampRange, got %T", msg)
+		}
+		if horizon.TimestampRange != timestampRange {
+			t.Fatalf("expected timestamp range %v, got %v",
+				timestampRange, horizon.TimestampRange)
+		}
+
+	case <-time.After(time.Second):
+		t.Fatalf("update horizon not sent")
+	}
+}
+
+// assertNoMsgSent asserts that the peer wasn't sent any message.
+func assertNoMsgSent(t *testing.T, peer *mockPeer) {
+	t.Helper()
+
+	select {
+	case msg := <-peer.sentMsgs:
+		t.Fatalf("expected no message to be sent, got %T", msg)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// completeInitialSync replies to the initial channel range query of the
+// peer's gossip syncer, such that it transitions to the chansSynced state.
+func completeInitialSync(t *testing.T, m *SyncManager,
+	chanSeries *mockChannelGraphTimeSeries, peer *mockPeer) {
+
+	t.Helper()
+
+	s, _ := m.GossipSyncer(peer.PubKey())
+	s.ProcessQueryMsg(&lnwire.ReplyChannelRange{
+		QueryChannelRange: lnwire.QueryChannelRange{
+			FirstBlockHeight: 0,
+			NumBlocks:        math.MaxUint32,
+		},
+		Complete: 1,
+	})
+
+	select {
+	case <-chanSeries.filterReq:
+		chanSeries.filterResp <- nil
+	case <-time.After(time.Second):
+		t.Fatalf("expected chan range reply to be filtered")
+	}
+}
+
+// TestSyncManagerNumActiveSyncers ensures that we are unable to have more than
+// NumActiveSyncers active syncers, and that only the first syncer performs a
+// historical sync as part of its initial sync.
+func TestSyncManagerNumActiveSyncers(t *testing.T) {
+	t.Parallel()
+
+	const numActiveSyncers = 3
+	syncMgr, _ := newTestSyncManager(numActiveSyncers)
+	defer syncMgr.Stop()
+
+	regularQueryHeight := uint32(latestKnownHeight - chanRangeQueryBuffer)
+
+	// The first syncers should all be active.
+	for i := 0; i < numActiveSyncers; i++ {
+		peer := randPeer(t)
+		syncMgr.InitSyncState(peer, true)
+		assertSyncType(t, syncMgr, peer, ActiveSync)
+
+		firstHeight := regularQueryHeight
+		if i == 0 {
+			firstHeight = 0
+		}
+		assertChanRangeQuery(t, peer, firstHeight)
+	}
+
+	// Any additional syncers should be passive.
+	for i := 0; i < 2; i++ {
+		peer := randPeer(t)
+		syncMgr.InitSyncState(peer, true)
+		assertSyncType(t, syncMgr, peer, PassiveSync)
+		assertChanRangeQuery(t, peer, regularQueryHeight)
+	}
+
+	if len(syncMgr.GossipSyncers()) != numActiveSyncers+2 {
+		t.Fatalf("expected %v syncers, got %v", numActiveSyncers+2,
+			len(syncMgr.GossipSyncers()))
+	}
+}
+
+// TestSyncManagerNoUpdates ensures that syncers that aren't allowed to receive
+// updates are never made active.
+func TestSyncManagerNoUpdates(t *testing.T) {
+	t.Parallel()
+
+	syncMgr, _ := newTestSyncManager(1)
+	defer syncMgr.Stop()
+
+	passivePeer := randPeer(t)
+	syncMgr.InitSyncState(passivePeer, false)
+	assertSyncType(t, syncMgr, passivePeer, PassiveSync)
+
+	activePeer := randPeer(t)
+	syncMgr.InitSyncState(activePeer, true)
+	assertSyncType(t, syncMgr, activePeer, ActiveSync)
+
+	// Pruning the active syncer shouldn't result in the syncer that can't
+	// receive updates being made active.
+	syncMgr.PruneSyncState(routing.Vertex(activePeer.PubKey()))
+	assertSyncType(t, syncMgr, passivePeer, PassiveSync)
+}
+
+// TestSyncManagerRotateActiveSyncer ensures that the SyncManager only rotates
+// active syncers that have completed their initial sync, and that the rotated
+// syncers update the remote peer's view of our update horizon.
+func TestSyncManagerRotateActiveSyncer(t *testing.T) {
+	t.Parallel()
+
+	syncMgr, chanSeries := newTestSyncManager(1)
+	defer syncMgr.Stop()
+
+	activePeer := randPeer(t)
+	syncMgr.InitSyncState(activePeer, true)
+	assertSyncType(t, syncMgr, activePeer, ActiveSync)
+	assertChanRangeQuery(t, activePeer, 0)
+
+	passivePeer := randPeer(t)
+	syncMgr.InitSyncState(passivePeer, true)
+	assertSyncType(t, syncMgr, passivePeer, PassiveSync)
+	assertChanRangeQuery(
+		t, passivePeer, latestKnownHeight-chanRangeQueryBuffer,
+	)
+
+	// As the active syncer hasn't completed its initial sync yet, it
+	// shouldn't be rotated.
+	syncMgr.rotateActiveSyncerCandidate()
+	assertSyncType(t, syncMgr, activePeer, ActiveSync)
+	assertSyncType(t, syncMgr, passivePeer, PassiveSync)
+
+	// Once both have completed their initial sync, only the active syncer
+	// should request new updates from its peer.
+	completeInitialSync(t, syncMgr, chanSeries, activePeer)
+	assertUpdateHorizon(t, activePeer, math.MaxUint32)
+
+	completeInitialSync(t, syncMgr, chanSeries, passivePeer)
+	assertNoMsgSent(t, passivePeer)
+
+	// Rotating the syncers should now swap their sync types, causing the
+	// previously active syncer to ask its peer to stop sending updates,
+	// and the previously passive one to request them.
+	syncMgr.rotateActiveSyncerCandidate()
+	assertSyncType(t, syncMgr, activePeer, PassiveSync)
+	assertSyncType(t, syncMgr, passivePeer, ActiveSync)
+
+	assertUpdateHorizon(t, activePeer, 0)
+	assertUpdateHorizon(t, passivePeer, math.MaxUint32)
+}
+
+// TestSyncManagerPruneActiveSyncer ensures that an active syncer that's pruned
+// is replaced by a passive one.
+func TestSyncManagerPruneActiveSyncer(t *testing.T) {
+	t.Parallel()
+
+	syncMgr, _ := newTestSyncManager(1)
+	defer syncMgr.Stop()
+
+	activePeer := randPeer(t)
+	syncMgr.InitSyncState(activePeer, true)
+	assertSyncType(t, syncMgr, activePeer, ActiveSync)
+
+	passivePeer := randPeer(t)
+	syncMgr.InitSyncState(passivePeer, true)
+	assertSyncType(t, syncMgr, passivePeer, PassiveSync)
+
+	syncMgr.PruneSyncState(routing.Vertex(activePeer.PubKey()))
+	if _, ok := syncMgr.GossipSyncer(activePeer.PubKey()); ok {
+		t.Fatalf("expected gossip syncer to be pruned")
+	}
+	assertSyncType(t, syncMgr, passivePeer, ActiveSync)
+}
+
+// TestSyncManagerForceHistoricalSync ensures that the SyncManager performs a
+// historical sync with one of its peers once requested, and that the sync
+// status of the peer reflects it.
+func TestSyncManagerForceHistoricalSync(t *testing.T) {
+	t.Parallel()
+
+	syncMgr, chanSeries := newTestSyncManager(1)
+	defer syncMgr.Stop()
+
+	// The first peer performs a historical sync as part of its initial
+	// sync.
+	peer := randPeer(t)
+	syncMgr.InitSyncState(peer, true)
+	assertChanRangeQuery(t, peer, 0)
+
+	completeInitialSync(t, syncMgr, chanSeries, peer)
+	assertUpdateHorizon(t, peer, math.MaxUint32)
+
+	// Reset the time of the last historical sync, so that we can detect
+	// the next one.
+	s, _ := syncMgr.GossipSyncer(peer.PubKey())
+	atomic.StoreInt64(&s.lastHistoricalSync, 0)
+
+	// Forcing a historical sync should cause the syncer to query for all
+	// channels of the remote peer once again.
+	syncMgr.forceHistoricalSync()
+	assertChanRangeQuery(t, peer, 0)
+
+	status, ok := syncMgr.SyncStatus(peer.PubKey())
+	if !ok {
+		t.Fatalf("sync status for peer not found")
+	}
+	if status.SyncType != ActiveSync {
+		t.Fatalf("expected %v, got %v", ActiveSync, status.SyncType)
+	}
+	if status.LastHistoricalSync.IsZero() {
+		t.Fatalf("expected historical sync time to be set")
+	}
+}
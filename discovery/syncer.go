@@ -13,6 +13,35 @@ import (
 	"golang.org/x/time/rate"
 )
 
+// SyncerType encapsulates the different types of syncing mechanisms for a
+// gossip syncer.
+type SyncerType uint8
+
+const (
+	// ActiveSync denotes that a gossip syncer should exercise its default
+	// behavior. This includes reconciling the set of missing graph updates
+	// with the remote peer _and_ receiving new updates from them.
+	ActiveSync SyncerType = iota
+
+	// PassiveSync denotes that a gossip syncer should not receive any new
+	// graph updates from the remote peer. It still reconciles the set of
+	// missing graph updates with the remote peer, and replies to any of
+	// their queries.
+	PassiveSync
+)
+
+// String returns a human readable string describing the target SyncerType.
+func (t SyncerType) String() string {
+	switch t {
+	case ActiveSync:
+		return "ActiveSync"
+	case PassiveSync:
+		return "PassiveSync"
+	default:
+		return fmt.Sprintf("unknown sync type %d", t)
+	}
+}
+
 // syncerState is an enum that represents the current state of the
 // gossipSyncer.  As the syncer is a state machine, we'll gate our actions
 // based off of the current state and the next incoming message.
@@ -220,6 +249,34 @@ type gossipSyncer struct {
 	// NOTE: This variable MUST be used atomically.
 	state uint32
 
+	// syncType denotes the SyncerType the gossip syncer is currently
+	// exercising.
+	//
+	// NOTE: This variable MUST be used atomically.
+	syncType uint32
+
+	// syncTypeSignal is used to wake up the syncer once its SyncerType
+	// has been changed, so that it can update the remote peer's view of
+	// our update horizon.
+	syncTypeSignal chan struct{}
+
+	// historicalSyncReqs is a channel that serves as a signal for the
+	// gossip syncer to perform a historical sync. A historical sync
+	// queries the remote peer for every channel it knows of, rather than
+	// only the most recent ones, in order to fill any gaps in our graph.
+	historicalSyncReqs chan struct{}
+
+	// lastHistoricalSync is the unix timestamp at which the last
+	// historical sync with the remote peer was started.
+	//
+	// NOTE: This variable MUST be used atomically.
+	lastHistoricalSync int64
+
+	// genHistoricalChanRangeQuery is set when the next channel range
+	// query should span the entire chain, rather than only the most
+	// recent blocks.
+	genHistoricalChanRangeQuery bool
+
 	// gossipMsgs is a channel that all messages from the target peer will
 	// be sent over.
 	gossipMsgs chan lnwire.Message
@@ -276,10 +333,12 @@ func newGossiperSyncer(cfg gossipSyncerCfg) *gossipSyncer {
 	)
 
 	return &gossipSyncer{
-		cfg:         cfg,
-		rateLimiter: rateLimiter,
-		gossipMsgs:  make(chan lnwire.Message, 100),
-		quit:        make(chan struct{}),
+		cfg:                cfg,
+		rateLimiter:        rateLimiter,
+		gossipMsgs:         make(chan lnwire.Message, 100),
+		syncTypeSignal:     make(chan struct{}, 1),
+		historicalSyncReqs: make(chan struct{}, 1),
+		quit:               make(chan struct{}),
 	}
 }
 
@@ -451,35 +510,18 @@ func (g *gossipSyncer) channelGraphSyncer() {
 		// This is our final terminal state where we'll only reply to
 		// any further queries by the remote peer.
 		case chansSynced:
-			// If we haven't yet sent out our update horizon, and
-			// we want to receive real-time channel updates, we'll
-			// do so now.
-			if g.localUpdateHorizon == nil && g.cfg.syncChanUpdates {
-				// TODO(roasbeef): query DB for most recent
-				// update?
-
-				// We'll give an hours room in our update
-				// horizon to ensure we don't miss any newer
-				// items.
-				updateHorizon := time.Now().Add(-time.Hour * 1)
-				log.Infof("gossipSyncer(%x): applying "+
-					"gossipFilter(start=%v)", g.peerPub[:],
-					updateHorizon)
-
-				g.localUpdateHorizon = &lnwire.GossipTimestampRange{
-					ChainHash:      g.cfg.chainHash,
-					FirstTimestamp: uint32(updateHorizon.Unix()),
-					TimestampRange: math.MaxUint32,
-				}
-				err := g.cfg.sendToPeer(g.localUpdateHorizon)
-				if err != nil {
-					log.Errorf("unable to send update "+
-						"horizon: %v", err)
-				}
+			// Depending on our current sync type, we'll make sure
+			// the remote peer either sends us new channel updates
+			// or not.
+			if err := g.updateLocalHorizon(); err != nil {
+				log.Errorf("unable to send update "+
+					"horizon: %v", err)
 			}
 
 			// With our horizon set, we'll simply reply to any new
-			// message and exit if needed.
+			// message and exit if needed. We'll also handle any
+			// change of our sync type, or a request to perform a
+			// historical sync.
 			select {
 			case msg := <-g.gossipMsgs:
 				err := g.replyPeerQueries(msg)
@@ -488,6 +530,15 @@ func (g *gossipSyncer) channelGraphSyncer() {
 						"query: %v", err)
 				}
 
+			case <-g.syncTypeSignal:
+
+			case <-g.historicalSyncReqs:
+				log.Infof("gossipSyncer(%x): starting "+
+					"historical sync", g.peerPub[:])
+
+				g.markHistoricalSync()
+				atomic.StoreUint32(&g.state, uint32(syncingChans))
+
 			case <-g.quit:
 				return
 			}
@@ -495,6 +546,53 @@ func (g *gossipSyncer) channelGraphSyncer() {
 	}
 }
 
+// updateLocalHorizon sends the remote peer our update horizon if it doesn't
+// match our current sync type yet. Active syncers request all new channel
+// updates from the remote peer, while passive syncers ask the remote peer to
+// stop sending any.
+func (g *gossipSyncer) updateLocalHorizon() error {
+	receivingUpdates := g.localUpdateHorizon != nil &&
+		g.localUpdateHorizon.TimestampRange != 0
+	wantUpdates := g.cfg.syncChanUpdates && g.SyncType() == ActiveSync
+
+	switch {
+	// If we want to receive real-time channel updates, but haven't
+	// requested them yet, we'll do so now.
+	case wantUpdates && !receivingUpdates:
+		// TODO(roasbeef): query DB for most recent update?
+
+		// We'll give an hours room in our update horizon to ensure we
+		// don't miss any newer items.
+		updateHorizon := time.Now().Add(-time.Hour * 1)
+		log.Infof("gossipSyncer(%x): applying "+
+			"gossipFilter(start=%v)", g.peerPub[:], updateHorizon)
+
+		g.localUpdateHorizon = &lnwire.GossipTimestampRange{
+			ChainHash:      g.cfg.chainHash,
+			FirstTimestamp: uint32(updateHorizon.Unix()),
+			TimestampRange: math.MaxUint32,
+		}
+
+	// If we're receiving updates we no longer want, then we'll send an
+	// empty horizon, which causes the remote peer to stop sending us any
+	// updates.
+	case !wantUpdates && receivingUpdates:
+		log.Infof("gossipSyncer(%x): applying empty gossipFilter",
+			g.peerPub[:])
+
+		g.localUpdateHorizon = &lnwire.GossipTimestampRange{
+			ChainHash:      g.cfg.chainHash,
+			FirstTimestamp: 0,
+			TimestampRange: 0,
+		}
+
+	default:
+		return nil
+	}
+
+	return g.cfg.sendToPeer(g.localUpdateHorizon)
+}
+
 // synchronizeChanIDs is called by the channelGraphSyncer when we need to query
 // the remote peer for its known set of channel IDs within a particular block
 // range. This method will be called continually until the entire range has
@@ -615,6 +713,12 @@ func (g *gossipSyncer) genChanRangeQuery() (*lnwire.QueryChannelRange, error) {
 	// newest channel.
 	var startHeight uint32
 	switch {
+	// If we're performing a historical sync, we'll ask for every channel
+	// the remote peer knows of.
+	case g.genHistoricalChanRangeQuery:
+		g.genHistoricalChanRangeQuery = false
+		startHeight = 0
+
 	case newestChan.BlockHeight <= chanRangeQueryBuffer:
 		fallthrough
 	case newestChan.BlockHeight == 0:
@@ -995,3 +1099,50 @@ func (g *gossipSyncer) ProcessQueryMsg(msg lnwire.Message) {
 func (g *gossipSyncer) SyncState() syncerState {
 	return syncerState(atomic.LoadUint32(&g.state))
 }
+
+// SyncType returns the current SyncerType of the target gossipSyncer.
+func (g *gossipSyncer) SyncType() SyncerType {
+	return SyncerType(atomic.LoadUint32(&g.syncType))
+}
+
+// SetSyncType changes the SyncerType of the target gossipSyncer. Once the
+// syncer has finished its initial sync, it'll either request new graph
+// updates from the remote peer, or ask it to stop sending them, depending on
+// the new type.
+func (g *gossipSyncer) SetSyncType(syncType SyncerType) {
+	atomic.StoreUint32(&g.syncType, uint32(syncType))
+
+	select {
+	case g.syncTypeSignal <- struct{}{}:
+	default:
+	}
+}
+
+// SyncHistorical requests the target gossipSyncer to perform a historical sync
+// with the remote peer, in which it queries for every channel known to the
+// remote peer. The sync starts once any sync in progress has completed.
+func (g *gossipSyncer) SyncHistorical() {
+	select {
+	case g.historicalSyncReqs <- struct{}{}:
+	default:
+	}
+}
+
+// markHistoricalSync ensures that the next channel range query of the syncer
+// spans the entire chain, such that we learn of every channel known to the
+// remote peer.
+func (g *gossipSyncer) markHistoricalSync() {
+	atomic.StoreInt64(&g.lastHistoricalSync, time.Now().Unix())
+	g.genHistoricalChanRangeQuery = true
+}
+
+// LastHistoricalSync returns the time at which the last historical sync with
+// the remote peer was started, or the zero time if none was performed yet.
+func (g *gossipSyncer) LastHistoricalSync() time.Time {
+	lastSync := atomic.LoadInt64(&g.lastHistoricalSync)
+	if lastSync == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(lastSync, 0)
+}
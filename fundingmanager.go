@@ -16,6 +16,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/go-errors/errors"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/keychain"
@@ -157,6 +158,17 @@ type fundingOpenMsg struct {
 	peer lnpeer.Peer
 }
 
+// fundingOpenDecisionMsg carries the decision the channel acceptor made on a
+// fundingOpenMsg back to the reservationCoordinator, such that the funding
+// workflow can be resumed once the acceptor has made up its mind.
+type fundingOpenDecisionMsg struct {
+	*fundingOpenMsg
+
+	// err is the error returned by the channel acceptor, which is nil if
+	// the channel was accepted.
+	err error
+}
+
 // fundingAcceptMsg couples an lnwire.AcceptChannel message with the peer who
 // sent the message. This allows the funding manager to queue a response
 // directly to the peer, progressing the funding workflow.
@@ -332,6 +344,14 @@ type fundingConfig struct {
 	// flood us with very small channels that would never really be usable
 	// due to fees.
 	MinChanSize btcutil.Amount
+
+	// OpenChannelPredicate is consulted for every inbound channel which
+	// passes our static checks, before we send AcceptChannel. It may
+	// block while an external acceptor decides on the channel, so it
+	// should enforce a timeout of its own.
+	//
+	// NOTE: This is optional, all channels are accepted if unset.
+	OpenChannelPredicate chanacceptor.ChannelAcceptor
 }
 
 // fundingManager acts as an orchestrator/bridge between the wallet's
@@ -843,6 +863,11 @@ func (f *fundingManager) failFundingFlow(peer lnpeer.Peer, tempChanID [32]byte,
 	case lnwallet.ReservationError:
 		msg = lnwire.ErrorData(e.Error())
 
+	// The channel was rejected by our acceptor, so we'll let the remote
+	// know why.
+	case *chanacceptor.RejectError:
+		msg = lnwire.ErrorData(e.Error())
+
 	// Send the status code.
 	case lnwire.ErrorCode:
 		msg = lnwire.ErrorData{byte(e)}
@@ -881,6 +906,8 @@ func (f *fundingManager) reservationCoordinator() {
 			switch fmsg := msg.(type) {
 			case *fundingOpenMsg:
 				f.handleFundingOpen(fmsg)
+			case *fundingOpenDecisionMsg:
+				f.handleFundingOpenDecision(fmsg)
 			case *fundingAcceptMsg:
 				f.handleFundingAccept(fmsg)
 			case *fundingCreatedMsg:
@@ -949,13 +976,9 @@ func (f *fundingManager) processFundingOpen(msg *lnwire.OpenChannel,
 	}
 }
 
-// handleFundingOpen creates an initial 'ChannelReservation' within the wallet,
-// then responds to the source peer with an accept channel message progressing
-// the funding workflow.
-//
-// TODO(roasbeef): add error chan to all, let channelManager handle
-// error+propagate
-func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
+// checkFundingOpen ensures that the channel proposed by the remote peer is
+// within our limits, failing the funding flow and returning false if it isn't.
+func (f *fundingManager) checkFundingOpen(fmsg *fundingOpenMsg) bool {
 	// Check number of pending channels to be smaller than maximum allowed
 	// number and send ErrorGeneric to remote peer if condition is
 	// violated.
@@ -977,7 +1000,7 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 		f.failFundingFlow(
 			fmsg.peer, fmsg.msg.PendingChannelID, err,
 		)
-		return
+		return false
 	}
 
 	for _, c := range channels {
@@ -993,7 +1016,7 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 			fmsg.peer, fmsg.msg.PendingChannelID,
 			lnwire.ErrMaxPendingChannels,
 		)
-		return
+		return false
 	}
 
 	// We'll also reject any requests to create channels until we're fully
@@ -1008,7 +1031,7 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 			fmsg.peer, fmsg.msg.PendingChannelID,
 			lnwire.ErrSynchronizingChain,
 		)
-		return
+		return false
 	}
 
 	// We'll reject any request to create a channel that's above the
@@ -1018,7 +1041,7 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 			fmsg.peer, fmsg.msg.PendingChannelID,
 			lnwire.ErrChanTooLarge,
 		)
-		return
+		return false
 	}
 
 	// We'll, also ensure that the remote party isn't attempting to propose
@@ -1028,9 +1051,87 @@ func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
 			fmsg.peer, fmsg.msg.PendingChannelID,
 			lnwallet.ErrChanTooSmall(amt, btcutil.Amount(f.cfg.MinChanSize)),
 		)
+		return false
+	}
+
+	return true
+}
+
+// handleFundingOpen creates an initial 'ChannelReservation' within the wallet,
+// then responds to the source peer with an accept channel message progressing
+// the funding workflow.
+//
+// TODO(roasbeef): add error chan to all, let channelManager handle
+// error+propagate
+func (f *fundingManager) handleFundingOpen(fmsg *fundingOpenMsg) {
+	if !f.checkFundingOpen(fmsg) {
+		return
+	}
+
+	if f.cfg.OpenChannelPredicate == nil {
+		f.acceptFundingOpen(fmsg)
+		return
+	}
+
+	// Otherwise, we'll let the channel acceptor apply the operator's own
+	// policy to the channel. As the acceptor may wait on the operator to
+	// make a decision, it's consulted in its own goroutine, such that it
+	// doesn't stall other funding flows. Its decision is then sent back
+	// to the reservationCoordinator to resume the funding flow.
+	f.wg.Add(1)
+	go func() {
+		defer f.wg.Done()
+
+		err := f.cfg.OpenChannelPredicate.Accept(
+			&chanacceptor.ChannelAcceptRequest{
+				Node:        fmsg.peer.IdentityKey(),
+				OpenChanMsg: fmsg.msg,
+			},
+		)
+
+		select {
+		case f.fundingMsgs <- &fundingOpenDecisionMsg{fmsg, err}:
+		case <-f.quit:
+		}
+	}()
+}
+
+// handleFundingOpenDecision resumes the funding flow of a channel proposed by
+// the remote peer once the channel acceptor has made its decision.
+func (f *fundingManager) handleFundingOpenDecision(
+	decision *fundingOpenDecisionMsg) {
+
+	fmsg := decision.fundingOpenMsg
+	if decision.err != nil {
+		fndgLog.Infof("Rejecting fundingRequest(pendingId=%x) "+
+			"from peer(%x): %v", fmsg.msg.PendingChannelID,
+			fmsg.peer.IdentityKey().SerializeCompressed(),
+			decision.err)
+		f.failFundingFlow(
+			fmsg.peer, fmsg.msg.PendingChannelID, decision.err,
+		)
 		return
 	}
 
+	// As other channels may have been proposed while the acceptor made
+	// its decision, we'll check our limits once again.
+	if !f.checkFundingOpen(fmsg) {
+		return
+	}
+
+	f.acceptFundingOpen(fmsg)
+}
+
+// acceptFundingOpen creates an initial 'ChannelReservation' within the wallet
+// for a channel proposed by the remote peer, then responds with an accept
+// channel message progressing the funding workflow.
+func (f *fundingManager) acceptFundingOpen(fmsg *fundingOpenMsg) {
+	peerPubKey := fmsg.peer.IdentityKey()
+	peerIDKey := newSerializedKey(peerPubKey)
+
+	msg := fmsg.msg
+	amt := msg.FundingAmount
+
 	fndgLog.Infof("Recv'd fundingRequest(amt=%v, push=%v, delay=%v, "+
 		"pendingId=%x) from peer(%x)", amt, msg.PushAmount,
 		msg.CsvDelay, msg.PendingChannelID,
@@ -2667,6 +2768,10 @@ func (f *fundingManager) handleInitFundingMsg(msg *initFundingMsg) {
 		PushMSat:        msg.pushAmt,
 		Flags:           channelFlags,
 		MinConfs:        msg.minConfs,
+
+		Outpoints:             msg.outpoints,
+		LockID:                msg.lockID,
+		CoinSelectionStrategy: msg.coinSelectionStrategy,
 	}
 
 	reservation, err := f.cfg.Wallet.InitChannelReservation(req)
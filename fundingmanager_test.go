@@ -22,6 +22,7 @@ import (
 	"github.com/btcsuite/btcutil"
 	_ "github.com/btcsuite/btcwallet/walletdb/bdb"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/htlcswitch"
@@ -1467,6 +1468,106 @@ func TestFundingManagerPeerTimeoutAfterFundingOpen(t *testing.T) {
 	assertNumPendingReservations(t, bob, alicePubKey, 0)
 }
 
+// blockingAcceptor is a channel acceptor which hands every request it receives
+// to the test, and blocks until the test decides on it.
+type blockingAcceptor struct {
+	requests  chan *chanacceptor.ChannelAcceptRequest
+	decisions chan error
+}
+
+func (b *blockingAcceptor) Accept(
+	req *chanacceptor.ChannelAcceptRequest) error {
+
+	b.requests <- req
+	return <-b.decisions
+}
+
+// TestFundingManagerAcceptorNoStall checks that the fundingManager keeps
+// serving other requests while the channel acceptor decides on an inbound
+// channel, and resumes the funding flow once it has been accepted.
+func TestFundingManagerAcceptorNoStall(t *testing.T) {
+	alice, bob := setupFundingManagers(t, defaultMaxPendingChannels)
+	defer tearDownFundingManagers(t, alice, bob)
+
+	acceptor := &blockingAcceptor{
+		requests:  make(chan *chanacceptor.ChannelAcceptRequest, 1),
+		decisions: make(chan error, 1),
+	}
+	bob.fundingMgr.cfg.OpenChannelPredicate = acceptor
+
+	// We consume the channel updates as we go, so no buffering is needed.
+	updateChan := make(chan *lnrpc.OpenStatusUpdate)
+
+	// Create a funding request and start the workflow.
+	errChan := make(chan error, 1)
+	initReq := &openChanReq{
+		targetPubkey:    bob.privKey.PubKey(),
+		chainHash:       *activeNetParams.GenesisHash,
+		localFundingAmt: 500000,
+		pushAmt:         lnwire.NewMSatFromSatoshis(0),
+		private:         false,
+		updates:         updateChan,
+		err:             errChan,
+	}
+
+	alice.fundingMgr.initFundingWorkflow(bob, initReq)
+
+	// Alice should have sent the OpenChannel message to Bob.
+	var aliceMsg lnwire.Message
+	select {
+	case aliceMsg = <-alice.msgChan:
+	case err := <-initReq.err:
+		t.Fatalf("error init funding workflow: %v", err)
+	case <-time.After(time.Second * 5):
+		t.Fatalf("alice did not send OpenChannel message")
+	}
+
+	openChannelReq, ok := aliceMsg.(*lnwire.OpenChannel)
+	if !ok {
+		t.Fatalf("expected OpenChannel to be sent from alice, "+
+			"instead got %T", aliceMsg)
+	}
+
+	// Let Bob handle the init message, which should be handed to his
+	// channel acceptor.
+	bob.fundingMgr.processFundingOpen(openChannelReq, alice)
+
+	select {
+	case req := <-acceptor.requests:
+		if req.OpenChanMsg != openChannelReq {
+			t.Fatalf("acceptor received unexpected request")
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("acceptor not consulted")
+	}
+
+	// While the acceptor is making up its mind, Bob's fundingManager
+	// should still be able to serve other requests.
+	pendingChanErr := make(chan error, 1)
+	go func() {
+		_, err := bob.fundingMgr.PendingChannels()
+		pendingChanErr <- err
+	}()
+
+	select {
+	case err := <-pendingChanErr:
+		if err != nil {
+			t.Fatalf("unable to fetch pending channels: %v", err)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatalf("funding manager stalled by channel acceptor")
+	}
+
+	// No reservation should be made until the channel is accepted.
+	assertNumPendingReservations(t, bob, alicePubKey, 0)
+
+	// Once the acceptor accepts the channel, Bob should answer with an
+	// AcceptChannel.
+	acceptor.decisions <- nil
+	assertFundingMsgSent(t, bob.msgChan, "AcceptChannel")
+	assertNumPendingReservations(t, bob, alicePubKey, 1)
+}
+
 // TestFundingManagerPeerTimeoutAfterFundingAccept checks that the zombie sweeper
 // will properly clean up a zombie reservation that times out after the
 // fundingAcceptMsg has been handled.
@@ -0,0 +1,384 @@
+package htlcswitch
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// ErrHtlcNotifierShuttingDown is returned when a client attempts to subscribe
+// to HTLC events after the notifier has been stopped.
+var ErrHtlcNotifierShuttingDown = errors.New("htlc notifier shutting down")
+
+// HtlcEventType denotes the stage of its lifecycle an HTLC has reached.
+type HtlcEventType uint8
+
+const (
+	// HtlcEventForward is sent when the switch hands an HTLC to the link
+	// of its outgoing channel, either as it's forwarded on behalf of a
+	// remote peer, or dispatched as part of a local payment.
+	HtlcEventForward HtlcEventType = iota
+
+	// HtlcEventAdd is sent when an outgoing HTLC has been added to the
+	// state machine of its channel, and will be included in the next
+	// commitment update.
+	HtlcEventAdd
+
+	// HtlcEventQueue is sent when an outgoing HTLC can't be added to its
+	// channel yet, and has been placed in the overflow queue of the link.
+	HtlcEventQueue
+
+	// HtlcEventDrop is sent when an outgoing HTLC has exceeded its
+	// deadline before it could be added to its channel, and is failed
+	// back to its source.
+	HtlcEventDrop
+
+	// HtlcEventSettle is sent when the switch receives the preimage of an
+	// HTLC, and the settle is passed back to its source.
+	HtlcEventSettle
+
+	// HtlcEventFail is sent when the switch receives the failure of an
+	// HTLC from downstream, and the fail is passed back to its source.
+	HtlcEventFail
+
+	// HtlcEventLinkFail is sent when an HTLC is failed by our own node,
+	// as either the switch or the outgoing link was unable to forward it.
+	HtlcEventLinkFail
+)
+
+// String returns a human readable version of the event type.
+func (t HtlcEventType) String() string {
+	switch t {
+	case HtlcEventForward:
+		return "Forward"
+	case HtlcEventAdd:
+		return "Add"
+	case HtlcEventQueue:
+		return "Queue"
+	case HtlcEventDrop:
+		return "Drop"
+	case HtlcEventSettle:
+		return "Settle"
+	case HtlcEventFail:
+		return "Fail"
+	case HtlcEventLinkFail:
+		return "LinkFail"
+	default:
+		return "Unknown"
+	}
+}
+
+// HtlcEvent describes a change in the state of an HTLC as it passes through
+// the switch and its links.
+type HtlcEvent struct {
+	// Type is the stage of its lifecycle the HTLC has reached.
+	Type HtlcEventType
+
+	// IncomingCircuit identifies the HTLC on its incoming channel. For
+	// locally initiated payments, the channel ID is that of the source
+	// hop.
+	IncomingCircuit CircuitKey
+
+	// OutgoingCircuit identifies the HTLC on its outgoing channel. As the
+	// ID of an outgoing HTLC is only assigned once it's added to the
+	// channel, only the channel ID is set for events which precede that.
+	OutgoingCircuit CircuitKey
+
+	// PaymentHash is the payment hash of the HTLC, if known.
+	PaymentHash [32]byte
+
+	// IncomingAmt is the amount of the incoming HTLC.
+	IncomingAmt lnwire.MilliSatoshi
+
+	// OutgoingAmt is the amount of the outgoing HTLC.
+	OutgoingAmt lnwire.MilliSatoshi
+
+	// Timestamp is the time at which the event occurred.
+	Timestamp time.Time
+
+	// FailureReason is a human readable description of why the HTLC was
+	// failed or dropped. Failures which are encrypted for the source of a
+	// remote payment can't be read by our node, and are left blank.
+	FailureReason string
+}
+
+// HtlcEventSubscription represents an intent to receive notifications on the
+// HTLC events observed by the switch and its links.
+type HtlcEventSubscription struct {
+	// Events is a receive only channel over which all HTLC events are
+	// sent in the order they occurred.
+	Events <-chan *HtlcEvent
+
+	// Cancel is a function closure that should be executed when the client
+	// wishes to cancel their notification intent. Doing so allows the
+	// HtlcNotifier to free up resources.
+	Cancel func()
+}
+
+// htlcEventClient is a single subscriber of the HtlcNotifier.
+type htlcEventClient struct {
+	events chan *HtlcEvent
+
+	ntfnQueue *chainntnfs.ConcurrentQueue
+
+	cancelChan chan struct{}
+}
+
+// HtlcNotifier dispatches the HTLC events observed by the switch and its
+// links to all subscribed clients. Each client is given an unbounded queue,
+// such that a slow client never stalls the forwarding of HTLCs.
+//
+// NOTE: All notification methods may be called on a nil HtlcNotifier, in
+// which case they are a no-op.
+type HtlcNotifier struct {
+	started int32
+	stopped int32
+
+	clientCounter uint64
+
+	clientMtx sync.Mutex
+	clients   map[uint64]*htlcEventClient
+
+	now func() time.Time
+
+	wg   sync.WaitGroup
+	quit chan struct{}
+}
+
+// NewHtlcNotifier creates a new HtlcNotifier.
+func NewHtlcNotifier() *HtlcNotifier {
+	return &HtlcNotifier{
+		clients: make(map[uint64]*htlcEventClient),
+		now:     time.Now,
+		quit:    make(chan struct{}),
+	}
+}
+
+// Start starts the HtlcNotifier.
+func (h *HtlcNotifier) Start() error {
+	if !atomic.CompareAndSwapInt32(&h.started, 0, 1) {
+		return nil
+	}
+
+	log.Tracef("HtlcNotifier starting")
+
+	return nil
+}
+
+// Stop signals the HtlcNotifier to shut down, and cancels all outstanding
+// subscriptions.
+func (h *HtlcNotifier) Stop() error {
+	if !atomic.CompareAndSwapInt32(&h.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Tracef("HtlcNotifier shutting down")
+
+	h.clientMtx.Lock()
+	close(h.quit)
+	for clientID, client := range h.clients {
+		client.ntfnQueue.Stop()
+		delete(h.clients, clientID)
+	}
+	h.clientMtx.Unlock()
+
+	h.wg.Wait()
+
+	return nil
+}
+
+// SubscribeHtlcEvents returns a subscription which will be sent all HTLC
+// events from this point onwards.
+func (h *HtlcNotifier) SubscribeHtlcEvents() (*HtlcEventSubscription, error) {
+	client := &htlcEventClient{
+		events:     make(chan *HtlcEvent),
+		ntfnQueue:  chainntnfs.NewConcurrentQueue(20),
+		cancelChan: make(chan struct{}),
+	}
+
+	h.clientMtx.Lock()
+	select {
+	case <-h.quit:
+		h.clientMtx.Unlock()
+		return nil, ErrHtlcNotifierShuttingDown
+	default:
+	}
+
+	clientID := atomic.AddUint64(&h.clientCounter, 1)
+	client.ntfnQueue.Start()
+	h.clients[clientID] = client
+	h.clientMtx.Unlock()
+
+	// We'll launch a goroutine that proxies all events appended to the
+	// end of the client's queue to the channel the caller will feed off
+	// of.
+	h.wg.Add(1)
+	go func() {
+		defer h.wg.Done()
+
+		for {
+			select {
+			case ntfn := <-client.ntfnQueue.ChanOut():
+				select {
+				case client.events <- ntfn.(*HtlcEvent):
+				case <-client.cancelChan:
+					return
+				case <-h.quit:
+					return
+				}
+
+			case <-client.cancelChan:
+				return
+
+			case <-h.quit:
+				return
+			}
+		}
+	}()
+
+	var cancelOnce sync.Once
+	return &HtlcEventSubscription{
+		Events: client.events,
+		Cancel: func() {
+			cancelOnce.Do(func() {
+				h.clientMtx.Lock()
+				if _, ok := h.clients[clientID]; ok {
+					client.ntfnQueue.Stop()
+					delete(h.clients, clientID)
+				}
+				h.clientMtx.Unlock()
+
+				close(client.cancelChan)
+			})
+		},
+	}, nil
+}
+
+// notify stamps the event and appends it to the queue of every client.
+func (h *HtlcNotifier) notify(event *HtlcEvent) {
+	if h == nil {
+		return
+	}
+
+	event.Timestamp = h.now()
+
+	log.Tracef("HTLC event %v: incoming=%v, outgoing=%v, hash=%x",
+		event.Type, event.IncomingCircuit, event.OutgoingCircuit,
+		event.PaymentHash[:])
+
+	h.clientMtx.Lock()
+	defer h.clientMtx.Unlock()
+
+	for _, client := range h.clients {
+		select {
+		case client.ntfnQueue.ChanIn() <- event:
+		case <-h.quit:
+			return
+		}
+	}
+}
+
+// NotifyForwardEvent signals that the switch has handed the outgoing HTLC of
+// the packet to the link of its outgoing channel.
+func (h *HtlcNotifier) NotifyForwardEvent(pkt *htlcPacket) {
+	h.notify(newPacketEvent(HtlcEventForward, pkt, ""))
+}
+
+// NotifyAddEvent signals that the outgoing HTLC of the packet has been added
+// to the state machine of its channel.
+func (h *HtlcNotifier) NotifyAddEvent(pkt *htlcPacket) {
+	h.notify(newPacketEvent(HtlcEventAdd, pkt, ""))
+}
+
+// NotifyQueueEvent signals that the outgoing HTLC of the packet has been
+// placed in the overflow queue of its link.
+func (h *HtlcNotifier) NotifyQueueEvent(pkt *htlcPacket) {
+	h.notify(newPacketEvent(HtlcEventQueue, pkt, ""))
+}
+
+// NotifyDropEvent signals that the outgoing HTLC of the packet has exceeded
+// its deadline, and is failed back to its source.
+func (h *HtlcNotifier) NotifyDropEvent(pkt *htlcPacket, reason string) {
+	h.notify(newPacketEvent(HtlcEventDrop, pkt, reason))
+}
+
+// NotifyLinkFailEvent signals that our node was unable to forward the HTLC of
+// the packet, and is failing it back to its source.
+func (h *HtlcNotifier) NotifyLinkFailEvent(pkt *htlcPacket, reason string) {
+	h.notify(newPacketEvent(HtlcEventLinkFail, pkt, reason))
+}
+
+// NotifyResolutionEvent signals that the switch has received the settle or
+// fail of an HTLC, and has closed its circuit.
+func (h *HtlcNotifier) NotifyResolutionEvent(pkt *htlcPacket,
+	circuit *PaymentCircuit) {
+
+	if h == nil {
+		return
+	}
+
+	event := &HtlcEvent{
+		Type:            HtlcEventSettle,
+		IncomingCircuit: circuit.Incoming,
+		PaymentHash:     circuit.PaymentHash,
+		IncomingAmt:     circuit.IncomingAmount,
+		OutgoingAmt:     circuit.OutgoingAmount,
+	}
+	if circuit.Outgoing != nil {
+		event.OutgoingCircuit = *circuit.Outgoing
+	}
+
+	if fail, ok := pkt.htlc.(*lnwire.UpdateFailHTLC); ok {
+		event.Type = HtlcEventFail
+		event.FailureReason = failureReason(pkt, fail)
+	}
+
+	h.notify(event)
+}
+
+// newPacketEvent creates an event for an add packet, which has yet to be
+// resolved.
+func newPacketEvent(eventType HtlcEventType, pkt *htlcPacket,
+	reason string) *HtlcEvent {
+
+	event := &HtlcEvent{
+		Type:            eventType,
+		IncomingCircuit: pkt.inKey(),
+		OutgoingCircuit: pkt.outKey(),
+		IncomingAmt:     pkt.incomingAmount,
+		OutgoingAmt:     pkt.amount,
+		FailureReason:   reason,
+	}
+	if add, ok := pkt.htlc.(*lnwire.UpdateAddHTLC); ok {
+		event.PaymentHash = add.PaymentHash
+	}
+
+	return event
+}
+
+// failureReason returns a description of the failure carried by a fail
+// packet. Only failures which originated at our own node, or at the outgoing
+// link, are in plaintext, all others are left blank.
+func failureReason(pkt *htlcPacket, fail *lnwire.UpdateFailHTLC) string {
+	switch {
+	case pkt.isResolution:
+		return "resolved on-chain"
+
+	case pkt.localFailure:
+		failure, err := lnwire.DecodeFailure(
+			bytes.NewReader(fail.Reason), 0,
+		)
+		if err != nil {
+			return ""
+		}
+		return failure.Error()
+
+	default:
+		return ""
+	}
+}
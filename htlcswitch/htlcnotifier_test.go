@@ -0,0 +1,147 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestHtlcNotifierEvents asserts that the events of an HTLC are delivered to
+// every subscriber in the order they occurred, and that subscribers no longer
+// receive events once they've cancelled their subscription.
+func TestHtlcNotifierEvents(t *testing.T) {
+	t.Parallel()
+
+	notifier := NewHtlcNotifier()
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start notifier: %v", err)
+	}
+	defer notifier.Stop()
+
+	first, err := notifier.SubscribeHtlcEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	second, err := notifier.SubscribeHtlcEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	defer second.Cancel()
+
+	var paymentHash [32]byte
+	paymentHash[0] = 1
+
+	pkt := &htlcPacket{
+		incomingChanID: lnwire.NewShortChanIDFromInt(1),
+		incomingHTLCID: 2,
+		outgoingChanID: lnwire.NewShortChanIDFromInt(3),
+		incomingAmount: 1100,
+		amount:         1000,
+		htlc: &lnwire.UpdateAddHTLC{
+			PaymentHash: paymentHash,
+			Amount:      1000,
+		},
+	}
+
+	// The HTLC is forwarded, queued as its channel is exhausted, and then
+	// added to the channel once it has been replenished.
+	notifier.NotifyForwardEvent(pkt)
+	notifier.NotifyQueueEvent(pkt)
+	pkt.outgoingHTLCID = 4
+	notifier.NotifyAddEvent(pkt)
+
+	// Finally, the HTLC is failed by the next hop.
+	outKey := pkt.outKey()
+	notifier.NotifyResolutionEvent(
+		&htlcPacket{htlc: &lnwire.UpdateFailHTLC{}},
+		&PaymentCircuit{
+			Incoming:       pkt.inKey(),
+			Outgoing:       &outKey,
+			PaymentHash:    paymentHash,
+			IncomingAmount: 1100,
+			OutgoingAmount: 1000,
+		},
+	)
+
+	expected := []HtlcEventType{
+		HtlcEventForward, HtlcEventQueue, HtlcEventAdd, HtlcEventFail,
+	}
+	for _, sub := range []*HtlcEventSubscription{first, second} {
+		for i, eventType := range expected {
+			var event *HtlcEvent
+			select {
+			case event = <-sub.Events:
+			case <-time.After(5 * time.Second):
+				t.Fatalf("event %v not received", eventType)
+			}
+
+			if event.Type != eventType {
+				t.Fatalf("expected event %v, got %v", eventType,
+					event.Type)
+			}
+			if event.IncomingCircuit != pkt.inKey() {
+				t.Fatalf("expected incoming circuit %v, got %v",
+					pkt.inKey(), event.IncomingCircuit)
+			}
+			if event.PaymentHash != paymentHash {
+				t.Fatalf("expected payment hash %x, got %x",
+					paymentHash, event.PaymentHash)
+			}
+			if event.IncomingAmt != 1100 || event.OutgoingAmt != 1000 {
+				t.Fatalf("unexpected amounts: in=%v, out=%v",
+					event.IncomingAmt, event.OutgoingAmt)
+			}
+			if event.Timestamp.IsZero() {
+				t.Fatalf("event %v has no timestamp", i)
+			}
+
+			// The ID of the outgoing HTLC is only known once it
+			// has been added to the channel.
+			var expectedHtlcID uint64
+			if i >= 2 {
+				expectedHtlcID = 4
+			}
+			if event.OutgoingCircuit.HtlcID != expectedHtlcID {
+				t.Fatalf("expected outgoing htlc id %v, got %v",
+					expectedHtlcID,
+					event.OutgoingCircuit.HtlcID)
+			}
+		}
+	}
+
+	// Once the first client cancels its subscription, only the second
+	// client should receive any further events.
+	first.Cancel()
+	notifier.NotifyLinkFailEvent(pkt, "insufficient bandwidth")
+
+	select {
+	case event := <-second.Events:
+		if event.Type != HtlcEventLinkFail {
+			t.Fatalf("expected event %v, got %v", HtlcEventLinkFail,
+				event.Type)
+		}
+		if event.FailureReason != "insufficient bandwidth" {
+			t.Fatalf("unexpected failure reason: %v",
+				event.FailureReason)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("event %v not received", HtlcEventLinkFail)
+	}
+
+	select {
+	case event := <-first.Events:
+		t.Fatalf("cancelled client received event %v", event.Type)
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+// TestHtlcNotifierNil asserts that notifications sent to a nil notifier,
+// which is used when no notifier is configured, are a no-op.
+func TestHtlcNotifierNil(t *testing.T) {
+	t.Parallel()
+
+	var notifier *HtlcNotifier
+	notifier.NotifyForwardEvent(&htlcPacket{htlc: &lnwire.UpdateAddHTLC{}})
+	notifier.NotifyDropEvent(&htlcPacket{}, "deadline exceeded")
+}
@@ -0,0 +1,332 @@
+package htlcswitch
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// DefaultInterceptTimeout is the default duration a forwarded HTLC is held
+// by the forward interceptor before it's failed back to its source.
+const DefaultInterceptTimeout = 30 * time.Second
+
+var (
+	// ErrInterceptorActive is returned when a forward interceptor is set
+	// while another interceptor is already registered with the switch.
+	ErrInterceptorActive = errors.New("forward interceptor already active")
+
+	// ErrForwardNotHeld is returned when a resolution is received for a
+	// forward which isn't currently held by the interceptor, either as it
+	// was never intercepted, or as it has already been resolved.
+	ErrForwardNotHeld = errors.New("forward not held by interceptor")
+
+	// ErrInvalidPreimage is returned when a held forward is settled with a
+	// preimage that doesn't match its payment hash.
+	ErrInvalidPreimage = errors.New("preimage doesn't match payment hash")
+)
+
+// FwdAction is the action taken to resolve a forward which is held by the
+// interceptor.
+type FwdAction uint8
+
+const (
+	// FwdActionResume forwards the HTLC to its outgoing channel, as if it
+	// was never intercepted.
+	FwdActionResume FwdAction = iota
+
+	// FwdActionFail fails the HTLC back to its source.
+	FwdActionFail
+
+	// FwdActionSettle settles the HTLC back to its source with the
+	// preimage provided by the interceptor, without forwarding it.
+	FwdActionSettle
+)
+
+// String returns a human readable version of the action.
+func (a FwdAction) String() string {
+	switch a {
+	case FwdActionResume:
+		return "Resume"
+	case FwdActionFail:
+		return "Fail"
+	case FwdActionSettle:
+		return "Settle"
+	default:
+		return "Unknown"
+	}
+}
+
+// InterceptedForward describes a forwarded HTLC which is held by the switch
+// until the interceptor decides how it should be resolved.
+type InterceptedForward struct {
+	// IncomingCircuit identifies the HTLC on its incoming channel, and is
+	// used to refer to the forward when resolving it.
+	IncomingCircuit CircuitKey
+
+	// OutgoingChanID is the channel requested by the onion of the HTLC to
+	// forward it over.
+	OutgoingChanID lnwire.ShortChannelID
+
+	// PaymentHash is the payment hash of the HTLC.
+	PaymentHash [32]byte
+
+	// IncomingAmt is the amount of the incoming HTLC.
+	IncomingAmt lnwire.MilliSatoshi
+
+	// OutgoingAmt is the amount the onion requests to be forwarded.
+	OutgoingAmt lnwire.MilliSatoshi
+
+	// IncomingExpiry is the absolute timeout of the incoming HTLC.
+	IncomingExpiry uint32
+
+	// OutgoingExpiry is the absolute timeout the onion requests for the
+	// outgoing HTLC.
+	OutgoingExpiry uint32
+
+	// Deadline is the time at which the forward is failed back to its
+	// source if it hasn't been resolved by the interceptor.
+	Deadline time.Time
+}
+
+// FwdResolution is the decision of the interceptor on how to resolve a held
+// forward.
+type FwdResolution struct {
+	// IncomingCircuit identifies the held forward.
+	IncomingCircuit CircuitKey
+
+	// Action is the action to take.
+	Action FwdAction
+
+	// Preimage is the preimage to settle the HTLC with. This is only used
+	// for FwdActionSettle.
+	Preimage [32]byte
+}
+
+// ForwardInterceptor is called by the switch for each forwarded HTLC it
+// holds on behalf of the interceptor. The forward must subsequently be
+// resolved through ResolveInterceptedForward.
+//
+// NOTE: The interceptor is called from the main event loop of the switch, and
+// therefore must not block.
+type ForwardInterceptor func(*InterceptedForward)
+
+// heldForward is a forward held by the switch on behalf of the interceptor.
+type heldForward struct {
+	packet *htlcPacket
+
+	// timer fails the forward back to its source once its deadline is
+	// exceeded.
+	timer *time.Timer
+}
+
+// SetInterceptor registers the interceptor which will be handed all HTLCs
+// forwarded by the switch from this point onwards. Only a single interceptor
+// can be registered at a time.
+func (s *Switch) SetInterceptor(interceptor ForwardInterceptor) error {
+	s.interceptorMtx.Lock()
+	defer s.interceptorMtx.Unlock()
+
+	if s.interceptor != nil {
+		return ErrInterceptorActive
+	}
+
+	log.Infof("Forward interceptor registered")
+
+	s.interceptor = interceptor
+	return nil
+}
+
+// ClearInterceptor unregisters the current interceptor. To fail safe, all
+// forwards it still holds are failed back to their source, as the decision
+// on how to resolve them was delegated to the interceptor.
+func (s *Switch) ClearInterceptor() {
+	s.interceptorMtx.Lock()
+	s.interceptor = nil
+	held := s.heldForwards
+	s.heldForwards = make(map[CircuitKey]*heldForward)
+	s.interceptorMtx.Unlock()
+
+	log.Infof("Forward interceptor unregistered, failing %v held "+
+		"forwards", len(held))
+
+	for _, fwd := range held {
+		fwd.timer.Stop()
+		s.failHeldForward(fwd.packet, "interceptor unregistered")
+	}
+}
+
+// interceptForward hands the forwarded HTLC of the packet to the interceptor,
+// if any is registered. True is returned if the packet is now held, in which
+// case the switch shouldn't forward it any further.
+func (s *Switch) interceptForward(packet *htlcPacket) bool {
+	// Packets which were held before have already been resolved by the
+	// interceptor.
+	if packet.intercepted {
+		return false
+	}
+
+	s.interceptorMtx.Lock()
+	defer s.interceptorMtx.Unlock()
+
+	if s.interceptor == nil {
+		return false
+	}
+
+	htlc, ok := packet.htlc.(*lnwire.UpdateAddHTLC)
+	if !ok {
+		return false
+	}
+
+	key := packet.inKey()
+	if _, ok := s.heldForwards[key]; ok {
+		log.Warnf("Forward %v already held by interceptor", key)
+		return true
+	}
+
+	timeout := s.cfg.InterceptTimeout
+	if timeout == 0 {
+		timeout = DefaultInterceptTimeout
+	}
+
+	packet.intercepted = true
+	s.heldForwards[key] = &heldForward{
+		packet: packet,
+		timer: time.AfterFunc(timeout, func() {
+			s.expireHeldForward(key)
+		}),
+	}
+
+	log.Debugf("Holding forward %v for interceptor", key)
+
+	s.interceptor(&InterceptedForward{
+		IncomingCircuit: key,
+		OutgoingChanID:  packet.outgoingChanID,
+		PaymentHash:     htlc.PaymentHash,
+		IncomingAmt:     packet.incomingAmount,
+		OutgoingAmt:     packet.amount,
+		IncomingExpiry:  packet.incomingTimeout,
+		OutgoingExpiry:  packet.outgoingTimeout,
+		Deadline:        time.Now().Add(timeout),
+	})
+
+	return true
+}
+
+// ResolveInterceptedForward resolves a forward held by the interceptor with
+// the given action.
+func (s *Switch) ResolveInterceptedForward(res *FwdResolution) error {
+	s.interceptorMtx.Lock()
+	fwd, ok := s.heldForwards[res.IncomingCircuit]
+	if !ok {
+		s.interceptorMtx.Unlock()
+		return ErrForwardNotHeld
+	}
+
+	// Before releasing the forward, we'll make sure that a settle can
+	// actually be carried out.
+	htlc := fwd.packet.htlc.(*lnwire.UpdateAddHTLC)
+	if res.Action == FwdActionSettle &&
+		sha256.Sum256(res.Preimage[:]) != htlc.PaymentHash {
+
+		s.interceptorMtx.Unlock()
+		return ErrInvalidPreimage
+	}
+
+	fwd.timer.Stop()
+	delete(s.heldForwards, res.IncomingCircuit)
+	s.interceptorMtx.Unlock()
+
+	log.Debugf("Resolving held forward %v with action %v",
+		res.IncomingCircuit, res.Action)
+
+	switch res.Action {
+	case FwdActionResume:
+		return s.route(fwd.packet)
+
+	case FwdActionFail:
+		return s.failHeldForward(fwd.packet, "failed by interceptor")
+
+	case FwdActionSettle:
+		return s.settleHeldForward(fwd.packet, res.Preimage)
+
+	default:
+		// An unknown action would leave the HTLC dangling, so we fail
+		// it back instead.
+		s.failHeldForward(fwd.packet, "unknown interceptor action")
+		return fmt.Errorf("unknown action %v", res.Action)
+	}
+}
+
+// expireHeldForward fails a held forward back to its source once its
+// deadline is exceeded without a resolution from the interceptor.
+func (s *Switch) expireHeldForward(key CircuitKey) {
+	s.interceptorMtx.Lock()
+	fwd, ok := s.heldForwards[key]
+	if ok {
+		delete(s.heldForwards, key)
+	}
+	s.interceptorMtx.Unlock()
+
+	if !ok {
+		return
+	}
+
+	log.Warnf("Held forward %v wasn't resolved in time by interceptor",
+		key)
+
+	s.failHeldForward(fwd.packet, "interceptor timeout")
+}
+
+// failHeldForward fails a held forward back to its source with a temporary
+// channel failure.
+func (s *Switch) failHeldForward(packet *htlcPacket, reason string) error {
+	var failure lnwire.FailureMessage
+	update, err := s.cfg.FetchLastChannelUpdate(packet.outgoingChanID)
+	if err != nil {
+		failure = &lnwire.FailTemporaryNodeFailure{}
+	} else {
+		failure = lnwire.NewTemporaryChannelFailure(update)
+	}
+
+	failErr := fmt.Errorf("forward %v %v", packet.inKey(), reason)
+	err = s.failAddPacket(packet, failure, failErr)
+	if err == failErr {
+		return nil
+	}
+
+	return err
+}
+
+// settleHeldForward settles a held forward back to its source with the given
+// preimage.
+func (s *Switch) settleHeldForward(packet *htlcPacket,
+	preimage [32]byte) error {
+
+	settlePkt := &htlcPacket{
+		sourceRef:      packet.sourceRef,
+		incomingChanID: packet.incomingChanID,
+		incomingHTLCID: packet.incomingHTLCID,
+		circuit:        packet.circuit,
+		htlc: &lnwire.UpdateFulfillHTLC{
+			PaymentPreimage: preimage,
+			Marked:          packet.marked,
+		},
+		marked: packet.marked,
+	}
+
+	return s.mailOrchestrator.Deliver(settlePkt.incomingChanID, settlePkt)
+}
+
+// stopInterceptor stops the timers of all held forwards. The forwards are
+// left in place, and will be reforwarded by their incoming link upon restart.
+func (s *Switch) stopInterceptor() {
+	s.interceptorMtx.Lock()
+	defer s.interceptorMtx.Unlock()
+
+	for _, fwd := range s.heldForwards {
+		fwd.timer.Stop()
+	}
+}
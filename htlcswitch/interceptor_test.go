@@ -0,0 +1,210 @@
+package htlcswitch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/btcsuite/fastsha256"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestSwitchForwardInterceptor asserts that forwarded HTLCs are held while an
+// interceptor is registered, and that they're resumed, settled or failed in
+// accordance with its decisions.
+func TestSwitchForwardInterceptor(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	s.cfg.InterceptTimeout = 500 * time.Millisecond
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := newMockChannelLink(
+		s, chanID2, bobChanID, bobPeer, true,
+	)
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	intercepted := make(chan *InterceptedForward, 3)
+	err = s.SetInterceptor(func(fwd *InterceptedForward) {
+		intercepted <- fwd
+	})
+	if err != nil {
+		t.Fatalf("unable to set interceptor: %v", err)
+	}
+
+	// Only a single interceptor may be registered at a time.
+	err = s.SetInterceptor(func(*InterceptedForward) {})
+	if err != ErrInterceptorActive {
+		t.Fatalf("expected ErrInterceptorActive, got %v", err)
+	}
+
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := fastsha256.Sum256(preimage[:])
+
+	// forwardHeld forwards an HTLC from Alice to Bob, and asserts that it
+	// is held by the interceptor instead of reaching Bob.
+	forwardHeld := func(htlcID uint64) *InterceptedForward {
+		packet := &htlcPacket{
+			incomingChanID: aliceChannelLink.ShortChanID(),
+			incomingHTLCID: htlcID,
+			outgoingChanID: bobChannelLink.ShortChanID(),
+			incomingAmount: 1100,
+			amount:         1000,
+			obfuscator:     NewMockObfuscator(),
+			htlc: &lnwire.UpdateAddHTLC{
+				PaymentHash: rhash,
+				Amount:      1000,
+			},
+		}
+		if err := s.forward(packet); err != nil {
+			t.Fatalf("unable to forward htlc: %v", err)
+		}
+
+		var fwd *InterceptedForward
+		select {
+		case fwd = <-intercepted:
+		case <-time.After(time.Second):
+			t.Fatalf("htlc %v was not intercepted", htlcID)
+		}
+
+		if fwd.IncomingCircuit != packet.inKey() {
+			t.Fatalf("expected circuit %v, got %v",
+				packet.inKey(), fwd.IncomingCircuit)
+		}
+		if fwd.OutgoingChanID != bobChannelLink.ShortChanID() {
+			t.Fatalf("expected outgoing channel %v, got %v",
+				bobChannelLink.ShortChanID(), fwd.OutgoingChanID)
+		}
+		if fwd.PaymentHash != rhash || fwd.IncomingAmt != 1100 ||
+			fwd.OutgoingAmt != 1000 {
+
+			t.Fatalf("intercepted forward mismatch: %v", fwd)
+		}
+
+		select {
+		case <-bobChannelLink.packets:
+			t.Fatalf("held htlc %v was forwarded", htlcID)
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		return fwd
+	}
+
+	// The first HTLC is resumed, and should be forwarded to Bob as usual.
+	fwd := forwardHeld(0)
+	err = s.ResolveInterceptedForward(&FwdResolution{
+		IncomingCircuit: fwd.IncomingCircuit,
+		Action:          FwdActionResume,
+	})
+	if err != nil {
+		t.Fatalf("unable to resume forward: %v", err)
+	}
+	select {
+	case <-bobChannelLink.packets:
+	case <-time.After(time.Second):
+		t.Fatal("resumed htlc was not forwarded")
+	}
+
+	// A forward can only be resolved once.
+	err = s.ResolveInterceptedForward(&FwdResolution{
+		IncomingCircuit: fwd.IncomingCircuit,
+		Action:          FwdActionFail,
+	})
+	if err != ErrForwardNotHeld {
+		t.Fatalf("expected ErrForwardNotHeld, got %v", err)
+	}
+
+	// The second HTLC is settled by the interceptor, which requires the
+	// preimage to match the payment hash.
+	fwd = forwardHeld(1)
+	err = s.ResolveInterceptedForward(&FwdResolution{
+		IncomingCircuit: fwd.IncomingCircuit,
+		Action:          FwdActionSettle,
+	})
+	if err != ErrInvalidPreimage {
+		t.Fatalf("expected ErrInvalidPreimage, got %v", err)
+	}
+	err = s.ResolveInterceptedForward(&FwdResolution{
+		IncomingCircuit: fwd.IncomingCircuit,
+		Action:          FwdActionSettle,
+		Preimage:        preimage,
+	})
+	if err != nil {
+		t.Fatalf("unable to settle forward: %v", err)
+	}
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		settle, ok := pkt.htlc.(*lnwire.UpdateFulfillHTLC)
+		if !ok || settle.PaymentPreimage != preimage {
+			t.Fatalf("expected settle with preimage %x, got %v",
+				preimage, pkt.htlc)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("settle was not sent back to alice")
+	}
+
+	// The third HTLC isn't resolved by the interceptor, and should be
+	// failed back to Alice once its deadline is exceeded.
+	forwardHeld(2)
+	select {
+	case pkt := <-aliceChannelLink.packets:
+		if _, ok := pkt.htlc.(*lnwire.UpdateFailHTLC); !ok {
+			t.Fatalf("expected fail, got %v", pkt.htlc)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expired htlc was not failed back to alice")
+	}
+	select {
+	case <-bobChannelLink.packets:
+		t.Fatal("expired htlc was forwarded")
+	default:
+	}
+
+	// Once the interceptor is cleared, HTLCs are forwarded as usual.
+	s.ClearInterceptor()
+	packet := &htlcPacket{
+		incomingChanID: aliceChannelLink.ShortChanID(),
+		incomingHTLCID: 3,
+		outgoingChanID: bobChannelLink.ShortChanID(),
+		obfuscator:     NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			PaymentHash: rhash,
+			Amount:      1000,
+		},
+	}
+	if err := s.forward(packet); err != nil {
+		t.Fatalf("unable to forward htlc: %v", err)
+	}
+	select {
+	case <-bobChannelLink.packets:
+	case <-time.After(time.Second):
+		t.Fatal("htlc was not forwarded after clearing interceptor")
+	}
+}
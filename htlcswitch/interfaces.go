@@ -19,6 +19,14 @@ type InvoiceDatabase interface {
 	// SettleInvoice attempts to mark an invoice corresponding to the
 	// passed payment hash as fully settled.
 	SettleInvoice(payHash chainhash.Hash, paidAmount lnwire.MilliSatoshi) error
+
+	// ValidatePaymentSecret checks the payment secret the sender of an
+	// HTLC paying to the passed payment hash included within the onion
+	// payload of the exit hop against the secret of the invoice. An error
+	// is returned if the secrets don't match, or if the invoice requires
+	// a secret yet none was included.
+	ValidatePaymentSecret(payHash chainhash.Hash,
+		secret PaymentSecret) error
 }
 
 // ChannelLink is an interface which represents the subsystem for managing the
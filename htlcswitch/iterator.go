@@ -37,6 +37,39 @@ func (c NetworkHop) String() string {
 	}
 }
 
+// PaymentSecretSize is the number of bytes of the payment secret of an invoice
+// that are carried within the onion payload of the exit hop. As the exit hop
+// has no use for the 8 byte next address of its payload, the secret is spread
+// across the next address and the 12 byte padding of the payload.
+const PaymentSecretSize = 20
+
+// PaymentSecret is the portion of the payment secret of an invoice that's
+// carried within the onion payload of the exit hop.
+type PaymentSecret [PaymentSecretSize]byte
+
+// NewPaymentSecret returns the portion of the passed invoice payment secret
+// that's carried within the onion.
+func NewPaymentSecret(secret [32]byte) PaymentSecret {
+	var p PaymentSecret
+	copy(p[:], secret[:])
+	return p
+}
+
+// Encode writes the payment secret into the passed payload of the exit hop.
+func (p PaymentSecret) Encode(hopData *sphinx.HopData) {
+	n := copy(hopData.NextAddress[:], p[:])
+	copy(hopData.ExtraBytes[:], p[n:])
+}
+
+// decodePaymentSecret reads the payment secret from the passed payload of the
+// exit hop.
+func decodePaymentSecret(hopData *sphinx.HopData) PaymentSecret {
+	var p PaymentSecret
+	n := copy(p[:], hopData.NextAddress[:])
+	copy(p[n:], hopData.ExtraBytes[:])
+	return p
+}
+
 var (
 	// exitHop is a special "hop" which denotes that an incoming HTLC is
 	// meant to pay finally to the receiving node.
@@ -70,6 +103,11 @@ type ForwardingInfo struct {
 	// in the outgoing HTLC.
 	OutgoingCTLV uint32
 
+	// PaymentSecret is the payment secret of the invoice the HTLC pays
+	// to, as included by the sender. This is only set if we're the exit
+	// hop, and is all zeroes if the sender didn't include a secret.
+	PaymentSecret PaymentSecret
+
 	// TODO(roasbeef): modify sphinx logic to not just discard the
 	// remaining bytes, instead should include the rest as excess
 }
@@ -142,10 +180,14 @@ func (r *sphinxHopIterator) EncodeNextHop(w io.Writer) error {
 func (r *sphinxHopIterator) ForwardingInstructions() ForwardingInfo {
 	fwdInst := r.processedPacket.ForwardingInstructions
 
-	var nextHop lnwire.ShortChannelID
+	var (
+		nextHop       lnwire.ShortChannelID
+		paymentSecret PaymentSecret
+	)
 	switch r.processedPacket.Action {
 	case sphinx.ExitNode:
 		nextHop = exitHop
+		paymentSecret = decodePaymentSecret(&fwdInst)
 	case sphinx.MoreHops:
 		s := binary.BigEndian.Uint64(fwdInst.NextAddress[:])
 		nextHop = lnwire.NewShortChanIDFromInt(s)
@@ -156,6 +198,7 @@ func (r *sphinxHopIterator) ForwardingInstructions() ForwardingInfo {
 		NextHop:         nextHop,
 		AmountToForward: lnwire.MilliSatoshi(fwdInst.ForwardAmount),
 		OutgoingCTLV:    fwdInst.OutgoingCltv,
+		PaymentSecret:   paymentSecret,
 	}
 }
 
@@ -236,6 +236,12 @@ type ChannelLinkConfig struct {
 	// fee rate. A random timeout will be selected between these values.
 	MinFeeUpdateTimeout time.Duration
 	MaxFeeUpdateTimeout time.Duration
+
+	// HtlcNotifier is used to notify subscribers of the adding, queueing
+	// and failing of outgoing HTLCs by the link.
+	//
+	// NOTE: This is optional, no events are sent if unset.
+	HtlcNotifier *HtlcNotifier
 }
 
 // channelLink is the service which drives a channel's commitment update
@@ -1280,6 +1286,7 @@ out:
 					l.batchCounter))
 
 				l.overflowQueue.AddPkt(pkt)
+				l.cfg.HtlcNotifier.NotifyQueueEvent(pkt)
 				continue
 			}
 
@@ -1367,6 +1374,10 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 			deadline := htlc.Crafted.Add(htlc.Timeout)
 			if deadline.Before(now) {
 				debug_print("going to send back failure message\n")
+				l.cfg.HtlcNotifier.NotifyDropEvent(pkt, fmt.Sprintf(
+					"deadline %v exceeded", deadline,
+				))
+
 				// send failure message back. Other details don't matter anymore.
 				var (
 					localFailure = false
@@ -1464,6 +1475,7 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 					l.batchCounter))
 
 				l.overflowQueue.AddPkt(pkt)
+				l.cfg.HtlcNotifier.NotifyQueueEvent(pkt)
 				return
 			case lnwallet.ErrBelowChanReserve:
 				// CHECK: if the flag is off, then will just fall through to the default case.
@@ -1474,6 +1486,7 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 						htlc.PaymentHash[:],
 						l.batchCounter))
 					l.overflowQueue.AddPkt(pkt)
+					l.cfg.HtlcNotifier.NotifyQueueEvent(pkt)
 					return
 				}
 				fallthrough
@@ -1483,6 +1496,7 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 			// cancel the pending payment.
 			default:
 				l.warnf("Unable to handle downstream add HTLC: %v", err)
+				l.cfg.HtlcNotifier.NotifyLinkFailEvent(pkt, err.Error())
 
 				var (
 					localFailure = false
@@ -1572,6 +1586,8 @@ func (l *channelLink) handleDownStreamPkt(pkt *htlcPacket, isReProcess bool) {
 		l.openedCircuits = append(l.openedCircuits, pkt.inKey())
 		l.keystoneBatch = append(l.keystoneBatch, pkt.keystone())
 
+		l.cfg.HtlcNotifier.NotifyAddEvent(pkt)
+
 		l.cfg.Peer.SendMessage(false, htlc)
 		// at this point we know that the packet is definitely inflight
 		// FIXME: does this need to be atomic?
@@ -2928,6 +2944,28 @@ func (l *channelLink) processRemoteAdds(fwdPkg *channeldb.FwdPkg,
 				continue
 			}
 
+			// Finally, we'll make sure the sender knows the
+			// payment secret of the invoice, if it has one. We
+			// fail the HTLC the same way as if the invoice was
+			// unknown, such that it can't be used to probe us.
+			err = l.cfg.Registry.ValidatePaymentSecret(
+				invoiceHash, fwdInfo.PaymentSecret,
+			)
+			if !l.cfg.DebugHTLC && err != nil {
+				log.Errorf("Onion payload of incoming "+
+					"htlc(%x) has invalid payment secret: "+
+					"%v", pd.RHash[:], err)
+
+				failure := lnwire.FailUnknownPaymentHash{}
+				l.sendHTLCError(
+					pd.HtlcIndex, failure, obfuscator,
+					pd.SourceRef, pd.Marked,
+				)
+
+				needUpdate = true
+				continue
+			}
+
 			preimage := invoice.Terms.PaymentPreimage
 			err = l.channel.SettleHTLC(
 				preimage, pd.HtlcIndex, pd.SourceRef, nil, nil, pd.Marked,
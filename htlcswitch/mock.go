@@ -730,6 +730,12 @@ func (i *mockInvoiceRegistry) SettleInvoice(rhash chainhash.Hash,
 	return nil
 }
 
+func (i *mockInvoiceRegistry) ValidatePaymentSecret(rhash chainhash.Hash,
+	secret PaymentSecret) error {
+
+	return nil
+}
+
 func (i *mockInvoiceRegistry) AddInvoice(invoice channeldb.Invoice) error {
 	i.Lock()
 	defer i.Unlock()
@@ -93,6 +93,10 @@ type htlcPacket struct {
 	// will be extraced from the hop payload recevived by the incoming
 	// link.
 	outgoingTimeout uint32
+
+	// intercepted is set once the packet has been held by the forward
+	// interceptor, such that it isn't intercepted again when resumed.
+	intercepted bool
 }
 
 // inKey returns the circuit key used to identify the incoming htlc.
@@ -0,0 +1,800 @@
+package htlcswitch
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcutil"
+	"github.com/go-errors/errors"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/contractcourt"
+	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/ticker"
+)
+
+const (
+	// simStartingHeight is the block height the simulated nodes start at.
+	simStartingHeight = 100
+
+	// simBatchTimeout is the interval of virtual time after which the
+	// links of the simulated nodes commit any pending updates. The
+	// simulation advances its clock in steps of this size.
+	simBatchTimeout = 50 * time.Millisecond
+
+	// simDrainTime is the amount of virtual time the simulation keeps
+	// running after the last payment has been sent, to allow in-flight
+	// payments to complete.
+	simDrainTime = 30 * time.Second
+
+	// simQuietPeriod is the real time during which no activity must be
+	// observed within the network for it to be considered idle.
+	simQuietPeriod = 20 * time.Millisecond
+
+	// simIdleTimeout is the real time after which the simulation gives up
+	// on the network becoming idle. It only guards against a network that
+	// never settles, and has no bearing on the outcome of a simulation.
+	simIdleTimeout = time.Minute
+)
+
+// simChannel describes a channel of a simulated network, along with the
+// initial balance of each of its ends in satoshis.
+type simChannel struct {
+	Node1    string         `json:"node1"`
+	Node2    string         `json:"node2"`
+	Balance1 btcutil.Amount `json:"balance1"`
+	Balance2 btcutil.Amount `json:"balance2"`
+}
+
+// simPayment is a payment of a simulated workload. The time at which it is
+// sent is given in milliseconds since the start of the simulation.
+type simPayment struct {
+	TimeMs   int64          `json:"time_ms"`
+	Sender   string         `json:"sender"`
+	Receiver string         `json:"receiver"`
+	Amount   btcutil.Amount `json:"amount"`
+}
+
+// simTrace describes a full simulation: the topology of the network, and the
+// payment workload that is replayed on top of it.
+type simTrace struct {
+	Nodes    []string     `json:"nodes"`
+	Channels []simChannel `json:"channels"`
+	Payments []simPayment `json:"payments"`
+}
+
+// loadSimTrace reads a JSON encoded simulation trace from the passed file.
+func loadSimTrace(path string) (*simTrace, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var trace simTrace
+	if err := json.NewDecoder(f).Decode(&trace); err != nil {
+		return nil, fmt.Errorf("unable to decode trace %v: %v", path,
+			err)
+	}
+
+	return &trace, nil
+}
+
+// simRouter selects the path of a payment within a simulated network. The
+// path is returned as the list of nodes the payment traverses, starting with
+// the sender and ending with the receiver. Routing algorithms can be compared
+// by replaying the same trace with different routers.
+type simRouter func(n *simNetwork, sender, receiver string,
+	amt lnwire.MilliSatoshi) ([]string, error)
+
+// shortestPathRouter is a simRouter that routes each payment over the path
+// with the least number of hops. Ties are broken by the names of the nodes,
+// such that the selected path is deterministic.
+func shortestPathRouter(n *simNetwork, sender, receiver string,
+	amt lnwire.MilliSatoshi) ([]string, error) {
+
+	prev := map[string]string{sender: ""}
+	queue := []string{sender}
+	for len(queue) > 0 && prev[receiver] == "" {
+		node := queue[0]
+		queue = queue[1:]
+
+		for _, neighbor := range n.neighbors(node) {
+			if _, ok := prev[neighbor]; ok {
+				continue
+			}
+
+			prev[neighbor] = node
+			queue = append(queue, neighbor)
+		}
+	}
+
+	if _, ok := prev[receiver]; !ok || sender == receiver {
+		return nil, fmt.Errorf("no path from %v to %v", sender,
+			receiver)
+	}
+
+	path := []string{receiver}
+	for node := receiver; node != sender; {
+		node = prev[node]
+		path = append([]string{node}, path...)
+	}
+
+	return path, nil
+}
+
+// simNodeStats are the metrics collected for a single node of a simulation.
+type simNodeStats struct {
+	// Sent is the number of payments sent by the node.
+	Sent uint64
+
+	// Succeeded is the number of payments sent by the node that were
+	// settled.
+	Succeeded uint64
+
+	// Failed is the number of payments sent by the node that failed.
+	// Payments that are still in flight at the end of the simulation
+	// count as neither succeeded nor failed.
+	Failed uint64
+
+	// AmtSucceeded is the total amount of the settled payments.
+	AmtSucceeded lnwire.MilliSatoshi
+
+	// TotalLatency is the sum of the virtual time it took each settled
+	// payment to complete.
+	TotalLatency time.Duration
+
+	// MaxQueueLen is the largest number of HTLCs that were queued on the
+	// links of the node, sampled after each step of the simulation.
+	MaxQueueLen int32
+
+	// queueLenSum and queueSamples are used to compute the average queue
+	// length.
+	queueLenSum  int64
+	queueSamples int64
+}
+
+// SuccessRate returns the fraction of the completed payments sent by the node
+// that were settled.
+func (s *simNodeStats) SuccessRate() float64 {
+	if s.Succeeded+s.Failed == 0 {
+		return 0
+	}
+
+	return float64(s.Succeeded) / float64(s.Succeeded+s.Failed)
+}
+
+// AvgQueueLen returns the average number of HTLCs queued on the links of the
+// node.
+func (s *simNodeStats) AvgQueueLen() float64 {
+	if s.queueSamples == 0 {
+		return 0
+	}
+
+	return float64(s.queueLenSum) / float64(s.queueSamples)
+}
+
+// simReport is the outcome of a simulation.
+type simReport struct {
+	// Duration is the amount of virtual time the simulation ran for.
+	Duration time.Duration
+
+	// Nodes maps the name of each node to its metrics.
+	Nodes map[string]*simNodeStats
+}
+
+// Throughput returns the amount of satoshis per second of virtual time the
+// passed node successfully sent.
+func (r *simReport) Throughput(node string) float64 {
+	stats, ok := r.Nodes[node]
+	if !ok || r.Duration == 0 {
+		return 0
+	}
+
+	return stats.AmtSucceeded.ToSatoshis().ToUnit(btcutil.AmountSatoshi) /
+		r.Duration.Seconds()
+}
+
+// WriteCSV writes the per node metrics of the report to w as CSV, with the
+// nodes ordered by name.
+func (r *simReport) WriteCSV(w io.Writer) error {
+	_, err := fmt.Fprintln(w, "node,sent,succeeded,failed,success_rate,"+
+		"throughput_sat_per_sec,avg_latency_ms,max_queue_len,"+
+		"avg_queue_len")
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(r.Nodes))
+	for name := range r.Nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		stats := r.Nodes[name]
+
+		var avgLatency time.Duration
+		if stats.Succeeded > 0 {
+			avgLatency = stats.TotalLatency /
+				time.Duration(stats.Succeeded)
+		}
+
+		_, err := fmt.Fprintf(w, "%v,%d,%d,%d,%.4f,%.2f,%d,%d,%.2f\n",
+			name, stats.Sent, stats.Succeeded, stats.Failed,
+			stats.SuccessRate(), r.Throughput(name),
+			avgLatency/time.Millisecond, stats.MaxQueueLen,
+			stats.AvgQueueLen())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// simInFlight is a payment of a simulation that hasn't completed yet.
+type simInFlight struct {
+	simPayment
+
+	result   chan error
+	sendTime time.Time
+}
+
+// simNetwork is an in-process network of real switches and channel links,
+// connected through mock servers. All tickers of the links are driven by a
+// virtual clock, such that a payment workload can be replayed independently
+// of the speed of the machine running it.
+//
+// The network is observed from the outside only: the messages exchanged by
+// the mock servers, the packets the links forward to their switches, and the
+// results of the payments all count as activity. The simulation only moves
+// on once every tick has been received and no activity has been observed for
+// a quiet period, such that the network has reacted to its previous step.
+type simNetwork struct {
+	t     testing.TB
+	clock *ticker.VirtualClock
+
+	// activity counts the events observed within the network so far. It
+	// MUST be used atomically.
+	activity uint64
+
+	router simRouter
+
+	servers map[string]*mockServer
+
+	// links maps the name of a node, and the name of one of its channel
+	// peers, to the link of the node with that peer.
+	links map[string]map[string]*channelLink
+
+	// linkErrs receives the failure of any link, which aborts the
+	// simulation.
+	linkErrs chan error
+
+	stats    map[string]*simNodeStats
+	inFlight []*simInFlight
+
+	cleanUps []func()
+}
+
+// newSimNetwork creates the network described by the topology of the passed
+// trace. Payments are routed using the given router.
+func newSimNetwork(t testing.TB, trace *simTrace,
+	router simRouter) (*simNetwork, error) {
+
+	n := &simNetwork{
+		t:        t,
+		clock:    ticker.NewVirtualClock(time.Unix(0, 0)),
+		router:   router,
+		servers:  make(map[string]*mockServer),
+		links:    make(map[string]map[string]*channelLink),
+		linkErrs: make(chan error, 1),
+		stats:    make(map[string]*simNodeStats),
+	}
+
+	// Each channel is backed by its own pair of databases, so we'll
+	// create all channels before creating the servers of the nodes.
+	type simChannelState struct {
+		channel1 *lnwallet.LightningChannel
+		channel2 *lnwallet.LightningChannel
+	}
+	states := make([]simChannelState, len(trace.Channels))
+
+	nodes := make(map[string]struct{}, len(trace.Nodes))
+	for _, name := range trace.Nodes {
+		nodes[name] = struct{}{}
+		n.stats[name] = &simNodeStats{}
+	}
+	for i, c := range trace.Channels {
+		_, ok1 := nodes[c.Node1]
+		_, ok2 := nodes[c.Node2]
+		if !ok1 || !ok2 || c.Node1 == c.Node2 {
+			n.cleanUp()
+			return nil, fmt.Errorf("invalid channel %v<->%v",
+				c.Node1, c.Node2)
+		}
+
+		_, _, chanID, _ := genIDs()
+		channel1, channel2, cleanUp, _, err := createTestChannel(
+			simPrivKey(c.Node1), simPrivKey(c.Node2), c.Balance1,
+			c.Balance2, 0, 0, chanID,
+		)
+		if err != nil {
+			n.cleanUp()
+			return nil, errors.Errorf("unable to create channel "+
+				"%v<->%v: %v", c.Node1, c.Node2, err)
+		}
+		n.cleanUps = append(n.cleanUps, cleanUp)
+
+		states[i] = simChannelState{
+			channel1: channel1,
+			channel2: channel2,
+		}
+	}
+
+	// The switch of each node stores its state in the database of one of
+	// the node's channels.
+	dbs := make(map[string]*channeldb.DB)
+	for i, c := range trace.Channels {
+		if _, ok := dbs[c.Node1]; !ok {
+			dbs[c.Node1] = states[i].channel1.State().Db
+		}
+		if _, ok := dbs[c.Node2]; !ok {
+			dbs[c.Node2] = states[i].channel2.State().Db
+		}
+	}
+
+	defaultDelta := uint32(6)
+	for _, name := range trace.Nodes {
+		server, err := newMockServer(
+			t, name, simStartingHeight, dbs[name], defaultDelta,
+		)
+		if err != nil {
+			n.cleanUp()
+			return nil, errors.Errorf("unable to create %v "+
+				"server: %v", name, err)
+		}
+
+		server.intersect(func(lnwire.Message) (bool, error) {
+			atomic.AddUint64(&n.activity, 1)
+			return false, nil
+		})
+
+		n.servers[name] = server
+		n.links[name] = make(map[string]*channelLink)
+	}
+
+	feeEstimator := &mockFeeEstimator{
+		byteFeeIn: make(chan lnwallet.SatPerKWeight),
+		quit:      make(chan struct{}),
+	}
+	pCache := &mockPreimageCache{
+		// hash -> preimage
+		preimageMap: make(map[[32]byte][]byte),
+	}
+	globalPolicy := ForwardingPolicy{
+		MinHTLC:       lnwire.NewMSatFromSatoshis(5),
+		BaseFee:       lnwire.NewMSatFromSatoshis(1),
+		TimeLockDelta: defaultDelta,
+	}
+
+	decoders := make(map[string]*mockIteratorDecoder)
+	for _, name := range trace.Nodes {
+		decoders[name] = newMockIteratorDecoder()
+	}
+
+	for i, c := range trace.Channels {
+		ends := []struct {
+			node, peer string
+			channel    *lnwallet.LightningChannel
+		}{
+			{c.Node1, c.Node2, states[i].channel1},
+			{c.Node2, c.Node1, states[i].channel2},
+		}
+
+		for _, end := range ends {
+			if _, ok := n.links[end.node][end.peer]; ok {
+				n.cleanUp()
+				return nil, fmt.Errorf("duplicate channel "+
+					"%v<->%v", c.Node1, c.Node2)
+			}
+
+			link, err := n.addLink(
+				end.node, end.peer, end.channel,
+				decoders[end.node], feeEstimator, pCache,
+				globalPolicy,
+			)
+			if err != nil {
+				n.cleanUp()
+				return nil, err
+			}
+
+			n.links[end.node][end.peer] = link
+		}
+	}
+
+	return n, nil
+}
+
+// simPrivKey derives the private key of a simulated node from its name.
+func simPrivKey(name string) []byte {
+	key := sha256.Sum256([]byte("sim priv key " + name))
+	return key[:]
+}
+
+// addLink creates the link of the passed node with the given peer, and adds it
+// to the switch of the node.
+func (n *simNetwork) addLink(node, peer string,
+	channel *lnwallet.LightningChannel, decoder *mockIteratorDecoder,
+	feeEstimator lnwallet.FeeEstimator, pCache *mockPreimageCache,
+	policy ForwardingPolicy) (*channelLink, error) {
+
+	const (
+		fwdPkgTimeout       = 15 * time.Second
+		minFeeUpdateTimeout = 30 * time.Minute
+		maxFeeUpdateTimeout = 40 * time.Minute
+	)
+
+	server := n.servers[node]
+	obfuscator := NewMockObfuscator()
+
+	// The forwarding package garbage collector has no effect on the
+	// outcome of a simulation, so its ticker never fires.
+	link := NewChannelLink(
+		ChannelLinkConfig{
+			Switch:        server.htlcSwitch,
+			FwrdingPolicy: policy,
+			Peer:          n.servers[peer],
+			Circuits:      server.htlcSwitch.CircuitModifier(),
+			ForwardPackets: func(linkQuit chan struct{},
+				pkts ...*htlcPacket) chan error {
+
+				atomic.AddUint64(&n.activity, 1)
+				return server.htlcSwitch.ForwardPackets(
+					linkQuit, pkts...,
+				)
+			},
+			DecodeHopIterators: decoder.DecodeHopIterators,
+			ExtractErrorEncrypter: func(*btcec.PublicKey) (
+				ErrorEncrypter, lnwire.FailCode) {
+				return obfuscator, lnwire.CodeNone
+			},
+			FetchLastChannelUpdate: mockGetChanUpdateMessage,
+			Registry:               server.registry,
+			FeeEstimator:           feeEstimator,
+			PreimageCache:          pCache,
+			UpdateContractSignals: func(*contractcourt.ContractSignals) error {
+				return nil
+			},
+			ChainEvents:    &contractcourt.ChainEventSubscription{},
+			SyncStates:     true,
+			BatchSize:      10,
+			BatchTicker:    n.clock.NewTicker(simBatchTimeout),
+			FwdPkgGCTicker: ticker.MockNew(fwdPkgTimeout),
+
+			MinFeeUpdateTimeout: minFeeUpdateTimeout,
+			MaxFeeUpdateTimeout: maxFeeUpdateTimeout,
+			OnChannelFailure: func(_ lnwire.ChannelID,
+				_ lnwire.ShortChannelID,
+				linkErr LinkFailureError) {
+
+				err := fmt.Errorf("%v<->%v link failed: %v",
+					node, peer, linkErr)
+				select {
+				case n.linkErrs <- err:
+				default:
+				}
+			},
+		},
+		channel,
+	).(*channelLink)
+
+	if err := server.htlcSwitch.AddLink(link); err != nil {
+		return nil, errors.Errorf("unable to add %v<->%v link: %v",
+			node, peer, err)
+	}
+	go func() {
+		for {
+			select {
+			case <-link.htlcUpdates:
+			case <-link.quit:
+				return
+			}
+		}
+	}()
+
+	return link, nil
+}
+
+// neighbors returns the names of the channel peers of the passed node, in
+// lexicographic order.
+func (n *simNetwork) neighbors(node string) []string {
+	neighbors := make([]string, 0, len(n.links[node]))
+	for peer := range n.links[node] {
+		neighbors = append(neighbors, peer)
+	}
+	sort.Strings(neighbors)
+
+	return neighbors
+}
+
+// start starts the servers of all nodes, and waits for the links to sync
+// their channel states.
+func (n *simNetwork) start() error {
+	for name, server := range n.servers {
+		if err := server.Start(); err != nil {
+			return errors.Errorf("unable to start %v server: %v",
+				name, err)
+		}
+	}
+
+	return n.waitIdle()
+}
+
+// stop stops the servers of all nodes and removes their databases.
+func (n *simNetwork) stop() {
+	var wg sync.WaitGroup
+	for _, server := range n.servers {
+		wg.Add(1)
+		go func(server *mockServer) {
+			defer wg.Done()
+			server.Stop()
+		}(server)
+	}
+	wg.Wait()
+
+	n.cleanUp()
+}
+
+// cleanUp removes the databases of all channels.
+func (n *simNetwork) cleanUp() {
+	for _, cleanUp := range n.cleanUps {
+		cleanUp()
+	}
+	n.cleanUps = nil
+}
+
+// run replays the passed payments on top of the network. The virtual clock is
+// advanced in steps of the batch timeout of the links. Within each step, the
+// tickers that are due fire one at a time, and the network is given the chance
+// to fully react to each tick before the next one fires. The queues of the
+// links are sampled at the end of each step. Once all payments have been sent,
+// the simulation keeps running until either all payments have completed, or
+// the drain time has passed.
+func (n *simNetwork) run(payments []simPayment) (*simReport, error) {
+	payments = append([]simPayment(nil), payments...)
+	sort.SliceStable(payments, func(i, j int) bool {
+		return payments[i].TimeMs < payments[j].TimeMs
+	})
+
+	start := n.clock.Now()
+
+	end := start.Add(simDrainTime)
+	if len(payments) > 0 {
+		lastPayment := payments[len(payments)-1].TimeMs
+		end = end.Add(time.Duration(lastPayment) * time.Millisecond)
+	}
+
+	for n.clock.Now().Before(end) {
+		elapsed := n.clock.Now().Sub(start)
+		for len(payments) > 0 {
+			sendTime := time.Duration(payments[0].TimeMs) *
+				time.Millisecond
+			if sendTime > elapsed {
+				break
+			}
+
+			if err := n.sendPayment(payments[0]); err != nil {
+				return nil, err
+			}
+			payments = payments[1:]
+		}
+
+		stepEnd := n.clock.Now().Add(simBatchTimeout)
+		for n.clock.Step(stepEnd) {
+			if err := n.waitIdle(); err != nil {
+				return nil, err
+			}
+			n.collectResults()
+		}
+		n.sampleQueues()
+
+		if len(payments) == 0 && len(n.inFlight) == 0 {
+			break
+		}
+	}
+
+	report := &simReport{
+		Duration: n.clock.Now().Sub(start),
+		Nodes:    make(map[string]*simNodeStats, len(n.stats)),
+	}
+	for name, stats := range n.stats {
+		s := *stats
+		report.Nodes[name] = &s
+	}
+
+	return report, nil
+}
+
+// sendPayment routes the passed payment and hands it to the switch of its
+// sender, waiting for the network to react to it. A payment that can't be
+// sent is recorded as failed in the stats of its sender, and only a failure of
+// the network itself is returned.
+func (n *simNetwork) sendPayment(p simPayment) error {
+	stats, ok := n.stats[p.Sender]
+	if _, known := n.stats[p.Receiver]; !ok || !known {
+		n.t.Logf("skipping payment from %v to %v: unknown node",
+			p.Sender, p.Receiver)
+		return nil
+	}
+	stats.Sent++
+
+	fail := func(err error) {
+		n.t.Logf("payment of %v from %v to %v failed: %v", p.Amount,
+			p.Sender, p.Receiver, err)
+		stats.Failed++
+	}
+
+	amt := lnwire.NewMSatFromSatoshis(p.Amount)
+	path, err := n.router(n, p.Sender, p.Receiver, amt)
+	if err != nil {
+		fail(err)
+		return nil
+	}
+
+	// The hops of the payment are described by the links of the nodes
+	// receiving the HTLC at each hop.
+	hopLinks := make([]*channelLink, 0, len(path)-1)
+	for i := 1; i < len(path); i++ {
+		link, ok := n.links[path[i]][path[i-1]]
+		if !ok {
+			fail(fmt.Errorf("no channel %v<->%v", path[i-1],
+				path[i]))
+			return nil
+		}
+		hopLinks = append(hopLinks, link)
+	}
+
+	htlcAmt, totalTimelock, hops := generateHops(
+		amt, simStartingHeight, hopLinks...,
+	)
+	blob, err := generateRoute(hops...)
+	if err != nil {
+		fail(err)
+		return nil
+	}
+	invoice, htlc, err := generatePayment(amt, htlcAmt, totalTimelock, blob)
+	if err != nil {
+		fail(err)
+		return nil
+	}
+
+	receiver := n.servers[p.Receiver]
+	if err := receiver.registry.AddInvoice(*invoice); err != nil {
+		fail(err)
+		return nil
+	}
+
+	// The payment is sent in the background, such that the simulation
+	// can keep advancing the clock until its result is in.
+	sender := n.servers[p.Sender]
+	firstHop := hopLinks[0].ShortChanID()
+	result := make(chan error, 1)
+	go func() {
+		_, err, _ := sender.htlcSwitch.SendHTLC(
+			firstHop, htlc, newMockDeobfuscator(),
+		)
+		result <- err
+		atomic.AddUint64(&n.activity, 1)
+	}()
+
+	n.inFlight = append(n.inFlight, &simInFlight{
+		simPayment: p,
+		result:     result,
+		sendTime:   n.clock.Now(),
+	})
+
+	if err := n.waitIdle(); err != nil {
+		return err
+	}
+	n.collectResults()
+
+	return nil
+}
+
+// waitIdle blocks until the network has become idle, or returns an error if a
+// link failed in the meantime. The network is idle once every tick of the
+// clock has been received, no message is queued at any of the mock servers,
+// and no activity has been observed for the quiet period.
+func (n *simNetwork) waitIdle() error {
+	timeout := time.After(simIdleTimeout)
+	last := atomic.LoadUint64(&n.activity)
+	for {
+		select {
+		case <-time.After(simQuietPeriod):
+
+		case err := <-n.linkErrs:
+			return err
+
+		case <-timeout:
+			return errors.New("network didn't become idle")
+		}
+
+		activity := atomic.LoadUint64(&n.activity)
+		if activity == last && n.clock.UnreceivedTicks() == 0 &&
+			!n.messagesQueued() {
+
+			return nil
+		}
+		last = activity
+	}
+}
+
+// messagesQueued returns whether any of the mock servers has messages queued
+// that it hasn't handed to its links yet.
+func (n *simNetwork) messagesQueued() bool {
+	for _, server := range n.servers {
+		if len(server.messages) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// collectResults records the outcome of every in-flight payment that has
+// completed. As the network is idle, the result of a completed payment has
+// already been delivered, and its latency is measured up to the current
+// virtual time.
+func (n *simNetwork) collectResults() {
+	inFlight := n.inFlight[:0]
+	for _, f := range n.inFlight {
+		var err error
+		select {
+		case err = <-f.result:
+		default:
+			inFlight = append(inFlight, f)
+			continue
+		}
+
+		stats := n.stats[f.Sender]
+		if err != nil {
+			n.t.Logf("payment of %v from %v to %v failed: %v",
+				f.Amount, f.Sender, f.Receiver, err)
+			stats.Failed++
+			continue
+		}
+
+		stats.Succeeded++
+		stats.AmtSucceeded += lnwire.NewMSatFromSatoshis(f.Amount)
+		stats.TotalLatency += n.clock.Now().Sub(f.sendTime)
+	}
+	n.inFlight = inFlight
+}
+
+// sampleQueues records the number of HTLCs currently queued on the links of
+// each node.
+func (n *simNetwork) sampleQueues() {
+	for name, links := range n.links {
+		var queueLen int32
+		for _, link := range links {
+			queueLen += link.overflowQueue.Length()
+		}
+
+		stats := n.stats[name]
+		stats.queueLenSum += int64(queueLen)
+		stats.queueSamples++
+		if queueLen > stats.MaxQueueLen {
+			stats.MaxQueueLen = queueLen
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package htlcswitch
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"testing"
+)
+
+const defaultSimTrace = "testdata/simulation_trace.json"
+
+var (
+	// simTraceFile is the trace replayed by TestSimulation. Custom traces
+	// can be replayed with: go test -run TestSimulation -args
+	// -simtrace=<file>.
+	simTraceFile = flag.String("simtrace", defaultSimTrace,
+		"the simulation trace replayed by TestSimulation")
+
+	// simReportFile is the file the CSV report of TestSimulation is
+	// written to, if set.
+	simReportFile = flag.String("simreport", "",
+		"the file the CSV report of TestSimulation is written to")
+)
+
+// runSimulation replays the passed trace on top of a fresh simulated network,
+// returning the report of the simulation along with its CSV encoding.
+func runSimulation(t *testing.T, trace *simTrace) (*simReport, []byte) {
+	n, err := newSimNetwork(t, trace, shortestPathRouter)
+	if err != nil {
+		t.Fatalf("unable to create network: %v", err)
+	}
+	if err := n.start(); err != nil {
+		n.stop()
+		t.Fatalf("unable to start network: %v", err)
+	}
+	defer n.stop()
+
+	report, err := n.run(trace.Payments)
+	if err != nil {
+		t.Fatalf("unable to run simulation: %v", err)
+	}
+
+	var b bytes.Buffer
+	if err := report.WriteCSV(&b); err != nil {
+		t.Fatalf("unable to write report: %v", err)
+	}
+
+	return report, b.Bytes()
+}
+
+// TestSimulation replays a payment trace on top of a simulated network, and
+// reports the metrics collected for each node.
+func TestSimulation(t *testing.T) {
+	t.Parallel()
+
+	trace, err := loadSimTrace(*simTraceFile)
+	if err != nil {
+		t.Fatalf("unable to load trace: %v", err)
+	}
+
+	report, csv := runSimulation(t, trace)
+	t.Logf("simulation report:\n%s", csv)
+
+	if *simReportFile != "" {
+		err := ioutil.WriteFile(*simReportFile, csv, 0644)
+		if err != nil {
+			t.Fatalf("unable to write report: %v", err)
+		}
+	}
+
+	// Every payment of the default trace easily fits within the balances
+	// of the channels, so all of them should succeed.
+	if *simTraceFile != defaultSimTrace {
+		return
+	}
+
+	var sent, succeeded uint64
+	for _, stats := range report.Nodes {
+		sent += stats.Sent
+		succeeded += stats.Succeeded
+	}
+	if sent != uint64(len(trace.Payments)) {
+		t.Fatalf("expected %v payments to be sent, got %v",
+			len(trace.Payments), sent)
+	}
+	if succeeded != sent {
+		t.Fatalf("expected all %v payments to succeed, %v did",
+			sent, succeeded)
+	}
+}
+
+// TestSimulationDeterministic asserts that replaying the same trace twice
+// yields the exact same report, as the network is given the chance to settle
+// after each step of the simulation.
+func TestSimulationDeterministic(t *testing.T) {
+	t.Parallel()
+
+	trace, err := loadSimTrace(defaultSimTrace)
+	if err != nil {
+		t.Fatalf("unable to load trace: %v", err)
+	}
+
+	_, first := runSimulation(t, trace)
+	_, second := runSimulation(t, trace)
+	if !bytes.Equal(first, second) {
+		t.Fatalf("simulation isn't deterministic, first report:\n%s\n"+
+			"second report:\n%s", first, second)
+	}
+}
+
+// TestShortestPathRouter asserts that the shortest path router picks the path
+// with the least number of hops, breaking ties by the names of the nodes.
+func TestShortestPathRouter(t *testing.T) {
+	t.Parallel()
+
+	// We'll only need the adjacency of the network, so there's no need to
+	// create any real links.
+	//
+	//   a -- b -- c
+	//   |         |
+	//   d -- e -- f -- g
+	n := &simNetwork{
+		links: make(map[string]map[string]*channelLink),
+	}
+	edges := [][2]string{
+		{"a", "b"}, {"b", "c"}, {"a", "d"}, {"d", "e"}, {"e", "f"},
+		{"c", "f"}, {"f", "g"},
+	}
+	for _, edge := range edges {
+		for i := 0; i < 2; i++ {
+			node, peer := edge[i], edge[1-i]
+			if n.links[node] == nil {
+				n.links[node] = make(map[string]*channelLink)
+			}
+			n.links[node][peer] = nil
+		}
+	}
+
+	tests := []struct {
+		sender, receiver string
+		path             []string
+	}{
+		{"a", "c", []string{"a", "b", "c"}},
+		{"a", "f", []string{"a", "b", "c", "f"}},
+		{"g", "a", []string{"g", "f", "c", "b", "a"}},
+		{"d", "c", []string{"d", "a", "b", "c"}},
+	}
+	for _, test := range tests {
+		path, err := shortestPathRouter(
+			n, test.sender, test.receiver, 0,
+		)
+		if err != nil {
+			t.Fatalf("unable to find path from %v to %v: %v",
+				test.sender, test.receiver, err)
+		}
+
+		if len(path) != len(test.path) {
+			t.Fatalf("expected path %v, got %v", test.path, path)
+		}
+		for i := range path {
+			if path[i] != test.path[i] {
+				t.Fatalf("expected path %v, got %v", test.path,
+					path)
+			}
+		}
+	}
+
+	if _, err := shortestPathRouter(n, "a", "x", 0); err == nil {
+		t.Fatalf("expected no path to unknown node")
+	}
+}
@@ -178,6 +178,26 @@ type Config struct {
 	// LogEventTicker is a signal instructing the htlcswitch to log
 	// aggregate stats about it's forwarding during the last interval.
 	LogEventTicker ticker.Ticker
+
+	// ResolveOrphanedPayment is called with the outcome of a locally
+	// initiated HTLC which was sent before the daemon restarted, and
+	// therefore has no application waiting for its response. A nil error
+	// indicates that the HTLC was settled with the given preimage.
+	//
+	// NOTE: This is optional, orphaned outcomes are dropped if unset.
+	ResolveOrphanedPayment func(paymentHash [32]byte, preimage [32]byte,
+		err error) error
+
+	// HtlcNotifier is used to notify subscribers of the forwarding,
+	// settling and failing of HTLCs by the switch.
+	//
+	// NOTE: This is optional, no events are sent if unset.
+	HtlcNotifier *HtlcNotifier
+
+	// InterceptTimeout is the duration a forwarded HTLC is held by the
+	// forward interceptor before it's failed back to its source. If zero,
+	// DefaultInterceptTimeout is used.
+	InterceptTimeout time.Duration
 }
 
 // Switch is the central messaging bus for all incoming/outgoing HTLCs.
@@ -279,6 +299,14 @@ type Switch struct {
 	// active ChainNotifier instance. This will be used to retrieve the
 	// lastest height of the chain.
 	blockEpochStream *chainntnfs.BlockEpochEvent
+
+	// interceptor, if set, decides how each forwarded HTLC is resolved
+	// before the switch dispatches it. heldForwards holds the forwards
+	// awaiting a decision, indexed by their incoming circuit key. Both
+	// are guarded by interceptorMtx.
+	interceptorMtx sync.Mutex
+	interceptor    ForwardInterceptor
+	heldForwards   map[CircuitKey]*heldForward
 }
 
 // New creates the new instance of htlc switch.
@@ -311,6 +339,7 @@ func New(cfg Config, currentHeight uint32) (*Switch, error) {
 		htlcPlex:          make(chan *plexPacket),
 		chanCloseRequests: make(chan *ChanClose),
 		resolutionMsgs:    make(chan *resolutionMsg),
+		heldForwards:      make(map[CircuitKey]*heldForward),
 		quit:              make(chan struct{}),
 	}, nil
 }
@@ -467,6 +496,20 @@ func (s *Switch) SendHTLC(firstHop lnwire.ShortChannelID,
 	return preimage, err, marked
 }
 
+// LocalPaymentInFlight returns whether an HTLC of the locally initiated payment
+// with the given hash is still in flight, i.e. whether its circuit is open.
+// The outcome of such an HTLC will be reported once it's settled or failed,
+// even across restarts.
+func (s *Switch) LocalPaymentInFlight(paymentHash [32]byte) bool {
+	for _, circuit := range s.circuits.LookupByPaymentHash(paymentHash) {
+		if circuit.Incoming.ChanID == sourceHop {
+			return true
+		}
+	}
+
+	return false
+}
+
 // UpdateForwardingPolicies sends a message to the switch to update the
 // forwarding policies for the set of target channels. If the set of targeted
 // channels is nil, then the forwarding policies for all active channels with
@@ -887,8 +930,18 @@ func (s *Switch) handleLocalDispatch(pkt *htlcPacket) error {
 			}
 		}
 
+		// The forward event is published before the packet is handed
+		// to the link, such that it's always delivered ahead of the
+		// events the link publishes for the HTLC.
+		s.cfg.HtlcNotifier.NotifyForwardEvent(pkt)
+
 		debug_print("before link.HandleSwitchPacket\n")
-		return link.HandleSwitchPacket(pkt)
+		if err := link.HandleSwitchPacket(pkt); err != nil {
+			s.cfg.HtlcNotifier.NotifyLinkFailEvent(pkt, err.Error())
+			return err
+		}
+
+		return nil
 	}
 
 	s.wg.Add(1)
@@ -999,6 +1052,19 @@ func (s *Switch) handleLocalResponse(pkt *htlcPacket) {
 		payment.preimage <- preimage
 		payment.marked <- marked
 		s.removePendingPayment(pkt.incomingHTLCID)
+		return
+	}
+
+	// Otherwise, the payment was sent before a restart, so we'll hand its
+	// outcome off to be recorded.
+	if s.cfg.ResolveOrphanedPayment != nil {
+		err := s.cfg.ResolveOrphanedPayment(
+			pkt.circuit.PaymentHash, preimage, paymentErr,
+		)
+		if err != nil {
+			log.Errorf("Unable to resolve orphaned payment %x: %v",
+				pkt.circuit.PaymentHash, err)
+		}
 	}
 }
 
@@ -1106,6 +1172,12 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			return s.handleLocalDispatch(packet)
 		}
 
+		// If an interceptor is registered, it decides how the HTLC is
+		// resolved, so we'll hold it until it does.
+		if s.interceptForward(packet) {
+			return nil
+		}
+
 		s.indexMtx.RLock()
 		targetLink, err := s.getLinkByShortID(packet.outgoingChanID)
 		if err != nil {
@@ -1257,7 +1329,15 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 		// Send the packet to the destination channel link which
 		// manages the channel.
 		packet.outgoingChanID = destination.ShortChanID()
-		return destination.HandleSwitchPacket(packet)
+		s.cfg.HtlcNotifier.NotifyForwardEvent(packet)
+		if err := destination.HandleSwitchPacket(packet); err != nil {
+			s.cfg.HtlcNotifier.NotifyLinkFailEvent(
+				packet, err.Error(),
+			)
+			return err
+		}
+
+		return nil
 
 	case *lnwire.UpdateFailHTLC, *lnwire.UpdateFulfillHTLC:
 		// If the source of this packet has not been set, use the
@@ -1267,6 +1347,8 @@ func (s *Switch) handlePacketForward(packet *htlcPacket) error {
 			return err
 		}
 
+		s.cfg.HtlcNotifier.NotifyResolutionEvent(packet, circuit)
+
 		fail, isFail := htlc.(*lnwire.UpdateFailHTLC)
 		if isFail && !packet.hasSource {
 			switch {
@@ -1354,6 +1436,8 @@ func (s *Switch) failAddPacket(packet *htlcPacket,
 
 	log.Error(failErr)
 
+	s.cfg.HtlcNotifier.NotifyLinkFailEvent(packet, failErr.Error())
+
 	failPkt := &htlcPacket{
 		sourceRef:      packet.sourceRef,
 		incomingChanID: packet.incomingChanID,
@@ -2015,6 +2099,8 @@ func (s *Switch) Stop() error {
 
 	close(s.quit)
 
+	s.stopInterceptor()
+
 	s.wg.Wait()
 
 	// Wait until all active goroutines have finished exiting before
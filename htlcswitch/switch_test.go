@@ -282,6 +282,109 @@ func TestSwitchForward(t *testing.T) {
 	}
 }
 
+// notifyingLink is a mock channel link which publishes an add event for every
+// packet handed to it, mimicking a link adding the HTLC to its channel right
+// away.
+type notifyingLink struct {
+	*mockChannelLink
+
+	notifier *HtlcNotifier
+}
+
+func (l *notifyingLink) HandleSwitchPacket(pkt *htlcPacket) error {
+	l.notifier.NotifyAddEvent(pkt)
+	return l.mockChannelLink.HandleSwitchPacket(pkt)
+}
+
+// TestSwitchForwardEventOrder checks that the switch publishes the forward
+// event of an HTLC before handing it to the outgoing link, such that it's
+// delivered ahead of the events published by the link.
+func TestSwitchForwardEventOrder(t *testing.T) {
+	t.Parallel()
+
+	alicePeer, err := newMockServer(t, "alice", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create alice server: %v", err)
+	}
+	bobPeer, err := newMockServer(t, "bob", testStartingHeight, nil, 6)
+	if err != nil {
+		t.Fatalf("unable to create bob server: %v", err)
+	}
+
+	notifier := NewHtlcNotifier()
+	if err := notifier.Start(); err != nil {
+		t.Fatalf("unable to start notifier: %v", err)
+	}
+	defer notifier.Stop()
+
+	sub, err := notifier.SubscribeHtlcEvents()
+	if err != nil {
+		t.Fatalf("unable to subscribe: %v", err)
+	}
+	defer sub.Cancel()
+
+	s, err := initSwitchWithDB(testStartingHeight, nil)
+	if err != nil {
+		t.Fatalf("unable to init switch: %v", err)
+	}
+	s.cfg.HtlcNotifier = notifier
+	if err := s.Start(); err != nil {
+		t.Fatalf("unable to start switch: %v", err)
+	}
+	defer s.Stop()
+
+	chanID1, chanID2, aliceChanID, bobChanID := genIDs()
+
+	aliceChannelLink := newMockChannelLink(
+		s, chanID1, aliceChanID, alicePeer, true,
+	)
+	bobChannelLink := &notifyingLink{
+		mockChannelLink: newMockChannelLink(
+			s, chanID2, bobChanID, bobPeer, true,
+		),
+		notifier: notifier,
+	}
+	if err := s.AddLink(aliceChannelLink); err != nil {
+		t.Fatalf("unable to add alice link: %v", err)
+	}
+	if err := s.AddLink(bobChannelLink); err != nil {
+		t.Fatalf("unable to add bob link: %v", err)
+	}
+
+	preimage, err := genPreimage()
+	if err != nil {
+		t.Fatalf("unable to generate preimage: %v", err)
+	}
+	rhash := fastsha256.Sum256(preimage[:])
+	packet := &htlcPacket{
+		incomingChanID: aliceChannelLink.ShortChanID(),
+		incomingHTLCID: 0,
+		outgoingChanID: bobChannelLink.ShortChanID(),
+		obfuscator:     NewMockObfuscator(),
+		htlc: &lnwire.UpdateAddHTLC{
+			PaymentHash: rhash,
+			Amount:      1,
+		},
+	}
+	if err := s.forward(packet); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, eventType := range []HtlcEventType{
+		HtlcEventForward, HtlcEventAdd,
+	} {
+		select {
+		case event := <-sub.Events:
+			if event.Type != eventType {
+				t.Fatalf("expected event %v, got %v", eventType,
+					event.Type)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("event %v not received", eventType)
+		}
+	}
+}
+
 func TestSwitchForwardFailAfterFullAdd(t *testing.T) {
 	t.Parallel()
 
@@ -3,6 +3,7 @@ package main
 import (
 	"bytes"
 	"crypto/sha256"
+	"crypto/subtle"
 	"fmt"
 	"sync"
 	"sync/atomic"
@@ -13,6 +14,7 @@ import (
 	"github.com/davecgh/go-spew/spew"
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/zpay32"
 )
@@ -337,6 +339,54 @@ func (i *invoiceRegistry) LookupInvoice(rHash chainhash.Hash) (channeldb.Invoice
 	return invoice, uint32(payReq.MinFinalCLTVExpiry()), nil
 }
 
+// ValidatePaymentSecret checks the payment secret included by the sender of an
+// HTLC against the secret of the invoice with the passed payment hash. If the
+// invoice has no payment secret, then any secret is accepted. If it has one,
+// then the secret must match, unless the sender didn't include one and the
+// invoice only signals the secret as optional.
+func (i *invoiceRegistry) ValidatePaymentSecret(rHash chainhash.Hash,
+	secret htlcswitch.PaymentSecret) error {
+
+	// Debug invoices don't carry a payment secret.
+	i.RLock()
+	_, ok := i.debugInvoices[rHash]
+	i.RUnlock()
+	if ok {
+		return nil
+	}
+
+	invoice, err := i.cdb.LookupInvoice(rHash)
+	if err != nil {
+		return err
+	}
+
+	payReq, err := zpay32.Decode(
+		string(invoice.PaymentRequest), activeNetParams.Params,
+	)
+	if err != nil {
+		return err
+	}
+
+	if payReq.PaymentSecret == nil {
+		return nil
+	}
+
+	if secret == (htlcswitch.PaymentSecret{}) {
+		if payReq.Features.IsSet(lnwire.PaymentSecretRequired) {
+			return fmt.Errorf("payment secret required")
+		}
+
+		return nil
+	}
+
+	expected := htlcswitch.NewPaymentSecret(*payReq.PaymentSecret)
+	if subtle.ConstantTimeCompare(secret[:], expected[:]) != 1 {
+		return fmt.Errorf("payment secret mismatch")
+	}
+
+	return nil
+}
+
 // SettleInvoice attempts to mark an invoice as settled. If the invoice is a
 // debug invoice, then this method is a noop as debug invoices are never fully
 // settled.
@@ -370,6 +420,19 @@ func (i *invoiceRegistry) SettleInvoice(rHash chainhash.Hash,
 	return nil
 }
 
+// DeleteInvoice removes the invoice matching the passed payment hash, such
+// that payments to it are no longer accepted. This is used to clean up
+// invoices we created for ourselves once the payment they were meant for has
+// failed.
+func (i *invoiceRegistry) DeleteInvoice(rHash chainhash.Hash) error {
+	i.Lock()
+	defer i.Unlock()
+
+	ltndLog.Debugf("Deleting invoice %x", rHash[:])
+
+	return i.cdb.DeleteInvoice(rHash)
+}
+
 // notifyClients notifies all currently registered invoice notification clients
 // of a newly added/settled invoice.
 func (i *invoiceRegistry) notifyClients(invoice *channeldb.Invoice, settle bool) {
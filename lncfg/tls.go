@@ -0,0 +1,93 @@
+package lncfg
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+)
+
+// ListenerTLS is a TLS certificate and key dedicated to a single RPC or REST
+// listener, used in place of the default certificate of lnd.
+type ListenerTLS struct {
+	// Addr is the normalized address of the listener.
+	Addr net.Addr
+
+	// CertPath is the path to the certificate of the listener.
+	CertPath string
+
+	// KeyPath is the path to the private key of the listener.
+	KeyPath string
+}
+
+// ParseListenerTLS parses the dedicated TLS certificate and key of a listener,
+// specified as <address>,<cert path>,<key path>. The address is normalized
+// with the passed default port, such that it can be matched against the
+// normalized addresses of the listeners.
+func ParseListenerTLS(raw string, defaultPort string,
+	tcpResolver tcpResolver) (*ListenerTLS, error) {
+
+	// Neither the addresses nor the paths we support contain commas, so
+	// we'll simply split on them.
+	parts := strings.Split(raw, ",")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("invalid listener TLS config %q, "+
+			"expected <address>,<cert path>,<key path>", raw)
+	}
+
+	certPath := strings.TrimSpace(parts[1])
+	keyPath := strings.TrimSpace(parts[2])
+	if certPath == "" || keyPath == "" {
+		return nil, fmt.Errorf("invalid listener TLS config %q, both "+
+			"a cert and a key path must be specified", raw)
+	}
+
+	addr, err := ParseAddressString(
+		strings.TrimSpace(parts[0]), defaultPort, tcpResolver,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ListenerTLS{
+		Addr:     addr,
+		CertPath: certPath,
+		KeyPath:  keyPath,
+	}, nil
+}
+
+// FindListenerTLS returns the dedicated TLS config of the listener with the
+// passed address, or nil if it doesn't have one.
+func FindListenerTLS(configs []*ListenerTLS, addr net.Addr) *ListenerTLS {
+	for _, config := range configs {
+		if sameAddr(config.Addr, addr) {
+			return config
+		}
+	}
+
+	return nil
+}
+
+// sameAddr returns true if both addresses are of the same network and point
+// to the same host and port, or socket path.
+func sameAddr(a, b net.Addr) bool {
+	return a.Network() == b.Network() && a.String() == b.String()
+}
+
+// SetSocketPermissions sets the file permissions of the socket file of a Unix
+// domain socket listening on the passed address. As connecting to a socket
+// requires write permission on its file, this determines who's able to
+// connect. Addresses of other networks are ignored.
+func SetSocketPermissions(addr net.Addr, perms os.FileMode) error {
+	if !IsUnix(addr) {
+		return nil
+	}
+
+	// Abstract sockets on Linux don't have a file, so there's nothing to
+	// restrict.
+	if strings.HasPrefix(addr.String(), "@") {
+		return nil
+	}
+
+	return os.Chmod(addr.String(), perms)
+}
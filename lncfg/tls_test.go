@@ -0,0 +1,129 @@
+// +build !rpctest
+
+package lncfg
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestParseListenerTLS ensures that the dedicated TLS config of a listener is
+// parsed with its address normalized, such that it matches the listener.
+func TestParseListenerTLS(t *testing.T) {
+	t.Parallel()
+
+	listeners, err := NormalizeAddresses(
+		[]string{"localhost:10009", "unix:///tmp/lnd.sock"},
+		defaultTestPort, net.ResolveTCPAddr,
+	)
+	if err != nil {
+		t.Fatalf("unable to normalize addresses: %v", err)
+	}
+
+	var configs []*ListenerTLS
+	for _, raw := range []string{
+		"127.0.0.1:10009, /tls/rpc.cert, /tls/rpc.key",
+		"unix:///tmp/lnd.sock,/tls/unix.cert,/tls/unix.key",
+	} {
+		config, err := ParseListenerTLS(
+			raw, defaultTestPort, net.ResolveTCPAddr,
+		)
+		if err != nil {
+			t.Fatalf("unable to parse %q: %v", raw, err)
+		}
+		configs = append(configs, config)
+	}
+
+	config := FindListenerTLS(configs, listeners[0])
+	if config == nil || config.CertPath != "/tls/rpc.cert" ||
+		config.KeyPath != "/tls/rpc.key" {
+
+		t.Fatalf("unexpected TLS config for %v: %v", listeners[0],
+			config)
+	}
+	config = FindListenerTLS(configs, listeners[1])
+	if config == nil || config.CertPath != "/tls/unix.cert" {
+		t.Fatalf("unexpected TLS config for %v: %v", listeners[1],
+			config)
+	}
+
+	// A listener on a different port shouldn't match.
+	other, err := ParseAddressString(
+		"localhost:10010", defaultTestPort, net.ResolveTCPAddr,
+	)
+	if err != nil {
+		t.Fatalf("unable to parse address: %v", err)
+	}
+	if config := FindListenerTLS(configs, other); config != nil {
+		t.Fatalf("expected no TLS config for %v, got %v", other,
+			config)
+	}
+
+	// Finally, malformed configs should be rejected.
+	for _, raw := range []string{
+		"127.0.0.1:10009",
+		"127.0.0.1:10009,/tls/rpc.cert",
+		"127.0.0.1:10009,,/tls/rpc.key",
+		"udp://127.0.0.1:10009,/tls/rpc.cert,/tls/rpc.key",
+	} {
+		_, err := ParseListenerTLS(
+			raw, defaultTestPort, net.ResolveTCPAddr,
+		)
+		if err == nil {
+			t.Fatalf("expected %q to be rejected", raw)
+		}
+	}
+}
+
+// TestSetSocketPermissions ensures that the permissions of the socket file of
+// a Unix domain socket listener can be restricted.
+func TestSetSocketPermissions(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "lncfg")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	socketPath := filepath.Join(tempDir, "rpc.sock")
+	addr, err := ParseAddressString(
+		"unix://"+socketPath, defaultTestPort, net.ResolveTCPAddr,
+	)
+	if err != nil {
+		t.Fatalf("unable to parse address: %v", err)
+	}
+
+	lis, err := ListenOnAddress(addr)
+	if err != nil {
+		t.Fatalf("unable to listen on %v: %v", addr, err)
+	}
+	defer lis.Close()
+
+	if err := SetSocketPermissions(addr, 0600); err != nil {
+		t.Fatalf("unable to set socket permissions: %v", err)
+	}
+
+	info, err := os.Stat(socketPath)
+	if err != nil {
+		t.Fatalf("unable to stat socket: %v", err)
+	}
+	if info.Mode().Perm() != 0600 {
+		t.Fatalf("expected permissions 0600, got %v",
+			info.Mode().Perm())
+	}
+
+	// Setting the permissions of TCP listeners should be a no-op.
+	tcpAddr, err := ParseAddressString(
+		"localhost:10009", defaultTestPort, net.ResolveTCPAddr,
+	)
+	if err != nil {
+		t.Fatalf("unable to parse address: %v", err)
+	}
+	if err := SetSocketPermissions(tcpAddr, 0600); err != nil {
+		t.Fatalf("unable to set socket permissions: %v", err)
+	}
+}
@@ -51,6 +51,10 @@ import (
 const (
 	// Make certificate valid for 14 months.
 	autogenCertValidity = 14 /*months*/ * 30 /*days*/ * 24 * time.Hour
+
+	// autogenCertOrg is the organization of the certificates generated by
+	// lnd, which tells them apart from the ones supplied by the user.
+	autogenCertOrg = "lnd autogenerated cert"
 )
 
 var (
@@ -178,30 +182,29 @@ func lndMain() error {
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
-	// Ensure we create TLS key and certificate if they don't exist
-	if !fileExists(cfg.TLSCertPath) && !fileExists(cfg.TLSKeyPath) {
-		if err := genCertPair(cfg.TLSCertPath, cfg.TLSKeyPath); err != nil {
-			return err
-		}
-	}
-
-	cert, err := tls.LoadX509KeyPair(cfg.TLSCertPath, cfg.TLSKeyPath)
+	// Load the TLS certificates of the RPC and REST listeners, generating
+	// them if they don't exist yet.
+	grpcListeners, restListeners, err := loadRPCListeners()
 	if err != nil {
 		return err
 	}
-	tlsConf := &tls.Config{
-		Certificates: []tls.Certificate{cert},
-		CipherSuites: tlsCipherSuites,
-		MinVersion:   tls.VersionTLS12,
-	}
-	sCreds := credentials.NewTLS(tlsConf)
-	serverOpts := []grpc.ServerOption{grpc.Creds(sCreds)}
-	cCreds, err := credentials.NewClientTLSFromFile(cfg.TLSCertPath, "")
+
+	// The REST proxy connects to one of the gRPC listeners like any other
+	// client, trusting the certificate of that listener.
+	proxyListener := restProxyListener(grpcListeners)
+	cCreds, err := credentials.NewClientTLSFromFile(
+		proxyListener.certPath, "",
+	)
 	if err != nil {
 		return err
 	}
+	proxyDest := proxyListener.addr.String()
 	proxyOpts := []grpc.DialOption{grpc.WithTransportCredentials(cCreds)}
 
+	// As TLS is terminated by the listeners themselves, the gRPC server
+	// doesn't need any transport credentials.
+	var serverOpts []grpc.ServerOption
+
 	var (
 		privateWalletPw = lnwallet.DefaultPrivatePassphrase
 		publicWalletPw  = lnwallet.DefaultPublicPassphrase
@@ -215,8 +218,8 @@ func lndMain() error {
 	// for wallet encryption.
 	if !cfg.NoSeedBackup {
 		walletInitParams, err := waitForWalletPassword(
-			cfg.RPCListeners, cfg.RESTListeners, serverOpts,
-			proxyOpts, tlsConf,
+			grpcListeners, restListeners, serverOpts, proxyDest,
+			proxyOpts,
 		)
 		if err != nil {
 			return err
@@ -247,6 +250,12 @@ func lndMain() error {
 		}
 		defer macaroonService.Close()
 
+		// Calls made over Unix sockets are authorized by the
+		// permissions of the socket files if requested.
+		if cfg.RPCUnixSocketAuth {
+			macaroonService.EnableUnixSocketAuth()
+		}
+
 		// Try to unlock the macaroon store with the private password.
 		err = macaroonService.CreateUnlock(&privateWalletPw)
 		if err != nil {
@@ -305,8 +314,10 @@ func lndMain() error {
 
 	if cfg.Tor.Active {
 		srvrLog.Infof("Proxying all network traffic via Tor "+
-			"(stream_isolation=%v)! NOTE: Ensure the backend node "+
-			"is proxying over Tor as well", cfg.Tor.StreamIsolation)
+			"(stream_isolation=%v, peer_isolation=%v, "+
+			"onion_only=%v)! NOTE: Ensure the backend node is "+
+			"proxying over Tor as well", cfg.Tor.StreamIsolation,
+			cfg.Tor.PeerIsolation, cfg.Tor.OnionOnly)
 	}
 
 	// Set up the core server which will listen for incoming peer
@@ -319,6 +330,20 @@ func lndMain() error {
 		return err
 	}
 
+	// If the private key of our onion service should be encrypted at rest,
+	// we'll do so with the wallet password.
+	if cfg.Tor.EncryptKey {
+		server.torKeyPassword = privateWalletPw
+	}
+
+	// Set up the autopilot manager, which allows the autopilot agent to be
+	// enabled and disabled at runtime through the RPC server.
+	pilot, err := newAutopilotManager(server, cfg.Autopilot)
+	if err != nil {
+		ltndLog.Errorf("unable to create autopilot manager: %v", err)
+		return err
+	}
+
 	// Check macaroon authentication if macaroons aren't disabled.
 	if macaroonService != nil {
 		serverOpts = append(serverOpts,
@@ -331,7 +356,7 @@ func lndMain() error {
 
 	// Initialize, and register our implementation of the gRPC interface
 	// exported by the rpcServer.
-	rpcServer := newRPCServer(server)
+	rpcServer := newRPCServer(server, pilot, macaroonService)
 	if err := rpcServer.Start(); err != nil {
 		return err
 	}
@@ -341,11 +366,12 @@ func lndMain() error {
 	lnrpc.RegisterLightningServer(grpcServer, rpcServer)
 
 	// Next, Start the gRPC server listening for HTTP/2 connections.
-	for _, listener := range cfg.RPCListeners {
-		lis, err := lncfg.ListenOnAddress(listener)
+	for _, listener := range grpcListeners {
+		lis, err := listener.listen()
 		if err != nil {
 			ltndLog.Errorf(
-				"RPC server unable to listen on %s", listener,
+				"RPC server unable to listen on %s",
+				listener.addr,
 			)
 			return err
 		}
@@ -359,17 +385,17 @@ func lndMain() error {
 	// Finally, start the REST proxy for our gRPC server above.
 	mux := proxy.NewServeMux()
 	err = lnrpc.RegisterLightningHandlerFromEndpoint(
-		ctx, mux, cfg.RPCListeners[0].String(), proxyOpts,
+		ctx, mux, proxyDest, proxyOpts,
 	)
 	if err != nil {
 		return err
 	}
-	for _, restEndpoint := range cfg.RESTListeners {
-		lis, err := lncfg.TLSListenOnAddress(restEndpoint, tlsConf)
+	for _, restEndpoint := range restListeners {
+		lis, err := restEndpoint.listen()
 		if err != nil {
 			ltndLog.Errorf(
 				"gRPC proxy unable to listen on %s",
-				restEndpoint,
+				restEndpoint.addr,
 			)
 			return err
 		}
@@ -429,20 +455,16 @@ func lndMain() error {
 
 	// Now that the server has started, if the autopilot mode is currently
 	// active, then we'll initialize a fresh instance of it and start it.
+	// It may also be enabled later on through the RPC server, so we'll
+	// stop it on exit regardless.
 	if cfg.Autopilot.Active {
-		pilot, err := initAutoPilot(server, cfg.Autopilot)
-		if err != nil {
-			ltndLog.Errorf("unable to create autopilot agent: %v",
-				err)
-			return err
-		}
 		if err := pilot.Start(); err != nil {
 			ltndLog.Errorf("unable to start autopilot agent: %v",
 				err)
 			return err
 		}
-		defer pilot.Stop()
 	}
+	defer pilot.Stop()
 
 	// Wait for shutdown signal from either a graceful server stop or from
 	// the interrupt handler.
@@ -474,80 +496,348 @@ func fileExists(name string) bool {
 	return true
 }
 
-// genCertPair generates a key/cert pair to the paths provided. The
-// auto-generated certificates should *not* be used in production for public
-// access as they're self-signed and don't necessarily contain all of the
-// desired hostnames for the service. For production/public use, consider a
-// real PKI.
-//
-// This function is adapted from https://github.com/btcsuite/btcd and
-// https://github.com/btcsuite/btcutil
-func genCertPair(certFile, keyFile string) error {
-	rpcsLog.Infof("Generating TLS certificates...")
+// rpcListener is a gRPC or REST listener along with the TLS config it
+// terminates connections with.
+type rpcListener struct {
+	// addr is the address the listener listens on.
+	addr net.Addr
 
-	org := "lnd autogenerated cert"
-	now := time.Now()
-	validUntil := now.Add(autogenCertValidity)
+	// tlsConf is the TLS config of the listener.
+	tlsConf *tls.Config
 
-	// Check that the certificate validity isn't past the ASN.1 end of time.
-	if validUntil.After(endOfTime) {
-		validUntil = endOfTime
+	// certPath is the path to the certificate of the listener, which
+	// clients of the listener trust.
+	certPath string
+}
+
+// listen starts listening on the address of the listener, restricting the
+// permissions of its socket file if it's a Unix domain socket.
+func (l *rpcListener) listen() (net.Listener, error) {
+	lis, err := lncfg.TLSListenOnAddress(l.addr, l.tlsConf)
+	if err != nil {
+		return nil, err
 	}
 
-	// Generate a serial number that's below the serialNumberLimit.
-	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	err = lncfg.SetSocketPermissions(l.addr, cfg.rpcSocketPerms)
 	if err != nil {
-		return fmt.Errorf("failed to generate serial number: %s", err)
+		lis.Close()
+		return nil, err
 	}
 
-	// Collect the host's IP addresses, including loopback, in a slice.
-	ipAddresses := []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")}
+	return lis, nil
+}
+
+// loadRPCListeners loads the TLS configs of the gRPC and REST listeners. Each
+// listener uses its dedicated certificate if it has one, and the default one
+// of lnd otherwise.
+func loadRPCListeners() ([]*rpcListener, []*rpcListener, error) {
+	defaultCert, err := loadTLSCert(cfg.TLSCertPath, cfg.TLSKeyPath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	newListeners := func(addrs []net.Addr, configs []*lncfg.ListenerTLS,
+		nextProtos []string) ([]*rpcListener, error) {
+
+		listeners := make([]*rpcListener, 0, len(addrs))
+		for _, addr := range addrs {
+			cert := defaultCert
+			certPath := cfg.TLSCertPath
+
+			config := lncfg.FindListenerTLS(configs, addr)
+			if config != nil {
+				cert, err = loadTLSCert(
+					config.CertPath, config.KeyPath,
+				)
+				if err != nil {
+					return nil, err
+				}
+				certPath = config.CertPath
+			}
+
+			listeners = append(listeners, &rpcListener{
+				addr: addr,
+				tlsConf: &tls.Config{
+					Certificates: []tls.Certificate{cert},
+					CipherSuites: tlsCipherSuites,
+					MinVersion:   tls.VersionTLS12,
+					NextProtos:   nextProtos,
+				},
+				certPath: certPath,
+			})
+		}
+
+		return listeners, nil
+	}
+
+	// gRPC requires HTTP/2 to be negotiated during the TLS handshake.
+	grpcListeners, err := newListeners(
+		cfg.RPCListeners, cfg.RPCListenerTLS, []string{"h2"},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+	restListeners, err := newListeners(
+		cfg.RESTListeners, cfg.RESTListenerTLS, nil,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return grpcListeners, restListeners, nil
+}
 
-	// addIP appends an IP address only if it isn't already in the slice.
-	addIP := func(ipAddr net.IP) {
-		for _, ip := range ipAddresses {
-			if bytes.Equal(ip, ipAddr) {
-				return
+// restProxyListener returns the gRPC listener the REST proxy should connect
+// to. If calls over Unix sockets aren't authenticated with macaroons, the
+// proxy must connect over TCP, otherwise it would let REST clients skip
+// authentication.
+func restProxyListener(grpcListeners []*rpcListener) *rpcListener {
+	if cfg.RPCUnixSocketAuth {
+		for _, listener := range grpcListeners {
+			if !lncfg.IsUnix(listener.addr) {
+				return listener
 			}
 		}
-		ipAddresses = append(ipAddresses, ipAddr)
 	}
 
+	return grpcListeners[0]
+}
+
+// loadTLSCert loads the TLS key/cert pair at the paths provided. If neither
+// exists, a new pair is generated. A pair generated by lnd is regenerated if
+// it isn't valid for all of the configured extra IPs and domains.
+func loadTLSCert(certPath, keyPath string) (tls.Certificate, error) {
+	switch {
+	case !fileExists(certPath) && !fileExists(keyPath):
+		if err := genCertPair(certPath, keyPath); err != nil {
+			return tls.Certificate{}, err
+		}
+
+	case fileExists(certPath):
+		outdated, err := certOutdated(certPath)
+		if err != nil {
+			return tls.Certificate{}, err
+		}
+		if !outdated {
+			break
+		}
+
+		rpcsLog.Infof("TLS certificate %v is outdated, regenerating",
+			certPath)
+
+		if err := os.Remove(certPath); err != nil {
+			return tls.Certificate{}, err
+		}
+		if err := os.Remove(keyPath); err != nil &&
+			!os.IsNotExist(err) {
+
+			return tls.Certificate{}, err
+		}
+		if err := genCertPair(certPath, keyPath); err != nil {
+			return tls.Certificate{}, err
+		}
+	}
+
+	return tls.LoadX509KeyPair(certPath, keyPath)
+}
+
+// certOutdated returns true if the certificate at the passed path was
+// generated by lnd, and the extra IPs and domains it's valid for differ from
+// the configured ones. Certificates that weren't generated by lnd are never
+// outdated.
+func certOutdated(certPath string) (bool, error) {
+	certBytes, err := ioutil.ReadFile(certPath)
+	if err != nil {
+		return false, err
+	}
+	block, _ := pem.Decode(certBytes)
+	if block == nil {
+		return false, fmt.Errorf("unable to decode certificate %v",
+			certPath)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return false, err
+	}
+
+	var autogenerated bool
+	for _, org := range cert.Subject.Organization {
+		if org == autogenCertOrg {
+			autogenerated = true
+			break
+		}
+	}
+	if !autogenerated {
+		return false, nil
+	}
+
+	// The host's own IP addresses and names are left out of the
+	// comparison, as they may change without the certificate becoming
+	// unusable. Anything else the certificate is valid for was added as
+	// an extra IP or domain.
+	hostIPs, hostNames, err := hostSANs()
+	if err != nil {
+		return false, err
+	}
+	isHostIP := make(map[string]struct{}, len(hostIPs))
+	for _, ip := range hostIPs {
+		isHostIP[ip.String()] = struct{}{}
+	}
+	isHostName := make(map[string]struct{}, len(hostNames))
+	for _, name := range hostNames {
+		isHostName[name] = struct{}{}
+	}
+
+	certIPs := make(map[string]struct{}, len(cert.IPAddresses))
+	for _, ip := range cert.IPAddresses {
+		if _, ok := isHostIP[ip.String()]; !ok {
+			certIPs[ip.String()] = struct{}{}
+		}
+	}
+	extraIPs := make(map[string]struct{}, len(cfg.TLSExtraIPs))
+	for _, extraIP := range cfg.TLSExtraIPs {
+		ip := net.ParseIP(extraIP)
+		if ip == nil {
+			continue
+		}
+		if _, ok := isHostIP[ip.String()]; !ok {
+			extraIPs[ip.String()] = struct{}{}
+		}
+	}
+
+	certNames := make(map[string]struct{}, len(cert.DNSNames))
+	for _, name := range cert.DNSNames {
+		if _, ok := isHostName[name]; !ok {
+			certNames[name] = struct{}{}
+		}
+	}
+	extraNames := make(map[string]struct{}, len(cfg.TLSExtraDomains))
+	for _, extraDomain := range cfg.TLSExtraDomains {
+		if _, ok := isHostName[extraDomain]; !ok {
+			extraNames[extraDomain] = struct{}{}
+		}
+	}
+
+	return !sameSet(certIPs, extraIPs) || !sameSet(certNames, extraNames),
+		nil
+}
+
+// sameSet returns true if both passed sets contain the same elements.
+func sameSet(a, b map[string]struct{}) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for elem := range a {
+		if _, ok := b[elem]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hostSANs returns the IP addresses and host names of this host that the
+// certificates generated by lnd are valid for, the first host name being the
+// one of this host.
+func hostSANs() ([]net.IP, []string, error) {
+	// Collect the host's IP addresses, including loopback, in a slice.
+	ipAddresses := []net.IP{net.ParseIP("127.0.0.1"), net.ParseIP("::1")}
+
 	// Add all the interface IPs that aren't already in the slice.
 	addrs, err := net.InterfaceAddrs()
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	for _, a := range addrs {
 		ipAddr, _, err := net.ParseCIDR(a.String())
 		if err == nil {
-			addIP(ipAddr)
+			ipAddresses = addIP(ipAddresses, ipAddr)
 		}
 	}
 
-	// Add extra IP to the slice.
-	ipAddr := net.ParseIP(cfg.TLSExtraIP)
-	if ipAddr != nil {
-		addIP(ipAddr)
-	}
-
 	// Collect the host's names into a slice.
 	host, err := os.Hostname()
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	dnsNames := []string{host}
 	if host != "localhost" {
 		dnsNames = append(dnsNames, "localhost")
 	}
-	if cfg.TLSExtraDomain != "" {
-		dnsNames = append(dnsNames, cfg.TLSExtraDomain)
-	}
 
 	// Also add fake hostnames for unix sockets, otherwise hostname
 	// verification will fail in the client.
 	dnsNames = append(dnsNames, "unix", "unixpacket")
 
+	return ipAddresses, dnsNames, nil
+}
+
+// addIP appends an IP address to the passed slice only if it isn't already in
+// the slice.
+func addIP(ipAddresses []net.IP, ipAddr net.IP) []net.IP {
+	for _, ip := range ipAddresses {
+		if ip.Equal(ipAddr) {
+			return ipAddresses
+		}
+	}
+
+	return append(ipAddresses, ipAddr)
+}
+
+// certSANs returns the IP addresses and host names the certificates generated
+// by lnd are valid for: those of this host, along with the configured extra
+// IPs and domains.
+func certSANs() ([]net.IP, []string, error) {
+	ipAddresses, dnsNames, err := hostSANs()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Add the extra IPs and domains.
+	for _, extraIP := range cfg.TLSExtraIPs {
+		ipAddr := net.ParseIP(extraIP)
+		if ipAddr != nil {
+			ipAddresses = addIP(ipAddresses, ipAddr)
+		}
+	}
+	dnsNames = append(dnsNames, cfg.TLSExtraDomains...)
+
+	return ipAddresses, dnsNames, nil
+}
+
+// genCertPair generates a key/cert pair to the paths provided. The
+// auto-generated certificates should *not* be used in production for public
+// access as they're self-signed and don't necessarily contain all of the
+// desired hostnames for the service. For production/public use, consider a
+// real PKI.
+//
+// This function is adapted from https://github.com/btcsuite/btcd and
+// https://github.com/btcsuite/btcutil
+func genCertPair(certFile, keyFile string) error {
+	rpcsLog.Infof("Generating TLS certificates...")
+
+	now := time.Now()
+	validUntil := now.Add(autogenCertValidity)
+
+	// Check that the certificate validity isn't past the ASN.1 end of time.
+	if validUntil.After(endOfTime) {
+		validUntil = endOfTime
+	}
+
+	// Generate a serial number that's below the serialNumberLimit.
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return fmt.Errorf("failed to generate serial number: %s", err)
+	}
+
+	// Collect the IP addresses and host names the certificate is valid
+	// for, the first host name being the one of this host.
+	ipAddresses, dnsNames, err := certSANs()
+	if err != nil {
+		return err
+	}
+	host := dnsNames[0]
+
 	// Generate a private key for the certificate.
 	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
 	if err != nil {
@@ -558,7 +848,7 @@ func genCertPair(certFile, keyFile string) error {
 	template := x509.Certificate{
 		SerialNumber: serialNumber,
 		Subject: pkix.Name{
-			Organization: []string{org},
+			Organization: []string{autogenCertOrg},
 			CommonName:   host,
 		},
 		NotBefore: now.Add(-time.Hour * 24),
@@ -696,9 +986,9 @@ type WalletUnlockParams struct {
 // waitForWalletPassword will spin up gRPC and REST endpoints for the
 // WalletUnlocker server, and block until a password is provided by
 // the user to this RPC server.
-func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
-	serverOpts []grpc.ServerOption, proxyOpts []grpc.DialOption,
-	tlsConf *tls.Config) (*WalletUnlockParams, error) {
+func waitForWalletPassword(grpcEndpoints, restEndpoints []*rpcListener,
+	serverOpts []grpc.ServerOption, proxyDest string,
+	proxyOpts []grpc.DialOption) (*WalletUnlockParams, error) {
 
 	// Set up a new PasswordService, which will listen for passwords
 	// provided over RPC.
@@ -717,8 +1007,16 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 		filepath.Join(networkDir, macaroons.DBFilename),
 		cfg.AdminMacPath, cfg.ReadMacPath, cfg.InvoiceMacPath,
 	}
+	// Similarly, the private key of our onion service is passed if it's
+	// encrypted with the wallet's password, such that it's re-encrypted
+	// with the new password.
+	var onionKeyPath string
+	if cfg.Tor.EncryptKey {
+		onionKeyPath = cfg.Tor.PrivateKeyPath
+	}
 	pwService := walletunlocker.New(
 		chainConfig.ChainDir, activeNetParams.Params, macaroonFiles,
+		onionKeyPath,
 	)
 	lnrpc.RegisterWalletUnlockerServer(grpcServer, pwService)
 
@@ -729,11 +1027,11 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 	for _, grpcEndpoint := range grpcEndpoints {
 		// Start a gRPC server listening for HTTP/2 connections, solely
 		// used for getting the encryption password from the client.
-		lis, err := lncfg.ListenOnAddress(grpcEndpoint)
+		lis, err := grpcEndpoint.listen()
 		if err != nil {
 			ltndLog.Errorf(
 				"password RPC server unable to listen on %s",
-				grpcEndpoint,
+				grpcEndpoint.addr,
 			)
 			return nil, err
 		}
@@ -758,7 +1056,7 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 	mux := proxy.NewServeMux()
 
 	err := lnrpc.RegisterWalletUnlockerHandlerFromEndpoint(
-		ctx, mux, grpcEndpoints[0].String(), proxyOpts,
+		ctx, mux, proxyDest, proxyOpts,
 	)
 	if err != nil {
 		return nil, err
@@ -767,11 +1065,11 @@ func waitForWalletPassword(grpcEndpoints, restEndpoints []net.Addr,
 	srv := &http.Server{Handler: mux}
 
 	for _, restEndpoint := range restEndpoints {
-		lis, err := lncfg.TLSListenOnAddress(restEndpoint, tlsConf)
+		lis, err := restEndpoint.listen()
 		if err != nil {
 			ltndLog.Errorf(
 				"password gRPC proxy unable to listen on %s",
-				restEndpoint,
+				restEndpoint.addr,
 			)
 			return nil, err
 		}
@@ -16,17 +16,32 @@ It has these top-level messages:
 	UnlockWalletResponse
 	ChangePasswordRequest
 	ChangePasswordResponse
+	ChangeSeedPassphraseRequest
+	ChangeSeedPassphraseResponse
 	Transaction
 	GetTransactionsRequest
 	TransactionDetails
+	GetRecoveryInfoRequest
+	RecoveryInfo
 	FeeLimit
 	SendRequest
 	SendResponse
 	SendToRouteRequest
 	ChannelPoint
+	OutPoint
 	LightningAddress
 	SendManyRequest
 	SendManyResponse
+	EstimateFeeRequest
+	FeeEstimate
+	EstimateFeeResponse
+	LeaseOutputRequest
+	LeaseOutputResponse
+	ReleaseOutputRequest
+	ReleaseOutputResponse
+	ListLeasesRequest
+	UtxoLease
+	ListLeasesResponse
 	SendCoinsRequest
 	SendCoinsResponse
 	NewAddressRequest
@@ -48,8 +63,14 @@ It has these top-level messages:
 	ClosedChannelsRequest
 	ClosedChannelsResponse
 	Peer
+	MessageTypeStats
+	ConnectionStats
 	ListPeersRequest
 	ListPeersResponse
+	ListPeerAddressesRequest
+	PeerAddress
+	PeerAddressBook
+	ListPeerAddressesResponse
 	GetInfoRequest
 	GetInfoResponse
 	ConfirmationUpdate
@@ -114,6 +135,39 @@ It has these top-level messages:
 	ForwardingHistoryRequest
 	ForwardingEvent
 	ForwardingHistoryResponse
+	RebalanceRequest
+	RebalanceResponse
+	TrackPaymentRequest
+	PaymentAttempt
+	PaymentUpdate
+	SubscribeHtlcEventsRequest
+	HtlcEvent
+	CircuitKey
+	ForwardHtlcInterceptRequest
+	ForwardHtlcInterceptResponse
+	ChannelAcceptRequest
+	ChannelAcceptResponse
+	PendingSweepsRequest
+	PendingSweep
+	PendingSweepsResponse
+	SweepOutputsRequest
+	SweepOutputsResponse
+	BumpFeeRequest
+	BumpFeeResponse
+	AutopilotStatusRequest
+	AutopilotProposal
+	AutopilotStatusResponse
+	ModifyAutopilotStatusRequest
+	ModifyAutopilotStatusResponse
+	SetAutopilotScoresRequest
+	SetAutopilotScoresResponse
+	MacaroonPermission
+	BakeMacaroonRequest
+	BakeMacaroonResponse
+	ListMacaroonIDsRequest
+	ListMacaroonIDsResponse
+	DeleteMacaroonIDRequest
+	DeleteMacaroonIDResponse
 */
 package lnrpc
 
@@ -138,6 +192,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// *
+// `CoinSelectionStrategy` has to be one of:
+//
+// - `DEFAULT`: Select outputs in the order returned by the wallet.
+// - `LARGEST`: Select the largest outputs first.
+// - `RANDOM`: Select outputs in a random order.
+// - `BNB`: Search for a set of outputs that avoids creating change, falling
+// back to the largest outputs first if there is none.
+type CoinSelectionStrategy int32
+
+const (
+	CoinSelectionStrategy_DEFAULT CoinSelectionStrategy = 0
+	CoinSelectionStrategy_LARGEST CoinSelectionStrategy = 1
+	CoinSelectionStrategy_RANDOM  CoinSelectionStrategy = 2
+	CoinSelectionStrategy_BNB     CoinSelectionStrategy = 3
+)
+
+var CoinSelectionStrategy_name = map[int32]string{
+	0: "DEFAULT",
+	1: "LARGEST",
+	2: "RANDOM",
+	3: "BNB",
+}
+var CoinSelectionStrategy_value = map[string]int32{
+	"DEFAULT": 0,
+	"LARGEST": 1,
+	"RANDOM":  2,
+	"BNB":     3,
+}
+
+func (x CoinSelectionStrategy) String() string {
+	return proto.EnumName(CoinSelectionStrategy_name, int32(x))
+}
+func (CoinSelectionStrategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{0}
+}
+
 type NewAddressRequest_AddressType int32
 
 const (
@@ -158,7 +249,7 @@ func (x NewAddressRequest_AddressType) String() string {
 	return proto.EnumName(NewAddressRequest_AddressType_name, int32(x))
 }
 func (NewAddressRequest_AddressType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{21, 0}
+	return fileDescriptor0, []int{36, 0}
 }
 
 type ChannelCloseSummary_ClosureType int32
@@ -190,7 +281,157 @@ func (x ChannelCloseSummary_ClosureType) String() string {
 	return proto.EnumName(ChannelCloseSummary_ClosureType_name, int32(x))
 }
 func (ChannelCloseSummary_ClosureType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{36, 0}
+	return fileDescriptor0, []int{51, 0}
+}
+
+type Peer_SyncType int32
+
+const (
+	// *
+	// Denotes that we cannot determine the peer's current sync type, e.g.
+	// because it doesn't support gossip queries.
+	Peer_UNKNOWN_SYNC Peer_SyncType = 0
+	// *
+	// Denotes that we are actively receiving new graph updates from the
+	// peer.
+	Peer_ACTIVE_SYNC Peer_SyncType = 1
+	// *
+	// Denotes that we are not receiving new graph updates from the peer.
+	Peer_PASSIVE_SYNC Peer_SyncType = 2
+)
+
+var Peer_SyncType_name = map[int32]string{
+	0: "UNKNOWN_SYNC",
+	1: "ACTIVE_SYNC",
+	2: "PASSIVE_SYNC",
+}
+var Peer_SyncType_value = map[string]int32{
+	"UNKNOWN_SYNC": 0,
+	"ACTIVE_SYNC":  1,
+	"PASSIVE_SYNC": 2,
+}
+
+func (x Peer_SyncType) String() string {
+	return proto.EnumName(Peer_SyncType_name, int32(x))
+}
+func (Peer_SyncType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{54, 0}
+}
+
+type PaymentAttempt_AttemptState int32
+
+const (
+	PaymentAttempt_IN_FLIGHT PaymentAttempt_AttemptState = 0
+	PaymentAttempt_SUCCEEDED PaymentAttempt_AttemptState = 1
+	PaymentAttempt_FAILED    PaymentAttempt_AttemptState = 2
+)
+
+var PaymentAttempt_AttemptState_name = map[int32]string{
+	0: "IN_FLIGHT",
+	1: "SUCCEEDED",
+	2: "FAILED",
+}
+var PaymentAttempt_AttemptState_value = map[string]int32{
+	"IN_FLIGHT": 0,
+	"SUCCEEDED": 1,
+	"FAILED":    2,
+}
+
+func (x PaymentAttempt_AttemptState) String() string {
+	return proto.EnumName(PaymentAttempt_AttemptState_name, int32(x))
+}
+func (PaymentAttempt_AttemptState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{130, 0}
+}
+
+type PaymentUpdate_PaymentState int32
+
+const (
+	PaymentUpdate_IN_FLIGHT PaymentUpdate_PaymentState = 0
+	PaymentUpdate_SUCCEEDED PaymentUpdate_PaymentState = 1
+	PaymentUpdate_FAILED    PaymentUpdate_PaymentState = 2
+)
+
+var PaymentUpdate_PaymentState_name = map[int32]string{
+	0: "IN_FLIGHT",
+	1: "SUCCEEDED",
+	2: "FAILED",
+}
+var PaymentUpdate_PaymentState_value = map[string]int32{
+	"IN_FLIGHT": 0,
+	"SUCCEEDED": 1,
+	"FAILED":    2,
+}
+
+func (x PaymentUpdate_PaymentState) String() string {
+	return proto.EnumName(PaymentUpdate_PaymentState_name, int32(x))
+}
+func (PaymentUpdate_PaymentState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{131, 0}
+}
+
+type HtlcEvent_EventType int32
+
+const (
+	HtlcEvent_FORWARD   HtlcEvent_EventType = 0
+	HtlcEvent_ADD       HtlcEvent_EventType = 1
+	HtlcEvent_QUEUE     HtlcEvent_EventType = 2
+	HtlcEvent_DROP      HtlcEvent_EventType = 3
+	HtlcEvent_SETTLE    HtlcEvent_EventType = 4
+	HtlcEvent_FAIL      HtlcEvent_EventType = 5
+	HtlcEvent_LINK_FAIL HtlcEvent_EventType = 6
+)
+
+var HtlcEvent_EventType_name = map[int32]string{
+	0: "FORWARD",
+	1: "ADD",
+	2: "QUEUE",
+	3: "DROP",
+	4: "SETTLE",
+	5: "FAIL",
+	6: "LINK_FAIL",
+}
+var HtlcEvent_EventType_value = map[string]int32{
+	"FORWARD":   0,
+	"ADD":       1,
+	"QUEUE":     2,
+	"DROP":      3,
+	"SETTLE":    4,
+	"FAIL":      5,
+	"LINK_FAIL": 6,
+}
+
+func (x HtlcEvent_EventType) String() string {
+	return proto.EnumName(HtlcEvent_EventType_name, int32(x))
+}
+func (HtlcEvent_EventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{133, 0}
+}
+
+type ForwardHtlcInterceptResponse_ResolveAction int32
+
+const (
+	ForwardHtlcInterceptResponse_RESUME ForwardHtlcInterceptResponse_ResolveAction = 0
+	ForwardHtlcInterceptResponse_FAIL   ForwardHtlcInterceptResponse_ResolveAction = 1
+	ForwardHtlcInterceptResponse_SETTLE ForwardHtlcInterceptResponse_ResolveAction = 2
+)
+
+var ForwardHtlcInterceptResponse_ResolveAction_name = map[int32]string{
+	0: "RESUME",
+	1: "FAIL",
+	2: "SETTLE",
+}
+var ForwardHtlcInterceptResponse_ResolveAction_value = map[string]int32{
+	"RESUME": 0,
+	"FAIL":   1,
+	"SETTLE": 2,
+}
+
+func (x ForwardHtlcInterceptResponse_ResolveAction) String() string {
+	return proto.EnumName(ForwardHtlcInterceptResponse_ResolveAction_name, int32(x))
+}
+func (ForwardHtlcInterceptResponse_ResolveAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{136, 0}
 }
 
 type GenSeedRequest struct {
@@ -401,6 +642,77 @@ func (m *ChangePasswordResponse) String() string            { return proto.Compa
 func (*ChangePasswordResponse) ProtoMessage()               {}
 func (*ChangePasswordResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
 
+type ChangeSeedPassphraseRequest struct {
+	// *
+	// cipher_seed_mnemonic is the 24-word mnemonic that encodes the aezeed
+	// cipher seed under its current passphrase.
+	CipherSeedMnemonic []string `protobuf:"bytes,1,rep,name=cipher_seed_mnemonic,json=cipherSeedMnemonic" json:"cipher_seed_mnemonic,omitempty"`
+	// *
+	// current_aezeed_passphrase is the passphrase the mnemonic is currently
+	// enciphered with, if any.
+	CurrentAezeedPassphrase []byte `protobuf:"bytes,2,opt,name=current_aezeed_passphrase,json=currentAezeedPassphrase,proto3" json:"current_aezeed_passphrase,omitempty"`
+	// *
+	// new_aezeed_passphrase is the passphrase the mnemonic should be enciphered
+	// with. An empty passphrase results in the default passphrase being used.
+	NewAezeedPassphrase []byte `protobuf:"bytes,3,opt,name=new_aezeed_passphrase,json=newAezeedPassphrase,proto3" json:"new_aezeed_passphrase,omitempty"`
+}
+
+func (m *ChangeSeedPassphraseRequest) Reset()                    { *m = ChangeSeedPassphraseRequest{} }
+func (m *ChangeSeedPassphraseRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChangeSeedPassphraseRequest) ProtoMessage()               {}
+func (*ChangeSeedPassphraseRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *ChangeSeedPassphraseRequest) GetCipherSeedMnemonic() []string {
+	if m != nil {
+		return m.CipherSeedMnemonic
+	}
+	return nil
+}
+
+func (m *ChangeSeedPassphraseRequest) GetCurrentAezeedPassphrase() []byte {
+	if m != nil {
+		return m.CurrentAezeedPassphrase
+	}
+	return nil
+}
+
+func (m *ChangeSeedPassphraseRequest) GetNewAezeedPassphrase() []byte {
+	if m != nil {
+		return m.NewAezeedPassphrase
+	}
+	return nil
+}
+
+type ChangeSeedPassphraseResponse struct {
+	// *
+	// cipher_seed_mnemonic is the 24-word mnemonic that encodes the same aezeed
+	// cipher seed, enciphered with the new passphrase.
+	CipherSeedMnemonic []string `protobuf:"bytes,1,rep,name=cipher_seed_mnemonic,json=cipherSeedMnemonic" json:"cipher_seed_mnemonic,omitempty"`
+	// *
+	// enciphered_seed are the raw aezeed cipher seed bytes enciphered with the
+	// new passphrase.
+	EncipheredSeed []byte `protobuf:"bytes,2,opt,name=enciphered_seed,json=encipheredSeed,proto3" json:"enciphered_seed,omitempty"`
+}
+
+func (m *ChangeSeedPassphraseResponse) Reset()                    { *m = ChangeSeedPassphraseResponse{} }
+func (m *ChangeSeedPassphraseResponse) String() string            { return proto.CompactTextString(m) }
+func (*ChangeSeedPassphraseResponse) ProtoMessage()               {}
+func (*ChangeSeedPassphraseResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *ChangeSeedPassphraseResponse) GetCipherSeedMnemonic() []string {
+	if m != nil {
+		return m.CipherSeedMnemonic
+	}
+	return nil
+}
+
+func (m *ChangeSeedPassphraseResponse) GetEncipheredSeed() []byte {
+	if m != nil {
+		return m.EncipheredSeed
+	}
+	return nil
+}
+
 type Transaction struct {
 	// / The transaction hash
 	TxHash string `protobuf:"bytes,1,opt,name=tx_hash" json:"tx_hash,omitempty"`
@@ -423,7 +735,7 @@ type Transaction struct {
 func (m *Transaction) Reset()                    { *m = Transaction{} }
 func (m *Transaction) String() string            { return proto.CompactTextString(m) }
 func (*Transaction) ProtoMessage()               {}
-func (*Transaction) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+func (*Transaction) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
 
 func (m *Transaction) GetTxHash() string {
 	if m != nil {
@@ -487,7 +799,7 @@ type GetTransactionsRequest struct {
 func (m *GetTransactionsRequest) Reset()                    { *m = GetTransactionsRequest{} }
 func (m *GetTransactionsRequest) String() string            { return proto.CompactTextString(m) }
 func (*GetTransactionsRequest) ProtoMessage()               {}
-func (*GetTransactionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+func (*GetTransactionsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
 
 type TransactionDetails struct {
 	// / The list of transactions relevant to the wallet.
@@ -497,7 +809,7 @@ type TransactionDetails struct {
 func (m *TransactionDetails) Reset()                    { *m = TransactionDetails{} }
 func (m *TransactionDetails) String() string            { return proto.CompactTextString(m) }
 func (*TransactionDetails) ProtoMessage()               {}
-func (*TransactionDetails) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+func (*TransactionDetails) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
 
 func (m *TransactionDetails) GetTransactions() []*Transaction {
 	if m != nil {
@@ -506,6 +818,94 @@ func (m *TransactionDetails) GetTransactions() []*Transaction {
 	return nil
 }
 
+type GetRecoveryInfoRequest struct {
+}
+
+func (m *GetRecoveryInfoRequest) Reset()                    { *m = GetRecoveryInfoRequest{} }
+func (m *GetRecoveryInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRecoveryInfoRequest) ProtoMessage()               {}
+func (*GetRecoveryInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+type RecoveryInfo struct {
+	// / Whether the wallet was started in recovery mode
+	RecoveryMode bool `protobuf:"varint,1,opt,name=recovery_mode" json:"recovery_mode,omitempty"`
+	// / Whether the wallet has finished rescanning the chain
+	RecoveryFinished bool `protobuf:"varint,2,opt,name=recovery_finished" json:"recovery_finished,omitempty"`
+	// / The fraction of the blocks to rescan that have been rescanned, from 0 to 1
+	Progress float64 `protobuf:"fixed64,3,opt,name=progress" json:"progress,omitempty"`
+	// / The height the wallet started rescanning from
+	StartHeight int32 `protobuf:"varint,4,opt,name=start_height" json:"start_height,omitempty"`
+	// / The height the wallet has rescanned up to
+	CurrentHeight int32 `protobuf:"varint,5,opt,name=current_height" json:"current_height,omitempty"`
+	// / The height of the best block known to the chain backend
+	BestHeight int32 `protobuf:"varint,6,opt,name=best_height" json:"best_height,omitempty"`
+	// / The number of addresses of the wallet found to be in use so far
+	AddressesFound uint32 `protobuf:"varint,7,opt,name=addresses_found" json:"addresses_found,omitempty"`
+	// / The address look-ahead used during the rescan
+	RecoveryWindow uint32 `protobuf:"varint,8,opt,name=recovery_window" json:"recovery_window,omitempty"`
+}
+
+func (m *RecoveryInfo) Reset()                    { *m = RecoveryInfo{} }
+func (m *RecoveryInfo) String() string            { return proto.CompactTextString(m) }
+func (*RecoveryInfo) ProtoMessage()               {}
+func (*RecoveryInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *RecoveryInfo) GetRecoveryMode() bool {
+	if m != nil {
+		return m.RecoveryMode
+	}
+	return false
+}
+
+func (m *RecoveryInfo) GetRecoveryFinished() bool {
+	if m != nil {
+		return m.RecoveryFinished
+	}
+	return false
+}
+
+func (m *RecoveryInfo) GetProgress() float64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
+
+func (m *RecoveryInfo) GetStartHeight() int32 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *RecoveryInfo) GetCurrentHeight() int32 {
+	if m != nil {
+		return m.CurrentHeight
+	}
+	return 0
+}
+
+func (m *RecoveryInfo) GetBestHeight() int32 {
+	if m != nil {
+		return m.BestHeight
+	}
+	return 0
+}
+
+func (m *RecoveryInfo) GetAddressesFound() uint32 {
+	if m != nil {
+		return m.AddressesFound
+	}
+	return 0
+}
+
+func (m *RecoveryInfo) GetRecoveryWindow() uint32 {
+	if m != nil {
+		return m.RecoveryWindow
+	}
+	return 0
+}
+
 type FeeLimit struct {
 	// Types that are valid to be assigned to Limit:
 	//	*FeeLimit_Fixed
@@ -516,7 +916,7 @@ type FeeLimit struct {
 func (m *FeeLimit) Reset()                    { *m = FeeLimit{} }
 func (m *FeeLimit) String() string            { return proto.CompactTextString(m) }
 func (*FeeLimit) ProtoMessage()               {}
-func (*FeeLimit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+func (*FeeLimit) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
 
 type isFeeLimit_Limit interface{ isFeeLimit_Limit() }
 
@@ -650,7 +1050,7 @@ type SendRequest struct {
 func (m *SendRequest) Reset()                    { *m = SendRequest{} }
 func (m *SendRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendRequest) ProtoMessage()               {}
-func (*SendRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+func (*SendRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
 
 func (m *SendRequest) GetDest() []byte {
 	if m != nil {
@@ -724,7 +1124,7 @@ type SendResponse struct {
 func (m *SendResponse) Reset()                    { *m = SendResponse{} }
 func (m *SendResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendResponse) ProtoMessage()               {}
-func (*SendResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+func (*SendResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
 
 func (m *SendResponse) GetPaymentError() string {
 	if m != nil {
@@ -759,7 +1159,7 @@ type SendToRouteRequest struct {
 func (m *SendToRouteRequest) Reset()                    { *m = SendToRouteRequest{} }
 func (m *SendToRouteRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendToRouteRequest) ProtoMessage()               {}
-func (*SendToRouteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+func (*SendToRouteRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
 
 func (m *SendToRouteRequest) GetPaymentHash() []byte {
 	if m != nil {
@@ -794,7 +1194,7 @@ type ChannelPoint struct {
 func (m *ChannelPoint) Reset()                    { *m = ChannelPoint{} }
 func (m *ChannelPoint) String() string            { return proto.CompactTextString(m) }
 func (*ChannelPoint) ProtoMessage()               {}
-func (*ChannelPoint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{15} }
+func (*ChannelPoint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
 
 type isChannelPoint_FundingTxid interface{ isChannelPoint_FundingTxid() }
 
@@ -902,6 +1302,41 @@ func _ChannelPoint_OneofSizer(msg proto.Message) (n int) {
 	return n
 }
 
+type OutPoint struct {
+	// / Raw bytes representing the transaction id
+	TxidBytes []byte `protobuf:"bytes,1,opt,name=txid_bytes,proto3" json:"txid_bytes,omitempty"`
+	// / Reversed, hex-encoded string representing the transaction id
+	TxidStr string `protobuf:"bytes,2,opt,name=txid_str" json:"txid_str,omitempty"`
+	// / The index of the output on the transaction
+	OutputIndex uint32 `protobuf:"varint,3,opt,name=output_index" json:"output_index,omitempty"`
+}
+
+func (m *OutPoint) Reset()                    { *m = OutPoint{} }
+func (m *OutPoint) String() string            { return proto.CompactTextString(m) }
+func (*OutPoint) ProtoMessage()               {}
+func (*OutPoint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+
+func (m *OutPoint) GetTxidBytes() []byte {
+	if m != nil {
+		return m.TxidBytes
+	}
+	return nil
+}
+
+func (m *OutPoint) GetTxidStr() string {
+	if m != nil {
+		return m.TxidStr
+	}
+	return ""
+}
+
+func (m *OutPoint) GetOutputIndex() uint32 {
+	if m != nil {
+		return m.OutputIndex
+	}
+	return 0
+}
+
 type LightningAddress struct {
 	// / The identity pubkey of the Lightning node
 	Pubkey string `protobuf:"bytes,1,opt,name=pubkey" json:"pubkey,omitempty"`
@@ -912,7 +1347,7 @@ type LightningAddress struct {
 func (m *LightningAddress) Reset()                    { *m = LightningAddress{} }
 func (m *LightningAddress) String() string            { return proto.CompactTextString(m) }
 func (*LightningAddress) ProtoMessage()               {}
-func (*LightningAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{16} }
+func (*LightningAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
 
 func (m *LightningAddress) GetPubkey() string {
 	if m != nil {
@@ -935,12 +1370,18 @@ type SendManyRequest struct {
 	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
 	// / A manual fee rate set in sat/byte that should be used when crafting the transaction.
 	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+	// / The outputs of the wallet the transaction should be funded from. If empty, any output of the wallet may be spent.
+	Outpoints []*OutPoint `protobuf:"bytes,6,rep,name=outpoints" json:"outpoints,omitempty"`
+	// / The strategy used to select the outputs the transaction is funded from.
+	CoinSelectionStrategy CoinSelectionStrategy `protobuf:"varint,7,opt,name=coin_selection_strategy,json=coinSelectionStrategy,enum=lnrpc.CoinSelectionStrategy" json:"coin_selection_strategy,omitempty"`
+	// / The 32 byte ID under which any of the passed outpoints are leased. Leased outputs are only spent if they're passed explicitly, and leased under this ID.
+	LockId []byte `protobuf:"bytes,8,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
 }
 
 func (m *SendManyRequest) Reset()                    { *m = SendManyRequest{} }
 func (m *SendManyRequest) String() string            { return proto.CompactTextString(m) }
 func (*SendManyRequest) ProtoMessage()               {}
-func (*SendManyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{17} }
+func (*SendManyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
 
 func (m *SendManyRequest) GetAddrToAmount() map[string]int64 {
 	if m != nil {
@@ -963,6 +1404,27 @@ func (m *SendManyRequest) GetSatPerByte() int64 {
 	return 0
 }
 
+func (m *SendManyRequest) GetOutpoints() []*OutPoint {
+	if m != nil {
+		return m.Outpoints
+	}
+	return nil
+}
+
+func (m *SendManyRequest) GetCoinSelectionStrategy() CoinSelectionStrategy {
+	if m != nil {
+		return m.CoinSelectionStrategy
+	}
+	return CoinSelectionStrategy_DEFAULT
+}
+
+func (m *SendManyRequest) GetLockId() []byte {
+	if m != nil {
+		return m.LockId
+	}
+	return nil
+}
+
 type SendManyResponse struct {
 	// / The id of the transaction
 	Txid string `protobuf:"bytes,1,opt,name=txid" json:"txid,omitempty"`
@@ -971,7 +1433,7 @@ type SendManyResponse struct {
 func (m *SendManyResponse) Reset()                    { *m = SendManyResponse{} }
 func (m *SendManyResponse) String() string            { return proto.CompactTextString(m) }
 func (*SendManyResponse) ProtoMessage()               {}
-func (*SendManyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{18} }
+func (*SendManyResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
 
 func (m *SendManyResponse) GetTxid() string {
 	if m != nil {
@@ -980,97 +1442,341 @@ func (m *SendManyResponse) GetTxid() string {
 	return ""
 }
 
-type SendCoinsRequest struct {
-	// / The address to send coins to
-	Addr string `protobuf:"bytes,1,opt,name=addr" json:"addr,omitempty"`
-	// / The amount in satoshis to send
-	Amount int64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
-	// / The target number of blocks that this transaction should be confirmed by.
-	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
-	// / A manual fee rate set in sat/byte that should be used when crafting the transaction.
-	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+type EstimateFeeRequest struct {
+	// *
+	// The confirmation targets to estimate fee rates for, expressed in blocks.
+	// If none are set, a default set of confirmation targets is used.
+	TargetConfs []uint32 `protobuf:"varint,1,rep,packed,name=target_confs,json=targetConfs" json:"target_confs,omitempty"`
 }
 
-func (m *SendCoinsRequest) Reset()                    { *m = SendCoinsRequest{} }
-func (m *SendCoinsRequest) String() string            { return proto.CompactTextString(m) }
-func (*SendCoinsRequest) ProtoMessage()               {}
-func (*SendCoinsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{19} }
+func (m *EstimateFeeRequest) Reset()                    { *m = EstimateFeeRequest{} }
+func (m *EstimateFeeRequest) String() string            { return proto.CompactTextString(m) }
+func (*EstimateFeeRequest) ProtoMessage()               {}
+func (*EstimateFeeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
 
-func (m *SendCoinsRequest) GetAddr() string {
+func (m *EstimateFeeRequest) GetTargetConfs() []uint32 {
 	if m != nil {
-		return m.Addr
+		return m.TargetConfs
 	}
-	return ""
+	return nil
 }
 
-func (m *SendCoinsRequest) GetAmount() int64 {
+type FeeEstimate struct {
+	// / The confirmation target the fee rate was estimated for
+	TargetConf uint32 `protobuf:"varint,1,opt,name=target_conf" json:"target_conf,omitempty"`
+	// / The estimated fee rate in satoshis per kilo-weight unit
+	SatPerKw int64 `protobuf:"varint,2,opt,name=sat_per_kw" json:"sat_per_kw,omitempty"`
+	// / The estimated fee rate in satoshis per virtual byte
+	SatPerVbyte int64 `protobuf:"varint,3,opt,name=sat_per_vbyte" json:"sat_per_vbyte,omitempty"`
+}
+
+func (m *FeeEstimate) Reset()                    { *m = FeeEstimate{} }
+func (m *FeeEstimate) String() string            { return proto.CompactTextString(m) }
+func (*FeeEstimate) ProtoMessage()               {}
+func (*FeeEstimate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+
+func (m *FeeEstimate) GetTargetConf() uint32 {
 	if m != nil {
-		return m.Amount
+		return m.TargetConf
 	}
 	return 0
 }
 
-func (m *SendCoinsRequest) GetTargetConf() int32 {
+func (m *FeeEstimate) GetSatPerKw() int64 {
 	if m != nil {
-		return m.TargetConf
+		return m.SatPerKw
 	}
 	return 0
 }
 
-func (m *SendCoinsRequest) GetSatPerByte() int64 {
+func (m *FeeEstimate) GetSatPerVbyte() int64 {
 	if m != nil {
-		return m.SatPerByte
+		return m.SatPerVbyte
 	}
 	return 0
 }
 
-type SendCoinsResponse struct {
-	// / The transaction ID of the transaction
-	Txid string `protobuf:"bytes,1,opt,name=txid" json:"txid,omitempty"`
+type EstimateFeeResponse struct {
+	// / The fee rate estimates, one per requested confirmation target
+	Estimates []*FeeEstimate `protobuf:"bytes,1,rep,name=estimates" json:"estimates,omitempty"`
 }
 
-func (m *SendCoinsResponse) Reset()                    { *m = SendCoinsResponse{} }
-func (m *SendCoinsResponse) String() string            { return proto.CompactTextString(m) }
-func (*SendCoinsResponse) ProtoMessage()               {}
-func (*SendCoinsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{20} }
+func (m *EstimateFeeResponse) Reset()                    { *m = EstimateFeeResponse{} }
+func (m *EstimateFeeResponse) String() string            { return proto.CompactTextString(m) }
+func (*EstimateFeeResponse) ProtoMessage()               {}
+func (*EstimateFeeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
 
-func (m *SendCoinsResponse) GetTxid() string {
+func (m *EstimateFeeResponse) GetEstimates() []*FeeEstimate {
 	if m != nil {
-		return m.Txid
+		return m.Estimates
 	}
-	return ""
+	return nil
 }
 
-// *
-// `AddressType` has to be one of:
-//
-// - `p2wkh`: Pay to witness key hash (`WITNESS_PUBKEY_HASH` = 0)
-// - `np2wkh`: Pay to nested witness key hash (`NESTED_PUBKEY_HASH` = 1)
-// - `p2pkh`:  Pay to public key hash (`PUBKEY_HASH` = 2)
-type NewAddressRequest struct {
-	// / The address type
-	Type NewAddressRequest_AddressType `protobuf:"varint,1,opt,name=type,enum=lnrpc.NewAddressRequest_AddressType" json:"type,omitempty"`
+type LeaseOutputRequest struct {
+	// / The 32 byte ID the output is leased under
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// / The output to lease
+	Outpoint *OutPoint `protobuf:"bytes,2,opt,name=outpoint" json:"outpoint,omitempty"`
+	// / The duration of the lease in seconds. If not set, a default of 10 minutes is used.
+	ExpirationSeconds uint64 `protobuf:"varint,3,opt,name=expiration_seconds" json:"expiration_seconds,omitempty"`
 }
 
-func (m *NewAddressRequest) Reset()                    { *m = NewAddressRequest{} }
-func (m *NewAddressRequest) String() string            { return proto.CompactTextString(m) }
-func (*NewAddressRequest) ProtoMessage()               {}
-func (*NewAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{21} }
+func (m *LeaseOutputRequest) Reset()                    { *m = LeaseOutputRequest{} }
+func (m *LeaseOutputRequest) String() string            { return proto.CompactTextString(m) }
+func (*LeaseOutputRequest) ProtoMessage()               {}
+func (*LeaseOutputRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
 
-func (m *NewAddressRequest) GetType() NewAddressRequest_AddressType {
+func (m *LeaseOutputRequest) GetId() []byte {
 	if m != nil {
-		return m.Type
+		return m.Id
 	}
-	return NewAddressRequest_WITNESS_PUBKEY_HASH
+	return nil
 }
 
-type NewWitnessAddressRequest struct {
+func (m *LeaseOutputRequest) GetOutpoint() *OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
 }
 
-func (m *NewWitnessAddressRequest) Reset()                    { *m = NewWitnessAddressRequest{} }
-func (m *NewWitnessAddressRequest) String() string            { return proto.CompactTextString(m) }
-func (*NewWitnessAddressRequest) ProtoMessage()               {}
-func (*NewWitnessAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{22} }
+func (m *LeaseOutputRequest) GetExpirationSeconds() uint64 {
+	if m != nil {
+		return m.ExpirationSeconds
+	}
+	return 0
+}
+
+type LeaseOutputResponse struct {
+	// / The unix timestamp at which the lease expires
+	Expiration uint64 `protobuf:"varint,1,opt,name=expiration" json:"expiration,omitempty"`
+}
+
+func (m *LeaseOutputResponse) Reset()                    { *m = LeaseOutputResponse{} }
+func (m *LeaseOutputResponse) String() string            { return proto.CompactTextString(m) }
+func (*LeaseOutputResponse) ProtoMessage()               {}
+func (*LeaseOutputResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *LeaseOutputResponse) GetExpiration() uint64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+type ReleaseOutputRequest struct {
+	// / The 32 byte ID the output was leased under
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// / The output to release
+	Outpoint *OutPoint `protobuf:"bytes,2,opt,name=outpoint" json:"outpoint,omitempty"`
+}
+
+func (m *ReleaseOutputRequest) Reset()                    { *m = ReleaseOutputRequest{} }
+func (m *ReleaseOutputRequest) String() string            { return proto.CompactTextString(m) }
+func (*ReleaseOutputRequest) ProtoMessage()               {}
+func (*ReleaseOutputRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *ReleaseOutputRequest) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *ReleaseOutputRequest) GetOutpoint() *OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+type ReleaseOutputResponse struct {
+}
+
+func (m *ReleaseOutputResponse) Reset()                    { *m = ReleaseOutputResponse{} }
+func (m *ReleaseOutputResponse) String() string            { return proto.CompactTextString(m) }
+func (*ReleaseOutputResponse) ProtoMessage()               {}
+func (*ReleaseOutputResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+type ListLeasesRequest struct {
+}
+
+func (m *ListLeasesRequest) Reset()                    { *m = ListLeasesRequest{} }
+func (m *ListLeasesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListLeasesRequest) ProtoMessage()               {}
+func (*ListLeasesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+
+type UtxoLease struct {
+	// / The 32 byte ID the output is leased under
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// / The leased output
+	Outpoint *OutPoint `protobuf:"bytes,2,opt,name=outpoint" json:"outpoint,omitempty"`
+	// / The unix timestamp at which the lease expires
+	Expiration uint64 `protobuf:"varint,3,opt,name=expiration" json:"expiration,omitempty"`
+}
+
+func (m *UtxoLease) Reset()                    { *m = UtxoLease{} }
+func (m *UtxoLease) String() string            { return proto.CompactTextString(m) }
+func (*UtxoLease) ProtoMessage()               {}
+func (*UtxoLease) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+
+func (m *UtxoLease) GetId() []byte {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (m *UtxoLease) GetOutpoint() *OutPoint {
+	if m != nil {
+		return m.Outpoint
+	}
+	return nil
+}
+
+func (m *UtxoLease) GetExpiration() uint64 {
+	if m != nil {
+		return m.Expiration
+	}
+	return 0
+}
+
+type ListLeasesResponse struct {
+	// / The outputs that are currently leased
+	Leases []*UtxoLease `protobuf:"bytes,1,rep,name=leases" json:"leases,omitempty"`
+}
+
+func (m *ListLeasesResponse) Reset()                    { *m = ListLeasesResponse{} }
+func (m *ListLeasesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListLeasesResponse) ProtoMessage()               {}
+func (*ListLeasesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+
+func (m *ListLeasesResponse) GetLeases() []*UtxoLease {
+	if m != nil {
+		return m.Leases
+	}
+	return nil
+}
+
+type SendCoinsRequest struct {
+	// / The address to send coins to
+	Addr string `protobuf:"bytes,1,opt,name=addr" json:"addr,omitempty"`
+	// / The amount in satoshis to send
+	Amount int64 `protobuf:"varint,2,opt,name=amount" json:"amount,omitempty"`
+	// / The target number of blocks that this transaction should be confirmed by.
+	TargetConf int32 `protobuf:"varint,3,opt,name=target_conf,json=targetConf" json:"target_conf,omitempty"`
+	// / A manual fee rate set in sat/byte that should be used when crafting the transaction.
+	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte,json=satPerByte" json:"sat_per_byte,omitempty"`
+	// / The outputs of the wallet the transaction should be funded from. If empty, any output of the wallet may be spent.
+	Outpoints []*OutPoint `protobuf:"bytes,6,rep,name=outpoints" json:"outpoints,omitempty"`
+	// / The strategy used to select the outputs the transaction is funded from.
+	CoinSelectionStrategy CoinSelectionStrategy `protobuf:"varint,7,opt,name=coin_selection_strategy,json=coinSelectionStrategy,enum=lnrpc.CoinSelectionStrategy" json:"coin_selection_strategy,omitempty"`
+	// / The 32 byte ID under which any of the passed outpoints are leased. Leased outputs are only spent if they're passed explicitly, and leased under this ID.
+	LockId []byte `protobuf:"bytes,8,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty"`
+}
+
+func (m *SendCoinsRequest) Reset()                    { *m = SendCoinsRequest{} }
+func (m *SendCoinsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SendCoinsRequest) ProtoMessage()               {}
+func (*SendCoinsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+
+func (m *SendCoinsRequest) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *SendCoinsRequest) GetAmount() int64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *SendCoinsRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *SendCoinsRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+func (m *SendCoinsRequest) GetOutpoints() []*OutPoint {
+	if m != nil {
+		return m.Outpoints
+	}
+	return nil
+}
+
+func (m *SendCoinsRequest) GetCoinSelectionStrategy() CoinSelectionStrategy {
+	if m != nil {
+		return m.CoinSelectionStrategy
+	}
+	return CoinSelectionStrategy_DEFAULT
+}
+
+func (m *SendCoinsRequest) GetLockId() []byte {
+	if m != nil {
+		return m.LockId
+	}
+	return nil
+}
+
+type SendCoinsResponse struct {
+	// / The transaction ID of the transaction
+	Txid string `protobuf:"bytes,1,opt,name=txid" json:"txid,omitempty"`
+}
+
+func (m *SendCoinsResponse) Reset()                    { *m = SendCoinsResponse{} }
+func (m *SendCoinsResponse) String() string            { return proto.CompactTextString(m) }
+func (*SendCoinsResponse) ProtoMessage()               {}
+func (*SendCoinsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+
+func (m *SendCoinsResponse) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+// *
+// `AddressType` has to be one of:
+//
+// - `p2wkh`: Pay to witness key hash (`WITNESS_PUBKEY_HASH` = 0)
+// - `np2wkh`: Pay to nested witness key hash (`NESTED_PUBKEY_HASH` = 1)
+// - `p2pkh`:  Pay to public key hash (`PUBKEY_HASH` = 2)
+type NewAddressRequest struct {
+	// / The address type
+	Type NewAddressRequest_AddressType `protobuf:"varint,1,opt,name=type,enum=lnrpc.NewAddressRequest_AddressType" json:"type,omitempty"`
+}
+
+func (m *NewAddressRequest) Reset()                    { *m = NewAddressRequest{} }
+func (m *NewAddressRequest) String() string            { return proto.CompactTextString(m) }
+func (*NewAddressRequest) ProtoMessage()               {}
+func (*NewAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+
+func (m *NewAddressRequest) GetType() NewAddressRequest_AddressType {
+	if m != nil {
+		return m.Type
+	}
+	return NewAddressRequest_WITNESS_PUBKEY_HASH
+}
+
+type NewWitnessAddressRequest struct {
+}
+
+func (m *NewWitnessAddressRequest) Reset()                    { *m = NewWitnessAddressRequest{} }
+func (m *NewWitnessAddressRequest) String() string            { return proto.CompactTextString(m) }
+func (*NewWitnessAddressRequest) ProtoMessage()               {}
+func (*NewWitnessAddressRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
 
 type NewAddressResponse struct {
 	// / The newly generated wallet address
@@ -1080,7 +1786,7 @@ type NewAddressResponse struct {
 func (m *NewAddressResponse) Reset()                    { *m = NewAddressResponse{} }
 func (m *NewAddressResponse) String() string            { return proto.CompactTextString(m) }
 func (*NewAddressResponse) ProtoMessage()               {}
-func (*NewAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{23} }
+func (*NewAddressResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
 
 func (m *NewAddressResponse) GetAddress() string {
 	if m != nil {
@@ -1097,7 +1803,7 @@ type SignMessageRequest struct {
 func (m *SignMessageRequest) Reset()                    { *m = SignMessageRequest{} }
 func (m *SignMessageRequest) String() string            { return proto.CompactTextString(m) }
 func (*SignMessageRequest) ProtoMessage()               {}
-func (*SignMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{24} }
+func (*SignMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
 
 func (m *SignMessageRequest) GetMsg() []byte {
 	if m != nil {
@@ -1114,7 +1820,7 @@ type SignMessageResponse struct {
 func (m *SignMessageResponse) Reset()                    { *m = SignMessageResponse{} }
 func (m *SignMessageResponse) String() string            { return proto.CompactTextString(m) }
 func (*SignMessageResponse) ProtoMessage()               {}
-func (*SignMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{25} }
+func (*SignMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
 
 func (m *SignMessageResponse) GetSignature() string {
 	if m != nil {
@@ -1133,7 +1839,7 @@ type VerifyMessageRequest struct {
 func (m *VerifyMessageRequest) Reset()                    { *m = VerifyMessageRequest{} }
 func (m *VerifyMessageRequest) String() string            { return proto.CompactTextString(m) }
 func (*VerifyMessageRequest) ProtoMessage()               {}
-func (*VerifyMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+func (*VerifyMessageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
 
 func (m *VerifyMessageRequest) GetMsg() []byte {
 	if m != nil {
@@ -1159,7 +1865,7 @@ type VerifyMessageResponse struct {
 func (m *VerifyMessageResponse) Reset()                    { *m = VerifyMessageResponse{} }
 func (m *VerifyMessageResponse) String() string            { return proto.CompactTextString(m) }
 func (*VerifyMessageResponse) ProtoMessage()               {}
-func (*VerifyMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+func (*VerifyMessageResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
 
 func (m *VerifyMessageResponse) GetValid() bool {
 	if m != nil {
@@ -1186,7 +1892,7 @@ type ConnectPeerRequest struct {
 func (m *ConnectPeerRequest) Reset()                    { *m = ConnectPeerRequest{} }
 func (m *ConnectPeerRequest) String() string            { return proto.CompactTextString(m) }
 func (*ConnectPeerRequest) ProtoMessage()               {}
-func (*ConnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+func (*ConnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
 
 func (m *ConnectPeerRequest) GetAddr() *LightningAddress {
 	if m != nil {
@@ -1208,7 +1914,7 @@ type ConnectPeerResponse struct {
 func (m *ConnectPeerResponse) Reset()                    { *m = ConnectPeerResponse{} }
 func (m *ConnectPeerResponse) String() string            { return proto.CompactTextString(m) }
 func (*ConnectPeerResponse) ProtoMessage()               {}
-func (*ConnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+func (*ConnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
 
 type DisconnectPeerRequest struct {
 	// / The pubkey of the node to disconnect from
@@ -1218,7 +1924,7 @@ type DisconnectPeerRequest struct {
 func (m *DisconnectPeerRequest) Reset()                    { *m = DisconnectPeerRequest{} }
 func (m *DisconnectPeerRequest) String() string            { return proto.CompactTextString(m) }
 func (*DisconnectPeerRequest) ProtoMessage()               {}
-func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+func (*DisconnectPeerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
 
 func (m *DisconnectPeerRequest) GetPubKey() string {
 	if m != nil {
@@ -1233,7 +1939,7 @@ type DisconnectPeerResponse struct {
 func (m *DisconnectPeerResponse) Reset()                    { *m = DisconnectPeerResponse{} }
 func (m *DisconnectPeerResponse) String() string            { return proto.CompactTextString(m) }
 func (*DisconnectPeerResponse) ProtoMessage()               {}
-func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{31} }
+func (*DisconnectPeerResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
 
 type HTLC struct {
 	Incoming         bool   `protobuf:"varint,1,opt,name=incoming" json:"incoming,omitempty"`
@@ -1245,7 +1951,7 @@ type HTLC struct {
 func (m *HTLC) Reset()                    { *m = HTLC{} }
 func (m *HTLC) String() string            { return proto.CompactTextString(m) }
 func (*HTLC) ProtoMessage()               {}
-func (*HTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{32} }
+func (*HTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
 
 func (m *HTLC) GetIncoming() bool {
 	if m != nil {
@@ -1335,7 +2041,7 @@ type Channel struct {
 func (m *Channel) Reset()                    { *m = Channel{} }
 func (m *Channel) String() string            { return proto.CompactTextString(m) }
 func (*Channel) ProtoMessage()               {}
-func (*Channel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{33} }
+func (*Channel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
 
 func (m *Channel) GetActive() bool {
 	if m != nil {
@@ -1466,7 +2172,7 @@ type ListChannelsRequest struct {
 func (m *ListChannelsRequest) Reset()                    { *m = ListChannelsRequest{} }
 func (m *ListChannelsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListChannelsRequest) ProtoMessage()               {}
-func (*ListChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{34} }
+func (*ListChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
 
 func (m *ListChannelsRequest) GetActiveOnly() bool {
 	if m != nil {
@@ -1504,7 +2210,7 @@ type ListChannelsResponse struct {
 func (m *ListChannelsResponse) Reset()                    { *m = ListChannelsResponse{} }
 func (m *ListChannelsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListChannelsResponse) ProtoMessage()               {}
-func (*ListChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{35} }
+func (*ListChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
 
 func (m *ListChannelsResponse) GetChannels() []*Channel {
 	if m != nil {
@@ -1539,7 +2245,7 @@ type ChannelCloseSummary struct {
 func (m *ChannelCloseSummary) Reset()                    { *m = ChannelCloseSummary{} }
 func (m *ChannelCloseSummary) String() string            { return proto.CompactTextString(m) }
 func (*ChannelCloseSummary) ProtoMessage()               {}
-func (*ChannelCloseSummary) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{36} }
+func (*ChannelCloseSummary) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
 
 func (m *ChannelCloseSummary) GetChannelPoint() string {
 	if m != nil {
@@ -1622,7 +2328,7 @@ type ClosedChannelsRequest struct {
 func (m *ClosedChannelsRequest) Reset()                    { *m = ClosedChannelsRequest{} }
 func (m *ClosedChannelsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ClosedChannelsRequest) ProtoMessage()               {}
-func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{37} }
+func (*ClosedChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
 
 func (m *ClosedChannelsRequest) GetCooperative() bool {
 	if m != nil {
@@ -1666,7 +2372,7 @@ type ClosedChannelsResponse struct {
 func (m *ClosedChannelsResponse) Reset()                    { *m = ClosedChannelsResponse{} }
 func (m *ClosedChannelsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ClosedChannelsResponse) ProtoMessage()               {}
-func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{38} }
+func (*ClosedChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
 
 func (m *ClosedChannelsResponse) GetChannels() []*ChannelCloseSummary {
 	if m != nil {
@@ -1692,12 +2398,24 @@ type Peer struct {
 	Inbound bool `protobuf:"varint,8,opt,name=inbound" json:"inbound,omitempty"`
 	// / Ping time to this peer
 	PingTime int64 `protobuf:"varint,9,opt,name=ping_time" json:"ping_time,omitempty"`
+	// / The type of sync we are currently performing with this peer.
+	SyncType Peer_SyncType `protobuf:"varint,10,opt,name=sync_type,enum=lnrpc.Peer_SyncType" json:"sync_type,omitempty"`
+	// / The current state of the gossip syncer of this peer.
+	SyncState string `protobuf:"bytes,11,opt,name=sync_state" json:"sync_state,omitempty"`
+	// *
+	// The unix timestamp of the last historical graph sync with this peer, or 0
+	// if none was performed yet.
+	LastHistoricalSync int64 `protobuf:"varint,12,opt,name=last_historical_sync" json:"last_historical_sync,omitempty"`
+	// / Statistics of the encrypted connection to this peer.
+	ConnectionStats *ConnectionStats `protobuf:"bytes,13,opt,name=connection_stats" json:"connection_stats,omitempty"`
+	// / The messages exchanged with this peer, by message type.
+	MessageStats []*MessageTypeStats `protobuf:"bytes,14,rep,name=message_stats" json:"message_stats,omitempty"`
 }
 
 func (m *Peer) Reset()                    { *m = Peer{} }
 func (m *Peer) String() string            { return proto.CompactTextString(m) }
 func (*Peer) ProtoMessage()               {}
-func (*Peer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{39} }
+func (*Peer) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
 
 func (m *Peer) GetPubKey() string {
 	if m != nil {
@@ -1755,137 +2473,465 @@ func (m *Peer) GetPingTime() int64 {
 	return 0
 }
 
-type ListPeersRequest struct {
+func (m *Peer) GetSyncType() Peer_SyncType {
+	if m != nil {
+		return m.SyncType
+	}
+	return Peer_UNKNOWN_SYNC
 }
 
-func (m *ListPeersRequest) Reset()                    { *m = ListPeersRequest{} }
-func (m *ListPeersRequest) String() string            { return proto.CompactTextString(m) }
-func (*ListPeersRequest) ProtoMessage()               {}
-func (*ListPeersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{40} }
-
-type ListPeersResponse struct {
-	// / The list of currently connected peers
-	Peers []*Peer `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
+func (m *Peer) GetSyncState() string {
+	if m != nil {
+		return m.SyncState
+	}
+	return ""
 }
 
-func (m *ListPeersResponse) Reset()                    { *m = ListPeersResponse{} }
-func (m *ListPeersResponse) String() string            { return proto.CompactTextString(m) }
-func (*ListPeersResponse) ProtoMessage()               {}
-func (*ListPeersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{41} }
+func (m *Peer) GetLastHistoricalSync() int64 {
+	if m != nil {
+		return m.LastHistoricalSync
+	}
+	return 0
+}
 
-func (m *ListPeersResponse) GetPeers() []*Peer {
+func (m *Peer) GetConnectionStats() *ConnectionStats {
 	if m != nil {
-		return m.Peers
+		return m.ConnectionStats
 	}
 	return nil
 }
 
-type GetInfoRequest struct {
+func (m *Peer) GetMessageStats() []*MessageTypeStats {
+	if m != nil {
+		return m.MessageStats
+	}
+	return nil
 }
 
-func (m *GetInfoRequest) Reset()                    { *m = GetInfoRequest{} }
-func (m *GetInfoRequest) String() string            { return proto.CompactTextString(m) }
-func (*GetInfoRequest) ProtoMessage()               {}
-func (*GetInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{42} }
-
-type GetInfoResponse struct {
-	// / The identity pubkey of the current node.
-	IdentityPubkey string `protobuf:"bytes,1,opt,name=identity_pubkey" json:"identity_pubkey,omitempty"`
-	// / If applicable, the alias of the current node, e.g. "bob"
-	Alias string `protobuf:"bytes,2,opt,name=alias" json:"alias,omitempty"`
-	// / Number of pending channels
-	NumPendingChannels uint32 `protobuf:"varint,3,opt,name=num_pending_channels" json:"num_pending_channels,omitempty"`
-	// / Number of active channels
-	NumActiveChannels uint32 `protobuf:"varint,4,opt,name=num_active_channels" json:"num_active_channels,omitempty"`
-	// / Number of peers
-	NumPeers uint32 `protobuf:"varint,5,opt,name=num_peers" json:"num_peers,omitempty"`
-	// / The node's current view of the height of the best block
-	BlockHeight uint32 `protobuf:"varint,6,opt,name=block_height" json:"block_height,omitempty"`
-	// / The node's current view of the hash of the best block
-	BlockHash string `protobuf:"bytes,8,opt,name=block_hash" json:"block_hash,omitempty"`
-	// / Whether the wallet's view is synced to the main chain
-	SyncedToChain bool `protobuf:"varint,9,opt,name=synced_to_chain" json:"synced_to_chain,omitempty"`
-	// / Whether the current node is connected to testnet
-	Testnet bool `protobuf:"varint,10,opt,name=testnet" json:"testnet,omitempty"`
-	// / A list of active chains the node is connected to
-	Chains []string `protobuf:"bytes,11,rep,name=chains" json:"chains,omitempty"`
-	// / The URIs of the current node.
-	Uris []string `protobuf:"bytes,12,rep,name=uris" json:"uris,omitempty"`
-	// / Timestamp of the block best known to the wallet
-	BestHeaderTimestamp int64 `protobuf:"varint,13,opt,name=best_header_timestamp" json:"best_header_timestamp,omitempty"`
-	// / The version of the LND software that the node is running.
-	Version string `protobuf:"bytes,14,opt,name=version" json:"version,omitempty"`
+type MessageTypeStats struct {
+	// / The name of the message type, eg `UpdateAddHTLC`
+	Type string `protobuf:"bytes,1,opt,name=type" json:"type,omitempty"`
+	// / The numeric message type on the wire
+	TypeId uint32 `protobuf:"varint,2,opt,name=type_id" json:"type_id,omitempty"`
+	// / The priority class outgoing messages of this type are queued in
+	PriorityClass string `protobuf:"bytes,3,opt,name=priority_class" json:"priority_class,omitempty"`
+	// / Messages of this type sent to the peer
+	MsgsSent uint64 `protobuf:"varint,4,opt,name=msgs_sent" json:"msgs_sent,omitempty"`
+	// / Bytes of messages of this type sent to the peer
+	BytesSent uint64 `protobuf:"varint,5,opt,name=bytes_sent" json:"bytes_sent,omitempty"`
+	// / Messages of this type received from the peer
+	MsgsRecv uint64 `protobuf:"varint,6,opt,name=msgs_recv" json:"msgs_recv,omitempty"`
+	// / Bytes of messages of this type received from the peer
+	BytesRecv uint64 `protobuf:"varint,7,opt,name=bytes_recv" json:"bytes_recv,omitempty"`
 }
 
-func (m *GetInfoResponse) Reset()                    { *m = GetInfoResponse{} }
-func (m *GetInfoResponse) String() string            { return proto.CompactTextString(m) }
-func (*GetInfoResponse) ProtoMessage()               {}
-func (*GetInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{43} }
+func (m *MessageTypeStats) Reset()                    { *m = MessageTypeStats{} }
+func (m *MessageTypeStats) String() string            { return proto.CompactTextString(m) }
+func (*MessageTypeStats) ProtoMessage()               {}
+func (*MessageTypeStats) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
 
-func (m *GetInfoResponse) GetIdentityPubkey() string {
+func (m *MessageTypeStats) GetType() string {
 	if m != nil {
-		return m.IdentityPubkey
+		return m.Type
 	}
 	return ""
 }
 
-func (m *GetInfoResponse) GetAlias() string {
+func (m *MessageTypeStats) GetTypeId() uint32 {
 	if m != nil {
-		return m.Alias
+		return m.TypeId
+	}
+	return 0
+}
+
+func (m *MessageTypeStats) GetPriorityClass() string {
+	if m != nil {
+		return m.PriorityClass
 	}
 	return ""
 }
 
-func (m *GetInfoResponse) GetNumPendingChannels() uint32 {
+func (m *MessageTypeStats) GetMsgsSent() uint64 {
 	if m != nil {
-		return m.NumPendingChannels
+		return m.MsgsSent
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetNumActiveChannels() uint32 {
+func (m *MessageTypeStats) GetBytesSent() uint64 {
 	if m != nil {
-		return m.NumActiveChannels
+		return m.BytesSent
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetNumPeers() uint32 {
+func (m *MessageTypeStats) GetMsgsRecv() uint64 {
 	if m != nil {
-		return m.NumPeers
+		return m.MsgsRecv
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetBlockHeight() uint32 {
+func (m *MessageTypeStats) GetBytesRecv() uint64 {
 	if m != nil {
-		return m.BlockHeight
+		return m.BytesRecv
 	}
 	return 0
 }
 
-func (m *GetInfoResponse) GetBlockHash() string {
+type ConnectionStats struct {
+	// / Bytes written to the connection, including encryption overhead
+	BytesSent uint64 `protobuf:"varint,1,opt,name=bytes_sent" json:"bytes_sent,omitempty"`
+	// / Bytes read from the connection, including encryption overhead
+	BytesRecv uint64 `protobuf:"varint,2,opt,name=bytes_recv" json:"bytes_recv,omitempty"`
+	// / Encrypted messages written to the connection
+	MsgsSent uint64 `protobuf:"varint,3,opt,name=msgs_sent" json:"msgs_sent,omitempty"`
+	// / Encrypted messages read from the connection
+	MsgsRecv uint64 `protobuf:"varint,4,opt,name=msgs_recv" json:"msgs_recv,omitempty"`
+	// / Number of times the key encrypting outgoing messages was rotated
+	SendKeyRotations uint64 `protobuf:"varint,5,opt,name=send_key_rotations" json:"send_key_rotations,omitempty"`
+	// / Number of times the key decrypting incoming messages was rotated
+	RecvKeyRotations uint64 `protobuf:"varint,6,opt,name=recv_key_rotations" json:"recv_key_rotations,omitempty"`
+	// / Incoming messages that failed to decrypt
+	DecryptFailures uint64 `protobuf:"varint,7,opt,name=decrypt_failures" json:"decrypt_failures,omitempty"`
+	// / Time it took to complete the handshake, in microseconds
+	HandshakeDurationUs int64 `protobuf:"varint,8,opt,name=handshake_duration_us" json:"handshake_duration_us,omitempty"`
+}
+
+func (m *ConnectionStats) Reset()                    { *m = ConnectionStats{} }
+func (m *ConnectionStats) String() string            { return proto.CompactTextString(m) }
+func (*ConnectionStats) ProtoMessage()               {}
+func (*ConnectionStats) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+
+func (m *ConnectionStats) GetBytesSent() uint64 {
 	if m != nil {
-		return m.BlockHash
+		return m.BytesSent
 	}
-	return ""
+	return 0
 }
 
-func (m *GetInfoResponse) GetSyncedToChain() bool {
+func (m *ConnectionStats) GetBytesRecv() uint64 {
 	if m != nil {
-		return m.SyncedToChain
+		return m.BytesRecv
 	}
-	return false
+	return 0
 }
 
-func (m *GetInfoResponse) GetTestnet() bool {
+func (m *ConnectionStats) GetMsgsSent() uint64 {
 	if m != nil {
-		return m.Testnet
+		return m.MsgsSent
 	}
-	return false
+	return 0
 }
 
-func (m *GetInfoResponse) GetChains() []string {
+func (m *ConnectionStats) GetMsgsRecv() uint64 {
+	if m != nil {
+		return m.MsgsRecv
+	}
+	return 0
+}
+
+func (m *ConnectionStats) GetSendKeyRotations() uint64 {
+	if m != nil {
+		return m.SendKeyRotations
+	}
+	return 0
+}
+
+func (m *ConnectionStats) GetRecvKeyRotations() uint64 {
+	if m != nil {
+		return m.RecvKeyRotations
+	}
+	return 0
+}
+
+func (m *ConnectionStats) GetDecryptFailures() uint64 {
+	if m != nil {
+		return m.DecryptFailures
+	}
+	return 0
+}
+
+func (m *ConnectionStats) GetHandshakeDurationUs() int64 {
+	if m != nil {
+		return m.HandshakeDurationUs
+	}
+	return 0
+}
+
+type ListPeersRequest struct {
+}
+
+func (m *ListPeersRequest) Reset()                    { *m = ListPeersRequest{} }
+func (m *ListPeersRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListPeersRequest) ProtoMessage()               {}
+func (*ListPeersRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+
+type ListPeersResponse struct {
+	// / The list of currently connected peers
+	Peers []*Peer `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
+}
+
+func (m *ListPeersResponse) Reset()                    { *m = ListPeersResponse{} }
+func (m *ListPeersResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListPeersResponse) ProtoMessage()               {}
+func (*ListPeersResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+
+func (m *ListPeersResponse) GetPeers() []*Peer {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+type ListPeerAddressesRequest struct {
+	// *
+	// If set, only the address book of the peer with this hex-encoded public key
+	// is returned.
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key,json=pubKey" json:"pub_key,omitempty"`
+}
+
+func (m *ListPeerAddressesRequest) Reset()                    { *m = ListPeerAddressesRequest{} }
+func (m *ListPeerAddressesRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListPeerAddressesRequest) ProtoMessage()               {}
+func (*ListPeerAddressesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+
+func (m *ListPeerAddressesRequest) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+type PeerAddress struct {
+	// / The network address of the peer
+	Address string `protobuf:"bytes,1,opt,name=address" json:"address,omitempty"`
+	// / Connection attempts to the address that succeeded
+	Successes uint32 `protobuf:"varint,2,opt,name=successes" json:"successes,omitempty"`
+	// / Connection attempts to the address that failed
+	Failures uint32 `protobuf:"varint,3,opt,name=failures" json:"failures,omitempty"`
+	// / Average time the successful connection attempts took, in microseconds
+	LatencyUs int64 `protobuf:"varint,4,opt,name=latency_us" json:"latency_us,omitempty"`
+	// / The unix timestamp of the last successful connection attempt, or 0
+	LastSeen int64 `protobuf:"varint,5,opt,name=last_seen" json:"last_seen,omitempty"`
+	// / The unix timestamp of the last connection attempt
+	LastAttempt int64 `protobuf:"varint,6,opt,name=last_attempt" json:"last_attempt,omitempty"`
+	// / The score used to pick the address to reconnect to the peer at
+	Score float64 `protobuf:"fixed64,7,opt,name=score" json:"score,omitempty"`
+}
+
+func (m *PeerAddress) Reset()                    { *m = PeerAddress{} }
+func (m *PeerAddress) String() string            { return proto.CompactTextString(m) }
+func (*PeerAddress) ProtoMessage()               {}
+func (*PeerAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+
+func (m *PeerAddress) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PeerAddress) GetSuccesses() uint32 {
+	if m != nil {
+		return m.Successes
+	}
+	return 0
+}
+
+func (m *PeerAddress) GetFailures() uint32 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *PeerAddress) GetLatencyUs() int64 {
+	if m != nil {
+		return m.LatencyUs
+	}
+	return 0
+}
+
+func (m *PeerAddress) GetLastSeen() int64 {
+	if m != nil {
+		return m.LastSeen
+	}
+	return 0
+}
+
+func (m *PeerAddress) GetLastAttempt() int64 {
+	if m != nil {
+		return m.LastAttempt
+	}
+	return 0
+}
+
+func (m *PeerAddress) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type PeerAddressBook struct {
+	// / The identity pubkey of the peer
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key" json:"pub_key,omitempty"`
+	// / The backoff used to reconnect to the peer, in milliseconds, or 0
+	BackoffMs int64 `protobuf:"varint,2,opt,name=backoff_ms" json:"backoff_ms,omitempty"`
+	// / The addresses we've attempted to connect to the peer at
+	Addresses []*PeerAddress `protobuf:"bytes,3,rep,name=addresses" json:"addresses,omitempty"`
+}
+
+func (m *PeerAddressBook) Reset()                    { *m = PeerAddressBook{} }
+func (m *PeerAddressBook) String() string            { return proto.CompactTextString(m) }
+func (*PeerAddressBook) ProtoMessage()               {}
+func (*PeerAddressBook) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+
+func (m *PeerAddressBook) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *PeerAddressBook) GetBackoffMs() int64 {
+	if m != nil {
+		return m.BackoffMs
+	}
+	return 0
+}
+
+func (m *PeerAddressBook) GetAddresses() []*PeerAddress {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type ListPeerAddressesResponse struct {
+	// / The address book of each peer
+	Peers []*PeerAddressBook `protobuf:"bytes,1,rep,name=peers" json:"peers,omitempty"`
+}
+
+func (m *ListPeerAddressesResponse) Reset()                    { *m = ListPeerAddressesResponse{} }
+func (m *ListPeerAddressesResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListPeerAddressesResponse) ProtoMessage()               {}
+func (*ListPeerAddressesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+
+func (m *ListPeerAddressesResponse) GetPeers() []*PeerAddressBook {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+type GetInfoRequest struct {
+}
+
+func (m *GetInfoRequest) Reset()                    { *m = GetInfoRequest{} }
+func (m *GetInfoRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetInfoRequest) ProtoMessage()               {}
+func (*GetInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+
+type GetInfoResponse struct {
+	// / The identity pubkey of the current node.
+	IdentityPubkey string `protobuf:"bytes,1,opt,name=identity_pubkey" json:"identity_pubkey,omitempty"`
+	// / If applicable, the alias of the current node, e.g. "bob"
+	Alias string `protobuf:"bytes,2,opt,name=alias" json:"alias,omitempty"`
+	// / Number of pending channels
+	NumPendingChannels uint32 `protobuf:"varint,3,opt,name=num_pending_channels" json:"num_pending_channels,omitempty"`
+	// / Number of active channels
+	NumActiveChannels uint32 `protobuf:"varint,4,opt,name=num_active_channels" json:"num_active_channels,omitempty"`
+	// / Number of peers
+	NumPeers uint32 `protobuf:"varint,5,opt,name=num_peers" json:"num_peers,omitempty"`
+	// / The node's current view of the height of the best block
+	BlockHeight uint32 `protobuf:"varint,6,opt,name=block_height" json:"block_height,omitempty"`
+	// / The node's current view of the hash of the best block
+	BlockHash string `protobuf:"bytes,8,opt,name=block_hash" json:"block_hash,omitempty"`
+	// / Whether the wallet's view is synced to the main chain
+	SyncedToChain bool `protobuf:"varint,9,opt,name=synced_to_chain" json:"synced_to_chain,omitempty"`
+	// / Whether the current node is connected to testnet
+	Testnet bool `protobuf:"varint,10,opt,name=testnet" json:"testnet,omitempty"`
+	// / A list of active chains the node is connected to
+	Chains []string `protobuf:"bytes,11,rep,name=chains" json:"chains,omitempty"`
+	// / The URIs of the current node.
+	Uris []string `protobuf:"bytes,12,rep,name=uris" json:"uris,omitempty"`
+	// / Timestamp of the block best known to the wallet
+	BestHeaderTimestamp int64 `protobuf:"varint,13,opt,name=best_header_timestamp" json:"best_header_timestamp,omitempty"`
+	// / The version of the LND software that the node is running.
+	Version string `protobuf:"bytes,14,opt,name=version" json:"version,omitempty"`
+}
+
+func (m *GetInfoResponse) Reset()                    { *m = GetInfoResponse{} }
+func (m *GetInfoResponse) String() string            { return proto.CompactTextString(m) }
+func (*GetInfoResponse) ProtoMessage()               {}
+func (*GetInfoResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+
+func (m *GetInfoResponse) GetIdentityPubkey() string {
+	if m != nil {
+		return m.IdentityPubkey
+	}
+	return ""
+}
+
+func (m *GetInfoResponse) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+func (m *GetInfoResponse) GetNumPendingChannels() uint32 {
+	if m != nil {
+		return m.NumPendingChannels
+	}
+	return 0
+}
+
+func (m *GetInfoResponse) GetNumActiveChannels() uint32 {
+	if m != nil {
+		return m.NumActiveChannels
+	}
+	return 0
+}
+
+func (m *GetInfoResponse) GetNumPeers() uint32 {
+	if m != nil {
+		return m.NumPeers
+	}
+	return 0
+}
+
+func (m *GetInfoResponse) GetBlockHeight() uint32 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *GetInfoResponse) GetBlockHash() string {
+	if m != nil {
+		return m.BlockHash
+	}
+	return ""
+}
+
+func (m *GetInfoResponse) GetSyncedToChain() bool {
+	if m != nil {
+		return m.SyncedToChain
+	}
+	return false
+}
+
+func (m *GetInfoResponse) GetTestnet() bool {
+	if m != nil {
+		return m.Testnet
+	}
+	return false
+}
+
+func (m *GetInfoResponse) GetChains() []string {
 	if m != nil {
 		return m.Chains
 	}
@@ -1922,7 +2968,7 @@ type ConfirmationUpdate struct {
 func (m *ConfirmationUpdate) Reset()                    { *m = ConfirmationUpdate{} }
 func (m *ConfirmationUpdate) String() string            { return proto.CompactTextString(m) }
 func (*ConfirmationUpdate) ProtoMessage()               {}
-func (*ConfirmationUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{44} }
+func (*ConfirmationUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
 
 func (m *ConfirmationUpdate) GetBlockSha() []byte {
 	if m != nil {
@@ -1952,7 +2998,7 @@ type ChannelOpenUpdate struct {
 func (m *ChannelOpenUpdate) Reset()                    { *m = ChannelOpenUpdate{} }
 func (m *ChannelOpenUpdate) String() string            { return proto.CompactTextString(m) }
 func (*ChannelOpenUpdate) ProtoMessage()               {}
-func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{45} }
+func (*ChannelOpenUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
 
 func (m *ChannelOpenUpdate) GetChannelPoint() *ChannelPoint {
 	if m != nil {
@@ -1969,7 +3015,7 @@ type ChannelCloseUpdate struct {
 func (m *ChannelCloseUpdate) Reset()                    { *m = ChannelCloseUpdate{} }
 func (m *ChannelCloseUpdate) String() string            { return proto.CompactTextString(m) }
 func (*ChannelCloseUpdate) ProtoMessage()               {}
-func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{46} }
+func (*ChannelCloseUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
 
 func (m *ChannelCloseUpdate) GetClosingTxid() []byte {
 	if m != nil {
@@ -2002,7 +3048,7 @@ type CloseChannelRequest struct {
 func (m *CloseChannelRequest) Reset()                    { *m = CloseChannelRequest{} }
 func (m *CloseChannelRequest) String() string            { return proto.CompactTextString(m) }
 func (*CloseChannelRequest) ProtoMessage()               {}
-func (*CloseChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{47} }
+func (*CloseChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
 
 func (m *CloseChannelRequest) GetChannelPoint() *ChannelPoint {
 	if m != nil {
@@ -2043,7 +3089,7 @@ type CloseStatusUpdate struct {
 func (m *CloseStatusUpdate) Reset()                    { *m = CloseStatusUpdate{} }
 func (m *CloseStatusUpdate) String() string            { return proto.CompactTextString(m) }
 func (*CloseStatusUpdate) ProtoMessage()               {}
-func (*CloseStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{48} }
+func (*CloseStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
 
 type isCloseStatusUpdate_Update interface{ isCloseStatusUpdate_Update() }
 
@@ -2190,7 +3236,7 @@ type PendingUpdate struct {
 func (m *PendingUpdate) Reset()                    { *m = PendingUpdate{} }
 func (m *PendingUpdate) String() string            { return proto.CompactTextString(m) }
 func (*PendingUpdate) ProtoMessage()               {}
-func (*PendingUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{49} }
+func (*PendingUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
 
 func (m *PendingUpdate) GetTxid() []byte {
 	if m != nil {
@@ -2227,12 +3273,18 @@ type OpenChannelRequest struct {
 	RemoteCsvDelay uint32 `protobuf:"varint,10,opt,name=remote_csv_delay" json:"remote_csv_delay,omitempty"`
 	// / The minimum number of confirmations each one of your outputs used for the funding transaction must satisfy.
 	MinConfs int32 `protobuf:"varint,11,opt,name=min_confs" json:"min_confs,omitempty"`
+	// / The outputs of the wallet the funding transaction should be funded from. If empty, any output of the wallet may be spent.
+	Outpoints []*OutPoint `protobuf:"bytes,12,rep,name=outpoints" json:"outpoints,omitempty"`
+	// / The strategy used to select the outputs the funding transaction is funded from.
+	CoinSelectionStrategy CoinSelectionStrategy `protobuf:"varint,13,opt,name=coin_selection_strategy,enum=lnrpc.CoinSelectionStrategy" json:"coin_selection_strategy,omitempty"`
+	// / The 32 byte ID under which any of the passed outpoints are leased. Leased outputs are only spent if they're passed explicitly, and leased under this ID.
+	LockId []byte `protobuf:"bytes,14,opt,name=lock_id,proto3" json:"lock_id,omitempty"`
 }
 
 func (m *OpenChannelRequest) Reset()                    { *m = OpenChannelRequest{} }
 func (m *OpenChannelRequest) String() string            { return proto.CompactTextString(m) }
 func (*OpenChannelRequest) ProtoMessage()               {}
-func (*OpenChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{50} }
+func (*OpenChannelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
 
 func (m *OpenChannelRequest) GetNodePubkey() []byte {
 	if m != nil {
@@ -2304,6 +3356,27 @@ func (m *OpenChannelRequest) GetMinConfs() int32 {
 	return 0
 }
 
+func (m *OpenChannelRequest) GetOutpoints() []*OutPoint {
+	if m != nil {
+		return m.Outpoints
+	}
+	return nil
+}
+
+func (m *OpenChannelRequest) GetCoinSelectionStrategy() CoinSelectionStrategy {
+	if m != nil {
+		return m.CoinSelectionStrategy
+	}
+	return CoinSelectionStrategy_DEFAULT
+}
+
+func (m *OpenChannelRequest) GetLockId() []byte {
+	if m != nil {
+		return m.LockId
+	}
+	return nil
+}
+
 type OpenStatusUpdate struct {
 	// Types that are valid to be assigned to Update:
 	//	*OpenStatusUpdate_ChanPending
@@ -2315,7 +3388,7 @@ type OpenStatusUpdate struct {
 func (m *OpenStatusUpdate) Reset()                    { *m = OpenStatusUpdate{} }
 func (m *OpenStatusUpdate) String() string            { return proto.CompactTextString(m) }
 func (*OpenStatusUpdate) ProtoMessage()               {}
-func (*OpenStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{51} }
+func (*OpenStatusUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
 
 type isOpenStatusUpdate_Update interface{ isOpenStatusUpdate_Update() }
 
@@ -2475,7 +3548,7 @@ type PendingHTLC struct {
 func (m *PendingHTLC) Reset()                    { *m = PendingHTLC{} }
 func (m *PendingHTLC) String() string            { return proto.CompactTextString(m) }
 func (*PendingHTLC) ProtoMessage()               {}
-func (*PendingHTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{52} }
+func (*PendingHTLC) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
 
 func (m *PendingHTLC) GetIncoming() bool {
 	if m != nil {
@@ -2525,7 +3598,7 @@ type PendingChannelsRequest struct {
 func (m *PendingChannelsRequest) Reset()                    { *m = PendingChannelsRequest{} }
 func (m *PendingChannelsRequest) String() string            { return proto.CompactTextString(m) }
 func (*PendingChannelsRequest) ProtoMessage()               {}
-func (*PendingChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{53} }
+func (*PendingChannelsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
 
 type PendingChannelsResponse struct {
 	// / The balance in satoshis encumbered in pending channels
@@ -2543,7 +3616,7 @@ type PendingChannelsResponse struct {
 func (m *PendingChannelsResponse) Reset()                    { *m = PendingChannelsResponse{} }
 func (m *PendingChannelsResponse) String() string            { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse) ProtoMessage()               {}
-func (*PendingChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{54} }
+func (*PendingChannelsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
 
 func (m *PendingChannelsResponse) GetTotalLimboBalance() int64 {
 	if m != nil {
@@ -2594,7 +3667,7 @@ func (m *PendingChannelsResponse_PendingChannel) Reset() {
 func (m *PendingChannelsResponse_PendingChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_PendingChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_PendingChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{54, 0}
+	return fileDescriptor0, []int{75, 0}
 }
 
 func (m *PendingChannelsResponse_PendingChannel) GetRemoteNodePub() string {
@@ -2661,7 +3734,7 @@ func (m *PendingChannelsResponse_PendingOpenChannel) String() string {
 }
 func (*PendingChannelsResponse_PendingOpenChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_PendingOpenChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{54, 1}
+	return fileDescriptor0, []int{75, 1}
 }
 
 func (m *PendingChannelsResponse_PendingOpenChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
@@ -2714,7 +3787,7 @@ func (m *PendingChannelsResponse_WaitingCloseChannel) String() string {
 }
 func (*PendingChannelsResponse_WaitingCloseChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_WaitingCloseChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{54, 2}
+	return fileDescriptor0, []int{75, 2}
 }
 
 func (m *PendingChannelsResponse_WaitingCloseChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
@@ -2742,7 +3815,7 @@ func (m *PendingChannelsResponse_ClosedChannel) Reset()         { *m = PendingCh
 func (m *PendingChannelsResponse_ClosedChannel) String() string { return proto.CompactTextString(m) }
 func (*PendingChannelsResponse_ClosedChannel) ProtoMessage()    {}
 func (*PendingChannelsResponse_ClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{54, 3}
+	return fileDescriptor0, []int{75, 3}
 }
 
 func (m *PendingChannelsResponse_ClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
@@ -2786,7 +3859,7 @@ func (m *PendingChannelsResponse_ForceClosedChannel) String() string {
 }
 func (*PendingChannelsResponse_ForceClosedChannel) ProtoMessage() {}
 func (*PendingChannelsResponse_ForceClosedChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor0, []int{54, 4}
+	return fileDescriptor0, []int{75, 4}
 }
 
 func (m *PendingChannelsResponse_ForceClosedChannel) GetChannel() *PendingChannelsResponse_PendingChannel {
@@ -2844,7 +3917,7 @@ type WalletBalanceRequest struct {
 func (m *WalletBalanceRequest) Reset()                    { *m = WalletBalanceRequest{} }
 func (m *WalletBalanceRequest) String() string            { return proto.CompactTextString(m) }
 func (*WalletBalanceRequest) ProtoMessage()               {}
-func (*WalletBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{55} }
+func (*WalletBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
 
 type WalletBalanceResponse struct {
 	// / The balance of the wallet
@@ -2858,7 +3931,7 @@ type WalletBalanceResponse struct {
 func (m *WalletBalanceResponse) Reset()                    { *m = WalletBalanceResponse{} }
 func (m *WalletBalanceResponse) String() string            { return proto.CompactTextString(m) }
 func (*WalletBalanceResponse) ProtoMessage()               {}
-func (*WalletBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{56} }
+func (*WalletBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
 
 func (m *WalletBalanceResponse) GetTotalBalance() int64 {
 	if m != nil {
@@ -2887,7 +3960,7 @@ type ChannelBalanceRequest struct {
 func (m *ChannelBalanceRequest) Reset()                    { *m = ChannelBalanceRequest{} }
 func (m *ChannelBalanceRequest) String() string            { return proto.CompactTextString(m) }
 func (*ChannelBalanceRequest) ProtoMessage()               {}
-func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{57} }
+func (*ChannelBalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
 
 type ChannelBalanceResponse struct {
 	// / Sum of channels balances denominated in satoshis
@@ -2899,7 +3972,7 @@ type ChannelBalanceResponse struct {
 func (m *ChannelBalanceResponse) Reset()                    { *m = ChannelBalanceResponse{} }
 func (m *ChannelBalanceResponse) String() string            { return proto.CompactTextString(m) }
 func (*ChannelBalanceResponse) ProtoMessage()               {}
-func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{58} }
+func (*ChannelBalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
 
 func (m *ChannelBalanceResponse) GetBalance() int64 {
 	if m != nil {
@@ -2935,7 +4008,7 @@ type QueryRoutesRequest struct {
 func (m *QueryRoutesRequest) Reset()                    { *m = QueryRoutesRequest{} }
 func (m *QueryRoutesRequest) String() string            { return proto.CompactTextString(m) }
 func (*QueryRoutesRequest) ProtoMessage()               {}
-func (*QueryRoutesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{59} }
+func (*QueryRoutesRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
 
 func (m *QueryRoutesRequest) GetPubKey() string {
 	if m != nil {
@@ -2979,7 +4052,7 @@ type QueryRoutesResponse struct {
 func (m *QueryRoutesResponse) Reset()                    { *m = QueryRoutesResponse{} }
 func (m *QueryRoutesResponse) String() string            { return proto.CompactTextString(m) }
 func (*QueryRoutesResponse) ProtoMessage()               {}
-func (*QueryRoutesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{60} }
+func (*QueryRoutesResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
 
 func (m *QueryRoutesResponse) GetRoutes() []*Route {
 	if m != nil {
@@ -3000,12 +4073,14 @@ type Hop struct {
 	Expiry           uint32 `protobuf:"varint,5,opt,name=expiry" json:"expiry,omitempty"`
 	AmtToForwardMsat int64  `protobuf:"varint,6,opt,name=amt_to_forward_msat" json:"amt_to_forward_msat,omitempty"`
 	FeeMsat          int64  `protobuf:"varint,7,opt,name=fee_msat" json:"fee_msat,omitempty"`
+	// / The public key of the node this hop leads to.
+	PubKey string `protobuf:"bytes,8,opt,name=pub_key" json:"pub_key,omitempty"`
 }
 
 func (m *Hop) Reset()                    { *m = Hop{} }
 func (m *Hop) String() string            { return proto.CompactTextString(m) }
 func (*Hop) ProtoMessage()               {}
-func (*Hop) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{61} }
+func (*Hop) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
 
 func (m *Hop) GetChanId() uint64 {
 	if m != nil {
@@ -3056,6 +4131,13 @@ func (m *Hop) GetFeeMsat() int64 {
 	return 0
 }
 
+func (m *Hop) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
 // *
 // A path through the channel graph which runs over one or more channels in
 // succession. This struct carries all the information required to craft the
@@ -3095,7 +4177,7 @@ type Route struct {
 func (m *Route) Reset()                    { *m = Route{} }
 func (m *Route) String() string            { return proto.CompactTextString(m) }
 func (*Route) ProtoMessage()               {}
-func (*Route) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{62} }
+func (*Route) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
 
 func (m *Route) GetTotalTimeLock() uint32 {
 	if m != nil {
@@ -3147,7 +4229,7 @@ type NodeInfoRequest struct {
 func (m *NodeInfoRequest) Reset()                    { *m = NodeInfoRequest{} }
 func (m *NodeInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*NodeInfoRequest) ProtoMessage()               {}
-func (*NodeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{63} }
+func (*NodeInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
 
 func (m *NodeInfoRequest) GetPubKey() string {
 	if m != nil {
@@ -3170,7 +4252,7 @@ type NodeInfo struct {
 func (m *NodeInfo) Reset()                    { *m = NodeInfo{} }
 func (m *NodeInfo) String() string            { return proto.CompactTextString(m) }
 func (*NodeInfo) ProtoMessage()               {}
-func (*NodeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{64} }
+func (*NodeInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
 
 func (m *NodeInfo) GetNode() *LightningNode {
 	if m != nil {
@@ -3209,7 +4291,7 @@ type LightningNode struct {
 func (m *LightningNode) Reset()                    { *m = LightningNode{} }
 func (m *LightningNode) String() string            { return proto.CompactTextString(m) }
 func (*LightningNode) ProtoMessage()               {}
-func (*LightningNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{65} }
+func (*LightningNode) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
 
 func (m *LightningNode) GetLastUpdate() uint32 {
 	if m != nil {
@@ -3254,7 +4336,7 @@ type NodeAddress struct {
 func (m *NodeAddress) Reset()                    { *m = NodeAddress{} }
 func (m *NodeAddress) String() string            { return proto.CompactTextString(m) }
 func (*NodeAddress) ProtoMessage()               {}
-func (*NodeAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{66} }
+func (*NodeAddress) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
 
 func (m *NodeAddress) GetNetwork() string {
 	if m != nil {
@@ -3281,7 +4363,7 @@ type RoutingPolicy struct {
 func (m *RoutingPolicy) Reset()                    { *m = RoutingPolicy{} }
 func (m *RoutingPolicy) String() string            { return proto.CompactTextString(m) }
 func (*RoutingPolicy) ProtoMessage()               {}
-func (*RoutingPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{67} }
+func (*RoutingPolicy) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
 
 func (m *RoutingPolicy) GetTimeLockDelta() uint32 {
 	if m != nil {
@@ -3342,7 +4424,7 @@ type ChannelEdge struct {
 func (m *ChannelEdge) Reset()                    { *m = ChannelEdge{} }
 func (m *ChannelEdge) String() string            { return proto.CompactTextString(m) }
 func (*ChannelEdge) ProtoMessage()               {}
-func (*ChannelEdge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{68} }
+func (*ChannelEdge) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
 
 func (m *ChannelEdge) GetChannelId() uint64 {
 	if m != nil {
@@ -3406,7 +4488,7 @@ type ChannelGraphRequest struct {
 func (m *ChannelGraphRequest) Reset()                    { *m = ChannelGraphRequest{} }
 func (m *ChannelGraphRequest) String() string            { return proto.CompactTextString(m) }
 func (*ChannelGraphRequest) ProtoMessage()               {}
-func (*ChannelGraphRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{69} }
+func (*ChannelGraphRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
 
 // / Returns a new instance of the directed channel graph.
 type ChannelGraph struct {
@@ -3419,7 +4501,7 @@ type ChannelGraph struct {
 func (m *ChannelGraph) Reset()                    { *m = ChannelGraph{} }
 func (m *ChannelGraph) String() string            { return proto.CompactTextString(m) }
 func (*ChannelGraph) ProtoMessage()               {}
-func (*ChannelGraph) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{70} }
+func (*ChannelGraph) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
 
 func (m *ChannelGraph) GetNodes() []*LightningNode {
 	if m != nil {
@@ -3446,7 +4528,7 @@ type ChanInfoRequest struct {
 func (m *ChanInfoRequest) Reset()                    { *m = ChanInfoRequest{} }
 func (m *ChanInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*ChanInfoRequest) ProtoMessage()               {}
-func (*ChanInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{71} }
+func (*ChanInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
 
 func (m *ChanInfoRequest) GetChanId() uint64 {
 	if m != nil {
@@ -3461,7 +4543,7 @@ type NetworkInfoRequest struct {
 func (m *NetworkInfoRequest) Reset()                    { *m = NetworkInfoRequest{} }
 func (m *NetworkInfoRequest) String() string            { return proto.CompactTextString(m) }
 func (*NetworkInfoRequest) ProtoMessage()               {}
-func (*NetworkInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{72} }
+func (*NetworkInfoRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
 
 type NetworkInfo struct {
 	GraphDiameter        uint32  `protobuf:"varint,1,opt,name=graph_diameter" json:"graph_diameter,omitempty"`
@@ -3478,7 +4560,7 @@ type NetworkInfo struct {
 func (m *NetworkInfo) Reset()                    { *m = NetworkInfo{} }
 func (m *NetworkInfo) String() string            { return proto.CompactTextString(m) }
 func (*NetworkInfo) ProtoMessage()               {}
-func (*NetworkInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{73} }
+func (*NetworkInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
 
 func (m *NetworkInfo) GetGraphDiameter() uint32 {
 	if m != nil {
@@ -3549,7 +4631,7 @@ type StopRequest struct {
 func (m *StopRequest) Reset()                    { *m = StopRequest{} }
 func (m *StopRequest) String() string            { return proto.CompactTextString(m) }
 func (*StopRequest) ProtoMessage()               {}
-func (*StopRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{74} }
+func (*StopRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
 
 type StopResponse struct {
 }
@@ -3557,7 +4639,7 @@ type StopResponse struct {
 func (m *StopResponse) Reset()                    { *m = StopResponse{} }
 func (m *StopResponse) String() string            { return proto.CompactTextString(m) }
 func (*StopResponse) ProtoMessage()               {}
-func (*StopResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{75} }
+func (*StopResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
 
 type GraphTopologySubscription struct {
 }
@@ -3565,7 +4647,7 @@ type GraphTopologySubscription struct {
 func (m *GraphTopologySubscription) Reset()                    { *m = GraphTopologySubscription{} }
 func (m *GraphTopologySubscription) String() string            { return proto.CompactTextString(m) }
 func (*GraphTopologySubscription) ProtoMessage()               {}
-func (*GraphTopologySubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{76} }
+func (*GraphTopologySubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
 
 type GraphTopologyUpdate struct {
 	NodeUpdates    []*NodeUpdate          `protobuf:"bytes,1,rep,name=node_updates,json=nodeUpdates" json:"node_updates,omitempty"`
@@ -3576,7 +4658,7 @@ type GraphTopologyUpdate struct {
 func (m *GraphTopologyUpdate) Reset()                    { *m = GraphTopologyUpdate{} }
 func (m *GraphTopologyUpdate) String() string            { return proto.CompactTextString(m) }
 func (*GraphTopologyUpdate) ProtoMessage()               {}
-func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{77} }
+func (*GraphTopologyUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
 
 func (m *GraphTopologyUpdate) GetNodeUpdates() []*NodeUpdate {
 	if m != nil {
@@ -3609,7 +4691,7 @@ type NodeUpdate struct {
 func (m *NodeUpdate) Reset()                    { *m = NodeUpdate{} }
 func (m *NodeUpdate) String() string            { return proto.CompactTextString(m) }
 func (*NodeUpdate) ProtoMessage()               {}
-func (*NodeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{78} }
+func (*NodeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
 
 func (m *NodeUpdate) GetAddresses() []string {
 	if m != nil {
@@ -3655,7 +4737,7 @@ type ChannelEdgeUpdate struct {
 func (m *ChannelEdgeUpdate) Reset()                    { *m = ChannelEdgeUpdate{} }
 func (m *ChannelEdgeUpdate) String() string            { return proto.CompactTextString(m) }
 func (*ChannelEdgeUpdate) ProtoMessage()               {}
-func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{79} }
+func (*ChannelEdgeUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
 
 func (m *ChannelEdgeUpdate) GetChanId() uint64 {
 	if m != nil {
@@ -3713,7 +4795,7 @@ type ClosedChannelUpdate struct {
 func (m *ClosedChannelUpdate) Reset()                    { *m = ClosedChannelUpdate{} }
 func (m *ClosedChannelUpdate) String() string            { return proto.CompactTextString(m) }
 func (*ClosedChannelUpdate) ProtoMessage()               {}
-func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{80} }
+func (*ClosedChannelUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
 
 func (m *ClosedChannelUpdate) GetChanId() uint64 {
 	if m != nil {
@@ -3761,7 +4843,7 @@ type HopHint struct {
 func (m *HopHint) Reset()                    { *m = HopHint{} }
 func (m *HopHint) String() string            { return proto.CompactTextString(m) }
 func (*HopHint) ProtoMessage()               {}
-func (*HopHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{81} }
+func (*HopHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
 
 func (m *HopHint) GetNodeId() string {
 	if m != nil {
@@ -3808,7 +4890,7 @@ type RouteHint struct {
 func (m *RouteHint) Reset()                    { *m = RouteHint{} }
 func (m *RouteHint) String() string            { return proto.CompactTextString(m) }
 func (*RouteHint) ProtoMessage()               {}
-func (*RouteHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{82} }
+func (*RouteHint) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
 
 func (m *RouteHint) GetHopHints() []*HopHint {
 	if m != nil {
@@ -3892,12 +4974,27 @@ type Invoice struct {
 	// paid MORE that was specified in the original invoice. So we'll record that
 	// here as well.
 	AmtPaidMsat int64 `protobuf:"varint,20,opt,name=amt_paid_msat" json:"amt_paid_msat,omitempty"`
+	// *
+	// Whether payers must include the payment secret of the invoice within their
+	// payment. Invoices created by this node always carry a payment secret, which
+	// is otherwise only checked if the payer included it.
+	RequirePaymentSecret bool `protobuf:"varint,21,opt,name=require_payment_secret" json:"require_payment_secret,omitempty"`
+	// *
+	// The Spider routing algorithm the payer should route the payment with, as
+	// defined in the beginning of routing/router.go. Zero means no preference.
+	// This is encoded within an experimental field of the payment request.
+	SpiderAlgo int32 `protobuf:"varint,22,opt,name=spider_algo" json:"spider_algo,omitempty"`
+	// *
+	// The unix timestamp after which the payer should stop attempting to pay the
+	// invoice. Zero means no deadline. This is encoded within an experimental
+	// field of the payment request.
+	SpiderDeadline int64 `protobuf:"varint,23,opt,name=spider_deadline" json:"spider_deadline,omitempty"`
 }
 
 func (m *Invoice) Reset()                    { *m = Invoice{} }
 func (m *Invoice) String() string            { return proto.CompactTextString(m) }
 func (*Invoice) ProtoMessage()               {}
-func (*Invoice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{83} }
+func (*Invoice) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
 
 func (m *Invoice) GetMemo() string {
 	if m != nil {
@@ -4039,7 +5136,28 @@ func (m *Invoice) GetAmtPaidMsat() int64 {
 	return 0
 }
 
-type AddInvoiceResponse struct {
+func (m *Invoice) GetRequirePaymentSecret() bool {
+	if m != nil {
+		return m.RequirePaymentSecret
+	}
+	return false
+}
+
+func (m *Invoice) GetSpiderAlgo() int32 {
+	if m != nil {
+		return m.SpiderAlgo
+	}
+	return 0
+}
+
+func (m *Invoice) GetSpiderDeadline() int64 {
+	if m != nil {
+		return m.SpiderDeadline
+	}
+	return 0
+}
+
+type AddInvoiceResponse struct {
 	RHash []byte `protobuf:"bytes,1,opt,name=r_hash,proto3" json:"r_hash,omitempty"`
 	// *
 	// A bare-bones invoice for a payment within the Lightning Network.  With the
@@ -4057,7 +5175,7 @@ type AddInvoiceResponse struct {
 func (m *AddInvoiceResponse) Reset()                    { *m = AddInvoiceResponse{} }
 func (m *AddInvoiceResponse) String() string            { return proto.CompactTextString(m) }
 func (*AddInvoiceResponse) ProtoMessage()               {}
-func (*AddInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{84} }
+func (*AddInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
 
 func (m *AddInvoiceResponse) GetRHash() []byte {
 	if m != nil {
@@ -4092,7 +5210,7 @@ type PaymentHash struct {
 func (m *PaymentHash) Reset()                    { *m = PaymentHash{} }
 func (m *PaymentHash) String() string            { return proto.CompactTextString(m) }
 func (*PaymentHash) ProtoMessage()               {}
-func (*PaymentHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{85} }
+func (*PaymentHash) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{106} }
 
 func (m *PaymentHash) GetRHashStr() string {
 	if m != nil {
@@ -4126,7 +5244,7 @@ type ListInvoiceRequest struct {
 func (m *ListInvoiceRequest) Reset()                    { *m = ListInvoiceRequest{} }
 func (m *ListInvoiceRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListInvoiceRequest) ProtoMessage()               {}
-func (*ListInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{86} }
+func (*ListInvoiceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{107} }
 
 func (m *ListInvoiceRequest) GetPendingOnly() bool {
 	if m != nil {
@@ -4174,7 +5292,7 @@ type ListInvoiceResponse struct {
 func (m *ListInvoiceResponse) Reset()                    { *m = ListInvoiceResponse{} }
 func (m *ListInvoiceResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListInvoiceResponse) ProtoMessage()               {}
-func (*ListInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{87} }
+func (*ListInvoiceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{108} }
 
 func (m *ListInvoiceResponse) GetInvoices() []*Invoice {
 	if m != nil {
@@ -4215,7 +5333,7 @@ type InvoiceSubscription struct {
 func (m *InvoiceSubscription) Reset()                    { *m = InvoiceSubscription{} }
 func (m *InvoiceSubscription) String() string            { return proto.CompactTextString(m) }
 func (*InvoiceSubscription) ProtoMessage()               {}
-func (*InvoiceSubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{88} }
+func (*InvoiceSubscription) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{109} }
 
 func (m *InvoiceSubscription) GetAddIndex() uint64 {
 	if m != nil {
@@ -4249,7 +5367,7 @@ type Payment struct {
 func (m *Payment) Reset()                    { *m = Payment{} }
 func (m *Payment) String() string            { return proto.CompactTextString(m) }
 func (*Payment) ProtoMessage()               {}
-func (*Payment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{89} }
+func (*Payment) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{110} }
 
 func (m *Payment) GetPaymentHash() string {
 	if m != nil {
@@ -4299,7 +5417,7 @@ type ListPaymentsRequest struct {
 func (m *ListPaymentsRequest) Reset()                    { *m = ListPaymentsRequest{} }
 func (m *ListPaymentsRequest) String() string            { return proto.CompactTextString(m) }
 func (*ListPaymentsRequest) ProtoMessage()               {}
-func (*ListPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{90} }
+func (*ListPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{111} }
 
 type ListPaymentsResponse struct {
 	// / The list of payments
@@ -4309,7 +5427,7 @@ type ListPaymentsResponse struct {
 func (m *ListPaymentsResponse) Reset()                    { *m = ListPaymentsResponse{} }
 func (m *ListPaymentsResponse) String() string            { return proto.CompactTextString(m) }
 func (*ListPaymentsResponse) ProtoMessage()               {}
-func (*ListPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{91} }
+func (*ListPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{112} }
 
 func (m *ListPaymentsResponse) GetPayments() []*Payment {
 	if m != nil {
@@ -4324,7 +5442,7 @@ type DeleteAllPaymentsRequest struct {
 func (m *DeleteAllPaymentsRequest) Reset()                    { *m = DeleteAllPaymentsRequest{} }
 func (m *DeleteAllPaymentsRequest) String() string            { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsRequest) ProtoMessage()               {}
-func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{92} }
+func (*DeleteAllPaymentsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{113} }
 
 type DeleteAllPaymentsResponse struct {
 }
@@ -4332,7 +5450,7 @@ type DeleteAllPaymentsResponse struct {
 func (m *DeleteAllPaymentsResponse) Reset()                    { *m = DeleteAllPaymentsResponse{} }
 func (m *DeleteAllPaymentsResponse) String() string            { return proto.CompactTextString(m) }
 func (*DeleteAllPaymentsResponse) ProtoMessage()               {}
-func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{93} }
+func (*DeleteAllPaymentsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{114} }
 
 type DebugLevelRequest struct {
 	Show      bool   `protobuf:"varint,1,opt,name=show" json:"show,omitempty"`
@@ -4342,7 +5460,7 @@ type DebugLevelRequest struct {
 func (m *DebugLevelRequest) Reset()                    { *m = DebugLevelRequest{} }
 func (m *DebugLevelRequest) String() string            { return proto.CompactTextString(m) }
 func (*DebugLevelRequest) ProtoMessage()               {}
-func (*DebugLevelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{94} }
+func (*DebugLevelRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{115} }
 
 func (m *DebugLevelRequest) GetShow() bool {
 	if m != nil {
@@ -4365,7 +5483,7 @@ type DebugLevelResponse struct {
 func (m *DebugLevelResponse) Reset()                    { *m = DebugLevelResponse{} }
 func (m *DebugLevelResponse) String() string            { return proto.CompactTextString(m) }
 func (*DebugLevelResponse) ProtoMessage()               {}
-func (*DebugLevelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{95} }
+func (*DebugLevelResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{116} }
 
 func (m *DebugLevelResponse) GetSubSystems() string {
 	if m != nil {
@@ -4382,7 +5500,7 @@ type PayReqString struct {
 func (m *PayReqString) Reset()                    { *m = PayReqString{} }
 func (m *PayReqString) String() string            { return proto.CompactTextString(m) }
 func (*PayReqString) ProtoMessage()               {}
-func (*PayReqString) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{96} }
+func (*PayReqString) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{117} }
 
 func (m *PayReqString) GetPayReq() string {
 	if m != nil {
@@ -4402,12 +5520,20 @@ type PayReq struct {
 	FallbackAddr    string       `protobuf:"bytes,8,opt,name=fallback_addr" json:"fallback_addr,omitempty"`
 	CltvExpiry      int64        `protobuf:"varint,9,opt,name=cltv_expiry" json:"cltv_expiry,omitempty"`
 	RouteHints      []*RouteHint `protobuf:"bytes,10,rep,name=route_hints" json:"route_hints,omitempty"`
+	// / The feature bits set within the payment request.
+	Features []uint32 `protobuf:"varint,11,rep,packed,name=features" json:"features,omitempty"`
+	// / The hex-encoded payment secret of the payment request, if any.
+	PaymentSecret string `protobuf:"bytes,12,opt,name=payment_secret" json:"payment_secret,omitempty"`
+	// / The Spider routing algorithm preferred by the payee, if any.
+	SpiderAlgo int32 `protobuf:"varint,13,opt,name=spider_algo" json:"spider_algo,omitempty"`
+	// / The unix timestamp after which the payee no longer expects payment.
+	SpiderDeadline int64 `protobuf:"varint,14,opt,name=spider_deadline" json:"spider_deadline,omitempty"`
 }
 
 func (m *PayReq) Reset()                    { *m = PayReq{} }
 func (m *PayReq) String() string            { return proto.CompactTextString(m) }
 func (*PayReq) ProtoMessage()               {}
-func (*PayReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{97} }
+func (*PayReq) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{118} }
 
 func (m *PayReq) GetDestination() string {
 	if m != nil {
@@ -4479,13 +5605,41 @@ func (m *PayReq) GetRouteHints() []*RouteHint {
 	return nil
 }
 
+func (m *PayReq) GetFeatures() []uint32 {
+	if m != nil {
+		return m.Features
+	}
+	return nil
+}
+
+func (m *PayReq) GetPaymentSecret() string {
+	if m != nil {
+		return m.PaymentSecret
+	}
+	return ""
+}
+
+func (m *PayReq) GetSpiderAlgo() int32 {
+	if m != nil {
+		return m.SpiderAlgo
+	}
+	return 0
+}
+
+func (m *PayReq) GetSpiderDeadline() int64 {
+	if m != nil {
+		return m.SpiderDeadline
+	}
+	return 0
+}
+
 type FeeReportRequest struct {
 }
 
 func (m *FeeReportRequest) Reset()                    { *m = FeeReportRequest{} }
 func (m *FeeReportRequest) String() string            { return proto.CompactTextString(m) }
 func (*FeeReportRequest) ProtoMessage()               {}
-func (*FeeReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{98} }
+func (*FeeReportRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{119} }
 
 type ChannelFeeReport struct {
 	// / The channel that this fee report belongs to.
@@ -4501,7 +5655,7 @@ type ChannelFeeReport struct {
 func (m *ChannelFeeReport) Reset()                    { *m = ChannelFeeReport{} }
 func (m *ChannelFeeReport) String() string            { return proto.CompactTextString(m) }
 func (*ChannelFeeReport) ProtoMessage()               {}
-func (*ChannelFeeReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{99} }
+func (*ChannelFeeReport) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{120} }
 
 func (m *ChannelFeeReport) GetChanPoint() string {
 	if m != nil {
@@ -4545,7 +5699,7 @@ type FeeReportResponse struct {
 func (m *FeeReportResponse) Reset()                    { *m = FeeReportResponse{} }
 func (m *FeeReportResponse) String() string            { return proto.CompactTextString(m) }
 func (*FeeReportResponse) ProtoMessage()               {}
-func (*FeeReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{100} }
+func (*FeeReportResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{121} }
 
 func (m *FeeReportResponse) GetChannelFees() []*ChannelFeeReport {
 	if m != nil {
@@ -4591,7 +5745,7 @@ type PolicyUpdateRequest struct {
 func (m *PolicyUpdateRequest) Reset()                    { *m = PolicyUpdateRequest{} }
 func (m *PolicyUpdateRequest) String() string            { return proto.CompactTextString(m) }
 func (*PolicyUpdateRequest) ProtoMessage()               {}
-func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{101} }
+func (*PolicyUpdateRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{122} }
 
 type isPolicyUpdateRequest_Scope interface{ isPolicyUpdateRequest_Scope() }
 
@@ -4726,7 +5880,7 @@ type PolicyUpdateResponse struct {
 func (m *PolicyUpdateResponse) Reset()                    { *m = PolicyUpdateResponse{} }
 func (m *PolicyUpdateResponse) String() string            { return proto.CompactTextString(m) }
 func (*PolicyUpdateResponse) ProtoMessage()               {}
-func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{102} }
+func (*PolicyUpdateResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{123} }
 
 type ForwardingHistoryRequest struct {
 	// / Start time is the starting point of the forwarding history request. All records beyond this point will be included, respecting the end time, and the index offset.
@@ -4742,7 +5896,7 @@ type ForwardingHistoryRequest struct {
 func (m *ForwardingHistoryRequest) Reset()                    { *m = ForwardingHistoryRequest{} }
 func (m *ForwardingHistoryRequest) String() string            { return proto.CompactTextString(m) }
 func (*ForwardingHistoryRequest) ProtoMessage()               {}
-func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{103} }
+func (*ForwardingHistoryRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{124} }
 
 func (m *ForwardingHistoryRequest) GetStartTime() uint64 {
 	if m != nil {
@@ -4790,7 +5944,7 @@ type ForwardingEvent struct {
 func (m *ForwardingEvent) Reset()                    { *m = ForwardingEvent{} }
 func (m *ForwardingEvent) String() string            { return proto.CompactTextString(m) }
 func (*ForwardingEvent) ProtoMessage()               {}
-func (*ForwardingEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{104} }
+func (*ForwardingEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{125} }
 
 func (m *ForwardingEvent) GetTimestamp() uint64 {
 	if m != nil {
@@ -4844,7 +5998,7 @@ type ForwardingHistoryResponse struct {
 func (m *ForwardingHistoryResponse) Reset()                    { *m = ForwardingHistoryResponse{} }
 func (m *ForwardingHistoryResponse) String() string            { return proto.CompactTextString(m) }
 func (*ForwardingHistoryResponse) ProtoMessage()               {}
-func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{105} }
+func (*ForwardingHistoryResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{126} }
 
 func (m *ForwardingHistoryResponse) GetForwardingEvents() []*ForwardingEvent {
 	if m != nil {
@@ -4860,216 +6014,1451 @@ func (m *ForwardingHistoryResponse) GetLastOffsetIndex() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*GenSeedRequest)(nil), "lnrpc.GenSeedRequest")
-	proto.RegisterType((*GenSeedResponse)(nil), "lnrpc.GenSeedResponse")
-	proto.RegisterType((*InitWalletRequest)(nil), "lnrpc.InitWalletRequest")
-	proto.RegisterType((*InitWalletResponse)(nil), "lnrpc.InitWalletResponse")
-	proto.RegisterType((*UnlockWalletRequest)(nil), "lnrpc.UnlockWalletRequest")
-	proto.RegisterType((*UnlockWalletResponse)(nil), "lnrpc.UnlockWalletResponse")
-	proto.RegisterType((*ChangePasswordRequest)(nil), "lnrpc.ChangePasswordRequest")
-	proto.RegisterType((*ChangePasswordResponse)(nil), "lnrpc.ChangePasswordResponse")
-	proto.RegisterType((*Transaction)(nil), "lnrpc.Transaction")
-	proto.RegisterType((*GetTransactionsRequest)(nil), "lnrpc.GetTransactionsRequest")
-	proto.RegisterType((*TransactionDetails)(nil), "lnrpc.TransactionDetails")
-	proto.RegisterType((*FeeLimit)(nil), "lnrpc.FeeLimit")
-	proto.RegisterType((*SendRequest)(nil), "lnrpc.SendRequest")
-	proto.RegisterType((*SendResponse)(nil), "lnrpc.SendResponse")
-	proto.RegisterType((*SendToRouteRequest)(nil), "lnrpc.SendToRouteRequest")
-	proto.RegisterType((*ChannelPoint)(nil), "lnrpc.ChannelPoint")
-	proto.RegisterType((*LightningAddress)(nil), "lnrpc.LightningAddress")
-	proto.RegisterType((*SendManyRequest)(nil), "lnrpc.SendManyRequest")
-	proto.RegisterType((*SendManyResponse)(nil), "lnrpc.SendManyResponse")
-	proto.RegisterType((*SendCoinsRequest)(nil), "lnrpc.SendCoinsRequest")
-	proto.RegisterType((*SendCoinsResponse)(nil), "lnrpc.SendCoinsResponse")
-	proto.RegisterType((*NewAddressRequest)(nil), "lnrpc.NewAddressRequest")
-	proto.RegisterType((*NewWitnessAddressRequest)(nil), "lnrpc.NewWitnessAddressRequest")
-	proto.RegisterType((*NewAddressResponse)(nil), "lnrpc.NewAddressResponse")
-	proto.RegisterType((*SignMessageRequest)(nil), "lnrpc.SignMessageRequest")
-	proto.RegisterType((*SignMessageResponse)(nil), "lnrpc.SignMessageResponse")
-	proto.RegisterType((*VerifyMessageRequest)(nil), "lnrpc.VerifyMessageRequest")
-	proto.RegisterType((*VerifyMessageResponse)(nil), "lnrpc.VerifyMessageResponse")
-	proto.RegisterType((*ConnectPeerRequest)(nil), "lnrpc.ConnectPeerRequest")
-	proto.RegisterType((*ConnectPeerResponse)(nil), "lnrpc.ConnectPeerResponse")
-	proto.RegisterType((*DisconnectPeerRequest)(nil), "lnrpc.DisconnectPeerRequest")
-	proto.RegisterType((*DisconnectPeerResponse)(nil), "lnrpc.DisconnectPeerResponse")
-	proto.RegisterType((*HTLC)(nil), "lnrpc.HTLC")
-	proto.RegisterType((*Channel)(nil), "lnrpc.Channel")
-	proto.RegisterType((*ListChannelsRequest)(nil), "lnrpc.ListChannelsRequest")
-	proto.RegisterType((*ListChannelsResponse)(nil), "lnrpc.ListChannelsResponse")
-	proto.RegisterType((*ChannelCloseSummary)(nil), "lnrpc.ChannelCloseSummary")
-	proto.RegisterType((*ClosedChannelsRequest)(nil), "lnrpc.ClosedChannelsRequest")
-	proto.RegisterType((*ClosedChannelsResponse)(nil), "lnrpc.ClosedChannelsResponse")
-	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
-	proto.RegisterType((*ListPeersRequest)(nil), "lnrpc.ListPeersRequest")
-	proto.RegisterType((*ListPeersResponse)(nil), "lnrpc.ListPeersResponse")
-	proto.RegisterType((*GetInfoRequest)(nil), "lnrpc.GetInfoRequest")
-	proto.RegisterType((*GetInfoResponse)(nil), "lnrpc.GetInfoResponse")
-	proto.RegisterType((*ConfirmationUpdate)(nil), "lnrpc.ConfirmationUpdate")
-	proto.RegisterType((*ChannelOpenUpdate)(nil), "lnrpc.ChannelOpenUpdate")
-	proto.RegisterType((*ChannelCloseUpdate)(nil), "lnrpc.ChannelCloseUpdate")
-	proto.RegisterType((*CloseChannelRequest)(nil), "lnrpc.CloseChannelRequest")
-	proto.RegisterType((*CloseStatusUpdate)(nil), "lnrpc.CloseStatusUpdate")
-	proto.RegisterType((*PendingUpdate)(nil), "lnrpc.PendingUpdate")
-	proto.RegisterType((*OpenChannelRequest)(nil), "lnrpc.OpenChannelRequest")
-	proto.RegisterType((*OpenStatusUpdate)(nil), "lnrpc.OpenStatusUpdate")
-	proto.RegisterType((*PendingHTLC)(nil), "lnrpc.PendingHTLC")
-	proto.RegisterType((*PendingChannelsRequest)(nil), "lnrpc.PendingChannelsRequest")
-	proto.RegisterType((*PendingChannelsResponse)(nil), "lnrpc.PendingChannelsResponse")
-	proto.RegisterType((*PendingChannelsResponse_PendingChannel)(nil), "lnrpc.PendingChannelsResponse.PendingChannel")
-	proto.RegisterType((*PendingChannelsResponse_PendingOpenChannel)(nil), "lnrpc.PendingChannelsResponse.PendingOpenChannel")
-	proto.RegisterType((*PendingChannelsResponse_WaitingCloseChannel)(nil), "lnrpc.PendingChannelsResponse.WaitingCloseChannel")
-	proto.RegisterType((*PendingChannelsResponse_ClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ClosedChannel")
-	proto.RegisterType((*PendingChannelsResponse_ForceClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ForceClosedChannel")
-	proto.RegisterType((*WalletBalanceRequest)(nil), "lnrpc.WalletBalanceRequest")
-	proto.RegisterType((*WalletBalanceResponse)(nil), "lnrpc.WalletBalanceResponse")
-	proto.RegisterType((*ChannelBalanceRequest)(nil), "lnrpc.ChannelBalanceRequest")
-	proto.RegisterType((*ChannelBalanceResponse)(nil), "lnrpc.ChannelBalanceResponse")
-	proto.RegisterType((*QueryRoutesRequest)(nil), "lnrpc.QueryRoutesRequest")
-	proto.RegisterType((*QueryRoutesResponse)(nil), "lnrpc.QueryRoutesResponse")
-	proto.RegisterType((*Hop)(nil), "lnrpc.Hop")
-	proto.RegisterType((*Route)(nil), "lnrpc.Route")
-	proto.RegisterType((*NodeInfoRequest)(nil), "lnrpc.NodeInfoRequest")
-	proto.RegisterType((*NodeInfo)(nil), "lnrpc.NodeInfo")
-	proto.RegisterType((*LightningNode)(nil), "lnrpc.LightningNode")
-	proto.RegisterType((*NodeAddress)(nil), "lnrpc.NodeAddress")
-	proto.RegisterType((*RoutingPolicy)(nil), "lnrpc.RoutingPolicy")
-	proto.RegisterType((*ChannelEdge)(nil), "lnrpc.ChannelEdge")
-	proto.RegisterType((*ChannelGraphRequest)(nil), "lnrpc.ChannelGraphRequest")
-	proto.RegisterType((*ChannelGraph)(nil), "lnrpc.ChannelGraph")
-	proto.RegisterType((*ChanInfoRequest)(nil), "lnrpc.ChanInfoRequest")
-	proto.RegisterType((*NetworkInfoRequest)(nil), "lnrpc.NetworkInfoRequest")
-	proto.RegisterType((*NetworkInfo)(nil), "lnrpc.NetworkInfo")
-	proto.RegisterType((*StopRequest)(nil), "lnrpc.StopRequest")
-	proto.RegisterType((*StopResponse)(nil), "lnrpc.StopResponse")
-	proto.RegisterType((*GraphTopologySubscription)(nil), "lnrpc.GraphTopologySubscription")
-	proto.RegisterType((*GraphTopologyUpdate)(nil), "lnrpc.GraphTopologyUpdate")
-	proto.RegisterType((*NodeUpdate)(nil), "lnrpc.NodeUpdate")
-	proto.RegisterType((*ChannelEdgeUpdate)(nil), "lnrpc.ChannelEdgeUpdate")
-	proto.RegisterType((*ClosedChannelUpdate)(nil), "lnrpc.ClosedChannelUpdate")
-	proto.RegisterType((*HopHint)(nil), "lnrpc.HopHint")
-	proto.RegisterType((*RouteHint)(nil), "lnrpc.RouteHint")
-	proto.RegisterType((*Invoice)(nil), "lnrpc.Invoice")
-	proto.RegisterType((*AddInvoiceResponse)(nil), "lnrpc.AddInvoiceResponse")
-	proto.RegisterType((*PaymentHash)(nil), "lnrpc.PaymentHash")
-	proto.RegisterType((*ListInvoiceRequest)(nil), "lnrpc.ListInvoiceRequest")
-	proto.RegisterType((*ListInvoiceResponse)(nil), "lnrpc.ListInvoiceResponse")
-	proto.RegisterType((*InvoiceSubscription)(nil), "lnrpc.InvoiceSubscription")
-	proto.RegisterType((*Payment)(nil), "lnrpc.Payment")
-	proto.RegisterType((*ListPaymentsRequest)(nil), "lnrpc.ListPaymentsRequest")
-	proto.RegisterType((*ListPaymentsResponse)(nil), "lnrpc.ListPaymentsResponse")
-	proto.RegisterType((*DeleteAllPaymentsRequest)(nil), "lnrpc.DeleteAllPaymentsRequest")
-	proto.RegisterType((*DeleteAllPaymentsResponse)(nil), "lnrpc.DeleteAllPaymentsResponse")
-	proto.RegisterType((*DebugLevelRequest)(nil), "lnrpc.DebugLevelRequest")
-	proto.RegisterType((*DebugLevelResponse)(nil), "lnrpc.DebugLevelResponse")
-	proto.RegisterType((*PayReqString)(nil), "lnrpc.PayReqString")
-	proto.RegisterType((*PayReq)(nil), "lnrpc.PayReq")
-	proto.RegisterType((*FeeReportRequest)(nil), "lnrpc.FeeReportRequest")
-	proto.RegisterType((*ChannelFeeReport)(nil), "lnrpc.ChannelFeeReport")
-	proto.RegisterType((*FeeReportResponse)(nil), "lnrpc.FeeReportResponse")
-	proto.RegisterType((*PolicyUpdateRequest)(nil), "lnrpc.PolicyUpdateRequest")
-	proto.RegisterType((*PolicyUpdateResponse)(nil), "lnrpc.PolicyUpdateResponse")
-	proto.RegisterType((*ForwardingHistoryRequest)(nil), "lnrpc.ForwardingHistoryRequest")
-	proto.RegisterType((*ForwardingEvent)(nil), "lnrpc.ForwardingEvent")
-	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
-	proto.RegisterEnum("lnrpc.NewAddressRequest_AddressType", NewAddressRequest_AddressType_name, NewAddressRequest_AddressType_value)
-	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
+type RebalanceRequest struct {
+	// / The channel the funds should be moved out of.
+	OutgoingChanId uint64 `protobuf:"varint,1,opt,name=outgoing_chan_id" json:"outgoing_chan_id,omitempty"`
+	// / The channel the funds should be moved into.
+	IncomingChanId uint64 `protobuf:"varint,2,opt,name=incoming_chan_id" json:"incoming_chan_id,omitempty"`
+	// / The number of satoshis to move.
+	Amt int64 `protobuf:"varint,3,opt,name=amt" json:"amt,omitempty"`
+	// *
+	// The maximum number of satoshis that will be paid as a fee of the
+	// rebalance. This value can be represented either as a percentage of the
+	// amount being moved, or as a fixed amount of the maximum fee the user is
+	// willing the pay.
+	FeeLimit *FeeLimit `protobuf:"bytes,4,opt,name=fee_limit" json:"fee_limit,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *RebalanceRequest) Reset()                    { *m = RebalanceRequest{} }
+func (m *RebalanceRequest) String() string            { return proto.CompactTextString(m) }
+func (*RebalanceRequest) ProtoMessage()               {}
+func (*RebalanceRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{127} }
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *RebalanceRequest) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
 
-// Client API for WalletUnlocker service
+func (m *RebalanceRequest) GetIncomingChanId() uint64 {
+	if m != nil {
+		return m.IncomingChanId
+	}
+	return 0
+}
 
-type WalletUnlockerClient interface {
-	// *
-	// GenSeed is the first method that should be used to instantiate a new lnd
-	// instance. This method allows a caller to generate a new aezeed cipher seed
-	// given an optional passphrase. If provided, the passphrase will be necessary
-	// to decrypt the cipherseed to expose the internal wallet seed.
-	//
-	// Once the cipherseed is obtained and verified by the user, the InitWallet
-	// method should be used to commit the newly generated seed, and create the
-	// wallet.
-	GenSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error)
-	// *
-	// InitWallet is used when lnd is starting up for the first time to fully
-	// initialize the daemon and its internal wallet. At the very least a wallet
-	// password must be provided. This will be used to encrypt sensitive material
-	// on disk.
-	//
-	// In the case of a recovery scenario, the user can also specify their aezeed
-	// mnemonic and passphrase. If set, then the daemon will use this prior state
-	// to initialize its internal wallet.
-	//
-	// Alternatively, this can be used along with the GenSeed RPC to obtain a
-	// seed, then present it to the user. Once it has been verified by the user,
-	// the seed can be fed into this RPC in order to commit the new wallet.
-	InitWallet(ctx context.Context, in *InitWalletRequest, opts ...grpc.CallOption) (*InitWalletResponse, error)
-	// * lncli: `unlock`
-	// UnlockWallet is used at startup of lnd to provide a password to unlock
-	// the wallet database.
-	UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error)
-	// * lncli: `changepassword`
-	// ChangePassword changes the password of the encrypted wallet. This will
-	// automatically unlock the wallet database if successful.
-	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+func (m *RebalanceRequest) GetAmt() int64 {
+	if m != nil {
+		return m.Amt
+	}
+	return 0
 }
 
-type walletUnlockerClient struct {
-	cc *grpc.ClientConn
+func (m *RebalanceRequest) GetFeeLimit() *FeeLimit {
+	if m != nil {
+		return m.FeeLimit
+	}
+	return nil
 }
 
-func NewWalletUnlockerClient(cc *grpc.ClientConn) WalletUnlockerClient {
-	return &walletUnlockerClient{cc}
+type RebalanceResponse struct {
+	PaymentError    string `protobuf:"bytes,1,opt,name=payment_error" json:"payment_error,omitempty"`
+	PaymentPreimage []byte `protobuf:"bytes,2,opt,name=payment_preimage,proto3" json:"payment_preimage,omitempty"`
+	PaymentRoute    *Route `protobuf:"bytes,3,opt,name=payment_route" json:"payment_route,omitempty"`
 }
 
-func (c *walletUnlockerClient) GenSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error) {
-	out := new(GenSeedResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/GenSeed", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *RebalanceResponse) Reset()                    { *m = RebalanceResponse{} }
+func (m *RebalanceResponse) String() string            { return proto.CompactTextString(m) }
+func (*RebalanceResponse) ProtoMessage()               {}
+func (*RebalanceResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{128} }
+
+func (m *RebalanceResponse) GetPaymentError() string {
+	if m != nil {
+		return m.PaymentError
 	}
-	return out, nil
+	return ""
 }
 
-func (c *walletUnlockerClient) InitWallet(ctx context.Context, in *InitWalletRequest, opts ...grpc.CallOption) (*InitWalletResponse, error) {
-	out := new(InitWalletResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/InitWallet", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *RebalanceResponse) GetPaymentPreimage() []byte {
+	if m != nil {
+		return m.PaymentPreimage
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletUnlockerClient) UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error) {
-	out := new(UnlockWalletResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/UnlockWallet", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
+func (m *RebalanceResponse) GetPaymentRoute() *Route {
+	if m != nil {
+		return m.PaymentRoute
 	}
-	return out, nil
+	return nil
 }
 
-func (c *walletUnlockerClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
-	out := new(ChangePasswordResponse)
-	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/ChangePassword", in, out, c.cc, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type TrackPaymentRequest struct {
+	// / The hash of the payment to track.
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
 }
 
-// Server API for WalletUnlocker service
+func (m *TrackPaymentRequest) Reset()                    { *m = TrackPaymentRequest{} }
+func (m *TrackPaymentRequest) String() string            { return proto.CompactTextString(m) }
+func (*TrackPaymentRequest) ProtoMessage()               {}
+func (*TrackPaymentRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{129} }
 
-type WalletUnlockerServer interface {
-	// *
-	// GenSeed is the first method that should be used to instantiate a new lnd
+func (m *TrackPaymentRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+type PaymentAttempt struct {
+	// / The unique ID of the attempt within the history of the payment.
+	AttemptId uint64 `protobuf:"varint,1,opt,name=attempt_id" json:"attempt_id,omitempty"`
+	// / The current state of the attempt.
+	State PaymentAttempt_AttemptState `protobuf:"varint,2,opt,name=state,enum=lnrpc.PaymentAttempt_AttemptState" json:"state,omitempty"`
+	// / The route taken by the attempt.
+	Route *Route `protobuf:"bytes,3,opt,name=route" json:"route,omitempty"`
+	// / The time in unix nanoseconds at which the attempt was dispatched.
+	AttemptTimeNs int64 `protobuf:"varint,4,opt,name=attempt_time_ns" json:"attempt_time_ns,omitempty"`
+	// *
+	// The time in unix nanoseconds at which the attempt was resolved, or zero if
+	// it's still in flight.
+	ResolveTimeNs int64 `protobuf:"varint,5,opt,name=resolve_time_ns" json:"resolve_time_ns,omitempty"`
+	// / The preimage revealed by a successful attempt.
+	Preimage []byte `protobuf:"bytes,6,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	// *
+	// The index of the node that reported the failure of a failed attempt, where
+	// zero denotes our own node, and i denotes the node reached by the i-th hop
+	// of the route.
+	FailureSourceIndex uint32 `protobuf:"varint,7,opt,name=failure_source_index" json:"failure_source_index,omitempty"`
+	// *
+	// The onion failure code reported for a failed attempt. A zero code
+	// indicates that the attempt failed locally without an onion failure.
+	FailureCode uint32 `protobuf:"varint,8,opt,name=failure_code" json:"failure_code,omitempty"`
+}
+
+func (m *PaymentAttempt) Reset()                    { *m = PaymentAttempt{} }
+func (m *PaymentAttempt) String() string            { return proto.CompactTextString(m) }
+func (*PaymentAttempt) ProtoMessage()               {}
+func (*PaymentAttempt) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{130} }
+
+func (m *PaymentAttempt) GetAttemptId() uint64 {
+	if m != nil {
+		return m.AttemptId
+	}
+	return 0
+}
+
+func (m *PaymentAttempt) GetState() PaymentAttempt_AttemptState {
+	if m != nil {
+		return m.State
+	}
+	return PaymentAttempt_IN_FLIGHT
+}
+
+func (m *PaymentAttempt) GetRoute() *Route {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *PaymentAttempt) GetAttemptTimeNs() int64 {
+	if m != nil {
+		return m.AttemptTimeNs
+	}
+	return 0
+}
+
+func (m *PaymentAttempt) GetResolveTimeNs() int64 {
+	if m != nil {
+		return m.ResolveTimeNs
+	}
+	return 0
+}
+
+func (m *PaymentAttempt) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+func (m *PaymentAttempt) GetFailureSourceIndex() uint32 {
+	if m != nil {
+		return m.FailureSourceIndex
+	}
+	return 0
+}
+
+func (m *PaymentAttempt) GetFailureCode() uint32 {
+	if m != nil {
+		return m.FailureCode
+	}
+	return 0
+}
+
+type PaymentUpdate struct {
+	// / The hash of the payment.
+	PaymentHash []byte `protobuf:"bytes,1,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// / The public key of the destination of the payment.
+	Target string `protobuf:"bytes,2,opt,name=target" json:"target,omitempty"`
+	// / The amount being paid to the destination in millisatoshis.
+	ValueMsat int64 `protobuf:"varint,3,opt,name=value_msat" json:"value_msat,omitempty"`
+	// / The time in unix seconds at which the payment was initiated.
+	CreationDate int64 `protobuf:"varint,4,opt,name=creation_date" json:"creation_date,omitempty"`
+	// / The current state of the payment.
+	State PaymentUpdate_PaymentState `protobuf:"varint,5,opt,name=state,enum=lnrpc.PaymentUpdate_PaymentState" json:"state,omitempty"`
+	// / Every attempt made to complete the payment, in the order they were made.
+	Attempts []*PaymentAttempt `protobuf:"bytes,6,rep,name=attempts" json:"attempts,omitempty"`
+}
+
+func (m *PaymentUpdate) Reset()                    { *m = PaymentUpdate{} }
+func (m *PaymentUpdate) String() string            { return proto.CompactTextString(m) }
+func (*PaymentUpdate) ProtoMessage()               {}
+func (*PaymentUpdate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{131} }
+
+func (m *PaymentUpdate) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *PaymentUpdate) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *PaymentUpdate) GetValueMsat() int64 {
+	if m != nil {
+		return m.ValueMsat
+	}
+	return 0
+}
+
+func (m *PaymentUpdate) GetCreationDate() int64 {
+	if m != nil {
+		return m.CreationDate
+	}
+	return 0
+}
+
+func (m *PaymentUpdate) GetState() PaymentUpdate_PaymentState {
+	if m != nil {
+		return m.State
+	}
+	return PaymentUpdate_IN_FLIGHT
+}
+
+func (m *PaymentUpdate) GetAttempts() []*PaymentAttempt {
+	if m != nil {
+		return m.Attempts
+	}
+	return nil
+}
+
+type SubscribeHtlcEventsRequest struct {
+}
+
+func (m *SubscribeHtlcEventsRequest) Reset()                    { *m = SubscribeHtlcEventsRequest{} }
+func (m *SubscribeHtlcEventsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SubscribeHtlcEventsRequest) ProtoMessage()               {}
+func (*SubscribeHtlcEventsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{132} }
+
+type HtlcEvent struct {
+	// / The stage of its lifecycle the HTLC has reached.
+	EventType HtlcEvent_EventType `protobuf:"varint,1,opt,name=event_type,enum=lnrpc.HtlcEvent_EventType" json:"event_type,omitempty"`
+	// / The short channel ID of the incoming channel, zero for local payments.
+	IncomingChanId uint64 `protobuf:"varint,2,opt,name=incoming_chan_id" json:"incoming_chan_id,omitempty"`
+	// / The ID of the HTLC on the incoming channel.
+	IncomingHtlcId uint64 `protobuf:"varint,3,opt,name=incoming_htlc_id" json:"incoming_htlc_id,omitempty"`
+	// / The short channel ID of the outgoing channel.
+	OutgoingChanId uint64 `protobuf:"varint,4,opt,name=outgoing_chan_id" json:"outgoing_chan_id,omitempty"`
+	// *
+	// The ID of the HTLC on the outgoing channel. This is only known once the
+	// HTLC has been added to the outgoing channel.
+	OutgoingHtlcId uint64 `protobuf:"varint,5,opt,name=outgoing_htlc_id" json:"outgoing_htlc_id,omitempty"`
+	// / The payment hash of the HTLC.
+	PaymentHash []byte `protobuf:"bytes,6,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// / The amount of the incoming HTLC in millisatoshis.
+	IncomingAmtMsat uint64 `protobuf:"varint,7,opt,name=incoming_amt_msat" json:"incoming_amt_msat,omitempty"`
+	// / The amount of the outgoing HTLC in millisatoshis.
+	OutgoingAmtMsat uint64 `protobuf:"varint,8,opt,name=outgoing_amt_msat" json:"outgoing_amt_msat,omitempty"`
+	// / The time in unix nanoseconds at which the event occurred.
+	TimestampNs uint64 `protobuf:"varint,9,opt,name=timestamp_ns" json:"timestamp_ns,omitempty"`
+	// *
+	// A description of why the HTLC was failed or dropped. Failures which are
+	// encrypted for the source of a remote payment are left blank.
+	FailureReason string `protobuf:"bytes,10,opt,name=failure_reason" json:"failure_reason,omitempty"`
+}
+
+func (m *HtlcEvent) Reset()                    { *m = HtlcEvent{} }
+func (m *HtlcEvent) String() string            { return proto.CompactTextString(m) }
+func (*HtlcEvent) ProtoMessage()               {}
+func (*HtlcEvent) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{133} }
+
+func (m *HtlcEvent) GetEventType() HtlcEvent_EventType {
+	if m != nil {
+		return m.EventType
+	}
+	return HtlcEvent_FORWARD
+}
+
+func (m *HtlcEvent) GetIncomingChanId() uint64 {
+	if m != nil {
+		return m.IncomingChanId
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetIncomingHtlcId() uint64 {
+	if m != nil {
+		return m.IncomingHtlcId
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetOutgoingChanId() uint64 {
+	if m != nil {
+		return m.OutgoingChanId
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetOutgoingHtlcId() uint64 {
+	if m != nil {
+		return m.OutgoingHtlcId
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *HtlcEvent) GetIncomingAmtMsat() uint64 {
+	if m != nil {
+		return m.IncomingAmtMsat
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetOutgoingAmtMsat() uint64 {
+	if m != nil {
+		return m.OutgoingAmtMsat
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetTimestampNs() uint64 {
+	if m != nil {
+		return m.TimestampNs
+	}
+	return 0
+}
+
+func (m *HtlcEvent) GetFailureReason() string {
+	if m != nil {
+		return m.FailureReason
+	}
+	return ""
+}
+
+type CircuitKey struct {
+	// / The short channel ID of the channel the HTLC is on.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id" json:"chan_id,omitempty"`
+	// / The ID of the HTLC on the channel.
+	HtlcId uint64 `protobuf:"varint,2,opt,name=htlc_id" json:"htlc_id,omitempty"`
+}
+
+func (m *CircuitKey) Reset()                    { *m = CircuitKey{} }
+func (m *CircuitKey) String() string            { return proto.CompactTextString(m) }
+func (*CircuitKey) ProtoMessage()               {}
+func (*CircuitKey) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{134} }
+
+func (m *CircuitKey) GetChanId() uint64 {
+	if m != nil {
+		return m.ChanId
+	}
+	return 0
+}
+
+func (m *CircuitKey) GetHtlcId() uint64 {
+	if m != nil {
+		return m.HtlcId
+	}
+	return 0
+}
+
+type ForwardHtlcInterceptRequest struct {
+	// / The key of the incoming HTLC, used to refer to the held forward.
+	IncomingCircuitKey *CircuitKey `protobuf:"bytes,1,opt,name=incoming_circuit_key" json:"incoming_circuit_key,omitempty"`
+	// / The amount of the incoming HTLC in millisatoshis.
+	IncomingAmountMsat uint64 `protobuf:"varint,2,opt,name=incoming_amount_msat" json:"incoming_amount_msat,omitempty"`
+	// / The absolute timeout of the incoming HTLC.
+	IncomingExpiry uint32 `protobuf:"varint,3,opt,name=incoming_expiry" json:"incoming_expiry,omitempty"`
+	// / The payment hash of the HTLC.
+	PaymentHash []byte `protobuf:"bytes,4,opt,name=payment_hash,proto3" json:"payment_hash,omitempty"`
+	// / The channel requested by the onion to forward the HTLC over.
+	OutgoingRequestedChanId uint64 `protobuf:"varint,5,opt,name=outgoing_requested_chan_id" json:"outgoing_requested_chan_id,omitempty"`
+	// / The amount requested by the onion to be forwarded in millisatoshis.
+	OutgoingAmountMsat uint64 `protobuf:"varint,6,opt,name=outgoing_amount_msat" json:"outgoing_amount_msat,omitempty"`
+	// / The absolute timeout requested by the onion for the outgoing HTLC.
+	OutgoingExpiry uint32 `protobuf:"varint,7,opt,name=outgoing_expiry" json:"outgoing_expiry,omitempty"`
+	// *
+	// The time in unix seconds at which the forward is failed back to its
+	// source if it hasn't been resolved.
+	Deadline int64 `protobuf:"varint,8,opt,name=deadline" json:"deadline,omitempty"`
+}
+
+func (m *ForwardHtlcInterceptRequest) Reset()                    { *m = ForwardHtlcInterceptRequest{} }
+func (m *ForwardHtlcInterceptRequest) String() string            { return proto.CompactTextString(m) }
+func (*ForwardHtlcInterceptRequest) ProtoMessage()               {}
+func (*ForwardHtlcInterceptRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{135} }
+
+func (m *ForwardHtlcInterceptRequest) GetIncomingCircuitKey() *CircuitKey {
+	if m != nil {
+		return m.IncomingCircuitKey
+	}
+	return nil
+}
+
+func (m *ForwardHtlcInterceptRequest) GetIncomingAmountMsat() uint64 {
+	if m != nil {
+		return m.IncomingAmountMsat
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetIncomingExpiry() uint32 {
+	if m != nil {
+		return m.IncomingExpiry
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetPaymentHash() []byte {
+	if m != nil {
+		return m.PaymentHash
+	}
+	return nil
+}
+
+func (m *ForwardHtlcInterceptRequest) GetOutgoingRequestedChanId() uint64 {
+	if m != nil {
+		return m.OutgoingRequestedChanId
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetOutgoingAmountMsat() uint64 {
+	if m != nil {
+		return m.OutgoingAmountMsat
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetOutgoingExpiry() uint32 {
+	if m != nil {
+		return m.OutgoingExpiry
+	}
+	return 0
+}
+
+func (m *ForwardHtlcInterceptRequest) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+type ForwardHtlcInterceptResponse struct {
+	// / The key of the incoming HTLC of the held forward to resolve.
+	IncomingCircuitKey *CircuitKey `protobuf:"bytes,1,opt,name=incoming_circuit_key" json:"incoming_circuit_key,omitempty"`
+	// / The action to resolve the held forward with.
+	Action ForwardHtlcInterceptResponse_ResolveAction `protobuf:"varint,2,opt,name=action,enum=lnrpc.ForwardHtlcInterceptResponse_ResolveAction" json:"action,omitempty"`
+	// / The preimage to settle the HTLC with, only used by the settle action.
+	Preimage []byte `protobuf:"bytes,3,opt,name=preimage,proto3" json:"preimage,omitempty"`
+}
+
+func (m *ForwardHtlcInterceptResponse) Reset()                    { *m = ForwardHtlcInterceptResponse{} }
+func (m *ForwardHtlcInterceptResponse) String() string            { return proto.CompactTextString(m) }
+func (*ForwardHtlcInterceptResponse) ProtoMessage()               {}
+func (*ForwardHtlcInterceptResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{136} }
+
+func (m *ForwardHtlcInterceptResponse) GetIncomingCircuitKey() *CircuitKey {
+	if m != nil {
+		return m.IncomingCircuitKey
+	}
+	return nil
+}
+
+func (m *ForwardHtlcInterceptResponse) GetAction() ForwardHtlcInterceptResponse_ResolveAction {
+	if m != nil {
+		return m.Action
+	}
+	return ForwardHtlcInterceptResponse_RESUME
+}
+
+func (m *ForwardHtlcInterceptResponse) GetPreimage() []byte {
+	if m != nil {
+		return m.Preimage
+	}
+	return nil
+}
+
+type ChannelAcceptRequest struct {
+	// / The public key of the node requesting to open the channel.
+	NodePubkey []byte `protobuf:"bytes,1,opt,name=node_pubkey,proto3" json:"node_pubkey,omitempty"`
+	// / The hash of the genesis block the channel is to be opened on.
+	ChainHash []byte `protobuf:"bytes,2,opt,name=chain_hash,proto3" json:"chain_hash,omitempty"`
+	// / The pending channel ID, used to refer to the request in the response.
+	PendingChanId []byte `protobuf:"bytes,3,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// / The capacity of the channel in satoshis.
+	FundingAmt uint64 `protobuf:"varint,4,opt,name=funding_amt" json:"funding_amt,omitempty"`
+	// / The amount pushed to our side of the channel in millisatoshis.
+	PushAmt uint64 `protobuf:"varint,5,opt,name=push_amt" json:"push_amt,omitempty"`
+	// / The dust limit of the initiator's commitment transaction in satoshis.
+	DustLimit uint64 `protobuf:"varint,6,opt,name=dust_limit" json:"dust_limit,omitempty"`
+	// / The maximum value in millisatoshis we may have in outgoing HTLCs.
+	MaxValueInFlight uint64 `protobuf:"varint,7,opt,name=max_value_in_flight" json:"max_value_in_flight,omitempty"`
+	// / The reserve in satoshis we must keep on our side of the channel.
+	ChannelReserve uint64 `protobuf:"varint,8,opt,name=channel_reserve" json:"channel_reserve,omitempty"`
+	// / The smallest HTLC in millisatoshis the initiator will accept.
+	MinHtlc uint64 `protobuf:"varint,9,opt,name=min_htlc" json:"min_htlc,omitempty"`
+	// / The initial fee rate of the commitment transaction in sat/kw.
+	FeePerKw uint64 `protobuf:"varint,10,opt,name=fee_per_kw" json:"fee_per_kw,omitempty"`
+	// / The number of blocks our outputs are delayed by on a force close.
+	CsvDelay uint32 `protobuf:"varint,11,opt,name=csv_delay" json:"csv_delay,omitempty"`
+	// / The maximum number of HTLCs we may offer to the initiator.
+	MaxAcceptedHtlcs uint32 `protobuf:"varint,12,opt,name=max_accepted_htlcs" json:"max_accepted_htlcs,omitempty"`
+	// / The channel flags, the lowest bit indicating a public channel.
+	ChannelFlags uint32 `protobuf:"varint,13,opt,name=channel_flags" json:"channel_flags,omitempty"`
+}
+
+func (m *ChannelAcceptRequest) Reset()                    { *m = ChannelAcceptRequest{} }
+func (m *ChannelAcceptRequest) String() string            { return proto.CompactTextString(m) }
+func (*ChannelAcceptRequest) ProtoMessage()               {}
+func (*ChannelAcceptRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{137} }
+
+func (m *ChannelAcceptRequest) GetNodePubkey() []byte {
+	if m != nil {
+		return m.NodePubkey
+	}
+	return nil
+}
+
+func (m *ChannelAcceptRequest) GetChainHash() []byte {
+	if m != nil {
+		return m.ChainHash
+	}
+	return nil
+}
+
+func (m *ChannelAcceptRequest) GetPendingChanId() []byte {
+	if m != nil {
+		return m.PendingChanId
+	}
+	return nil
+}
+
+func (m *ChannelAcceptRequest) GetFundingAmt() uint64 {
+	if m != nil {
+		return m.FundingAmt
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetPushAmt() uint64 {
+	if m != nil {
+		return m.PushAmt
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetDustLimit() uint64 {
+	if m != nil {
+		return m.DustLimit
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetMaxValueInFlight() uint64 {
+	if m != nil {
+		return m.MaxValueInFlight
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetChannelReserve() uint64 {
+	if m != nil {
+		return m.ChannelReserve
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetMinHtlc() uint64 {
+	if m != nil {
+		return m.MinHtlc
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetFeePerKw() uint64 {
+	if m != nil {
+		return m.FeePerKw
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetCsvDelay() uint32 {
+	if m != nil {
+		return m.CsvDelay
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetMaxAcceptedHtlcs() uint32 {
+	if m != nil {
+		return m.MaxAcceptedHtlcs
+	}
+	return 0
+}
+
+func (m *ChannelAcceptRequest) GetChannelFlags() uint32 {
+	if m != nil {
+		return m.ChannelFlags
+	}
+	return 0
+}
+
+type ChannelAcceptResponse struct {
+	// / Whether the channel is accepted.
+	Accept bool `protobuf:"varint,1,opt,name=accept" json:"accept,omitempty"`
+	// / The pending channel ID of the request being responded to.
+	PendingChanId []byte `protobuf:"bytes,2,opt,name=pending_chan_id,proto3" json:"pending_chan_id,omitempty"`
+	// *
+	// The reason for rejecting the channel, which is sent to the remote peer.
+	// This is ignored if the channel is accepted.
+	Error string `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+}
+
+func (m *ChannelAcceptResponse) Reset()                    { *m = ChannelAcceptResponse{} }
+func (m *ChannelAcceptResponse) String() string            { return proto.CompactTextString(m) }
+func (*ChannelAcceptResponse) ProtoMessage()               {}
+func (*ChannelAcceptResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{138} }
+
+func (m *ChannelAcceptResponse) GetAccept() bool {
+	if m != nil {
+		return m.Accept
+	}
+	return false
+}
+
+func (m *ChannelAcceptResponse) GetPendingChanId() []byte {
+	if m != nil {
+		return m.PendingChanId
+	}
+	return nil
+}
+
+func (m *ChannelAcceptResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type PendingSweepsRequest struct {
+}
+
+func (m *PendingSweepsRequest) Reset()                    { *m = PendingSweepsRequest{} }
+func (m *PendingSweepsRequest) String() string            { return proto.CompactTextString(m) }
+func (*PendingSweepsRequest) ProtoMessage()               {}
+func (*PendingSweepsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{139} }
+
+type PendingSweep struct {
+	// / The outpoint of the output being swept, in the form txid:index.
+	Outpoint string `protobuf:"bytes,1,opt,name=outpoint" json:"outpoint,omitempty"`
+	// / The type of witness used to spend the output.
+	WitnessType string `protobuf:"bytes,2,opt,name=witness_type" json:"witness_type,omitempty"`
+	// / The value of the output in satoshis.
+	AmountSat int64 `protobuf:"varint,3,opt,name=amount_sat" json:"amount_sat,omitempty"`
+	// / The number of blocks the sweep is targeting confirmation within.
+	TargetConf uint32 `protobuf:"varint,4,opt,name=target_conf" json:"target_conf,omitempty"`
+	// / The fee rate of the latest sweep transaction in sat/byte.
+	SatPerByte int64 `protobuf:"varint,5,opt,name=sat_per_byte" json:"sat_per_byte,omitempty"`
+	// / The number of sweep transactions spending the output broadcast so far.
+	BroadcastAttempts uint32 `protobuf:"varint,6,opt,name=broadcast_attempts" json:"broadcast_attempts,omitempty"`
+	// / The txid of the latest sweep transaction, if any.
+	SweepTxid string `protobuf:"bytes,7,opt,name=sweep_txid" json:"sweep_txid,omitempty"`
+}
+
+func (m *PendingSweep) Reset()                    { *m = PendingSweep{} }
+func (m *PendingSweep) String() string            { return proto.CompactTextString(m) }
+func (*PendingSweep) ProtoMessage()               {}
+func (*PendingSweep) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{140} }
+
+func (m *PendingSweep) GetOutpoint() string {
+	if m != nil {
+		return m.Outpoint
+	}
+	return ""
+}
+
+func (m *PendingSweep) GetWitnessType() string {
+	if m != nil {
+		return m.WitnessType
+	}
+	return ""
+}
+
+func (m *PendingSweep) GetAmountSat() int64 {
+	if m != nil {
+		return m.AmountSat
+	}
+	return 0
+}
+
+func (m *PendingSweep) GetTargetConf() uint32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *PendingSweep) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+func (m *PendingSweep) GetBroadcastAttempts() uint32 {
+	if m != nil {
+		return m.BroadcastAttempts
+	}
+	return 0
+}
+
+func (m *PendingSweep) GetSweepTxid() string {
+	if m != nil {
+		return m.SweepTxid
+	}
+	return ""
+}
+
+type PendingSweepsResponse struct {
+	// / The outputs waiting to be swept.
+	PendingSweeps []*PendingSweep `protobuf:"bytes,1,rep,name=pending_sweeps" json:"pending_sweeps,omitempty"`
+}
+
+func (m *PendingSweepsResponse) Reset()                    { *m = PendingSweepsResponse{} }
+func (m *PendingSweepsResponse) String() string            { return proto.CompactTextString(m) }
+func (*PendingSweepsResponse) ProtoMessage()               {}
+func (*PendingSweepsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{141} }
+
+func (m *PendingSweepsResponse) GetPendingSweeps() []*PendingSweep {
+	if m != nil {
+		return m.PendingSweeps
+	}
+	return nil
+}
+
+type SweepOutputsRequest struct {
+	// / The number of blocks the sweep transactions should confirm within.
+	TargetConf int32 `protobuf:"varint,1,opt,name=target_conf" json:"target_conf,omitempty"`
+}
+
+func (m *SweepOutputsRequest) Reset()                    { *m = SweepOutputsRequest{} }
+func (m *SweepOutputsRequest) String() string            { return proto.CompactTextString(m) }
+func (*SweepOutputsRequest) ProtoMessage()               {}
+func (*SweepOutputsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{142} }
+
+func (m *SweepOutputsRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+type SweepOutputsResponse struct {
+	// / The txids of the published sweep transactions.
+	SweepTxids []string `protobuf:"bytes,1,rep,name=sweep_txids" json:"sweep_txids,omitempty"`
+}
+
+func (m *SweepOutputsResponse) Reset()                    { *m = SweepOutputsResponse{} }
+func (m *SweepOutputsResponse) String() string            { return proto.CompactTextString(m) }
+func (*SweepOutputsResponse) ProtoMessage()               {}
+func (*SweepOutputsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{143} }
+
+func (m *SweepOutputsResponse) GetSweepTxids() []string {
+	if m != nil {
+		return m.SweepTxids
+	}
+	return nil
+}
+
+type BumpFeeRequest struct {
+	// / The outpoint whose transaction fee should be bumped, as txid:index.
+	Outpoint string `protobuf:"bytes,1,opt,name=outpoint" json:"outpoint,omitempty"`
+	// / The number of blocks the transaction should confirm within.
+	TargetConf int32 `protobuf:"varint,2,opt,name=target_conf" json:"target_conf,omitempty"`
+	// *
+	// A manual fee rate set in sat/byte. If neither this nor target_conf is
+	// set, a pending sweep has its fee rate bumped by the default increment.
+	SatPerByte int64 `protobuf:"varint,3,opt,name=sat_per_byte" json:"sat_per_byte,omitempty"`
+}
+
+func (m *BumpFeeRequest) Reset()                    { *m = BumpFeeRequest{} }
+func (m *BumpFeeRequest) String() string            { return proto.CompactTextString(m) }
+func (*BumpFeeRequest) ProtoMessage()               {}
+func (*BumpFeeRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{144} }
+
+func (m *BumpFeeRequest) GetOutpoint() string {
+	if m != nil {
+		return m.Outpoint
+	}
+	return ""
+}
+
+func (m *BumpFeeRequest) GetTargetConf() int32 {
+	if m != nil {
+		return m.TargetConf
+	}
+	return 0
+}
+
+func (m *BumpFeeRequest) GetSatPerByte() int64 {
+	if m != nil {
+		return m.SatPerByte
+	}
+	return 0
+}
+
+type BumpFeeResponse struct {
+	// / The txid of the replacement or child transaction.
+	Txid string `protobuf:"bytes,1,opt,name=txid" json:"txid,omitempty"`
+}
+
+func (m *BumpFeeResponse) Reset()                    { *m = BumpFeeResponse{} }
+func (m *BumpFeeResponse) String() string            { return proto.CompactTextString(m) }
+func (*BumpFeeResponse) ProtoMessage()               {}
+func (*BumpFeeResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{145} }
+
+func (m *BumpFeeResponse) GetTxid() string {
+	if m != nil {
+		return m.Txid
+	}
+	return ""
+}
+
+type AutopilotStatusRequest struct {
+}
+
+func (m *AutopilotStatusRequest) Reset()                    { *m = AutopilotStatusRequest{} }
+func (m *AutopilotStatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*AutopilotStatusRequest) ProtoMessage()               {}
+func (*AutopilotStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{146} }
+
+type AutopilotProposal struct {
+	// / The identity pubkey of the node the agent would open a channel to.
+	PubKey string `protobuf:"bytes,1,opt,name=pub_key" json:"pub_key,omitempty"`
+	// / The capacity of the proposed channel in satoshis.
+	ChanAmt int64 `protobuf:"varint,2,opt,name=chan_amt" json:"chan_amt,omitempty"`
+	// / The advertised addresses of the node.
+	Addresses []string `protobuf:"bytes,3,rep,name=addresses" json:"addresses,omitempty"`
+}
+
+func (m *AutopilotProposal) Reset()                    { *m = AutopilotProposal{} }
+func (m *AutopilotProposal) String() string            { return proto.CompactTextString(m) }
+func (*AutopilotProposal) ProtoMessage()               {}
+func (*AutopilotProposal) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{147} }
+
+func (m *AutopilotProposal) GetPubKey() string {
+	if m != nil {
+		return m.PubKey
+	}
+	return ""
+}
+
+func (m *AutopilotProposal) GetChanAmt() int64 {
+	if m != nil {
+		return m.ChanAmt
+	}
+	return 0
+}
+
+func (m *AutopilotProposal) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
+	}
+	return nil
+}
+
+type AutopilotStatusResponse struct {
+	// / Whether the autopilot agent is active.
+	Active bool `protobuf:"varint,1,opt,name=active" json:"active,omitempty"`
+	// / The heuristic used to select the nodes to open channels to.
+	Heuristic string `protobuf:"bytes,2,opt,name=heuristic" json:"heuristic,omitempty"`
+	// / The number of open channels.
+	NumChannels uint32 `protobuf:"varint,3,opt,name=num_channels" json:"num_channels,omitempty"`
+	// / The number of channels the agent initiated that aren't open yet.
+	NumPendingOpens uint32 `protobuf:"varint,4,opt,name=num_pending_opens" json:"num_pending_opens,omitempty"`
+	// / The number of nodes the agent is attempting to connect to.
+	NumPendingConns uint32 `protobuf:"varint,5,opt,name=num_pending_conns" json:"num_pending_conns,omitempty"`
+	// / The wallet balance in satoshis, as last seen by the agent.
+	TotalBalance int64 `protobuf:"varint,6,opt,name=total_balance" json:"total_balance,omitempty"`
+	// / Whether the heuristic wants additional channels to be opened.
+	NeedMoreChans bool `protobuf:"varint,7,opt,name=need_more_chans" json:"need_more_chans,omitempty"`
+	// / The amount in satoshis the heuristic would commit to new channels.
+	AvailableFunds int64 `protobuf:"varint,8,opt,name=available_funds" json:"available_funds,omitempty"`
+	// / The channels the agent currently proposes to open.
+	Proposals []*AutopilotProposal `protobuf:"bytes,9,rep,name=proposals" json:"proposals,omitempty"`
+}
+
+func (m *AutopilotStatusResponse) Reset()                    { *m = AutopilotStatusResponse{} }
+func (m *AutopilotStatusResponse) String() string            { return proto.CompactTextString(m) }
+func (*AutopilotStatusResponse) ProtoMessage()               {}
+func (*AutopilotStatusResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{148} }
+
+func (m *AutopilotStatusResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *AutopilotStatusResponse) GetHeuristic() string {
+	if m != nil {
+		return m.Heuristic
+	}
+	return ""
+}
+
+func (m *AutopilotStatusResponse) GetNumChannels() uint32 {
+	if m != nil {
+		return m.NumChannels
+	}
+	return 0
+}
+
+func (m *AutopilotStatusResponse) GetNumPendingOpens() uint32 {
+	if m != nil {
+		return m.NumPendingOpens
+	}
+	return 0
+}
+
+func (m *AutopilotStatusResponse) GetNumPendingConns() uint32 {
+	if m != nil {
+		return m.NumPendingConns
+	}
+	return 0
+}
+
+func (m *AutopilotStatusResponse) GetTotalBalance() int64 {
+	if m != nil {
+		return m.TotalBalance
+	}
+	return 0
+}
+
+func (m *AutopilotStatusResponse) GetNeedMoreChans() bool {
+	if m != nil {
+		return m.NeedMoreChans
+	}
+	return false
+}
+
+func (m *AutopilotStatusResponse) GetAvailableFunds() int64 {
+	if m != nil {
+		return m.AvailableFunds
+	}
+	return 0
+}
+
+func (m *AutopilotStatusResponse) GetProposals() []*AutopilotProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+type ModifyAutopilotStatusRequest struct {
+	// / Whether the autopilot agent should be enabled or disabled.
+	Enable bool `protobuf:"varint,1,opt,name=enable" json:"enable,omitempty"`
+}
+
+func (m *ModifyAutopilotStatusRequest) Reset()                    { *m = ModifyAutopilotStatusRequest{} }
+func (m *ModifyAutopilotStatusRequest) String() string            { return proto.CompactTextString(m) }
+func (*ModifyAutopilotStatusRequest) ProtoMessage()               {}
+func (*ModifyAutopilotStatusRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{149} }
+
+func (m *ModifyAutopilotStatusRequest) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+type ModifyAutopilotStatusResponse struct {
+}
+
+func (m *ModifyAutopilotStatusResponse) Reset()         { *m = ModifyAutopilotStatusResponse{} }
+func (m *ModifyAutopilotStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyAutopilotStatusResponse) ProtoMessage()    {}
+func (*ModifyAutopilotStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor0, []int{150}
+}
+
+type SetAutopilotScoresRequest struct {
+	// *
+	// The new scores, as a map from hex encoded node pubkey to score. Scores
+	// must lie within [0, 1], a higher score marking a more desirable node.
+	// Nodes which aren't part of the map no longer receive a score.
+	Scores map[string]float64 `protobuf:"bytes,1,rep,name=scores" json:"scores,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+}
+
+func (m *SetAutopilotScoresRequest) Reset()                    { *m = SetAutopilotScoresRequest{} }
+func (m *SetAutopilotScoresRequest) String() string            { return proto.CompactTextString(m) }
+func (*SetAutopilotScoresRequest) ProtoMessage()               {}
+func (*SetAutopilotScoresRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{151} }
+
+func (m *SetAutopilotScoresRequest) GetScores() map[string]float64 {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
+type SetAutopilotScoresResponse struct {
+}
+
+func (m *SetAutopilotScoresResponse) Reset()                    { *m = SetAutopilotScoresResponse{} }
+func (m *SetAutopilotScoresResponse) String() string            { return proto.CompactTextString(m) }
+func (*SetAutopilotScoresResponse) ProtoMessage()               {}
+func (*SetAutopilotScoresResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{152} }
+
+type MacaroonPermission struct {
+	// / The entity a permission grants access to.
+	Entity string `protobuf:"bytes,1,opt,name=entity" json:"entity,omitempty"`
+	// / The action that is granted.
+	Action string `protobuf:"bytes,2,opt,name=action" json:"action,omitempty"`
+}
+
+func (m *MacaroonPermission) Reset()                    { *m = MacaroonPermission{} }
+func (m *MacaroonPermission) String() string            { return proto.CompactTextString(m) }
+func (*MacaroonPermission) ProtoMessage()               {}
+func (*MacaroonPermission) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{153} }
+
+func (m *MacaroonPermission) GetEntity() string {
+	if m != nil {
+		return m.Entity
+	}
+	return ""
+}
+
+func (m *MacaroonPermission) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+type BakeMacaroonRequest struct {
+	// / The list of permissions the new macaroon should grant.
+	Permissions []*MacaroonPermission `protobuf:"bytes,1,rep,name=permissions" json:"permissions,omitempty"`
+	// / The ID of the root key to bake the macaroon with, 0 being the default.
+	RootKeyId uint64 `protobuf:"varint,2,opt,name=root_key_id" json:"root_key_id,omitempty"`
+}
+
+func (m *BakeMacaroonRequest) Reset()                    { *m = BakeMacaroonRequest{} }
+func (m *BakeMacaroonRequest) String() string            { return proto.CompactTextString(m) }
+func (*BakeMacaroonRequest) ProtoMessage()               {}
+func (*BakeMacaroonRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{154} }
+
+func (m *BakeMacaroonRequest) GetPermissions() []*MacaroonPermission {
+	if m != nil {
+		return m.Permissions
+	}
+	return nil
+}
+
+func (m *BakeMacaroonRequest) GetRootKeyId() uint64 {
+	if m != nil {
+		return m.RootKeyId
+	}
+	return 0
+}
+
+type BakeMacaroonResponse struct {
+	// / The hex encoded macaroon, serialized in binary format.
+	Macaroon string `protobuf:"bytes,1,opt,name=macaroon" json:"macaroon,omitempty"`
+}
+
+func (m *BakeMacaroonResponse) Reset()                    { *m = BakeMacaroonResponse{} }
+func (m *BakeMacaroonResponse) String() string            { return proto.CompactTextString(m) }
+func (*BakeMacaroonResponse) ProtoMessage()               {}
+func (*BakeMacaroonResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{155} }
+
+func (m *BakeMacaroonResponse) GetMacaroon() string {
+	if m != nil {
+		return m.Macaroon
+	}
+	return ""
+}
+
+type ListMacaroonIDsRequest struct {
+}
+
+func (m *ListMacaroonIDsRequest) Reset()                    { *m = ListMacaroonIDsRequest{} }
+func (m *ListMacaroonIDsRequest) String() string            { return proto.CompactTextString(m) }
+func (*ListMacaroonIDsRequest) ProtoMessage()               {}
+func (*ListMacaroonIDsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{156} }
+
+type ListMacaroonIDsResponse struct {
+	// / The IDs of all root keys macaroons have been baked with.
+	RootKeyIds []uint64 `protobuf:"varint,1,rep,packed,name=root_key_ids" json:"root_key_ids,omitempty"`
+}
+
+func (m *ListMacaroonIDsResponse) Reset()                    { *m = ListMacaroonIDsResponse{} }
+func (m *ListMacaroonIDsResponse) String() string            { return proto.CompactTextString(m) }
+func (*ListMacaroonIDsResponse) ProtoMessage()               {}
+func (*ListMacaroonIDsResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{157} }
+
+func (m *ListMacaroonIDsResponse) GetRootKeyIds() []uint64 {
+	if m != nil {
+		return m.RootKeyIds
+	}
+	return nil
+}
+
+type DeleteMacaroonIDRequest struct {
+	// / The ID of the root key to delete.
+	RootKeyId uint64 `protobuf:"varint,1,opt,name=root_key_id" json:"root_key_id,omitempty"`
+}
+
+func (m *DeleteMacaroonIDRequest) Reset()                    { *m = DeleteMacaroonIDRequest{} }
+func (m *DeleteMacaroonIDRequest) String() string            { return proto.CompactTextString(m) }
+func (*DeleteMacaroonIDRequest) ProtoMessage()               {}
+func (*DeleteMacaroonIDRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{158} }
+
+func (m *DeleteMacaroonIDRequest) GetRootKeyId() uint64 {
+	if m != nil {
+		return m.RootKeyId
+	}
+	return 0
+}
+
+type DeleteMacaroonIDResponse struct {
+	// / Whether a root key of the given ID existed and was deleted.
+	Deleted bool `protobuf:"varint,1,opt,name=deleted" json:"deleted,omitempty"`
+}
+
+func (m *DeleteMacaroonIDResponse) Reset()                    { *m = DeleteMacaroonIDResponse{} }
+func (m *DeleteMacaroonIDResponse) String() string            { return proto.CompactTextString(m) }
+func (*DeleteMacaroonIDResponse) ProtoMessage()               {}
+func (*DeleteMacaroonIDResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{159} }
+
+func (m *DeleteMacaroonIDResponse) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*GenSeedRequest)(nil), "lnrpc.GenSeedRequest")
+	proto.RegisterType((*GenSeedResponse)(nil), "lnrpc.GenSeedResponse")
+	proto.RegisterType((*InitWalletRequest)(nil), "lnrpc.InitWalletRequest")
+	proto.RegisterType((*InitWalletResponse)(nil), "lnrpc.InitWalletResponse")
+	proto.RegisterType((*UnlockWalletRequest)(nil), "lnrpc.UnlockWalletRequest")
+	proto.RegisterType((*UnlockWalletResponse)(nil), "lnrpc.UnlockWalletResponse")
+	proto.RegisterType((*ChangePasswordRequest)(nil), "lnrpc.ChangePasswordRequest")
+	proto.RegisterType((*ChangePasswordResponse)(nil), "lnrpc.ChangePasswordResponse")
+	proto.RegisterType((*ChangeSeedPassphraseRequest)(nil), "lnrpc.ChangeSeedPassphraseRequest")
+	proto.RegisterType((*ChangeSeedPassphraseResponse)(nil), "lnrpc.ChangeSeedPassphraseResponse")
+	proto.RegisterType((*Transaction)(nil), "lnrpc.Transaction")
+	proto.RegisterType((*GetTransactionsRequest)(nil), "lnrpc.GetTransactionsRequest")
+	proto.RegisterType((*TransactionDetails)(nil), "lnrpc.TransactionDetails")
+	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "lnrpc.GetRecoveryInfoRequest")
+	proto.RegisterType((*RecoveryInfo)(nil), "lnrpc.RecoveryInfo")
+	proto.RegisterType((*FeeLimit)(nil), "lnrpc.FeeLimit")
+	proto.RegisterType((*SendRequest)(nil), "lnrpc.SendRequest")
+	proto.RegisterType((*SendResponse)(nil), "lnrpc.SendResponse")
+	proto.RegisterType((*SendToRouteRequest)(nil), "lnrpc.SendToRouteRequest")
+	proto.RegisterType((*ChannelPoint)(nil), "lnrpc.ChannelPoint")
+	proto.RegisterType((*OutPoint)(nil), "lnrpc.OutPoint")
+	proto.RegisterType((*LightningAddress)(nil), "lnrpc.LightningAddress")
+	proto.RegisterType((*SendManyRequest)(nil), "lnrpc.SendManyRequest")
+	proto.RegisterType((*SendManyResponse)(nil), "lnrpc.SendManyResponse")
+	proto.RegisterType((*EstimateFeeRequest)(nil), "lnrpc.EstimateFeeRequest")
+	proto.RegisterType((*FeeEstimate)(nil), "lnrpc.FeeEstimate")
+	proto.RegisterType((*EstimateFeeResponse)(nil), "lnrpc.EstimateFeeResponse")
+	proto.RegisterType((*LeaseOutputRequest)(nil), "lnrpc.LeaseOutputRequest")
+	proto.RegisterType((*LeaseOutputResponse)(nil), "lnrpc.LeaseOutputResponse")
+	proto.RegisterType((*ReleaseOutputRequest)(nil), "lnrpc.ReleaseOutputRequest")
+	proto.RegisterType((*ReleaseOutputResponse)(nil), "lnrpc.ReleaseOutputResponse")
+	proto.RegisterType((*ListLeasesRequest)(nil), "lnrpc.ListLeasesRequest")
+	proto.RegisterType((*UtxoLease)(nil), "lnrpc.UtxoLease")
+	proto.RegisterType((*ListLeasesResponse)(nil), "lnrpc.ListLeasesResponse")
+	proto.RegisterType((*SendCoinsRequest)(nil), "lnrpc.SendCoinsRequest")
+	proto.RegisterType((*SendCoinsResponse)(nil), "lnrpc.SendCoinsResponse")
+	proto.RegisterType((*NewAddressRequest)(nil), "lnrpc.NewAddressRequest")
+	proto.RegisterType((*NewWitnessAddressRequest)(nil), "lnrpc.NewWitnessAddressRequest")
+	proto.RegisterType((*NewAddressResponse)(nil), "lnrpc.NewAddressResponse")
+	proto.RegisterType((*SignMessageRequest)(nil), "lnrpc.SignMessageRequest")
+	proto.RegisterType((*SignMessageResponse)(nil), "lnrpc.SignMessageResponse")
+	proto.RegisterType((*VerifyMessageRequest)(nil), "lnrpc.VerifyMessageRequest")
+	proto.RegisterType((*VerifyMessageResponse)(nil), "lnrpc.VerifyMessageResponse")
+	proto.RegisterType((*ConnectPeerRequest)(nil), "lnrpc.ConnectPeerRequest")
+	proto.RegisterType((*ConnectPeerResponse)(nil), "lnrpc.ConnectPeerResponse")
+	proto.RegisterType((*DisconnectPeerRequest)(nil), "lnrpc.DisconnectPeerRequest")
+	proto.RegisterType((*DisconnectPeerResponse)(nil), "lnrpc.DisconnectPeerResponse")
+	proto.RegisterType((*HTLC)(nil), "lnrpc.HTLC")
+	proto.RegisterType((*Channel)(nil), "lnrpc.Channel")
+	proto.RegisterType((*ListChannelsRequest)(nil), "lnrpc.ListChannelsRequest")
+	proto.RegisterType((*ListChannelsResponse)(nil), "lnrpc.ListChannelsResponse")
+	proto.RegisterType((*ChannelCloseSummary)(nil), "lnrpc.ChannelCloseSummary")
+	proto.RegisterType((*ClosedChannelsRequest)(nil), "lnrpc.ClosedChannelsRequest")
+	proto.RegisterType((*ClosedChannelsResponse)(nil), "lnrpc.ClosedChannelsResponse")
+	proto.RegisterType((*Peer)(nil), "lnrpc.Peer")
+	proto.RegisterType((*MessageTypeStats)(nil), "lnrpc.MessageTypeStats")
+	proto.RegisterType((*ConnectionStats)(nil), "lnrpc.ConnectionStats")
+	proto.RegisterType((*ListPeersRequest)(nil), "lnrpc.ListPeersRequest")
+	proto.RegisterType((*ListPeersResponse)(nil), "lnrpc.ListPeersResponse")
+	proto.RegisterType((*ListPeerAddressesRequest)(nil), "lnrpc.ListPeerAddressesRequest")
+	proto.RegisterType((*PeerAddress)(nil), "lnrpc.PeerAddress")
+	proto.RegisterType((*PeerAddressBook)(nil), "lnrpc.PeerAddressBook")
+	proto.RegisterType((*ListPeerAddressesResponse)(nil), "lnrpc.ListPeerAddressesResponse")
+	proto.RegisterType((*GetInfoRequest)(nil), "lnrpc.GetInfoRequest")
+	proto.RegisterType((*GetInfoResponse)(nil), "lnrpc.GetInfoResponse")
+	proto.RegisterType((*ConfirmationUpdate)(nil), "lnrpc.ConfirmationUpdate")
+	proto.RegisterType((*ChannelOpenUpdate)(nil), "lnrpc.ChannelOpenUpdate")
+	proto.RegisterType((*ChannelCloseUpdate)(nil), "lnrpc.ChannelCloseUpdate")
+	proto.RegisterType((*CloseChannelRequest)(nil), "lnrpc.CloseChannelRequest")
+	proto.RegisterType((*CloseStatusUpdate)(nil), "lnrpc.CloseStatusUpdate")
+	proto.RegisterType((*PendingUpdate)(nil), "lnrpc.PendingUpdate")
+	proto.RegisterType((*OpenChannelRequest)(nil), "lnrpc.OpenChannelRequest")
+	proto.RegisterType((*OpenStatusUpdate)(nil), "lnrpc.OpenStatusUpdate")
+	proto.RegisterType((*PendingHTLC)(nil), "lnrpc.PendingHTLC")
+	proto.RegisterType((*PendingChannelsRequest)(nil), "lnrpc.PendingChannelsRequest")
+	proto.RegisterType((*PendingChannelsResponse)(nil), "lnrpc.PendingChannelsResponse")
+	proto.RegisterType((*PendingChannelsResponse_PendingChannel)(nil), "lnrpc.PendingChannelsResponse.PendingChannel")
+	proto.RegisterType((*PendingChannelsResponse_PendingOpenChannel)(nil), "lnrpc.PendingChannelsResponse.PendingOpenChannel")
+	proto.RegisterType((*PendingChannelsResponse_WaitingCloseChannel)(nil), "lnrpc.PendingChannelsResponse.WaitingCloseChannel")
+	proto.RegisterType((*PendingChannelsResponse_ClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ClosedChannel")
+	proto.RegisterType((*PendingChannelsResponse_ForceClosedChannel)(nil), "lnrpc.PendingChannelsResponse.ForceClosedChannel")
+	proto.RegisterType((*WalletBalanceRequest)(nil), "lnrpc.WalletBalanceRequest")
+	proto.RegisterType((*WalletBalanceResponse)(nil), "lnrpc.WalletBalanceResponse")
+	proto.RegisterType((*ChannelBalanceRequest)(nil), "lnrpc.ChannelBalanceRequest")
+	proto.RegisterType((*ChannelBalanceResponse)(nil), "lnrpc.ChannelBalanceResponse")
+	proto.RegisterType((*QueryRoutesRequest)(nil), "lnrpc.QueryRoutesRequest")
+	proto.RegisterType((*QueryRoutesResponse)(nil), "lnrpc.QueryRoutesResponse")
+	proto.RegisterType((*Hop)(nil), "lnrpc.Hop")
+	proto.RegisterType((*Route)(nil), "lnrpc.Route")
+	proto.RegisterType((*NodeInfoRequest)(nil), "lnrpc.NodeInfoRequest")
+	proto.RegisterType((*NodeInfo)(nil), "lnrpc.NodeInfo")
+	proto.RegisterType((*LightningNode)(nil), "lnrpc.LightningNode")
+	proto.RegisterType((*NodeAddress)(nil), "lnrpc.NodeAddress")
+	proto.RegisterType((*RoutingPolicy)(nil), "lnrpc.RoutingPolicy")
+	proto.RegisterType((*ChannelEdge)(nil), "lnrpc.ChannelEdge")
+	proto.RegisterType((*ChannelGraphRequest)(nil), "lnrpc.ChannelGraphRequest")
+	proto.RegisterType((*ChannelGraph)(nil), "lnrpc.ChannelGraph")
+	proto.RegisterType((*ChanInfoRequest)(nil), "lnrpc.ChanInfoRequest")
+	proto.RegisterType((*NetworkInfoRequest)(nil), "lnrpc.NetworkInfoRequest")
+	proto.RegisterType((*NetworkInfo)(nil), "lnrpc.NetworkInfo")
+	proto.RegisterType((*StopRequest)(nil), "lnrpc.StopRequest")
+	proto.RegisterType((*StopResponse)(nil), "lnrpc.StopResponse")
+	proto.RegisterType((*GraphTopologySubscription)(nil), "lnrpc.GraphTopologySubscription")
+	proto.RegisterType((*GraphTopologyUpdate)(nil), "lnrpc.GraphTopologyUpdate")
+	proto.RegisterType((*NodeUpdate)(nil), "lnrpc.NodeUpdate")
+	proto.RegisterType((*ChannelEdgeUpdate)(nil), "lnrpc.ChannelEdgeUpdate")
+	proto.RegisterType((*ClosedChannelUpdate)(nil), "lnrpc.ClosedChannelUpdate")
+	proto.RegisterType((*HopHint)(nil), "lnrpc.HopHint")
+	proto.RegisterType((*RouteHint)(nil), "lnrpc.RouteHint")
+	proto.RegisterType((*Invoice)(nil), "lnrpc.Invoice")
+	proto.RegisterType((*AddInvoiceResponse)(nil), "lnrpc.AddInvoiceResponse")
+	proto.RegisterType((*PaymentHash)(nil), "lnrpc.PaymentHash")
+	proto.RegisterType((*ListInvoiceRequest)(nil), "lnrpc.ListInvoiceRequest")
+	proto.RegisterType((*ListInvoiceResponse)(nil), "lnrpc.ListInvoiceResponse")
+	proto.RegisterType((*InvoiceSubscription)(nil), "lnrpc.InvoiceSubscription")
+	proto.RegisterType((*Payment)(nil), "lnrpc.Payment")
+	proto.RegisterType((*ListPaymentsRequest)(nil), "lnrpc.ListPaymentsRequest")
+	proto.RegisterType((*ListPaymentsResponse)(nil), "lnrpc.ListPaymentsResponse")
+	proto.RegisterType((*DeleteAllPaymentsRequest)(nil), "lnrpc.DeleteAllPaymentsRequest")
+	proto.RegisterType((*DeleteAllPaymentsResponse)(nil), "lnrpc.DeleteAllPaymentsResponse")
+	proto.RegisterType((*DebugLevelRequest)(nil), "lnrpc.DebugLevelRequest")
+	proto.RegisterType((*DebugLevelResponse)(nil), "lnrpc.DebugLevelResponse")
+	proto.RegisterType((*PayReqString)(nil), "lnrpc.PayReqString")
+	proto.RegisterType((*PayReq)(nil), "lnrpc.PayReq")
+	proto.RegisterType((*FeeReportRequest)(nil), "lnrpc.FeeReportRequest")
+	proto.RegisterType((*ChannelFeeReport)(nil), "lnrpc.ChannelFeeReport")
+	proto.RegisterType((*FeeReportResponse)(nil), "lnrpc.FeeReportResponse")
+	proto.RegisterType((*PolicyUpdateRequest)(nil), "lnrpc.PolicyUpdateRequest")
+	proto.RegisterType((*PolicyUpdateResponse)(nil), "lnrpc.PolicyUpdateResponse")
+	proto.RegisterType((*ForwardingHistoryRequest)(nil), "lnrpc.ForwardingHistoryRequest")
+	proto.RegisterType((*ForwardingEvent)(nil), "lnrpc.ForwardingEvent")
+	proto.RegisterType((*ForwardingHistoryResponse)(nil), "lnrpc.ForwardingHistoryResponse")
+	proto.RegisterType((*RebalanceRequest)(nil), "lnrpc.RebalanceRequest")
+	proto.RegisterType((*RebalanceResponse)(nil), "lnrpc.RebalanceResponse")
+	proto.RegisterType((*TrackPaymentRequest)(nil), "lnrpc.TrackPaymentRequest")
+	proto.RegisterType((*PaymentAttempt)(nil), "lnrpc.PaymentAttempt")
+	proto.RegisterType((*PaymentUpdate)(nil), "lnrpc.PaymentUpdate")
+	proto.RegisterType((*SubscribeHtlcEventsRequest)(nil), "lnrpc.SubscribeHtlcEventsRequest")
+	proto.RegisterType((*HtlcEvent)(nil), "lnrpc.HtlcEvent")
+	proto.RegisterType((*CircuitKey)(nil), "lnrpc.CircuitKey")
+	proto.RegisterType((*ForwardHtlcInterceptRequest)(nil), "lnrpc.ForwardHtlcInterceptRequest")
+	proto.RegisterType((*ForwardHtlcInterceptResponse)(nil), "lnrpc.ForwardHtlcInterceptResponse")
+	proto.RegisterType((*ChannelAcceptRequest)(nil), "lnrpc.ChannelAcceptRequest")
+	proto.RegisterType((*ChannelAcceptResponse)(nil), "lnrpc.ChannelAcceptResponse")
+	proto.RegisterType((*PendingSweepsRequest)(nil), "lnrpc.PendingSweepsRequest")
+	proto.RegisterType((*PendingSweep)(nil), "lnrpc.PendingSweep")
+	proto.RegisterType((*PendingSweepsResponse)(nil), "lnrpc.PendingSweepsResponse")
+	proto.RegisterType((*SweepOutputsRequest)(nil), "lnrpc.SweepOutputsRequest")
+	proto.RegisterType((*SweepOutputsResponse)(nil), "lnrpc.SweepOutputsResponse")
+	proto.RegisterType((*BumpFeeRequest)(nil), "lnrpc.BumpFeeRequest")
+	proto.RegisterType((*BumpFeeResponse)(nil), "lnrpc.BumpFeeResponse")
+	proto.RegisterType((*AutopilotStatusRequest)(nil), "lnrpc.AutopilotStatusRequest")
+	proto.RegisterType((*AutopilotProposal)(nil), "lnrpc.AutopilotProposal")
+	proto.RegisterType((*AutopilotStatusResponse)(nil), "lnrpc.AutopilotStatusResponse")
+	proto.RegisterType((*ModifyAutopilotStatusRequest)(nil), "lnrpc.ModifyAutopilotStatusRequest")
+	proto.RegisterType((*ModifyAutopilotStatusResponse)(nil), "lnrpc.ModifyAutopilotStatusResponse")
+	proto.RegisterType((*SetAutopilotScoresRequest)(nil), "lnrpc.SetAutopilotScoresRequest")
+	proto.RegisterType((*SetAutopilotScoresResponse)(nil), "lnrpc.SetAutopilotScoresResponse")
+	proto.RegisterType((*MacaroonPermission)(nil), "lnrpc.MacaroonPermission")
+	proto.RegisterType((*BakeMacaroonRequest)(nil), "lnrpc.BakeMacaroonRequest")
+	proto.RegisterType((*BakeMacaroonResponse)(nil), "lnrpc.BakeMacaroonResponse")
+	proto.RegisterType((*ListMacaroonIDsRequest)(nil), "lnrpc.ListMacaroonIDsRequest")
+	proto.RegisterType((*ListMacaroonIDsResponse)(nil), "lnrpc.ListMacaroonIDsResponse")
+	proto.RegisterType((*DeleteMacaroonIDRequest)(nil), "lnrpc.DeleteMacaroonIDRequest")
+	proto.RegisterType((*DeleteMacaroonIDResponse)(nil), "lnrpc.DeleteMacaroonIDResponse")
+	proto.RegisterEnum("lnrpc.CoinSelectionStrategy", CoinSelectionStrategy_name, CoinSelectionStrategy_value)
+	proto.RegisterEnum("lnrpc.NewAddressRequest_AddressType", NewAddressRequest_AddressType_name, NewAddressRequest_AddressType_value)
+	proto.RegisterEnum("lnrpc.ChannelCloseSummary_ClosureType", ChannelCloseSummary_ClosureType_name, ChannelCloseSummary_ClosureType_value)
+	proto.RegisterEnum("lnrpc.Peer_SyncType", Peer_SyncType_name, Peer_SyncType_value)
+	proto.RegisterEnum("lnrpc.PaymentAttempt_AttemptState", PaymentAttempt_AttemptState_name, PaymentAttempt_AttemptState_value)
+	proto.RegisterEnum("lnrpc.PaymentUpdate_PaymentState", PaymentUpdate_PaymentState_name, PaymentUpdate_PaymentState_value)
+	proto.RegisterEnum("lnrpc.HtlcEvent_EventType", HtlcEvent_EventType_name, HtlcEvent_EventType_value)
+	proto.RegisterEnum("lnrpc.ForwardHtlcInterceptResponse_ResolveAction", ForwardHtlcInterceptResponse_ResolveAction_name, ForwardHtlcInterceptResponse_ResolveAction_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// Client API for WalletUnlocker service
+
+type WalletUnlockerClient interface {
+	// *
+	// GenSeed is the first method that should be used to instantiate a new lnd
+	// instance. This method allows a caller to generate a new aezeed cipher seed
+	// given an optional passphrase. If provided, the passphrase will be necessary
+	// to decrypt the cipherseed to expose the internal wallet seed.
+	//
+	// Once the cipherseed is obtained and verified by the user, the InitWallet
+	// method should be used to commit the newly generated seed, and create the
+	// wallet.
+	GenSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error)
+	// *
+	// InitWallet is used when lnd is starting up for the first time to fully
+	// initialize the daemon and its internal wallet. At the very least a wallet
+	// password must be provided. This will be used to encrypt sensitive material
+	// on disk.
+	//
+	// In the case of a recovery scenario, the user can also specify their aezeed
+	// mnemonic and passphrase. If set, then the daemon will use this prior state
+	// to initialize its internal wallet.
+	//
+	// Alternatively, this can be used along with the GenSeed RPC to obtain a
+	// seed, then present it to the user. Once it has been verified by the user,
+	// the seed can be fed into this RPC in order to commit the new wallet.
+	InitWallet(ctx context.Context, in *InitWalletRequest, opts ...grpc.CallOption) (*InitWalletResponse, error)
+	// * lncli: `unlock`
+	// UnlockWallet is used at startup of lnd to provide a password to unlock
+	// the wallet database.
+	UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error)
+	// * lncli: `changepassword`
+	// ChangePassword changes the password of the encrypted wallet. This will
+	// automatically unlock the wallet database if successful.
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	// * lncli: `changeseedpassphrase`
+	// ChangeSeedPassphrase re-enciphers an aezeed cipher seed mnemonic under a
+	// new passphrase. The seed itself, and therefore the wallet derived from it,
+	// remains unchanged, so the new mnemonic can be used to restore the same
+	// wallet. No wallet needs to exist to call this method.
+	ChangeSeedPassphrase(ctx context.Context, in *ChangeSeedPassphraseRequest, opts ...grpc.CallOption) (*ChangeSeedPassphraseResponse, error)
+}
+
+type walletUnlockerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWalletUnlockerClient(cc *grpc.ClientConn) WalletUnlockerClient {
+	return &walletUnlockerClient{cc}
+}
+
+func (c *walletUnlockerClient) GenSeed(ctx context.Context, in *GenSeedRequest, opts ...grpc.CallOption) (*GenSeedResponse, error) {
+	out := new(GenSeedResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/GenSeed", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletUnlockerClient) InitWallet(ctx context.Context, in *InitWalletRequest, opts ...grpc.CallOption) (*InitWalletResponse, error) {
+	out := new(InitWalletResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/InitWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletUnlockerClient) UnlockWallet(ctx context.Context, in *UnlockWalletRequest, opts ...grpc.CallOption) (*UnlockWalletResponse, error) {
+	out := new(UnlockWalletResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/UnlockWallet", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletUnlockerClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	out := new(ChangePasswordResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/ChangePassword", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *walletUnlockerClient) ChangeSeedPassphrase(ctx context.Context, in *ChangeSeedPassphraseRequest, opts ...grpc.CallOption) (*ChangeSeedPassphraseResponse, error) {
+	out := new(ChangeSeedPassphraseResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.WalletUnlocker/ChangeSeedPassphrase", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Server API for WalletUnlocker service
+
+type WalletUnlockerServer interface {
+	// *
+	// GenSeed is the first method that should be used to instantiate a new lnd
 	// instance. This method allows a caller to generate a new aezeed cipher seed
 	// given an optional passphrase. If provided, the passphrase will be necessary
 	// to decrypt the cipherseed to expose the internal wallet seed.
@@ -5100,6 +7489,12 @@ type WalletUnlockerServer interface {
 	// ChangePassword changes the password of the encrypted wallet. This will
 	// automatically unlock the wallet database if successful.
 	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	// * lncli: `changeseedpassphrase`
+	// ChangeSeedPassphrase re-enciphers an aezeed cipher seed mnemonic under a
+	// new passphrase. The seed itself, and therefore the wallet derived from it,
+	// remains unchanged, so the new mnemonic can be used to restore the same
+	// wallet. No wallet needs to exist to call this method.
+	ChangeSeedPassphrase(context.Context, *ChangeSeedPassphraseRequest) (*ChangeSeedPassphraseResponse, error)
 }
 
 func RegisterWalletUnlockerServer(s *grpc.Server, srv WalletUnlockerServer) {
@@ -5178,6 +7573,24 @@ func _WalletUnlocker_ChangePassword_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WalletUnlocker_ChangeSeedPassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeSeedPassphraseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WalletUnlockerServer).ChangeSeedPassphrase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.WalletUnlocker/ChangeSeedPassphrase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WalletUnlockerServer).ChangeSeedPassphrase(ctx, req.(*ChangeSeedPassphraseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _WalletUnlocker_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lnrpc.WalletUnlocker",
 	HandlerType: (*WalletUnlockerServer)(nil),
@@ -5198,6 +7611,10 @@ var _WalletUnlocker_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ChangePassword",
 			Handler:    _WalletUnlocker_ChangePassword_Handler,
 		},
+		{
+			MethodName: "ChangeSeedPassphrase",
+			Handler:    _WalletUnlocker_ChangeSeedPassphrase_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc.proto",
@@ -5231,12 +7648,40 @@ type LightningClient interface {
 	// the client in which any newly discovered transactions relevant to the
 	// wallet are sent over.
 	SubscribeTransactions(ctx context.Context, in *GetTransactionsRequest, opts ...grpc.CallOption) (Lightning_SubscribeTransactionsClient, error)
+	// * lncli: `getrecoveryinfo`
+	// GetRecoveryInfo returns a stream of updates on the progress of the
+	// wallet's rescan for the funds of a restored seed. An update is sent each
+	// time the rescan advances, and the stream is closed once the rescan has
+	// finished. If the wallet wasn't started in recovery mode, then a single
+	// update reporting so is sent.
+	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (Lightning_GetRecoveryInfoClient, error)
 	// * lncli: `sendmany`
 	// SendMany handles a request for a transaction that creates multiple specified
 	// outputs in parallel. If neither target_conf, or sat_per_byte are set, then
 	// the internal wallet will consult its fee model to determine a fee for the
 	// default confirmation target.
 	SendMany(ctx context.Context, in *SendManyRequest, opts ...grpc.CallOption) (*SendManyResponse, error)
+	// * lncli: `estimatefee`
+	// EstimateFee returns the fee rates the wallet's fee model currently
+	// estimates for each of the requested confirmation targets. These are the
+	// same fee rates used when opening channels and proposing commitment fee
+	// updates.
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
+	// * lncli: `leaseoutput`
+	// LeaseOutput locks an output of the wallet under the given lock ID for the
+	// given duration, excluding it from coin selection until the lease is either
+	// released or expires. Leases are persisted, so they survive restarts.
+	// Leasing an output that is already leased under the same ID extends the
+	// lease.
+	LeaseOutput(ctx context.Context, in *LeaseOutputRequest, opts ...grpc.CallOption) (*LeaseOutputResponse, error)
+	// * lncli: `releaseoutput`
+	// ReleaseOutput releases the lease on an output, making it eligible for coin
+	// selection once again. The lock ID must match the one the output was leased
+	// under.
+	ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error)
+	// * lncli: `listleases`
+	// ListLeases lists all outputs of the wallet that are currently leased.
+	ListLeases(ctx context.Context, in *ListLeasesRequest, opts ...grpc.CallOption) (*ListLeasesResponse, error)
 	// * lncli: `newaddress`
 	// NewAddress creates a new address under control of the local wallet.
 	NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error)
@@ -5267,6 +7712,12 @@ type LightningClient interface {
 	// * lncli: `listpeers`
 	// ListPeers returns a verbose listing of all currently active peers.
 	ListPeers(ctx context.Context, in *ListPeersRequest, opts ...grpc.CallOption) (*ListPeersResponse, error)
+	// * lncli: `listpeeraddresses`
+	// ListPeerAddresses returns the address book of each persistent peer we've
+	// attempted to connect to, recording the outcome of the connection attempts
+	// made to each of its addresses, along with the backoff used to reconnect to
+	// the peer.
+	ListPeerAddresses(ctx context.Context, in *ListPeerAddressesRequest, opts ...grpc.CallOption) (*ListPeerAddressesResponse, error)
 	// * lncli: `getinfo`
 	// GetInfo returns general information concerning the lightning node including
 	// it's identity pubkey, alias, the chains it is connected to, and information
@@ -5440,6 +7891,90 @@ type LightningClient interface {
 	// the index offset of the last entry. The index offset can be provided to the
 	// request to allow the caller to skip a series of records.
 	ForwardingHistory(ctx context.Context, in *ForwardingHistoryRequest, opts ...grpc.CallOption) (*ForwardingHistoryResponse, error)
+	// * lncli: `rebalance`
+	// Rebalance moves funds from one of our channels to another by sending a
+	// circular payment to ourselves. The payment leaves through the outgoing
+	// channel, and returns through the incoming channel. The completed rebalance
+	// is recorded within the forwarding log.
+	Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceResponse, error)
+	// * lncli: `trackpayment`
+	// TrackPayment returns the full history of an outgoing payment, including
+	// every attempt made to complete it. If the payment is still in flight, an
+	// updated history is sent each time it progresses, and the stream is closed
+	// once the payment either succeeds or fails. As the history is persisted,
+	// payments initiated before a restart can be tracked as well.
+	TrackPayment(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Lightning_TrackPaymentClient, error)
+	// * lncli: `subscribehtlcevents`
+	// SubscribeHtlcEvents creates a uni-directional stream from the server to
+	// the client in which every HTLC event observed by the switch is sent. This
+	// covers HTLCs as they are forwarded, added to their outgoing channel,
+	// queued in the overflow queue, dropped after their deadline, settled and
+	// failed.
+	SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Lightning_SubscribeHtlcEventsClient, error)
+	// *
+	// HtlcInterceptor dispatches a bi-directional streaming RPC in which every
+	// HTLC forwarded by the switch is held and sent to the client, which then
+	// decides whether the HTLC is resumed, failed or settled. Only a single
+	// interceptor can be active at a time. Forwards that aren't resolved before
+	// their deadline, or are still held once the stream is closed, are failed
+	// back to their source.
+	HtlcInterceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_HtlcInterceptorClient, error)
+	// *
+	// ChannelAcceptor dispatches a bi-directional streaming RPC in which every
+	// inbound channel request that satisfies the configured acceptor rules is
+	// sent to the client, which then decides whether the channel is accepted.
+	// Channel requests that aren't decided on before the configured timeout,
+	// or are still undecided once the stream is closed, are rejected.
+	ChannelAcceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_ChannelAcceptorClient, error)
+	// * lncli: `pendingsweeps`
+	// PendingSweeps returns all outputs which are waiting to be swept back into
+	// the wallet by the sweeper, along with the state of the sweep transactions
+	// spending them.
+	PendingSweeps(ctx context.Context, in *PendingSweepsRequest, opts ...grpc.CallOption) (*PendingSweepsResponse, error)
+	// * lncli: `sweepoutputs`
+	// SweepOutputs immediately sweeps all outputs which are waiting to be swept,
+	// targeting confirmation within the given number of blocks. Outputs which
+	// already are part of a sweep transaction have their fee bumped if the target
+	// requires a higher fee.
+	SweepOutputs(ctx context.Context, in *SweepOutputsRequest, opts ...grpc.CallOption) (*SweepOutputsResponse, error)
+	// * lncli: `bumpfee`
+	// BumpFee bumps the fee of the unconfirmed transaction creating the given
+	// output. If the output is waiting to be swept, its sweep transaction is
+	// replaced by one paying a higher fee (RBF). Otherwise, if the output is an
+	// unconfirmed p2wkh output of the wallet, it is spent by a child transaction
+	// paying for its parent (CPFP). Transactions funded by the wallet itself are
+	// never replaced, as they may fund channels whose outpoints must not change,
+	// so their fee is bumped through CPFP of their change output instead. As
+	// the fee of the parent must be known, all outputs it spends must belong to
+	// the wallet. The output is leased while the child is pending.
+	BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error)
+	// * lncli: `autopilotstatus`
+	// AutopilotStatus returns whether the autopilot agent is active, along with
+	// its current state and the channel attachments it proposes to make.
+	AutopilotStatus(ctx context.Context, in *AutopilotStatusRequest, opts ...grpc.CallOption) (*AutopilotStatusResponse, error)
+	// * lncli: `setautopilot`
+	// ModifyAutopilotStatus enables or disables the autopilot agent at runtime.
+	ModifyAutopilotStatus(ctx context.Context, in *ModifyAutopilotStatusRequest, opts ...grpc.CallOption) (*ModifyAutopilotStatusResponse, error)
+	// * lncli: `setautopilotscores`
+	// SetAutopilotScores replaces the node scores used by the externalscore
+	// autopilot heuristic, allowing an external process to decide which nodes
+	// the agent opens channels to.
+	SetAutopilotScores(ctx context.Context, in *SetAutopilotScoresRequest, opts ...grpc.CallOption) (*SetAutopilotScoresResponse, error)
+	// * lncli: `bakemacaroon`
+	// BakeMacaroon allows the creation of a new macaroon with custom permissions,
+	// scoped to any of the entity/action pairs known to the RPC server. The
+	// macaroon is baked with the root key of the given ID, such that it can be
+	// revoked along with all other macaroons sharing the root key.
+	BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error)
+	// * lncli: `listmacaroonids`
+	// ListMacaroonIDs returns the IDs of all root keys macaroons have been baked
+	// with.
+	ListMacaroonIDs(ctx context.Context, in *ListMacaroonIDsRequest, opts ...grpc.CallOption) (*ListMacaroonIDsResponse, error)
+	// * lncli: `deletemacaroonid`
+	// DeleteMacaroonID deletes the root key of the given ID, revoking all
+	// macaroons that were baked with it. The default root key, used for the
+	// macaroons lnd creates on startup, can't be deleted.
+	DeleteMacaroonID(ctx context.Context, in *DeleteMacaroonIDRequest, opts ...grpc.CallOption) (*DeleteMacaroonIDResponse, error)
 }
 
 type lightningClient struct {
@@ -5491,7 +8026,39 @@ func (c *lightningClient) SubscribeTransactions(ctx context.Context, in *GetTran
 	if err != nil {
 		return nil, err
 	}
-	x := &lightningSubscribeTransactionsClient{stream}
+	x := &lightningSubscribeTransactionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeTransactionsClient interface {
+	Recv() (*Transaction, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeTransactionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeTransactionsClient) Recv() (*Transaction, error) {
+	m := new(Transaction)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (Lightning_GetRecoveryInfoClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[1], c.cc, "/lnrpc.Lightning/GetRecoveryInfo", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningGetRecoveryInfoClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -5501,17 +8068,17 @@ func (c *lightningClient) SubscribeTransactions(ctx context.Context, in *GetTran
 	return x, nil
 }
 
-type Lightning_SubscribeTransactionsClient interface {
-	Recv() (*Transaction, error)
+type Lightning_GetRecoveryInfoClient interface {
+	Recv() (*RecoveryInfo, error)
 	grpc.ClientStream
 }
 
-type lightningSubscribeTransactionsClient struct {
+type lightningGetRecoveryInfoClient struct {
 	grpc.ClientStream
 }
 
-func (x *lightningSubscribeTransactionsClient) Recv() (*Transaction, error) {
-	m := new(Transaction)
+func (x *lightningGetRecoveryInfoClient) Recv() (*RecoveryInfo, error) {
+	m := new(RecoveryInfo)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
@@ -5527,6 +8094,42 @@ func (c *lightningClient) SendMany(ctx context.Context, in *SendManyRequest, opt
 	return out, nil
 }
 
+func (c *lightningClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/EstimateFee", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) LeaseOutput(ctx context.Context, in *LeaseOutputRequest, opts ...grpc.CallOption) (*LeaseOutputResponse, error) {
+	out := new(LeaseOutputResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/LeaseOutput", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ReleaseOutput(ctx context.Context, in *ReleaseOutputRequest, opts ...grpc.CallOption) (*ReleaseOutputResponse, error) {
+	out := new(ReleaseOutputResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ReleaseOutput", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListLeases(ctx context.Context, in *ListLeasesRequest, opts ...grpc.CallOption) (*ListLeasesResponse, error) {
+	out := new(ListLeasesResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListLeases", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) NewAddress(ctx context.Context, in *NewAddressRequest, opts ...grpc.CallOption) (*NewAddressResponse, error) {
 	out := new(NewAddressResponse)
 	err := grpc.Invoke(ctx, "/lnrpc.Lightning/NewAddress", in, out, c.cc, opts...)
@@ -5590,6 +8193,15 @@ func (c *lightningClient) ListPeers(ctx context.Context, in *ListPeersRequest, o
 	return out, nil
 }
 
+func (c *lightningClient) ListPeerAddresses(ctx context.Context, in *ListPeerAddressesRequest, opts ...grpc.CallOption) (*ListPeerAddressesResponse, error) {
+	out := new(ListPeerAddressesResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListPeerAddresses", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *lightningClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
 	out := new(GetInfoResponse)
 	err := grpc.Invoke(ctx, "/lnrpc.Lightning/GetInfo", in, out, c.cc, opts...)
@@ -5636,7 +8248,7 @@ func (c *lightningClient) OpenChannelSync(ctx context.Context, in *OpenChannelRe
 }
 
 func (c *lightningClient) OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (Lightning_OpenChannelClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[1], c.cc, "/lnrpc.Lightning/OpenChannel", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[2], c.cc, "/lnrpc.Lightning/OpenChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5668,7 +8280,7 @@ func (x *lightningOpenChannelClient) Recv() (*OpenStatusUpdate, error) {
 }
 
 func (c *lightningClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (Lightning_CloseChannelClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[2], c.cc, "/lnrpc.Lightning/CloseChannel", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[3], c.cc, "/lnrpc.Lightning/CloseChannel", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5700,7 +8312,7 @@ func (x *lightningCloseChannelClient) Recv() (*CloseStatusUpdate, error) {
 }
 
 func (c *lightningClient) SendPayment(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendPaymentClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[3], c.cc, "/lnrpc.Lightning/SendPayment", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[4], c.cc, "/lnrpc.Lightning/SendPayment", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5740,7 +8352,7 @@ func (c *lightningClient) SendPaymentSync(ctx context.Context, in *SendRequest,
 }
 
 func (c *lightningClient) SendToRoute(ctx context.Context, opts ...grpc.CallOption) (Lightning_SendToRouteClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[4], c.cc, "/lnrpc.Lightning/SendToRoute", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[5], c.cc, "/lnrpc.Lightning/SendToRoute", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5807,7 +8419,7 @@ func (c *lightningClient) LookupInvoice(ctx context.Context, in *PaymentHash, op
 }
 
 func (c *lightningClient) SubscribeInvoices(ctx context.Context, in *InvoiceSubscription, opts ...grpc.CallOption) (Lightning_SubscribeInvoicesClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[5], c.cc, "/lnrpc.Lightning/SubscribeInvoices", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[6], c.cc, "/lnrpc.Lightning/SubscribeInvoices", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5920,7 +8532,7 @@ func (c *lightningClient) StopDaemon(ctx context.Context, in *StopRequest, opts
 }
 
 func (c *lightningClient) SubscribeChannelGraph(ctx context.Context, in *GraphTopologySubscription, opts ...grpc.CallOption) (Lightning_SubscribeChannelGraphClient, error) {
-	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[6], c.cc, "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[7], c.cc, "/lnrpc.Lightning/SubscribeChannelGraph", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5987,6 +8599,222 @@ func (c *lightningClient) ForwardingHistory(ctx context.Context, in *ForwardingH
 	return out, nil
 }
 
+func (c *lightningClient) Rebalance(ctx context.Context, in *RebalanceRequest, opts ...grpc.CallOption) (*RebalanceResponse, error) {
+	out := new(RebalanceResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/Rebalance", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) TrackPayment(ctx context.Context, in *TrackPaymentRequest, opts ...grpc.CallOption) (Lightning_TrackPaymentClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[8], c.cc, "/lnrpc.Lightning/TrackPayment", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningTrackPaymentClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_TrackPaymentClient interface {
+	Recv() (*PaymentUpdate, error)
+	grpc.ClientStream
+}
+
+type lightningTrackPaymentClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningTrackPaymentClient) Recv() (*PaymentUpdate, error) {
+	m := new(PaymentUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) SubscribeHtlcEvents(ctx context.Context, in *SubscribeHtlcEventsRequest, opts ...grpc.CallOption) (Lightning_SubscribeHtlcEventsClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[9], c.cc, "/lnrpc.Lightning/SubscribeHtlcEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningSubscribeHtlcEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Lightning_SubscribeHtlcEventsClient interface {
+	Recv() (*HtlcEvent, error)
+	grpc.ClientStream
+}
+
+type lightningSubscribeHtlcEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningSubscribeHtlcEventsClient) Recv() (*HtlcEvent, error) {
+	m := new(HtlcEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) HtlcInterceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_HtlcInterceptorClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[10], c.cc, "/lnrpc.Lightning/HtlcInterceptor", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningHtlcInterceptorClient{stream}
+	return x, nil
+}
+
+type Lightning_HtlcInterceptorClient interface {
+	Send(*ForwardHtlcInterceptResponse) error
+	Recv() (*ForwardHtlcInterceptRequest, error)
+	grpc.ClientStream
+}
+
+type lightningHtlcInterceptorClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningHtlcInterceptorClient) Send(m *ForwardHtlcInterceptResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningHtlcInterceptorClient) Recv() (*ForwardHtlcInterceptRequest, error) {
+	m := new(ForwardHtlcInterceptRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) ChannelAcceptor(ctx context.Context, opts ...grpc.CallOption) (Lightning_ChannelAcceptorClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Lightning_serviceDesc.Streams[11], c.cc, "/lnrpc.Lightning/ChannelAcceptor", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &lightningChannelAcceptorClient{stream}
+	return x, nil
+}
+
+type Lightning_ChannelAcceptorClient interface {
+	Send(*ChannelAcceptResponse) error
+	Recv() (*ChannelAcceptRequest, error)
+	grpc.ClientStream
+}
+
+type lightningChannelAcceptorClient struct {
+	grpc.ClientStream
+}
+
+func (x *lightningChannelAcceptorClient) Send(m *ChannelAcceptResponse) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *lightningChannelAcceptorClient) Recv() (*ChannelAcceptRequest, error) {
+	m := new(ChannelAcceptRequest)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *lightningClient) PendingSweeps(ctx context.Context, in *PendingSweepsRequest, opts ...grpc.CallOption) (*PendingSweepsResponse, error) {
+	out := new(PendingSweepsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/PendingSweeps", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SweepOutputs(ctx context.Context, in *SweepOutputsRequest, opts ...grpc.CallOption) (*SweepOutputsResponse, error) {
+	out := new(SweepOutputsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SweepOutputs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) BumpFee(ctx context.Context, in *BumpFeeRequest, opts ...grpc.CallOption) (*BumpFeeResponse, error) {
+	out := new(BumpFeeResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/BumpFee", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) AutopilotStatus(ctx context.Context, in *AutopilotStatusRequest, opts ...grpc.CallOption) (*AutopilotStatusResponse, error) {
+	out := new(AutopilotStatusResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/AutopilotStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ModifyAutopilotStatus(ctx context.Context, in *ModifyAutopilotStatusRequest, opts ...grpc.CallOption) (*ModifyAutopilotStatusResponse, error) {
+	out := new(ModifyAutopilotStatusResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ModifyAutopilotStatus", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) SetAutopilotScores(ctx context.Context, in *SetAutopilotScoresRequest, opts ...grpc.CallOption) (*SetAutopilotScoresResponse, error) {
+	out := new(SetAutopilotScoresResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/SetAutopilotScores", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) BakeMacaroon(ctx context.Context, in *BakeMacaroonRequest, opts ...grpc.CallOption) (*BakeMacaroonResponse, error) {
+	out := new(BakeMacaroonResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/BakeMacaroon", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) ListMacaroonIDs(ctx context.Context, in *ListMacaroonIDsRequest, opts ...grpc.CallOption) (*ListMacaroonIDsResponse, error) {
+	out := new(ListMacaroonIDsResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/ListMacaroonIDs", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lightningClient) DeleteMacaroonID(ctx context.Context, in *DeleteMacaroonIDRequest, opts ...grpc.CallOption) (*DeleteMacaroonIDResponse, error) {
+	out := new(DeleteMacaroonIDResponse)
+	err := grpc.Invoke(ctx, "/lnrpc.Lightning/DeleteMacaroonID", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Lightning service
 
 type LightningServer interface {
@@ -6015,12 +8843,40 @@ type LightningServer interface {
 	// the client in which any newly discovered transactions relevant to the
 	// wallet are sent over.
 	SubscribeTransactions(*GetTransactionsRequest, Lightning_SubscribeTransactionsServer) error
+	// * lncli: `getrecoveryinfo`
+	// GetRecoveryInfo returns a stream of updates on the progress of the
+	// wallet's rescan for the funds of a restored seed. An update is sent each
+	// time the rescan advances, and the stream is closed once the rescan has
+	// finished. If the wallet wasn't started in recovery mode, then a single
+	// update reporting so is sent.
+	GetRecoveryInfo(*GetRecoveryInfoRequest, Lightning_GetRecoveryInfoServer) error
 	// * lncli: `sendmany`
 	// SendMany handles a request for a transaction that creates multiple specified
 	// outputs in parallel. If neither target_conf, or sat_per_byte are set, then
 	// the internal wallet will consult its fee model to determine a fee for the
 	// default confirmation target.
 	SendMany(context.Context, *SendManyRequest) (*SendManyResponse, error)
+	// * lncli: `estimatefee`
+	// EstimateFee returns the fee rates the wallet's fee model currently
+	// estimates for each of the requested confirmation targets. These are the
+	// same fee rates used when opening channels and proposing commitment fee
+	// updates.
+	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
+	// * lncli: `leaseoutput`
+	// LeaseOutput locks an output of the wallet under the given lock ID for the
+	// given duration, excluding it from coin selection until the lease is either
+	// released or expires. Leases are persisted, so they survive restarts.
+	// Leasing an output that is already leased under the same ID extends the
+	// lease.
+	LeaseOutput(context.Context, *LeaseOutputRequest) (*LeaseOutputResponse, error)
+	// * lncli: `releaseoutput`
+	// ReleaseOutput releases the lease on an output, making it eligible for coin
+	// selection once again. The lock ID must match the one the output was leased
+	// under.
+	ReleaseOutput(context.Context, *ReleaseOutputRequest) (*ReleaseOutputResponse, error)
+	// * lncli: `listleases`
+	// ListLeases lists all outputs of the wallet that are currently leased.
+	ListLeases(context.Context, *ListLeasesRequest) (*ListLeasesResponse, error)
 	// * lncli: `newaddress`
 	// NewAddress creates a new address under control of the local wallet.
 	NewAddress(context.Context, *NewAddressRequest) (*NewAddressResponse, error)
@@ -6051,6 +8907,12 @@ type LightningServer interface {
 	// * lncli: `listpeers`
 	// ListPeers returns a verbose listing of all currently active peers.
 	ListPeers(context.Context, *ListPeersRequest) (*ListPeersResponse, error)
+	// * lncli: `listpeeraddresses`
+	// ListPeerAddresses returns the address book of each persistent peer we've
+	// attempted to connect to, recording the outcome of the connection attempts
+	// made to each of its addresses, along with the backoff used to reconnect to
+	// the peer.
+	ListPeerAddresses(context.Context, *ListPeerAddressesRequest) (*ListPeerAddressesResponse, error)
 	// * lncli: `getinfo`
 	// GetInfo returns general information concerning the lightning node including
 	// it's identity pubkey, alias, the chains it is connected to, and information
@@ -6224,6 +9086,90 @@ type LightningServer interface {
 	// the index offset of the last entry. The index offset can be provided to the
 	// request to allow the caller to skip a series of records.
 	ForwardingHistory(context.Context, *ForwardingHistoryRequest) (*ForwardingHistoryResponse, error)
+	// * lncli: `rebalance`
+	// Rebalance moves funds from one of our channels to another by sending a
+	// circular payment to ourselves. The payment leaves through the outgoing
+	// channel, and returns through the incoming channel. The completed rebalance
+	// is recorded within the forwarding log.
+	Rebalance(context.Context, *RebalanceRequest) (*RebalanceResponse, error)
+	// * lncli: `trackpayment`
+	// TrackPayment returns the full history of an outgoing payment, including
+	// every attempt made to complete it. If the payment is still in flight, an
+	// updated history is sent each time it progresses, and the stream is closed
+	// once the payment either succeeds or fails. As the history is persisted,
+	// payments initiated before a restart can be tracked as well.
+	TrackPayment(*TrackPaymentRequest, Lightning_TrackPaymentServer) error
+	// * lncli: `subscribehtlcevents`
+	// SubscribeHtlcEvents creates a uni-directional stream from the server to
+	// the client in which every HTLC event observed by the switch is sent. This
+	// covers HTLCs as they are forwarded, added to their outgoing channel,
+	// queued in the overflow queue, dropped after their deadline, settled and
+	// failed.
+	SubscribeHtlcEvents(*SubscribeHtlcEventsRequest, Lightning_SubscribeHtlcEventsServer) error
+	// *
+	// HtlcInterceptor dispatches a bi-directional streaming RPC in which every
+	// HTLC forwarded by the switch is held and sent to the client, which then
+	// decides whether the HTLC is resumed, failed or settled. Only a single
+	// interceptor can be active at a time. Forwards that aren't resolved before
+	// their deadline, or are still held once the stream is closed, are failed
+	// back to their source.
+	HtlcInterceptor(Lightning_HtlcInterceptorServer) error
+	// *
+	// ChannelAcceptor dispatches a bi-directional streaming RPC in which every
+	// inbound channel request that satisfies the configured acceptor rules is
+	// sent to the client, which then decides whether the channel is accepted.
+	// Channel requests that aren't decided on before the configured timeout,
+	// or are still undecided once the stream is closed, are rejected.
+	ChannelAcceptor(Lightning_ChannelAcceptorServer) error
+	// * lncli: `pendingsweeps`
+	// PendingSweeps returns all outputs which are waiting to be swept back into
+	// the wallet by the sweeper, along with the state of the sweep transactions
+	// spending them.
+	PendingSweeps(context.Context, *PendingSweepsRequest) (*PendingSweepsResponse, error)
+	// * lncli: `sweepoutputs`
+	// SweepOutputs immediately sweeps all outputs which are waiting to be swept,
+	// targeting confirmation within the given number of blocks. Outputs which
+	// already are part of a sweep transaction have their fee bumped if the target
+	// requires a higher fee.
+	SweepOutputs(context.Context, *SweepOutputsRequest) (*SweepOutputsResponse, error)
+	// * lncli: `bumpfee`
+	// BumpFee bumps the fee of the unconfirmed transaction creating the given
+	// output. If the output is waiting to be swept, its sweep transaction is
+	// replaced by one paying a higher fee (RBF). Otherwise, if the output is an
+	// unconfirmed p2wkh output of the wallet, it is spent by a child transaction
+	// paying for its parent (CPFP). Transactions funded by the wallet itself are
+	// never replaced, as they may fund channels whose outpoints must not change,
+	// so their fee is bumped through CPFP of their change output instead. As
+	// the fee of the parent must be known, all outputs it spends must belong to
+	// the wallet. The output is leased while the child is pending.
+	BumpFee(context.Context, *BumpFeeRequest) (*BumpFeeResponse, error)
+	// * lncli: `autopilotstatus`
+	// AutopilotStatus returns whether the autopilot agent is active, along with
+	// its current state and the channel attachments it proposes to make.
+	AutopilotStatus(context.Context, *AutopilotStatusRequest) (*AutopilotStatusResponse, error)
+	// * lncli: `setautopilot`
+	// ModifyAutopilotStatus enables or disables the autopilot agent at runtime.
+	ModifyAutopilotStatus(context.Context, *ModifyAutopilotStatusRequest) (*ModifyAutopilotStatusResponse, error)
+	// * lncli: `setautopilotscores`
+	// SetAutopilotScores replaces the node scores used by the externalscore
+	// autopilot heuristic, allowing an external process to decide which nodes
+	// the agent opens channels to.
+	SetAutopilotScores(context.Context, *SetAutopilotScoresRequest) (*SetAutopilotScoresResponse, error)
+	// * lncli: `bakemacaroon`
+	// BakeMacaroon allows the creation of a new macaroon with custom permissions,
+	// scoped to any of the entity/action pairs known to the RPC server. The
+	// macaroon is baked with the root key of the given ID, such that it can be
+	// revoked along with all other macaroons sharing the root key.
+	BakeMacaroon(context.Context, *BakeMacaroonRequest) (*BakeMacaroonResponse, error)
+	// * lncli: `listmacaroonids`
+	// ListMacaroonIDs returns the IDs of all root keys macaroons have been baked
+	// with.
+	ListMacaroonIDs(context.Context, *ListMacaroonIDsRequest) (*ListMacaroonIDsResponse, error)
+	// * lncli: `deletemacaroonid`
+	// DeleteMacaroonID deletes the root key of the given ID, revoking all
+	// macaroons that were baked with it. The default root key, used for the
+	// macaroons lnd creates on startup, can't be deleted.
+	DeleteMacaroonID(context.Context, *DeleteMacaroonIDRequest) (*DeleteMacaroonIDResponse, error)
 }
 
 func RegisterLightningServer(s *grpc.Server, srv LightningServer) {
@@ -6323,20 +9269,113 @@ func (x *lightningSubscribeTransactionsServer) Send(m *Transaction) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Lightning_GetRecoveryInfo_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetRecoveryInfoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).GetRecoveryInfo(m, &lightningGetRecoveryInfoServer{stream})
+}
+
+type Lightning_GetRecoveryInfoServer interface {
+	Send(*RecoveryInfo) error
+	grpc.ServerStream
+}
+
+type lightningGetRecoveryInfoServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningGetRecoveryInfoServer) Send(m *RecoveryInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Lightning_SendMany_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SendManyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LightningServer).SendMany(ctx, in)
+		return srv.(LightningServer).SendMany(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SendMany",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SendMany(ctx, req.(*SendManyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_LeaseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaseOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).LeaseOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/LeaseOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).LeaseOutput(ctx, req.(*LeaseOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ReleaseOutput_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseOutputRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ReleaseOutput(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ReleaseOutput",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ReleaseOutput(ctx, req.(*ReleaseOutputRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListLeases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListLeases(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/lnrpc.Lightning/SendMany",
+		FullMethod: "/lnrpc.Lightning/ListLeases",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LightningServer).SendMany(ctx, req.(*SendManyRequest))
+		return srv.(LightningServer).ListLeases(ctx, req.(*ListLeasesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -6467,6 +9506,24 @@ func _Lightning_ListPeers_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_ListPeerAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPeerAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListPeerAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListPeerAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListPeerAddresses(ctx, req.(*ListPeerAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Lightning_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetInfoRequest)
 	if err := dec(in); err != nil {
@@ -7017,6 +10074,280 @@ func _Lightning_ForwardingHistory_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Lightning_Rebalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).Rebalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/Rebalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).Rebalance(ctx, req.(*RebalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_TrackPayment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TrackPaymentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).TrackPayment(m, &lightningTrackPaymentServer{stream})
+}
+
+type Lightning_TrackPaymentServer interface {
+	Send(*PaymentUpdate) error
+	grpc.ServerStream
+}
+
+type lightningTrackPaymentServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningTrackPaymentServer) Send(m *PaymentUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_SubscribeHtlcEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeHtlcEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LightningServer).SubscribeHtlcEvents(m, &lightningSubscribeHtlcEventsServer{stream})
+}
+
+type Lightning_SubscribeHtlcEventsServer interface {
+	Send(*HtlcEvent) error
+	grpc.ServerStream
+}
+
+type lightningSubscribeHtlcEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningSubscribeHtlcEventsServer) Send(m *HtlcEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Lightning_HtlcInterceptor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).HtlcInterceptor(&lightningHtlcInterceptorServer{stream})
+}
+
+type Lightning_HtlcInterceptorServer interface {
+	Send(*ForwardHtlcInterceptRequest) error
+	Recv() (*ForwardHtlcInterceptResponse, error)
+	grpc.ServerStream
+}
+
+type lightningHtlcInterceptorServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningHtlcInterceptorServer) Send(m *ForwardHtlcInterceptRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningHtlcInterceptorServer) Recv() (*ForwardHtlcInterceptResponse, error) {
+	m := new(ForwardHtlcInterceptResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Lightning_ChannelAcceptor_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LightningServer).ChannelAcceptor(&lightningChannelAcceptorServer{stream})
+}
+
+type Lightning_ChannelAcceptorServer interface {
+	Send(*ChannelAcceptRequest) error
+	Recv() (*ChannelAcceptResponse, error)
+	grpc.ServerStream
+}
+
+type lightningChannelAcceptorServer struct {
+	grpc.ServerStream
+}
+
+func (x *lightningChannelAcceptorServer) Send(m *ChannelAcceptRequest) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *lightningChannelAcceptorServer) Recv() (*ChannelAcceptResponse, error) {
+	m := new(ChannelAcceptResponse)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Lightning_PendingSweeps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PendingSweepsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).PendingSweeps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/PendingSweeps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).PendingSweeps(ctx, req.(*PendingSweepsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SweepOutputs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SweepOutputsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SweepOutputs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SweepOutputs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SweepOutputs(ctx, req.(*SweepOutputsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_BumpFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BumpFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).BumpFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/BumpFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).BumpFee(ctx, req.(*BumpFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_AutopilotStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutopilotStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).AutopilotStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/AutopilotStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).AutopilotStatus(ctx, req.(*AutopilotStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ModifyAutopilotStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyAutopilotStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ModifyAutopilotStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ModifyAutopilotStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ModifyAutopilotStatus(ctx, req.(*ModifyAutopilotStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_SetAutopilotScores_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAutopilotScoresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).SetAutopilotScores(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/SetAutopilotScores",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).SetAutopilotScores(ctx, req.(*SetAutopilotScoresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_BakeMacaroon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BakeMacaroonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).BakeMacaroon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/BakeMacaroon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).BakeMacaroon(ctx, req.(*BakeMacaroonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_ListMacaroonIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMacaroonIDsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).ListMacaroonIDs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/ListMacaroonIDs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).ListMacaroonIDs(ctx, req.(*ListMacaroonIDsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Lightning_DeleteMacaroonID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMacaroonIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LightningServer).DeleteMacaroonID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/lnrpc.Lightning/DeleteMacaroonID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LightningServer).DeleteMacaroonID(ctx, req.(*DeleteMacaroonIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Lightning_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "lnrpc.Lightning",
 	HandlerType: (*LightningServer)(nil),
@@ -7041,6 +10372,22 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendMany",
 			Handler:    _Lightning_SendMany_Handler,
 		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _Lightning_EstimateFee_Handler,
+		},
+		{
+			MethodName: "LeaseOutput",
+			Handler:    _Lightning_LeaseOutput_Handler,
+		},
+		{
+			MethodName: "ReleaseOutput",
+			Handler:    _Lightning_ReleaseOutput_Handler,
+		},
+		{
+			MethodName: "ListLeases",
+			Handler:    _Lightning_ListLeases_Handler,
+		},
 		{
 			MethodName: "NewAddress",
 			Handler:    _Lightning_NewAddress_Handler,
@@ -7069,6 +10416,10 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListPeers",
 			Handler:    _Lightning_ListPeers_Handler,
 		},
+		{
+			MethodName: "ListPeerAddresses",
+			Handler:    _Lightning_ListPeerAddresses_Handler,
+		},
 		{
 			MethodName: "GetInfo",
 			Handler:    _Lightning_GetInfo_Handler,
@@ -7161,6 +10512,46 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ForwardingHistory",
 			Handler:    _Lightning_ForwardingHistory_Handler,
 		},
+		{
+			MethodName: "Rebalance",
+			Handler:    _Lightning_Rebalance_Handler,
+		},
+		{
+			MethodName: "PendingSweeps",
+			Handler:    _Lightning_PendingSweeps_Handler,
+		},
+		{
+			MethodName: "SweepOutputs",
+			Handler:    _Lightning_SweepOutputs_Handler,
+		},
+		{
+			MethodName: "BumpFee",
+			Handler:    _Lightning_BumpFee_Handler,
+		},
+		{
+			MethodName: "AutopilotStatus",
+			Handler:    _Lightning_AutopilotStatus_Handler,
+		},
+		{
+			MethodName: "ModifyAutopilotStatus",
+			Handler:    _Lightning_ModifyAutopilotStatus_Handler,
+		},
+		{
+			MethodName: "SetAutopilotScores",
+			Handler:    _Lightning_SetAutopilotScores_Handler,
+		},
+		{
+			MethodName: "BakeMacaroon",
+			Handler:    _Lightning_BakeMacaroon_Handler,
+		},
+		{
+			MethodName: "ListMacaroonIDs",
+			Handler:    _Lightning_ListMacaroonIDs_Handler,
+		},
+		{
+			MethodName: "DeleteMacaroonID",
+			Handler:    _Lightning_DeleteMacaroonID_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -7168,6 +10559,11 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeTransactions_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetRecoveryInfo",
+			Handler:       _Lightning_GetRecoveryInfo_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "OpenChannel",
 			Handler:       _Lightning_OpenChannel_Handler,
@@ -7200,6 +10596,28 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 			Handler:       _Lightning_SubscribeChannelGraph_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "TrackPayment",
+			Handler:       _Lightning_TrackPayment_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeHtlcEvents",
+			Handler:       _Lightning_SubscribeHtlcEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "HtlcInterceptor",
+			Handler:       _Lightning_HtlcInterceptor_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ChannelAcceptor",
+			Handler:       _Lightning_ChannelAcceptor_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
@@ -7207,401 +10625,574 @@ var _Lightning_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("rpc.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 6332 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5c, 0xdd, 0x8f, 0x1c, 0xd9,
-	0x55, 0x77, 0xf5, 0xf4, 0x7c, 0xf4, 0xe9, 0x9e, 0x99, 0x9e, 0x3b, 0xe3, 0x99, 0x76, 0xef, 0xae,
-	0xd7, 0x5b, 0xb1, 0xd6, 0xce, 0xb0, 0xd8, 0xde, 0x49, 0xb2, 0xda, 0xec, 0x42, 0x82, 0x3d, 0x1e,
-	0x7b, 0x9c, 0xcc, 0xda, 0x93, 0x1a, 0x3b, 0x86, 0x04, 0xd4, 0xa9, 0xe9, 0xbe, 0xd3, 0x53, 0x71,
-	0x77, 0x55, 0xa5, 0xaa, 0x7a, 0xc6, 0x9d, 0xc5, 0x52, 0x20, 0x88, 0x27, 0x22, 0x84, 0x40, 0x42,
-	0x41, 0x42, 0x48, 0x01, 0x21, 0xf8, 0x03, 0xe0, 0x25, 0x20, 0xf1, 0xc0, 0x03, 0x20, 0x21, 0x1e,
-	0xf2, 0x14, 0xf1, 0x08, 0x2f, 0x80, 0x78, 0x41, 0xe2, 0x15, 0xa1, 0x73, 0xee, 0x47, 0xdd, 0x5b,
-	0x55, 0xe3, 0x71, 0x3e, 0xe0, 0xad, 0xef, 0xef, 0x9e, 0xba, 0x9f, 0xe7, 0xeb, 0x9e, 0x7b, 0x6e,
-	0x43, 0x23, 0x89, 0xfb, 0x37, 0xe2, 0x24, 0xca, 0x22, 0x36, 0x3b, 0x0a, 0x93, 0xb8, 0xdf, 0x7d,
-	0x7d, 0x18, 0x45, 0xc3, 0x11, 0xbf, 0xe9, 0xc7, 0xc1, 0x4d, 0x3f, 0x0c, 0xa3, 0xcc, 0xcf, 0x82,
-	0x28, 0x4c, 0x05, 0x91, 0xfb, 0x35, 0x58, 0xba, 0xcf, 0xc3, 0x03, 0xce, 0x07, 0x1e, 0xff, 0xc6,
-	0x84, 0xa7, 0x19, 0xfb, 0x19, 0x58, 0xf1, 0xf9, 0x37, 0x39, 0x1f, 0xf4, 0x62, 0x3f, 0x4d, 0xe3,
-	0xe3, 0xc4, 0x4f, 0x79, 0xc7, 0xb9, 0xe2, 0x5c, 0x6f, 0x79, 0x6d, 0x51, 0xb1, 0xaf, 0x71, 0xf6,
-	0x16, 0xb4, 0x52, 0x24, 0xe5, 0x61, 0x96, 0x44, 0xf1, 0xb4, 0x53, 0x23, 0xba, 0x26, 0x62, 0x3b,
-	0x02, 0x72, 0x47, 0xb0, 0xac, 0x7b, 0x48, 0xe3, 0x28, 0x4c, 0x39, 0xbb, 0x05, 0x6b, 0xfd, 0x20,
-	0x3e, 0xe6, 0x49, 0x8f, 0x3e, 0x1e, 0x87, 0x7c, 0x1c, 0x85, 0x41, 0xbf, 0xe3, 0x5c, 0x99, 0xb9,
-	0xde, 0xf0, 0x98, 0xa8, 0xc3, 0x2f, 0x3e, 0x92, 0x35, 0xec, 0x1a, 0x2c, 0xf3, 0x50, 0xe0, 0x7c,
-	0x40, 0x5f, 0xc9, 0xae, 0x96, 0x72, 0x18, 0x3f, 0x70, 0xff, 0xd6, 0x81, 0x95, 0x07, 0x61, 0x90,
-	0x3d, 0xf5, 0x47, 0x23, 0x9e, 0xa9, 0x39, 0x5d, 0x83, 0xe5, 0x53, 0x02, 0x68, 0x4e, 0xa7, 0x51,
-	0x32, 0x90, 0x33, 0x5a, 0x12, 0xf0, 0xbe, 0x44, 0xcf, 0x1c, 0x59, 0xed, 0xcc, 0x91, 0x55, 0x2e,
-	0xd7, 0xcc, 0x19, 0xcb, 0x75, 0x0d, 0x96, 0x13, 0xde, 0x8f, 0x4e, 0x78, 0x32, 0xed, 0x9d, 0x06,
-	0xe1, 0x20, 0x3a, 0xed, 0xd4, 0xaf, 0x38, 0xd7, 0x67, 0xbd, 0x25, 0x05, 0x3f, 0x25, 0xd4, 0x5d,
-	0x03, 0x66, 0xce, 0x42, 0xac, 0x9b, 0x3b, 0x84, 0xd5, 0x27, 0xe1, 0x28, 0xea, 0x3f, 0xfb, 0x31,
-	0x67, 0x57, 0xd1, 0x7d, 0xad, 0xb2, 0xfb, 0x75, 0x58, 0xb3, 0x3b, 0x92, 0x03, 0xe0, 0x70, 0x71,
-	0xfb, 0xd8, 0x0f, 0x87, 0x5c, 0x35, 0xa9, 0x86, 0xf0, 0x49, 0x68, 0xf7, 0x27, 0x49, 0xc2, 0xc3,
-	0xd2, 0x18, 0x96, 0x25, 0xae, 0x07, 0xf1, 0x16, 0xb4, 0x42, 0x7e, 0x9a, 0x93, 0x49, 0x96, 0x09,
-	0xf9, 0xa9, 0x22, 0x71, 0x3b, 0xb0, 0x5e, 0xec, 0x46, 0x0e, 0xe0, 0xbb, 0x35, 0x68, 0x3e, 0x4e,
-	0xfc, 0x30, 0xf5, 0xfb, 0xc8, 0xc5, 0xac, 0x03, 0xf3, 0xd9, 0xf3, 0xde, 0xb1, 0x9f, 0x1e, 0x53,
-	0x77, 0x0d, 0x4f, 0x15, 0xd9, 0x3a, 0xcc, 0xf9, 0xe3, 0x68, 0x12, 0x66, 0xd4, 0xc1, 0x8c, 0x27,
-	0x4b, 0xec, 0x1d, 0x58, 0x09, 0x27, 0xe3, 0x5e, 0x3f, 0x0a, 0x8f, 0x82, 0x64, 0x2c, 0x64, 0x81,
-	0xf6, 0x6b, 0xd6, 0x2b, 0x57, 0xb0, 0xcb, 0x00, 0x87, 0xb8, 0x0e, 0xa2, 0x8b, 0x3a, 0x75, 0x61,
-	0x20, 0xcc, 0x85, 0x96, 0x2c, 0xf1, 0x60, 0x78, 0x9c, 0x75, 0x66, 0xa9, 0x21, 0x0b, 0xc3, 0x36,
-	0xb2, 0x60, 0xcc, 0x7b, 0x69, 0xe6, 0x8f, 0xe3, 0xce, 0x1c, 0x8d, 0xc6, 0x40, 0xa8, 0x3e, 0xca,
-	0xfc, 0x51, 0xef, 0x88, 0xf3, 0xb4, 0x33, 0x2f, 0xeb, 0x35, 0xc2, 0xde, 0x86, 0xa5, 0x01, 0x4f,
-	0xb3, 0x9e, 0x3f, 0x18, 0x24, 0x3c, 0x4d, 0x79, 0xda, 0x59, 0x20, 0x6e, 0x2c, 0xa0, 0xb8, 0x6a,
-	0xf7, 0x79, 0x66, 0xac, 0x4e, 0x2a, 0x77, 0xc7, 0xdd, 0x03, 0x66, 0xc0, 0x77, 0x79, 0xe6, 0x07,
-	0xa3, 0x94, 0xbd, 0x07, 0xad, 0xcc, 0x20, 0x26, 0xe9, 0x6b, 0x6e, 0xb1, 0x1b, 0xa4, 0x36, 0x6e,
-	0x18, 0x1f, 0x78, 0x16, 0x9d, 0x7b, 0x1f, 0x16, 0xee, 0x71, 0xbe, 0x17, 0x8c, 0x83, 0x8c, 0xad,
-	0xc3, 0xec, 0x51, 0xf0, 0x9c, 0x8b, 0xcd, 0x9e, 0xd9, 0xbd, 0xe0, 0x89, 0x22, 0xeb, 0xc2, 0x7c,
-	0xcc, 0x93, 0x3e, 0x57, 0xcb, 0xbf, 0x7b, 0xc1, 0x53, 0xc0, 0x9d, 0x79, 0x98, 0x1d, 0xe1, 0xc7,
-	0xee, 0xdf, 0xd5, 0xa0, 0x79, 0xc0, 0x43, 0xcd, 0x44, 0x0c, 0xea, 0x38, 0x25, 0xc9, 0x38, 0xf4,
-	0x9b, 0xbd, 0x09, 0x4d, 0x9a, 0x66, 0x9a, 0x25, 0x41, 0x38, 0xa4, 0xc6, 0x1a, 0x1e, 0x20, 0x74,
-	0x40, 0x08, 0x6b, 0xc3, 0x8c, 0x3f, 0xce, 0x68, 0x07, 0x67, 0x3c, 0xfc, 0x89, 0x0c, 0x16, 0xfb,
-	0xd3, 0x31, 0xf2, 0xa2, 0xde, 0xb5, 0x96, 0xd7, 0x94, 0xd8, 0x2e, 0x6e, 0xdb, 0x0d, 0x58, 0x35,
-	0x49, 0x54, 0xeb, 0xb3, 0xd4, 0xfa, 0x8a, 0x41, 0x29, 0x3b, 0xb9, 0x06, 0xcb, 0x8a, 0x3e, 0x11,
-	0x83, 0xa5, 0x7d, 0x6c, 0x78, 0x4b, 0x12, 0x56, 0x53, 0xb8, 0x0e, 0xed, 0xa3, 0x20, 0xf4, 0x47,
-	0xbd, 0xfe, 0x28, 0x3b, 0xe9, 0x0d, 0xf8, 0x28, 0xf3, 0x69, 0x47, 0x67, 0xbd, 0x25, 0xc2, 0xb7,
-	0x47, 0xd9, 0xc9, 0x5d, 0x44, 0xd9, 0x3b, 0xd0, 0x38, 0xe2, 0xbc, 0x47, 0x2b, 0xd1, 0x59, 0xb8,
-	0xe2, 0x5c, 0x6f, 0x6e, 0x2d, 0xcb, 0xa5, 0x57, 0xab, 0xeb, 0x2d, 0x1c, 0xa9, 0x75, 0xbe, 0x0c,
-	0x90, 0xc6, 0xc1, 0x80, 0x27, 0xb7, 0x47, 0xc3, 0xa8, 0xd3, 0xa0, 0x16, 0x0d, 0xc4, 0xfd, 0x3d,
-	0x07, 0x5a, 0x62, 0x29, 0xa5, 0x8a, 0xbd, 0x0a, 0x8b, 0x6a, 0xc4, 0x3c, 0x49, 0xa2, 0x44, 0x8a,
-	0x87, 0x0d, 0xb2, 0x4d, 0x68, 0x2b, 0x20, 0x4e, 0x78, 0x30, 0xf6, 0x87, 0x5c, 0xca, 0x63, 0x09,
-	0x67, 0x5b, 0x79, 0x8b, 0x49, 0x34, 0xc9, 0x84, 0x92, 0x6b, 0x6e, 0xb5, 0xe4, 0xa0, 0x3d, 0xc4,
-	0x3c, 0x9b, 0xc4, 0xfd, 0x8e, 0x03, 0x0c, 0x87, 0xf5, 0x38, 0x12, 0xd5, 0x72, 0x95, 0x8a, 0x3b,
-	0xe4, 0xbc, 0xf2, 0x0e, 0xd5, 0xce, 0xda, 0xa1, 0xab, 0x30, 0x47, 0x5d, 0xa2, 0x2c, 0xcf, 0x94,
-	0x86, 0x25, 0xeb, 0xdc, 0xef, 0x39, 0xd0, 0x42, 0xcd, 0x12, 0xf2, 0xd1, 0x7e, 0x14, 0x84, 0x19,
-	0xbb, 0x05, 0xec, 0x68, 0x12, 0x0e, 0x82, 0x70, 0xd8, 0xcb, 0x9e, 0x07, 0x83, 0xde, 0xe1, 0x14,
-	0x9b, 0xa0, 0xf1, 0xec, 0x5e, 0xf0, 0x2a, 0xea, 0xd8, 0x3b, 0xd0, 0xb6, 0xd0, 0x34, 0x4b, 0xc4,
-	0xa8, 0x76, 0x2f, 0x78, 0xa5, 0x1a, 0xd4, 0x0f, 0xd1, 0x24, 0x8b, 0x27, 0x59, 0x2f, 0x08, 0x07,
-	0xfc, 0x39, 0xad, 0xd9, 0xa2, 0x67, 0x61, 0x77, 0x96, 0xa0, 0x65, 0x7e, 0xe7, 0x7e, 0x0e, 0xda,
-	0x7b, 0xa8, 0x38, 0xc2, 0x20, 0x1c, 0xde, 0x16, 0xd2, 0x8d, 0xda, 0x2c, 0x9e, 0x1c, 0x3e, 0xe3,
-	0x53, 0xb9, 0x8f, 0xb2, 0x84, 0x22, 0x73, 0x1c, 0xa5, 0x99, 0x5c, 0x17, 0xfa, 0xed, 0xfe, 0x8b,
-	0x03, 0xcb, 0xb8, 0xe8, 0x1f, 0xf9, 0xe1, 0x54, 0xad, 0xf8, 0x1e, 0xb4, 0xb0, 0xa9, 0xc7, 0xd1,
-	0x6d, 0xa1, 0x13, 0x85, 0xac, 0x5f, 0x97, 0x8b, 0x54, 0xa0, 0xbe, 0x61, 0x92, 0xa2, 0x19, 0x9f,
-	0x7a, 0xd6, 0xd7, 0x28, 0x94, 0x99, 0x9f, 0x0c, 0x79, 0x46, 0xda, 0x52, 0x6a, 0x4f, 0x10, 0xd0,
-	0x76, 0x14, 0x1e, 0xb1, 0x2b, 0xd0, 0x4a, 0xfd, 0xac, 0x17, 0xf3, 0x84, 0x56, 0x8d, 0x04, 0x6b,
-	0xc6, 0x83, 0xd4, 0xcf, 0xf6, 0x79, 0x72, 0x67, 0x9a, 0xf1, 0xee, 0xe7, 0x61, 0xa5, 0xd4, 0x0b,
-	0xca, 0x72, 0x3e, 0x45, 0xfc, 0xc9, 0xd6, 0x60, 0xf6, 0xc4, 0x1f, 0x4d, 0xb8, 0x54, 0xe2, 0xa2,
-	0xf0, 0x41, 0xed, 0x7d, 0xc7, 0x7d, 0x1b, 0xda, 0xf9, 0xb0, 0x25, 0xd3, 0x33, 0xa8, 0xe3, 0x0a,
-	0xca, 0x06, 0xe8, 0xb7, 0xfb, 0x6b, 0x8e, 0x20, 0xdc, 0x8e, 0x02, 0xad, 0x10, 0x91, 0x10, 0xf5,
-	0xa6, 0x22, 0xc4, 0xdf, 0x67, 0x1a, 0x8c, 0x9f, 0x7c, 0xb2, 0xee, 0x35, 0x58, 0x31, 0x86, 0xf0,
-	0x92, 0xc1, 0x7e, 0xc7, 0x81, 0x95, 0x87, 0xfc, 0x54, 0xee, 0xba, 0x1a, 0xed, 0xfb, 0x50, 0xcf,
-	0xa6, 0xb1, 0x70, 0xc2, 0x96, 0xb6, 0xae, 0xca, 0x4d, 0x2b, 0xd1, 0xdd, 0x90, 0xc5, 0xc7, 0xd3,
-	0x98, 0x7b, 0xf4, 0x85, 0xfb, 0x39, 0x68, 0x1a, 0x20, 0xdb, 0x80, 0xd5, 0xa7, 0x0f, 0x1e, 0x3f,
-	0xdc, 0x39, 0x38, 0xe8, 0xed, 0x3f, 0xb9, 0xf3, 0xc5, 0x9d, 0x5f, 0xea, 0xed, 0xde, 0x3e, 0xd8,
-	0x6d, 0x5f, 0x60, 0xeb, 0xc0, 0x1e, 0xee, 0x1c, 0x3c, 0xde, 0xb9, 0x6b, 0xe1, 0x8e, 0xdb, 0x85,
-	0xce, 0x43, 0x7e, 0xfa, 0x34, 0xc8, 0x42, 0x9e, 0xa6, 0x76, 0x6f, 0xee, 0x0d, 0x60, 0xe6, 0x10,
-	0xe4, 0xac, 0x3a, 0x30, 0x2f, 0x2d, 0x92, 0x32, 0xc8, 0xb2, 0xe8, 0xbe, 0x0d, 0xec, 0x20, 0x18,
-	0x86, 0x1f, 0xf1, 0x34, 0xf5, 0x87, 0x5a, 0x15, 0xb4, 0x61, 0x66, 0x9c, 0x0e, 0xa5, 0x06, 0xc0,
-	0x9f, 0xee, 0xa7, 0x60, 0xd5, 0xa2, 0x93, 0x0d, 0xbf, 0x0e, 0x8d, 0x34, 0x18, 0x86, 0x7e, 0x36,
-	0x49, 0xb8, 0x6c, 0x3a, 0x07, 0xdc, 0x7b, 0xb0, 0xf6, 0x65, 0x9e, 0x04, 0x47, 0xd3, 0xf3, 0x9a,
-	0xb7, 0xdb, 0xa9, 0x15, 0xdb, 0xd9, 0x81, 0x8b, 0x85, 0x76, 0x64, 0xf7, 0x82, 0x11, 0xe5, 0x76,
-	0x2d, 0x78, 0xa2, 0x60, 0x88, 0x65, 0xcd, 0x14, 0x4b, 0xf7, 0x09, 0xb0, 0xed, 0x28, 0x0c, 0x79,
-	0x3f, 0xdb, 0xe7, 0x3c, 0xc9, 0x3d, 0xeb, 0x9c, 0xeb, 0x9a, 0x5b, 0x1b, 0x72, 0x1f, 0x8b, 0xb2,
-	0x2e, 0xd9, 0x91, 0x41, 0x3d, 0xe6, 0xc9, 0x98, 0x1a, 0x5e, 0xf0, 0xe8, 0xb7, 0x7b, 0x11, 0x56,
-	0xad, 0x66, 0xa5, 0x53, 0xf4, 0x2e, 0x5c, 0xbc, 0x1b, 0xa4, 0xfd, 0x72, 0x87, 0x1d, 0x98, 0x8f,
-	0x27, 0x87, 0xbd, 0x5c, 0xa6, 0x54, 0x11, 0x7d, 0x85, 0xe2, 0x27, 0xb2, 0xb1, 0xdf, 0x74, 0xa0,
-	0xbe, 0xfb, 0x78, 0x6f, 0x9b, 0x75, 0x61, 0x21, 0x08, 0xfb, 0xd1, 0x18, 0xd5, 0xae, 0x98, 0xb4,
-	0x2e, 0x9f, 0x29, 0x2b, 0xaf, 0x43, 0x83, 0xb4, 0x35, 0xba, 0x3f, 0xd2, 0x09, 0xce, 0x01, 0x74,
-	0xbd, 0xf8, 0xf3, 0x38, 0x48, 0xc8, 0xb7, 0x52, 0x1e, 0x53, 0x9d, 0x34, 0x62, 0xb9, 0xc2, 0xfd,
-	0x9f, 0x3a, 0xcc, 0x4b, 0x5d, 0x4d, 0xfd, 0xf5, 0xb3, 0xe0, 0x84, 0xcb, 0x91, 0xc8, 0x12, 0x5a,
-	0xb9, 0x84, 0x8f, 0xa3, 0x8c, 0xf7, 0xac, 0x6d, 0xb0, 0x41, 0xa4, 0xea, 0x8b, 0x86, 0x7a, 0x31,
-	0x6a, 0x7d, 0x1a, 0x59, 0xc3, 0xb3, 0x41, 0x5c, 0x2c, 0x04, 0x7a, 0xc1, 0x80, 0xc6, 0x54, 0xf7,
-	0x54, 0x11, 0x57, 0xa2, 0xef, 0xc7, 0x7e, 0x3f, 0xc8, 0xa6, 0x52, 0xb8, 0x75, 0x19, 0xdb, 0x1e,
-	0x45, 0x7d, 0x7f, 0xd4, 0x3b, 0xf4, 0x47, 0x7e, 0xd8, 0xe7, 0xd2, 0xbf, 0xb3, 0x41, 0x74, 0xe1,
-	0xe4, 0x90, 0x14, 0x99, 0x70, 0xf3, 0x0a, 0x28, 0x9a, 0xf9, 0x7e, 0x34, 0x1e, 0x07, 0x19, 0x7a,
-	0x7e, 0xe4, 0x15, 0xcc, 0x78, 0x06, 0x42, 0x33, 0x11, 0xa5, 0x53, 0xb1, 0x7a, 0x0d, 0xd1, 0x9b,
-	0x05, 0x62, 0x2b, 0xe8, 0x5a, 0xa0, 0x42, 0x7a, 0x76, 0xda, 0x01, 0xd1, 0x4a, 0x8e, 0xe0, 0x3e,
-	0x4c, 0xc2, 0x94, 0x67, 0xd9, 0x88, 0x0f, 0xf4, 0x80, 0x9a, 0x44, 0x56, 0xae, 0x60, 0xb7, 0x60,
-	0x55, 0x38, 0xa3, 0xa9, 0x9f, 0x45, 0xe9, 0x71, 0x90, 0xf6, 0x52, 0x74, 0xeb, 0x5a, 0x44, 0x5f,
-	0x55, 0xc5, 0xde, 0x87, 0x8d, 0x02, 0x9c, 0xf0, 0x3e, 0x0f, 0x4e, 0xf8, 0xa0, 0xb3, 0x48, 0x5f,
-	0x9d, 0x55, 0xcd, 0xae, 0x40, 0x13, 0x7d, 0xf0, 0x49, 0x3c, 0xf0, 0xd1, 0x0e, 0x2f, 0xd1, 0x3e,
-	0x98, 0x10, 0x7b, 0x17, 0x16, 0x63, 0x2e, 0x8c, 0xe5, 0x71, 0x36, 0xea, 0xa7, 0x9d, 0x65, 0xb2,
-	0x64, 0x4d, 0x29, 0x4c, 0xc8, 0xb9, 0x9e, 0x4d, 0x81, 0x4c, 0xd9, 0x4f, 0xc9, 0x19, 0xf3, 0xa7,
-	0x9d, 0x36, 0xb1, 0x5b, 0x0e, 0x90, 0x8c, 0x24, 0xc1, 0x89, 0x9f, 0xf1, 0xce, 0x0a, 0xf1, 0x96,
-	0x2a, 0xba, 0x7f, 0xe4, 0xc0, 0xea, 0x5e, 0x90, 0x66, 0x92, 0x09, 0xb5, 0x3a, 0x7e, 0x13, 0x9a,
-	0x82, 0xfd, 0x7a, 0x51, 0x38, 0x9a, 0x4a, 0x8e, 0x04, 0x01, 0x3d, 0x0a, 0x47, 0x53, 0xf6, 0x09,
-	0x58, 0x0c, 0x42, 0x93, 0x44, 0xc8, 0x70, 0x4b, 0x81, 0x44, 0xf4, 0x26, 0x34, 0xe3, 0xc9, 0xe1,
-	0x28, 0xe8, 0x0b, 0x92, 0x19, 0xd1, 0x8a, 0x80, 0x88, 0x00, 0x9d, 0x24, 0x31, 0x12, 0x41, 0x51,
-	0x27, 0x8a, 0xa6, 0xc4, 0x90, 0xc4, 0xbd, 0x03, 0x6b, 0xf6, 0x00, 0xa5, 0xb2, 0xda, 0x84, 0x05,
-	0xc9, 0xdb, 0x69, 0xa7, 0x49, 0xeb, 0xb3, 0x24, 0xd7, 0x47, 0x92, 0x7a, 0xba, 0xde, 0xfd, 0xd3,
-	0x3a, 0xac, 0x4a, 0x74, 0x7b, 0x14, 0xa5, 0xfc, 0x60, 0x32, 0x1e, 0xfb, 0x49, 0x85, 0xd0, 0x38,
-	0xe7, 0x08, 0x4d, 0xcd, 0x16, 0x1a, 0x64, 0xe5, 0x63, 0x3f, 0x08, 0x85, 0x87, 0x27, 0x24, 0xce,
-	0x40, 0xd8, 0x75, 0x58, 0xee, 0x8f, 0xa2, 0x54, 0x78, 0x3d, 0xe6, 0xf1, 0xaa, 0x08, 0x97, 0x85,
-	0x7c, 0xb6, 0x4a, 0xc8, 0x4d, 0x21, 0x9d, 0x2b, 0x08, 0xa9, 0x0b, 0x2d, 0x6c, 0x94, 0x2b, 0x9d,
-	0x33, 0x2f, 0xbc, 0x30, 0x13, 0xc3, 0xf1, 0x14, 0x45, 0x42, 0xc8, 0xdf, 0x72, 0x95, 0x40, 0xe0,
-	0xe9, 0x0d, 0x75, 0x9a, 0x41, 0xdd, 0x90, 0x02, 0x51, 0xae, 0x62, 0xf7, 0x00, 0x44, 0x5f, 0x64,
-	0xc6, 0x81, 0xcc, 0xf8, 0xdb, 0xf6, 0x8e, 0x98, 0x6b, 0x7f, 0x03, 0x0b, 0x93, 0x84, 0x93, 0x21,
-	0x37, 0xbe, 0x74, 0x3f, 0x86, 0xa6, 0x51, 0xc5, 0x2e, 0xc2, 0xca, 0xf6, 0xa3, 0x47, 0xfb, 0x3b,
-	0xde, 0xed, 0xc7, 0x0f, 0xbe, 0xbc, 0xd3, 0xdb, 0xde, 0x7b, 0x74, 0xb0, 0xd3, 0xbe, 0x80, 0xf0,
-	0xde, 0xa3, 0xed, 0xdb, 0x7b, 0xbd, 0x7b, 0x8f, 0xbc, 0x6d, 0x05, 0x3b, 0x68, 0xe3, 0xbd, 0x9d,
-	0x8f, 0x1e, 0x3d, 0xde, 0xb1, 0xf0, 0x1a, 0x6b, 0x43, 0xeb, 0x8e, 0xb7, 0x73, 0x7b, 0x7b, 0x57,
-	0x22, 0x33, 0x6c, 0x0d, 0xda, 0xf7, 0x9e, 0x3c, 0xbc, 0xfb, 0xe0, 0xe1, 0xfd, 0xde, 0xf6, 0xed,
-	0x87, 0xdb, 0x3b, 0x7b, 0x3b, 0x77, 0xdb, 0x75, 0xf7, 0x6f, 0x1c, 0xb8, 0x48, 0xa3, 0x1c, 0x14,
-	0x05, 0xe2, 0x0a, 0x34, 0xfb, 0x51, 0x14, 0x73, 0xd4, 0xdf, 0x5a, 0x45, 0x9b, 0x10, 0x32, 0xbb,
-	0x50, 0x88, 0x47, 0x51, 0xd2, 0xe7, 0x52, 0x1e, 0x80, 0xa0, 0x7b, 0x88, 0x20, 0xb3, 0xcb, 0xed,
-	0x14, 0x14, 0x42, 0x1c, 0x9a, 0x02, 0x13, 0x24, 0xeb, 0x30, 0x77, 0x98, 0x70, 0xbf, 0x7f, 0x2c,
-	0x25, 0x41, 0x96, 0xd8, 0x27, 0x73, 0x87, 0xbc, 0x8f, 0xab, 0x3d, 0xe2, 0x03, 0xe2, 0x90, 0x05,
-	0x6f, 0x59, 0xe2, 0xdb, 0x12, 0x76, 0xf7, 0x61, 0xbd, 0x38, 0x03, 0x29, 0x31, 0xef, 0x19, 0x12,
-	0x23, 0x7c, 0xe3, 0xee, 0xd9, 0xfb, 0x63, 0x48, 0xcf, 0xbf, 0x3b, 0x50, 0x47, 0xf3, 0x79, 0xb6,
-	0xa9, 0x35, 0x3d, 0xa2, 0x19, 0xcb, 0x23, 0xa2, 0xe0, 0x02, 0x9e, 0x29, 0x84, 0x42, 0x15, 0x46,
-	0xc7, 0x40, 0xf2, 0xfa, 0x84, 0xf7, 0x4f, 0x68, 0x4e, 0xba, 0x1e, 0x11, 0x64, 0x79, 0x74, 0x3c,
-	0xe9, 0x6b, 0xc9, 0xf2, 0xaa, 0xac, 0xea, 0xe8, 0xcb, 0xf9, 0xbc, 0x8e, 0xbe, 0xeb, 0xc0, 0x7c,
-	0x10, 0x1e, 0x46, 0x93, 0x70, 0x40, 0x2c, 0xbe, 0xe0, 0xa9, 0x22, 0xaa, 0xca, 0x98, 0x44, 0x2f,
-	0x18, 0x2b, 0x86, 0xce, 0x01, 0x97, 0xe1, 0xc1, 0x24, 0x25, 0x77, 0x41, 0x7b, 0x81, 0xef, 0xc1,
-	0x8a, 0x81, 0xc9, 0xd5, 0x7c, 0x0b, 0x66, 0x63, 0x04, 0xe4, 0x52, 0x2a, 0xe5, 0x4c, 0x7e, 0x86,
-	0xa8, 0x71, 0xdb, 0xb0, 0x74, 0x9f, 0x67, 0x0f, 0xc2, 0xa3, 0x48, 0xb5, 0xf4, 0xc3, 0x19, 0x58,
-	0xd6, 0x90, 0x6c, 0xe8, 0x3a, 0x2c, 0x07, 0x03, 0x1e, 0x66, 0x41, 0x36, 0xed, 0x59, 0xe7, 0x9f,
-	0x22, 0x8c, 0xfe, 0x99, 0x3f, 0x0a, 0xfc, 0x54, 0x7a, 0x00, 0xa2, 0xc0, 0xb6, 0x60, 0x0d, 0x8d,
-	0x87, 0xb2, 0x07, 0x7a, 0x8b, 0xc5, 0x31, 0xac, 0xb2, 0x0e, 0xc5, 0x1b, 0x71, 0xa9, 0xbf, 0xf5,
-	0x27, 0xc2, 0x4f, 0xa9, 0xaa, 0xc2, 0x55, 0x13, 0x2d, 0xe1, 0x94, 0x67, 0x85, 0x81, 0xd1, 0x40,
-	0x29, 0x44, 0x34, 0x27, 0x94, 0x4f, 0x31, 0x44, 0x64, 0x84, 0x99, 0x16, 0x4a, 0x61, 0x26, 0x54,
-	0x4e, 0xd3, 0xb0, 0xcf, 0x07, 0xbd, 0x2c, 0xea, 0x91, 0x12, 0xa5, 0xdd, 0x59, 0xf0, 0x8a, 0x30,
-	0x05, 0xc4, 0x78, 0x9a, 0x85, 0x3c, 0x23, 0x3d, 0xb3, 0xe0, 0xa9, 0x22, 0xca, 0x0f, 0x91, 0x08,
-	0x93, 0xd0, 0xf0, 0x64, 0x09, 0x1d, 0xcd, 0x49, 0x12, 0xa4, 0x9d, 0x16, 0xa1, 0xf4, 0x9b, 0x7d,
-	0x1a, 0x2e, 0x1e, 0xf2, 0x34, 0xeb, 0x1d, 0x73, 0x7f, 0xc0, 0x13, 0xda, 0x7d, 0x11, 0xbd, 0x12,
-	0xf6, 0xbb, 0xba, 0x12, 0xfb, 0x3e, 0xe1, 0x49, 0x1a, 0x44, 0x21, 0x59, 0xee, 0x86, 0xa7, 0x8a,
-	0xee, 0x37, 0xc9, 0x1f, 0xd6, 0x71, 0xb5, 0x27, 0x64, 0xcc, 0xd9, 0x6b, 0xd0, 0x10, 0x73, 0x4c,
-	0x8f, 0x7d, 0xe9, 0xa2, 0x2f, 0x10, 0x70, 0x70, 0xec, 0xa3, 0x46, 0xb0, 0x96, 0x4d, 0x04, 0x2a,
-	0x9b, 0x84, 0xed, 0x8a, 0x55, 0xbb, 0x0a, 0x4b, 0x2a, 0x62, 0x97, 0xf6, 0x46, 0xfc, 0x28, 0x53,
-	0xc7, 0xeb, 0x70, 0x32, 0xc6, 0xee, 0xd2, 0x3d, 0x7e, 0x94, 0xb9, 0x0f, 0x61, 0x45, 0xca, 0xf0,
-	0xa3, 0x98, 0xab, 0xae, 0x3f, 0x5b, 0x65, 0xdd, 0x9a, 0x5b, 0xab, 0xb6, 0xd0, 0x53, 0x8c, 0xa0,
-	0x60, 0xf2, 0x5c, 0x0f, 0x98, 0xa9, 0x13, 0x64, 0x83, 0xd2, 0xc4, 0xa8, 0x43, 0xbc, 0x9c, 0x8e,
-	0x85, 0xe1, 0xfa, 0xa4, 0x93, 0x7e, 0x1f, 0x35, 0x81, 0xd0, 0x80, 0xaa, 0xe8, 0xfe, 0x99, 0x03,
-	0xab, 0xd4, 0x9a, 0xb2, 0xcf, 0xfa, 0xe4, 0xf7, 0xea, 0xc3, 0x6c, 0xf5, 0xcd, 0xc0, 0xc6, 0x1a,
-	0xcc, 0x9a, 0xba, 0x56, 0x14, 0x7e, 0xf4, 0xb3, 0x6c, 0xbd, 0x74, 0x96, 0xfd, 0xa1, 0x03, 0x2b,
-	0x42, 0x19, 0x66, 0x7e, 0x36, 0x49, 0xe5, 0xf4, 0x7f, 0x0e, 0x16, 0x85, 0x9d, 0x92, 0xe2, 0x24,
-	0x07, 0xba, 0xa6, 0x25, 0x9f, 0x50, 0x41, 0xbc, 0x7b, 0xc1, 0xb3, 0x89, 0xd9, 0xe7, 0xa1, 0x65,
-	0x86, 0x5d, 0x69, 0xcc, 0xcd, 0xad, 0x4b, 0x6a, 0x96, 0x25, 0xce, 0xd9, 0xbd, 0xe0, 0x59, 0x1f,
-	0xb0, 0x0f, 0xc9, 0xd9, 0x08, 0x7b, 0xd4, 0xac, 0x0c, 0x4c, 0x5d, 0xaa, 0x50, 0xe0, 0xfa, 0x73,
-	0x83, 0xfc, 0xce, 0x02, 0xcc, 0x09, 0xef, 0xd2, 0xbd, 0x0f, 0x8b, 0xd6, 0x48, 0xad, 0x33, 0x7a,
-	0x4b, 0x9c, 0xd1, 0x4b, 0x21, 0x9d, 0x5a, 0x39, 0xa4, 0xe3, 0x7e, 0x7b, 0x06, 0x18, 0x72, 0x5b,
-	0x61, 0x3b, 0xd1, 0xbd, 0x8d, 0x06, 0xd6, 0x61, 0xa5, 0xe5, 0x99, 0x10, 0xbb, 0x01, 0xcc, 0x28,
-	0xaa, 0xa8, 0x97, 0xb0, 0x1b, 0x15, 0x35, 0xa8, 0xe0, 0xa4, 0x61, 0x95, 0x26, 0x50, 0x1e, 0xcb,
-	0xc4, 0xbe, 0x55, 0xd6, 0xa1, 0x69, 0x88, 0x27, 0xe9, 0x31, 0xba, 0xdf, 0xea, 0x38, 0xa3, 0xca,
-	0x45, 0x06, 0x99, 0x3b, 0x97, 0x41, 0xe6, 0x8b, 0x0c, 0x62, 0x3a, 0xd4, 0x0b, 0x96, 0x43, 0x8d,
-	0x8e, 0xdc, 0x18, 0xdd, 0xbf, 0x6c, 0xd4, 0xef, 0x8d, 0xb1, 0x77, 0x79, 0x7a, 0xb1, 0x40, 0xb6,
-	0x09, 0x6d, 0xe9, 0x0a, 0xe4, 0x5e, 0x3b, 0xd0, 0x1a, 0x97, 0x70, 0xd4, 0xbc, 0xf8, 0x31, 0x69,
-	0x00, 0x3a, 0xc1, 0xcc, 0x7a, 0x39, 0xe0, 0xfe, 0xc0, 0x81, 0x36, 0xee, 0x82, 0xc5, 0xa9, 0x1f,
-	0x00, 0x09, 0xca, 0x2b, 0x32, 0xaa, 0x45, 0xfb, 0x93, 0xf3, 0xe9, 0xfb, 0xd0, 0xa0, 0x06, 0xa3,
-	0x98, 0x87, 0x92, 0x4d, 0x3b, 0x36, 0x9b, 0xe6, 0x3a, 0x6a, 0xf7, 0x82, 0x97, 0x13, 0x1b, 0x4c,
-	0xfa, 0x4f, 0x0e, 0x34, 0xe5, 0x30, 0x7f, 0xec, 0x73, 0x7a, 0x17, 0x16, 0x90, 0x5f, 0x8d, 0xc3,
-	0xb0, 0x2e, 0xa3, 0xad, 0x19, 0xfb, 0xd9, 0x24, 0x41, 0xe3, 0x6a, 0x9d, 0xd1, 0x8b, 0x30, 0x5a,
-	0x4a, 0x52, 0xc7, 0x69, 0x2f, 0x0b, 0x46, 0x3d, 0x55, 0x2b, 0xef, 0x40, 0xaa, 0xaa, 0x50, 0x2b,
-	0xa5, 0x99, 0x3f, 0xe4, 0xd2, 0x08, 0x8a, 0x82, 0xdb, 0x81, 0x75, 0x39, 0xa1, 0x82, 0x67, 0xe9,
-	0xfe, 0x75, 0x0b, 0x36, 0x4a, 0x55, 0xfa, 0x12, 0x51, 0x1e, 0x3e, 0x47, 0xc1, 0xf8, 0x30, 0xd2,
-	0x6e, 0xb8, 0x63, 0x9e, 0x4b, 0xad, 0x2a, 0x36, 0x84, 0x8b, 0xca, 0xda, 0xe3, 0x9a, 0xe6, 0xb6,
-	0xbd, 0x46, 0x6e, 0xca, 0xbb, 0x36, 0x0f, 0x14, 0x3b, 0x54, 0xb8, 0x29, 0xd7, 0xd5, 0xed, 0xb1,
-	0x63, 0xe8, 0x68, 0xb7, 0x42, 0x1a, 0x00, 0xc3, 0xf5, 0xc0, 0xbe, 0xde, 0x39, 0xa7, 0x2f, 0xcb,
-	0x4d, 0xf5, 0xce, 0x6c, 0x8d, 0x4d, 0xe1, 0xb2, 0xaa, 0x23, 0x0d, 0x5f, 0xee, 0xaf, 0xfe, 0x4a,
-	0x73, 0x23, 0x17, 0xdb, 0xee, 0xf4, 0x9c, 0x86, 0xd9, 0xd7, 0x61, 0xfd, 0xd4, 0x0f, 0x32, 0x35,
-	0x2c, 0xc3, 0x55, 0x9a, 0xa5, 0x2e, 0xb7, 0xce, 0xe9, 0xf2, 0xa9, 0xf8, 0xd8, 0x32, 0x7b, 0x67,
-	0xb4, 0xd8, 0xfd, 0x07, 0x07, 0x96, 0xec, 0x76, 0x90, 0x4d, 0xa5, 0x3a, 0x50, 0x6a, 0x51, 0xb9,
-	0x86, 0x05, 0xb8, 0x7c, 0x92, 0xad, 0x55, 0x9d, 0x64, 0xcd, 0xf3, 0xe3, 0xcc, 0x79, 0x41, 0x9e,
-	0xfa, 0xab, 0x05, 0x79, 0x66, 0xab, 0x82, 0x3c, 0xdd, 0xff, 0x76, 0x80, 0x95, 0x79, 0x89, 0xdd,
-	0x17, 0x47, 0xe9, 0x90, 0x8f, 0xa4, 0x4e, 0xfa, 0xd9, 0x57, 0xe3, 0x47, 0xb5, 0x76, 0xea, 0x6b,
-	0x14, 0x0c, 0x53, 0xe9, 0x98, 0x0e, 0xd4, 0xa2, 0x57, 0x55, 0x55, 0x08, 0x3b, 0xd5, 0xcf, 0x0f,
-	0x3b, 0xcd, 0x9e, 0x1f, 0x76, 0x9a, 0x2b, 0x86, 0x9d, 0xba, 0xbf, 0xe1, 0xc0, 0x6a, 0xc5, 0xa6,
-	0xff, 0xf4, 0x26, 0x8e, 0xdb, 0x64, 0xe9, 0x82, 0x9a, 0xdc, 0x26, 0x13, 0xec, 0xfe, 0x2a, 0x2c,
-	0x5a, 0x8c, 0xfe, 0xd3, 0xeb, 0xbf, 0xe8, 0x03, 0x0a, 0x3e, 0xb3, 0xb0, 0xee, 0x7f, 0xd4, 0x80,
-	0x95, 0x85, 0xed, 0xff, 0x75, 0x0c, 0xe5, 0x75, 0x9a, 0xa9, 0x58, 0xa7, 0xff, 0x53, 0x3b, 0xf0,
-	0x0e, 0xac, 0xc8, 0x8c, 0x03, 0x23, 0x80, 0x22, 0x38, 0xa6, 0x5c, 0x81, 0x5e, 0xb0, 0x1d, 0xf3,
-	0x5b, 0xb0, 0x6e, 0xaa, 0x0d, 0x63, 0x58, 0x08, 0xfd, 0xb9, 0xeb, 0xb0, 0x26, 0x32, 0x18, 0xee,
-	0x88, 0xa6, 0x94, 0x5d, 0xf9, 0x43, 0x07, 0x2e, 0x16, 0x2a, 0xf2, 0x7b, 0x53, 0x61, 0x3a, 0x6c,
-	0x7b, 0x62, 0x83, 0x38, 0x7e, 0x29, 0x47, 0xc6, 0xf8, 0x05, 0xb7, 0x95, 0x2b, 0x70, 0x7d, 0x26,
-	0x61, 0x99, 0x5e, 0xac, 0x7a, 0x55, 0x95, 0xbb, 0x21, 0xf2, 0x2c, 0x42, 0x3e, 0x2a, 0x0c, 0xfc,
-	0x48, 0x64, 0x46, 0x98, 0x15, 0xf9, 0xc5, 0x8b, 0x3d, 0x64, 0x55, 0x44, 0x1f, 0xd1, 0x32, 0x53,
-	0xf6, 0x78, 0x2b, 0xeb, 0xdc, 0xbf, 0x74, 0x80, 0x7d, 0x69, 0xc2, 0x93, 0x29, 0xdd, 0x9f, 0xea,
-	0x48, 0xcf, 0x46, 0x31, 0xca, 0x31, 0x17, 0x4f, 0x0e, 0xbf, 0xc8, 0xa7, 0xea, 0x16, 0xbe, 0x96,
-	0xdf, 0xc2, 0xbf, 0x01, 0x80, 0x87, 0x33, 0x7d, 0x29, 0x4b, 0xbe, 0x59, 0x38, 0x19, 0x8b, 0x06,
-	0x2b, 0x2f, 0xca, 0xeb, 0xe7, 0x5f, 0x94, 0xcf, 0x9e, 0x73, 0x51, 0xee, 0x7e, 0x08, 0xab, 0xd6,
-	0xb8, 0xf5, 0xb6, 0xaa, 0xeb, 0x61, 0xe7, 0x25, 0xd7, 0xc3, 0xff, 0xe9, 0xc0, 0xcc, 0x6e, 0x14,
-	0x9b, 0x51, 0x4d, 0xc7, 0x8e, 0x6a, 0x4a, 0x5b, 0xd2, 0xd3, 0xa6, 0x42, 0xaa, 0x18, 0x0b, 0x64,
-	0x9b, 0xb0, 0xe4, 0x8f, 0x33, 0x3c, 0x94, 0x1f, 0x45, 0xc9, 0xa9, 0x9f, 0x0c, 0xc4, 0x5e, 0xdf,
-	0xa9, 0x75, 0x1c, 0xaf, 0x50, 0xc3, 0xd6, 0x60, 0x46, 0x2b, 0x5d, 0x22, 0xc0, 0x22, 0x3a, 0x6e,
-	0x74, 0x23, 0x32, 0x95, 0xf1, 0x04, 0x59, 0x42, 0x56, 0xb2, 0xbf, 0x17, 0x8e, 0xb4, 0x10, 0x9d,
-	0xaa, 0x2a, 0xb4, 0x6b, 0xb8, 0x7c, 0x44, 0x26, 0x03, 0x41, 0xaa, 0xec, 0xfe, 0x9b, 0x03, 0xb3,
-	0xb4, 0x02, 0x28, 0xec, 0x82, 0xc3, 0x75, 0xf8, 0x92, 0x66, 0xbe, 0xe8, 0x15, 0x61, 0xe6, 0x5a,
-	0xd9, 0x2a, 0x35, 0x3d, 0x6c, 0x33, 0x63, 0xe5, 0x0a, 0x34, 0x44, 0x49, 0x67, 0x66, 0x10, 0x49,
-	0x0e, 0xb2, 0xcb, 0x50, 0x3f, 0x8e, 0x62, 0xe5, 0x9d, 0x80, 0x8a, 0xde, 0x47, 0xb1, 0x47, 0x78,
-	0x3e, 0x1e, 0x6c, 0x4f, 0x0c, 0x5e, 0xd8, 0x9c, 0x22, 0x8c, 0x56, 0x57, 0x37, 0x6b, 0x2e, 0x46,
-	0x01, 0x75, 0x37, 0x61, 0xf9, 0x61, 0x34, 0xe0, 0x46, 0xc4, 0xe9, 0x4c, 0x6e, 0x76, 0xbf, 0xe5,
-	0xc0, 0x82, 0x22, 0x66, 0xd7, 0xa1, 0x8e, 0xae, 0x44, 0xe1, 0xa0, 0xa0, 0x6f, 0xed, 0x90, 0xce,
-	0x23, 0x0a, 0xd4, 0xbd, 0x14, 0x8f, 0xc8, 0xdd, 0x4a, 0x15, 0x8d, 0xc8, 0xbd, 0x26, 0x3d, 0xdc,
-	0x82, 0xb3, 0x51, 0x40, 0xdd, 0x3f, 0x77, 0x60, 0xd1, 0xea, 0x03, 0x0f, 0x8f, 0x23, 0x3f, 0xcd,
-	0xe4, 0x4d, 0x88, 0xdc, 0x1e, 0x13, 0x32, 0x63, 0x90, 0x35, 0x3b, 0x06, 0xa9, 0xa3, 0x63, 0x33,
-	0x66, 0x74, 0xec, 0x16, 0x34, 0xf2, 0x9c, 0xa2, 0xba, 0xa5, 0x53, 0xb1, 0x47, 0x75, 0x1f, 0x99,
-	0x13, 0x61, 0x3b, 0xfd, 0x68, 0x14, 0x25, 0x32, 0x04, 0x2f, 0x0a, 0xee, 0x87, 0xd0, 0x34, 0xe8,
-	0x71, 0x18, 0x21, 0xcf, 0x4e, 0xa3, 0xe4, 0x99, 0x0a, 0x85, 0xca, 0xa2, 0xbe, 0x76, 0xaf, 0xe5,
-	0xd7, 0xee, 0xee, 0xdf, 0x3b, 0xb0, 0x88, 0x3c, 0x18, 0x84, 0xc3, 0xfd, 0x68, 0x14, 0xf4, 0xa7,
-	0xb4, 0xf7, 0x8a, 0xdd, 0xa4, 0x66, 0x50, 0xbc, 0x68, 0xc3, 0xc8, 0xdb, 0xea, 0xec, 0x28, 0x05,
-	0x51, 0x97, 0x51, 0x52, 0x91, 0xcf, 0x0f, 0xfd, 0x54, 0x32, 0xbf, 0x34, 0x72, 0x16, 0x88, 0xf2,
-	0x84, 0x40, 0xe2, 0x67, 0xbc, 0x37, 0x0e, 0x46, 0xa3, 0x40, 0xd0, 0x0a, 0x17, 0xa8, 0xaa, 0x0a,
-	0xfb, 0x1c, 0x04, 0xa9, 0x7f, 0x98, 0x87, 0x99, 0x75, 0xd9, 0xfd, 0x7e, 0x0d, 0x9a, 0x52, 0x3d,
-	0xef, 0x0c, 0x86, 0x5c, 0xde, 0x81, 0x90, 0x93, 0xa9, 0x55, 0x89, 0x81, 0xa8, 0x7a, 0xcb, 0x2d,
-	0x35, 0x90, 0xe2, 0x96, 0xcf, 0x94, 0xb7, 0xfc, 0x75, 0x68, 0x20, 0xeb, 0xbd, 0x4b, 0xfe, 0xaf,
-	0xb8, 0x3f, 0xc9, 0x01, 0x55, 0xbb, 0x45, 0xb5, 0xb3, 0x79, 0x2d, 0x01, 0x2f, 0xbd, 0x31, 0x79,
-	0x1f, 0x5a, 0xb2, 0x19, 0xda, 0x13, 0xd2, 0x1c, 0x39, 0xf3, 0x5b, 0xfb, 0xe5, 0x59, 0x94, 0xea,
-	0xcb, 0x2d, 0xf5, 0xe5, 0xc2, 0x79, 0x5f, 0x2a, 0x4a, 0xba, 0xdd, 0x16, 0x6b, 0x73, 0x3f, 0xf1,
-	0xe3, 0x63, 0x65, 0xf2, 0x06, 0x3a, 0x65, 0x87, 0x60, 0xb6, 0x09, 0xb3, 0xf8, 0x99, 0xd2, 0xe4,
-	0xd5, 0x02, 0x29, 0x48, 0xd8, 0x75, 0x98, 0xe5, 0x83, 0x21, 0x57, 0x27, 0x3c, 0x66, 0x9f, 0xb5,
-	0x71, 0x8f, 0x3c, 0x41, 0x80, 0xea, 0x01, 0xd1, 0x82, 0x7a, 0xb0, 0xad, 0xc0, 0x1c, 0x16, 0x1f,
-	0x0c, 0xdc, 0x35, 0x60, 0x0f, 0x05, 0x47, 0x9b, 0xf1, 0xeb, 0x6f, 0xcf, 0x40, 0xd3, 0x80, 0x51,
-	0xd2, 0x87, 0x38, 0xe0, 0xde, 0x20, 0xf0, 0xc7, 0x3c, 0xe3, 0x89, 0xe4, 0xe2, 0x02, 0x8a, 0x74,
-	0xfe, 0xc9, 0xb0, 0x17, 0x4d, 0xb2, 0xde, 0x80, 0x0f, 0x13, 0x2e, 0x0c, 0x33, 0x1a, 0x0a, 0x0b,
-	0x45, 0xba, 0xb1, 0xff, 0xdc, 0xa4, 0x13, 0xfc, 0x50, 0x40, 0x55, 0x34, 0x5a, 0xac, 0x51, 0x3d,
-	0x8f, 0x46, 0x8b, 0x15, 0x29, 0xea, 0xa8, 0xd9, 0x0a, 0x1d, 0xf5, 0x1e, 0xac, 0x0b, 0x6d, 0x24,
-	0xe5, 0xb6, 0x57, 0x60, 0x93, 0x33, 0x6a, 0xd9, 0x26, 0xb4, 0x71, 0xcc, 0x8a, 0xc1, 0xd3, 0xe0,
-	0x9b, 0x22, 0x3e, 0xe4, 0x78, 0x25, 0x1c, 0x69, 0x29, 0x50, 0x63, 0xd2, 0x8a, 0xfb, 0xb6, 0x12,
-	0x4e, 0xb4, 0xfe, 0x73, 0x9b, 0xb6, 0x21, 0x69, 0x0b, 0xb8, 0xbb, 0x08, 0xcd, 0x83, 0x2c, 0x8a,
-	0xd5, 0xa6, 0x2c, 0x41, 0x4b, 0x14, 0x65, 0x76, 0xc3, 0x6b, 0x70, 0x89, 0xb8, 0xe8, 0x71, 0x14,
-	0x47, 0xa3, 0x68, 0x38, 0x3d, 0x98, 0x1c, 0xa6, 0xfd, 0x24, 0x88, 0xf1, 0x34, 0xe4, 0xfe, 0xa3,
-	0x03, 0xab, 0x56, 0xad, 0x0c, 0x19, 0x7d, 0x5a, 0xb0, 0xb4, 0xbe, 0x96, 0x16, 0x8c, 0xb7, 0x62,
-	0xa8, 0x4a, 0x41, 0x28, 0x42, 0x79, 0x4f, 0xe4, 0x4d, 0xf5, 0x6d, 0x58, 0x56, 0x23, 0x53, 0x1f,
-	0x0a, 0x2e, 0xec, 0x94, 0xb9, 0x50, 0x7e, 0xbf, 0x24, 0x3f, 0x50, 0x4d, 0xfc, 0xbc, 0xbc, 0xb7,
-	0x1c, 0xd0, 0x1c, 0x55, 0xec, 0x40, 0xdf, 0x4c, 0x99, 0x27, 0x08, 0x35, 0x82, 0xbe, 0x06, 0x53,
-	0xf7, 0xb7, 0x1c, 0x80, 0x7c, 0x74, 0xc8, 0x18, 0xb9, 0xba, 0x17, 0xa9, 0xd6, 0x86, 0x6a, 0x7f,
-	0x0b, 0x5a, 0xfa, 0x4e, 0x25, 0xb7, 0x20, 0x4d, 0x85, 0xa1, 0x93, 0x77, 0x0d, 0x96, 0x87, 0xa3,
-	0xe8, 0x90, 0xcc, 0x2f, 0xa5, 0xcb, 0xa4, 0x32, 0xc7, 0x63, 0x49, 0xc0, 0xf7, 0x24, 0x9a, 0x9b,
-	0x9b, 0xba, 0x61, 0x6e, 0xdc, 0xef, 0xd4, 0x74, 0x24, 0x3e, 0x9f, 0xf3, 0x99, 0x52, 0xc6, 0xb6,
-	0x4a, 0xca, 0xf1, 0x8c, 0xc0, 0x37, 0x45, 0xc9, 0xf6, 0xcf, 0x3d, 0xc4, 0x7f, 0x08, 0x4b, 0x89,
-	0xd0, 0x3e, 0x4a, 0x35, 0xd5, 0x5f, 0xa2, 0x9a, 0x16, 0x13, 0xcb, 0x26, 0x7d, 0x12, 0xda, 0xfe,
-	0xe0, 0x84, 0x27, 0x59, 0x40, 0xc7, 0x28, 0x72, 0x08, 0x84, 0x42, 0x5d, 0x36, 0x70, 0xb2, 0xd3,
-	0xd7, 0x60, 0x59, 0xe6, 0xd5, 0x68, 0x4a, 0x99, 0x2b, 0x9a, 0xc3, 0x48, 0xe8, 0xfe, 0xb1, 0x0a,
-	0xfa, 0xdb, 0x7b, 0x78, 0xf6, 0x8a, 0x98, 0xb3, 0xab, 0x15, 0x66, 0xf7, 0x09, 0x19, 0x80, 0x1f,
-	0xa8, 0xb3, 0xda, 0x8c, 0x71, 0xc7, 0x3d, 0x90, 0x17, 0x26, 0xf6, 0x92, 0xd6, 0x5f, 0x65, 0x49,
-	0xdd, 0x1f, 0x38, 0x30, 0xbf, 0x1b, 0xc5, 0xbb, 0xf2, 0xb6, 0x9f, 0x04, 0x41, 0x67, 0xad, 0xa9,
-	0xe2, 0x4b, 0xf2, 0x00, 0x2a, 0xed, 0xf0, 0x62, 0xd1, 0x0e, 0xff, 0x02, 0xbc, 0x46, 0x91, 0x82,
-	0x24, 0x8a, 0xa3, 0x04, 0x85, 0xd1, 0x1f, 0x09, 0xa3, 0x1b, 0x85, 0xd9, 0xb1, 0x52, 0x63, 0x2f,
-	0x23, 0xa1, 0x23, 0x19, 0x1e, 0x25, 0x84, 0xa3, 0x2c, 0xfd, 0x06, 0xa1, 0xdd, 0xca, 0x15, 0xee,
-	0x67, 0xa1, 0x41, 0x8e, 0x2f, 0x4d, 0xeb, 0x1d, 0x68, 0x1c, 0x47, 0x71, 0xef, 0x38, 0x08, 0x33,
-	0x25, 0xdc, 0x4b, 0xb9, 0x47, 0xba, 0x4b, 0x0b, 0xa2, 0x09, 0xdc, 0xdf, 0x9f, 0x85, 0xf9, 0x07,
-	0xe1, 0x49, 0x14, 0xf4, 0xe9, 0x7e, 0x60, 0xcc, 0xc7, 0x91, 0xca, 0xe1, 0xc3, 0xdf, 0xb8, 0x14,
-	0x94, 0xcf, 0x12, 0x67, 0x32, 0xc0, 0xaf, 0x8a, 0x68, 0xee, 0x93, 0x3c, 0xcf, 0x56, 0x88, 0x8e,
-	0x81, 0xa0, 0xd3, 0x9f, 0x98, 0x29, 0xcb, 0xb2, 0x94, 0x27, 0x41, 0xce, 0x1a, 0x49, 0x90, 0x74,
-	0x9b, 0x24, 0x32, 0x13, 0x88, 0xbf, 0x16, 0x3c, 0x55, 0xa4, 0x43, 0x4a, 0xc2, 0x45, 0x84, 0x87,
-	0x1c, 0x87, 0x79, 0x79, 0x48, 0x31, 0x41, 0x74, 0x2e, 0xc4, 0x07, 0x82, 0x46, 0x28, 0x5f, 0x13,
-	0x42, 0x47, 0xac, 0x98, 0xf5, 0xdc, 0x10, 0x3c, 0x5f, 0x80, 0x51, 0x43, 0x0f, 0xb8, 0x56, 0xa4,
-	0x62, 0x0e, 0x20, 0xf2, 0x88, 0x8b, 0xb8, 0x71, 0xb4, 0x11, 0x29, 0x47, 0xea, 0x68, 0x83, 0x8c,
-	0xe2, 0x8f, 0x46, 0x87, 0x7e, 0xff, 0x19, 0x25, 0xb5, 0x53, 0x86, 0x51, 0xc3, 0xb3, 0x41, 0xca,
-	0x35, 0xc8, 0x77, 0x93, 0xee, 0x23, 0xeb, 0x9e, 0x09, 0xb1, 0x2d, 0x68, 0xd2, 0x71, 0x4e, 0xee,
-	0xe7, 0x12, 0xed, 0x67, 0xdb, 0x3c, 0xef, 0xd1, 0x8e, 0x9a, 0x44, 0xe6, 0x9d, 0xc5, 0xb2, 0x7d,
-	0x67, 0x21, 0x94, 0xa6, 0xbc, 0xea, 0x69, 0x53, 0x6f, 0x39, 0x80, 0xd6, 0x54, 0x2e, 0x98, 0x20,
-	0x58, 0x21, 0x02, 0x0b, 0x63, 0x97, 0x61, 0x01, 0x0f, 0x21, 0xb1, 0x1f, 0x0c, 0x3a, 0x4c, 0x9f,
-	0x85, 0x34, 0x86, 0x6d, 0xa8, 0xdf, 0x74, 0x25, 0xb3, 0x4a, 0xab, 0x62, 0x61, 0xb8, 0x36, 0xba,
-	0x4c, 0x42, 0xb4, 0x26, 0x76, 0xd4, 0x02, 0xdd, 0x0c, 0xd8, 0xed, 0xc1, 0x40, 0xf2, 0xa6, 0x3e,
-	0xfa, 0xe6, 0x5c, 0xe5, 0x58, 0x5c, 0x55, 0xb1, 0xbb, 0xb5, 0xea, 0xdd, 0x7d, 0xe9, 0x1a, 0xb8,
-	0x3b, 0xd0, 0xdc, 0x37, 0x12, 0xb7, 0x89, 0xc9, 0x55, 0xca, 0xb6, 0x14, 0x0c, 0x03, 0x31, 0x86,
-	0x53, 0x33, 0x87, 0xe3, 0xfe, 0x89, 0x03, 0x6c, 0x2f, 0x48, 0x33, 0x3d, 0x7c, 0xd1, 0xb7, 0x0b,
-	0x2d, 0x1d, 0xa0, 0xc8, 0xb3, 0xad, 0x2c, 0x0c, 0x69, 0x68, 0x28, 0xbd, 0xe8, 0xe8, 0x28, 0xe5,
-	0x2a, 0x93, 0xc2, 0xc2, 0x90, 0x43, 0xd1, 0xc7, 0x41, 0x7f, 0x21, 0x10, 0x3d, 0xa4, 0x32, 0xa3,
-	0xa2, 0x84, 0xa3, 0x9e, 0x4d, 0xf8, 0x09, 0x4f, 0x52, 0x2d, 0x5a, 0xba, 0xac, 0x93, 0xc2, 0x8a,
-	0xab, 0xbc, 0x09, 0x0b, 0xba, 0x5d, 0x5b, 0x85, 0x28, 0x4a, 0x5d, 0x8f, 0xaa, 0x8a, 0x7c, 0x78,
-	0x6b, 0xd0, 0x42, 0x6d, 0x96, 0x2b, 0xd8, 0x0d, 0x60, 0x47, 0x41, 0x52, 0x24, 0x9f, 0x21, 0xf2,
-	0x8a, 0x1a, 0xf7, 0x29, 0xac, 0xca, 0x2e, 0x4d, 0xe7, 0xc6, 0xde, 0x44, 0xe7, 0x3c, 0x46, 0xae,
-	0x95, 0x19, 0xd9, 0xfd, 0xbe, 0x03, 0xf3, 0x72, 0xa7, 0x69, 0x5b, 0x8a, 0x19, 0xfc, 0x0d, 0xcf,
-	0xc2, 0xaa, 0x73, 0xb7, 0xcb, 0xca, 0x69, 0xa6, 0x4a, 0x39, 0x31, 0xa8, 0xc7, 0x7e, 0x76, 0x4c,
-	0xa7, 0xd2, 0x86, 0x47, 0xbf, 0x59, 0x5b, 0x44, 0x4a, 0x84, 0x12, 0xa4, 0x28, 0x49, 0xd5, 0xf3,
-	0x05, 0x61, 0x6b, 0x4b, 0x38, 0x9e, 0x2e, 0x28, 0x51, 0x45, 0xe0, 0xfa, 0x86, 0x49, 0xa6, 0xd0,
-	0xe5, 0x70, 0xbe, 0x9f, 0xb2, 0x89, 0xe2, 0x7e, 0x4a, 0x52, 0x4f, 0xd7, 0xbb, 0x5d, 0xe8, 0xdc,
-	0xe5, 0x23, 0x9e, 0xf1, 0xdb, 0xa3, 0x51, 0xb1, 0xfd, 0xd7, 0xe0, 0x52, 0x45, 0x9d, 0xf4, 0x46,
-	0xef, 0xc1, 0xca, 0x5d, 0x7e, 0x38, 0x19, 0xee, 0xf1, 0x93, 0xfc, 0x92, 0x98, 0x41, 0x3d, 0x3d,
-	0x8e, 0x4e, 0x25, 0xa7, 0xd3, 0x6f, 0xf6, 0x06, 0xc0, 0x08, 0x69, 0x7a, 0x69, 0xcc, 0xfb, 0x2a,
-	0x6b, 0x99, 0x90, 0x83, 0x98, 0xf7, 0xdd, 0xf7, 0x80, 0x99, 0xed, 0xc8, 0x29, 0xa0, 0x82, 0x9f,
-	0x1c, 0xf6, 0xd2, 0x69, 0x9a, 0xf1, 0xb1, 0x4a, 0xc7, 0x36, 0x21, 0xf7, 0x1a, 0xb4, 0xf6, 0xfd,
-	0xa9, 0xc7, 0xbf, 0x21, 0x1f, 0x51, 0x6c, 0xc0, 0x7c, 0xec, 0x4f, 0x51, 0xee, 0x75, 0x40, 0x84,
-	0xaa, 0xdd, 0xff, 0xaa, 0xc1, 0x9c, 0xa0, 0xc4, 0x56, 0x07, 0x3c, 0xcd, 0x82, 0x50, 0x5c, 0x81,
-	0xca, 0x56, 0x0d, 0xa8, 0xc4, 0x1b, 0xb5, 0x0a, 0xde, 0x90, 0xc7, 0x10, 0x95, 0x01, 0x2a, 0x99,
-	0xc0, 0xc2, 0x90, 0x63, 0xf3, 0xc4, 0x13, 0x71, 0x22, 0xcf, 0x81, 0x42, 0x84, 0x2c, 0x37, 0x23,
-	0x62, 0x7c, 0x8a, 0xed, 0x25, 0x3b, 0x98, 0x50, 0xa5, 0xb1, 0x9a, 0x17, 0x5c, 0x53, 0x32, 0x56,
-	0x25, 0xa3, 0xb4, 0xf0, 0x0a, 0x46, 0x49, 0x9c, 0x4d, 0x5e, 0x66, 0x94, 0xe0, 0x15, 0x8c, 0x92,
-	0xcb, 0xa0, 0x7d, 0x8f, 0x73, 0x8f, 0xa3, 0xbb, 0xa3, 0xd8, 0xe9, 0xbb, 0x0e, 0xb4, 0xa5, 0xa7,
-	0xa6, 0xeb, 0xd8, 0x5b, 0x96, 0x5b, 0x57, 0x99, 0xa7, 0x79, 0x15, 0x16, 0xc9, 0xd9, 0xd2, 0xa1,
-	0x40, 0x19, 0xb7, 0xb4, 0x40, 0x9c, 0x87, 0xba, 0xaf, 0x19, 0x07, 0x23, 0xb9, 0x29, 0x26, 0xa4,
-	0xa2, 0x89, 0x89, 0x2f, 0x73, 0x43, 0x1c, 0x4f, 0x97, 0xdd, 0xbf, 0x72, 0x60, 0xc5, 0x18, 0xb0,
-	0xe4, 0xc2, 0x0f, 0x41, 0x25, 0xa6, 0x88, 0x88, 0xa1, 0x10, 0xa6, 0x0d, 0xdb, 0xeb, 0xcc, 0x3f,
-	0xb3, 0x88, 0x69, 0x33, 0xfd, 0x29, 0x0d, 0x30, 0x9d, 0x8c, 0xa5, 0x56, 0x32, 0x21, 0x64, 0xa4,
-	0x53, 0xce, 0x9f, 0x69, 0x12, 0xa1, 0x17, 0x2d, 0x8c, 0xf2, 0x0e, 0xd0, 0x49, 0xd4, 0x44, 0xc2,
-	0x40, 0xd8, 0xa0, 0xfb, 0xcf, 0x0e, 0xac, 0x0a, 0x6f, 0x5f, 0x9e, 0xa5, 0x74, 0x12, 0xfd, 0x9c,
-	0x38, 0xde, 0x08, 0x89, 0xdc, 0xbd, 0xe0, 0xc9, 0x32, 0xfb, 0xcc, 0x2b, 0x9e, 0x50, 0x74, 0xbe,
-	0xc9, 0x19, 0x7b, 0x31, 0x53, 0xb5, 0x17, 0x2f, 0x59, 0xe9, 0xaa, 0x08, 0xd9, 0x6c, 0x65, 0x84,
-	0xec, 0xce, 0x3c, 0xcc, 0xa6, 0xfd, 0x28, 0xe6, 0xee, 0x3a, 0xac, 0xd9, 0x93, 0x93, 0x2a, 0xe8,
-	0x7b, 0x0e, 0x74, 0xee, 0x89, 0x78, 0x71, 0x10, 0x0e, 0x77, 0x83, 0x34, 0x8b, 0x12, 0xfd, 0x6a,
-	0xe8, 0x32, 0x40, 0x9a, 0xf9, 0x49, 0x26, 0xf2, 0x01, 0x65, 0xfc, 0x2a, 0x47, 0x70, 0x8c, 0x3c,
-	0x1c, 0x88, 0x5a, 0xb1, 0x37, 0xba, 0x5c, 0x32, 0xca, 0xf2, 0x3c, 0x62, 0x99, 0xb6, 0xb7, 0x45,
-	0x02, 0x17, 0x1a, 0x5f, 0x7e, 0x42, 0xaa, 0x56, 0x38, 0xfa, 0x05, 0xd4, 0xfd, 0x0b, 0x07, 0x96,
-	0xf3, 0x41, 0xee, 0x20, 0x68, 0x6b, 0x07, 0x69, 0xcf, 0x72, 0xed, 0xa0, 0x22, 0x6b, 0x01, 0x1a,
-	0x38, 0x39, 0x36, 0x03, 0x21, 0x89, 0x95, 0xa5, 0x68, 0xa2, 0x3c, 0x06, 0x13, 0x12, 0xa9, 0x13,
-	0x68, 0x5a, 0xa5, 0x9b, 0x20, 0x4b, 0x94, 0xce, 0x39, 0xce, 0xe8, 0xab, 0x39, 0x71, 0xd2, 0x91,
-	0x45, 0x65, 0x9f, 0xe6, 0x09, 0xc5, 0x9f, 0xee, 0x6f, 0x3b, 0x70, 0xa9, 0x62, 0x71, 0xa5, 0x64,
-	0xdc, 0x85, 0x95, 0x23, 0x5d, 0xa9, 0x16, 0x40, 0x88, 0xc7, 0xba, 0xba, 0xe0, 0xb0, 0x27, 0xed,
-	0x95, 0x3f, 0xd0, 0xce, 0x84, 0x58, 0x52, 0x2b, 0x27, 0xa9, 0x5c, 0xb1, 0xf5, 0x3b, 0x33, 0xb0,
-	0x24, 0x2e, 0xbe, 0xc4, 0xfb, 0x5e, 0x9e, 0xb0, 0x8f, 0x60, 0x5e, 0xbe, 0xcf, 0x66, 0x17, 0x65,
-	0xb7, 0xf6, 0x8b, 0xf0, 0xee, 0x7a, 0x11, 0x96, 0xbc, 0xb3, 0xfa, 0xeb, 0x3f, 0xf8, 0xd7, 0xdf,
-	0xad, 0x2d, 0xb2, 0xe6, 0xcd, 0x93, 0x77, 0x6f, 0x0e, 0x79, 0x98, 0x62, 0x1b, 0xbf, 0x0c, 0x90,
-	0xbf, 0x5c, 0x66, 0x1d, 0xed, 0x04, 0x15, 0x9e, 0x64, 0x77, 0x2f, 0x55, 0xd4, 0xc8, 0x76, 0x2f,
-	0x51, 0xbb, 0xab, 0xee, 0x12, 0xb6, 0x1b, 0x84, 0x41, 0x26, 0x9e, 0x31, 0x7f, 0xe0, 0x6c, 0xb2,
-	0x01, 0xb4, 0xcc, 0x87, 0xc9, 0x4c, 0xc5, 0x42, 0x2a, 0x9e, 0x45, 0x77, 0x5f, 0xab, 0xac, 0x53,
-	0x81, 0x20, 0xea, 0xe3, 0xa2, 0xdb, 0xc6, 0x3e, 0x26, 0x44, 0x91, 0xf7, 0x32, 0x82, 0x25, 0xfb,
-	0xfd, 0x31, 0x7b, 0xdd, 0x10, 0xeb, 0xd2, 0xeb, 0xe7, 0xee, 0x1b, 0x67, 0xd4, 0xca, 0xbe, 0xde,
-	0xa0, 0xbe, 0x36, 0x5c, 0x86, 0x7d, 0xf5, 0x89, 0x46, 0xbd, 0x7e, 0xfe, 0xc0, 0xd9, 0xdc, 0xfa,
-	0xd6, 0x65, 0x68, 0xe8, 0xe8, 0x25, 0xfb, 0x3a, 0x2c, 0x5a, 0x37, 0x93, 0x4c, 0x4d, 0xa3, 0xea,
-	0x22, 0xb3, 0xfb, 0x7a, 0x75, 0xa5, 0xec, 0xf8, 0x32, 0x75, 0xdc, 0x61, 0xeb, 0xd8, 0xb1, 0xbc,
-	0xda, 0xbb, 0x49, 0xf7, 0xb1, 0x22, 0x59, 0xf4, 0x99, 0x98, 0x67, 0x7e, 0x9b, 0x68, 0xcd, 0xb3,
-	0x74, 0xfb, 0x68, 0xcd, 0xb3, 0x7c, 0x05, 0xe9, 0xbe, 0x4e, 0xdd, 0xad, 0xb3, 0x35, 0xb3, 0x3b,
-	0x1d, 0x55, 0xe4, 0x94, 0xde, 0x6b, 0x3e, 0x4f, 0x66, 0x6f, 0x68, 0xc6, 0xaa, 0x7a, 0xb6, 0xac,
-	0x59, 0xa4, 0xfc, 0x76, 0xd9, 0xed, 0x50, 0x57, 0x8c, 0xd1, 0xf6, 0x99, 0xaf, 0x93, 0xd9, 0x57,
-	0xa1, 0xa1, 0xdf, 0xda, 0xb1, 0x0d, 0xe3, 0x81, 0xa3, 0xf9, 0x00, 0xb0, 0xdb, 0x29, 0x57, 0x54,
-	0x31, 0x86, 0xd9, 0x32, 0x32, 0xc6, 0x1e, 0x5c, 0x94, 0x4e, 0xf5, 0x21, 0xff, 0x51, 0x66, 0x52,
-	0xf1, 0xa8, 0xfa, 0x96, 0xc3, 0x3e, 0x84, 0x05, 0xf5, 0x84, 0x91, 0xad, 0x57, 0x3f, 0xc5, 0xec,
-	0x6e, 0x94, 0x70, 0xa9, 0x3d, 0x6e, 0x03, 0xe4, 0xcf, 0xef, 0xb4, 0x9c, 0x95, 0x1e, 0x05, 0xea,
-	0x45, 0xac, 0x78, 0xab, 0x37, 0xa4, 0xc7, 0x86, 0xf6, 0xeb, 0x3e, 0xf6, 0x66, 0x4e, 0x5f, 0xf9,
-	0xee, 0xef, 0x25, 0x0d, 0xba, 0xeb, 0xb4, 0x76, 0x6d, 0x46, 0x82, 0x1b, 0xf2, 0x53, 0x95, 0xe8,
-	0x7e, 0x17, 0x9a, 0xc6, 0x93, 0x3e, 0xa6, 0x5a, 0x28, 0x3f, 0x07, 0xec, 0x76, 0xab, 0xaa, 0xe4,
-	0x70, 0xbf, 0x00, 0x8b, 0xd6, 0xdb, 0x3c, 0x2d, 0x19, 0x55, 0x2f, 0xff, 0xb4, 0x64, 0x54, 0x3f,
-	0xe7, 0xfb, 0x0a, 0x34, 0x8d, 0x97, 0x74, 0xcc, 0x48, 0xe1, 0x2b, 0xbc, 0xa1, 0xd3, 0x23, 0xaa,
-	0x7a, 0x78, 0xb7, 0x46, 0xf3, 0x5d, 0x72, 0x1b, 0x38, 0x5f, 0xca, 0xf6, 0x46, 0x26, 0xf9, 0x3a,
-	0x2c, 0xd9, 0x6f, 0xeb, 0xb4, 0x54, 0x55, 0xbe, 0xd2, 0xd3, 0x52, 0x75, 0xc6, 0x83, 0x3c, 0xc9,
-	0x90, 0x9b, 0xab, 0xba, 0x93, 0x9b, 0x1f, 0xcb, 0x7b, 0xbd, 0x17, 0xec, 0x4b, 0xa8, 0x3a, 0x64,
-	0xfa, 0x3d, 0xcb, 0x5f, 0x14, 0xda, 0x49, 0xfa, 0x9a, 0xdb, 0x4b, 0x99, 0xfa, 0xee, 0x0a, 0x35,
-	0xde, 0x64, 0xf9, 0x0c, 0x84, 0x3d, 0xa0, 0x34, 0x7c, 0xc3, 0x1e, 0x98, 0x99, 0xfa, 0x86, 0x3d,
-	0xb0, 0xb2, 0xf5, 0x8b, 0xf6, 0x20, 0x0b, 0xb0, 0x8d, 0x10, 0x96, 0x0b, 0x39, 0x2c, 0x5a, 0x58,
-	0xaa, 0x93, 0xfe, 0xba, 0x97, 0x5f, 0x9e, 0xfa, 0x62, 0xab, 0x19, 0xa5, 0x5e, 0x6e, 0xaa, 0x1c,
-	0xcd, 0x5f, 0x81, 0x96, 0xf9, 0x26, 0x4a, 0x5b, 0x88, 0x8a, 0x97, 0x5c, 0xda, 0x42, 0x54, 0x3d,
-	0xa2, 0x52, 0x9b, 0xcb, 0x5a, 0x66, 0x37, 0xb8, 0xb9, 0xf6, 0x13, 0x92, 0x5c, 0x65, 0x56, 0xbd,
-	0x8d, 0xc9, 0x55, 0x66, 0xe5, 0xbb, 0x13, 0xb5, 0xb9, 0x6c, 0xd5, 0x9a, 0x8b, 0x08, 0xda, 0xb2,
-	0xaf, 0xc0, 0xb2, 0x91, 0x20, 0x76, 0x30, 0x0d, 0xfb, 0x9a, 0x51, 0xcb, 0xc9, 0xc5, 0xdd, 0x2a,
-	0xcf, 0xd3, 0xdd, 0xa0, 0xf6, 0x57, 0x5c, 0x6b, 0x12, 0xc8, 0xa4, 0xdb, 0xd0, 0x34, 0x93, 0xcf,
-	0x5e, 0xd2, 0xee, 0x86, 0x51, 0x65, 0x66, 0xd2, 0xde, 0x72, 0xd8, 0x1f, 0x38, 0xd0, 0xb2, 0x52,
-	0xb9, 0xac, 0xab, 0x89, 0x42, 0x3b, 0x1d, 0xb3, 0xce, 0x6c, 0xc8, 0xf5, 0x68, 0x90, 0x7b, 0x9b,
-	0x5f, 0xb0, 0x16, 0xe1, 0x63, 0xeb, 0x04, 0x73, 0xa3, 0xf8, 0xb4, 0xfe, 0x45, 0x91, 0xc0, 0x4c,
-	0xc0, 0x7e, 0x71, 0xcb, 0x61, 0x1f, 0x88, 0x3f, 0x97, 0x50, 0x11, 0x0b, 0x66, 0x28, 0xd2, 0xe2,
-	0x92, 0x99, 0xff, 0x9c, 0x70, 0xdd, 0xb9, 0xe5, 0xb0, 0xaf, 0x89, 0x17, 0xf4, 0xf2, 0x5b, 0x5a,
-	0xf9, 0x57, 0xfd, 0xde, 0xbd, 0x4a, 0xb3, 0xb9, 0xec, 0x5e, 0xb2, 0x66, 0x53, 0xb4, 0x24, 0xb7,
-	0xc5, 0xe8, 0xe4, 0x1f, 0x23, 0xe4, 0x2a, 0xb1, 0xf4, 0x67, 0x09, 0x67, 0x0f, 0x72, 0x2c, 0x06,
-	0x29, 0xc9, 0x2d, 0xf6, 0x78, 0xc5, 0x66, 0xdc, 0x4d, 0x1a, 0xeb, 0x55, 0xf7, 0xcd, 0x33, 0xc7,
-	0x7a, 0x93, 0x4e, 0xa4, 0x38, 0xe2, 0x7d, 0x80, 0x3c, 0xba, 0xc8, 0x0a, 0xd1, 0x2d, 0x6d, 0x15,
-	0xca, 0x01, 0x48, 0x9b, 0x07, 0x55, 0x10, 0x0c, 0x5b, 0xfc, 0xaa, 0x10, 0xd5, 0x07, 0x2a, 0x2e,
-	0x76, 0xc9, 0x10, 0x47, 0x3b, 0x0c, 0xd8, 0xed, 0x56, 0x55, 0x55, 0x09, 0xaa, 0x0e, 0xb2, 0x3d,
-	0x81, 0xc5, 0xbd, 0x28, 0x7a, 0x36, 0x89, 0x75, 0xac, 0xde, 0x8e, 0xdf, 0xec, 0xfa, 0xe9, 0x71,
-	0xb7, 0x30, 0x0b, 0xf7, 0x0a, 0x35, 0xd5, 0x65, 0x1d, 0xa3, 0xa9, 0x9b, 0x1f, 0xe7, 0xd1, 0xcb,
-	0x17, 0xcc, 0x87, 0x15, 0xed, 0x01, 0xe8, 0x81, 0x77, 0xed, 0x66, 0xcc, 0xb8, 0x5b, 0xa9, 0x0b,
-	0xcb, 0x27, 0x53, 0xa3, 0xbd, 0x99, 0xaa, 0x36, 0x6f, 0x39, 0x6c, 0x1f, 0x5a, 0x77, 0x79, 0x3f,
-	0x1a, 0x70, 0x19, 0x71, 0x59, 0xcd, 0x07, 0xae, 0x43, 0x35, 0xdd, 0x45, 0x0b, 0xb4, 0x75, 0x62,
-	0xec, 0x4f, 0x13, 0xfe, 0x8d, 0x9b, 0x1f, 0xcb, 0x58, 0xce, 0x0b, 0xa5, 0x13, 0x55, 0xfc, 0xc9,
-	0xd2, 0x89, 0x85, 0x80, 0x95, 0xa5, 0x13, 0x4b, 0x01, 0x2b, 0x6b, 0xa9, 0x55, 0xfc, 0x8b, 0x8d,
-	0x60, 0xa5, 0x14, 0xe3, 0xd2, 0x7e, 0xc4, 0x59, 0x91, 0xb1, 0xee, 0x95, 0xb3, 0x09, 0xec, 0xde,
-	0x36, 0xed, 0xde, 0x0e, 0x60, 0xf1, 0x2e, 0x17, 0x8b, 0x25, 0x12, 0x02, 0x0a, 0x2f, 0xf5, 0xcc,
-	0xe4, 0x81, 0xa2, 0x52, 0xa4, 0x3a, 0xdb, 0xe8, 0xd1, 0x6d, 0x3c, 0xfb, 0x2a, 0x34, 0xef, 0xf3,
-	0x4c, 0x65, 0x00, 0x68, 0x6f, 0xac, 0x90, 0x12, 0xd0, 0xad, 0x48, 0x20, 0xb0, 0x79, 0x86, 0x5a,
-	0xbb, 0xc9, 0x07, 0x43, 0x2e, 0xd4, 0x53, 0x2f, 0x18, 0xbc, 0x60, 0xbf, 0x48, 0x8d, 0xeb, 0x84,
-	0xa2, 0x75, 0xe3, 0xe2, 0xd8, 0x6c, 0x7c, 0xb9, 0x80, 0x57, 0xb5, 0x1c, 0x46, 0x03, 0x6e, 0x98,
-	0xff, 0x10, 0x9a, 0x46, 0xb6, 0x9b, 0x16, 0xa0, 0x72, 0xe6, 0x9e, 0x16, 0xa0, 0x8a, 0xe4, 0x38,
-	0xf7, 0x3a, 0xf5, 0xe3, 0xb2, 0x2b, 0x79, 0x3f, 0x22, 0x21, 0x2e, 0xef, 0xe9, 0xe6, 0xc7, 0xfe,
-	0x38, 0x7b, 0xc1, 0x9e, 0xd2, 0xab, 0x3d, 0x33, 0xcb, 0x21, 0xf7, 0x06, 0x8b, 0x09, 0x11, 0x7a,
-	0xb1, 0x8c, 0x2a, 0xdb, 0x43, 0x14, 0x5d, 0x91, 0x97, 0xf0, 0x19, 0x80, 0x83, 0x2c, 0x8a, 0xef,
-	0xfa, 0x7c, 0x1c, 0x85, 0xb9, 0xae, 0xcd, 0x6f, 0xf2, 0x73, 0xfd, 0x65, 0x5c, 0xe7, 0xb3, 0xa7,
-	0x86, 0x3f, 0x6e, 0x25, 0x89, 0x28, 0xe6, 0x3a, 0xf3, 0xb2, 0x5f, 0x2f, 0x48, 0xc5, 0x85, 0xff,
-	0x2d, 0x07, 0xbd, 0xeb, 0x3c, 0xa2, 0xaa, 0xbd, 0xeb, 0x52, 0xb0, 0x56, 0xab, 0xbd, 0x8a, 0xf0,
-	0xeb, 0x3e, 0x34, 0xf2, 0x10, 0xdd, 0x46, 0x9e, 0xb1, 0x68, 0x05, 0xf4, 0xb4, 0x55, 0x2c, 0x05,
-	0xce, 0xdc, 0x36, 0x2d, 0x15, 0xb0, 0x05, 0x5c, 0x2a, 0x8a, 0x86, 0x05, 0xb0, 0x2a, 0x06, 0xa8,
-	0x4d, 0x3c, 0xdd, 0x4d, 0xab, 0x99, 0x54, 0x04, 0xaf, 0xb4, 0x34, 0x57, 0xc6, 0x7e, 0xac, 0x73,
-	0x36, 0x72, 0xab, 0xb8, 0x17, 0x47, 0xd5, 0x3c, 0x86, 0x95, 0x52, 0xe0, 0x42, 0x8b, 0xf4, 0x59,
-	0xf1, 0x22, 0x2d, 0xd2, 0x67, 0xc6, 0x3c, 0xdc, 0x8b, 0xd4, 0xe5, 0xb2, 0x0b, 0xd8, 0x65, 0x7a,
-	0x1a, 0x64, 0xfd, 0xe3, 0x0f, 0x9c, 0xcd, 0xc3, 0x39, 0xfa, 0x33, 0xba, 0x4f, 0xfd, 0x6f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x64, 0x91, 0xdd, 0xba, 0xbe, 0x4e, 0x00, 0x00,
+	// 9101 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0xbd, 0x4d, 0x70, 0x1c, 0x49,
+	0x76, 0x1f, 0xce, 0xea, 0x6e, 0x7c, 0xf4, 0xeb, 0x46, 0xa3, 0x91, 0xf8, 0x6a, 0x16, 0x3f, 0x86,
+	0x53, 0xc3, 0xff, 0x0e, 0xff, 0xd4, 0x2c, 0xc9, 0xc1, 0xee, 0xce, 0x72, 0x67, 0xbc, 0xb3, 0x02,
+	0x01, 0x90, 0xe0, 0x0e, 0x08, 0x62, 0x0b, 0xe0, 0xd2, 0xab, 0xb5, 0xdd, 0x2a, 0x74, 0x27, 0x80,
+	0x5a, 0x76, 0x57, 0xb5, 0xaa, 0xaa, 0x81, 0xe9, 0x1d, 0x4f, 0x84, 0x25, 0x6f, 0xd8, 0x17, 0x6f,
+	0xd8, 0x11, 0xf6, 0xc1, 0xf2, 0x47, 0x48, 0x21, 0x2b, 0x1c, 0x76, 0x38, 0x7c, 0xb4, 0x7c, 0x90,
+	0x1d, 0xd6, 0xc1, 0x07, 0xdb, 0x11, 0x0e, 0x1d, 0x74, 0x52, 0xf8, 0xaa, 0x8b, 0xc3, 0xbe, 0xfa,
+	0x6a, 0x3b, 0x5e, 0x7e, 0x55, 0x66, 0x55, 0x36, 0xc0, 0x91, 0x56, 0x0e, 0x87, 0x2f, 0x64, 0xe7,
+	0xef, 0xbd, 0xca, 0xcf, 0x97, 0x2f, 0x5f, 0xbe, 0x7c, 0x99, 0x80, 0x7a, 0x32, 0xea, 0x3d, 0x18,
+	0x25, 0x71, 0x16, 0x93, 0x99, 0x41, 0x94, 0x8c, 0x7a, 0xee, 0xcd, 0xd3, 0x38, 0x3e, 0x1d, 0xd0,
+	0x87, 0xc1, 0x28, 0x7c, 0x18, 0x44, 0x51, 0x9c, 0x05, 0x59, 0x18, 0x47, 0x29, 0x67, 0xf2, 0x7e,
+	0x15, 0x5a, 0xcf, 0x68, 0x74, 0x48, 0x69, 0xdf, 0xa7, 0xbf, 0x36, 0xa6, 0x69, 0x46, 0x7e, 0x09,
+	0x96, 0x02, 0xfa, 0x53, 0x4a, 0xfb, 0xdd, 0x51, 0x90, 0xa6, 0xa3, 0xb3, 0x24, 0x48, 0x69, 0xc7,
+	0xb9, 0xe3, 0xdc, 0x6b, 0xfa, 0x6d, 0x4e, 0x38, 0x50, 0x38, 0x79, 0x17, 0x9a, 0x29, 0xb2, 0xd2,
+	0x28, 0x4b, 0xe2, 0xd1, 0xa4, 0x53, 0x61, 0x7c, 0x0d, 0xc4, 0x76, 0x38, 0xe4, 0x0d, 0x60, 0x51,
+	0x95, 0x90, 0x8e, 0xe2, 0x28, 0xa5, 0xe4, 0x11, 0xac, 0xf4, 0xc2, 0xd1, 0x19, 0x4d, 0xba, 0xec,
+	0xe3, 0x61, 0x44, 0x87, 0x71, 0x14, 0xf6, 0x3a, 0xce, 0x9d, 0xea, 0xbd, 0xba, 0x4f, 0x38, 0x0d,
+	0xbf, 0x78, 0x21, 0x28, 0xe4, 0x7d, 0x58, 0xa4, 0x11, 0xc7, 0x69, 0x9f, 0x7d, 0x25, 0x8a, 0x6a,
+	0xe5, 0x30, 0x7e, 0xe0, 0xfd, 0x7b, 0x07, 0x96, 0x9e, 0x47, 0x61, 0xf6, 0x3a, 0x18, 0x0c, 0x68,
+	0x26, 0xdb, 0xf4, 0x3e, 0x2c, 0x5e, 0x30, 0x80, 0xb5, 0xe9, 0x22, 0x4e, 0xfa, 0xa2, 0x45, 0x2d,
+	0x0e, 0x1f, 0x08, 0x74, 0x6a, 0xcd, 0x2a, 0x53, 0x6b, 0x66, 0xed, 0xae, 0xea, 0x94, 0xee, 0x7a,
+	0x1f, 0x16, 0x13, 0xda, 0x8b, 0xcf, 0x69, 0x32, 0xe9, 0x5e, 0x84, 0x51, 0x3f, 0xbe, 0xe8, 0xd4,
+	0xee, 0x38, 0xf7, 0x66, 0xfc, 0x96, 0x84, 0x5f, 0x33, 0xd4, 0x5b, 0x01, 0xa2, 0xb7, 0x82, 0xf7,
+	0x9b, 0x77, 0x0a, 0xcb, 0xaf, 0xa2, 0x41, 0xdc, 0x7b, 0xf3, 0xa7, 0x6c, 0x9d, 0xa5, 0xf8, 0x8a,
+	0xb5, 0xf8, 0x35, 0x58, 0x31, 0x0b, 0x12, 0x15, 0xa0, 0xb0, 0xba, 0x75, 0x16, 0x44, 0xa7, 0x54,
+	0x66, 0x29, 0xab, 0xf0, 0xff, 0x43, 0xbb, 0x37, 0x4e, 0x12, 0x1a, 0x95, 0xea, 0xb0, 0x28, 0x70,
+	0x55, 0x89, 0x77, 0xa1, 0x19, 0xd1, 0x8b, 0x9c, 0x4d, 0x88, 0x4c, 0x44, 0x2f, 0x24, 0x8b, 0xd7,
+	0x81, 0xb5, 0x62, 0x31, 0xa2, 0x02, 0xff, 0xce, 0x81, 0x1b, 0x9c, 0x74, 0x68, 0xf4, 0xac, 0xac,
+	0xc7, 0x57, 0x97, 0xac, 0x8f, 0xe1, 0xba, 0xac, 0x79, 0x79, 0x1c, 0x79, 0xdd, 0xd6, 0x05, 0xc3,
+	0x66, 0x71, 0x38, 0x37, 0x60, 0x15, 0x9b, 0x32, 0x6d, 0xfc, 0x97, 0x23, 0x7a, 0x51, 0xfc, 0xc6,
+	0x9b, 0xc0, 0x4d, 0x7b, 0x03, 0xfe, 0xfc, 0xe7, 0xc6, 0x6f, 0x56, 0xa0, 0x71, 0x94, 0x04, 0x51,
+	0x1a, 0xf4, 0x50, 0x05, 0x90, 0x0e, 0xcc, 0x65, 0x9f, 0x77, 0xcf, 0x82, 0xf4, 0x8c, 0x8d, 0x55,
+	0xdd, 0x97, 0x49, 0xb2, 0x06, 0xb3, 0xc1, 0x30, 0x1e, 0x47, 0x19, 0xcb, 0xa9, 0xea, 0x8b, 0x14,
+	0xf9, 0x00, 0x96, 0xa2, 0xf1, 0xb0, 0xdb, 0x8b, 0xa3, 0x93, 0x30, 0x19, 0x72, 0x45, 0xc2, 0x1a,
+	0x3b, 0xe3, 0x97, 0x09, 0xe4, 0x36, 0xc0, 0x31, 0x0a, 0x11, 0x2f, 0xa2, 0xc6, 0x8a, 0xd0, 0x10,
+	0xe2, 0x41, 0x53, 0xa4, 0x68, 0x78, 0x7a, 0x96, 0x75, 0x66, 0x58, 0x46, 0x06, 0x86, 0x79, 0x64,
+	0xe1, 0x90, 0x76, 0xd3, 0x2c, 0x18, 0x8e, 0x3a, 0xb3, 0xac, 0x36, 0x1a, 0xc2, 0xe8, 0x71, 0x16,
+	0x0c, 0xba, 0x27, 0x94, 0xa6, 0x9d, 0x39, 0x41, 0x57, 0x08, 0xf9, 0x1a, 0xb4, 0xfa, 0x34, 0xcd,
+	0xba, 0x41, 0xbf, 0x9f, 0xd0, 0x34, 0xa5, 0x69, 0x67, 0x9e, 0x75, 0x64, 0x01, 0x45, 0x91, 0x7b,
+	0x46, 0x33, 0xad, 0x77, 0x52, 0x21, 0x52, 0xde, 0x1e, 0x10, 0x0d, 0xde, 0xa6, 0x59, 0x10, 0x0e,
+	0x52, 0xf2, 0x11, 0x34, 0x33, 0x8d, 0x99, 0x0d, 0x4f, 0x63, 0x83, 0x3c, 0x60, 0x3a, 0xf7, 0x81,
+	0xf6, 0x81, 0x6f, 0xf0, 0x89, 0x72, 0x7c, 0x31, 0xdd, 0x9e, 0x47, 0x27, 0xb1, 0x2c, 0xe7, 0x0f,
+	0x2a, 0xd0, 0xd4, 0x71, 0x72, 0x17, 0x16, 0xd4, 0x6c, 0x1d, 0xc6, 0x7d, 0xae, 0x84, 0xe7, 0x7d,
+	0x13, 0xc4, 0x21, 0x51, 0xc0, 0x49, 0x18, 0x85, 0xe9, 0x99, 0x18, 0xff, 0x79, 0xbf, 0x4c, 0x20,
+	0x2e, 0xcc, 0x8f, 0x92, 0xf8, 0x14, 0x1b, 0xcd, 0xc6, 0xcd, 0xf1, 0x55, 0x1a, 0x87, 0x23, 0xcd,
+	0x82, 0x24, 0x93, 0xc3, 0xc1, 0x35, 0x93, 0x81, 0x61, 0x77, 0xca, 0xd9, 0x62, 0x0c, 0x5a, 0x01,
+	0x25, 0x77, 0xa0, 0x71, 0x4c, 0x53, 0x99, 0x64, 0xe3, 0x36, 0xe3, 0xeb, 0x10, 0xb9, 0x07, 0x8b,
+	0xaa, 0xf7, 0xbb, 0x27, 0xf1, 0x38, 0xea, 0xb3, 0xd1, 0x5b, 0xf0, 0x8b, 0x30, 0x72, 0x16, 0xb5,
+	0xd6, 0x3c, 0xe7, 0x2c, 0xc0, 0xde, 0x33, 0x98, 0x7f, 0x4a, 0xe9, 0x5e, 0x38, 0x0c, 0x33, 0xb2,
+	0x06, 0x33, 0x27, 0xe1, 0xe7, 0x94, 0xab, 0xa1, 0xea, 0xee, 0x35, 0x9f, 0x27, 0x89, 0x0b, 0x73,
+	0x23, 0x9a, 0xf4, 0xa8, 0x94, 0xed, 0xdd, 0x6b, 0xbe, 0x04, 0x9e, 0xcc, 0xc1, 0xcc, 0x00, 0x3f,
+	0xf6, 0xfe, 0x43, 0x05, 0x1a, 0x87, 0x34, 0x52, 0xea, 0x8d, 0x40, 0x0d, 0xe5, 0x45, 0xa8, 0x34,
+	0xf6, 0x9b, 0xbc, 0x03, 0x0d, 0xfc, 0xbf, 0x9b, 0x66, 0x49, 0x18, 0x9d, 0xb2, 0xcc, 0xea, 0x3e,
+	0x20, 0x74, 0xc8, 0x10, 0xd2, 0x86, 0x6a, 0x30, 0xcc, 0x58, 0x37, 0x57, 0x7d, 0xfc, 0x89, 0xaa,
+	0x6f, 0x14, 0x4c, 0x86, 0xac, 0x9f, 0xe4, 0x94, 0x68, 0xfa, 0x0d, 0x81, 0xed, 0xe2, 0x9c, 0x78,
+	0x00, 0xcb, 0x3a, 0x8b, 0xcc, 0x7d, 0x86, 0xe5, 0xbe, 0xa4, 0x71, 0x8a, 0x42, 0xde, 0x87, 0x45,
+	0xc9, 0x9f, 0xf0, 0xca, 0xb2, 0xce, 0xae, 0xfb, 0x2d, 0x01, 0xcb, 0x26, 0xdc, 0x83, 0xf6, 0x49,
+	0x18, 0x05, 0x83, 0x6e, 0x6f, 0x90, 0x9d, 0x77, 0xfb, 0x74, 0x90, 0x05, 0xac, 0xc3, 0x67, 0xfc,
+	0x16, 0xc3, 0xb7, 0x06, 0xd9, 0xf9, 0x36, 0xa2, 0xe4, 0x03, 0xa8, 0x9f, 0x50, 0xda, 0x65, 0x3d,
+	0xc1, 0x7a, 0xba, 0xb1, 0xb1, 0x28, 0xe4, 0x5a, 0xf6, 0xae, 0x3f, 0x7f, 0x22, 0x7e, 0xe1, 0x04,
+	0x4c, 0x47, 0x61, 0x9f, 0x26, 0x9b, 0x83, 0xd3, 0xb8, 0x53, 0x67, 0x39, 0x6a, 0x88, 0xf7, 0xf7,
+	0x1c, 0x68, 0xf2, 0xae, 0x14, 0x0a, 0xee, 0x2e, 0x2c, 0xc8, 0x1a, 0xd3, 0x24, 0x89, 0x13, 0xa1,
+	0x7b, 0x4c, 0x90, 0xdc, 0x87, 0xb6, 0x04, 0x46, 0x09, 0x0d, 0x87, 0xc1, 0xa9, 0xd4, 0xc6, 0x25,
+	0x9c, 0x6c, 0xe4, 0x39, 0x26, 0xf1, 0x38, 0xe3, 0xea, 0xb7, 0xb1, 0xd1, 0x14, 0x95, 0xf6, 0x11,
+	0xf3, 0x4d, 0x16, 0xef, 0xe7, 0x0e, 0x10, 0xac, 0xd6, 0x51, 0xcc, 0xc9, 0xa2, 0x97, 0x8a, 0x23,
+	0xe4, 0xbc, 0xf5, 0x08, 0x55, 0xa6, 0x8d, 0xd0, 0x5d, 0x98, 0x65, 0x45, 0xe2, 0x84, 0xab, 0x96,
+	0xaa, 0x25, 0x68, 0xde, 0xef, 0x38, 0xd0, 0xc4, 0x75, 0x21, 0xa2, 0x83, 0x83, 0x38, 0x8c, 0x70,
+	0x25, 0x23, 0x27, 0xe3, 0xa8, 0x1f, 0x46, 0xa7, 0xdd, 0xec, 0xf3, 0xb0, 0xdf, 0x3d, 0x9e, 0x60,
+	0x16, 0xac, 0x3e, 0xbb, 0xd7, 0x7c, 0x0b, 0x8d, 0x7c, 0x00, 0x6d, 0x03, 0x4d, 0xb3, 0x84, 0xd7,
+	0x6a, 0xf7, 0x9a, 0x5f, 0xa2, 0xe0, 0x6c, 0x8f, 0xc7, 0xd9, 0x68, 0x9c, 0x75, 0xc3, 0xa8, 0x4f,
+	0x3f, 0x67, 0x7d, 0xb6, 0xe0, 0x1b, 0xd8, 0x93, 0x16, 0x34, 0xf5, 0xef, 0xbc, 0x9f, 0xc0, 0xfc,
+	0xcb, 0x71, 0xc6, 0xeb, 0x87, 0x8a, 0xb7, 0x50, 0x2f, 0x5f, 0x43, 0x50, 0xd3, 0x98, 0xb5, 0xf0,
+	0xe7, 0xbf, 0x4a, 0xd9, 0xde, 0xa7, 0xd0, 0xde, 0x43, 0x45, 0x11, 0x85, 0xd1, 0xe9, 0x26, 0xd7,
+	0x08, 0xb8, 0x2c, 0x8d, 0xc6, 0xc7, 0x6f, 0xe8, 0x44, 0xc8, 0x8c, 0x48, 0xe1, 0xf4, 0x3c, 0x8b,
+	0xd3, 0x4c, 0x94, 0xc3, 0x7e, 0x7b, 0xbf, 0x5e, 0x85, 0x45, 0x1c, 0xe0, 0x17, 0x41, 0x34, 0x91,
+	0xa3, 0xbb, 0x07, 0x4d, 0xcc, 0xea, 0x28, 0xde, 0xe4, 0x8b, 0x1b, 0x57, 0xda, 0xf7, 0xc4, 0x80,
+	0x14, 0xb8, 0x1f, 0xe8, 0xac, 0x68, 0xcc, 0x4e, 0x7c, 0xe3, 0x6b, 0x54, 0x00, 0x59, 0x90, 0x9c,
+	0xd2, 0x8c, 0x2d, 0x7b, 0x62, 0x19, 0x04, 0x0e, 0x6d, 0xc5, 0xd1, 0x09, 0xb9, 0x03, 0xcd, 0x34,
+	0xc8, 0xba, 0x23, 0x9a, 0xb0, 0x3e, 0x61, 0x93, 0xb8, 0xea, 0x43, 0x1a, 0x64, 0x07, 0x34, 0x79,
+	0x32, 0xc9, 0x28, 0xf9, 0x3a, 0xd4, 0xb1, 0xd1, 0xd8, 0xa1, 0x69, 0x67, 0xf6, 0x4e, 0x55, 0x9b,
+	0x6a, 0xb2, 0xa3, 0xfd, 0x9c, 0x83, 0x1c, 0xc1, 0x7a, 0x2f, 0x0e, 0xa3, 0x6e, 0x4a, 0x07, 0x94,
+	0xad, 0x27, 0xd8, 0x9b, 0x41, 0x46, 0x4f, 0x27, 0x6c, 0x2a, 0xb7, 0x36, 0x6e, 0x8a, 0x8f, 0xb7,
+	0xe2, 0x30, 0x3a, 0x94, 0x4c, 0x87, 0x82, 0xc7, 0x5f, 0xed, 0xd9, 0x60, 0xb2, 0x0e, 0x73, 0x6c,
+	0xc5, 0x0d, 0xfb, 0x6c, 0xb6, 0x37, 0xfd, 0x59, 0x4c, 0x3e, 0xef, 0xbb, 0xdf, 0x83, 0xa5, 0x52,
+	0x1f, 0xa0, 0x56, 0xcb, 0x07, 0x00, 0x7f, 0x92, 0x15, 0x98, 0x39, 0x0f, 0x06, 0x63, 0x2a, 0x6c,
+	0x05, 0x9e, 0xf8, 0xb8, 0xf2, 0xd8, 0xf1, 0xbe, 0x06, 0xed, 0xbc, 0x53, 0xc5, 0xf4, 0x27, 0x50,
+	0x43, 0x39, 0x10, 0x19, 0xb0, 0xdf, 0xde, 0xb7, 0x81, 0xec, 0xa4, 0x59, 0x38, 0x0c, 0x32, 0xfa,
+	0x94, 0xea, 0x73, 0x51, 0xeb, 0x5f, 0xbe, 0xc4, 0x2e, 0xf8, 0x8d, 0xbc, 0x83, 0x53, 0x6f, 0x0c,
+	0x8d, 0xa7, 0x94, 0xca, 0x6f, 0x71, 0xd5, 0xd1, 0x47, 0xc4, 0x61, 0x62, 0xa5, 0x43, 0x4c, 0x5b,
+	0x89, 0x21, 0x79, 0x73, 0x21, 0x2a, 0xac, 0x21, 0xa8, 0x9c, 0x64, 0xea, 0x9c, 0x8d, 0x19, 0xd7,
+	0xde, 0x26, 0xe8, 0x3d, 0x83, 0x65, 0xa3, 0xbe, 0xca, 0x74, 0xab, 0x53, 0x01, 0x17, 0x0d, 0x02,
+	0xad, 0x96, 0x7e, 0xce, 0xe4, 0xfd, 0xba, 0x03, 0x64, 0x8f, 0x06, 0x29, 0x7d, 0xc9, 0x44, 0x5f,
+	0xb6, 0xbc, 0x05, 0x95, 0x50, 0xda, 0xcf, 0x95, 0xb0, 0x4f, 0x7e, 0x09, 0xe6, 0xa5, 0x10, 0xb0,
+	0x3a, 0x5b, 0xa4, 0x44, 0x31, 0x90, 0x07, 0x40, 0xe8, 0xe7, 0xa3, 0x30, 0x09, 0xb8, 0x80, 0xd0,
+	0x5e, 0x1c, 0xf5, 0xf9, 0x62, 0x5f, 0xf3, 0x2d, 0x14, 0xef, 0x5b, 0xb0, 0x6c, 0x54, 0x41, 0x34,
+	0xe6, 0x36, 0x40, 0xce, 0xcc, 0xea, 0x52, 0xf3, 0x35, 0xc4, 0x3b, 0x84, 0x15, 0x9f, 0x0e, 0x7e,
+	0xb1, 0x75, 0xf7, 0xd6, 0x61, 0xb5, 0x90, 0xa9, 0xb0, 0xfb, 0x97, 0x61, 0x69, 0x2f, 0x4c, 0x33,
+	0x56, 0x51, 0x65, 0x99, 0x9d, 0x41, 0xfd, 0x55, 0xf6, 0x79, 0xcc, 0xc0, 0x3f, 0x5b, 0x9f, 0x99,
+	0x8d, 0xad, 0x96, 0x1a, 0xfb, 0x29, 0x10, 0xbd, 0x78, 0xd1, 0x45, 0xf7, 0x60, 0x96, 0xd5, 0x55,
+	0x0e, 0x76, 0x5b, 0x14, 0xa0, 0x2a, 0xe5, 0x0b, 0xba, 0xf7, 0x5b, 0x15, 0x3e, 0x13, 0x70, 0x5e,
+	0xa6, 0x9a, 0x51, 0x81, 0xa6, 0x8e, 0x9c, 0x09, 0xf8, 0x7b, 0xaa, 0xe1, 0xfd, 0xff, 0xba, 0xae,
+	0xf1, 0xde, 0x87, 0x25, 0xad, 0x83, 0x2e, 0xd1, 0x15, 0x3f, 0x77, 0x60, 0x69, 0x9f, 0x5e, 0x88,
+	0x25, 0x41, 0xf6, 0xe5, 0x63, 0xa8, 0x65, 0x93, 0x11, 0x37, 0x91, 0x5b, 0x1b, 0x77, 0x45, 0xd5,
+	0x4a, 0x7c, 0x0f, 0x44, 0xf2, 0x68, 0x32, 0xa2, 0x3e, 0xfb, 0xc2, 0xfb, 0x14, 0x1a, 0x1a, 0x48,
+	0xd6, 0x61, 0xf9, 0xf5, 0xf3, 0xa3, 0xfd, 0x9d, 0xc3, 0xc3, 0xee, 0xc1, 0xab, 0x27, 0x9f, 0xed,
+	0xfc, 0xa8, 0xbb, 0xbb, 0x79, 0xb8, 0xdb, 0xbe, 0x46, 0xd6, 0x80, 0xec, 0xef, 0x1c, 0x1e, 0xed,
+	0x6c, 0x1b, 0xb8, 0xe3, 0xb9, 0xd0, 0xd9, 0xa7, 0x17, 0xaf, 0xc3, 0x2c, 0xa2, 0x69, 0x6a, 0x96,
+	0xe6, 0x3d, 0x00, 0xa2, 0x57, 0x41, 0xb4, 0xaa, 0x03, 0x73, 0xc2, 0xc4, 0x95, 0xdb, 0x2e, 0x91,
+	0xf4, 0xbe, 0x06, 0xe4, 0x30, 0x3c, 0x8d, 0x5e, 0xd0, 0x34, 0x0d, 0x4e, 0x95, 0x1e, 0x6c, 0x43,
+	0x75, 0x98, 0x9e, 0x0a, 0xd1, 0xc6, 0x9f, 0xde, 0x37, 0x60, 0xd9, 0xe0, 0x13, 0x19, 0xdf, 0x84,
+	0x7a, 0x1a, 0x9e, 0x46, 0x41, 0x36, 0x4e, 0xa8, 0xc8, 0x3a, 0x07, 0xbc, 0xa7, 0xb0, 0xf2, 0x43,
+	0x9a, 0x84, 0x27, 0x93, 0xab, 0xb2, 0x37, 0xf3, 0xa9, 0x14, 0xf3, 0xd9, 0x81, 0xd5, 0x42, 0x3e,
+	0xa2, 0x78, 0xbe, 0x0e, 0x88, 0xe1, 0x9a, 0xf7, 0x79, 0x42, 0x5b, 0xb3, 0x2b, 0xfa, 0x9a, 0xed,
+	0xbd, 0x02, 0xb2, 0x15, 0x47, 0x11, 0xed, 0x65, 0x07, 0x94, 0x26, 0xb9, 0xf3, 0x29, 0x9f, 0x13,
+	0x8d, 0x8d, 0x75, 0x31, 0x8e, 0x45, 0x43, 0x40, 0x4c, 0x16, 0x02, 0xb5, 0x11, 0x4d, 0x86, 0x62,
+	0xb7, 0xc3, 0x7e, 0x7b, 0xab, 0xb0, 0x6c, 0x64, 0x2b, 0xf4, 0xc7, 0x87, 0xb0, 0xba, 0x1d, 0xa6,
+	0xbd, 0x72, 0x81, 0x1d, 0x98, 0x1b, 0x8d, 0x8f, 0xbb, 0xf9, 0x92, 0x26, 0x93, 0xb8, 0x53, 0x2b,
+	0x7e, 0x22, 0x32, 0xfb, 0x1b, 0x0e, 0xd4, 0x76, 0x8f, 0xf6, 0xb6, 0xd0, 0xc6, 0x09, 0xa3, 0x5e,
+	0x3c, 0x44, 0xfb, 0x8f, 0x37, 0x5a, 0xa5, 0xa7, 0xce, 0xe4, 0x9b, 0x50, 0x67, 0x66, 0x23, 0xca,
+	0xbd, 0xf0, 0x13, 0xe4, 0x00, 0xee, 0xe6, 0x34, 0x15, 0xad, 0x6d, 0xc4, 0x16, 0xfc, 0x32, 0xc1,
+	0xfb, 0x9f, 0x35, 0x98, 0x13, 0x46, 0x23, 0x2b, 0xaf, 0x97, 0x85, 0xe7, 0x72, 0x9b, 0x28, 0x52,
+	0x7c, 0x17, 0x39, 0x8c, 0x33, 0xda, 0x35, 0x86, 0xc1, 0x04, 0x91, 0xab, 0xc7, 0x33, 0xea, 0x72,
+	0x95, 0x59, 0xe5, 0x5c, 0x06, 0x88, 0x9d, 0x85, 0x00, 0xce, 0xde, 0x1a, 0xd3, 0x91, 0x32, 0x89,
+	0x3d, 0xd1, 0x0b, 0x46, 0x41, 0x2f, 0xcc, 0x26, 0x42, 0xf5, 0xa8, 0x34, 0xe6, 0x3d, 0x88, 0x7b,
+	0xc1, 0xa0, 0x7b, 0x1c, 0x0c, 0x82, 0xa8, 0x47, 0xc5, 0x2e, 0xde, 0x04, 0x71, 0x67, 0x29, 0xaa,
+	0x24, 0xd9, 0xf8, 0x66, 0xbe, 0x80, 0xa2, 0xaa, 0xee, 0xc5, 0xc3, 0x61, 0x98, 0xe1, 0xfe, 0x9e,
+	0x29, 0x91, 0xaa, 0xaf, 0x21, 0xac, 0x25, 0x3c, 0x75, 0xc1, 0x7b, 0xaf, 0xce, 0x4b, 0x33, 0x40,
+	0xcc, 0x05, 0xf7, 0x38, 0xc2, 0x0e, 0x00, 0x9e, 0x4b, 0x8e, 0xe0, 0x38, 0x8c, 0xa3, 0x94, 0x66,
+	0xd9, 0x80, 0xf6, 0x55, 0x85, 0x1a, 0x8c, 0xad, 0x4c, 0x20, 0x8f, 0x60, 0x99, 0xbb, 0x1c, 0xd2,
+	0x20, 0x8b, 0xd3, 0xb3, 0x30, 0xed, 0xa6, 0xb8, 0xbf, 0x6c, 0x32, 0x7e, 0x1b, 0x89, 0x3c, 0x86,
+	0xf5, 0x02, 0x9c, 0xd0, 0x1e, 0x0d, 0xcf, 0x69, 0xbf, 0xb3, 0xc0, 0xbe, 0x9a, 0x46, 0x46, 0x1b,
+	0x07, 0x3d, 0x2d, 0xe3, 0x51, 0x9f, 0x99, 0x19, 0x2d, 0x36, 0x0e, 0x3a, 0x44, 0x3e, 0x84, 0x85,
+	0x11, 0xe5, 0x56, 0xfb, 0x59, 0x36, 0xe8, 0xa5, 0x9d, 0x45, 0xa6, 0xec, 0x1b, 0x62, 0x32, 0xa1,
+	0xe4, 0xfa, 0x26, 0x07, 0x0a, 0x65, 0x2f, 0x65, 0xbb, 0xc2, 0x60, 0xd2, 0x69, 0x33, 0x71, 0xcb,
+	0x01, 0x36, 0x47, 0x92, 0xf0, 0x3c, 0xc8, 0x68, 0x67, 0x89, 0xc9, 0x96, 0x4c, 0x7a, 0xbf, 0xe5,
+	0xc0, 0x32, 0x2e, 0x8c, 0x42, 0x08, 0x95, 0x3a, 0x7e, 0x07, 0x1a, 0x5c, 0xfc, 0xba, 0x71, 0x34,
+	0x98, 0x08, 0x89, 0x04, 0x0e, 0xbd, 0x8c, 0x06, 0x13, 0xf2, 0x1e, 0x2c, 0x84, 0x91, 0xce, 0xc2,
+	0xe7, 0x70, 0x33, 0x8c, 0x34, 0xa6, 0x77, 0xa0, 0x31, 0x1a, 0x1f, 0x0f, 0xc2, 0x1e, 0x67, 0xa9,
+	0xf2, 0x5c, 0x38, 0xc4, 0x18, 0x70, 0xb7, 0xc6, 0x6b, 0xc2, 0x39, 0x6a, 0x8c, 0xa3, 0x21, 0x30,
+	0x64, 0xf1, 0x9e, 0xc0, 0x8a, 0x59, 0x41, 0xa1, 0xac, 0xee, 0xc3, 0xbc, 0x90, 0xed, 0xb4, 0xd3,
+	0x60, 0xfd, 0xd3, 0x92, 0xeb, 0x19, 0x87, 0x7d, 0x45, 0xf7, 0xfe, 0x69, 0x0d, 0x96, 0x05, 0xba,
+	0x35, 0x88, 0x53, 0x7a, 0x38, 0x1e, 0x0e, 0x83, 0xc4, 0x32, 0x69, 0x9c, 0x2b, 0x26, 0x4d, 0xc5,
+	0x9c, 0x34, 0x28, 0xca, 0x67, 0x41, 0x18, 0xf1, 0xad, 0x26, 0x9f, 0x71, 0x1a, 0x82, 0x8e, 0x8f,
+	0xde, 0x20, 0x4e, 0xf9, 0xf6, 0x4b, 0x77, 0xa2, 0x15, 0xe1, 0xf2, 0x24, 0x9f, 0xb1, 0x4d, 0x72,
+	0x7d, 0x92, 0xce, 0x16, 0x26, 0xa9, 0x07, 0x4d, 0xcc, 0x94, 0x4a, 0x9d, 0xc3, 0x7d, 0x31, 0x06,
+	0x86, 0xf5, 0x29, 0x4e, 0x09, 0x3e, 0xff, 0x16, 0x6d, 0x13, 0x02, 0x7d, 0x74, 0xa8, 0xd3, 0x34,
+	0xee, 0xba, 0x98, 0x10, 0x65, 0x12, 0x79, 0x0a, 0xc0, 0xcb, 0x62, 0xcb, 0x38, 0xb0, 0x65, 0xfc,
+	0x6b, 0xe6, 0x88, 0xe8, 0x7d, 0xff, 0x00, 0x13, 0xe3, 0x84, 0xb2, 0x85, 0x5c, 0xfb, 0xd2, 0xfb,
+	0x02, 0x1a, 0x1a, 0x89, 0xac, 0xc2, 0xd2, 0xd6, 0xcb, 0x97, 0x07, 0x3b, 0xfe, 0xe6, 0xd1, 0xf3,
+	0x1f, 0xee, 0x74, 0xb7, 0xf6, 0x5e, 0x1e, 0xee, 0xb4, 0xaf, 0x21, 0xbc, 0xf7, 0x72, 0x6b, 0x73,
+	0xaf, 0xfb, 0xf4, 0xa5, 0xbf, 0x25, 0x61, 0x07, 0xd7, 0x78, 0x7f, 0xe7, 0xc5, 0xcb, 0xa3, 0x1d,
+	0x03, 0xaf, 0x90, 0x36, 0x34, 0x9f, 0xf8, 0x3b, 0x9b, 0x5b, 0xbb, 0x02, 0xa9, 0x92, 0x15, 0x68,
+	0x3f, 0x7d, 0xb5, 0xbf, 0xfd, 0x7c, 0xff, 0x59, 0x77, 0x6b, 0x73, 0x7f, 0x6b, 0x67, 0x6f, 0x67,
+	0xbb, 0x5d, 0xf3, 0xfe, 0xc0, 0x81, 0x55, 0x56, 0xcb, 0x7e, 0x71, 0x42, 0xdc, 0x81, 0x46, 0x2f,
+	0x8e, 0x47, 0x34, 0x09, 0x34, 0x15, 0xad, 0x43, 0x28, 0xec, 0x5c, 0x21, 0x9e, 0xc4, 0x49, 0x8f,
+	0x8a, 0xf9, 0x00, 0x0c, 0x7a, 0x8a, 0x08, 0x0a, 0xbb, 0x18, 0x4e, 0xce, 0xc1, 0xa7, 0x43, 0x83,
+	0x63, 0x9c, 0x65, 0x0d, 0x66, 0x8f, 0x13, 0x1a, 0xf4, 0xce, 0xc4, 0x4c, 0x10, 0x29, 0xf4, 0xce,
+	0xcb, 0x7d, 0x7c, 0x0f, 0x7b, 0x7b, 0x40, 0xfb, 0x4c, 0x42, 0xe6, 0xfd, 0x45, 0x81, 0x6f, 0x09,
+	0xd8, 0x3b, 0x80, 0xb5, 0x62, 0x0b, 0xc4, 0x8c, 0xf9, 0x48, 0x9b, 0x31, 0xdc, 0xde, 0x75, 0xa7,
+	0x8f, 0x8f, 0x36, 0x7b, 0x7e, 0xaf, 0x06, 0x35, 0x5c, 0x3e, 0xa7, 0x2f, 0xb5, 0xba, 0x45, 0x54,
+	0x35, 0x2c, 0x22, 0xe6, 0x42, 0x9e, 0x64, 0x54, 0x28, 0x54, 0xbe, 0xe8, 0x68, 0x48, 0x4e, 0x4f,
+	0x68, 0xef, 0xbc, 0x33, 0xa3, 0xd3, 0x11, 0x41, 0x91, 0x47, 0xb3, 0x98, 0x7d, 0x2d, 0x44, 0x5e,
+	0xa6, 0x25, 0x8d, 0x7d, 0x39, 0x97, 0xd3, 0xd8, 0x77, 0x1d, 0x98, 0x0b, 0xa3, 0x63, 0xe6, 0x95,
+	0x9c, 0xe7, 0x2a, 0x4f, 0x24, 0x51, 0x55, 0x8e, 0xd8, 0xd4, 0x0b, 0x87, 0x52, 0xa0, 0x73, 0x80,
+	0x6c, 0x40, 0x3d, 0x9d, 0x44, 0x3d, 0x5d, 0x8a, 0x57, 0x44, 0x2f, 0x61, 0x1f, 0x3c, 0x38, 0x9c,
+	0x44, 0x3d, 0x26, 0xb3, 0x39, 0x1b, 0xb6, 0x81, 0x25, 0xd2, 0x2c, 0xc8, 0xf8, 0x22, 0x53, 0xf7,
+	0x35, 0x84, 0x6c, 0xc0, 0xca, 0x20, 0x40, 0xc7, 0x69, 0x98, 0x66, 0x71, 0x12, 0xa2, 0x8c, 0x20,
+	0x55, 0x2c, 0x2f, 0x56, 0x1a, 0x79, 0x02, 0x6d, 0x61, 0xb9, 0x70, 0xcb, 0x3d, 0xc8, 0x52, 0xb6,
+	0xb0, 0x34, 0x36, 0xd6, 0x94, 0xd9, 0x2e, 0xc9, 0x87, 0x48, 0xf5, 0x4b, 0xfc, 0xe4, 0xbb, 0xb0,
+	0x30, 0xe4, 0x26, 0x9e, 0xc8, 0xa0, 0x75, 0xa7, 0xaa, 0x19, 0x65, 0xc2, 0xfc, 0xc3, 0xd6, 0xf0,
+	0x1c, 0x4c, 0x6e, 0xef, 0x7b, 0x30, 0x2f, 0x5b, 0x8b, 0x13, 0xe8, 0xd5, 0xfe, 0x67, 0xfb, 0x2f,
+	0x5f, 0xef, 0x77, 0x0f, 0x7f, 0xb4, 0xbf, 0xd5, 0xbe, 0x46, 0x16, 0xa1, 0xb1, 0xb9, 0xc5, 0xe6,
+	0x24, 0x03, 0x1c, 0x64, 0x39, 0xd8, 0x3c, 0x3c, 0x54, 0x48, 0xc5, 0xfb, 0x13, 0x07, 0xda, 0xc5,
+	0x42, 0xd8, 0x96, 0x40, 0x1a, 0xfa, 0x75, 0x6e, 0xc2, 0xe3, 0x60, 0xe1, 0xff, 0x52, 0xc3, 0x2e,
+	0xf8, 0x32, 0x89, 0x46, 0xc5, 0x28, 0x09, 0xe3, 0x24, 0xcc, 0x26, 0xdd, 0xde, 0x20, 0x50, 0xf2,
+	0x55, 0x40, 0x71, 0x50, 0x87, 0xe9, 0xa9, 0x21, 0x65, 0x39, 0x50, 0x10, 0xc2, 0x99, 0x92, 0x10,
+	0xca, 0xaf, 0x99, 0x24, 0xcd, 0x6a, 0x5f, 0x23, 0x50, 0x10, 0xd1, 0xb9, 0xa2, 0x88, 0x7a, 0x7f,
+	0x58, 0x81, 0xc5, 0xc2, 0x60, 0x14, 0x4a, 0x74, 0xae, 0x10, 0xfb, 0x4a, 0x49, 0xec, 0x8d, 0xf6,
+	0x54, 0x8b, 0xed, 0x31, 0xea, 0x5b, 0x2b, 0xd6, 0xf7, 0x01, 0x90, 0x94, 0x46, 0x7d, 0x9c, 0x98,
+	0xdd, 0x44, 0x9e, 0x16, 0x8b, 0x56, 0x5b, 0x28, 0xc8, 0x8f, 0xdf, 0x15, 0xf8, 0x79, 0x37, 0x58,
+	0x28, 0xe8, 0xd9, 0xed, 0xd3, 0x5e, 0x32, 0x19, 0x65, 0xdd, 0x93, 0x20, 0x1c, 0x8c, 0x13, 0x71,
+	0x6e, 0x53, 0xf3, 0x4b, 0x38, 0xf9, 0x26, 0xac, 0x9e, 0x05, 0x51, 0x3f, 0x3d, 0x0b, 0xde, 0xd0,
+	0x6e, 0x7f, 0x2c, 0xac, 0xdf, 0x71, 0x2a, 0xd6, 0x1d, 0x3b, 0xd1, 0x23, 0xe8, 0x3a, 0x4c, 0x99,
+	0xcd, 0xae, 0xb6, 0x62, 0x1f, 0xc1, 0x92, 0x86, 0x09, 0x95, 0xf6, 0x2e, 0xcc, 0x8c, 0x10, 0xe8,
+	0x38, 0x86, 0x85, 0x84, 0x4c, 0x3e, 0xa7, 0x78, 0xdf, 0x80, 0x8e, 0xfc, 0x6e, 0x53, 0x9e, 0x4b,
+	0x48, 0xa5, 0xbe, 0x5e, 0x54, 0x68, 0xb8, 0xb7, 0xf9, 0x8c, 0x4e, 0xbc, 0x3f, 0x76, 0xa0, 0xa1,
+	0x7d, 0x31, 0x7d, 0xc7, 0xc7, 0xb6, 0x5a, 0xe3, 0x5e, 0x8f, 0x65, 0x2b, 0x84, 0x37, 0x07, 0x50,
+	0x43, 0xa9, 0x2e, 0xe2, 0x3e, 0x52, 0x95, 0x46, 0x11, 0x18, 0x04, 0x19, 0x8d, 0x7a, 0x13, 0xec,
+	0x8f, 0x1a, 0xb7, 0x60, 0x73, 0x04, 0x73, 0x66, 0x9a, 0x21, 0xa5, 0x34, 0x12, 0x26, 0x79, 0x0e,
+	0xe0, 0x72, 0xcf, 0x12, 0x41, 0x96, 0xd1, 0xe1, 0x48, 0xea, 0x46, 0x03, 0xc3, 0xfd, 0x5c, 0xda,
+	0x8b, 0x13, 0x6e, 0x88, 0x3b, 0x3e, 0x4f, 0x78, 0x5f, 0xc2, 0xa2, 0xd6, 0xb4, 0x27, 0x71, 0xfc,
+	0xe6, 0x12, 0xc5, 0x8e, 0x72, 0x1a, 0xf4, 0xde, 0xc4, 0x27, 0x27, 0xdd, 0x61, 0x2a, 0xdd, 0x6d,
+	0x39, 0x82, 0x1e, 0xb3, 0xfc, 0x60, 0xae, 0x6a, 0x78, 0xcc, 0xb4, 0x42, 0xfc, 0x9c, 0xc9, 0x7b,
+	0x0e, 0xd7, 0x2d, 0xe3, 0x21, 0xc6, 0xf3, 0x03, 0x73, 0x3c, 0xd7, 0xca, 0x59, 0x61, 0x7d, 0xe5,
+	0xd0, 0xb6, 0x31, 0xf4, 0x21, 0xd3, 0x8f, 0xe0, 0xfe, 0xb8, 0x0a, 0x8b, 0x0a, 0x52, 0x4e, 0x9e,
+	0xc5, 0xb0, 0x4f, 0xa3, 0x0c, 0x95, 0x85, 0xe1, 0x7c, 0x2e, 0xc2, 0xd8, 0x5f, 0xc1, 0x20, 0x0c,
+	0x52, 0xb1, 0xc3, 0xe2, 0x09, 0xd4, 0xde, 0x68, 0x9c, 0x4b, 0x7b, 0x5b, 0x2d, 0xa1, 0x7c, 0x3c,
+	0xad, 0x34, 0x34, 0x9f, 0x10, 0x17, 0xf6, 0xb1, 0xfa, 0x84, 0xef, 0x03, 0x6d, 0x24, 0x1c, 0x6d,
+	0x9e, 0x13, 0xb6, 0x7e, 0x86, 0xcb, 0x91, 0x02, 0x4a, 0x07, 0xad, 0xb3, 0xdc, 0xb8, 0x2b, 0x1e,
+	0xb4, 0x6a, 0x87, 0xb5, 0xf3, 0xa5, 0xc3, 0x5a, 0x34, 0xfe, 0x26, 0x51, 0x8f, 0xf6, 0xbb, 0x59,
+	0xdc, 0x65, 0x46, 0x2a, 0x5b, 0xfd, 0xe6, 0xfd, 0x22, 0xcc, 0xd4, 0x31, 0x4d, 0xb3, 0x88, 0x66,
+	0x6c, 0x05, 0x9c, 0xf7, 0x65, 0x12, 0xed, 0x13, 0xc6, 0xc2, 0x4d, 0xee, 0xba, 0x2f, 0x52, 0xa8,
+	0xd4, 0xc7, 0x49, 0x98, 0x76, 0x9a, 0x0c, 0x65, 0xbf, 0x71, 0xea, 0x8b, 0xe3, 0xc2, 0xa0, 0x4f,
+	0x13, 0xb6, 0xba, 0xf2, 0x33, 0x60, 0xbe, 0x3f, 0xb2, 0x13, 0xb1, 0xec, 0x73, 0x9a, 0xa4, 0xe8,
+	0xc5, 0x6b, 0x71, 0x51, 0x14, 0x49, 0xef, 0xa7, 0xcc, 0xdf, 0xa0, 0x4e, 0xa7, 0x5f, 0xb1, 0xcd,
+	0x12, 0xb9, 0x01, 0x75, 0xde, 0xc6, 0xf4, 0x2c, 0x10, 0x2e, 0x90, 0x79, 0x06, 0x1c, 0x9e, 0x05,
+	0x68, 0x71, 0x19, 0xdd, 0x56, 0x11, 0xa7, 0x98, 0x88, 0xed, 0xf2, 0x5e, 0xbb, 0x0b, 0x2d, 0x79,
+	0xee, 0x9d, 0x76, 0x07, 0xf4, 0x24, 0x93, 0x67, 0x19, 0xd1, 0x78, 0x88, 0xc5, 0xa5, 0x7b, 0xf4,
+	0x24, 0xf3, 0xf6, 0x61, 0x49, 0xd8, 0x48, 0x2f, 0x47, 0x54, 0x16, 0xfd, 0x1d, 0xdb, 0xee, 0xa1,
+	0xb1, 0xb1, 0x6c, 0x1a, 0x55, 0xdc, 0x2f, 0x67, 0x72, 0x7a, 0x3e, 0x10, 0xdd, 0xe6, 0x12, 0x19,
+	0x0a, 0x13, 0x5e, 0x9e, 0xd6, 0x88, 0xe6, 0x18, 0x18, 0xf6, 0x8f, 0x50, 0x2f, 0xc2, 0xc2, 0x94,
+	0x49, 0xef, 0x9f, 0x39, 0xb0, 0xcc, 0x72, 0x13, 0x39, 0xe7, 0x9e, 0xb5, 0xb7, 0xaf, 0x66, 0xb3,
+	0xa7, 0xa5, 0x70, 0x3e, 0xe8, 0xb6, 0x2c, 0x4f, 0x7c, 0x75, 0x4f, 0x66, 0xad, 0xe8, 0xc9, 0x44,
+	0xf5, 0xba, 0xc4, 0x8d, 0xcd, 0x2c, 0xc8, 0xc6, 0xa9, 0x68, 0xfe, 0x5f, 0x80, 0x05, 0xbe, 0x0f,
+	0x10, 0xd3, 0x49, 0x54, 0x34, 0x37, 0xbf, 0x18, 0xca, 0x99, 0x77, 0xaf, 0xf9, 0x26, 0x33, 0xf9,
+	0x1e, 0x34, 0xf5, 0xe0, 0x05, 0xe1, 0x32, 0xbe, 0x9e, 0x1b, 0x4b, 0x05, 0xc9, 0xd9, 0xbd, 0xe6,
+	0x1b, 0x1f, 0x90, 0x4f, 0xd8, 0x66, 0x2e, 0xea, 0xb2, 0x6c, 0x3b, 0x55, 0xf3, 0xf3, 0xd2, 0x60,
+	0xed, 0x5e, 0xf3, 0x35, 0xf6, 0x27, 0xf3, 0x30, 0xcb, 0x77, 0xef, 0xde, 0x33, 0x58, 0x30, 0x6a,
+	0x6a, 0xf8, 0x40, 0x9b, 0xdc, 0x07, 0x5a, 0x3a, 0x3f, 0xab, 0x58, 0xce, 0xcf, 0x7e, 0xb7, 0x06,
+	0x04, 0xa5, 0xad, 0x30, 0x9c, 0xe8, 0x3e, 0x88, 0xfb, 0x86, 0x33, 0xa8, 0xe9, 0xeb, 0x10, 0xae,
+	0xe7, 0x5a, 0x52, 0x1e, 0x6f, 0x72, 0xbb, 0xc9, 0x42, 0x61, 0xe6, 0x29, 0xdf, 0xb8, 0x88, 0x2d,
+	0x86, 0x70, 0x7b, 0xd5, 0x84, 0x79, 0x6a, 0xa1, 0xb1, 0x30, 0x84, 0x31, 0x9e, 0x9d, 0x06, 0x99,
+	0x74, 0x17, 0xc9, 0x74, 0x51, 0x40, 0x66, 0xaf, 0x14, 0x90, 0xb9, 0x92, 0xab, 0x5b, 0x73, 0x58,
+	0xcc, 0x1b, 0x0e, 0x0b, 0xdc, 0x28, 0x0f, 0x71, 0x7b, 0x9d, 0x0d, 0x7a, 0xdd, 0x21, 0x96, 0x2e,
+	0xbc, 0x43, 0x06, 0x88, 0x26, 0x8a, 0xd8, 0x6a, 0xe5, 0x5e, 0x11, 0x60, 0x7d, 0x5c, 0xc2, 0x99,
+	0x31, 0x15, 0x46, 0xe2, 0x88, 0xaa, 0xc1, 0x2a, 0x9b, 0x03, 0xa6, 0xd3, 0xbd, 0x79, 0xa5, 0xd3,
+	0xfd, 0x87, 0xd3, 0x9d, 0xee, 0x0b, 0x6f, 0xe1, 0x74, 0x9f, 0xf6, 0x31, 0x76, 0x88, 0x74, 0xbb,
+	0xb7, 0xd8, 0x88, 0xcb, 0xa4, 0xf7, 0x47, 0x0e, 0xb4, 0x51, 0x4c, 0x8c, 0xa9, 0xf4, 0x31, 0xb0,
+	0x99, 0xfc, 0x96, 0x33, 0xc9, 0xe0, 0xfd, 0xb3, 0x4f, 0xa4, 0xc7, 0x50, 0x67, 0x19, 0xc6, 0x23,
+	0x1a, 0x89, 0x79, 0xd4, 0x31, 0xe7, 0x51, 0xae, 0x44, 0x77, 0xaf, 0xf9, 0x39, 0xb3, 0x36, 0x8b,
+	0xfe, 0x90, 0x19, 0x60, 0xac, 0x42, 0x7f, 0x6a, 0x47, 0xad, 0xab, 0x1d, 0x20, 0x71, 0xe9, 0x57,
+	0x69, 0x5c, 0x0c, 0x87, 0xe8, 0x0d, 0xc7, 0xd5, 0xdf, 0x70, 0xd2, 0x16, 0x61, 0x5c, 0xca, 0xd9,
+	0x7a, 0x91, 0x76, 0xb3, 0x70, 0xd0, 0x95, 0x54, 0x11, 0x35, 0x63, 0x23, 0x31, 0xb3, 0x2b, 0xc3,
+	0x70, 0x07, 0xbe, 0x4a, 0xf3, 0x04, 0x7a, 0xa3, 0x45, 0x83, 0x0a, 0xae, 0x05, 0xef, 0xdf, 0x36,
+	0x61, 0xbd, 0x44, 0x52, 0x27, 0x92, 0xc2, 0xfb, 0x38, 0x08, 0x87, 0xc7, 0xb1, 0xf2, 0xc3, 0x38,
+	0xba, 0x63, 0xd2, 0x20, 0x91, 0x53, 0x58, 0x95, 0xe6, 0x08, 0xf6, 0x69, 0x6e, 0x7c, 0x54, 0x98,
+	0xf0, 0x7e, 0x68, 0xca, 0x40, 0xb1, 0x40, 0x89, 0xeb, 0x8a, 0xc7, 0x9e, 0x1f, 0x39, 0x83, 0x8e,
+	0x24, 0xc8, 0x15, 0x4a, 0xb3, 0x8d, 0xb0, 0xac, 0x0f, 0xae, 0x28, 0xcb, 0xf0, 0x53, 0xf8, 0x53,
+	0x73, 0x23, 0x13, 0xb8, 0x2d, 0x69, 0x6c, 0x09, 0x2a, 0x97, 0x57, 0x7b, 0xab, 0xb6, 0x31, 0x1f,
+	0x8b, 0x59, 0xe8, 0x15, 0x19, 0x93, 0x9f, 0xc0, 0xda, 0x45, 0x10, 0x66, 0xb2, 0x5a, 0x9a, 0x2d,
+	0x37, 0xc3, 0x8a, 0xdc, 0xb8, 0xa2, 0xc8, 0xd7, 0xfc, 0x63, 0x63, 0x5d, 0x9e, 0x92, 0xa3, 0xfb,
+	0x9f, 0x1c, 0x68, 0x99, 0xf9, 0xf0, 0xc8, 0x29, 0xa6, 0xaf, 0xa4, 0xde, 0x96, 0xb6, 0x6b, 0x01,
+	0x2e, 0xbb, 0x32, 0x2b, 0x36, 0x57, 0xa6, 0xee, 0x40, 0xac, 0x5e, 0xe5, 0xe5, 0xaf, 0xbd, 0x9d,
+	0x97, 0x7f, 0xc6, 0xe6, 0xe5, 0x77, 0xff, 0x87, 0x03, 0xa4, 0x2c, 0x4b, 0xe4, 0x19, 0xf7, 0xa5,
+	0x46, 0x74, 0x20, 0x74, 0xd2, 0xd7, 0xdf, 0x4e, 0x1e, 0x65, 0xdf, 0xc9, 0xaf, 0x71, 0x62, 0xe8,
+	0x4a, 0x47, 0xb7, 0xf0, 0x16, 0x7c, 0x1b, 0xa9, 0x70, 0xee, 0x50, 0xbb, 0xfa, 0xdc, 0x61, 0xe6,
+	0xea, 0x73, 0x87, 0xd9, 0xe2, 0xb9, 0x83, 0xfb, 0x33, 0x07, 0x96, 0x2d, 0x83, 0xfe, 0x8b, 0x6b,
+	0x38, 0x0e, 0x93, 0xa1, 0x0b, 0x2a, 0x62, 0x98, 0x74, 0xd0, 0xfd, 0xab, 0xb0, 0x60, 0x08, 0xfa,
+	0x2f, 0xae, 0xfc, 0xa2, 0x91, 0xca, 0xe5, 0xcc, 0xc0, 0xdc, 0xff, 0x56, 0x01, 0x52, 0x9e, 0x6c,
+	0xff, 0x47, 0xeb, 0x50, 0xee, 0xa7, 0xaa, 0xa5, 0x9f, 0xfe, 0x5c, 0xd7, 0x81, 0x3c, 0xb0, 0x53,
+	0xf3, 0xa0, 0x73, 0x89, 0x29, 0x13, 0xd0, 0x4c, 0x37, 0x0f, 0x7d, 0xe6, 0x0b, 0xbb, 0x69, 0xb5,
+	0x18, 0x16, 0xce, 0x7e, 0x30, 0xd6, 0x9b, 0x47, 0x79, 0x3f, 0xe1, 0x59, 0xc9, 0x75, 0xe5, 0x1f,
+	0x3b, 0xb0, 0x5a, 0x20, 0xe4, 0x11, 0x7c, 0x7c, 0xe9, 0x30, 0xd7, 0x13, 0x13, 0xc4, 0xfa, 0x8b,
+	0x79, 0xa4, 0xd5, 0x9f, 0x4b, 0x5b, 0x99, 0x80, 0xfd, 0x33, 0x8e, 0xca, 0xfc, 0xbc, 0xd7, 0x6d,
+	0x24, 0x8c, 0x15, 0x11, 0x23, 0x5b, 0xa8, 0xf8, 0x09, 0xac, 0x15, 0x09, 0xf9, 0xc9, 0xbb, 0x59,
+	0x65, 0x99, 0x44, 0x23, 0xd6, 0x58, 0xa6, 0xcc, 0xfa, 0x5a, 0x69, 0xde, 0xef, 0x39, 0x40, 0x7e,
+	0x30, 0xa6, 0xc9, 0x84, 0x45, 0xf2, 0x5d, 0xe9, 0x15, 0x92, 0xf1, 0xa0, 0x95, 0x3c, 0x1e, 0xf4,
+	0x16, 0x00, 0xee, 0x1e, 0x55, 0x78, 0x20, 0x33, 0x1e, 0xa3, 0xf1, 0x90, 0x67, 0x68, 0x0d, 0xd9,
+	0xac, 0x5d, 0x1d, 0xb2, 0x39, 0x73, 0x45, 0xc8, 0xa6, 0xf7, 0x09, 0x2c, 0x1b, 0xf5, 0x56, 0xc3,
+	0x2a, 0x03, 0x15, 0x9d, 0x4b, 0x02, 0x15, 0xff, 0x66, 0x05, 0xaa, 0xbb, 0xf1, 0x48, 0x3f, 0xd6,
+	0x72, 0xcc, 0x63, 0x2d, 0xb1, 0x96, 0x74, 0xd5, 0x52, 0x21, 0x54, 0x8c, 0x01, 0x92, 0xfb, 0xd0,
+	0x0a, 0x86, 0x19, 0x7a, 0x0d, 0x4e, 0xe2, 0xe4, 0x22, 0x48, 0xfa, 0x7c, 0xac, 0x9f, 0x54, 0x3a,
+	0x8e, 0x5f, 0xa0, 0x90, 0x15, 0xa8, 0x2a, 0xa5, 0xcb, 0x18, 0x30, 0x89, 0x86, 0x1b, 0x3b, 0x12,
+	0x9f, 0x08, 0x87, 0x87, 0x48, 0xa1, 0x28, 0x99, 0xdf, 0x73, 0x4b, 0x9f, 0x4f, 0x1d, 0x1b, 0x89,
+	0xf9, 0xd9, 0x28, 0xe5, 0x6c, 0xe2, 0x24, 0x40, 0xa6, 0x75, 0xe7, 0xd6, 0xbc, 0x19, 0x20, 0xf0,
+	0x5f, 0x1d, 0x98, 0x61, 0x7d, 0x83, 0x6a, 0x80, 0xcb, 0xbe, 0x3a, 0xd9, 0x12, 0xb1, 0x67, 0x45,
+	0x98, 0x78, 0x46, 0xb8, 0x7a, 0x45, 0x35, 0x48, 0x43, 0xc9, 0x1d, 0xa8, 0xf3, 0x94, 0x8a, 0x1e,
+	0x66, 0x2c, 0x39, 0x48, 0x6e, 0x63, 0xbc, 0xe3, 0x48, 0xda, 0x2d, 0x20, 0x0f, 0x76, 0xe3, 0x91,
+	0xcf, 0xf0, 0xbc, 0x3e, 0x98, 0x1f, 0x6f, 0x16, 0x5f, 0x8d, 0x8a, 0x30, 0xae, 0xc7, 0x2a, 0x5b,
+	0xbd, 0x9b, 0x0a, 0xa8, 0x77, 0x1f, 0x16, 0xf7, 0xe3, 0x3e, 0xd5, 0x9c, 0x65, 0xd3, 0xbd, 0x9f,
+	0x7f, 0xcd, 0x81, 0x79, 0xc9, 0x4c, 0xee, 0x41, 0x2d, 0x92, 0xb1, 0xeb, 0xf9, 0x16, 0x42, 0x05,
+	0x74, 0x20, 0x9f, 0xcf, 0x38, 0x50, 0x2b, 0x33, 0x57, 0x4a, 0x6e, 0x70, 0x4a, 0x47, 0x8a, 0xc2,
+	0xf2, 0xea, 0x16, 0xcc, 0x90, 0x02, 0xea, 0xfd, 0x73, 0x07, 0x16, 0x8c, 0x32, 0x70, 0xdf, 0xcb,
+	0x9c, 0x9b, 0x7c, 0x83, 0x20, 0x43, 0x03, 0x35, 0x48, 0x1f, 0xe8, 0x8a, 0xe9, 0xc5, 0x54, 0x8e,
+	0xbd, 0xaa, 0xee, 0xd8, 0x33, 0x7c, 0x97, 0x35, 0x43, 0xdb, 0x62, 0x89, 0x65, 0xdf, 0x25, 0xe6,
+	0xd3, 0x8b, 0x07, 0x71, 0x22, 0x4e, 0x67, 0x79, 0xc2, 0xfb, 0x04, 0x1a, 0x1a, 0x3f, 0x56, 0x23,
+	0xa2, 0xd9, 0x45, 0x9c, 0xbc, 0x91, 0xce, 0x54, 0x91, 0x54, 0xf1, 0x62, 0x95, 0x3c, 0x5e, 0xcc,
+	0xfb, 0x8f, 0x0e, 0x2c, 0xa0, 0x0c, 0x86, 0xd1, 0xe9, 0x41, 0x3c, 0x08, 0x7b, 0x13, 0x36, 0xf6,
+	0x52, 0xdc, 0x84, 0xce, 0x90, 0xb2, 0x68, 0xc2, 0x28, 0xf5, 0x72, 0xdb, 0x2b, 0xa6, 0xa8, 0x4a,
+	0xe3, 0x1c, 0xc6, 0x19, 0x70, 0x1c, 0xa4, 0x62, 0x5a, 0x88, 0xe5, 0xcf, 0x00, 0x71, 0xa6, 0x21,
+	0x80, 0x9b, 0xcc, 0xee, 0x30, 0x1c, 0x0c, 0x42, 0xce, 0xcb, 0x8d, 0x23, 0x1b, 0x09, 0xcb, 0xec,
+	0x87, 0x69, 0x70, 0x9c, 0x9f, 0x40, 0xaa, 0xb4, 0xf7, 0xfb, 0x15, 0x68, 0x08, 0xc5, 0xbd, 0xd3,
+	0x3f, 0xa5, 0xe2, 0x78, 0x1c, 0x93, 0xb9, 0x92, 0xd1, 0x10, 0x49, 0x37, 0x0c, 0x56, 0x0d, 0x29,
+	0x0e, 0x79, 0xb5, 0x3c, 0xe4, 0xe8, 0x35, 0x8d, 0xfb, 0xf4, 0x43, 0x66, 0x19, 0xf3, 0xa3, 0xf5,
+	0x1c, 0x90, 0xd4, 0x0d, 0x46, 0x9d, 0xc9, 0xa9, 0x0c, 0xb8, 0xf4, 0x30, 0xfd, 0x31, 0x34, 0x45,
+	0x36, 0x6c, 0x4c, 0x3a, 0x73, 0x86, 0xf0, 0x1b, 0xe3, 0xe5, 0x1b, 0x9c, 0xf2, 0xcb, 0x0d, 0xf9,
+	0xe5, 0xfc, 0x55, 0x5f, 0x4a, 0x4e, 0x16, 0xf8, 0xc4, 0xfb, 0xe6, 0x59, 0x12, 0x8c, 0xce, 0xe4,
+	0x62, 0xd8, 0x87, 0xa6, 0x0e, 0x93, 0xfb, 0x30, 0x83, 0x9f, 0x49, 0x1d, 0x6f, 0x9f, 0x90, 0x9c,
+	0x85, 0xdc, 0x83, 0x19, 0xda, 0x3f, 0xa5, 0x72, 0xef, 0x47, 0xcc, 0x5d, 0x38, 0x8e, 0x91, 0xcf,
+	0x19, 0x50, 0x3d, 0x20, 0x5a, 0x50, 0x0f, 0xe6, 0xfa, 0x80, 0xce, 0xde, 0xe8, 0x79, 0x1f, 0xaf,
+	0xb6, 0xed, 0x73, 0x89, 0xd6, 0xd8, 0xbd, 0xbf, 0x5e, 0x85, 0x86, 0x06, 0xe3, 0x4c, 0x3f, 0xc5,
+	0x0a, 0x77, 0xfb, 0x61, 0x30, 0xa4, 0x19, 0x4d, 0x84, 0x14, 0x17, 0x50, 0xe4, 0x0b, 0xce, 0x4f,
+	0xbb, 0xf1, 0x38, 0xeb, 0xf6, 0xe9, 0x69, 0x42, 0xf9, 0x92, 0xed, 0xf8, 0x05, 0x14, 0xf9, 0x86,
+	0xc1, 0xe7, 0x3a, 0x1f, 0x97, 0x87, 0x02, 0x2a, 0x1d, 0xe9, 0xbc, 0x8f, 0x6a, 0xb9, 0x23, 0x9d,
+	0xf7, 0x48, 0x51, 0x47, 0xcd, 0x58, 0x74, 0xd4, 0x47, 0xb0, 0xc6, 0xb5, 0x91, 0x98, 0xb7, 0xdd,
+	0x82, 0x98, 0x4c, 0xa1, 0xa2, 0xd3, 0x09, 0xeb, 0x2c, 0x05, 0x3c, 0x0d, 0x7f, 0x2a, 0x4f, 0x5e,
+	0x4a, 0x38, 0xf2, 0x32, 0x1f, 0x93, 0xce, 0xcb, 0x8f, 0xc4, 0x4a, 0x38, 0xe3, 0x0d, 0x3e, 0x37,
+	0x79, 0xeb, 0x82, 0xb7, 0x80, 0x7b, 0x0b, 0xd0, 0x38, 0xcc, 0xe2, 0x91, 0x1c, 0x94, 0x16, 0x34,
+	0x79, 0x52, 0x04, 0xbe, 0xdd, 0x80, 0xeb, 0x4c, 0x8a, 0x8e, 0xe2, 0x51, 0x3c, 0x88, 0x4f, 0x27,
+	0x87, 0xe3, 0xe3, 0xb4, 0x97, 0x84, 0x23, 0x16, 0x23, 0xfb, 0x9f, 0x1d, 0x58, 0x36, 0xa8, 0xc2,
+	0x99, 0xf4, 0x4d, 0x2e, 0xd2, 0x2a, 0x62, 0x89, 0x0b, 0xde, 0x92, 0xa6, 0x2a, 0x39, 0x23, 0xf7,
+	0x42, 0xf2, 0xdf, 0x29, 0xd9, 0x84, 0x45, 0x59, 0x33, 0xf9, 0x21, 0x97, 0xc2, 0x4e, 0x59, 0x0a,
+	0xc5, 0xf7, 0x2d, 0xf1, 0x81, 0xcc, 0xe2, 0xbb, 0x22, 0xa4, 0xa5, 0xcf, 0xda, 0x28, 0xbd, 0x0a,
+	0x2a, 0x68, 0x41, 0xdf, 0x5b, 0xc8, 0x1a, 0xf4, 0x14, 0x98, 0x7a, 0x7f, 0xcb, 0x01, 0xc8, 0x6b,
+	0x87, 0x82, 0x91, 0xab, 0x7b, 0x7e, 0x19, 0x2f, 0x07, 0xf0, 0xa8, 0x40, 0x1d, 0x07, 0xe5, 0x2b,
+	0x48, 0x43, 0x62, 0x68, 0xfe, 0xbd, 0x0f, 0x8b, 0xa7, 0x83, 0xf8, 0x98, 0x2d, 0xbf, 0x2c, 0x92,
+	0x32, 0x15, 0xe1, 0x7f, 0x2d, 0x0e, 0x3f, 0x15, 0x68, 0xbe, 0xdc, 0xd4, 0xb4, 0xe5, 0xc6, 0xfb,
+	0x79, 0x05, 0x96, 0x4a, 0x6d, 0x9e, 0x3a, 0xcb, 0xc8, 0x46, 0x49, 0x39, 0x4e, 0xf1, 0xd9, 0x33,
+	0xff, 0xd9, 0xc1, 0x95, 0xdb, 0xfb, 0x4f, 0xa0, 0x95, 0x70, 0xed, 0x23, 0x55, 0x53, 0xed, 0x12,
+	0xd5, 0xb4, 0x90, 0xe8, 0x49, 0x8c, 0x3f, 0x09, 0xfa, 0xe7, 0x34, 0xc9, 0x42, 0xb6, 0xc1, 0x62,
+	0x06, 0x01, 0x57, 0xa8, 0x8b, 0x1a, 0xce, 0xd6, 0xe9, 0xf7, 0x61, 0x51, 0x06, 0x22, 0x48, 0x4e,
+	0x71, 0x9f, 0x29, 0x87, 0x91, 0xd1, 0xfb, 0x27, 0xf2, 0xbc, 0xc2, 0x1c, 0xc3, 0xe9, 0x3d, 0xa2,
+	0xb7, 0xae, 0x52, 0x68, 0xdd, 0x7b, 0xe2, 0xec, 0xa0, 0x2f, 0x77, 0x71, 0x55, 0x2d, 0xfc, 0xa9,
+	0x2f, 0xce, 0x7a, 0xcc, 0x2e, 0xad, 0xbd, 0x4d, 0x97, 0xa2, 0x7b, 0x75, 0x6e, 0x37, 0x1e, 0xed,
+	0x8a, 0x40, 0x30, 0x36, 0x11, 0x54, 0x40, 0xb3, 0x4c, 0x5e, 0x12, 0x22, 0x66, 0x5d, 0x87, 0x17,
+	0x8a, 0xeb, 0xf0, 0x2f, 0xc3, 0x0d, 0x04, 0x46, 0x49, 0x3c, 0x8a, 0x13, 0x9c, 0x8c, 0xc1, 0x80,
+	0x2f, 0xba, 0x71, 0x94, 0x9d, 0x49, 0x35, 0x76, 0x19, 0x0b, 0xdb, 0xac, 0xe1, 0x26, 0x83, 0x9b,
+	0xd0, 0xc2, 0x6e, 0xe0, 0xda, 0xad, 0x4c, 0xf0, 0xbe, 0x03, 0x75, 0x66, 0xf8, 0xb2, 0x66, 0x7d,
+	0x00, 0xf5, 0xb3, 0x78, 0xd4, 0x3d, 0x63, 0x2e, 0x6e, 0xc7, 0x08, 0xa5, 0x13, 0x2d, 0xf7, 0x73,
+	0x06, 0xef, 0x1f, 0xce, 0xc2, 0xdc, 0xf3, 0xe8, 0x3c, 0x0e, 0x7b, 0xec, 0x68, 0x63, 0x48, 0x87,
+	0xb1, 0x8c, 0xe5, 0xc0, 0xdf, 0xd8, 0x15, 0x2c, 0xd4, 0x71, 0x94, 0x89, 0xb3, 0x09, 0x99, 0xc4,
+	0xe5, 0x3e, 0xc9, 0xef, 0x82, 0xf1, 0xa9, 0xa3, 0x21, 0xb8, 0x1d, 0x48, 0xf4, 0x6b, 0x75, 0x22,
+	0x95, 0x5f, 0x4f, 0x99, 0xd1, 0xae, 0xa7, 0x60, 0x39, 0x22, 0x68, 0xad, 0x33, 0x2b, 0x0e, 0xc2,
+	0x78, 0x92, 0x6d, 0x5f, 0x12, 0xca, 0x7d, 0x3f, 0xcc, 0x70, 0x98, 0x13, 0xdb, 0x17, 0x1d, 0x44,
+	0xe3, 0x82, 0x7f, 0xc0, 0x79, 0xb8, 0xf2, 0xd5, 0x21, 0x34, 0xc4, 0x8a, 0x37, 0xf3, 0xea, 0x5c,
+	0xe6, 0x0b, 0x30, 0x8f, 0x88, 0x50, 0x8a, 0x94, 0xb7, 0x01, 0xf8, 0x5d, 0xb7, 0x22, 0xae, 0x6d,
+	0x7a, 0x78, 0x34, 0xaa, 0x48, 0x31, 0x41, 0x09, 0x06, 0x03, 0x3c, 0x5b, 0x67, 0xb7, 0x5a, 0x59,
+	0x74, 0x50, 0xdd, 0x37, 0x41, 0xac, 0xb5, 0x36, 0x9a, 0xec, 0x4c, 0xa1, 0xe6, 0xeb, 0x10, 0xd9,
+	0x80, 0x06, 0xdb, 0xe8, 0x89, 0xf1, 0x6c, 0x19, 0x17, 0x1b, 0xd4, 0xa0, 0xfb, 0x3a, 0x93, 0x7e,
+	0xdc, 0xb2, 0x68, 0x1e, 0xb7, 0x70, 0xa5, 0x29, 0x4e, 0xa9, 0xda, 0xac, 0xb4, 0x1c, 0x60, 0x17,
+	0x4e, 0x79, 0x87, 0x71, 0x86, 0x25, 0xc6, 0x60, 0x60, 0xe4, 0x36, 0xcc, 0xe3, 0x26, 0x64, 0x14,
+	0x84, 0xfd, 0x0e, 0x51, 0x7b, 0x21, 0x85, 0x61, 0x1e, 0xf2, 0x37, 0x3b, 0x4d, 0x5a, 0xe6, 0x81,
+	0x0c, 0x3a, 0x86, 0x7d, 0xa3, 0xd2, 0x6c, 0x12, 0xad, 0xf0, 0x11, 0x35, 0x40, 0x5c, 0xb7, 0x71,
+	0x40, 0xc2, 0x84, 0x76, 0xe5, 0x00, 0xa5, 0xb4, 0x97, 0xd0, 0xac, 0xb3, 0xca, 0x1a, 0x35, 0x85,
+	0xca, 0x24, 0x81, 0x5d, 0x77, 0xec, 0x06, 0x78, 0x03, 0x72, 0x8d, 0x1f, 0x12, 0x6b, 0x10, 0x3b,
+	0x3a, 0xe7, 0xc9, 0x3e, 0x0d, 0xfa, 0x83, 0x30, 0xa2, 0x9d, 0x75, 0x11, 0x37, 0x69, 0xc2, 0x5e,
+	0x06, 0x64, 0xb3, 0xdf, 0x17, 0xf3, 0x43, 0x6d, 0xcc, 0x73, 0xc9, 0x76, 0x0c, 0xc9, 0xb6, 0x48,
+	0x58, 0xc5, 0x2e, 0x61, 0x97, 0x8e, 0x83, 0xb7, 0x03, 0x8d, 0x03, 0xed, 0x82, 0x23, 0x9b, 0x68,
+	0xf2, 0x6a, 0xa3, 0x98, 0x9c, 0x1a, 0xa2, 0x55, 0xa7, 0xa2, 0x57, 0xc7, 0xfb, 0x5d, 0x87, 0xdf,
+	0x92, 0x51, 0xd5, 0xe7, 0x65, 0x7b, 0xd0, 0x54, 0xee, 0x93, 0x3c, 0x18, 0xd8, 0xc0, 0x90, 0x87,
+	0x55, 0xa5, 0x1b, 0x9f, 0x9c, 0xa4, 0x54, 0x86, 0x60, 0x19, 0x18, 0xce, 0x12, 0xb4, 0xb3, 0xd0,
+	0x66, 0x09, 0x79, 0x09, 0x32, 0x2a, 0xa9, 0x84, 0xa3, 0xae, 0x4f, 0x28, 0x9e, 0xfc, 0xab, 0xe9,
+	0xad, 0xd2, 0x2a, 0x66, 0xb9, 0xd8, 0xcb, 0xf7, 0xf1, 0x8c, 0x48, 0xe4, 0x6b, 0xaa, 0x31, 0xc9,
+	0xa9, 0xe8, 0xa8, 0x2e, 0xd9, 0x3e, 0xc2, 0xa8, 0x34, 0x57, 0xdd, 0x65, 0x02, 0x9e, 0xa8, 0x9e,
+	0x84, 0x49, 0x91, 0x5d, 0xdc, 0xc8, 0x2a, 0x53, 0xbc, 0xd7, 0xb0, 0x2c, 0x8a, 0xd4, 0x0d, 0x2c,
+	0x73, 0x10, 0x9d, 0xab, 0x26, 0x53, 0xa5, 0x3c, 0x99, 0xbc, 0xdf, 0x77, 0x60, 0x4e, 0x8c, 0x34,
+	0x1b, 0x96, 0xe2, 0x4d, 0xd7, 0xba, 0x6f, 0x60, 0xf6, 0x9b, 0x7d, 0x65, 0x05, 0x59, 0xb5, 0x29,
+	0x48, 0xbc, 0x9c, 0x11, 0x64, 0x67, 0x6c, 0x67, 0x5c, 0xf7, 0xd9, 0x6f, 0xd2, 0xe6, 0x7e, 0x1c,
+	0xae, 0x88, 0xf1, 0xa7, 0xf5, 0x9a, 0x2f, 0x5f, 0xef, 0x4b, 0xb8, 0xb7, 0xca, 0xc7, 0x4d, 0x34,
+	0x40, 0x9d, 0x7f, 0x89, 0x08, 0xef, 0x1c, 0xce, 0xc7, 0x53, 0x64, 0x51, 0x1c, 0x4f, 0xc1, 0xea,
+	0x2b, 0x3a, 0x5e, 0xe2, 0xd9, 0xa6, 0x03, 0x9a, 0xd1, 0xcd, 0xc1, 0xa0, 0x98, 0xff, 0x0d, 0xb8,
+	0x6e, 0xa1, 0x09, 0x8b, 0xf8, 0x29, 0x2c, 0x6d, 0xd3, 0xe3, 0xf1, 0xe9, 0x1e, 0x3d, 0xcf, 0xcf,
+	0xd8, 0x09, 0xd4, 0xd2, 0xb3, 0xf8, 0x42, 0x48, 0x3a, 0xfb, 0x8d, 0xae, 0xbe, 0x01, 0xf2, 0x74,
+	0xd3, 0x11, 0xed, 0xc9, 0x4b, 0x35, 0x0c, 0x39, 0x1c, 0xd1, 0x9e, 0xf7, 0x11, 0x10, 0x3d, 0x1f,
+	0xd1, 0x04, 0x54, 0x2d, 0xe3, 0xe3, 0x6e, 0x3a, 0x49, 0x33, 0x3a, 0x94, 0xb1, 0x63, 0x3a, 0xe4,
+	0xbd, 0x0f, 0xcd, 0x83, 0x00, 0x6f, 0xac, 0x8a, 0xcb, 0xc6, 0xe8, 0x94, 0x09, 0x26, 0x38, 0xef,
+	0x95, 0x53, 0x86, 0x91, 0xbd, 0xdf, 0xa8, 0xc1, 0x2c, 0xe7, 0xc4, 0x5c, 0xfb, 0x34, 0xcd, 0xc2,
+	0x28, 0xbf, 0xda, 0x57, 0xf7, 0x75, 0xa8, 0x24, 0x1b, 0x15, 0x8b, 0x6c, 0x88, 0xad, 0x90, 0xbc,
+	0xa0, 0x20, 0x84, 0xc0, 0xc0, 0x50, 0x62, 0xf3, 0xb8, 0x1d, 0xee, 0x15, 0xc8, 0x81, 0x82, 0xff,
+	0x2e, 0x5f, 0xca, 0x78, 0xfd, 0xa4, 0xd8, 0x0b, 0x71, 0xd0, 0x21, 0xeb, 0x82, 0x39, 0xc7, 0xa5,
+	0xa6, 0x88, 0x97, 0x17, 0xc6, 0xf9, 0xb7, 0x58, 0x18, 0xf9, 0xfe, 0xe8, 0xb2, 0x85, 0x11, 0xde,
+	0x66, 0x61, 0x64, 0x7e, 0x45, 0x61, 0xeb, 0x37, 0xd8, 0xed, 0x55, 0x95, 0x66, 0xa1, 0xa9, 0xe6,
+	0x32, 0xd3, 0x34, 0xee, 0xed, 0x4f, 0x59, 0x5e, 0x16, 0xde, 0x6a, 0x79, 0x69, 0xd9, 0x97, 0x17,
+	0x02, 0x6d, 0x76, 0x5f, 0x15, 0x4d, 0x40, 0x29, 0xde, 0xbf, 0xe9, 0x40, 0x5b, 0x58, 0xaf, 0x8a,
+	0x46, 0xde, 0x35, 0x4c, 0x5d, 0xeb, 0xb5, 0x86, 0xbb, 0xb0, 0xc0, 0x0c, 0x50, 0xe5, 0x38, 0x15,
+	0x5e, 0x5e, 0x03, 0xc4, 0xda, 0xcb, 0xd3, 0xad, 0x61, 0x38, 0x10, 0x42, 0xa2, 0x43, 0xd2, 0xf7,
+	0x9a, 0x04, 0x22, 0xd4, 0xc7, 0xf1, 0x55, 0xda, 0xfb, 0x37, 0x0e, 0x2c, 0x69, 0x15, 0x16, 0xb3,
+	0xe2, 0x13, 0x90, 0x71, 0x46, 0xdc, 0x8b, 0xea, 0x18, 0x61, 0xc9, 0xc5, 0xb6, 0xf8, 0x06, 0x33,
+	0x13, 0xae, 0x60, 0xc2, 0x2a, 0x98, 0x8e, 0x87, 0x42, 0x4b, 0xea, 0x10, 0x0a, 0xf6, 0x05, 0xa5,
+	0x6f, 0x14, 0x0b, 0xd7, 0xd3, 0x06, 0x86, 0x8d, 0x1f, 0xa2, 0xe1, 0xac, 0x98, 0xf8, 0x82, 0x65,
+	0x82, 0xde, 0x7f, 0x71, 0x60, 0x99, 0xef, 0x80, 0xc4, 0xfe, 0x52, 0xdd, 0x39, 0x9b, 0xe5, 0x5b,
+	0x3e, 0xae, 0x21, 0x76, 0xaf, 0xf9, 0x22, 0x4d, 0xbe, 0xf5, 0x96, 0xbb, 0x36, 0x15, 0x3e, 0x34,
+	0x65, 0x2c, 0xaa, 0xb6, 0xb1, 0xb8, 0xa4, 0xa7, 0x6d, 0x5e, 0xc3, 0x19, 0xab, 0xd7, 0x10, 0xdf,
+	0xc8, 0x48, 0x7b, 0xf1, 0x88, 0xe2, 0xb9, 0x91, 0xd9, 0x38, 0xa1, 0x12, 0x7f, 0xc7, 0x81, 0xce,
+	0x53, 0xee, 0x5d, 0xc7, 0x13, 0x27, 0x16, 0x98, 0xae, 0x6e, 0xe0, 0x63, 0xac, 0x3b, 0x7b, 0x4f,
+	0x04, 0xb3, 0x95, 0x3e, 0xbd, 0x1c, 0xc1, 0x3a, 0xd2, 0xa8, 0xcf, 0xa9, 0x7c, 0x6c, 0x54, 0xba,
+	0x64, 0x24, 0x88, 0x3d, 0x9a, 0x8e, 0xe1, 0xac, 0x92, 0xc6, 0x00, 0x3d, 0x67, 0xaa, 0x9f, 0x6f,
+	0x7e, 0x0a, 0xa8, 0xf7, 0xaf, 0x1c, 0x58, 0xcc, 0x2b, 0xb9, 0x73, 0x2e, 0xc2, 0xa2, 0x73, 0x6d,
+	0x25, 0xd6, 0x57, 0x05, 0x28, 0x6f, 0x63, 0x88, 0x0b, 0xae, 0xa8, 0x9b, 0x86, 0x30, 0x0d, 0x22,
+	0x52, 0xf1, 0x58, 0x5a, 0x30, 0x3a, 0xc4, 0x03, 0x4d, 0x70, 0xa9, 0x17, 0x66, 0x8b, 0x48, 0xb1,
+	0xe8, 0xe0, 0x61, 0xc6, 0xbe, 0xe2, 0x51, 0xd3, 0x32, 0x29, 0xd7, 0x4b, 0x1e, 0x1d, 0x8d, 0x3f,
+	0xbd, 0xbf, 0xed, 0xc0, 0x75, 0x4b, 0xe7, 0x8a, 0x99, 0xb1, 0x0d, 0x4b, 0x27, 0x8a, 0x28, 0x3b,
+	0xc0, 0x8c, 0x85, 0x2d, 0x34, 0xda, 0x2f, 0x7f, 0xa0, 0x8c, 0x1b, 0xde, 0xa5, 0x46, 0x88, 0x59,
+	0x99, 0xe0, 0xfd, 0x0b, 0x07, 0xda, 0x3e, 0x3d, 0x36, 0x8e, 0xe0, 0x50, 0x41, 0xc7, 0xe3, 0xec,
+	0x34, 0x96, 0xc1, 0x10, 0xf9, 0x6e, 0xbc, 0x84, 0x23, 0xaf, 0x8c, 0xc5, 0xe9, 0x9a, 0xbb, 0xe0,
+	0x12, 0x6e, 0x79, 0x52, 0xe5, 0xeb, 0xfa, 0xc9, 0x57, 0xcd, 0x7e, 0xf2, 0x95, 0x73, 0xa0, 0xb6,
+	0x5b, 0xd2, 0x6a, 0xfb, 0x7f, 0xd5, 0x93, 0x24, 0xdf, 0x81, 0xe5, 0xa3, 0x24, 0xe8, 0xbd, 0x39,
+	0x30, 0x1f, 0x6e, 0xf1, 0xac, 0x4f, 0x92, 0x18, 0x98, 0xf7, 0x77, 0xaa, 0xd0, 0x12, 0x9f, 0x6d,
+	0x8a, 0xe8, 0xed, 0xdb, 0x00, 0x22, 0x90, 0x3b, 0xef, 0x7c, 0x0d, 0x21, 0x8f, 0x59, 0x98, 0x51,
+	0xc6, 0x9b, 0xd0, 0xda, 0xf0, 0x4c, 0xdb, 0x48, 0xe4, 0xf2, 0x40, 0xfc, 0x8f, 0xd1, 0x61, 0xd4,
+	0xe7, 0x1f, 0x10, 0x0f, 0x66, 0xa6, 0xb7, 0x89, 0x93, 0xd8, 0xeb, 0x3e, 0xa2, 0x2c, 0xa6, 0x40,
+	0x22, 0x19, 0xa2, 0x5e, 0x84, 0x79, 0x8c, 0x4a, 0x1a, 0x0f, 0xce, 0xa9, 0xe2, 0x14, 0x67, 0x55,
+	0x05, 0x98, 0xbf, 0x5d, 0xa4, 0xd9, 0x88, 0x4d, 0x7f, 0x5e, 0xeb, 0xef, 0x15, 0x11, 0x19, 0xdf,
+	0x4d, 0xe3, 0x71, 0xd2, 0x93, 0x56, 0x30, 0xbf, 0xc6, 0x66, 0xa5, 0x61, 0xc7, 0x4a, 0xbc, 0x87,
+	0x7e, 0x26, 0xfe, 0xa8, 0x90, 0x81, 0x79, 0x8f, 0xa1, 0xa9, 0x77, 0x01, 0x59, 0x80, 0xfa, 0xf3,
+	0xfd, 0xee, 0xd3, 0xbd, 0xe7, 0xcf, 0x76, 0x8f, 0xda, 0xd7, 0x30, 0x79, 0xf8, 0x6a, 0x6b, 0x6b,
+	0x67, 0x67, 0x7b, 0x67, 0xbb, 0xed, 0x10, 0x80, 0xd9, 0xa7, 0x9b, 0xcf, 0xf1, 0x2e, 0x58, 0xc5,
+	0xfb, 0xd7, 0x15, 0x58, 0x10, 0x9d, 0x99, 0xc7, 0xe7, 0x5e, 0x35, 0x90, 0xa8, 0x23, 0x78, 0xa4,
+	0xa3, 0xbc, 0x2d, 0xcd, 0x53, 0x38, 0x9a, 0xcc, 0xf8, 0xd6, 0xd5, 0xbb, 0x86, 0x94, 0x6d, 0xf2,
+	0x9a, 0xcd, 0x26, 0xff, 0xb6, 0x1c, 0xf3, 0x19, 0x36, 0xe6, 0xef, 0x9a, 0x63, 0xce, 0xab, 0x29,
+	0x53, 0xc6, 0x90, 0x7f, 0x08, 0xf3, 0x62, 0xdc, 0xe4, 0xd3, 0x01, 0xab, 0x56, 0x79, 0xf1, 0x15,
+	0x1b, 0xf6, 0x9c, 0x9e, 0xd3, 0x57, 0xe8, 0xb9, 0x9b, 0xe0, 0x8a, 0x7d, 0xcf, 0x31, 0xdd, 0xcd,
+	0x06, 0xbd, 0x9d, 0x73, 0xdd, 0x1c, 0xff, 0xed, 0x1a, 0xd4, 0x15, 0x4a, 0x3e, 0x06, 0x60, 0x5a,
+	0xab, 0xab, 0xdd, 0xfe, 0x97, 0x1e, 0x5e, 0xc5, 0xf5, 0x80, 0xfd, 0xcb, 0xaf, 0x0a, 0xe6, 0xdc,
+	0x5f, 0x49, 0xf1, 0xe8, 0xbc, 0x2c, 0x4e, 0x34, 0xec, 0x0b, 0xc3, 0xa0, 0x84, 0x5b, 0x95, 0x5f,
+	0x6d, 0xba, 0xf2, 0x53, 0x98, 0xcc, 0x77, 0xa6, 0xc0, 0x2b, 0xf3, 0x2d, 0xca, 0xcf, 0xac, 0x45,
+	0x7e, 0x3e, 0x80, 0x25, 0x55, 0x1f, 0x75, 0xa4, 0xcb, 0xd7, 0x8f, 0x32, 0x01, 0xb9, 0x55, 0x29,
+	0x8a, 0x7b, 0x9e, 0x73, 0x97, 0x08, 0x58, 0xbe, 0x5a, 0x0e, 0x71, 0x9a, 0xd6, 0xb9, 0x61, 0xa4,
+	0x63, 0xb8, 0xfe, 0xca, 0xf9, 0x93, 0xd0, 0x20, 0x8d, 0x23, 0xe6, 0xc8, 0xaa, 0xfb, 0x05, 0xd4,
+	0xfb, 0x31, 0xd4, 0xd5, 0xa0, 0x90, 0x06, 0xcc, 0x3d, 0x7d, 0xe9, 0xbf, 0xde, 0xf4, 0xb7, 0xdb,
+	0xd7, 0xc8, 0x1c, 0x54, 0x37, 0xb7, 0x51, 0x24, 0xea, 0x30, 0xf3, 0x83, 0x57, 0x3b, 0xaf, 0xf0,
+	0xfe, 0xe5, 0x3c, 0xd4, 0xb6, 0xfd, 0x97, 0x07, 0xed, 0x2a, 0xca, 0xc9, 0xe1, 0xce, 0xd1, 0xd1,
+	0xde, 0x4e, 0xbb, 0x86, 0x28, 0xca, 0x4c, 0x7b, 0x06, 0x85, 0x69, 0xef, 0xf9, 0xfe, 0x67, 0x5d,
+	0x96, 0x9c, 0xf5, 0x7e, 0x19, 0x60, 0x2b, 0x4c, 0x7a, 0xe3, 0x30, 0xfb, 0x8c, 0x5f, 0x2e, 0x9c,
+	0x12, 0xa8, 0xd0, 0x81, 0x39, 0xd9, 0xe7, 0xc2, 0xed, 0x2a, 0x92, 0xde, 0x6f, 0x57, 0xe1, 0x86,
+	0x58, 0x29, 0x51, 0x8a, 0x9e, 0x47, 0x19, 0x4d, 0x7a, 0x74, 0xa4, 0x74, 0xf2, 0x0e, 0xac, 0xe4,
+	0x22, 0xc2, 0x8b, 0x52, 0x07, 0xe1, 0xf9, 0xd9, 0x46, 0x5e, 0x09, 0xdf, 0xca, 0x8e, 0x5a, 0x4b,
+	0x1b, 0x94, 0x78, 0x1c, 0x65, 0xb9, 0x29, 0x5d, 0xf3, 0xad, 0x34, 0x76, 0x1f, 0x45, 0xe2, 0x62,
+	0xb7, 0xc2, 0x0d, 0xa1, 0x22, 0x5c, 0x92, 0x97, 0x9a, 0x45, 0x5e, 0x3e, 0x05, 0x57, 0x0d, 0xb4,
+	0x70, 0x16, 0x89, 0xf3, 0x92, 0x5c, 0x12, 0x2f, 0xe1, 0xc0, 0x16, 0x68, 0x82, 0x92, 0xb7, 0x80,
+	0x1b, 0x32, 0x56, 0x1a, 0xb6, 0x40, 0xe1, 0xa2, 0x05, 0xe2, 0xe5, 0xb7, 0x02, 0xcc, 0x4e, 0x8b,
+	0xe5, 0x96, 0x86, 0x7b, 0x58, 0x55, 0xda, 0xfb, 0x5f, 0x0e, 0xdc, 0xb4, 0x0f, 0x91, 0x58, 0xd4,
+	0x7f, 0x41, 0x63, 0xf4, 0x9c, 0xbf, 0xab, 0x20, 0x22, 0x99, 0x5b, 0x2a, 0x4a, 0xf4, 0xb2, 0xb2,
+	0x1f, 0xf8, 0x7c, 0xe9, 0xda, 0x64, 0x1f, 0xfa, 0x22, 0x03, 0x63, 0x01, 0xab, 0x9a, 0x0b, 0x98,
+	0xf7, 0x21, 0x2c, 0x18, 0x1f, 0xa1, 0xa4, 0xfb, 0x3b, 0x87, 0xaf, 0x5e, 0xe0, 0x75, 0x65, 0x29,
+	0xe9, 0x8e, 0x26, 0xff, 0x15, 0xef, 0xbf, 0x57, 0x61, 0x45, 0x6c, 0x0a, 0x36, 0x7b, 0xba, 0x74,
+	0x16, 0x62, 0xfc, 0x9d, 0x72, 0x8c, 0xbf, 0x79, 0xf3, 0x9c, 0x1b, 0x31, 0x85, 0x9b, 0xe7, 0xfa,
+	0xa5, 0x24, 0xa9, 0xed, 0x9a, 0x7e, 0x11, 0x66, 0x1b, 0x3c, 0x15, 0xdb, 0xaf, 0xcc, 0x5e, 0x0d,
+	0x52, 0xb1, 0xfe, 0x48, 0xe6, 0x02, 0xa5, 0xd2, 0x58, 0x8f, 0xfe, 0x38, 0xcd, 0x84, 0xf9, 0xc6,
+	0x85, 0x46, 0x43, 0x30, 0xc0, 0x00, 0x8d, 0x76, 0xbe, 0xd0, 0x85, 0x51, 0xf7, 0x64, 0xa0, 0x2e,
+	0xa7, 0xd7, 0x7c, 0x1b, 0x09, 0x6b, 0x2e, 0xf7, 0x7b, 0x09, 0x4d, 0x69, 0x72, 0x4e, 0x85, 0x42,
+	0x2b, 0xc2, 0x46, 0xf8, 0x03, 0x57, 0x65, 0x2a, 0x6d, 0x79, 0x1e, 0xa2, 0x66, 0x3c, 0x0f, 0x61,
+	0xbc, 0x97, 0xd0, 0x28, 0xbe, 0x97, 0xf0, 0x00, 0x08, 0x56, 0x2d, 0x60, 0x83, 0x42, 0xfb, 0x3c,
+	0xce, 0x8e, 0x6d, 0xef, 0x17, 0x7c, 0x0b, 0x45, 0x0f, 0xbe, 0x3d, 0x19, 0x04, 0xa7, 0xfc, 0xa6,
+	0xee, 0x82, 0x6f, 0x82, 0x5e, 0x0c, 0xab, 0x85, 0xd1, 0xce, 0xdd, 0xc3, 0x3c, 0xc3, 0xfc, 0xe5,
+	0x0f, 0x4c, 0xd9, 0x06, 0xb1, 0x62, 0x1f, 0xc4, 0x15, 0x98, 0xe1, 0x76, 0xaf, 0x08, 0x70, 0x61,
+	0x09, 0xb6, 0xc1, 0xe3, 0x8c, 0x87, 0x17, 0x94, 0x8e, 0xd4, 0x0a, 0xfc, 0xb3, 0x0a, 0x34, 0x75,
+	0x82, 0x11, 0x29, 0xef, 0x14, 0x22, 0xe5, 0x71, 0x37, 0xcd, 0xdf, 0xc6, 0xe1, 0x4b, 0xb4, 0x70,
+	0x25, 0xe9, 0x18, 0x33, 0x55, 0xb9, 0x7e, 0xd0, 0x8c, 0x9b, 0x1c, 0x29, 0x3e, 0xeb, 0x55, 0x2b,
+	0x3f, 0xeb, 0xe5, 0x59, 0x5f, 0x3f, 0x32, 0x30, 0x1c, 0x95, 0xe3, 0x24, 0x0e, 0xfa, 0x3d, 0xed,
+	0x8e, 0x63, 0x2a, 0x82, 0xec, 0x2d, 0x14, 0xac, 0x55, 0x8a, 0xcd, 0xe3, 0x31, 0xa2, 0x73, 0xe2,
+	0x5a, 0xb6, 0x42, 0xbc, 0x23, 0x58, 0x2d, 0x74, 0x8f, 0xf2, 0x4f, 0xb4, 0x64, 0x07, 0x33, 0x76,
+	0xb9, 0x05, 0x5b, 0x36, 0x63, 0x31, 0xd9, 0x57, 0x7e, 0x81, 0xd5, 0xfb, 0x36, 0x2c, 0x33, 0x02,
+	0x7f, 0xff, 0x4a, 0x7f, 0x3f, 0xa0, 0xf8, 0xb2, 0xd9, 0x8c, 0xd1, 0x05, 0xde, 0x63, 0x58, 0x31,
+	0x3f, 0xd4, 0x7c, 0x88, 0xaa, 0xd2, 0xf2, 0xe4, 0x5a, 0x87, 0xbc, 0x04, 0x5a, 0x4f, 0xc6, 0xc3,
+	0x91, 0xf6, 0xf2, 0xda, 0x65, 0x03, 0x5a, 0xa8, 0x49, 0xa5, 0x54, 0x93, 0xd2, 0x60, 0x54, 0xcb,
+	0x83, 0xe1, 0xfd, 0x7f, 0xb0, 0xa8, 0xca, 0xbc, 0xe4, 0xb1, 0xa7, 0x0e, 0xac, 0x6d, 0x8e, 0xb3,
+	0x78, 0x14, 0x0e, 0xe2, 0x8c, 0xdf, 0x50, 0x91, 0x42, 0x78, 0x0a, 0x4b, 0x8a, 0x72, 0x80, 0x87,
+	0x9a, 0x69, 0x30, 0xb8, 0xe4, 0x22, 0xaa, 0xcb, 0x1f, 0x2f, 0xe8, 0xe6, 0x01, 0x98, 0x2a, 0x6d,
+	0x9e, 0xec, 0x57, 0x0b, 0x27, 0xfb, 0xf8, 0x8e, 0xe0, 0x7a, 0xa9, 0x0e, 0xfa, 0xcc, 0xb3, 0xbc,
+	0xb9, 0x83, 0x6f, 0xfc, 0x50, 0xbc, 0xc5, 0x98, 0x85, 0xca, 0xd7, 0xab, 0x80, 0x52, 0x10, 0x49,
+	0xd5, 0x12, 0x44, 0x22, 0x1e, 0xda, 0xd5, 0xe3, 0x4e, 0xa5, 0x2b, 0xa3, 0x4c, 0x28, 0x72, 0xf7,
+	0xe2, 0x28, 0x92, 0xb1, 0x29, 0x65, 0x42, 0x39, 0x7c, 0x77, 0xd6, 0x16, 0xbe, 0x7b, 0x0f, 0x16,
+	0x23, 0xf6, 0x00, 0x71, 0x9c, 0x50, 0x11, 0x40, 0x31, 0xc7, 0xef, 0x7b, 0x16, 0x60, 0xe4, 0x0c,
+	0xce, 0x83, 0x70, 0x80, 0x51, 0x5c, 0xec, 0xa2, 0x97, 0xbc, 0x9e, 0x5d, 0x84, 0xc9, 0x47, 0x50,
+	0x1f, 0x89, 0xb1, 0x42, 0xf3, 0x51, 0x0f, 0xe7, 0x28, 0x0d, 0xa6, 0x9f, 0xb3, 0x7a, 0x1f, 0xc1,
+	0xcd, 0x17, 0x71, 0x3f, 0x3c, 0x99, 0xd8, 0x85, 0x01, 0xc7, 0x81, 0x46, 0x58, 0x8e, 0x1c, 0x07,
+	0x9e, 0xf2, 0xde, 0x81, 0x5b, 0x53, 0xbe, 0x13, 0xbe, 0xaa, 0x7f, 0xe4, 0xc0, 0xf5, 0x43, 0x9a,
+	0xe5, 0xe4, 0x5e, 0x9c, 0xe4, 0x91, 0xbc, 0xdb, 0x30, 0xcb, 0xee, 0x3c, 0xcb, 0x09, 0xfc, 0x81,
+	0x7a, 0x28, 0x72, 0xca, 0x17, 0x0f, 0x78, 0x8a, 0x3f, 0x16, 0x29, 0xbe, 0x75, 0xbf, 0x03, 0x0d,
+	0x0d, 0xbe, 0xea, 0xfd, 0x44, 0x47, 0x7f, 0x3f, 0x11, 0x77, 0x42, 0x96, 0xb2, 0x44, 0xe5, 0xb7,
+	0x81, 0xbc, 0x08, 0x7a, 0x41, 0x12, 0xc7, 0xd1, 0x01, 0x4d, 0x86, 0x61, 0x9a, 0xa2, 0xdd, 0xc0,
+	0xfa, 0x22, 0x0b, 0x33, 0x59, 0x84, 0x48, 0x91, 0x35, 0xc3, 0x8e, 0xa9, 0x4b, 0xa3, 0xc4, 0xcb,
+	0x60, 0xf9, 0x49, 0xf0, 0x86, 0xca, 0x9c, 0x64, 0xdb, 0x3f, 0x81, 0xc6, 0x48, 0x65, 0x2a, 0x3b,
+	0x40, 0xde, 0xe2, 0x2a, 0x17, 0xeb, 0xeb, 0xdc, 0xa8, 0x23, 0x92, 0x38, 0x66, 0xf6, 0x53, 0x6e,
+	0x5c, 0xeb, 0x90, 0xb7, 0x01, 0x2b, 0x66, 0xa9, 0x62, 0x46, 0xe1, 0xa2, 0x2c, 0x30, 0xa9, 0x79,
+	0x64, 0x1a, 0x95, 0x01, 0x1e, 0xf4, 0xc8, 0x6f, 0x9e, 0x6f, 0x2b, 0x65, 0xf0, 0x5d, 0x58, 0x2f,
+	0x51, 0x44, 0x86, 0x1e, 0x34, 0xb5, 0x72, 0x79, 0x43, 0x6a, 0xbe, 0x81, 0x79, 0x9f, 0xc0, 0x3a,
+	0x3f, 0xe1, 0xc9, 0x33, 0xd0, 0xf4, 0xae, 0xde, 0x12, 0xa7, 0xdc, 0x92, 0x6f, 0x42, 0xa7, 0xfc,
+	0x71, 0x1e, 0x6f, 0xde, 0x67, 0x34, 0xf9, 0x26, 0x9a, 0x4c, 0xde, 0x7f, 0x0a, 0xab, 0xd6, 0x2b,
+	0x7c, 0xb8, 0x17, 0xda, 0xde, 0x79, 0xba, 0xf9, 0x6a, 0x0f, 0x37, 0xc9, 0x0d, 0x98, 0xdb, 0xdb,
+	0xf4, 0x9f, 0xed, 0x1c, 0x1e, 0x71, 0xd3, 0xcf, 0xdf, 0xdc, 0xdf, 0x7e, 0xf9, 0xa2, 0x5d, 0xc1,
+	0x4d, 0xd2, 0x93, 0xfd, 0x27, 0xed, 0xea, 0xc6, 0xdf, 0xaf, 0x41, 0x8b, 0x07, 0xe9, 0xf3, 0xf7,
+	0xda, 0x69, 0x42, 0x5e, 0xc0, 0x9c, 0x78, 0x6f, 0x9f, 0xc8, 0x4d, 0xba, 0xf9, 0xc2, 0xbf, 0xbb,
+	0x56, 0x84, 0xe5, 0x23, 0x8b, 0xbf, 0xf1, 0x47, 0x7f, 0xf2, 0x77, 0x2b, 0x0b, 0xa4, 0xf1, 0xf0,
+	0xfc, 0xc3, 0x87, 0xa7, 0x34, 0x4a, 0x31, 0x8f, 0xbf, 0x04, 0x90, 0xbf, 0x44, 0x4f, 0x3a, 0xea,
+	0x48, 0xb4, 0xf0, 0xc4, 0xbe, 0x7b, 0xdd, 0x42, 0x11, 0xf9, 0x5e, 0x67, 0xf9, 0x2e, 0x7b, 0x2d,
+	0xcc, 0x37, 0x8c, 0xc2, 0x8c, 0x3f, 0x4b, 0xff, 0xb1, 0x73, 0x9f, 0xf4, 0xa1, 0xa9, 0x3f, 0x34,
+	0x4f, 0xe4, 0xde, 0xdd, 0xf2, 0xcc, 0xbd, 0x7b, 0xc3, 0x4a, 0x93, 0xa1, 0x69, 0xac, 0x8c, 0x55,
+	0xaf, 0x8d, 0x65, 0x8c, 0x19, 0x47, 0x5e, 0xca, 0x00, 0x5a, 0xe6, 0x7b, 0xf2, 0xe4, 0xa6, 0xe6,
+	0x54, 0x2f, 0xbd, 0x66, 0xef, 0xde, 0x9a, 0x42, 0x15, 0x65, 0xdd, 0x62, 0x65, 0xad, 0x7b, 0x04,
+	0xcb, 0xea, 0x31, 0x1e, 0xf9, 0x9a, 0x3d, 0x96, 0xf6, 0x33, 0x07, 0x56, 0x6c, 0x4f, 0xbc, 0x13,
+	0xcf, 0xc8, 0xd6, 0xfa, 0x80, 0xbd, 0xfb, 0xde, 0xa5, 0x3c, 0xa2, 0x02, 0xef, 0xb1, 0x0a, 0xdc,
+	0xf2, 0x3a, 0x79, 0x05, 0x70, 0xac, 0xf2, 0x07, 0xe8, 0x3f, 0x76, 0xee, 0x6f, 0xfc, 0xcb, 0xfb,
+	0x50, 0x57, 0x61, 0x9d, 0xe4, 0x27, 0xb0, 0x60, 0x5c, 0xe6, 0x20, 0xb2, 0x37, 0x6d, 0x77, 0x3f,
+	0xdc, 0x9b, 0x76, 0xa2, 0x28, 0xfe, 0x36, 0x2b, 0xbe, 0x43, 0xd6, 0xb0, 0x78, 0xb1, 0x5e, 0x3c,
+	0x64, 0x57, 0x58, 0xf8, 0x03, 0x00, 0x6f, 0xa0, 0x65, 0x5e, 0xc0, 0x30, 0xba, 0xbb, 0x74, 0x61,
+	0xc3, 0xbd, 0x35, 0x85, 0x2a, 0x8a, 0xbb, 0xc9, 0x8a, 0x5b, 0x23, 0x2b, 0x7a, 0x71, 0x6a, 0xa5,
+	0xa4, 0xec, 0xc9, 0x06, 0xfd, 0xe1, 0x76, 0x72, 0x4b, 0xc9, 0xb7, 0xed, 0x41, 0x77, 0x25, 0xa9,
+	0xe5, 0x57, 0xdd, 0xbd, 0x0e, 0x2b, 0x8a, 0x10, 0x26, 0x45, 0xfa, 0xbb, 0xed, 0xe4, 0xc7, 0x50,
+	0x57, 0xef, 0x53, 0x92, 0x75, 0xed, 0xc5, 0x60, 0xfd, 0x49, 0x4f, 0xb7, 0x53, 0x26, 0xd8, 0xe4,
+	0x53, 0xcf, 0x19, 0x25, 0x66, 0x0f, 0x56, 0x95, 0xc7, 0xeb, 0xab, 0xb4, 0xc4, 0xf2, 0xdc, 0xfc,
+	0x23, 0x87, 0x3c, 0x63, 0x3d, 0x62, 0x3c, 0x25, 0xaf, 0xe5, 0x63, 0x79, 0x7a, 0xde, 0x95, 0x96,
+	0xa9, 0x4e, 0x7b, 0xe4, 0x90, 0x4f, 0x60, 0x5e, 0x3e, 0xdf, 0x4b, 0xd6, 0xec, 0x8f, 0x24, 0xbb,
+	0xeb, 0x25, 0x5c, 0x9d, 0x45, 0x34, 0xb4, 0x37, 0x72, 0x89, 0xec, 0xf4, 0xf2, 0x3b, 0xbf, 0xae,
+	0x6b, 0x23, 0xe5, 0xb9, 0x68, 0x8f, 0xd3, 0xaa, 0x5c, 0xca, 0x6f, 0xe6, 0xba, 0xae, 0x8d, 0x24,
+	0x72, 0xf9, 0x3e, 0x6e, 0xae, 0xb5, 0x67, 0x65, 0x95, 0xf0, 0xdb, 0x5e, 0xb0, 0x75, 0x6f, 0xda,
+	0x89, 0x22, 0xaf, 0x4d, 0x80, 0xfc, 0x29, 0x58, 0xa5, 0x0f, 0x4b, 0x8f, 0xd3, 0xba, 0xd7, 0x2d,
+	0x94, 0x3c, 0x8b, 0xfc, 0x59, 0x50, 0x95, 0x45, 0xe9, 0xb1, 0x52, 0xf7, 0xba, 0x85, 0x22, 0xb2,
+	0x38, 0x85, 0xa5, 0xd2, 0xab, 0xa3, 0xe4, 0x9d, 0x9c, 0xdf, 0xfa, 0x1e, 0xe9, 0x25, 0x19, 0x7a,
+	0x6b, 0x4c, 0x3e, 0xdb, 0x84, 0xe9, 0xe8, 0x88, 0x5e, 0xc8, 0x07, 0x6a, 0xb6, 0xa1, 0xa1, 0x3d,
+	0x35, 0xaa, 0x06, 0xa0, 0xfc, 0x4c, 0xa9, 0xeb, 0xda, 0x48, 0xf9, 0x00, 0x18, 0x6f, 0x86, 0xaa,
+	0x01, 0xb0, 0xbd, 0x48, 0xea, 0xde, 0xb4, 0x13, 0x45, 0x5e, 0xbf, 0x02, 0x0d, 0xed, 0x85, 0x4f,
+	0x72, 0xdd, 0x7c, 0xcf, 0x4a, 0x7b, 0xdb, 0xd3, 0x75, 0x6d, 0x24, 0xd1, 0xde, 0x15, 0xd6, 0xde,
+	0x96, 0x57, 0xc7, 0xf6, 0xb2, 0x57, 0x52, 0x70, 0x22, 0xfe, 0x04, 0x5a, 0xe6, 0x9b, 0x9f, 0x4a,
+	0x73, 0x59, 0x5f, 0x0f, 0x75, 0x6f, 0x4d, 0xa1, 0x9a, 0x93, 0xfe, 0xfe, 0xb2, 0x2a, 0xe4, 0xe1,
+	0x17, 0x62, 0x47, 0xf2, 0x25, 0xf9, 0x01, 0xd4, 0xe5, 0x4b, 0x36, 0xb9, 0x46, 0x29, 0xbe, 0x5b,
+	0xe4, 0x76, 0xca, 0x04, 0x91, 0xf9, 0x12, 0xcb, 0xbc, 0x41, 0xf2, 0x16, 0x90, 0x34, 0x7f, 0xe4,
+	0x48, 0x3d, 0x8e, 0xa3, 0xa4, 0x62, 0xda, 0x33, 0x46, 0xee, 0x9d, 0xe9, 0x0c, 0x66, 0x3b, 0x88,
+	0xd6, 0x8e, 0x3c, 0xf4, 0x99, 0xd9, 0x1b, 0xec, 0xcd, 0x1c, 0xcd, 0xde, 0xd0, 0x9f, 0xd5, 0x71,
+	0xd7, 0x8a, 0xb0, 0xdd, 0xde, 0xc8, 0x42, 0xcc, 0x23, 0xc2, 0xf7, 0x85, 0x8c, 0xfb, 0x9c, 0x4a,
+	0x7b, 0xd9, 0x2f, 0xc0, 0xbb, 0xb7, 0x2f, 0xbf, 0x06, 0x6a, 0xae, 0x1f, 0x72, 0xdd, 0x78, 0x28,
+	0xdf, 0x2b, 0xf8, 0xcb, 0xd0, 0xd4, 0x1f, 0x88, 0x54, 0x16, 0x88, 0xe5, 0x59, 0x4b, 0xf7, 0x86,
+	0x95, 0x66, 0x4a, 0x14, 0x69, 0xea, 0xc5, 0xa0, 0x44, 0x99, 0xef, 0xe9, 0xe5, 0x6b, 0xa1, 0xed,
+	0xa1, 0x40, 0xf7, 0xd6, 0x14, 0xaa, 0x6d, 0x24, 0x54, 0x5b, 0x78, 0x98, 0x32, 0xf9, 0x15, 0x58,
+	0xd4, 0x2e, 0x4b, 0xe3, 0xe3, 0x6b, 0x6a, 0x76, 0x94, 0x5f, 0x02, 0x71, 0x6d, 0x71, 0x05, 0xde,
+	0x3a, 0xcb, 0x7f, 0xc9, 0x33, 0x1a, 0x81, 0x33, 0x63, 0x0b, 0x1a, 0x5a, 0x1e, 0x97, 0xe5, 0xbb,
+	0xae, 0x91, 0xf4, 0x57, 0x25, 0x1e, 0x39, 0xe4, 0x1f, 0xe0, 0x1f, 0x39, 0xd0, 0xaf, 0x35, 0x1b,
+	0xc1, 0xf8, 0x85, 0x7c, 0x3a, 0x3a, 0x4d, 0xcf, 0xc8, 0xf3, 0x59, 0x25, 0xf7, 0xee, 0x7f, 0xdf,
+	0xe8, 0x84, 0x2f, 0x8c, 0xf8, 0x94, 0x07, 0xc5, 0x3f, 0x78, 0xf0, 0x65, 0x91, 0x41, 0x7f, 0x2d,
+	0xe5, 0xcb, 0x47, 0x0e, 0xf9, 0x98, 0xff, 0xc9, 0x0f, 0x19, 0x1f, 0x47, 0xb4, 0x85, 0xad, 0xd8,
+	0x65, 0xfa, 0xdf, 0xb3, 0xb8, 0xe7, 0x3c, 0x72, 0xc8, 0xaf, 0xc2, 0xa2, 0xf6, 0x2d, 0xeb, 0xf9,
+	0xb7, 0xfd, 0xde, 0xbb, 0xcb, 0x5a, 0x73, 0xdb, 0xbb, 0x6e, 0xb4, 0xa6, 0x68, 0x22, 0x6c, 0x42,
+	0x43, 0xfb, 0x73, 0x15, 0xb9, 0x1e, 0x2e, 0xfd, 0x09, 0x8b, 0xe9, 0x95, 0x1c, 0xc2, 0xa2, 0xc6,
+	0x6e, 0x88, 0xc7, 0x5b, 0x66, 0xe3, 0xdd, 0x67, 0x75, 0xbd, 0xeb, 0xbd, 0x33, 0xb5, 0xae, 0x0f,
+	0xd9, 0xf9, 0x2f, 0xd6, 0xf8, 0x00, 0x20, 0x8f, 0x65, 0x25, 0x85, 0x58, 0x4a, 0xb5, 0x14, 0x95,
+	0xc3, 0x5d, 0x4d, 0x19, 0x94, 0x21, 0x97, 0x98, 0xe3, 0x8f, 0xf9, 0x54, 0x15, 0xfc, 0x29, 0xd1,
+	0x97, 0x58, 0x33, 0xe8, 0xd4, 0x75, 0x6d, 0x24, 0xdb, 0x44, 0x95, 0xf9, 0x93, 0x57, 0xb0, 0xb0,
+	0x17, 0xc7, 0x6f, 0xc6, 0x23, 0x59, 0x63, 0x62, 0x9e, 0x70, 0x62, 0x68, 0xac, 0x5b, 0x68, 0x85,
+	0x77, 0x87, 0x65, 0xe5, 0x92, 0x8e, 0x96, 0xd5, 0xc3, 0x2f, 0xf2, 0x58, 0xd9, 0x2f, 0x49, 0x00,
+	0x4b, 0xca, 0xb4, 0x53, 0x15, 0x77, 0xcd, 0x6c, 0xf4, 0x28, 0xcf, 0x52, 0x11, 0x86, 0xb1, 0x2d,
+	0x6b, 0xfb, 0x30, 0x95, 0x79, 0x3e, 0x72, 0xc8, 0x01, 0x34, 0xb7, 0x29, 0x9e, 0x56, 0x8b, 0xf8,
+	0xbe, 0xe5, 0xbc, 0xe2, 0x2a, 0x30, 0xd0, 0x5d, 0x30, 0x40, 0x53, 0x27, 0x8e, 0x82, 0x49, 0x42,
+	0x7f, 0xed, 0xe1, 0x17, 0x22, 0x72, 0xf0, 0x4b, 0xa9, 0x13, 0x45, 0xcb, 0x4d, 0x9d, 0x58, 0x08,
+	0x8f, 0x74, 0x6f, 0x58, 0x69, 0xb6, 0xae, 0x96, 0xd1, 0x96, 0x64, 0x80, 0x41, 0x93, 0x85, 0x88,
+	0x4a, 0xb5, 0x4c, 0x4d, 0x8b, 0xc3, 0x74, 0xef, 0x4c, 0x67, 0x30, 0x4b, 0xbb, 0x6f, 0x96, 0x76,
+	0x08, 0x0b, 0xdb, 0x94, 0x77, 0x16, 0xbf, 0x02, 0x57, 0x78, 0xb6, 0x54, 0xbf, 0x2e, 0xe7, 0x2e,
+	0x5b, 0x68, 0xe6, 0x4a, 0xcb, 0xee, 0x9f, 0x91, 0x1f, 0x43, 0xe3, 0x19, 0xcd, 0xe4, 0x9d, 0x37,
+	0x65, 0x1d, 0x17, 0x2e, 0xc1, 0xb9, 0x96, 0x2b, 0x73, 0xa6, 0xcc, 0xb0, 0xdc, 0x1e, 0xe2, 0x25,
+	0x3a, 0xae, 0x9e, 0xba, 0x61, 0xff, 0x4b, 0xf2, 0x17, 0x59, 0xe6, 0xea, 0x0a, 0xed, 0x9a, 0x76,
+	0x55, 0x4a, 0xcf, 0x7c, 0xb1, 0x80, 0xdb, 0x72, 0x8e, 0xe2, 0x3e, 0xd5, 0x6c, 0x8e, 0x08, 0x1a,
+	0xda, 0xcd, 0x6f, 0x35, 0x81, 0xca, 0xb7, 0xd8, 0x5d, 0xd7, 0x46, 0x12, 0xfd, 0x7c, 0x8f, 0x95,
+	0xe3, 0x91, 0x3b, 0x79, 0x39, 0x6c, 0xd6, 0x6b, 0xd6, 0xcd, 0xc3, 0x2f, 0x82, 0x61, 0xf6, 0x25,
+	0x79, 0xcd, 0x9e, 0xd8, 0xd3, 0xef, 0xf5, 0xe5, 0x26, 0x68, 0xf1, 0x0a, 0xa0, 0x4b, 0xca, 0x24,
+	0xd3, 0x2c, 0xe5, 0x45, 0x31, 0x2b, 0xe1, 0x5b, 0x00, 0x78, 0x33, 0x6d, 0x3b, 0xa0, 0xc3, 0x38,
+	0xca, 0x75, 0x6d, 0x7e, 0x77, 0xcd, 0x5d, 0x36, 0x30, 0x61, 0x3b, 0xbe, 0xd6, 0x36, 0x5a, 0xfa,
+	0x10, 0x13, 0x29, 0x5c, 0x53, 0xaf, 0xb7, 0xb9, 0xae, 0x8d, 0x43, 0xad, 0x6c, 0x9b, 0x00, 0x79,
+	0xfc, 0xae, 0x32, 0xe9, 0x4b, 0xa1, 0xc1, 0xee, 0x75, 0x0b, 0x45, 0xd4, 0xed, 0x00, 0xea, 0x79,
+	0x00, 0xe6, 0x7a, 0x1e, 0xc3, 0x64, 0x84, 0x6b, 0xba, 0x9d, 0x32, 0x41, 0x8c, 0x4a, 0x9b, 0x75,
+	0x15, 0x90, 0x79, 0xec, 0x2a, 0x16, 0xeb, 0x18, 0xc2, 0x32, 0xaf, 0xa0, 0x5a, 0xe2, 0xd9, 0x6d,
+	0x2c, 0xd9, 0x12, 0x4b, 0x68, 0xa2, 0x7b, 0xc3, 0x4a, 0xb3, 0xf9, 0x71, 0x50, 0x5a, 0xf9, 0x4d,
+	0x30, 0x54, 0xcd, 0x43, 0x58, 0x2a, 0x85, 0xa5, 0xa9, 0x29, 0x3d, 0x2d, 0x1a, 0xd0, 0xbd, 0x33,
+	0x9d, 0x41, 0x14, 0xb9, 0xca, 0x8a, 0x5c, 0xf4, 0x00, 0x8b, 0x4c, 0x2f, 0xc2, 0xac, 0x77, 0x86,
+	0xc5, 0x7d, 0x0a, 0x75, 0x15, 0xc5, 0xa5, 0xfa, 0xaa, 0x18, 0x85, 0xe6, 0x76, 0xca, 0x04, 0xd1,
+	0xd7, 0x4f, 0xa0, 0xa9, 0x87, 0x5a, 0xa9, 0x2e, 0xb1, 0xc4, 0x5f, 0xb9, 0x2b, 0xb6, 0x28, 0x99,
+	0x47, 0x0e, 0xd9, 0x83, 0x65, 0x4b, 0x98, 0x0a, 0x91, 0x41, 0x35, 0xd3, 0x43, 0x58, 0xdc, 0x76,
+	0x31, 0x40, 0xe5, 0x91, 0x43, 0xfe, 0x0a, 0x2c, 0x1a, 0x47, 0xc9, 0x71, 0x42, 0xde, 0x7b, 0x8b,
+	0x93, 0x66, 0xd7, 0xbb, 0x94, 0x89, 0x95, 0xc7, 0x16, 0xff, 0x03, 0x58, 0x34, 0x4e, 0x0f, 0xe3,
+	0xa4, 0xe8, 0x94, 0x31, 0x4f, 0x15, 0xdd, 0x1b, 0x76, 0x6a, 0x9e, 0xe3, 0xf7, 0xd5, 0x4b, 0x75,
+	0xfc, 0xfc, 0x4b, 0xed, 0xe9, 0x6c, 0x87, 0x86, 0xee, 0x4d, 0x3b, 0x51, 0x8c, 0xc7, 0x33, 0x68,
+	0xea, 0x87, 0x57, 0x6a, 0x3c, 0x2c, 0x47, 0x61, 0xee, 0x0d, 0x2b, 0x4d, 0x64, 0xf4, 0x18, 0xe6,
+	0xc4, 0xb9, 0x92, 0xda, 0x8c, 0x98, 0x67, 0x5b, 0xee, 0x5a, 0x11, 0x56, 0xd3, 0x6f, 0xb1, 0x70,
+	0x4a, 0xa0, 0xf6, 0x1d, 0xf6, 0x53, 0x07, 0xf7, 0xf6, 0x34, 0xb2, 0xc8, 0xf1, 0x18, 0x56, 0xad,
+	0xa7, 0x0f, 0x6a, 0x60, 0x2f, 0x3b, 0xd3, 0x70, 0xef, 0x5e, 0xce, 0x24, 0xca, 0xf8, 0x11, 0x90,
+	0xf2, 0x09, 0x81, 0xd2, 0x66, 0x53, 0x0f, 0x2a, 0xdc, 0x77, 0x2f, 0xe1, 0xc8, 0xc7, 0x44, 0x77,
+	0xd1, 0xab, 0x31, 0xb1, 0x9c, 0x16, 0xb8, 0x37, 0xac, 0xb4, 0xbc, 0x67, 0x0b, 0xde, 0x79, 0xd5,
+	0xb3, 0x76, 0x7f, 0xbe, 0x7b, 0x7b, 0x1a, 0x59, 0xe4, 0x78, 0x08, 0xed, 0xa2, 0xcf, 0x9d, 0xdc,
+	0x36, 0xcc, 0x83, 0x92, 0x27, 0xdf, 0x7d, 0x67, 0x2a, 0x9d, 0x67, 0x7a, 0x3c, 0xcb, 0xfe, 0x20,
+	0xee, 0x37, 0xfe, 0xf7, 0x00, 0xf1, 0x3f, 0x0e, 0x60, 0x42, 0x77, 0x00, 0x00,
 }
@@ -63,6 +63,14 @@ type BtcWallet struct {
 	// FetchInputInfo.
 	utxoCache map[wire.OutPoint]*wire.TxOut
 	cacheMtx  sync.RWMutex
+
+	// leaseMtx serializes all operations on the output leases stored
+	// within the wallet's database.
+	leaseMtx sync.Mutex
+
+	// recoveryStartHeight is the height the wallet was synced to when it
+	// was started, from which a recovery rescan proceeds.
+	recoveryStartHeight int32
 }
 
 // A compile time check to ensure that BtcWallet implements the
@@ -160,6 +168,10 @@ func (b *BtcWallet) Start() error {
 		return err
 	}
 
+	// Before the wallet starts syncing, we'll note the height it's synced
+	// to, such that we can report the progress of a recovery rescan.
+	b.recoveryStartHeight = b.wallet.Manager.SyncedTo().Height
+
 	// Start the underlying btcwallet core.
 	b.wallet.Start()
 
@@ -192,7 +204,9 @@ func (b *BtcWallet) Start() error {
 		}
 	}
 
-	return nil
+	// Finally, we'll lock all outputs that are still leased, as the base
+	// wallet doesn't persist locked outputs across restarts.
+	return b.restoreLeases()
 }
 
 // Stop signals the wallet for shutdown. Shutdown may entail closing
@@ -301,9 +315,28 @@ func (b *BtcWallet) UnlockOutpoint(o wire.OutPoint) {
 
 // ListUnspentWitness returns a slice of all the unspent outputs the wallet
 // controls which pay to witness programs either directly or indirectly.
+// Outputs that are locked or leased aren't returned.
 //
 // This is a part of the WalletController interface.
 func (b *BtcWallet) ListUnspentWitness(minConfs int32) ([]*lnwallet.Utxo, error) {
+	// Outputs whose lease has expired are eligible to be spent once
+	// again, so we'll release them first.
+	b.leaseMtx.Lock()
+	err := b.pruneExpiredLeases()
+	b.leaseMtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	return b.listUnspentWitness(minConfs)
+}
+
+// listUnspentWitness returns a slice of all the unlocked unspent outputs the
+// wallet controls which pay to witness programs either directly or
+// indirectly.
+func (b *BtcWallet) listUnspentWitness(minConfs int32) ([]*lnwallet.Utxo,
+	error) {
+
 	// First, grab all the unfiltered currently unspent outputs.
 	maxConfs := int32(math.MaxInt32)
 	unspentOutputs, err := b.wallet.ListUnspent(minConfs, maxConfs, nil)
@@ -508,6 +541,7 @@ func minedTransactionsToDetails(
 			Timestamp:        block.Timestamp,
 			TotalFees:        int64(tx.Fee),
 			DestAddresses:    destAddresses,
+			RawTx:            tx.Transaction,
 		}
 
 		balanceDelta, err := extractBalanceDelta(tx, wireTx)
@@ -538,6 +572,7 @@ func unminedTransactionsToDetail(
 		Hash:      *summary.Hash,
 		TotalFees: int64(summary.Fee),
 		Timestamp: summary.Timestamp,
+		RawTx:     summary.Transaction,
 	}
 
 	balanceDelta, err := extractBalanceDelta(summary, wireTx)
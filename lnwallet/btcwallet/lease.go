@@ -0,0 +1,383 @@
+package btcwallet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+var (
+	// leaseBucketKey is the key of the top-level walletdb bucket in which
+	// all output leases are stored. The bucket maps an outpoint to the
+	// lock ID the output is leased under, followed by the expiration of
+	// the lease:
+	//
+	//   outpoint -> lockID || expiration
+	leaseBucketKey = []byte("lnd-output-leases")
+
+	// wtxmgrNamespaceKey is the namespace key that the wtxmgr state is
+	// stored within the top-level walletdb buckets of btcwallet.
+	wtxmgrNamespaceKey = []byte("wtxmgr")
+)
+
+// leaseValueSize is the size of a serialized lease: the 32 byte lock ID,
+// followed by the expiration of the lease in unix seconds.
+const leaseValueSize = 32 + 8
+
+// serializeOutPoint serializes the passed outpoint as its hash followed by its
+// big endian index.
+func serializeOutPoint(op wire.OutPoint) []byte {
+	var b [36]byte
+	copy(b[:32], op.Hash[:])
+	binary.BigEndian.PutUint32(b[32:], op.Index)
+
+	return b[:]
+}
+
+// deserializeLease decodes a lease from its serialized key and value.
+func deserializeLease(k, v []byte) (*lnwallet.LeasedOutput, error) {
+	if len(k) != 36 || len(v) != leaseValueSize {
+		return nil, fmt.Errorf("invalid lease of outpoint %x", k)
+	}
+
+	lease := &lnwallet.LeasedOutput{}
+	copy(lease.OutPoint.Hash[:], k[:32])
+	lease.OutPoint.Index = binary.BigEndian.Uint32(k[32:])
+	copy(lease.LockID[:], v[:32])
+	lease.Expiration = time.Unix(
+		int64(binary.BigEndian.Uint64(v[32:])), 0,
+	)
+
+	return lease, nil
+}
+
+// LeaseOutput locks an output of the wallet under the passed lock ID for the
+// given duration, excluding it from coin selection until the lease expires.
+// Leasing an output that's already leased under the same ID extends the
+// lease. The expiration of the lease is returned.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) LeaseOutput(id lnwallet.LockID, op wire.OutPoint,
+	duration time.Duration) (time.Time, error) {
+
+	if duration <= 0 {
+		return time.Time{}, fmt.Errorf("lease duration must be " +
+			"positive")
+	}
+
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	now := time.Now()
+	expiration := now.Add(duration)
+
+	var value [leaseValueSize]byte
+	copy(value[:32], id[:])
+	binary.BigEndian.PutUint64(value[32:], uint64(expiration.Unix()))
+
+	// First, we'll check whether the output is already leased.
+	opKey := serializeOutPoint(op)
+	var lease *lnwallet.LeasedOutput
+	err := walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		leases := tx.ReadBucket(leaseBucketKey)
+		if leases == nil {
+			return nil
+		}
+
+		v := leases.Get(opKey)
+		if v == nil {
+			return nil
+		}
+
+		var err error
+		lease, err = deserializeLease(opKey, v)
+		return err
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	switch {
+	// Only the holder of an active lease may extend it.
+	case lease != nil && lease.LockID != id && lease.Expiration.After(now):
+		return time.Time{}, lnwallet.ErrOutputAlreadyLeased
+
+	// If the output isn't leased yet, then we'll make sure it isn't locked
+	// otherwise, and that it's an unspent output of ours.
+	case lease == nil:
+		if b.wallet.LockedOutpoint(op) {
+			return time.Time{}, lnwallet.ErrOutputAlreadyLeased
+		}
+
+		utxos, err := b.listUnspentWitness(0)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		var found bool
+		for _, utxo := range utxos {
+			if utxo.OutPoint == op {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return time.Time{}, lnwallet.ErrNotMine
+		}
+	}
+
+	err = walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		leases, err := tx.CreateTopLevelBucket(leaseBucketKey)
+		if err != nil {
+			return err
+		}
+
+		return leases.Put(opKey, value[:])
+	})
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	b.wallet.LockOutpoint(op)
+
+	return time.Unix(expiration.Unix(), 0), nil
+}
+
+// ReleaseOutput releases the lease on an output, making it eligible for coin
+// selection once again. The passed lock ID must match the one the output was
+// leased under.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ReleaseOutput(id lnwallet.LockID, op wire.OutPoint) error {
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	err := walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		leases := tx.ReadWriteBucket(leaseBucketKey)
+		if leases == nil {
+			return lnwallet.ErrOutputNotLeased
+		}
+
+		opKey := serializeOutPoint(op)
+		v := leases.Get(opKey)
+		if v == nil {
+			return lnwallet.ErrOutputNotLeased
+		}
+
+		if !bytes.Equal(v[:32], id[:]) {
+			return lnwallet.ErrOutputLeaseMismatch
+		}
+
+		return leases.Delete(opKey)
+	})
+	if err != nil {
+		return err
+	}
+
+	b.wallet.UnlockOutpoint(op)
+
+	return nil
+}
+
+// ListLeasedOutputs returns all outputs that are currently leased.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ListLeasedOutputs() ([]*lnwallet.LeasedOutput, error) {
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	if err := b.pruneExpiredLeases(); err != nil {
+		return nil, err
+	}
+
+	var leased []*lnwallet.LeasedOutput
+	err := walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		leases := tx.ReadBucket(leaseBucketKey)
+		if leases == nil {
+			return nil
+		}
+
+		return leases.ForEach(func(k, v []byte) error {
+			lease, err := deserializeLease(k, v)
+			if err != nil {
+				return err
+			}
+
+			leased = append(leased, lease)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return leased, nil
+}
+
+// ListLeasedUnspentWitness returns the unspent witness outputs of the wallet
+// which are leased under the passed lock ID, and have at least minConfs
+// confirmations.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) ListLeasedUnspentWitness(id lnwallet.LockID,
+	minConfs int32) ([]*lnwallet.Utxo, error) {
+
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	if err := b.pruneExpiredLeases(); err != nil {
+		return nil, err
+	}
+
+	syncHeight := b.wallet.Manager.SyncedTo().Height
+
+	var utxos []*lnwallet.Utxo
+	err := walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		leases := tx.ReadBucket(leaseBucketKey)
+		if leases == nil {
+			return nil
+		}
+
+		// As leased outputs are locked, they're skipped when listing
+		// the unspent outputs of the wallet, so we'll look them up
+		// within the transaction store directly.
+		txmgrNs := tx.ReadBucket(wtxmgrNamespaceKey)
+		credits, err := b.wallet.TxStore.UnspentOutputs(txmgrNs)
+		if err != nil {
+			return err
+		}
+
+		for _, credit := range credits {
+			v := leases.Get(serializeOutPoint(credit.OutPoint))
+			if v == nil || !bytes.Equal(v[:32], id[:]) {
+				continue
+			}
+
+			// Outputs which haven't been mined yet have no
+			// confirmations.
+			var confs int32
+			if credit.Height != -1 {
+				confs = syncHeight - credit.Height + 1
+			}
+			if confs < minConfs {
+				continue
+			}
+
+			var addressType lnwallet.AddressType
+			switch {
+			case txscript.IsPayToWitnessPubKeyHash(credit.PkScript):
+				addressType = lnwallet.WitnessPubKey
+			case txscript.IsPayToScriptHash(credit.PkScript):
+				addressType = lnwallet.NestedWitnessPubKey
+			default:
+				continue
+			}
+
+			utxos = append(utxos, &lnwallet.Utxo{
+				AddressType: addressType,
+				Value:       credit.Amount,
+				PkScript:    credit.PkScript,
+				OutPoint:    credit.OutPoint,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return utxos, nil
+}
+
+// pruneExpiredLeases removes all expired leases from the database, and
+// unlocks their outputs.
+//
+// NOTE: The leaseMtx MUST be held when calling this method.
+func (b *BtcWallet) pruneExpiredLeases() error {
+	now := time.Now()
+
+	// We'll first scan for expired leases, such that we only need to
+	// write to the database if there are any.
+	var expired []wire.OutPoint
+	err := walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		leases := tx.ReadBucket(leaseBucketKey)
+		if leases == nil {
+			return nil
+		}
+
+		return leases.ForEach(func(k, v []byte) error {
+			lease, err := deserializeLease(k, v)
+			if err != nil {
+				return err
+			}
+
+			if !lease.Expiration.After(now) {
+				expired = append(expired, lease.OutPoint)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+	if len(expired) == 0 {
+		return nil
+	}
+
+	err = walletdb.Update(b.db, func(tx walletdb.ReadWriteTx) error {
+		leases := tx.ReadWriteBucket(leaseBucketKey)
+		for _, op := range expired {
+			err := leases.Delete(serializeOutPoint(op))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, op := range expired {
+		b.wallet.UnlockOutpoint(op)
+	}
+
+	return nil
+}
+
+// restoreLeases locks the outputs of all active leases within the wallet. As
+// locked outputs aren't persisted by the base wallet, this must be done each
+// time the wallet is started.
+func (b *BtcWallet) restoreLeases() error {
+	b.leaseMtx.Lock()
+	defer b.leaseMtx.Unlock()
+
+	if err := b.pruneExpiredLeases(); err != nil {
+		return err
+	}
+
+	return walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		leases := tx.ReadBucket(leaseBucketKey)
+		if leases == nil {
+			return nil
+		}
+
+		return leases.ForEach(func(k, v []byte) error {
+			lease, err := deserializeLease(k, v)
+			if err != nil {
+				return err
+			}
+
+			b.wallet.LockOutpoint(lease.OutPoint)
+			return nil
+		})
+	})
+}
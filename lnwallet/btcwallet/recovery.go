@@ -0,0 +1,82 @@
+package btcwallet
+
+import (
+	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcwallet/waddrmgr"
+	"github.com/btcsuite/btcwallet/walletdb"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// recoveryKeyScopes are the key scopes of the default accounts of the wallet,
+// which are the ones scanned for when restoring a seed.
+var recoveryKeyScopes = []waddrmgr.KeyScope{
+	waddrmgr.KeyScopeBIP0049Plus,
+	waddrmgr.KeyScopeBIP0084,
+}
+
+// RecoveryProgress returns the progress of the wallet's rescan for the funds
+// of a restored seed. If the wallet wasn't started in recovery mode, then the
+// returned progress reports so.
+//
+// This is a part of the WalletController interface.
+func (b *BtcWallet) RecoveryProgress() (*lnwallet.RecoveryProgress, error) {
+	progress := &lnwallet.RecoveryProgress{
+		RecoveryMode:   b.cfg.RecoveryWindow > 0,
+		RecoveryWindow: b.cfg.RecoveryWindow,
+		StartHeight:    b.recoveryStartHeight,
+		CurrentHeight:  b.wallet.Manager.SyncedTo().Height,
+	}
+
+	_, bestHeight, err := b.cfg.ChainSource.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+	progress.BestHeight = bestHeight
+
+	// The rescan is only finished once the wallet considers itself synced
+	// to the chain, as it may otherwise still be waiting on the backend.
+	progress.Finished = b.wallet.ChainSynced() &&
+		progress.CurrentHeight >= bestHeight
+
+	// The default accounts also contain the addresses derived as
+	// look-ahead, or handed out without ever receiving funds. We'll
+	// therefore only count the addresses the rescan found to be used
+	// on-chain.
+	err = walletdb.View(b.db, func(tx walletdb.ReadTx) error {
+		addrmgrNs := tx.ReadBucket(waddrmgrNamespaceKey)
+
+		manager := b.wallet.Manager
+		for _, scope := range recoveryKeyScopes {
+			scopedMgr, err := manager.FetchScopedKeyManager(scope)
+			if err != nil {
+				return err
+			}
+
+			err = scopedMgr.ForEachActiveAddress(
+				addrmgrNs, func(addr btcutil.Address) error {
+					managedAddr, err := scopedMgr.Address(
+						addrmgrNs, addr,
+					)
+					if err != nil {
+						return err
+					}
+
+					if managedAddr.Used(addrmgrNs) {
+						progress.AddressesFound++
+					}
+					return nil
+				},
+			)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return progress, nil
+}
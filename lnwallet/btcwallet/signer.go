@@ -196,7 +196,7 @@ func (b *BtcWallet) ComputeInputScript(tx *wire.MsgTx,
 	outputScript := signDesc.Output.PkScript
 	walletAddr, err := b.fetchOutputAddr(outputScript)
 	if err != nil {
-		return nil, nil
+		return nil, err
 	}
 
 	pka := walletAddr.(waddrmgr.ManagedPubKeyAddress)
@@ -0,0 +1,261 @@
+package lnwallet
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// bnbMaxTries is the maximum number of nodes of the search tree that the
+// branch-and-bound coin selection visits before giving up on finding a
+// changeless solution.
+const bnbMaxTries = 100000
+
+// CoinSelectionStrategy denotes the strategy used to pick the inputs that
+// fund a transaction out of the set of eligible wallet outputs.
+type CoinSelectionStrategy uint8
+
+const (
+	// CoinSelectionDefault selects outputs in the order they are returned
+	// by the WalletController.
+	CoinSelectionDefault CoinSelectionStrategy = iota
+
+	// CoinSelectionLargest selects the largest outputs first, minimizing
+	// the number of inputs of the transaction.
+	CoinSelectionLargest
+
+	// CoinSelectionRandom selects outputs in a random order, such that the
+	// inputs of our transactions don't follow a recognizable pattern.
+	CoinSelectionRandom
+
+	// CoinSelectionBnB performs a branch-and-bound search for a set of
+	// outputs which funds the transaction without requiring a change
+	// output. If no such set is found, the largest outputs are selected
+	// first.
+	CoinSelectionBnB
+)
+
+// String returns a human readable version of the coin selection strategy.
+func (c CoinSelectionStrategy) String() string {
+	switch c {
+	case CoinSelectionDefault:
+		return "default"
+	case CoinSelectionLargest:
+		return "largest"
+	case CoinSelectionRandom:
+		return "random"
+	case CoinSelectionBnB:
+		return "bnb"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(c))
+	}
+}
+
+// filterCoins restricts the set of coins to the passed outpoints. If any of
+// the outpoints isn't within the set, e.g. because it is unknown to the
+// wallet, has already been locked, or lacks confirmations, or if an outpoint
+// is passed more than once, then an error is returned. If no outpoints are
+// passed, then the coins are returned as is.
+func filterCoins(coins []*Utxo, outpoints []wire.OutPoint) ([]*Utxo, error) {
+	if len(outpoints) == 0 {
+		return coins, nil
+	}
+
+	coinIndex := make(map[wire.OutPoint]*Utxo, len(coins))
+	for _, coin := range coins {
+		coinIndex[coin.OutPoint] = coin
+	}
+
+	filtered := make([]*Utxo, 0, len(outpoints))
+	selected := make(map[wire.OutPoint]struct{}, len(outpoints))
+	for _, op := range outpoints {
+		if _, ok := selected[op]; ok {
+			return nil, fmt.Errorf("outpoint %v passed more than "+
+				"once", op)
+		}
+
+		coin, ok := coinIndex[op]
+		if !ok {
+			return nil, fmt.Errorf("outpoint %v is not an "+
+				"eligible wallet output", op)
+		}
+
+		selected[op] = struct{}{}
+		filtered = append(filtered, coin)
+	}
+
+	return filtered, nil
+}
+
+// orderCoins returns a copy of the passed coins, ordered according to the
+// passed coin selection strategy.
+func orderCoins(coins []*Utxo,
+	strategy CoinSelectionStrategy) ([]*Utxo, error) {
+
+	ordered := make([]*Utxo, len(coins))
+	copy(ordered, coins)
+
+	switch strategy {
+	case CoinSelectionDefault:
+
+	// The branch-and-bound search explores the largest coins first, which
+	// is also the order of its fallback.
+	case CoinSelectionLargest, CoinSelectionBnB:
+		sort.SliceStable(ordered, func(i, j int) bool {
+			return ordered[i].Value > ordered[j].Value
+		})
+
+	case CoinSelectionRandom:
+		for i := len(ordered) - 1; i > 0; i-- {
+			j, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+			if err != nil {
+				return nil, err
+			}
+
+			k := j.Int64()
+			ordered[i], ordered[k] = ordered[k], ordered[i]
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown coin selection strategy: %v",
+			strategy)
+	}
+
+	return ordered, nil
+}
+
+// addInputWeight updates the weight estimate to account for spending the
+// passed coin.
+func addInputWeight(weightEstimate *TxWeightEstimator, coin *Utxo) error {
+	switch coin.AddressType {
+	case WitnessPubKey:
+		weightEstimate.AddP2WKHInput()
+	case NestedWitnessPubKey:
+		weightEstimate.AddNestedP2WKHInput()
+	default:
+		return fmt.Errorf("Unsupported address type: %v",
+			coin.AddressType)
+	}
+
+	return nil
+}
+
+// inputWeight returns the weight that spending the passed coin adds to a
+// transaction.
+func inputWeight(coin *Utxo) (int64, error) {
+	switch coin.AddressType {
+	case WitnessPubKey:
+		return InputSize*witnessScaleFactor + P2WKHWitnessSize, nil
+	case NestedWitnessPubKey:
+		return (InputSize+P2WPKHSize+1)*witnessScaleFactor +
+			P2WKHWitnessSize, nil
+	default:
+		return 0, fmt.Errorf("Unsupported address type: %v",
+			coin.AddressType)
+	}
+}
+
+// coinSelectBnB attempts to find a set of coins that pays for amt satoshis,
+// along with the fee of a transaction containing the outputs of the base
+// weight estimate, without requiring a change output. The effective value of
+// each coin, i.e. its value minus the fee to spend it, is used to perform a
+// depth first search for the set of coins that overshoots the target the
+// least. As the overshoot is paid to the miners, solutions that overshoot the
+// target by more than the cost of a change output, along with the dust limit
+// below which we wouldn't create change anyway, are rejected. If no solution
+// is found within bnbMaxTries steps, then nil is returned.
+func coinSelectBnB(feeRate SatPerKWeight, amt btcutil.Amount,
+	baseEstimate TxWeightEstimator, coins []*Utxo) ([]*Utxo, error) {
+
+	// Coins that cost more to spend than they are worth never help us
+	// reach the target, so we'll exclude them from the search.
+	var (
+		candidates []*Utxo
+		effValues  []btcutil.Amount
+		remaining  btcutil.Amount
+	)
+	for _, coin := range coins {
+		weight, err := inputWeight(coin)
+		if err != nil {
+			return nil, err
+		}
+
+		effValue := coin.Value - feeRate.FeeForWeight(weight)
+		if effValue <= 0 {
+			continue
+		}
+
+		candidates = append(candidates, coin)
+		effValues = append(effValues, effValue)
+		remaining += effValue
+	}
+
+	// Our inputs are all witness inputs, so we'll account for the witness
+	// header on top of the weight of the outputs.
+	baseWeight := int64(baseEstimate.Weight() + WitnessHeaderSize)
+	target := amt + feeRate.FeeForWeight(baseWeight)
+
+	changeWeight := int64(P2WKHOutputSize * witnessScaleFactor)
+	costOfChange := feeRate.FeeForWeight(changeWeight) + DefaultDustLimit()
+	upperBound := target + costOfChange
+
+	var (
+		tries      int
+		current    []int
+		best       []int
+		bestExcess btcutil.Amount
+	)
+
+	var search func(i int, selected, remaining btcutil.Amount)
+	search = func(i int, selected, remaining btcutil.Amount) {
+		tries++
+		if tries > bnbMaxTries || selected > upperBound {
+			return
+		}
+
+		// Once we've reached the target, adding more coins would
+		// only increase the excess, so there's no need to descend
+		// any further.
+		if selected >= target {
+			excess := selected - target
+			if best == nil || excess < bestExcess {
+				best = append([]int(nil), current...)
+				bestExcess = excess
+			}
+			return
+		}
+
+		if i == len(candidates) || selected+remaining < target {
+			return
+		}
+
+		// Explore the branch including the coin first, which heads
+		// towards a solution quickly as the coins are sorted by value.
+		current = append(current, i)
+		search(i+1, selected+effValues[i], remaining-effValues[i])
+		current = current[:len(current)-1]
+
+		// There's no improving upon an exact match.
+		if best != nil && bestExcess == 0 {
+			return
+		}
+
+		search(i+1, selected, remaining-effValues[i])
+	}
+	search(0, 0, remaining)
+
+	if best == nil {
+		return nil, nil
+	}
+
+	selectedCoins := make([]*Utxo, len(best))
+	for i, idx := range best {
+		selectedCoins[i] = candidates[idx]
+	}
+
+	return selectedCoins, nil
+}
@@ -0,0 +1,211 @@
+package lnwallet
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+)
+
+// testCoins creates a set of P2WKH coins of the passed values, using the index
+// of each value as the index of its outpoint.
+func testCoins(values ...btcutil.Amount) []*Utxo {
+	coins := make([]*Utxo, len(values))
+	for i, value := range values {
+		coins[i] = &Utxo{
+			AddressType: WitnessPubKey,
+			Value:       value,
+			OutPoint:    wire.OutPoint{Index: uint32(i)},
+		}
+	}
+
+	return coins
+}
+
+// fundingEstimate returns the base weight estimate of a funding transaction.
+func fundingEstimate() TxWeightEstimator {
+	var weightEstimate TxWeightEstimator
+	weightEstimate.AddP2WSHOutput()
+
+	return weightEstimate
+}
+
+// assertSelectedCoins asserts that the indexes of the outpoints of the
+// selected coins match the expected ones.
+func assertSelectedCoins(t *testing.T, selected []*Utxo, expected ...uint32) {
+	t.Helper()
+
+	if len(selected) != len(expected) {
+		t.Fatalf("expected %v coins to be selected, got %v",
+			len(expected), len(selected))
+	}
+	for i, coin := range selected {
+		if coin.Index != expected[i] {
+			t.Fatalf("expected coin %v to be selected at "+
+				"position %v, got coin %v", expected[i], i,
+				coin.Index)
+		}
+	}
+}
+
+// TestCoinSelectStrategies asserts that the coins are selected in the order
+// dictated by the coin selection strategy, and that the change pays for the
+// fee of the transaction.
+func TestCoinSelectStrategies(t *testing.T) {
+	t.Parallel()
+
+	const feeRate = SatPerKWeight(1000)
+	coins := testCoins(100000, 300000, 200000)
+
+	// The default strategy should select the coins in the order they were
+	// returned by the wallet.
+	selected, change, err := coinSelect(
+		feeRate, 350000, coins, CoinSelectionDefault,
+		fundingEstimate(),
+	)
+	if err != nil {
+		t.Fatalf("unable to select coins: %v", err)
+	}
+	assertSelectedCoins(t, selected, 0, 1)
+
+	weightEstimate := fundingEstimate()
+	weightEstimate.AddP2WKHInput().AddP2WKHInput().AddP2WKHOutput()
+	fee := feeRate.FeeForWeight(int64(weightEstimate.Weight()))
+	if change != 400000-350000-fee {
+		t.Fatalf("expected change of %v, got %v",
+			400000-350000-fee, change)
+	}
+
+	// The largest first strategy should select the two largest coins.
+	selected, _, err = coinSelect(
+		feeRate, 350000, coins, CoinSelectionLargest,
+		fundingEstimate(),
+	)
+	if err != nil {
+		t.Fatalf("unable to select coins: %v", err)
+	}
+	assertSelectedCoins(t, selected, 1, 2)
+
+	// The random strategy should select a permutation of the coins,
+	// selecting all of them if needed.
+	selected, _, err = coinSelect(
+		feeRate, 550000, coins, CoinSelectionRandom,
+		fundingEstimate(),
+	)
+	if err != nil {
+		t.Fatalf("unable to select coins: %v", err)
+	}
+	seen := make(map[uint32]struct{})
+	for _, coin := range selected {
+		seen[coin.Index] = struct{}{}
+	}
+	if len(seen) != len(coins) {
+		t.Fatalf("expected all %v coins to be selected, got %v",
+			len(coins), len(seen))
+	}
+
+	// Selecting more than the coins are worth should fail, regardless of
+	// the strategy.
+	_, _, err = coinSelect(
+		feeRate, 600000, coins, CoinSelectionLargest,
+		fundingEstimate(),
+	)
+	if _, ok := err.(*ErrInsufficientFunds); !ok {
+		t.Fatalf("expected ErrInsufficientFunds, got %v", err)
+	}
+}
+
+// TestCoinSelectBnB asserts that the branch-and-bound coin selection finds a
+// set of coins that doesn't require a change output if there is one, and falls
+// back to selecting the largest coins first otherwise.
+func TestCoinSelectBnB(t *testing.T) {
+	t.Parallel()
+
+	const feeRate = SatPerKWeight(1000)
+
+	inputWeight := int64(InputSize*witnessScaleFactor + P2WKHWitnessSize)
+	inputFee := feeRate.FeeForWeight(inputWeight)
+
+	// Along with the weight of its outputs, a funding transaction spending
+	// two coins carries the witness header.
+	baseEstimate := fundingEstimate()
+	baseWeight := int64(baseEstimate.Weight() + WitnessHeaderSize)
+	baseFee := feeRate.FeeForWeight(baseWeight)
+
+	// We'll craft the amount such that the two smallest coins pay for it,
+	// along with the fee, exactly. Selecting the largest coins first would
+	// instead result in change.
+	const amt = 500000
+	coins := testCoins(
+		1000000, 200000, 300000+baseFee+2*inputFee, 5000,
+	)
+
+	selected, change, err := coinSelect(
+		feeRate, amt, coins, CoinSelectionBnB, fundingEstimate(),
+	)
+	if err != nil {
+		t.Fatalf("unable to select coins: %v", err)
+	}
+	assertSelectedCoins(t, selected, 2, 1)
+	if change != 0 {
+		t.Fatalf("expected no change, got %v", change)
+	}
+
+	// The fee paid by the transaction should match the estimated fee of
+	// the transaction without a change output.
+	weightEstimate := fundingEstimate()
+	weightEstimate.AddP2WKHInput().AddP2WKHInput()
+	fee := feeRate.FeeForWeight(int64(weightEstimate.Weight()))
+	paid := selected[0].Value + selected[1].Value - amt
+	if paid != fee {
+		t.Fatalf("expected fee of %v, got %v", fee, paid)
+	}
+
+	// If there's no combination of coins that avoids change, then the
+	// largest coin should be selected instead.
+	coins = testCoins(200000, 1000000, 700000)
+	selected, change, err = coinSelect(
+		feeRate, amt, coins, CoinSelectionBnB, fundingEstimate(),
+	)
+	if err != nil {
+		t.Fatalf("unable to select coins: %v", err)
+	}
+	assertSelectedCoins(t, selected, 1)
+	if change == 0 {
+		t.Fatalf("expected change output")
+	}
+}
+
+// TestFilterCoins asserts that coin selection can be restricted to a set of
+// outpoints, as long as all of them are eligible coins.
+func TestFilterCoins(t *testing.T) {
+	t.Parallel()
+
+	coins := testCoins(100000, 200000, 300000)
+
+	filtered, err := filterCoins(coins, nil)
+	if err != nil {
+		t.Fatalf("unable to filter coins: %v", err)
+	}
+	assertSelectedCoins(t, filtered, 0, 1, 2)
+
+	// As a coin can only be spent once, passing its outpoint twice should
+	// be rejected.
+	outpoints := []wire.OutPoint{{Index: 2}, {Index: 0}, {Index: 2}}
+	_, err = filterCoins(coins, outpoints)
+	if err == nil {
+		t.Fatalf("expected duplicate outpoint to be rejected")
+	}
+
+	outpoints = []wire.OutPoint{{Index: 2}, {Index: 0}}
+	filtered, err = filterCoins(coins, outpoints)
+	if err != nil {
+		t.Fatalf("unable to filter coins: %v", err)
+	}
+	assertSelectedCoins(t, filtered, 2, 0)
+
+	outpoints = []wire.OutPoint{{Index: 3}}
+	if _, err := filterCoins(coins, outpoints); err == nil {
+		t.Fatalf("expected unknown outpoint to be rejected")
+	}
+}
@@ -0,0 +1,55 @@
+package lnwallet
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+// TestWebAPIEstimatorStaleFees checks that the WebAPIEstimator only uses its
+// cached fee estimates until they're older than maxFeeEstimateAge, such that
+// a MedianFeeEstimator falls back to its other sources once the API has been
+// unreachable for too long.
+func TestWebAPIEstimatorStaleFees(t *testing.T) {
+	t.Parallel()
+
+	feeEstimator := NewWebAPIEstimator(
+		SparseConfFeeSource{URL: "http://127.0.0.1:0"}, net.Dial,
+	)
+	feeEstimator.feeByBlockTarget = map[uint32]uint32{6: 20000}
+
+	// Fees that were just fetched should be used.
+	feeEstimator.feesUpdated = time.Now()
+	feeRate, err := feeEstimator.EstimateFeePerKW(6)
+	if err != nil {
+		t.Fatalf("unable to get fee rate: %v", err)
+	}
+	if feeRate != 5000 {
+		t.Fatalf("expected fee rate 5000, got %v", feeRate)
+	}
+
+	// Once the fees are older than the maximum age, they should no longer
+	// be used.
+	staleAge := maxFeeEstimateAge + time.Minute
+	feeEstimator.feesUpdated = time.Now().Add(-staleAge)
+	if _, err := feeEstimator.EstimateFeePerKW(6); err == nil {
+		t.Fatalf("expected error estimating fee rate from stale fees")
+	}
+
+	// A MedianFeeEstimator relying on the stale estimator should fall back
+	// to its remaining sources.
+	medianEstimator, err := NewMedianFeeEstimator(
+		FeePerKwFloor, 0, 0, feeEstimator,
+		StaticFeeEstimator{FeePerKW: 2000},
+	)
+	if err != nil {
+		t.Fatalf("unable to create fee estimator: %v", err)
+	}
+	feeRate, err = medianEstimator.EstimateFeePerKW(6)
+	if err != nil {
+		t.Fatalf("unable to get median fee rate: %v", err)
+	}
+	if feeRate != 2000 {
+		t.Fatalf("expected fee rate 2000, got %v", feeRate)
+	}
+}
@@ -2,6 +2,15 @@ package lnwallet
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
+	prand "math/rand"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/rpcclient"
@@ -12,6 +21,28 @@ const (
 	// FeePerKwFloor is the lowest fee rate in sat/kw that we should use for
 	// determining transaction fees.
 	FeePerKwFloor SatPerKWeight = 253
+
+	// minFeeUpdateTimeout is the minimum interval in which a
+	// WebAPIEstimator will request fresh fees from its API.
+	minFeeUpdateTimeout = 5 * time.Minute
+
+	// maxFeeUpdateTimeout is the maximum interval in which a
+	// WebAPIEstimator will request fresh fees from its API.
+	maxFeeUpdateTimeout = 20 * time.Minute
+
+	// maxFeeEstimateAge is the maximum age of the fee estimates cached by
+	// a WebAPIEstimator. Once its API has been unreachable for this long,
+	// the cached estimates are considered stale and no longer used.
+	maxFeeEstimateAge = time.Hour
+
+	// feeQueryTimeout is the maximum time we'll wait for a fee API to
+	// respond to a single query.
+	feeQueryTimeout = 10 * time.Second
+
+	// maxBlockTarget is the highest confirmation target that a
+	// WebAPIEstimator will return fees for. This number is chosen because
+	// it's the largest confirmation target that bitcoind allows.
+	maxBlockTarget uint32 = 1008
 )
 
 // SatPerKVByte represents a fee rate in sat/kb.
@@ -413,3 +444,477 @@ func (b *BitcoindFeeEstimator) fetchEstimate(confTarget uint32) (SatPerKWeight,
 // A compile-time assertion to ensure that BitcoindFeeEstimator implements the
 // FeeEstimator interface.
 var _ FeeEstimator = (*BitcoindFeeEstimator)(nil)
+
+// WebAPIFeeSource is an interface that allows the WebAPIEstimator to query an
+// arbitrary HTTP-based fee estimator. Each API format gains an implementation
+// of this interface in order to allow the WebAPIEstimator to be fully generic
+// in its logic.
+type WebAPIFeeSource interface {
+	// GenQueryURL generates the full query URL. The value returned by this
+	// method should be able to be used directly as a path for an HTTP GET
+	// request.
+	GenQueryURL() string
+
+	// ParseResponse attempts to parse the body of the response generated
+	// by the above query URL. Typically this will be JSON, but the
+	// specifics are left to the WebAPIFeeSource implementation. The
+	// returned map maps confirmation targets to fee rates expressed in
+	// sat/kb.
+	ParseResponse(r io.Reader) (map[uint32]uint32, error)
+}
+
+// SparseConfFeeSource is an implementation of the WebAPIFeeSource that
+// utilizes a user-specified fee estimation API that responds with a JSON
+// object of the form:
+//
+//	{"fee_by_block_target": {"1": 12000, "2": 10000, "6": 5000}}
+//
+// The fee rates are expressed in sat/kb, and the confirmation targets within
+// the response may be sparse.
+type SparseConfFeeSource struct {
+	// URL is the fee estimation API specified by the user.
+	URL string
+}
+
+// GenQueryURL generates the full query URL. The value returned by this
+// method should be able to be used directly as a path for an HTTP GET
+// request.
+//
+// NOTE: Part of the WebAPIFeeSource interface.
+func (s SparseConfFeeSource) GenQueryURL() string {
+	return s.URL
+}
+
+// ParseResponse attempts to parse the body of the response generated by the
+// above query URL.
+//
+// NOTE: Part of the WebAPIFeeSource interface.
+func (s SparseConfFeeSource) ParseResponse(
+	r io.Reader) (map[uint32]uint32, error) {
+
+	type jsonResp struct {
+		FeeByBlockTarget map[uint32]uint32 `json:"fee_by_block_target"`
+	}
+
+	resp := jsonResp{
+		FeeByBlockTarget: make(map[uint32]uint32),
+	}
+	jsonReader := json.NewDecoder(r)
+	if err := jsonReader.Decode(&resp); err != nil {
+		return nil, err
+	}
+
+	return resp.FeeByBlockTarget, nil
+}
+
+// A compile-time assertion to ensure that SparseConfFeeSource implements the
+// WebAPIFeeSource interface.
+var _ WebAPIFeeSource = (*SparseConfFeeSource)(nil)
+
+// WebAPIEstimator is an implementation of the FeeEstimator interface that
+// queries fee estimates from an existing HTTP/JSON web API. The fee
+// estimates are cached and periodically refreshed in the background, such
+// that queries for an estimate never block on the API. If the API can't be
+// reached, the last known estimates are used until they're older than
+// maxFeeEstimateAge. If no fresh enough estimates are known, an error is
+// returned.
+type WebAPIEstimator struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	// apiSource is the backing web API source we'll use for our queries.
+	apiSource WebAPIFeeSource
+
+	// client is the HTTP client used to query the web API.
+	client *http.Client
+
+	// feesMtx guards feeByBlockTarget and feesUpdated.
+	feesMtx sync.Mutex
+
+	// feeByBlockTarget is our cache for fees pulled from the API. When a
+	// fee estimate request comes in, we pull the estimate from this
+	// map rather than re-querying the API, to prevent an inadvertent DoS
+	// attack.
+	feeByBlockTarget map[uint32]uint32
+
+	// feesUpdated is the time at which feeByBlockTarget was last
+	// refreshed from the API.
+	feesUpdated time.Time
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewWebAPIEstimator creates a new WebAPIEstimator from a given API source.
+// All connections to the API are established through the passed dial
+// function, allowing them to be routed through a proxy such as Tor.
+func NewWebAPIEstimator(api WebAPIFeeSource,
+	dial func(network, address string) (net.Conn, error)) *WebAPIEstimator {
+
+	return &WebAPIEstimator{
+		apiSource: api,
+		client: &http.Client{
+			// As the transport doesn't specify a proxy, any proxy
+			// set through the environment is ignored, such that
+			// all connections go through the dial function.
+			Transport: &http.Transport{
+				Dial: dial,
+			},
+			Timeout: feeQueryTimeout,
+		},
+		feeByBlockTarget: make(map[uint32]uint32),
+		quit:             make(chan struct{}),
+	}
+}
+
+// EstimateFeePerKW takes in a target for the number of blocks until an initial
+// confirmation and returns the estimated fee expressed in sat/kw.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (w *WebAPIEstimator) EstimateFeePerKW(
+	numBlocks uint32) (SatPerKWeight, error) {
+
+	if numBlocks == 0 {
+		return 0, fmt.Errorf("conf target must be greater than zero")
+	}
+	if numBlocks > maxBlockTarget {
+		numBlocks = maxBlockTarget
+	}
+
+	feePerKb, err := w.getCachedFee(numBlocks)
+	if err != nil {
+		return 0, fmt.Errorf("unable to use web API fee estimate "+
+			"for conf target of %v: %v", numBlocks, err)
+	}
+
+	// Since we use fee rates in sat/kw internally, we'll convert the
+	// estimated fee rate from its sat/kb representation to sat/kw.
+	satPerKw := SatPerKVByte(feePerKb).FeePerKWeight()
+
+	// Finally, we'll enforce our fee floor.
+	if satPerKw < FeePerKwFloor {
+		satPerKw = FeePerKwFloor
+	}
+
+	walletLog.Debugf("Web API returning %v sat/kw for conf target of %v",
+		int64(satPerKw), numBlocks)
+
+	return satPerKw, nil
+}
+
+// Start signals the FeeEstimator to start any processes or goroutines it
+// needs to perform its duty.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (w *WebAPIEstimator) Start() error {
+	if !atomic.CompareAndSwapUint32(&w.started, 0, 1) {
+		return nil
+	}
+
+	walletLog.Infof("Starting web API fee estimator")
+
+	// We'll populate our cache with the initial fee estimates right away.
+	// Failing to do so isn't fatal, as we'll simply be unable to return
+	// any estimates until the API becomes reachable.
+	w.updateFeeEstimates()
+
+	w.wg.Add(1)
+	go w.feeUpdateManager()
+
+	return nil
+}
+
+// Stop stops any spawned goroutines and cleans up the resources used by the
+// fee estimator.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (w *WebAPIEstimator) Stop() error {
+	if !atomic.CompareAndSwapUint32(&w.stopped, 0, 1) {
+		return nil
+	}
+
+	close(w.quit)
+	w.wg.Wait()
+
+	return nil
+}
+
+// randomFeeUpdateTimeout returns a random timeout between minFeeUpdateTimeout
+// and maxFeeUpdateTimeout that will be used to determine how often the
+// estimator should retrieve fresh fees from its API. Randomizing the
+// interval makes the queries of a node harder to fingerprint.
+func (w *WebAPIEstimator) randomFeeUpdateTimeout() time.Duration {
+	lower := int64(minFeeUpdateTimeout)
+	upper := int64(maxFeeUpdateTimeout)
+	return time.Duration(prand.Int63n(upper-lower) + lower)
+}
+
+// getCachedFee takes in a target for the number of blocks until an initial
+// confirmation and returns a cached fee in sat/kb. As the API may only
+// provide estimates for a sparse set of confirmation targets, the estimate of
+// the closest target that's at least as fast as the requested one is used.
+// If the requested target is faster than any known target, the fastest known
+// target is used instead. An error is returned if the cached estimates are
+// older than maxFeeEstimateAge.
+func (w *WebAPIEstimator) getCachedFee(numBlocks uint32) (uint32, error) {
+	w.feesMtx.Lock()
+	defer w.feesMtx.Unlock()
+
+	if len(w.feeByBlockTarget) == 0 {
+		return 0, fmt.Errorf("no fee estimates cached")
+	}
+
+	age := time.Since(w.feesUpdated)
+	if age > maxFeeEstimateAge {
+		return 0, fmt.Errorf("cached fee estimates are stale, last "+
+			"updated %v ago", age)
+	}
+
+	var (
+		closest, fastest uint32
+		found            bool
+	)
+	for target := range w.feeByBlockTarget {
+		if fastest == 0 || target < fastest {
+			fastest = target
+		}
+		if target <= numBlocks && target > closest {
+			closest = target
+			found = true
+		}
+	}
+	if !found {
+		closest = fastest
+	}
+
+	fee := w.feeByBlockTarget[closest]
+	if fee == 0 {
+		return 0, fmt.Errorf("no fee estimate for conf target of %v",
+			closest)
+	}
+
+	return fee, nil
+}
+
+// updateFeeEstimates re-queries the API for fresh fees and caches them. If
+// the query fails, the previously cached fees are left untouched, and will
+// expire once they're older than maxFeeEstimateAge.
+func (w *WebAPIEstimator) updateFeeEstimates() {
+	feesByBlockTarget, err := w.queryFeeEstimates()
+	if err != nil {
+		walletLog.Errorf("Unable to query web API for fee "+
+			"estimates: %v", err)
+		return
+	}
+
+	// Targets of zero blocks can never be satisfied, so we'll ignore
+	// them.
+	delete(feesByBlockTarget, 0)
+	if len(feesByBlockTarget) == 0 {
+		walletLog.Errorf("Web API returned no usable fee estimates")
+		return
+	}
+
+	w.feesMtx.Lock()
+	w.feeByBlockTarget = feesByBlockTarget
+	w.feesUpdated = time.Now()
+	w.feesMtx.Unlock()
+}
+
+// queryFeeEstimates queries the API for the current fee estimates.
+func (w *WebAPIEstimator) queryFeeEstimates() (map[uint32]uint32, error) {
+	targetURL := w.apiSource.GenQueryURL()
+	resp, err := w.client.Get(targetURL)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status from %v: %v",
+			targetURL, resp.Status)
+	}
+
+	// Once we've obtained the response, we'll instruct the
+	// WebAPIFeeSource to parse out the body to obtain our final result.
+	return w.apiSource.ParseResponse(resp.Body)
+}
+
+// feeUpdateManager periodically refreshes the cached fee estimates, using a
+// random interval between each update.
+//
+// NOTE: This MUST be run as a goroutine.
+func (w *WebAPIEstimator) feeUpdateManager() {
+	defer w.wg.Done()
+
+	timer := time.NewTimer(w.randomFeeUpdateTimeout())
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			w.updateFeeEstimates()
+			timer.Reset(w.randomFeeUpdateTimeout())
+
+		case <-w.quit:
+			return
+		}
+	}
+}
+
+// A compile-time assertion to ensure that WebAPIEstimator implements the
+// FeeEstimator interface.
+var _ FeeEstimator = (*WebAPIEstimator)(nil)
+
+// MedianFeeEstimator is an implementation of the FeeEstimator interface that
+// combines several fee sources. Each estimate is the median of the estimates
+// of all sources that were able to produce one, bounded by a fee floor and
+// ceiling. This shields us from a single misbehaving source, while allowing
+// all subsystems to share consistent fee rates. Only if every source fails,
+// a fallback fee rate is used.
+type MedianFeeEstimator struct {
+	// estimators is the set of fee sources the median is taken from.
+	estimators []FeeEstimator
+
+	// minFeePerKW is the lowest fee rate in sat/kw that we'll return.
+	minFeePerKW SatPerKWeight
+
+	// maxFeePerKW is the highest fee rate in sat/kw that we'll return. A
+	// value of zero means no ceiling is enforced.
+	maxFeePerKW SatPerKWeight
+
+	// fallbackFeePerKW is the fee rate in sat/kw that is returned if
+	// none of the sources is able to produce an estimate. A value of zero
+	// means an error is returned instead.
+	fallbackFeePerKW SatPerKWeight
+}
+
+// NewMedianFeeEstimator creates a new MedianFeeEstimator from the passed fee
+// sources. All estimates are bounded by the passed fee floor and ceiling. A
+// ceiling of zero disables the upper bound. The fallback fee rate is used if
+// all sources fail, a fallback of zero disables it.
+func NewMedianFeeEstimator(minFeePerKW, maxFeePerKW,
+	fallbackFeePerKW SatPerKWeight,
+	estimators ...FeeEstimator) (*MedianFeeEstimator, error) {
+
+	if len(estimators) == 0 {
+		return nil, fmt.Errorf("at least one fee estimator must be " +
+			"provided")
+	}
+	if maxFeePerKW != 0 && maxFeePerKW < minFeePerKW {
+		return nil, fmt.Errorf("fee ceiling of %v sat/kw is below fee "+
+			"floor of %v sat/kw", int64(maxFeePerKW),
+			int64(minFeePerKW))
+	}
+
+	return &MedianFeeEstimator{
+		estimators:       estimators,
+		minFeePerKW:      minFeePerKW,
+		maxFeePerKW:      maxFeePerKW,
+		fallbackFeePerKW: fallbackFeePerKW,
+	}, nil
+}
+
+// EstimateFeePerKW takes in a target for the number of blocks until an initial
+// confirmation and returns the estimated fee expressed in sat/kw.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (m *MedianFeeEstimator) EstimateFeePerKW(
+	numBlocks uint32) (SatPerKWeight, error) {
+
+	// First, we'll gather the estimates of all of our sources, skipping
+	// those that fail to produce one.
+	estimates := make([]SatPerKWeight, 0, len(m.estimators))
+	for _, estimator := range m.estimators {
+		feePerKw, err := estimator.EstimateFeePerKW(numBlocks)
+		if err != nil {
+			walletLog.Warnf("Unable to query fee source: %v", err)
+			continue
+		}
+
+		estimates = append(estimates, feePerKw)
+	}
+	switch {
+	case len(estimates) == 0 && m.fallbackFeePerKW == 0:
+		return 0, fmt.Errorf("no fee source was able to estimate a "+
+			"fee rate for conf target of %v", numBlocks)
+
+	// If none of the sources produced an estimate, we'll use our fallback
+	// fee rate. It isn't mixed with the estimates of the sources that
+	// did, as it'd skew their median.
+	case len(estimates) == 0:
+		walletLog.Warnf("No fee source was able to estimate a fee "+
+			"rate for conf target of %v, using fallback fee rate "+
+			"of %v sat/kw", numBlocks, int64(m.fallbackFeePerKW))
+
+		estimates = append(estimates, m.fallbackFeePerKW)
+	}
+
+	// With the estimates gathered, we'll take their median. For an even
+	// number of estimates, the mean of the two middle ones is used.
+	sort.Slice(estimates, func(i, j int) bool {
+		return estimates[i] < estimates[j]
+	})
+	mid := len(estimates) / 2
+	median := estimates[mid]
+	if len(estimates)%2 == 0 {
+		median = (estimates[mid-1] + estimates[mid]) / 2
+	}
+
+	// Finally, we'll make sure the median lies within our bounds.
+	switch {
+	case median < m.minFeePerKW:
+		median = m.minFeePerKW
+
+	case m.maxFeePerKW != 0 && median > m.maxFeePerKW:
+		walletLog.Warnf("Estimated fee rate of %v sat/kw exceeds fee "+
+			"ceiling, using %v sat/kw instead", int64(median),
+			int64(m.maxFeePerKW))
+
+		median = m.maxFeePerKW
+	}
+
+	walletLog.Debugf("Returning median of %v sat/kw from %v fee sources "+
+		"for conf target of %v", int64(median), len(estimates),
+		numBlocks)
+
+	return median, nil
+}
+
+// Start signals the FeeEstimator to start any processes or goroutines it
+// needs to perform its duty. All of the fee sources are started, and if any
+// of them fails to start, those already started are stopped again.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (m *MedianFeeEstimator) Start() error {
+	for i, estimator := range m.estimators {
+		if err := estimator.Start(); err != nil {
+			for _, started := range m.estimators[:i] {
+				started.Stop()
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Stop stops any spawned goroutines and cleans up the resources used by the
+// fee estimator.
+//
+// NOTE: This method is part of the FeeEstimator interface.
+func (m *MedianFeeEstimator) Stop() error {
+	var firstErr error
+	for _, estimator := range m.estimators {
+		if err := estimator.Stop(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// A compile-time assertion to ensure that MedianFeeEstimator implements the
+// FeeEstimator interface.
+var _ FeeEstimator = (*MedianFeeEstimator)(nil)
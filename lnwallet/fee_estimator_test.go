@@ -1,6 +1,11 @@
 package lnwallet_test
 
 import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
 
 	"github.com/btcsuite/btcutil"
@@ -91,3 +96,241 @@ func TestStaticFeeEstimator(t *testing.T) {
 		t.Fatalf("expected fee rate %v, got %v", feePerKw, feeRate)
 	}
 }
+
+// TestWebAPIFeeEstimator checks that the WebAPIEstimator returns the estimate
+// of the closest confirmation target served by the API, that it reaches the
+// API through its dial function, and that it fails to estimate a fee rate if
+// the API can't be reached.
+func TestWebAPIFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	// All connections to the API should be made through our dial
+	// function.
+	var numDials uint32
+	dial := func(network, address string) (net.Conn, error) {
+		atomic.AddUint32(&numDials, 1)
+		return net.Dial(network, address)
+	}
+
+	// We'll serve a sparse set of fee estimates in sat/kb, with the
+	// slowest one being below our fee floor.
+	server := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"fee_by_block_target": {"2": 40000, `+
+				`"6": 20000, "144": 4000, "1008": 800}}`)
+		},
+	))
+	defer server.Close()
+
+	feeEstimator := lnwallet.NewWebAPIEstimator(
+		lnwallet.SparseConfFeeSource{URL: server.URL}, dial,
+	)
+	if err := feeEstimator.Start(); err != nil {
+		t.Fatalf("unable to start fee estimator: %v", err)
+	}
+	defer feeEstimator.Stop()
+
+	testCases := []struct {
+		numBlocks uint32
+		feePerKw  lnwallet.SatPerKWeight
+	}{
+		// Targets faster than any known one use the fastest estimate.
+		{numBlocks: 1, feePerKw: 10000},
+		{numBlocks: 2, feePerKw: 10000},
+
+		// Targets in between known ones use the closest faster one.
+		{numBlocks: 5, feePerKw: 10000},
+		{numBlocks: 6, feePerKw: 5000},
+		{numBlocks: 1000, feePerKw: 1000},
+
+		// Estimates below the fee floor are raised to it, and targets
+		// beyond the maximum are capped.
+		{numBlocks: 1008, feePerKw: lnwallet.FeePerKwFloor},
+		{numBlocks: 5000, feePerKw: lnwallet.FeePerKwFloor},
+	}
+	for _, testCase := range testCases {
+		feeRate, err := feeEstimator.EstimateFeePerKW(
+			testCase.numBlocks,
+		)
+		if err != nil {
+			t.Fatalf("unable to get fee rate: %v", err)
+		}
+		if feeRate != testCase.feePerKw {
+			t.Fatalf("expected fee rate %v for conf target %v, "+
+				"got %v", testCase.feePerKw, testCase.numBlocks,
+				feeRate)
+		}
+	}
+
+	if atomic.LoadUint32(&numDials) == 0 {
+		t.Fatalf("expected API to be reached through dial function")
+	}
+
+	// A fee estimator whose API fails shouldn't return any fee rate, as
+	// that'd skew the median of the other fee sources.
+	failingServer := httptest.NewServer(http.HandlerFunc(
+		func(w http.ResponseWriter, r *http.Request) {
+			http.Error(
+				w, "unavailable", http.StatusServiceUnavailable,
+			)
+		},
+	))
+	defer failingServer.Close()
+
+	failingEstimator := lnwallet.NewWebAPIEstimator(
+		lnwallet.SparseConfFeeSource{URL: failingServer.URL}, dial,
+	)
+	if err := failingEstimator.Start(); err != nil {
+		t.Fatalf("unable to start fee estimator: %v", err)
+	}
+	defer failingEstimator.Stop()
+
+	if _, err := failingEstimator.EstimateFeePerKW(6); err == nil {
+		t.Fatalf("expected error estimating fee rate without API")
+	}
+}
+
+// failingFeeEstimator is a fee estimator that's unable to estimate any fee
+// rates.
+type failingFeeEstimator struct {
+	lnwallet.StaticFeeEstimator
+}
+
+// EstimateFeePerKW always returns an error.
+func (f failingFeeEstimator) EstimateFeePerKW(
+	numBlocks uint32) (lnwallet.SatPerKWeight, error) {
+
+	return 0, fmt.Errorf("unable to estimate fee")
+}
+
+// TestMedianFeeEstimator checks that the MedianFeeEstimator returns the median
+// of the estimates of its fee sources, bounded by its fee floor and ceiling.
+func TestMedianFeeEstimator(t *testing.T) {
+	t.Parallel()
+
+	static := func(feePerKw lnwallet.SatPerKWeight) lnwallet.FeeEstimator {
+		return lnwallet.StaticFeeEstimator{FeePerKW: feePerKw}
+	}
+
+	testCases := []struct {
+		name       string
+		minFee     lnwallet.SatPerKWeight
+		maxFee     lnwallet.SatPerKWeight
+		fallback   lnwallet.SatPerKWeight
+		estimators []lnwallet.FeeEstimator
+		feePerKw   lnwallet.SatPerKWeight
+		expectErr  bool
+	}{
+		{
+			name:   "odd number of sources",
+			minFee: lnwallet.FeePerKwFloor,
+			estimators: []lnwallet.FeeEstimator{
+				static(1000), static(3000), static(2000),
+			},
+			feePerKw: 2000,
+		},
+		{
+			name:   "even number of sources",
+			minFee: lnwallet.FeePerKwFloor,
+			estimators: []lnwallet.FeeEstimator{
+				static(1000), static(3000), static(2000),
+				static(5000),
+			},
+			feePerKw: 2500,
+		},
+		{
+			name:   "failing sources are skipped",
+			minFee: lnwallet.FeePerKwFloor,
+			estimators: []lnwallet.FeeEstimator{
+				static(1000), failingFeeEstimator{},
+				static(3000), static(2000),
+			},
+			feePerKw: 2000,
+		},
+		{
+			name:   "fee floor",
+			minFee: 3000,
+			estimators: []lnwallet.FeeEstimator{
+				static(1000), static(2000),
+			},
+			feePerKw: 3000,
+		},
+		{
+			name:   "fee ceiling",
+			minFee: lnwallet.FeePerKwFloor,
+			maxFee: 1500,
+			estimators: []lnwallet.FeeEstimator{
+				static(2000), static(4000),
+			},
+			feePerKw: 1500,
+		},
+		{
+			name:     "fallback isn't mixed with estimates",
+			minFee:   lnwallet.FeePerKwFloor,
+			fallback: 12500,
+			estimators: []lnwallet.FeeEstimator{
+				static(1000), failingFeeEstimator{},
+				failingFeeEstimator{},
+			},
+			feePerKw: 1000,
+		},
+		{
+			name:     "fallback if all sources failing",
+			minFee:   lnwallet.FeePerKwFloor,
+			maxFee:   10000,
+			fallback: 12500,
+			estimators: []lnwallet.FeeEstimator{
+				failingFeeEstimator{}, failingFeeEstimator{},
+			},
+			feePerKw: 10000,
+		},
+		{
+			name:   "all sources failing",
+			minFee: lnwallet.FeePerKwFloor,
+			estimators: []lnwallet.FeeEstimator{
+				failingFeeEstimator{}, failingFeeEstimator{},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		feeEstimator, err := lnwallet.NewMedianFeeEstimator(
+			testCase.minFee, testCase.maxFee, testCase.fallback,
+			testCase.estimators...,
+		)
+		if err != nil {
+			t.Fatalf("%v: unable to create fee estimator: %v",
+				testCase.name, err)
+		}
+
+		feeRate, err := feeEstimator.EstimateFeePerKW(6)
+		switch {
+		case testCase.expectErr && err == nil:
+			t.Fatalf("%v: expected error", testCase.name)
+
+		case !testCase.expectErr && err != nil:
+			t.Fatalf("%v: unable to get fee rate: %v",
+				testCase.name, err)
+
+		case feeRate != testCase.feePerKw:
+			t.Fatalf("%v: expected fee rate %v, got %v",
+				testCase.name, testCase.feePerKw, feeRate)
+		}
+	}
+
+	// A fee estimator without any sources, or with a ceiling below its
+	// floor, can't be created.
+	_, err := lnwallet.NewMedianFeeEstimator(lnwallet.FeePerKwFloor, 0, 0)
+	if err == nil {
+		t.Fatalf("expected error creating estimator without sources")
+	}
+	_, err = lnwallet.NewMedianFeeEstimator(
+		lnwallet.FeePerKwFloor, lnwallet.FeePerKwFloor-1, 0,
+		static(1000),
+	)
+	if err == nil {
+		t.Fatalf("expected error creating estimator with ceiling " +
+			"below floor")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
@@ -45,6 +46,20 @@ var (
 	// ErrNotMine is an error denoting that a WalletController instance is
 	// unable to spend a specified output.
 	ErrNotMine = errors.New("the passed output doesn't belong to the wallet")
+
+	// ErrOutputAlreadyLeased is returned when attempting to lease an
+	// output that is currently leased under a different lock ID, or that
+	// is locked otherwise, e.g. by a pending channel reservation.
+	ErrOutputAlreadyLeased = errors.New("output is already leased")
+
+	// ErrOutputNotLeased is returned when attempting to release an output
+	// that isn't currently leased.
+	ErrOutputNotLeased = errors.New("output is not leased")
+
+	// ErrOutputLeaseMismatch is returned when attempting to release an
+	// output that is leased under a different lock ID.
+	ErrOutputLeaseMismatch = errors.New("output is leased under a " +
+		"different lock ID")
 )
 
 // Utxo is an unspent output denoted by its outpoint, and output value of the
@@ -58,6 +73,76 @@ type Utxo struct {
 	wire.OutPoint
 }
 
+// LockID is an identifier chosen by the holder of a lease on a wallet output.
+// Only the holder of the lease, i.e. the caller that presents the same ID, is
+// able to release or extend the lease.
+type LockID [32]byte
+
+// LeasedOutput is a wallet output that is currently leased, and therefore
+// excluded from coin selection until the lease is either released or expires.
+type LeasedOutput struct {
+	// LockID is the ID the output was leased under.
+	LockID LockID
+
+	// OutPoint is the leased output.
+	OutPoint wire.OutPoint
+
+	// Expiration is the time at which the lease expires, making the output
+	// eligible for coin selection once again.
+	Expiration time.Time
+}
+
+// RecoveryProgress describes how far the wallet has come in rescanning the
+// chain for the funds of a restored seed.
+type RecoveryProgress struct {
+	// RecoveryMode is true if the wallet was started with a non-zero
+	// recovery window, i.e. it is rescanning for the addresses of a
+	// restored seed.
+	RecoveryMode bool
+
+	// RecoveryWindow is the address look-ahead used during the rescan.
+	RecoveryWindow uint32
+
+	// Finished is true once the wallet has rescanned the chain up to the
+	// current best block.
+	Finished bool
+
+	// StartHeight is the height the wallet started rescanning from,
+	// which is bounded by the birthday of the seed.
+	StartHeight int32
+
+	// CurrentHeight is the height the wallet has rescanned up to.
+	CurrentHeight int32
+
+	// BestHeight is the height of the best block of the chain backend.
+	BestHeight int32
+
+	// AddressesFound is the number of addresses of the wallet's default
+	// accounts that have been found to be used on-chain so far. Addresses
+	// that were derived but never received funds aren't counted.
+	AddressesFound uint32
+}
+
+// Progress returns the fraction of the blocks between the start height and
+// the best height that have been rescanned, between 0 and 1.
+func (r *RecoveryProgress) Progress() float64 {
+	if r.Finished || r.BestHeight <= r.StartHeight {
+		return 1
+	}
+
+	scanned := float64(r.CurrentHeight - r.StartHeight)
+	if scanned < 0 {
+		return 0
+	}
+
+	progress := scanned / float64(r.BestHeight-r.StartHeight)
+	if progress > 1 {
+		return 1
+	}
+
+	return progress
+}
+
 // TransactionDetail describes a transaction with either inputs which belong to
 // the wallet, or has outputs that pay to the wallet.
 type TransactionDetail struct {
@@ -94,6 +179,9 @@ type TransactionDetail struct {
 
 	// DestAddresses are the destinations for a transaction
 	DestAddresses []btcutil.Address
+
+	// RawTx returns the raw serialized transaction.
+	RawTx []byte
 }
 
 // TransactionSubscription is an interface which describes an object capable of
@@ -183,6 +271,30 @@ type WalletController interface {
 	// eligible for coin selection.
 	UnlockOutpoint(o wire.OutPoint)
 
+	// LeaseOutput locks an output of the wallet under the passed lock ID
+	// for the given duration, excluding it from coin selection until the
+	// lease expires. Unlike locked outputs, leases are persisted and
+	// therefore survive restarts. Leasing an output that's already leased
+	// under the same ID extends the lease. The expiration of the lease is
+	// returned.
+	LeaseOutput(id LockID, op wire.OutPoint,
+		duration time.Duration) (time.Time, error)
+
+	// ReleaseOutput releases the lease on an output, making it eligible
+	// for coin selection once again. The passed lock ID must match the one
+	// the output was leased under.
+	ReleaseOutput(id LockID, op wire.OutPoint) error
+
+	// ListLeasedOutputs returns all outputs that are currently leased.
+	ListLeasedOutputs() ([]*LeasedOutput, error)
+
+	// ListLeasedUnspentWitness returns the unspent witness outputs of the
+	// wallet which are leased under the passed lock ID, and have at least
+	// minConfs confirmations. As leased outputs are locked, they're never
+	// returned by ListUnspentWitness, so this allows the holder of a lease
+	// to spend its outputs.
+	ListLeasedUnspentWitness(id LockID, minConfs int32) ([]*Utxo, error)
+
 	// PublishTransaction performs cursory validation (dust checks, etc),
 	// then finally broadcasts the passed transaction to the Bitcoin network.
 	// If the transaction is rejected because it is conflicting with an
@@ -209,6 +321,11 @@ type WalletController interface {
 	// known to the wallet, expressed in Unix epoch time
 	IsSynced() (bool, int64, error)
 
+	// RecoveryProgress returns the progress of the wallet's rescan for the
+	// funds of a restored seed. If the wallet wasn't started in recovery
+	// mode, then the returned progress reports so.
+	RecoveryProgress() (*RecoveryProgress, error)
+
 	// Start initializes the wallet, making any necessary connections,
 	// starting up required goroutines etc.
 	Start() error
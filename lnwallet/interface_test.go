@@ -543,6 +543,194 @@ func testFundingTransactionLockedOutputs(miner *rpctest.Harness,
 	}
 }
 
+func testLeaseOutputs(miner *rpctest.Harness,
+	alice, _ *lnwallet.LightningWallet, t *testing.T) {
+
+	utxos, err := alice.ListUnspentWitness(1)
+	if err != nil {
+		t.Fatalf("unable to list utxos: %v", err)
+	}
+	if len(utxos) == 0 {
+		t.Fatalf("expected wallet to have utxos")
+	}
+	outpoint := utxos[0].OutPoint
+
+	// Lease the first output of the wallet, after which it should no
+	// longer be eligible for coin selection.
+	lockID := lnwallet.LockID{1}
+	_, err = alice.LeaseOutput(lockID, outpoint, time.Hour)
+	if err != nil {
+		t.Fatalf("unable to lease output: %v", err)
+	}
+
+	assertEligible := func(eligible bool) {
+		t.Helper()
+
+		utxos, err := alice.ListUnspentWitness(1)
+		if err != nil {
+			t.Fatalf("unable to list utxos: %v", err)
+		}
+
+		var found bool
+		for _, utxo := range utxos {
+			if utxo.OutPoint == outpoint {
+				found = true
+			}
+		}
+		if found != eligible {
+			t.Fatalf("expected output eligibility to be %v, "+
+				"was %v", eligible, found)
+		}
+	}
+	assertEligible(false)
+
+	leases, err := alice.ListLeasedOutputs()
+	if err != nil {
+		t.Fatalf("unable to list leases: %v", err)
+	}
+	if len(leases) != 1 || leases[0].OutPoint != outpoint ||
+		leases[0].LockID != lockID {
+
+		t.Fatalf("unexpected leases: %v", spew.Sdump(leases))
+	}
+
+	// The output can't be leased, nor released, under a different ID, but
+	// the holder of the lease should be able to extend it.
+	otherID := lnwallet.LockID{2}
+	_, err = alice.LeaseOutput(otherID, outpoint, time.Hour)
+	if err != lnwallet.ErrOutputAlreadyLeased {
+		t.Fatalf("expected ErrOutputAlreadyLeased, got %v", err)
+	}
+	err = alice.ReleaseOutput(otherID, outpoint)
+	if err != lnwallet.ErrOutputLeaseMismatch {
+		t.Fatalf("expected ErrOutputLeaseMismatch, got %v", err)
+	}
+	_, err = alice.LeaseOutput(lockID, outpoint, 2*time.Hour)
+	if err != nil {
+		t.Fatalf("unable to extend lease: %v", err)
+	}
+
+	// Once released, the output should be eligible for coin selection
+	// once again.
+	if err := alice.ReleaseOutput(lockID, outpoint); err != nil {
+		t.Fatalf("unable to release output: %v", err)
+	}
+	assertEligible(true)
+
+	err = alice.ReleaseOutput(lockID, outpoint)
+	if err != lnwallet.ErrOutputNotLeased {
+		t.Fatalf("expected ErrOutputNotLeased, got %v", err)
+	}
+
+	// Finally, a lease that has expired shouldn't prevent the output from
+	// being selected.
+	_, err = alice.LeaseOutput(lockID, outpoint, time.Second)
+	if err != nil {
+		t.Fatalf("unable to lease output: %v", err)
+	}
+	time.Sleep(time.Second * 2)
+	assertEligible(true)
+}
+
+func testSendOutputsWithCoinSelect(miner *rpctest.Harness,
+	alice, _ *lnwallet.LightningWallet, t *testing.T) {
+
+	utxos, err := alice.ListUnspentWitness(1)
+	if err != nil {
+		t.Fatalf("unable to list utxos: %v", err)
+	}
+	if len(utxos) < 2 {
+		t.Fatalf("expected wallet to have at least two utxos")
+	}
+	outpoint := utxos[1].OutPoint
+
+	minerAddr, err := miner.NewAddress()
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	script, err := txscript.PayToAddrScript(minerAddr)
+	if err != nil {
+		t.Fatalf("unable to create output script: %v", err)
+	}
+	output := &wire.TxOut{
+		Value:    btcutil.SatoshiPerBitcoin / 2,
+		PkScript: script,
+	}
+
+	// Send to the miner, spending only the chosen output of the wallet.
+	feePerKw, err := alice.Cfg.FeeEstimator.EstimateFeePerKW(1)
+	if err != nil {
+		t.Fatalf("unable to query fee estimator: %v", err)
+	}
+	tx, err := alice.SendOutputsWithCoinSelect(
+		[]*wire.TxOut{output}, feePerKw, 1,
+		[]wire.OutPoint{outpoint}, nil, lnwallet.CoinSelectionLargest,
+	)
+	if err != nil {
+		t.Fatalf("unable to send outputs: %v", err)
+	}
+
+	txid := tx.TxHash()
+	if err := waitForMempoolTx(miner, &txid); err != nil {
+		t.Fatalf("tx not relayed to miner: %v", err)
+	}
+
+	if len(tx.TxIn) != 1 || tx.TxIn[0].PreviousOutPoint != outpoint {
+		t.Fatalf("expected tx to only spend %v, got %v", outpoint,
+			spew.Sdump(tx.TxIn))
+	}
+
+	// Now that the output has been spent, it should no longer be possible
+	// to select it.
+	_, err = alice.SendOutputsWithCoinSelect(
+		[]*wire.TxOut{output}, feePerKw, 0,
+		[]wire.OutPoint{outpoint}, nil, lnwallet.CoinSelectionDefault,
+	)
+	if err == nil {
+		t.Fatalf("expected spent output to be rejected")
+	}
+
+	// A leased output should only be spent by the holder of the lease, if
+	// it's passed explicitly.
+	leasedOutpoint := utxos[0].OutPoint
+	lockID := lnwallet.LockID{3}
+	_, err = alice.LeaseOutput(lockID, leasedOutpoint, time.Hour)
+	if err != nil {
+		t.Fatalf("unable to lease output: %v", err)
+	}
+	defer alice.ReleaseOutput(lockID, leasedOutpoint)
+
+	otherID := lnwallet.LockID{4}
+	for _, id := range []*lnwallet.LockID{nil, &otherID} {
+		_, err = alice.SendOutputsWithCoinSelect(
+			[]*wire.TxOut{output}, feePerKw, 1,
+			[]wire.OutPoint{leasedOutpoint}, id,
+			lnwallet.CoinSelectionDefault,
+		)
+		if err == nil {
+			t.Fatalf("expected leased output to be rejected")
+		}
+	}
+
+	tx, err = alice.SendOutputsWithCoinSelect(
+		[]*wire.TxOut{output}, feePerKw, 1,
+		[]wire.OutPoint{leasedOutpoint}, &lockID,
+		lnwallet.CoinSelectionDefault,
+	)
+	if err != nil {
+		t.Fatalf("unable to spend leased output: %v", err)
+	}
+	if len(tx.TxIn) != 1 || tx.TxIn[0].PreviousOutPoint != leasedOutpoint {
+		t.Fatalf("expected tx to only spend %v, got %v",
+			leasedOutpoint, spew.Sdump(tx.TxIn))
+	}
+
+	txid = tx.TxHash()
+	if err := waitForMempoolTx(miner, &txid); err != nil {
+		t.Fatalf("tx not relayed to miner: %v", err)
+	}
+}
+
 func testFundingCancellationNotEnoughFunds(miner *rpctest.Harness,
 	alice, _ *lnwallet.LightningWallet, t *testing.T) {
 
@@ -1972,6 +2160,192 @@ type walletTestCase struct {
 		test *testing.T)
 }
 
+// testRecoveryProgress asserts that the wallet reports the progress of its
+// rescan, including the number of used addresses, and that a wallet started
+// without a recovery window doesn't report being in recovery mode.
+func testRecoveryProgress(miner *rpctest.Harness,
+	alice, _ *lnwallet.LightningWallet, t *testing.T) {
+
+	// We'll first mine a block, such that the wallet needs to advance its
+	// rescan.
+	if _, err := miner.Node.Generate(1); err != nil {
+		t.Fatalf("unable to generate block: %v", err)
+	}
+	if err := waitForWalletSync(miner, alice); err != nil {
+		t.Fatalf("unable to sync wallet: %v", err)
+	}
+	_, bestHeight, err := miner.Node.GetBestBlock()
+	if err != nil {
+		t.Fatalf("unable to get best block: %v", err)
+	}
+
+	progress, err := alice.RecoveryProgress()
+	if err != nil {
+		t.Fatalf("unable to get recovery progress: %v", err)
+	}
+	if progress.RecoveryMode {
+		t.Fatalf("wallet without recovery window in recovery mode")
+	}
+	if !progress.Finished || progress.Progress() != 1 {
+		t.Fatalf("expected rescan of synced wallet to be finished, "+
+			"got progress %v", progress.Progress())
+	}
+	if progress.CurrentHeight != bestHeight ||
+		progress.BestHeight != bestHeight {
+
+		t.Fatalf("expected current and best height %v, got %v and %v",
+			bestHeight, progress.CurrentHeight, progress.BestHeight)
+	}
+
+	// The wallet received its test credits at fresh addresses, which
+	// should all be reported as found.
+	if progress.AddressesFound < 20 {
+		t.Fatalf("expected at least 20 addresses found, got %v",
+			progress.AddressesFound)
+	}
+
+	// Merely deriving another address shouldn't change the number of
+	// addresses found, as it isn't used yet.
+	addr, err := alice.NewAddress(lnwallet.WitnessPubKey, false)
+	if err != nil {
+		t.Fatalf("unable to create address: %v", err)
+	}
+	newProgress, err := alice.RecoveryProgress()
+	if err != nil {
+		t.Fatalf("unable to get recovery progress: %v", err)
+	}
+	if newProgress.AddressesFound != progress.AddressesFound {
+		t.Fatalf("expected %v addresses found, got %v",
+			progress.AddressesFound, newProgress.AddressesFound)
+	}
+
+	// Once the address receives funds, it should be counted.
+	script, err := txscript.PayToAddrScript(addr)
+	if err != nil {
+		t.Fatalf("unable to create output script: %v", err)
+	}
+	output := &wire.TxOut{
+		Value:    btcutil.SatoshiPerBitcoin,
+		PkScript: script,
+	}
+	_, err = miner.SendOutputs([]*wire.TxOut{output}, 2500)
+	if err != nil {
+		t.Fatalf("unable to send to address: %v", err)
+	}
+	if _, err := miner.Node.Generate(1); err != nil {
+		t.Fatalf("unable to generate block: %v", err)
+	}
+	if err := waitForWalletSync(miner, alice); err != nil {
+		t.Fatalf("unable to sync wallet: %v", err)
+	}
+
+	newProgress, err = alice.RecoveryProgress()
+	if err != nil {
+		t.Fatalf("unable to get recovery progress: %v", err)
+	}
+	if newProgress.AddressesFound != progress.AddressesFound+1 {
+		t.Fatalf("expected %v addresses found, got %v",
+			progress.AddressesFound+1, newProgress.AddressesFound)
+	}
+}
+
+// testRecoveryWindow is the address look-ahead of the wallet restored from
+// Alice's seed.
+const testRecoveryWindow = 25
+
+// testWalletRecovery asserts that a wallet restored from Alice's seed with a
+// non-zero recovery window reports the progress of its rescan, as streamed by
+// GetRecoveryInfo, and recovers all of Alice's funds once it has finished.
+func testWalletRecovery(miner *rpctest.Harness,
+	alice *lnwallet.LightningWallet, restored lnwallet.WalletController,
+	t *testing.T) {
+
+	if err := restored.Start(); err != nil {
+		t.Fatalf("unable to start restored wallet: %v", err)
+	}
+	defer restored.Stop()
+
+	_, bestHeight, err := miner.Node.GetBestBlock()
+	if err != nil {
+		t.Fatalf("unable to get best block: %v", err)
+	}
+
+	// Just like GetRecoveryInfo, we'll poll the progress of the rescan
+	// until it has finished, making sure it never goes backwards.
+	var (
+		progress     *lnwallet.RecoveryProgress
+		lastProgress float64
+	)
+	timeout := time.After(30 * time.Second)
+	for {
+		progress, err = restored.RecoveryProgress()
+		if err != nil {
+			t.Fatalf("unable to get recovery progress: %v", err)
+		}
+		if !progress.RecoveryMode {
+			t.Fatalf("restored wallet not in recovery mode")
+		}
+		if progress.RecoveryWindow != testRecoveryWindow {
+			t.Fatalf("expected recovery window %v, got %v",
+				testRecoveryWindow, progress.RecoveryWindow)
+		}
+
+		fraction := progress.Progress()
+		if fraction < lastProgress || fraction > 1 {
+			t.Fatalf("invalid progress %v, previously %v",
+				fraction, lastProgress)
+		}
+		lastProgress = fraction
+
+		if progress.Finished {
+			break
+		}
+
+		select {
+		case <-time.After(100 * time.Millisecond):
+		case <-timeout:
+			t.Fatalf("rescan didn't finish, progress %v: %v",
+				fraction, spew.Sdump(progress))
+		}
+	}
+
+	if progress.Progress() != 1 {
+		t.Fatalf("expected finished rescan to have progress 1, got "+
+			"%v", progress.Progress())
+	}
+	if progress.CurrentHeight < bestHeight ||
+		progress.BestHeight < bestHeight {
+
+		t.Fatalf("expected current and best height of at least %v, "+
+			"got %v and %v", bestHeight, progress.CurrentHeight,
+			progress.BestHeight)
+	}
+	if progress.StartHeight >= bestHeight {
+		t.Fatalf("expected rescan to start below height %v, got %v",
+			bestHeight, progress.StartHeight)
+	}
+
+	// Alice was funded at 20 fresh addresses, all of which should have
+	// been found along with her funds.
+	if progress.AddressesFound < 20 {
+		t.Fatalf("expected at least 20 addresses found, got %v",
+			progress.AddressesFound)
+	}
+
+	aliceBalance, err := alice.ConfirmedBalance(1)
+	if err != nil {
+		t.Fatalf("unable to query for balance: %v", err)
+	}
+	restoredBalance, err := restored.ConfirmedBalance(1)
+	if err != nil {
+		t.Fatalf("unable to query for balance: %v", err)
+	}
+	if restoredBalance != aliceBalance {
+		t.Fatalf("expected restored balance %v, got %v",
+			aliceBalance, restoredBalance)
+	}
+}
+
 var walletTests = []walletTestCase{
 	{
 		name: "insane fee reject",
@@ -1989,6 +2363,14 @@ var walletTests = []walletTestCase{
 		name: "output locking",
 		test: testFundingTransactionLockedOutputs,
 	},
+	{
+		name: "output leasing",
+		test: testLeaseOutputs,
+	},
+	{
+		name: "send outputs with coin selection",
+		test: testSendOutputsWithCoinSelect,
+	},
 	{
 		name: "reservation insufficient funds",
 		test: testFundingCancellationNotEnoughFunds,
@@ -2013,6 +2395,10 @@ var walletTests = []walletTestCase{
 		name: "test cancel non-existent reservation",
 		test: testCancelNonExistentReservation,
 	},
+	{
+		name: "recovery progress",
+		test: testRecoveryProgress,
+	},
 	{
 		name: "reorg wallet balance",
 		test: testReorgWalletBalance,
@@ -2194,6 +2580,9 @@ func runTests(t *testing.T, walletDriver *lnwallet.WalletDriver,
 		aliceWalletController lnwallet.WalletController
 		bobWalletController   lnwallet.WalletController
 
+		// recoveredWalletController is restored from Alice's seed.
+		recoveredWalletController lnwallet.WalletController
+
 		feeEstimator lnwallet.FeeEstimator
 	)
 
@@ -2209,10 +2598,16 @@ func runTests(t *testing.T, walletDriver *lnwallet.WalletDriver,
 	}
 	defer os.RemoveAll(tempTestDirBob)
 
+	tempTestDirRecovery, err := ioutil.TempDir("", "lnwallet")
+	if err != nil {
+		t.Fatalf("unable to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(tempTestDirRecovery)
+
 	walletType := walletDriver.WalletType
 	switch walletType {
 	case "btcwallet":
-		var aliceClient, bobClient chain.Interface
+		var aliceClient, bobClient, recoveryClient chain.Interface
 		switch backEnd {
 		case "btcd":
 			feeEstimator, err = lnwallet.NewBtcdFeeEstimator(
@@ -2233,6 +2628,12 @@ func runTests(t *testing.T, walletDriver *lnwallet.WalletDriver,
 			if err != nil {
 				t.Fatalf("unable to make chain rpc: %v", err)
 			}
+			recoveryClient, err = chain.NewRPCClient(netParams,
+				rpcConfig.Host, rpcConfig.User, rpcConfig.Pass,
+				rpcConfig.Certificates, false, 20)
+			if err != nil {
+				t.Fatalf("unable to make chain rpc: %v", err)
+			}
 
 		case "neutrino":
 			feeEstimator = lnwallet.StaticFeeEstimator{FeePerKW: 62500}
@@ -2297,6 +2698,33 @@ func runTests(t *testing.T, walletDriver *lnwallet.WalletDriver,
 				netParams, bobChain,
 			)
 
+			// Do the same for the wallet restored from Alice's
+			// seed.
+			recoveryDB, err := walletdb.Create("bdb",
+				tempTestDirRecovery+"/neutrino.db")
+			if err != nil {
+				t.Fatalf("unable to create DB: %v", err)
+			}
+			defer recoveryDB.Close()
+			recoveryChain, err := neutrino.NewChainService(
+				neutrino.Config{
+					DataDir:     tempTestDirRecovery,
+					Database:    recoveryDB,
+					ChainParams: *netParams,
+					ConnectPeers: []string{
+						miningNode.P2PAddress(),
+					},
+				},
+			)
+			if err != nil {
+				t.Fatalf("unable to make neutrino: %v", err)
+			}
+			recoveryChain.Start()
+			defer recoveryChain.Stop()
+			recoveryClient = chain.NewNeutrinoClient(
+				netParams, recoveryChain,
+			)
+
 		case "bitcoind":
 			feeEstimator, err = lnwallet.NewBitcoindFeeEstimator(
 				rpcConfig, 250)
@@ -2353,9 +2781,11 @@ func runTests(t *testing.T, walletDriver *lnwallet.WalletDriver,
 			defer chainConn.Stop()
 
 			// Create a btcwallet bitcoind client for both Alice and
-			// Bob.
+			// Bob, along with the wallet restored from Alice's
+			// seed.
 			aliceClient = chainConn.NewBitcoindClient()
 			bobClient = chainConn.NewBitcoindClient()
+			recoveryClient = chainConn.NewBitcoindClient()
 		default:
 			t.Fatalf("unknown chain driver: %v", backEnd)
 		}
@@ -2408,6 +2838,19 @@ func runTests(t *testing.T, walletDriver *lnwallet.WalletDriver,
 			keychain.CoinTypeTestnet,
 		)
 		bio = bobWalletController.(*btcwallet.BtcWallet)
+
+		// Finally, we'll create the wallet restored from Alice's
+		// seed, which won't be started until she has been funded.
+		recoveryWalletConfig := *aliceWalletConfig
+		recoveryWalletConfig.DataDir = tempTestDirRecovery
+		recoveryWalletConfig.ChainSource = recoveryClient
+		recoveryWalletConfig.RecoveryWindow = testRecoveryWindow
+		recoveredWalletController, err = walletDriver.New(
+			&recoveryWalletConfig,
+		)
+		if err != nil {
+			t.Fatalf("unable to create btcwallet: %v", err)
+		}
 	default:
 		t.Fatalf("unknown wallet driver: %v", walletType)
 	}
@@ -2438,12 +2881,24 @@ func runTests(t *testing.T, walletDriver *lnwallet.WalletDriver,
 	assertProperBalance(t, alice, 1, 80)
 	assertProperBalance(t, bob, 1, 80)
 
+	// Before any of Alice's funds are spent, we'll ensure that they can
+	// be recovered from her seed.
+	testName := fmt.Sprintf("%v/%v:wallet recovery", walletType, backEnd)
+	success := t.Run(testName, func(t *testing.T) {
+		testWalletRecovery(
+			miningNode, alice, recoveredWalletController, t,
+		)
+	})
+	if !success {
+		return
+	}
+
 	// Execute every test, clearing possibly mutated
 	// wallet state after each step.
 	for _, walletTest := range walletTests {
 		testName := fmt.Sprintf("%v/%v:%v", walletType, backEnd,
 			walletTest.name)
-		success := t.Run(testName, func(t *testing.T) {
+		success = t.Run(testName, func(t *testing.T) {
 			walletTest.test(miningNode, alice, bob, t)
 		})
 		if !success {
@@ -97,6 +97,20 @@ type InitFundingReserveMsg struct {
 	// output selected to fund the channel should satisfy.
 	MinConfs int32
 
+	// Outpoints is an optional set of wallet outputs that the funding
+	// transaction should be funded from. If set, coin selection won't
+	// consider any other outputs.
+	Outpoints []wire.OutPoint
+
+	// LockID is the optional ID under which any of the passed outpoints
+	// are leased. Leased outputs are only spent if they're explicitly
+	// passed, and leased under this ID.
+	LockID *LockID
+
+	// CoinSelectionStrategy is the strategy used to select the outputs
+	// that fund the channel.
+	CoinSelectionStrategy CoinSelectionStrategy
+
 	// err is a channel in which all errors will be sent across. Will be
 	// nil if this initial set is successful.
 	//
@@ -478,6 +492,7 @@ func (l *LightningWallet) handleFundingReserveRequest(req *InitFundingReserveMsg
 		// the fee rate passed in to perform coin selection.
 		err := l.selectCoinsAndChange(
 			req.FundingFeePerKw, req.FundingAmount, req.MinConfs,
+			req.Outpoints, req.LockID, req.CoinSelectionStrategy,
 			reservation.ourContribution,
 		)
 		if err != nil {
@@ -609,10 +624,16 @@ func (l *LightningWallet) handleFundingCancelRequest(req *fundingReserveCancelMs
 	defer pendingReservation.Unlock()
 
 	// Mark all previously locked outpoints as useable for future funding
-	// requests.
+	// requests. Leased outputs aren't tracked as locked outpoints, as
+	// they remain locked until their lease is released.
 	for _, unusedInput := range pendingReservation.ourContribution.Inputs {
-		delete(l.lockedOutPoints, unusedInput.PreviousOutPoint)
-		l.UnlockOutpoint(unusedInput.PreviousOutPoint)
+		op := unusedInput.PreviousOutPoint
+		if _, ok := l.lockedOutPoints[op]; !ok {
+			continue
+		}
+
+		delete(l.lockedOutPoints, op)
+		l.UnlockOutpoint(op)
 	}
 
 	// TODO(roasbeef): is it even worth it to keep track of unused keys?
@@ -1259,10 +1280,13 @@ func (l *LightningWallet) handleSingleFunderSigs(req *addSingleFunderSigsMsg) {
 // outputs which sum to at least 'numCoins' amount of satoshis. If coin
 // selection is successful/possible, then the selected coins are available
 // within the passed contribution's inputs. If necessary, a change address will
-// also be generated.
+// also be generated. If any outpoints are passed, then only those are
+// considered during coin selection, including those leased under the passed
+// lock ID.
 // TODO(roasbeef): remove hardcoded fees.
 func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
-	amt btcutil.Amount, minConfs int32,
+	amt btcutil.Amount, minConfs int32, outpoints []wire.OutPoint,
+	lockID *LockID, strategy CoinSelectionStrategy,
 	contribution *ChannelContribution) error {
 
 	// We hold the coin select mutex while querying for outputs, and
@@ -1274,29 +1298,32 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 	walletLog.Infof("Performing funding tx coin selection using %v "+
 		"sat/kw as fee rate", int64(feeRate))
 
-	// Find all unlocked unspent witness outputs that satisfy the minimum
-	// number of confirmations required.
-	coins, err := l.ListUnspentWitness(minConfs)
-	if err != nil {
-		return err
-	}
+	// Channel funding multisig output is P2WSH.
+	var weightEstimate TxWeightEstimator
+	weightEstimate.AddP2WSHOutput()
 
 	// Perform coin selection over our available, unlocked unspent outputs
 	// in order to find enough coins to meet the funding amount
 	// requirements.
-	selectedCoins, changeAmt, err := coinSelect(feeRate, amt, coins)
+	selectedCoins, changeAmt, leased, err := l.selectCoins(
+		feeRate, amt, minConfs, outpoints, lockID, strategy,
+		weightEstimate,
+	)
 	if err != nil {
 		return err
 	}
 
 	// Lock the selected coins. These coins are now "reserved", this
 	// prevents concurrent funding requests from referring to and this
-	// double-spending the same set of coins.
+	// double-spending the same set of coins. Leased coins are already
+	// locked for the duration of their lease.
 	contribution.Inputs = make([]*wire.TxIn, len(selectedCoins))
 	for i, coin := range selectedCoins {
 		outpoint := &coin.OutPoint
-		l.lockedOutPoints[*outpoint] = struct{}{}
-		l.LockOutpoint(*outpoint)
+		if _, ok := leased[*outpoint]; !ok {
+			l.lockedOutPoints[*outpoint] = struct{}{}
+			l.LockOutpoint(*outpoint)
+		}
 
 		// Empty sig script, we'll actually sign if this reservation is
 		// queued up to be completed (the other side accepts).
@@ -1307,23 +1334,184 @@ func (l *LightningWallet) selectCoinsAndChange(feeRate SatPerKWeight,
 	// selection, but only if the addition of the output won't lead to the
 	// creation of dust.
 	if changeAmt != 0 && changeAmt > DefaultDustLimit() {
-		changeAddr, err := l.NewAddress(WitnessPubKey, true)
+		changeOutput, err := l.newChangeOutput(changeAmt)
 		if err != nil {
 			return err
 		}
-		changeScript, err := txscript.PayToAddrScript(changeAddr)
+
+		contribution.ChangeOutputs = []*wire.TxOut{changeOutput}
+	}
+
+	return nil
+}
+
+// selectCoins performs coin selection over the unlocked unspent witness
+// outputs of the wallet that satisfy the minimum number of confirmations,
+// restricted to the passed outpoints if any. Outputs leased under the passed
+// lock ID are only eligible if they're explicitly passed. The base weight
+// estimate should account for all outputs of the transaction to be funded,
+// except for the change output. The selected coins are returned along with the
+// amount of change left over after paying fees, and the set of eligible leased
+// outpoints.
+//
+// NOTE: The coinSelectMtx MUST be held when calling this method.
+func (l *LightningWallet) selectCoins(feeRate SatPerKWeight,
+	amt btcutil.Amount, minConfs int32, outpoints []wire.OutPoint,
+	lockID *LockID, strategy CoinSelectionStrategy,
+	baseEstimate TxWeightEstimator) ([]*Utxo, btcutil.Amount,
+	map[wire.OutPoint]struct{}, error) {
+
+	// Find all unlocked unspent witness outputs that satisfy the minimum
+	// number of confirmations required.
+	coins, err := l.ListUnspentWitness(minConfs)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	// As leased outputs are locked, we'll need to add those leased under
+	// the caller's lock ID ourselves.
+	leased := make(map[wire.OutPoint]struct{})
+	if lockID != nil && len(outpoints) != 0 {
+		leasedCoins, err := l.ListLeasedUnspentWitness(
+			*lockID, minConfs,
+		)
 		if err != nil {
-			return err
+			return nil, 0, nil, err
 		}
 
-		contribution.ChangeOutputs = make([]*wire.TxOut, 1)
-		contribution.ChangeOutputs[0] = &wire.TxOut{
-			Value:    int64(changeAmt),
-			PkScript: changeScript,
+		for _, coin := range leasedCoins {
+			leased[coin.OutPoint] = struct{}{}
 		}
+		coins = append(coins, leasedCoins...)
 	}
 
-	return nil
+	coins, err = filterCoins(coins, outpoints)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	selected, changeAmt, err := coinSelect(
+		feeRate, amt, coins, strategy, baseEstimate,
+	)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	return selected, changeAmt, leased, nil
+}
+
+// newChangeOutput creates an output paying the passed amount to a fresh change
+// address of the wallet.
+func (l *LightningWallet) newChangeOutput(
+	amt btcutil.Amount) (*wire.TxOut, error) {
+
+	changeAddr, err := l.NewAddress(WitnessPubKey, true)
+	if err != nil {
+		return nil, err
+	}
+	changeScript, err := txscript.PayToAddrScript(changeAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &wire.TxOut{
+		Value:    int64(amt),
+		PkScript: changeScript,
+	}, nil
+}
+
+// SendOutputsWithCoinSelect funds, signs, and broadcasts a transaction paying
+// out to the specified outputs. Unlike SendOutputs of the WalletController,
+// the inputs of the transaction are selected by the LightningWallet itself,
+// which allows the caller to restrict the inputs to the passed outpoints, and
+// to pick the coin selection strategy used. Only outputs with at least
+// minConfs confirmations are eligible to be spent. Leased outputs are only
+// spent if they're explicitly passed, and leased under the passed lock ID.
+// Coin selection is serialized with the funding of channels, so that
+// concurrent requests never attempt to spend the same outputs.
+func (l *LightningWallet) SendOutputsWithCoinSelect(outputs []*wire.TxOut,
+	feeRate SatPerKWeight, minConfs int32, outpoints []wire.OutPoint,
+	lockID *LockID, strategy CoinSelectionStrategy) (*wire.MsgTx, error) {
+
+	if len(outputs) == 0 {
+		return nil, errors.New("no outputs to send to")
+	}
+
+	var (
+		amt            btcutil.Amount
+		weightEstimate TxWeightEstimator
+	)
+	for _, output := range outputs {
+		amt += btcutil.Amount(output.Value)
+		weightEstimate.AddTxOutput(output)
+	}
+
+	l.coinSelectMtx.Lock()
+	defer l.coinSelectMtx.Unlock()
+
+	walletLog.Infof("Performing coin selection for sending %v using %v "+
+		"sat/kw as fee rate and %v strategy", amt, int64(feeRate),
+		strategy)
+
+	selectedCoins, changeAmt, _, err := l.selectCoins(
+		feeRate, amt, minConfs, outpoints, lockID, strategy,
+		weightEstimate,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.NewMsgTx(2)
+	for _, coin := range selectedCoins {
+		tx.AddTxIn(wire.NewTxIn(&coin.OutPoint, nil, nil))
+	}
+	for _, output := range outputs {
+		tx.AddTxOut(output)
+	}
+
+	// As with funding transactions, change that would be considered dust
+	// is left to the miners.
+	if changeAmt > DefaultDustLimit() {
+		changeOutput, err := l.newChangeOutput(changeAmt)
+		if err != nil {
+			return nil, err
+		}
+		tx.AddTxOut(changeOutput)
+	}
+
+	txsort.InPlaceSort(tx)
+
+	// With the transaction fully assembled, we'll now sign each of its
+	// inputs, all of which are ours.
+	signDesc := SignDescriptor{
+		HashType:  txscript.SigHashAll,
+		SigHashes: txscript.NewTxSigHashes(tx),
+	}
+	for i, txIn := range tx.TxIn {
+		info, err := l.FetchInputInfo(&txIn.PreviousOutPoint)
+		if err != nil {
+			return nil, err
+		}
+
+		signDesc.Output = info
+		signDesc.InputIndex = i
+
+		inputScript, err := l.Cfg.Signer.ComputeInputScript(
+			tx, &signDesc,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		txIn.SignatureScript = inputScript.ScriptSig
+		txIn.Witness = inputScript.Witness
+	}
+
+	if err := l.PublishTransaction(tx); err != nil {
+		return nil, err
+	}
+
+	return tx, nil
 }
 
 // DeriveStateHintObfuscator derives the bytes to be used for obfuscating the
@@ -1381,9 +1569,32 @@ func selectInputs(amt btcutil.Amount, coins []*Utxo) (btcutil.Amount, []*Utxo, e
 // coinSelect attempts to select a sufficient amount of coins, including a
 // change output to fund amt satoshis, adhering to the specified fee rate. The
 // specified fee rate should be expressed in sat/kw for coin selection to
-// function properly.
-func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount,
-	coins []*Utxo) ([]*Utxo, btcutil.Amount, error) {
+// function properly. The base weight estimate should account for all outputs
+// of the transaction, except for the change output. The coins are picked
+// according to the passed coin selection strategy.
+func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount, coins []*Utxo,
+	strategy CoinSelectionStrategy,
+	baseEstimate TxWeightEstimator) ([]*Utxo, btcutil.Amount, error) {
+
+	coins, err := orderCoins(coins, strategy)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// If requested, we'll first attempt to find a set of coins that
+	// doesn't require a change output, falling back to the largest coins
+	// first otherwise.
+	if strategy == CoinSelectionBnB {
+		selectedUtxos, err := coinSelectBnB(
+			feeRate, amt, baseEstimate, coins,
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+		if selectedUtxos != nil {
+			return selectedUtxos, 0, nil
+		}
+	}
 
 	amtNeeded := amt
 	for {
@@ -1394,23 +1605,14 @@ func coinSelect(feeRate SatPerKWeight, amt btcutil.Amount,
 			return nil, 0, err
 		}
 
-		var weightEstimate TxWeightEstimator
-
+		weightEstimate := baseEstimate
 		for _, utxo := range selectedUtxos {
-			switch utxo.AddressType {
-			case WitnessPubKey:
-				weightEstimate.AddP2WKHInput()
-			case NestedWitnessPubKey:
-				weightEstimate.AddNestedP2WKHInput()
-			default:
-				return nil, 0, fmt.Errorf("Unsupported address type: %v",
-					utxo.AddressType)
+			err := addInputWeight(&weightEstimate, utxo)
+			if err != nil {
+				return nil, 0, err
 			}
 		}
 
-		// Channel funding multisig output is P2WSH.
-		weightEstimate.AddP2WSHOutput()
-
 		// Assume that change output is a P2WKH output.
 		//
 		// TODO: Handle wallets that generate non-witness change
@@ -69,8 +69,54 @@ const (
 	// broadcast a revoked commitment, but then also immediately attempt to
 	// go to the second level to claim the HTLC.
 	HtlcSecondLevelRevoke WitnessType = 9
+
+	// WitnessKeyHash is a witness type that allows us to spend a regular
+	// p2wkh output that's sent to an output which is under complete
+	// control of the backing wallet.
+	WitnessKeyHash WitnessType = 10
 )
 
+// String returns a human readable version of the WitnessType.
+func (wt WitnessType) String() string {
+	switch wt {
+	case CommitmentTimeLock:
+		return "CommitmentTimeLock"
+
+	case CommitmentNoDelay:
+		return "CommitmentNoDelay"
+
+	case CommitmentRevoke:
+		return "CommitmentRevoke"
+
+	case HtlcOfferedRevoke:
+		return "HtlcOfferedRevoke"
+
+	case HtlcAcceptedRevoke:
+		return "HtlcAcceptedRevoke"
+
+	case HtlcOfferedTimeoutSecondLevel:
+		return "HtlcOfferedTimeoutSecondLevel"
+
+	case HtlcAcceptedSuccessSecondLevel:
+		return "HtlcAcceptedSuccessSecondLevel"
+
+	case HtlcOfferedRemoteTimeout:
+		return "HtlcOfferedRemoteTimeout"
+
+	case HtlcAcceptedRemoteSuccess:
+		return "HtlcAcceptedRemoteSuccess"
+
+	case HtlcSecondLevelRevoke:
+		return "HtlcSecondLevelRevoke"
+
+	case WitnessKeyHash:
+		return "WitnessKeyHash"
+
+	default:
+		return fmt.Sprintf("Unknown WitnessType: %d", uint16(wt))
+	}
+}
+
 // WitnessGenerator represents a function which is able to generate the final
 // witness for a particular public key script. This function acts as an
 // abstraction layer, hiding the details of the underlying script.
@@ -120,6 +166,16 @@ func (wt WitnessType) GenWitnessFunc(signer Signer,
 		case HtlcSecondLevelRevoke:
 			return htlcSpendRevoke(signer, desc, tx)
 
+		case WitnessKeyHash:
+			inputScript, err := signer.ComputeInputScript(
+				tx, desc,
+			)
+			if err != nil {
+				return nil, err
+			}
+
+			return inputScript.Witness, nil
+
 		default:
 			return nil, fmt.Errorf("unknown witness type: %v", wt)
 		}
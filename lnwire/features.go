@@ -46,6 +46,17 @@ const (
 	// efficient network view reconciliation.
 	GossipQueriesOptional FeatureBit = 7
 
+	// PaymentSecretRequired is an invoice feature bit that signals that
+	// the payee requires the payer to include the payment secret of the
+	// invoice within the onion payload of the final hop. Payments that
+	// don't carry the secret will be rejected.
+	PaymentSecretRequired FeatureBit = 14
+
+	// PaymentSecretOptional is an invoice feature bit that signals that
+	// the invoice carries a payment secret which the payer should include
+	// within the onion payload of the final hop.
+	PaymentSecretOptional FeatureBit = 15
+
 	// maxAllowedSize is a maximum allowed size of feature vector.
 	//
 	// NOTE: Within the protocol, the maximum allowed message size is 65535
@@ -80,6 +91,15 @@ var LocalFeatures = map[FeatureBit]string{
 // description of these feature bits is provided in the BOLT-09 specification.
 var GlobalFeatures map[FeatureBit]string
 
+// InvoiceFeatures is a mapping of known invoice feature bits to a descriptive
+// name. Invoice features are included within the feature field of a BOLT-11
+// payment request, and signal the features the payee supports or requires
+// from the payer.
+var InvoiceFeatures = map[FeatureBit]string{
+	PaymentSecretRequired: "payment-secret",
+	PaymentSecretOptional: "payment-secret",
+}
+
 // RawFeatureVector represents a set of feature bits as defined in BOLT-09.  A
 // RawFeatureVector itself just stores a set of bit flags but can be used to
 // construct a FeatureVector which binds meaning to each bit. Feature vectors
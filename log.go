@@ -14,7 +14,9 @@ import (
 	"github.com/lightninglabs/neutrino"
 	"github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/autopilot"
+	"github.com/lightningnetwork/lnd/brontide"
 	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
@@ -22,6 +24,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/signal"
+	"github.com/lightningnetwork/lnd/sweep"
 )
 
 // logWriter implements an io.Writer that outputs to both standard output and
@@ -75,6 +78,10 @@ var (
 	atplLog = backendLog.Logger("ATPL")
 	cnctLog = backendLog.Logger("CNCT")
 	sphxLog = backendLog.Logger("SPHX")
+	rbalLog = backendLog.Logger("RBAL")
+	chacLog = backendLog.Logger("CHAC")
+	swprLog = backendLog.Logger("SWPR")
+	brntLog = backendLog.Logger("BRNT")
 )
 
 // Initialize package-global logger variables.
@@ -91,6 +98,9 @@ func init() {
 	contractcourt.UseLogger(cnctLog)
 	sphinx.UseLogger(sphxLog)
 	signal.UseLogger(ltndLog)
+	chanacceptor.UseLogger(chacLog)
+	sweep.UseLogger(swprLog)
+	brontide.UseLogger(brntLog)
 }
 
 // subsystemLoggers maps each subsystem identifier to its associated logger.
@@ -113,6 +123,10 @@ var subsystemLoggers = map[string]btclog.Logger{
 	"ATPL": atplLog,
 	"CNCT": cnctLog,
 	"SPHX": sphxLog,
+	"RBAL": rbalLog,
+	"CHAC": chacLog,
+	"SWPR": swprLog,
+	"BRNT": brntLog,
 }
 
 // initLogRotator initializes the logging rotator to write logs to logFile and
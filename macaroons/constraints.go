@@ -3,6 +3,8 @@ package macaroons
 import (
 	"fmt"
 	"net"
+	"strconv"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/peer"
@@ -11,8 +13,32 @@ import (
 	macaroon "gopkg.in/macaroon.v2"
 
 	"golang.org/x/net/context"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+const (
+	// methodsCondition is the name of the caveat restricting a macaroon to
+	// a set of gRPC methods.
+	methodsCondition = "methods"
+
+	// maxPaymentCondition is the name of the caveat restricting the amount
+	// of a single payment.
+	maxPaymentCondition = "max-payment"
+
+	// spendBudgetCondition is the name of the caveat restricting the total
+	// amount of payments within a rolling window.
+	spendBudgetCondition = "spend-budget"
+
+	// rateLimitCondition is the name of the caveat restricting the number
+	// of calls per minute.
+	rateLimitCondition = "rate-limit"
 )
 
+// fullMethodContextKey is the type of the key under which the full gRPC method
+// name of a request is stored within the context of its validation.
+type fullMethodContextKey struct{}
+
 // Constraint type adds a layer of indirection over macaroon caveats.
 type Constraint func(*macaroon.Macaroon) error
 
@@ -88,3 +114,184 @@ func IPLockChecker() (string, checkers.Func) {
 		return nil
 	}
 }
+
+// MethodsConstraint restricts the macaroon to the passed gRPC methods, given
+// by their full method names, e.g. /lnrpc.Lightning/GetInfo. The permissions
+// of the macaroon still apply to the allowed methods.
+func MethodsConstraint(methods ...string) func(*macaroon.Macaroon) error {
+	return func(mac *macaroon.Macaroon) error {
+		if len(methods) == 0 {
+			return fmt.Errorf("at least one method must be allowed")
+		}
+		for _, method := range methods {
+			if !strings.HasPrefix(method, "/") ||
+				strings.ContainsAny(method, " \t\n") {
+
+				return fmt.Errorf("invalid full method name %q",
+					method)
+			}
+		}
+
+		caveat := checkers.Condition(
+			methodsCondition, strings.Join(methods, " "),
+		)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// MaxPaymentConstraint restricts the amount of each payment sent with the
+// macaroon, including routing fees, to the passed amount.
+func MaxPaymentConstraint(
+	maxAmt lnwire.MilliSatoshi) func(*macaroon.Macaroon) error {
+
+	return func(mac *macaroon.Macaroon) error {
+		caveat := checkers.Condition(
+			maxPaymentCondition, strconv.FormatUint(
+				uint64(maxAmt), 10,
+			),
+		)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// SpendBudgetConstraint restricts the total amount of the payments sent with
+// the macaroon, including routing fees, to the passed budget within any
+// window of the passed duration.
+func SpendBudgetConstraint(budget lnwire.MilliSatoshi,
+	window time.Duration) func(*macaroon.Macaroon) error {
+
+	return func(mac *macaroon.Macaroon) error {
+		if window < time.Second {
+			return fmt.Errorf("spend budget window must be at " +
+				"least one second")
+		}
+
+		caveat := checkers.Condition(
+			spendBudgetCondition, fmt.Sprintf(
+				"%d %d", uint64(budget),
+				int64(window/time.Second),
+			),
+		)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// RateLimitConstraint restricts the number of calls made with the macaroon
+// within any minute to the passed limit.
+func RateLimitConstraint(
+	callsPerMinute uint32) func(*macaroon.Macaroon) error {
+
+	return func(mac *macaroon.Macaroon) error {
+		if callsPerMinute == 0 {
+			return fmt.Errorf("rate limit must be positive")
+		}
+
+		caveat := checkers.Condition(
+			rateLimitCondition, strconv.FormatUint(
+				uint64(callsPerMinute), 10,
+			),
+		)
+		return mac.AddFirstPartyCaveat([]byte(caveat))
+	}
+}
+
+// MethodsChecker compares the gRPC method a request is made for, as stored in
+// the validation context, with the methods the macaroon is restricted to. It
+// is of the `Checker` type.
+func MethodsChecker() (string, checkers.Func) {
+	return methodsCondition, func(ctx context.Context, cond,
+		arg string) error {
+
+		method, ok := ctx.Value(fullMethodContextKey{}).(string)
+		if !ok {
+			return fmt.Errorf("unable to get method from context")
+		}
+
+		for _, allowed := range strings.Fields(arg) {
+			if allowed == method {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("macaroon not valid for method %v", method)
+	}
+}
+
+// MaxPaymentChecker verifies the format of the max-payment caveat. As the
+// amount of a payment isn't known when a request is validated, the caveat is
+// enforced by Service.ValidatePayment. It is of the `Checker` type.
+func MaxPaymentChecker() (string, checkers.Func) {
+	return maxPaymentCondition, func(_ context.Context, _,
+		arg string) error {
+
+		_, err := parseMaxPayment(arg)
+		return err
+	}
+}
+
+// SpendBudgetChecker verifies the format of the spend-budget caveat. As the
+// amount of a payment isn't known when a request is validated, the caveat is
+// enforced by Service.ValidatePayment. It is of the `Checker` type.
+func SpendBudgetChecker() (string, checkers.Func) {
+	return spendBudgetCondition, func(_ context.Context, _,
+		arg string) error {
+
+		_, _, err := parseSpendBudget(arg)
+		return err
+	}
+}
+
+// RateLimitChecker verifies the format of the rate-limit caveat. As the caveat
+// requires state to be kept across requests, it's enforced by
+// Service.ValidateMacaroon. It is of the `Checker` type.
+func RateLimitChecker() (string, checkers.Func) {
+	return rateLimitCondition, func(_ context.Context, _,
+		arg string) error {
+
+		_, err := parseRateLimit(arg)
+		return err
+	}
+}
+
+// parseMaxPayment parses the argument of a max-payment caveat.
+func parseMaxPayment(arg string) (lnwire.MilliSatoshi, error) {
+	maxAmt, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid max payment %q", arg)
+	}
+
+	return lnwire.MilliSatoshi(maxAmt), nil
+}
+
+// parseSpendBudget parses the argument of a spend-budget caveat, the budget in
+// millisatoshis followed by the window in seconds.
+func parseSpendBudget(arg string) (lnwire.MilliSatoshi, time.Duration,
+	error) {
+
+	fields := strings.Fields(arg)
+	if len(fields) != 2 {
+		return 0, 0, fmt.Errorf("invalid spend budget %q", arg)
+	}
+
+	budget, err := strconv.ParseUint(fields[0], 10, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid spend budget %q", arg)
+	}
+	window, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil || window <= 0 {
+		return 0, 0, fmt.Errorf("invalid spend budget window %q", arg)
+	}
+
+	return lnwire.MilliSatoshi(budget), time.Duration(window) * time.Second,
+		nil
+}
+
+// parseRateLimit parses the argument of a rate-limit caveat.
+func parseRateLimit(arg string) (uint32, error) {
+	limit, err := strconv.ParseUint(arg, 10, 32)
+	if err != nil || limit == 0 {
+		return 0, fmt.Errorf("invalid rate limit %q", arg)
+	}
+
+	return uint32(limit), nil
+}
@@ -106,3 +106,54 @@ func TestIPLockBadIP(t *testing.T) {
 		t.Fatalf("IPLockConstraint with bad IP should fail.")
 	}
 }
+
+// TestLimitConstraints tests that the caveats restricting the methods,
+// payments and call rate of a macaroon are created, and that invalid limits
+// are rejected.
+func TestLimitConstraints(t *testing.T) {
+	testMacaroon := createDummyMacaroon(t)
+	newMac, err := macaroons.AddConstraints(testMacaroon,
+		macaroons.MethodsConstraint(
+			"/lnrpc.Lightning/GetInfo",
+			"/lnrpc.Lightning/SendPaymentSync",
+		),
+		macaroons.MaxPaymentConstraint(100000),
+		macaroons.SpendBudgetConstraint(1000000, time.Hour),
+		macaroons.RateLimitConstraint(60),
+	)
+	if err != nil {
+		t.Fatalf("Error adding constraints: %v", err)
+	}
+
+	expectedCaveats := []string{
+		"methods /lnrpc.Lightning/GetInfo " +
+			"/lnrpc.Lightning/SendPaymentSync",
+		"max-payment 100000",
+		"spend-budget 1000000 3600",
+		"rate-limit 60",
+	}
+	caveats := newMac.Caveats()
+	if len(caveats) != len(expectedCaveats) {
+		t.Fatalf("Expected %d caveats, got %d", len(expectedCaveats),
+			len(caveats))
+	}
+	for i, caveat := range caveats {
+		if string(caveat.Id) != expectedCaveats[i] {
+			t.Fatalf("Added caveat '%s' does not meet the "+
+				"expectations, expected '%s'", caveat.Id,
+				expectedCaveats[i])
+		}
+	}
+
+	invalidConstraints := []macaroons.Constraint{
+		macaroons.MethodsConstraint(),
+		macaroons.MethodsConstraint("GetInfo"),
+		macaroons.SpendBudgetConstraint(1000, time.Millisecond),
+		macaroons.RateLimitConstraint(0),
+	}
+	for i, constraint := range invalidConstraints {
+		if err := constraint(createDummyMacaroon(t)); err == nil {
+			t.Fatalf("Invalid constraint %d should fail.", i)
+		}
+	}
+}
@@ -0,0 +1,354 @@
+package macaroons
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/coreos/bbolt"
+	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
+	macaroon "gopkg.in/macaroon.v2"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+var (
+	// spendBudgetBucketName is the name of the bucket within the macaroon
+	// DB that stores the payments counted towards each spend budget. The
+	// bucket maps the key of a spend budget to the list of its payments:
+	//
+	//   limitKey -> (timestamp || amount)*
+	spendBudgetBucketName = []byte("macspendbudgets")
+)
+
+const (
+	// spendRecordSize is the size of a serialized payment within a spend
+	// budget: the unix timestamp in nanoseconds of the payment, followed
+	// by its amount in millisatoshis.
+	spendRecordSize = 8 + 8
+
+	// rateLimitWindow is the window within which the calls counting
+	// towards a rate-limit caveat are made.
+	rateLimitWindow = time.Minute
+)
+
+// limitKey identifies the state of a stateful caveat of a macaroon. It
+// commits to the ID of the macaroon, which is shared with all macaroons
+// derived from it, along with the caveat itself.
+type limitKey [sha256.Size]byte
+
+// newLimitKey returns the key of the state of the passed caveat of the
+// macaroon.
+func newLimitKey(mac *macaroon.Macaroon, caveat []byte) limitKey {
+	h := sha256.New()
+	h.Write(mac.Id())
+	h.Write(caveat)
+
+	var key limitKey
+	copy(key[:], h.Sum(nil))
+	return key
+}
+
+// firstPartyCaveats returns the raw caveats of the macaroon with the passed
+// condition, along with their arguments.
+func firstPartyCaveats(mac *macaroon.Macaroon,
+	condition string) ([][]byte, []string) {
+
+	var (
+		caveats [][]byte
+		args    []string
+	)
+	for _, caveat := range mac.Caveats() {
+		// Third-party caveats carry a verification ID.
+		if len(caveat.VerificationId) != 0 {
+			continue
+		}
+
+		cond, arg, err := checkers.ParseCaveat(string(caveat.Id))
+		if err != nil || cond != condition {
+			continue
+		}
+
+		caveats = append(caveats, caveat.Id)
+		args = append(args, arg)
+	}
+
+	return caveats, args
+}
+
+// rateLimiter keeps track of the calls made under each rate-limit caveat
+// within the last rateLimitWindow.
+type rateLimiter struct {
+	mu    sync.Mutex
+	calls map[limitKey][]time.Time
+}
+
+// newRateLimiter creates a new rate limiter.
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		calls: make(map[limitKey][]time.Time),
+	}
+}
+
+// allow records a call made with the passed macaroon, unless this would
+// exceed any of its rate-limit caveats, in which case an error is returned
+// and the call isn't recorded.
+func (r *rateLimiter) allow(mac *macaroon.Macaroon, now time.Time) error {
+	caveats, args := firstPartyCaveats(mac, rateLimitCondition)
+	if len(caveats) == 0 {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// We'll first check all limits, such that a call is either recorded
+	// towards all of them, or none.
+	keys := make([]limitKey, len(caveats))
+	for i, caveat := range caveats {
+		limit, err := parseRateLimit(args[i])
+		if err != nil {
+			return err
+		}
+
+		keys[i] = newLimitKey(mac, caveat)
+		calls := r.prune(keys[i], now)
+		if uint32(len(calls)) >= limit {
+			return fmt.Errorf("rate limit of %d calls per minute "+
+				"exceeded", limit)
+		}
+	}
+
+	for _, key := range keys {
+		r.calls[key] = append(r.calls[key], now)
+	}
+
+	return nil
+}
+
+// prune removes the calls that are no longer within the window of the rate
+// limit with the passed key, and returns the remaining ones.
+//
+// NOTE: The mutex MUST be held when calling this method.
+func (r *rateLimiter) prune(key limitKey, now time.Time) []time.Time {
+	calls := r.calls[key]
+
+	var i int
+	for i < len(calls) && !calls[i].After(now.Add(-rateLimitWindow)) {
+		i++
+	}
+	calls = calls[i:]
+
+	if len(calls) == 0 {
+		delete(r.calls, key)
+		return nil
+	}
+
+	r.calls[key] = calls
+	return calls
+}
+
+// SpendReservation is a payment reserved within the spend budgets of a
+// macaroon. Once the payment has either settled or failed, the reservation
+// must be reduced to the amount actually spent, if any.
+type SpendReservation struct {
+	db      *bolt.DB
+	budgets []spendBudget
+	amt     lnwire.MilliSatoshi
+	at      time.Time
+}
+
+// Settle reduces the reservation to the amount actually spent by the payment,
+// including routing fees, releasing the rest. A nil reservation is a no-op.
+func (r *SpendReservation) Settle(spent lnwire.MilliSatoshi) error {
+	if r == nil || spent >= r.amt {
+		return nil
+	}
+
+	if err := adjust(r.db, r.budgets, r.amt, r.at, spent); err != nil {
+		return err
+	}
+	r.amt = spent
+
+	return nil
+}
+
+// Release releases the full reservation, as the payment failed. A nil
+// reservation is a no-op.
+func (r *SpendReservation) Release() error {
+	return r.Settle(0)
+}
+
+// spendBudget is a spend-budget caveat of a macaroon.
+type spendBudget struct {
+	key    limitKey
+	budget lnwire.MilliSatoshi
+	window time.Duration
+}
+
+// spendBudgets returns all spend-budget caveats of the macaroon.
+func spendBudgets(mac *macaroon.Macaroon) ([]spendBudget, error) {
+	caveats, args := firstPartyCaveats(mac, spendBudgetCondition)
+
+	budgets := make([]spendBudget, 0, len(caveats))
+	for i, caveat := range caveats {
+		budget, window, err := parseSpendBudget(args[i])
+		if err != nil {
+			return nil, err
+		}
+
+		budgets = append(budgets, spendBudget{
+			key:    newLimitKey(mac, caveat),
+			budget: budget,
+			window: window,
+		})
+	}
+
+	return budgets, nil
+}
+
+// spend reserves a payment of the passed amount within each of the spend
+// budgets in the database, unless this would exceed any of them within its
+// window, in which case an error is returned and nothing is reserved.
+func spend(db *bolt.DB, budgets []spendBudget, amt lnwire.MilliSatoshi,
+	now time.Time) error {
+
+	if len(budgets) == 0 {
+		return nil
+	}
+
+	return db.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(spendBudgetBucketName)
+		if err != nil {
+			return err
+		}
+
+		for _, b := range budgets {
+			if err := b.record(bucket, amt, now); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// adjust changes the amount of a payment that was reserved at the given time
+// within each of the spend budgets in the database to newAmt. A payment
+// adjusted to zero no longer counts towards the budgets.
+func adjust(db *bolt.DB, budgets []spendBudget, amt lnwire.MilliSatoshi,
+	at time.Time, newAmt lnwire.MilliSatoshi) error {
+
+	if len(budgets) == 0 || amt == newAmt {
+		return nil
+	}
+
+	return db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(spendBudgetBucketName)
+		if bucket == nil {
+			return nil
+		}
+
+		for _, b := range budgets {
+			err := b.replace(bucket, amt, at, newAmt)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// record adds a payment of the passed amount to the payments counted towards
+// the spend budget, unless this would exceed the budget within its window.
+// Payments that fall out of the window are removed.
+func (b *spendBudget) record(bucket *bolt.Bucket, amt lnwire.MilliSatoshi,
+	now time.Time) error {
+
+	records := bucket.Get(b.key[:])
+	if len(records)%spendRecordSize != 0 {
+		return fmt.Errorf("invalid spend budget records")
+	}
+
+	// Only the payments within the window of the budget count towards
+	// it.
+	cutoff := now.Add(-b.window).UnixNano()
+	var (
+		spent lnwire.MilliSatoshi
+		kept  []byte
+	)
+	for i := 0; i < len(records); i += spendRecordSize {
+		record := records[i : i+spendRecordSize]
+		timestamp := int64(binary.BigEndian.Uint64(record[:8]))
+		if timestamp <= cutoff {
+			continue
+		}
+
+		recordAmt := binary.BigEndian.Uint64(record[8:])
+		spent += lnwire.MilliSatoshi(recordAmt)
+		kept = append(kept, record...)
+	}
+
+	if spent+amt > b.budget {
+		return fmt.Errorf("payment of %v exceeds spend budget of %v "+
+			"within %v, %v already spent", amt, b.budget, b.window,
+			spent)
+	}
+
+	record := newSpendRecord(amt, now)
+	kept = append(kept, record[:]...)
+
+	return bucket.Put(b.key[:], kept)
+}
+
+// replace changes the amount of a payment made at the given time from amt to
+// newAmt within the payments counted towards the spend budget, removing the
+// payment if newAmt is zero. If no such payment exists, for instance because
+// it has already fallen out of the window, nothing is changed.
+func (b *spendBudget) replace(bucket *bolt.Bucket, amt lnwire.MilliSatoshi,
+	at time.Time, newAmt lnwire.MilliSatoshi) error {
+
+	records := bucket.Get(b.key[:])
+	if len(records)%spendRecordSize != 0 {
+		return fmt.Errorf("invalid spend budget records")
+	}
+
+	// Payments with the same amount made at the same time can't be told
+	// apart, so we'll only change the first one.
+	target := newSpendRecord(amt, at)
+	for i := 0; i < len(records); i += spendRecordSize {
+		if !bytes.Equal(records[i:i+spendRecordSize], target[:]) {
+			continue
+		}
+
+		kept := make([]byte, 0, len(records))
+		kept = append(kept, records[:i]...)
+		if newAmt != 0 {
+			record := newSpendRecord(newAmt, at)
+			kept = append(kept, record[:]...)
+		}
+		kept = append(kept, records[i+spendRecordSize:]...)
+		if len(kept) == 0 {
+			return bucket.Delete(b.key[:])
+		}
+
+		return bucket.Put(b.key[:], kept)
+	}
+
+	return nil
+}
+
+// newSpendRecord serializes a payment of the passed amount made at the given
+// time.
+func newSpendRecord(amt lnwire.MilliSatoshi,
+	at time.Time) [spendRecordSize]byte {
+
+	var record [spendRecordSize]byte
+	binary.BigEndian.PutUint64(record[:8], uint64(at.UnixNano()))
+	binary.BigEndian.PutUint64(record[8:], uint64(amt))
+	return record
+}
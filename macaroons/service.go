@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"strings"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 
 	"gopkg.in/macaroon-bakery.v2/bakery"
 	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
@@ -16,6 +19,8 @@ import (
 	"golang.org/x/net/context"
 
 	"github.com/coreos/bbolt"
+
+	"github.com/lightningnetwork/lnd/lnwire"
 )
 
 var (
@@ -31,6 +36,14 @@ type Service struct {
 	bakery.Bakery
 
 	rks *RootKeyStorage
+
+	// limiter keeps track of the calls made with macaroons carrying a
+	// rate-limit caveat.
+	limiter *rateLimiter
+
+	// unixSocketAuth, if true, authorizes all calls made over Unix domain
+	// sockets without requiring a macaroon.
+	unixSocketAuth bool
 }
 
 // NewService returns a service backed by the macaroon Bolt DB stored in the
@@ -39,7 +52,9 @@ type Service struct {
 // constructor prevents double-registration of checkers to prevent panics, so
 // listing the same checker more than once is not harmful. Default checkers,
 // such as those for `allow`, `time-before`, `declared`, and `error` caveats
-// are registered automatically and don't need to be added.
+// are registered automatically and don't need to be added. The same goes for
+// the checkers of the method, payment and rate limiting caveats, which are
+// enforced by the service itself.
 func NewService(dir string, checks ...Checker) (*Service, error) {
 	// Ensure that the path to the directory exists.
 	if _, err := os.Stat(dir); os.IsNotExist(err) {
@@ -76,6 +91,10 @@ func NewService(dir string, checks ...Checker) (*Service, error) {
 	// Register all custom caveat checkers with the bakery's checker.
 	// TODO(aakselrod): Add more checks as required.
 	checker := svc.Checker.FirstPartyCaveatChecker.(*checkers.Checker)
+	checks = append(
+		checks, MethodsChecker, MaxPaymentChecker, SpendBudgetChecker,
+		RateLimitChecker,
+	)
 	for _, check := range checks {
 		cond, fun := check()
 		if !isRegistered(checker, cond) {
@@ -83,7 +102,35 @@ func NewService(dir string, checks ...Checker) (*Service, error) {
 		}
 	}
 
-	return &Service{*svc, rootKeyStore}, nil
+	return &Service{
+		Bakery:  *svc,
+		rks:     rootKeyStore,
+		limiter: newRateLimiter(),
+	}, nil
+}
+
+// EnableUnixSocketAuth makes the service authorize all calls made over Unix
+// domain sockets without a macaroon, relying on the permissions of the socket
+// files to restrict who is able to connect instead. Calls made over any other
+// network still require a valid macaroon.
+func (svc *Service) EnableUnixSocketAuth() {
+	svc.unixSocketAuth = true
+}
+
+// socketAuthenticated returns true if the call of the passed context was made
+// over a Unix domain socket, and the service authorizes such calls by the
+// permissions of the socket file.
+func (svc *Service) socketAuthenticated(ctx context.Context) bool {
+	if !svc.unixSocketAuth {
+		return false
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return false
+	}
+
+	return strings.HasPrefix(p.Addr.Network(), "unix")
 }
 
 // isRegistered checks to see if the required checker has already been
@@ -118,7 +165,9 @@ func (svc *Service) UnaryServerInterceptor(
 				"required for method", info.FullMethod)
 		}
 
-		err := svc.ValidateMacaroon(ctx, permissionMap[info.FullMethod])
+		err := svc.ValidateMacaroon(
+			ctx, permissionMap[info.FullMethod], info.FullMethod,
+		)
 		if err != nil {
 			return nil, err
 		}
@@ -140,8 +189,10 @@ func (svc *Service) StreamServerInterceptor(
 				"for method", info.FullMethod)
 		}
 
-		err := svc.ValidateMacaroon(ss.Context(),
-			permissionMap[info.FullMethod])
+		err := svc.ValidateMacaroon(
+			ss.Context(), permissionMap[info.FullMethod],
+			info.FullMethod,
+		)
 		if err != nil {
 			return err
 		}
@@ -151,19 +202,106 @@ func (svc *Service) StreamServerInterceptor(
 }
 
 // ValidateMacaroon validates the capabilities of a given request given a
-// bakery service, context, and uri. Within the passed context.Context, we
-// expect a macaroon to be encoded as request metadata using the key
-// "macaroon".
+// bakery service, context, and the full name of the gRPC method being called.
+// Within the passed context.Context, we expect a macaroon to be encoded as
+// request metadata using the key "macaroon". A successfully validated call
+// counts towards the rate limits of the macaroon. If Unix socket auth is
+// enabled, calls made over Unix domain sockets are authorized without one.
 func (svc *Service) ValidateMacaroon(ctx context.Context,
-	requiredPermissions []bakery.Op) error {
+	requiredPermissions []bakery.Op, fullMethod string) error {
+
+	if svc.socketAuthenticated(ctx) {
+		return nil
+	}
+
+	mac, err := macaroonFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	// Check the method being called against the permitted operation and
+	// the expiration time and IP address. The method is passed to the
+	// checkers within the context, for those macaroons that are
+	// restricted to a set of methods.
+	ctx = context.WithValue(ctx, fullMethodContextKey{}, fullMethod)
+	authChecker := svc.Checker.Auth(macaroon.Slice{mac})
+	_, err = authChecker.Allow(ctx, requiredPermissions...)
+	if err != nil {
+		return err
+	}
+
+	// Only once we know the macaroon is valid, we'll count the call
+	// towards its rate limits, such that forged macaroons can't exhaust
+	// them.
+	return svc.limiter.allow(mac, time.Now())
+}
+
+// ValidatePayment checks whether the macaroon within the passed context allows
+// sending a payment of the given amount, which must include the maximum
+// routing fees the payment may incur. If so, the amount is reserved within the
+// spend budgets of the macaroon, which are persisted within the macaroon DB.
+// The caller MUST settle the returned reservation to the amount actually
+// spent once the payment completes, or release it if the payment fails. The
+// macaroon is expected to have been validated through ValidateMacaroon
+// already. Payments made over Unix domain sockets authorized by the socket
+// permissions aren't limited, in which case the reservation is nil.
+func (svc *Service) ValidatePayment(ctx context.Context,
+	amt lnwire.MilliSatoshi) (*SpendReservation, error) {
+
+	if svc.socketAuthenticated(ctx) {
+		return nil, nil
+	}
+
+	mac, err := macaroonFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
 
+	_, maxAmts := firstPartyCaveats(mac, maxPaymentCondition)
+	for _, arg := range maxAmts {
+		maxAmt, err := parseMaxPayment(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		if amt > maxAmt {
+			return nil, fmt.Errorf("payment of %v exceeds maximum "+
+				"payment of %v allowed by macaroon", amt,
+				maxAmt)
+		}
+	}
+
+	budgets, err := spendBudgets(mac)
+	if err != nil {
+		return nil, err
+	}
+	if len(budgets) == 0 {
+		return nil, nil
+	}
+
+	now := time.Now()
+	if err := spend(svc.rks.DB, budgets, amt, now); err != nil {
+		return nil, err
+	}
+
+	return &SpendReservation{
+		db:      svc.rks.DB,
+		budgets: budgets,
+		amt:     amt,
+		at:      now,
+	}, nil
+}
+
+// macaroonFromContext decodes the macaroon encoded as request metadata within
+// the passed context using the key "macaroon".
+func macaroonFromContext(ctx context.Context) (*macaroon.Macaroon, error) {
 	// Get macaroon bytes from context and unmarshal into macaroon.
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return fmt.Errorf("unable to get metadata from context")
+		return nil, fmt.Errorf("unable to get metadata from context")
 	}
 	if len(md["macaroon"]) != 1 {
-		return fmt.Errorf("expected 1 macaroon, got %d",
+		return nil, fmt.Errorf("expected 1 macaroon, got %d",
 			len(md["macaroon"]))
 	}
 
@@ -172,19 +310,41 @@ func (svc *Service) ValidateMacaroon(ctx context.Context,
 	// representation.
 	macBytes, err := hex.DecodeString(md["macaroon"][0])
 	if err != nil {
-		return err
+		return nil, err
 	}
 	mac := &macaroon.Macaroon{}
-	err = mac.UnmarshalBinary(macBytes)
-	if err != nil {
-		return err
+	if err := mac.UnmarshalBinary(macBytes); err != nil {
+		return nil, err
 	}
 
-	// Check the method being called against the permitted operation and
-	// the expiration time and IP address and return the result.
-	authChecker := svc.Checker.Auth(macaroon.Slice{mac})
-	_, err = authChecker.Allow(ctx, requiredPermissions...)
-	return err
+	return mac, nil
+}
+
+// NewMacaroon bakes a new macaroon granting the passed permissions, using the
+// root key with the passed ID. If no root key with the ID exists yet, it's
+// created. A nil ID bakes the macaroon with the default root key.
+func (svc *Service) NewMacaroon(ctx context.Context, rootKeyID []byte,
+	ops ...bakery.Op) (*bakery.Macaroon, error) {
+
+	if rootKeyID != nil {
+		ctx = ContextWithRootKeyID(ctx, rootKeyID)
+	}
+
+	return svc.Oven.NewMacaroon(ctx, bakery.LatestVersion, nil, ops...)
+}
+
+// ListMacaroonIDs returns the IDs of all root keys in the underlying root key
+// store.
+func (svc *Service) ListMacaroonIDs(ctx context.Context) ([][]byte, error) {
+	return svc.rks.ListMacaroonIDs(ctx)
+}
+
+// DeleteMacaroonID removes the root key with the passed ID from the
+// underlying root key store, which revokes all macaroons baked with it.
+func (svc *Service) DeleteMacaroonID(ctx context.Context,
+	rootKeyID []byte) ([]byte, error) {
+
+	return svc.rks.DeleteMacaroonID(ctx, rootKeyID)
 }
 
 // Close closes the database that underlies the RootKeyStore and zeroes the
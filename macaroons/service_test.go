@@ -4,13 +4,16 @@ import (
 	"context"
 	"encoding/hex"
 	"io/ioutil"
+	"net"
 	"os"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/coreos/bbolt"
 	"github.com/lightningnetwork/lnd/macaroons"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"gopkg.in/macaroon-bakery.v2/bakery"
 	"gopkg.in/macaroon-bakery.v2/bakery/checkers"
 )
@@ -20,7 +23,8 @@ var (
 		Entity: "testEntity",
 		Action: "read",
 	}
-	defaultPw = []byte("hello")
+	defaultPw  = []byte("hello")
+	testMethod = "/lnrpc.Lightning/SendPaymentSync"
 )
 
 // setupTestRootKeyStorage creates a dummy root key storage by
@@ -129,8 +133,288 @@ func TestValidateMacaroon(t *testing.T) {
 	mockContext := metadata.NewIncomingContext(context.Background(), md)
 
 	// Finally, validate the macaroon against the required permissions.
-	err = service.ValidateMacaroon(mockContext, []bakery.Op{testOperation})
+	err = service.ValidateMacaroon(
+		mockContext, []bakery.Op{testOperation}, testMethod,
+	)
 	if err != nil {
 		t.Fatalf("Error validating the macaroon: %v", err)
 	}
 }
+
+// TestRevokeMacaroon tests that macaroons baked with a custom root key ID are
+// revoked once the root key is deleted, while macaroons baked with the default
+// root key remain valid.
+func TestRevokeMacaroon(t *testing.T) {
+	tempDir := setupTestRootKeyStorage(t)
+	defer os.RemoveAll(tempDir)
+	service, err := macaroons.NewService(tempDir, macaroons.IPLockChecker)
+	defer service.Close()
+	if err != nil {
+		t.Fatalf("Error creating new service: %v", err)
+	}
+	err = service.CreateUnlock(&defaultPw)
+	if err != nil {
+		t.Fatalf("Error unlocking root key storage: %v", err)
+	}
+
+	// validate checks whether the passed macaroon grants the test
+	// operation.
+	validate := func(mac *bakery.Macaroon) error {
+		macaroonBinary, err := mac.M().MarshalBinary()
+		if err != nil {
+			t.Fatalf("Error serializing macaroon: %v", err)
+		}
+		md := metadata.New(map[string]string{
+			"macaroon": hex.EncodeToString(macaroonBinary),
+		})
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+
+		return service.ValidateMacaroon(
+			ctx, []bakery.Op{testOperation}, testMethod,
+		)
+	}
+
+	ctx := context.Background()
+	defaultMac, err := service.NewMacaroon(ctx, nil, testOperation)
+	if err != nil {
+		t.Fatalf("Error creating macaroon from service: %v", err)
+	}
+	customMac, err := service.NewMacaroon(ctx, []byte("1"), testOperation)
+	if err != nil {
+		t.Fatalf("Error creating macaroon from service: %v", err)
+	}
+
+	if err := validate(defaultMac); err != nil {
+		t.Fatalf("Error validating the macaroon: %v", err)
+	}
+	if err := validate(customMac); err != nil {
+		t.Fatalf("Error validating the macaroon: %v", err)
+	}
+
+	// Both root keys should now be known to the service.
+	ids, err := service.ListMacaroonIDs(ctx)
+	if err != nil {
+		t.Fatalf("Error listing root key IDs: %v", err)
+	}
+	if len(ids) != 2 || string(ids[0]) != "0" || string(ids[1]) != "1" {
+		t.Fatalf("Unexpected root key IDs: %q", ids)
+	}
+
+	// The default root key can't be deleted, as it's used for the
+	// macaroons lnd creates on startup.
+	_, err = service.DeleteMacaroonID(ctx, []byte("0"))
+	if err != macaroons.ErrDeletionForbidden {
+		t.Fatalf("Received %v instead of ErrDeletionForbidden", err)
+	}
+
+	deletedID, err := service.DeleteMacaroonID(ctx, []byte("1"))
+	if err != nil {
+		t.Fatalf("Error deleting root key: %v", err)
+	}
+	if string(deletedID) != "1" {
+		t.Fatalf("Expected root key 1 to be deleted, got %q", deletedID)
+	}
+
+	// With its root key gone, the custom macaroon should no longer be
+	// valid, unlike the default one.
+	if err := validate(customMac); err == nil {
+		t.Fatalf("Expected revoked macaroon to be rejected")
+	}
+	if err := validate(defaultMac); err != nil {
+		t.Fatalf("Error validating the macaroon: %v", err)
+	}
+
+	// Deleting an unknown root key ID isn't an error, but no ID should be
+	// returned.
+	deletedID, err = service.DeleteMacaroonID(ctx, []byte("1"))
+	if err != nil {
+		t.Fatalf("Error deleting root key: %v", err)
+	}
+	if deletedID != nil {
+		t.Fatalf("Expected no root key to be deleted, got %q",
+			deletedID)
+	}
+}
+
+// macaroonContext returns an incoming request context carrying the passed
+// macaroon, after applying the passed constraints to it.
+func macaroonContext(t *testing.T, mac *bakery.Macaroon,
+	cs ...macaroons.Constraint) context.Context {
+
+	constrainedMac, err := macaroons.AddConstraints(mac.M(), cs...)
+	if err != nil {
+		t.Fatalf("Error adding constraints: %v", err)
+	}
+	macaroonBinary, err := constrainedMac.MarshalBinary()
+	if err != nil {
+		t.Fatalf("Error serializing macaroon: %v", err)
+	}
+	md := metadata.New(map[string]string{
+		"macaroon": hex.EncodeToString(macaroonBinary),
+	})
+
+	return metadata.NewIncomingContext(context.Background(), md)
+}
+
+// TestMacaroonCaveats tests that the method, payment amount, spend budget and
+// rate limiting caveats are enforced, and that spend budgets survive a restart
+// of the service.
+func TestMacaroonCaveats(t *testing.T) {
+	tempDir := setupTestRootKeyStorage(t)
+	defer os.RemoveAll(tempDir)
+	service, err := macaroons.NewService(tempDir)
+	if err != nil {
+		t.Fatalf("Error creating new service: %v", err)
+	}
+	err = service.CreateUnlock(&defaultPw)
+	if err != nil {
+		service.Close()
+		t.Fatalf("Error unlocking root key storage: %v", err)
+	}
+
+	mac, err := service.NewMacaroon(
+		context.Background(), nil, testOperation,
+	)
+	if err != nil {
+		service.Close()
+		t.Fatalf("Error creating macaroon from service: %v", err)
+	}
+	ops := []bakery.Op{testOperation}
+
+	// A macaroon restricted to a set of methods should only be valid for
+	// those methods.
+	ctx := macaroonContext(t, mac, macaroons.MethodsConstraint(
+		testMethod, "/lnrpc.Lightning/GetInfo",
+	))
+	if err := service.ValidateMacaroon(ctx, ops, testMethod); err != nil {
+		t.Fatalf("Error validating the macaroon: %v", err)
+	}
+	err = service.ValidateMacaroon(ctx, ops, "/lnrpc.Lightning/SendCoins")
+	if err == nil {
+		t.Fatalf("Expected macaroon to be rejected for method")
+	}
+
+	// A rate limited macaroon should only be valid for the given number
+	// of calls within a minute.
+	ctx = macaroonContext(t, mac, macaroons.RateLimitConstraint(2))
+	for i := 0; i < 2; i++ {
+		err := service.ValidateMacaroon(ctx, ops, testMethod)
+		if err != nil {
+			t.Fatalf("Error validating the macaroon: %v", err)
+		}
+	}
+	if err := service.ValidateMacaroon(ctx, ops, testMethod); err == nil {
+		t.Fatalf("Expected rate limit to be exceeded")
+	}
+
+	// Payments above the maximum amount should be rejected.
+	ctx = macaroonContext(t, mac, macaroons.MaxPaymentConstraint(1000))
+	if _, err := service.ValidatePayment(ctx, 1000); err != nil {
+		t.Fatalf("Error validating payment: %v", err)
+	}
+	if _, err := service.ValidatePayment(ctx, 1001); err == nil {
+		t.Fatalf("Expected payment to exceed maximum amount")
+	}
+
+	// Payments should be counted towards the spend budget, until it's
+	// exhausted.
+	budgetCtx := macaroonContext(
+		t, mac, macaroons.SpendBudgetConstraint(5000, time.Hour),
+	)
+	if _, err := service.ValidatePayment(budgetCtx, 3000); err != nil {
+		t.Fatalf("Error validating payment: %v", err)
+	}
+	if _, err := service.ValidatePayment(budgetCtx, 3000); err == nil {
+		t.Fatalf("Expected payment to exceed spend budget")
+	}
+
+	// A payment that failed should no longer count towards the spend
+	// budget once it's released.
+	reservation, err := service.ValidatePayment(budgetCtx, 1000)
+	if err != nil {
+		t.Fatalf("Error validating payment: %v", err)
+	}
+	if _, err := service.ValidatePayment(budgetCtx, 2000); err == nil {
+		t.Fatalf("Expected payment to exceed spend budget")
+	}
+	if err := reservation.Release(); err != nil {
+		t.Fatalf("Error releasing payment: %v", err)
+	}
+
+	// A payment that settled for less than it reserved, as it paid less
+	// than its fee limit, should only count the amount actually spent.
+	reservation, err = service.ValidatePayment(budgetCtx, 1500)
+	if err != nil {
+		t.Fatalf("Error validating payment: %v", err)
+	}
+	if err := reservation.Settle(1000); err != nil {
+		t.Fatalf("Error settling payment: %v", err)
+	}
+	if _, err := service.ValidatePayment(budgetCtx, 1500); err == nil {
+		t.Fatalf("Expected payment to exceed spend budget")
+	}
+	service.Close()
+
+	// The spend budget should survive a restart of the service.
+	service, err = macaroons.NewService(tempDir)
+	if err != nil {
+		t.Fatalf("Error creating new service: %v", err)
+	}
+	defer service.Close()
+	err = service.CreateUnlock(&defaultPw)
+	if err != nil {
+		t.Fatalf("Error unlocking root key storage: %v", err)
+	}
+
+	if _, err := service.ValidatePayment(budgetCtx, 1500); err == nil {
+		t.Fatalf("Expected payment to exceed spend budget")
+	}
+	if _, err := service.ValidatePayment(budgetCtx, 1000); err != nil {
+		t.Fatalf("Error validating payment: %v", err)
+	}
+}
+
+// TestUnixSocketAuth tests that calls made over Unix domain sockets don't
+// require a macaroon once Unix socket authentication is enabled, while calls
+// made over TCP still do.
+func TestUnixSocketAuth(t *testing.T) {
+	tempDir := setupTestRootKeyStorage(t)
+	defer os.RemoveAll(tempDir)
+	service, err := macaroons.NewService(tempDir, macaroons.IPLockChecker)
+	if err != nil {
+		t.Fatalf("Error creating new service: %v", err)
+	}
+	defer service.Close()
+	err = service.CreateUnlock(&defaultPw)
+	if err != nil {
+		t.Fatalf("Error unlocking root key storage: %v", err)
+	}
+
+	unixCtx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.UnixAddr{Name: "/tmp/lnd.sock", Net: "unix"},
+	})
+	tcpCtx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 10009},
+	})
+	ops := []bakery.Op{testOperation}
+
+	// Without Unix socket authentication, calls without a macaroon are
+	// rejected regardless of the network.
+	err = service.ValidateMacaroon(unixCtx, ops, testMethod)
+	if err == nil {
+		t.Fatalf("Expected call over Unix socket to be rejected")
+	}
+
+	service.EnableUnixSocketAuth()
+	err = service.ValidateMacaroon(unixCtx, ops, testMethod)
+	if err != nil {
+		t.Fatalf("Error validating call over Unix socket: %v", err)
+	}
+	if _, err := service.ValidatePayment(unixCtx, 1000); err != nil {
+		t.Fatalf("Error validating payment over Unix socket: %v", err)
+	}
+	err = service.ValidateMacaroon(tcpCtx, ops, testMethod)
+	if err == nil {
+		t.Fatalf("Expected call over TCP to be rejected")
+	}
+}
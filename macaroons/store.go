@@ -1,6 +1,7 @@
 package macaroons
 
 import (
+	"bytes"
 	"crypto/rand"
 	"fmt"
 	"io"
@@ -22,9 +23,9 @@ var (
 	rootKeyBucketName = []byte("macrootkeys")
 
 	// defaultRootKeyID is the ID of the default root key. The first is
-	// just 0, to emulate the memory storage that comes with bakery.
-	//
-	// TODO(aakselrod): Add support for key rotation.
+	// just 0, to emulate the memory storage that comes with bakery. It's
+	// used whenever no other root key ID is set within the context of a
+	// request for a root key.
 	defaultRootKeyID = []byte("0")
 
 	// encryptedKeyID is the name of the database key that stores the
@@ -42,8 +43,45 @@ var (
 
 	// ErrPasswordRequired specifies that a nil password has been passed.
 	ErrPasswordRequired = fmt.Errorf("a non-nil password is required")
+
+	// ErrInvalidRootKeyID specifies that the passed root key ID is either
+	// empty or clashes with the key the encryption key is stored under.
+	ErrInvalidRootKeyID = fmt.Errorf("invalid root key ID")
+
+	// ErrDeletionForbidden specifies that the deletion of the passed root
+	// key ID is not allowed, as it's the ID of the default root key.
+	ErrDeletionForbidden = fmt.Errorf("the default root key ID cannot " +
+		"be deleted")
 )
 
+// rootKeyIDContextKey is the type of the key under which the ID of the root
+// key to bake a macaroon with is stored within a context.
+type rootKeyIDContextKey struct{}
+
+// ContextWithRootKeyID returns a copy of the passed context, which instructs
+// the RootKeyStorage to use the root key with the passed ID when baking a
+// macaroon. If no root key with the ID exists yet, a new one will be created.
+// Macaroons baked with a root key can be revoked all at once by deleting the
+// root key.
+func ContextWithRootKeyID(ctx context.Context, id []byte) context.Context {
+	return context.WithValue(ctx, rootKeyIDContextKey{}, id)
+}
+
+// RootKeyIDFromContext returns the root key ID stored within the passed
+// context. If there's none, the ID of the default root key is returned.
+func RootKeyIDFromContext(ctx context.Context) []byte {
+	if ctx == nil {
+		return defaultRootKeyID
+	}
+
+	id, ok := ctx.Value(rootKeyIDContextKey{}).([]byte)
+	if !ok {
+		return defaultRootKeyID
+	}
+
+	return id
+}
+
 // RootKeyStorage implements the bakery.RootKeyStorage interface.
 type RootKeyStorage struct {
 	*bolt.DB
@@ -148,14 +186,20 @@ func (r *RootKeyStorage) Get(_ context.Context, id []byte) ([]byte, error) {
 }
 
 // RootKey implements the RootKey method for the bakery.RootKeyStorage
-// interface.
-// TODO(aakselrod): Add support for key rotation.
-func (r *RootKeyStorage) RootKey(_ context.Context) ([]byte, []byte, error) {
+// interface. The root key with the ID stored within the context is returned,
+// or the default root key if the context doesn't carry an ID. If the root key
+// doesn't exist yet, it is created.
+func (r *RootKeyStorage) RootKey(ctx context.Context) ([]byte, []byte, error) {
 	if r.encKey == nil {
 		return nil, nil, ErrStoreLocked
 	}
+
+	id := RootKeyIDFromContext(ctx)
+	if len(id) == 0 || bytes.Equal(id, encryptedKeyID) {
+		return nil, nil, ErrInvalidRootKeyID
+	}
+
 	var rootKey []byte
-	id := defaultRootKeyID
 	err := r.Update(func(tx *bolt.Tx) error {
 		ns := tx.Bucket(rootKeyBucketName)
 		dbKey := ns.Get(id)
@@ -193,6 +237,74 @@ func (r *RootKeyStorage) RootKey(_ context.Context) ([]byte, []byte, error) {
 	return rootKey, id, nil
 }
 
+// ListMacaroonIDs returns the IDs of all root keys within the store.
+func (r *RootKeyStorage) ListMacaroonIDs(_ context.Context) ([][]byte, error) {
+	if r.encKey == nil {
+		return nil, ErrStoreLocked
+	}
+
+	var rootKeyIDs [][]byte
+	err := r.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(rootKeyBucketName)
+		return bucket.ForEach(func(k, _ []byte) error {
+			// The encryption key shares the bucket with the root
+			// keys, so we'll skip it.
+			if bytes.Equal(k, encryptedKeyID) {
+				return nil
+			}
+
+			id := make([]byte, len(k))
+			copy(id, k)
+			rootKeyIDs = append(rootKeyIDs, id)
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return rootKeyIDs, nil
+}
+
+// DeleteMacaroonID removes the root key with the passed ID from the store,
+// revoking all macaroons baked with it. The ID of the deleted root key is
+// returned, or nil if no root key with the ID exists. The default root key
+// can't be deleted.
+func (r *RootKeyStorage) DeleteMacaroonID(_ context.Context,
+	id []byte) ([]byte, error) {
+
+	if r.encKey == nil {
+		return nil, ErrStoreLocked
+	}
+
+	if len(id) == 0 || bytes.Equal(id, encryptedKeyID) {
+		return nil, ErrInvalidRootKeyID
+	}
+	if bytes.Equal(id, defaultRootKeyID) {
+		return nil, ErrDeletionForbidden
+	}
+
+	var deletedID []byte
+	err := r.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(rootKeyBucketName)
+		if bucket.Get(id) == nil {
+			return nil
+		}
+
+		if err := bucket.Delete(id); err != nil {
+			return err
+		}
+
+		deletedID = id
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deletedID, nil
+}
+
 // Close closes the underlying database and zeroes the encryption key stored
 // in memory.
 func (r *RootKeyStorage) Close() error {
@@ -2,6 +2,7 @@ package macaroons_test
 
 import (
 	"bytes"
+	"context"
 	"io/ioutil"
 	"os"
 	"path"
@@ -134,4 +135,30 @@ func TestStore(t *testing.T) {
 		t.Fatalf("Root ID doesn't match: expected %v, got %v",
 			rootID, id)
 	}
+
+	// A root key ID within the context should result in a new root key
+	// being created under that ID.
+	ctx := macaroons.ContextWithRootKeyID(
+		context.Background(), []byte("1"),
+	)
+	key3, id, err := store.RootKey(ctx)
+	if err != nil {
+		t.Fatalf("Error getting root key from store: %v", err)
+	}
+	if !bytes.Equal(id, []byte("1")) {
+		t.Fatalf("Root ID doesn't match: expected %v, got %v",
+			[]byte("1"), id)
+	}
+	if bytes.Equal(key3, key2) {
+		t.Fatalf("Expected a new root key for ID %s", string(id))
+	}
+
+	// The encryption key can't be used as a root key.
+	ctx = macaroons.ContextWithRootKeyID(
+		context.Background(), []byte("enckey"),
+	)
+	_, _, err = store.RootKey(ctx)
+	if err != macaroons.ErrInvalidRootKeyID {
+		t.Fatalf("Received %v instead of ErrInvalidRootKeyID", err)
+	}
 }
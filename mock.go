@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/chaincfg"
@@ -254,6 +255,26 @@ func (*mockWalletController) ListTransactionDetails() ([]*lnwallet.TransactionDe
 }
 func (*mockWalletController) LockOutpoint(o wire.OutPoint)   {}
 func (*mockWalletController) UnlockOutpoint(o wire.OutPoint) {}
+func (*mockWalletController) LeaseOutput(id lnwallet.LockID, op wire.OutPoint,
+	duration time.Duration) (time.Time, error) {
+
+	return time.Now().Add(duration), nil
+}
+func (*mockWalletController) ReleaseOutput(id lnwallet.LockID,
+	op wire.OutPoint) error {
+
+	return nil
+}
+func (*mockWalletController) ListLeasedOutputs() ([]*lnwallet.LeasedOutput,
+	error) {
+
+	return nil, nil
+}
+func (*mockWalletController) ListLeasedUnspentWitness(id lnwallet.LockID,
+	minConfs int32) ([]*lnwallet.Utxo, error) {
+
+	return nil, nil
+}
 func (m *mockWalletController) PublishTransaction(tx *wire.MsgTx) error {
 	m.publishedTransactions <- tx
 	return nil
@@ -264,6 +285,11 @@ func (*mockWalletController) SubscribeTransactions() (lnwallet.TransactionSubscr
 func (*mockWalletController) IsSynced() (bool, int64, error) {
 	return true, int64(0), nil
 }
+func (*mockWalletController) RecoveryProgress() (*lnwallet.RecoveryProgress,
+	error) {
+
+	return &lnwallet.RecoveryProgress{}, nil
+}
 func (*mockWalletController) Start() error {
 	return nil
 }
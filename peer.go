@@ -127,6 +127,13 @@ type peer struct {
 	// objects to queue messages to be sent out on the wire.
 	outgoingQueue chan outgoingMsg
 
+	// traffic counts the messages exchanged with the peer by type.
+	traffic msgTraffic
+
+	// shaper caps the rate at which the low priority messages queued by
+	// the queueHandler are sent to the peer.
+	shaper *trafficShaper
+
 	// activeChannels is a map which stores the state machines of all
 	// active channels. Channels are indexed into the map by the txid of
 	// the funding transaction which opened the channel.
@@ -214,6 +221,9 @@ func newPeer(conn net.Conn, connReq *connmgr.ConnReq, server *server,
 
 		sendQueue:     make(chan outgoingMsg),
 		outgoingQueue: make(chan outgoingMsg),
+		shaper: newTrafficShaper(
+			cfg.PeerGossipRateLimit, cfg.PeerProbeRateLimit,
+		),
 
 		activeChannels: make(map[lnwire.ChannelID]*lnwallet.LightningChannel),
 		newChannels:    make(chan *newChannelMsg, 1),
@@ -337,11 +347,9 @@ func (p *peer) initGossipSync() {
 			p.pubKeyBytes[:])
 
 		// We'll only request channel updates from the remote peer if
-		// its enabled in the config, or we're already getting updates
-		// from enough peers.
-		//
-		// TODO(roasbeef): craft s.t. we only get updates from a few
-		// peers
+		// its enabled in the config. The gossiper's sync manager will
+		// further ensure that we only receive them from a small set of
+		// peers at a time.
 		recvUpdates := !cfg.NoChanUpdates
 
 		// Register the this peer's for gossip syncer with the gossiper.
@@ -570,6 +578,7 @@ func (p *peer) addLink(chanPoint *wire.OutPoint,
 		UnsafeReplay:        cfg.UnsafeReplay,
 		MinFeeUpdateTimeout: htlcswitch.DefaultMinLinkFeeUpdateTimeout,
 		MaxFeeUpdateTimeout: htlcswitch.DefaultMaxLinkFeeUpdateTimeout,
+		HtlcNotifier:        p.server.htlcNotifier,
 	}
 
 	link := htlcswitch.NewChannelLink(linkCfg, lnChan)
@@ -624,6 +633,17 @@ func (p *peer) String() string {
 	return p.conn.RemoteAddr().String()
 }
 
+// ConnStats returns a snapshot of the counters of the encrypted connection to
+// the peer, or nil if the peer isn't connected over brontide.
+func (p *peer) ConnStats() *brontide.ConnStats {
+	noiseConn, ok := p.conn.(*brontide.Conn)
+	if !ok {
+		return nil
+	}
+
+	return noiseConn.Stats()
+}
+
 // readNextMessage reads, and returns the next message on the wire along with
 // any additional raw payload.
 func (p *peer) readNextMessage() (lnwire.Message, error) {
@@ -650,6 +670,7 @@ func (p *peer) readNextMessage() (lnwire.Message, error) {
 		return nil, err
 	}
 
+	p.traffic.recordRecv(nextMsg.MsgType(), len(rawMsg))
 	p.logWireMessage(nextMsg, true)
 
 	return nextMsg, nil
@@ -1357,8 +1378,14 @@ func (p *peer) writeMessage(msg lnwire.Message) error {
 	p.conn.SetWriteDeadline(time.Now().Add(writeMessageTimeout))
 
 	// Finally, write the message itself in a single swoop.
-	_, err = p.conn.Write(b.Bytes())
-	return err
+	if _, err := p.conn.Write(b.Bytes()); err != nil {
+		return err
+	}
+
+	p.traffic.recordSent(msg.MsgType(), n)
+	p.shaper.charge(classifyMsg(msg.MsgType()), n, time.Now())
+
+	return nil
 }
 
 // writeHandler is a goroutine dedicated to reading messages off of an incoming
@@ -1418,42 +1445,96 @@ out:
 }
 
 // queueHandler is responsible for accepting messages from outside subsystems
-// to be eventually sent out on the wire by the writeHandler.
+// to be eventually sent out on the wire by the writeHandler. Pending messages
+// are handed to the writeHandler by priority class: channel state messages
+// always preempt gossip, which in turn preempts Spider probe traffic. Within a
+// class, messages are sent in the order they were queued. The low priority
+// classes are additionally held back while they exceed their rate cap.
 //
 // NOTE: This method MUST be run as a goroutine.
 func (p *peer) queueHandler() {
 	defer p.wg.Done()
 
-	// pendingMsgs will hold all messages waiting to be added
-	// to the sendQueue.
-	pendingMsgs := list.New()
+	// pendingMsgs will hold all messages waiting to be added to the
+	// sendQueue, by priority class.
+	var pendingMsgs [numMsgClasses]*list.List
+	for i := range pendingMsgs {
+		pendingMsgs[i] = list.New()
+	}
+
+	// shapingTimer wakes us up once a class that is held back by its rate
+	// cap may be sent again.
+	shapingTimer := time.NewTimer(0)
+	if !shapingTimer.Stop() {
+		<-shapingTimer.C
+	}
+	defer shapingTimer.Stop()
 
 	for {
-		// Examine the front of the queue.
-		elem := pendingMsgs.Front()
-		if elem != nil {
-			// There's an element on the queue, try adding
-			// it to the sendQueue. We also watch for
-			// messages on the outgoingQueue, in case the
-			// writeHandler cannot accept messages on the
-			// sendQueue.
-			select {
-			case p.sendQueue <- elem.Value.(outgoingMsg):
-				pendingMsgs.Remove(elem)
-			case msg := <-p.outgoingQueue:
-				pendingMsgs.PushBack(msg)
-			case <-p.quit:
-				return
+		// Find the front of the highest priority class that has
+		// messages pending and isn't held back by its rate cap.
+		var (
+			next      *list.Element
+			nextClass msgClass
+			minDelay  time.Duration
+		)
+		now := time.Now()
+		for class := msgClassChannel; class < numMsgClasses; class++ {
+			elem := pendingMsgs[class].Front()
+			if elem == nil {
+				continue
 			}
-		} else {
-			// If there weren't any messages to send to the
-			// writeHandler, then we'll accept a new message
-			// into the queue from outside sub-systems.
+
+			delay := p.shaper.delay(class, now)
+			if delay > 0 {
+				if minDelay == 0 || delay < minDelay {
+					minDelay = delay
+				}
+				continue
+			}
+
+			next, nextClass = elem, class
+			break
+		}
+
+		// If there's a message to send, we'll offer it to the
+		// writeHandler, otherwise the sendQueue is left nil so we'll
+		// only accept new messages from outside sub-systems. If all
+		// pending messages are held back, we'll wait for the earliest
+		// class to be allowed again.
+		var (
+			sendQueue chan outgoingMsg
+			nextMsg   outgoingMsg
+			wakeup    <-chan time.Time
+		)
+		if next != nil {
+			sendQueue = p.sendQueue
+			nextMsg = next.Value.(outgoingMsg)
+		} else if minDelay > 0 {
+			shapingTimer.Reset(minDelay)
+			wakeup = shapingTimer.C
+		}
+
+		select {
+		case sendQueue <- nextMsg:
+			pendingMsgs[nextClass].Remove(next)
+
+		case msg := <-p.outgoingQueue:
+			class := classifyMsg(msg.msg.MsgType())
+			pendingMsgs[class].PushBack(msg)
+
+		case <-wakeup:
+
+		case <-p.quit:
+			return
+		}
+
+		// Stop the timer if it didn't fire, so it can be reset on the
+		// next iteration.
+		if wakeup != nil && !shapingTimer.Stop() {
 			select {
-			case msg := <-p.outgoingQueue:
-				pendingMsgs.PushBack(msg)
-			case <-p.quit:
-				return
+			case <-shapingTimer.C:
+			default:
 			}
 		}
 	}
@@ -1504,6 +1585,12 @@ func (p *peer) queueMsg(msg lnwire.Message, errChan chan error) {
 	}
 }
 
+// MsgTraffic returns the counters of the messages exchanged with the peer,
+// for each message type, ordered by message type.
+func (p *peer) MsgTraffic() []msgTypeTraffic {
+	return p.traffic.snapshot()
+}
+
 // ChannelSnapshots returns a slice of channel snapshots detailing all
 // currently active channels maintained with the remote peer.
 func (p *peer) ChannelSnapshots() []*channeldb.ChannelSnapshot {
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"net"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+// addrLatencyScale is the connection latency at which the score of an address
+// is halved. It's large enough for addresses reached over Tor to still be
+// preferred over ones we've never attempted to connect to.
+const addrLatencyScale = 10 * time.Second
+
+// scorePeerAddr scores the address of a peer based on the outcome of our past
+// connection attempts to it, with higher scores denoting more reliable
+// addresses. Addresses with no recorded attempts score 0.5.
+func scorePeerAddr(stats *channeldb.PeerAddrStats) float64 {
+	if stats == nil {
+		return 0.5
+	}
+
+	// We'll estimate the probability of a connection attempt succeeding
+	// with a prior of a single success and failure, such that addresses
+	// with few attempts aren't scored too aggressively.
+	successProb := float64(stats.Successes+1) /
+		float64(stats.Successes+stats.Failures+2)
+
+	// Slow addresses are then penalized according to the average latency
+	// of the connections made to them.
+	latencyFactor := 1 / (1 + float64(stats.Latency)/
+		float64(addrLatencyScale))
+
+	return successProb * latencyFactor
+}
+
+// selectPeerAddr returns the candidate address with the highest score within
+// the address book of the peer, or nil if there are no candidates. Ties are
+// broken in favor of the address seen the most recently, and then by the order
+// of the candidates.
+func selectPeerAddr(candidates []net.Addr,
+	book *channeldb.PeerAddrBook) net.Addr {
+
+	addrStats := make(map[string]*channeldb.PeerAddrStats)
+	if book != nil {
+		for _, stats := range book.Addrs {
+			addrStats[stats.Address.String()] = stats
+		}
+	}
+
+	var (
+		bestAddr     net.Addr
+		bestScore    float64
+		bestLastSeen time.Time
+	)
+	for _, addr := range candidates {
+		stats := addrStats[addr.String()]
+		score := scorePeerAddr(stats)
+
+		var lastSeen time.Time
+		if stats != nil {
+			lastSeen = stats.LastSeen
+		}
+
+		switch {
+		case bestAddr == nil:
+		case score > bestScore:
+		case score == bestScore && lastSeen.After(bestLastSeen):
+		default:
+			continue
+		}
+
+		bestAddr = addr
+		bestScore = score
+		bestLastSeen = lastSeen
+	}
+
+	return bestAddr
+}
@@ -0,0 +1,103 @@
+// +build !rpctest
+
+package main
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/channeldb"
+)
+
+// TestScorePeerAddr ensures that addresses are scored higher the more reliable
+// and the faster connections made to them are.
+func TestScorePeerAddr(t *testing.T) {
+	t.Parallel()
+
+	unknown := scorePeerAddr(nil)
+
+	reliable := scorePeerAddr(&channeldb.PeerAddrStats{
+		Successes: 10,
+		Latency:   100 * time.Millisecond,
+	})
+	if reliable <= unknown {
+		t.Fatalf("expected reliable address to score higher than an "+
+			"unknown one: %v vs %v", reliable, unknown)
+	}
+
+	// An address reached over Tor should still be preferred over one we
+	// never connected to.
+	slow := scorePeerAddr(&channeldb.PeerAddrStats{
+		Successes: 10,
+		Latency:   2 * time.Second,
+	})
+	if slow >= reliable || slow <= unknown {
+		t.Fatalf("expected slow address to score between %v and %v, "+
+			"got %v", unknown, reliable, slow)
+	}
+
+	failing := scorePeerAddr(&channeldb.PeerAddrStats{
+		Successes: 1,
+		Failures:  10,
+	})
+	if failing >= unknown {
+		t.Fatalf("expected failing address to score lower than an "+
+			"unknown one: %v vs %v", failing, unknown)
+	}
+}
+
+// TestSelectPeerAddr ensures that the candidate address with the highest score
+// is selected, with ties broken by the most recently seen address.
+func TestSelectPeerAddr(t *testing.T) {
+	t.Parallel()
+
+	var (
+		addr1 = &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 9735}
+		addr2 = &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 9735}
+		addr3 = &net.TCPAddr{IP: net.ParseIP("10.0.0.3"), Port: 9735}
+		now   = time.Now()
+	)
+
+	if addr := selectPeerAddr(nil, nil); addr != nil {
+		t.Fatalf("expected no address, got %v", addr)
+	}
+
+	// Without an address book, the first candidate is selected.
+	candidates := []net.Addr{addr1, addr2, addr3}
+	if addr := selectPeerAddr(candidates, nil); addr != addr1 {
+		t.Fatalf("expected %v, got %v", addr1, addr)
+	}
+
+	book := &channeldb.PeerAddrBook{
+		Addrs: []*channeldb.PeerAddrStats{
+			{
+				Address:  addr1,
+				Failures: 5,
+			},
+			{
+				Address:   addr2,
+				Successes: 3,
+				LastSeen:  now.Add(-time.Hour),
+			},
+			{
+				Address:   addr3,
+				Successes: 3,
+				LastSeen:  now,
+			},
+		},
+	}
+
+	// The second and third addresses score the same, so the one seen most
+	// recently should be selected.
+	if addr := selectPeerAddr(candidates, book); addr != addr3 {
+		t.Fatalf("expected %v, got %v", addr3, addr)
+	}
+
+	// Only candidates may be selected, even if the address book knows of
+	// better addresses.
+	candidates = []net.Addr{addr1}
+	if addr := selectPeerAddr(candidates, book); addr != addr1 {
+		t.Fatalf("expected %v, got %v", addr1, addr)
+	}
+}
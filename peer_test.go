@@ -601,3 +601,84 @@ func TestPeerChannelClosureFeeNegotiationsInitiator(t *testing.T) {
 		t.Fatalf("closing tx not broadcast")
 	}
 }
+
+// TestPeerQueueHandler ensures that the queueHandler hands pending messages to
+// the writeHandler in order of their priority class, and holds back the
+// classes whose rate cap has been exceeded.
+func TestPeerQueueHandler(t *testing.T) {
+	t.Parallel()
+
+	const probeRate = 1000
+	p := &peer{
+		sendQueue:     make(chan outgoingMsg),
+		outgoingQueue: make(chan outgoingMsg),
+		shaper:        newTrafficShaper(0, probeRate),
+		quit:          make(chan struct{}),
+	}
+	p.wg.Add(1)
+	go p.queueHandler()
+	defer func() {
+		close(p.quit)
+		p.wg.Wait()
+	}()
+
+	probeMsg := &lnwire.UpdatePriceProbe{}
+	gossipMsg := &lnwire.ChannelUpdate{}
+	channelMsg := lnwire.NewPing(16)
+
+	// nextMsg returns the next message offered by the queueHandler, or
+	// nil if none is offered within the timeout.
+	nextMsg := func(timeout time.Duration) lnwire.Message {
+		select {
+		case msg := <-p.sendQueue:
+			return msg.msg
+		case <-time.After(timeout):
+			return nil
+		}
+	}
+
+	// We'll queue the messages in reverse order of their priority. As
+	// nothing is reading from the sendQueue yet, all of them will be
+	// pending once queued.
+	p.queueMsg(probeMsg, nil)
+	p.queueMsg(gossipMsg, nil)
+	p.queueMsg(channelMsg, nil)
+
+	expected := []lnwire.Message{channelMsg, gossipMsg, probeMsg}
+	for i, expectedMsg := range expected {
+		msg := nextMsg(time.Second)
+		if msg != expectedMsg {
+			t.Fatalf("expected message #%d to be %T, got %T", i,
+				expectedMsg, msg)
+		}
+	}
+
+	// Next, we'll exceed the rate cap of the probe class, such that it's
+	// held back for about a second, while the uncapped classes can still
+	// be sent right away.
+	p.shaper.charge(
+		msgClassProbe, lnwire.MaxMessagePayload+probeRate, time.Now(),
+	)
+	p.queueMsg(probeMsg, nil)
+	p.queueMsg(gossipMsg, nil)
+
+	if msg := nextMsg(time.Second); msg != gossipMsg {
+		t.Fatalf("expected gossip message, got %T", msg)
+	}
+	if msg := nextMsg(100 * time.Millisecond); msg != nil {
+		t.Fatalf("expected probe message to be held back, got %T",
+			msg)
+	}
+
+	p.queueMsg(channelMsg, nil)
+	if msg := nextMsg(time.Second); msg != channelMsg {
+		t.Fatalf("expected channel message, got %T", msg)
+	}
+
+	// Once the rate of the probe class has refilled, the probe message
+	// should be sent.
+	if msg := nextMsg(3 * time.Second); msg != probeMsg {
+		t.Fatalf("expected probe message once the rate refilled, "+
+			"got %T", msg)
+	}
+}
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+	"golang.org/x/time/rate"
+)
+
+// msgClass is the priority class of an outgoing message. Messages of a class
+// are only sent once no messages of a higher priority class are pending.
+type msgClass uint8
+
+const (
+	// msgClassChannel contains the messages which advance the state of
+	// our channels with the peer, along with connection control messages
+	// such as pings and errors. It has the highest priority.
+	msgClassChannel msgClass = iota
+
+	// msgClassGossip contains the channel graph gossip and gossip query
+	// messages.
+	msgClassGossip
+
+	// msgClassProbe contains the Spider probe messages, which are sent at
+	// a high frequency to learn the balances and prices along routes. It
+	// has the lowest priority.
+	msgClassProbe
+
+	// numMsgClasses is the number of message classes.
+	numMsgClasses
+)
+
+// String returns a human readable name of the message class.
+func (c msgClass) String() string {
+	switch c {
+	case msgClassChannel:
+		return "channel"
+	case msgClassGossip:
+		return "gossip"
+	case msgClassProbe:
+		return "probe"
+	default:
+		return "<unknown>"
+	}
+}
+
+// classifyMsg returns the priority class of messages of the passed type.
+func classifyMsg(msgType lnwire.MessageType) msgClass {
+	switch msgType {
+	case lnwire.MsgChannelAnnouncement,
+		lnwire.MsgNodeAnnouncement,
+		lnwire.MsgChannelUpdate,
+		lnwire.MsgAnnounceSignatures,
+		lnwire.MsgQueryShortChanIDs,
+		lnwire.MsgReplyShortChanIDsEnd,
+		lnwire.MsgQueryChannelRange,
+		lnwire.MsgReplyChannelRange,
+		lnwire.MsgGossipTimestampRange:
+
+		return msgClassGossip
+
+	case lnwire.MsgProbeRouteChannelBalances,
+		lnwire.MsgUpdatePriceProbe,
+		lnwire.MsgProbeRouteChannelPrices:
+
+		return msgClassProbe
+
+	default:
+		return msgClassChannel
+	}
+}
+
+// msgTypeTraffic counts the messages of a single type exchanged with a peer.
+type msgTypeTraffic struct {
+	msgType   lnwire.MessageType
+	msgsSent  uint64
+	bytesSent uint64
+	msgsRecv  uint64
+	bytesRecv uint64
+}
+
+// msgTraffic tracks the traffic exchanged with a peer for each message type.
+// The zero value is ready to be used.
+type msgTraffic struct {
+	mtx    sync.Mutex
+	byType map[lnwire.MessageType]*msgTypeTraffic
+}
+
+// counter returns the counters of the passed message type, creating them if
+// they don't exist yet. The mutex MUST be held.
+func (t *msgTraffic) counter(msgType lnwire.MessageType) *msgTypeTraffic {
+	if t.byType == nil {
+		t.byType = make(map[lnwire.MessageType]*msgTypeTraffic)
+	}
+
+	counter, ok := t.byType[msgType]
+	if !ok {
+		counter = &msgTypeTraffic{msgType: msgType}
+		t.byType[msgType] = counter
+	}
+
+	return counter
+}
+
+// recordSent accounts for a message of numBytes sent to the peer.
+func (t *msgTraffic) recordSent(msgType lnwire.MessageType, numBytes int) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	counter := t.counter(msgType)
+	counter.msgsSent++
+	counter.bytesSent += uint64(numBytes)
+}
+
+// recordRecv accounts for a message of numBytes received from the peer.
+func (t *msgTraffic) recordRecv(msgType lnwire.MessageType, numBytes int) {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	counter := t.counter(msgType)
+	counter.msgsRecv++
+	counter.bytesRecv += uint64(numBytes)
+}
+
+// snapshot returns a copy of the counters of all message types exchanged with
+// the peer, ordered by message type.
+func (t *msgTraffic) snapshot() []msgTypeTraffic {
+	t.mtx.Lock()
+	defer t.mtx.Unlock()
+
+	counters := make([]msgTypeTraffic, 0, len(t.byType))
+	for _, counter := range t.byType {
+		counters = append(counters, *counter)
+	}
+	sort.Slice(counters, func(i, j int) bool {
+		return counters[i].msgType < counters[j].msgType
+	})
+
+	return counters
+}
+
+// trafficShaper caps the rate in bytes per second at which the messages of
+// the low priority classes are sent to a peer. Messages are charged once they
+// have been written, after which the class is held back until the rate allows
+// for the bytes written.
+type trafficShaper struct {
+	limiters [numMsgClasses]*rate.Limiter
+}
+
+// newTrafficShaper creates a trafficShaper which caps gossip and Spider probe
+// traffic at the passed rates in bytes per second. A rate of zero leaves the
+// class uncapped.
+func newTrafficShaper(gossipRate, probeRate uint64) *trafficShaper {
+	newLimiter := func(bytesPerSec uint64) *rate.Limiter {
+		if bytesPerSec == 0 {
+			return nil
+		}
+
+		// The burst must allow the largest possible message to be
+		// charged at once.
+		return rate.NewLimiter(
+			rate.Limit(bytesPerSec), lnwire.MaxMessagePayload,
+		)
+	}
+
+	var s trafficShaper
+	s.limiters[msgClassGossip] = newLimiter(gossipRate)
+	s.limiters[msgClassProbe] = newLimiter(probeRate)
+
+	return &s
+}
+
+// charge accounts for numBytes of the class having been written at the passed
+// time.
+func (s *trafficShaper) charge(class msgClass, numBytes int, now time.Time) {
+	if s == nil || s.limiters[class] == nil {
+		return
+	}
+
+	// The reservation is never cancelled, which puts the limiter in debt
+	// until the rate allows for the bytes written.
+	s.limiters[class].ReserveN(now, numBytes)
+}
+
+// delay returns how long messages of the class must be held back at the
+// passed time, or zero if they can be sent right away.
+func (s *trafficShaper) delay(class msgClass, now time.Time) time.Duration {
+	if s == nil || s.limiters[class] == nil {
+		return 0
+	}
+
+	// Peek at the delay of sending a single byte without consuming it.
+	r := s.limiters[class].ReserveN(now, 1)
+	delay := r.DelayFrom(now)
+	r.CancelAt(now)
+
+	return delay
+}
@@ -0,0 +1,115 @@
+// +build !rpctest
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestMsgTraffic ensures that the traffic exchanged with a peer is counted
+// separately for each message type, and reported ordered by type.
+func TestMsgTraffic(t *testing.T) {
+	t.Parallel()
+
+	var traffic msgTraffic
+	traffic.recordSent(lnwire.MsgUpdatePriceProbe, 100)
+	traffic.recordSent(lnwire.MsgUpdatePriceProbe, 50)
+	traffic.recordRecv(lnwire.MsgUpdatePriceProbe, 70)
+	traffic.recordRecv(lnwire.MsgCommitSig, 200)
+
+	counters := traffic.snapshot()
+	if len(counters) != 2 {
+		t.Fatalf("expected 2 message types, got %v", len(counters))
+	}
+
+	commitSig := counters[0]
+	if commitSig.msgType != lnwire.MsgCommitSig ||
+		commitSig.msgsRecv != 1 || commitSig.bytesRecv != 200 ||
+		commitSig.msgsSent != 0 {
+
+		t.Fatalf("unexpected CommitSig traffic: %+v", commitSig)
+	}
+
+	probe := counters[1]
+	if probe.msgType != lnwire.MsgUpdatePriceProbe ||
+		probe.msgsSent != 2 || probe.bytesSent != 150 ||
+		probe.msgsRecv != 1 || probe.bytesRecv != 70 {
+
+		t.Fatalf("unexpected UpdatePriceProbe traffic: %+v", probe)
+	}
+}
+
+// TestClassifyMsg ensures that channel state messages are given precedence
+// over gossip and Spider probe messages.
+func TestClassifyMsg(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		msgType lnwire.MessageType
+		class   msgClass
+	}{
+		{lnwire.MsgUpdateAddHTLC, msgClassChannel},
+		{lnwire.MsgCommitSig, msgClassChannel},
+		{lnwire.MsgRevokeAndAck, msgClassChannel},
+		{lnwire.MsgPing, msgClassChannel},
+		{lnwire.MsgChannelUpdate, msgClassGossip},
+		{lnwire.MsgReplyChannelRange, msgClassGossip},
+		{lnwire.MsgUpdatePriceProbe, msgClassProbe},
+		{lnwire.MsgProbeRouteChannelBalances, msgClassProbe},
+	}
+	for _, test := range tests {
+		class := classifyMsg(test.msgType)
+		if class != test.class {
+			t.Fatalf("expected %v to be in class %v, got %v",
+				test.msgType, test.class, class)
+		}
+	}
+}
+
+// TestTrafficShaper ensures that a capped class is held back once the bytes
+// written exceed its rate, while uncapped classes are never held back.
+func TestTrafficShaper(t *testing.T) {
+	t.Parallel()
+
+	const probeRate = 1000
+	shaper := newTrafficShaper(0, probeRate)
+	now := time.Now()
+
+	// Initially, the full burst is available, so a large message can be
+	// sent right away.
+	if delay := shaper.delay(msgClassProbe, now); delay != 0 {
+		t.Fatalf("expected no delay, got %v", delay)
+	}
+	shaper.charge(msgClassProbe, lnwire.MaxMessagePayload, now)
+
+	// Once it has been charged, the class is in debt until the rate has
+	// refilled a single byte.
+	shaper.charge(msgClassProbe, probeRate, now)
+	delay := shaper.delay(msgClassProbe, now)
+	if delay < time.Second || delay > 2*time.Second {
+		t.Fatalf("expected delay of about a second, got %v", delay)
+	}
+
+	// Peeking at the delay shouldn't consume any of the rate.
+	if shaper.delay(msgClassProbe, now) != delay {
+		t.Fatalf("expected delay to be unchanged")
+	}
+	refilled := now.Add(delay + time.Millisecond)
+	if shaper.delay(msgClassProbe, refilled) != 0 {
+		t.Fatalf("expected no delay once the rate refilled")
+	}
+
+	// Neither the uncapped gossip class nor the channel class are ever
+	// held back.
+	shaper.charge(msgClassGossip, lnwire.MaxMessagePayload, now)
+	shaper.charge(msgClassGossip, lnwire.MaxMessagePayload, now)
+	if delay := shaper.delay(msgClassGossip, now); delay != 0 {
+		t.Fatalf("expected no gossip delay, got %v", delay)
+	}
+	if delay := shaper.delay(msgClassChannel, now); delay != 0 {
+		t.Fatalf("expected no channel delay, got %v", delay)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"sort"
+	"sync"
 
 	"github.com/btcsuite/btcd/btcec"
 	"github.com/btcsuite/btcd/wire"
@@ -79,26 +81,98 @@ func (c *chanController) SpliceOut(chanPoint *wire.OutPoint,
 // autopilot.ChannelController interface.
 var _ autopilot.ChannelController = (*chanController)(nil)
 
+// newAttachmentHeuristic creates the attachment heuristic selected by the
+// passed auto pilot configuration. The external scorer backs the externalscore
+// heuristic and weight.
+func newAttachmentHeuristic(svr *server, cfg *autoPilotConfig,
+	externalScorer *autopilot.ExternalScorer) (
+	autopilot.AttachmentHeuristic, error) {
+
+	minChanSize := btcutil.Amount(cfg.MinChannelSize)
+	maxChanSize := btcutil.Amount(cfg.MaxChannelSize)
+	chanLimit := uint16(cfg.MaxChannels)
+
+	if cfg.Heuristic == "prefattach" {
+		return autopilot.NewConstrainedPrefAttachment(
+			minChanSize, maxChanSize, chanLimit, cfg.Allocation,
+		), nil
+	}
+
+	// The remaining heuristics pick the nodes rated highest by one, or a
+	// combination of scorers.
+	spiderPathUsage := func() map[autopilot.NodeID]btcutil.Amount {
+		usage := svr.chanRouter.SpiderPathUsage()
+
+		nodeUsage := make(
+			map[autopilot.NodeID]btcutil.Amount, len(usage),
+		)
+		for vertex, amt := range usage {
+			nodeUsage[autopilot.NodeID(vertex)] = amt.ToSatoshis()
+		}
+
+		return nodeUsage
+	}
+	scorers := map[string]autopilot.NodeScorer{
+		"degree":        autopilot.NewDegreeScorer(),
+		"betweenness":   autopilot.NewBetweennessCentrality(),
+		"spider":        autopilot.NewSpiderPathScorer(spiderPathUsage),
+		"externalscore": externalScorer,
+	}
+
+	var scorer autopilot.NodeScorer
+	switch cfg.Heuristic {
+	case "betweenness", "spider", "externalscore":
+		scorer = scorers[cfg.Heuristic]
+
+	case "weighted":
+		names := make([]string, 0, len(cfg.Weight))
+		for name := range cfg.Weight {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		var weighted []autopilot.WeightedScorer
+		for _, name := range names {
+			weighted = append(weighted, autopilot.WeightedScorer{
+				Scorer: scorers[name],
+				Weight: cfg.Weight[name],
+			})
+		}
+
+		var err error
+		scorer, err = autopilot.NewWeightedCombScorer(weighted...)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown autopilot heuristic: %v",
+			cfg.Heuristic)
+	}
+
+	atplLog.Infof("Using autopilot heuristic %v", scorer.Name())
+
+	return autopilot.NewScoredAttachment(
+		minChanSize, maxChanSize, chanLimit, cfg.Allocation, scorer,
+	), nil
+}
+
 // initAutoPilot initializes a new autopilot.Agent instance based on the passed
-// configuration struct. All interfaces needed to drive the pilot will be
-// registered and launched.
-func initAutoPilot(svr *server, cfg *autoPilotConfig) (*autopilot.Agent, error) {
-	atplLog.Infof("Instantiating autopilot with cfg: %v", spew.Sdump(cfg))
+// configuration struct and attachment heuristic. All interfaces needed to
+// drive the pilot will be registered and launched, until either the server or
+// the passed quit channel is shut down.
+func initAutoPilot(svr *server, cfg *autoPilotConfig,
+	heuristic autopilot.AttachmentHeuristic,
+	quit chan struct{}) (*autopilot.Agent, error) {
 
-	// First, we'll create the preferential attachment heuristic,
-	// initialized with the passed auto pilot configuration parameters.
-	prefAttachment := autopilot.NewConstrainedPrefAttachment(
-		btcutil.Amount(cfg.MinChannelSize),
-		btcutil.Amount(cfg.MaxChannelSize),
-		uint16(cfg.MaxChannels), cfg.Allocation,
-	)
+	atplLog.Infof("Instantiating autopilot with cfg: %v", spew.Sdump(cfg))
 
-	// With the heuristic itself created, we can now populate the remainder
-	// of the items that the autopilot agent needs to perform its duties.
+	// We'll populate the items that the autopilot agent needs to perform
+	// its duties, using the passed heuristic.
 	self := svr.identityPriv.PubKey()
 	pilotCfg := autopilot.Config{
 		Self:      self,
-		Heuristic: prefAttachment,
+		Heuristic: heuristic,
 		ChanController: &chanController{
 			server:   svr,
 			private:  cfg.Private,
@@ -216,6 +290,8 @@ func initAutoPilot(svr *server, cfg *autoPilotConfig) (*autopilot.Agent, error)
 				pilot.OnBalanceChange()
 			case <-svr.quit:
 				return
+			case <-quit:
+				return
 			}
 		}
 
@@ -233,6 +309,8 @@ func initAutoPilot(svr *server, cfg *autoPilotConfig) (*autopilot.Agent, error)
 			case <-txnSubscription.UnconfirmedTransactions():
 			case <-svr.quit:
 				return
+			case <-quit:
+				return
 			}
 		}
 
@@ -303,9 +381,150 @@ func initAutoPilot(svr *server, cfg *autoPilotConfig) (*autopilot.Agent, error)
 
 			case <-svr.quit:
 				return
+			case <-quit:
+				return
 			}
 		}
 	}()
 
 	return pilot, nil
 }
+
+// autopilotManager owns the autopilot agent, and allows it to be enabled and
+// disabled at runtime. As an agent can't be restarted once stopped, a fresh
+// one is created each time the autopilot is enabled. The attachment heuristic
+// is shared among them, so external scores survive a restart of the agent.
+type autopilotManager struct {
+	svr *server
+	cfg *autoPilotConfig
+
+	heuristic      autopilot.AttachmentHeuristic
+	externalScorer *autopilot.ExternalScorer
+
+	mtx       sync.Mutex
+	pilot     *autopilot.Agent
+	pilotQuit chan struct{}
+}
+
+// newAutopilotManager creates a new autopilotManager for the passed server
+// and configuration. The agent isn't started until Start is called.
+func newAutopilotManager(svr *server,
+	cfg *autoPilotConfig) (*autopilotManager, error) {
+
+	externalScorer := autopilot.NewExternalScorer()
+	heuristic, err := newAttachmentHeuristic(svr, cfg, externalScorer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &autopilotManager{
+		svr:            svr,
+		cfg:            cfg,
+		heuristic:      heuristic,
+		externalScorer: externalScorer,
+	}, nil
+}
+
+// Start creates and starts a new autopilot agent, if none is active yet.
+func (m *autopilotManager) Start() error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.pilot != nil {
+		return nil
+	}
+
+	quit := make(chan struct{})
+	pilot, err := initAutoPilot(m.svr, m.cfg, m.heuristic, quit)
+	if err != nil {
+		close(quit)
+		return err
+	}
+	if err := pilot.Start(); err != nil {
+		close(quit)
+		return err
+	}
+
+	m.pilot = pilot
+	m.pilotQuit = quit
+
+	return nil
+}
+
+// Stop stops the active autopilot agent, if any, along with the goroutines
+// feeding it with updates.
+func (m *autopilotManager) Stop() error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if m.pilot == nil {
+		return nil
+	}
+
+	close(m.pilotQuit)
+	err := m.pilot.Stop()
+
+	m.pilot = nil
+	m.pilotQuit = nil
+
+	return err
+}
+
+// IsActive returns true if an autopilot agent is currently running.
+func (m *autopilotManager) IsActive() bool {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	return m.pilot != nil
+}
+
+// Status returns the status of the active autopilot agent, along with the
+// attachment directives it currently proposes.
+func (m *autopilotManager) Status() (*autopilot.Status, error) {
+	m.mtx.Lock()
+	pilot := m.pilot
+	m.mtx.Unlock()
+
+	if pilot == nil {
+		return nil, autopilot.ErrAgentNotActive
+	}
+
+	return pilot.Status()
+}
+
+// UsesExternalScores returns true if the configured heuristic takes the
+// external scores into account.
+func (m *autopilotManager) UsesExternalScores() bool {
+	switch m.cfg.Heuristic {
+	case "externalscore":
+		return true
+	case "weighted":
+		return m.cfg.Weight["externalscore"] > 0
+	default:
+		return false
+	}
+}
+
+// SetExternalScores replaces the scores of the externalscore heuristic with
+// the passed ones. If the agent is active, it reconsiders its attachments
+// using the new scores.
+func (m *autopilotManager) SetExternalScores(
+	scores map[autopilot.NodeID]float64) error {
+
+	if !m.UsesExternalScores() {
+		return fmt.Errorf("autopilot heuristic %v doesn't use "+
+			"external scores", m.cfg.Heuristic)
+	}
+
+	if err := m.externalScorer.SetNodeScores(scores); err != nil {
+		return err
+	}
+
+	m.mtx.Lock()
+	if m.pilot != nil {
+		m.pilot.OnNodeUpdates()
+	}
+	m.mtx.Unlock()
+
+	return nil
+}
@@ -0,0 +1,378 @@
+package main
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/zpay32"
+)
+
+const (
+	// numRebalanceRoutes is the number of candidate circular routes we'll
+	// attempt for a single rebalance.
+	numRebalanceRoutes = 10
+
+	// rebalanceMemo is the memo attached to the invoices we create to pay
+	// ourselves when rebalancing.
+	rebalanceMemo = "circular rebalance"
+)
+
+var (
+	// ErrRebalancerShuttingDown is returned when a rebalance is requested
+	// while the rebalancer is exiting.
+	ErrRebalancerShuttingDown = errors.New("rebalancer shutting down")
+)
+
+// rebalancerConfig houses the set of functions and parameters the rebalancer
+// requires to shift liquidity between our channels.
+type rebalancerConfig struct {
+	// SelfPub is the public key of our node. This is the final destination
+	// of every rebalancing payment.
+	SelfPub *btcec.PublicKey
+
+	// ChainParams are the parameters of the chain our invoices are
+	// created for.
+	ChainParams *chaincfg.Params
+
+	// FindCircularRoutes finds a set of routes that leave through the
+	// outgoing channel and return to us through the incoming channel.
+	FindCircularRoutes func(outgoingChan, incomingChan uint64,
+		amt, feeLimit lnwire.MilliSatoshi, numPaths uint32,
+		finalExpiry ...uint16) ([]*routing.Route, error)
+
+	// SendToRoute attempts to send a payment across the passed routes.
+	SendToRoute func(routes []*routing.Route,
+		payment *routing.LightningPayment) ([32]byte, *routing.Route,
+		error, uint32)
+
+	// AddInvoice adds the invoice we'll settle upon receiving the
+	// rebalancing payment back.
+	AddInvoice func(*channeldb.Invoice) (uint64, error)
+
+	// DeleteInvoice removes the invoice of a rebalancing payment that
+	// failed, so it doesn't linger as an open invoice.
+	DeleteInvoice func(chainhash.Hash) error
+
+	// SignInvoice is used to sign the payment request of the invoices we
+	// create for ourselves.
+	SignInvoice zpay32.MessageSigner
+
+	// FetchAllOpenChannels returns all of our currently open channels,
+	// which are inspected by the automatic rebalancer.
+	FetchAllOpenChannels func() ([]*channeldb.OpenChannel, error)
+
+	// AddForwardingEvents records each completed rebalance within the
+	// forwarding log.
+	AddForwardingEvents func([]channeldb.ForwardingEvent) error
+
+	// Auto indicates whether the channel balances should periodically be
+	// evened out.
+	Auto bool
+
+	// Interval is how often the automatic rebalancer inspects the balances
+	// of our channels.
+	Interval time.Duration
+
+	// Threshold is how far the ratio of a channel's local balance to its
+	// capacity may deviate from an even split before it's rebalanced.
+	Threshold float64
+
+	// MaxFeePercent is the maximum fee the automatic rebalancer will pay,
+	// expressed as a percentage of the amount being moved.
+	MaxFeePercent float64
+}
+
+// rebalancer moves liquidity between our own channels by sending circular
+// payments that leave through one channel and come back through another. A
+// rebalance can either be requested explicitly, or be carried out
+// periodically for channels whose balances have become lopsided.
+type rebalancer struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	cfg *rebalancerConfig
+
+	// rebalanceMtx ensures that only a single rebalance is in flight at a
+	// time, so the automatic rebalancer and manual requests don't act on
+	// stale balances.
+	rebalanceMtx sync.Mutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// newRebalancer creates a new rebalancer from the passed config.
+func newRebalancer(cfg *rebalancerConfig) *rebalancer {
+	return &rebalancer{
+		cfg:  cfg,
+		quit: make(chan struct{}),
+	}
+}
+
+// Start launches the automatic rebalancer, if it was enabled.
+func (r *rebalancer) Start() error {
+	if !atomic.CompareAndSwapUint32(&r.started, 0, 1) {
+		return nil
+	}
+
+	if r.cfg.Auto {
+		rbalLog.Infof("Automatic rebalancer active, checking channels "+
+			"every %v", r.cfg.Interval)
+
+		r.wg.Add(1)
+		go r.autoRebalancer()
+	}
+
+	return nil
+}
+
+// Stop signals the automatic rebalancer to exit, and waits for it to do so.
+func (r *rebalancer) Stop() {
+	if !atomic.CompareAndSwapUint32(&r.stopped, 0, 1) {
+		return
+	}
+
+	close(r.quit)
+	r.wg.Wait()
+}
+
+// Rebalance moves amt from the outgoing channel to the incoming channel by
+// paying ourselves across a circular route, paying at most feeLimit in fees.
+// The route taken and the preimage of the payment are returned upon success.
+func (r *rebalancer) Rebalance(outgoingChan, incomingChan lnwire.ShortChannelID,
+	amt, feeLimit lnwire.MilliSatoshi) (*routing.Route, [32]byte, error) {
+
+	r.rebalanceMtx.Lock()
+	defer r.rebalanceMtx.Unlock()
+
+	select {
+	case <-r.quit:
+		return nil, [32]byte{}, ErrRebalancerShuttingDown
+	default:
+	}
+
+	routes, err := r.cfg.FindCircularRoutes(
+		outgoingChan.ToUint64(), incomingChan.ToUint64(), amt,
+		feeLimit, numRebalanceRoutes,
+	)
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+
+	// In order to receive the payment at the end of the loop, we'll need
+	// an invoice we're able to settle.
+	var preimage [32]byte
+	if _, err := rand.Read(preimage[:]); err != nil {
+		return nil, [32]byte{}, err
+	}
+	paymentHash := sha256.Sum256(preimage[:])
+
+	creationDate := time.Now()
+	payReq, err := zpay32.NewInvoice(
+		r.cfg.ChainParams, paymentHash, creationDate,
+		zpay32.Amount(amt), zpay32.Description(rebalanceMemo),
+		zpay32.CLTVExpiry(routing.DefaultFinalCLTVDelta),
+	)
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	payReqString, err := payReq.Encode(r.cfg.SignInvoice)
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+
+	invoice := &channeldb.Invoice{
+		CreationDate:   creationDate,
+		Memo:           []byte(rebalanceMemo),
+		PaymentRequest: []byte(payReqString),
+		Terms: channeldb.ContractTerm{
+			PaymentPreimage: preimage,
+			Value:           amt,
+		},
+	}
+	if _, err := r.cfg.AddInvoice(invoice); err != nil {
+		return nil, [32]byte{}, err
+	}
+
+	payment := &routing.LightningPayment{
+		Target:      r.cfg.SelfPub,
+		Amount:      amt,
+		FeeLimit:    feeLimit,
+		PaymentHash: paymentHash,
+	}
+	_, route, err, _ := r.cfg.SendToRoute(routes, payment)
+	if err != nil {
+		// As the payment failed, nobody should be able to pay the
+		// invoice anymore. Even if our HTLC were still in flight,
+		// failing it once it arrives only returns the funds to us.
+		if err := r.cfg.DeleteInvoice(paymentHash); err != nil {
+			rbalLog.Errorf("Unable to delete invoice of failed "+
+				"rebalance %x: %v", paymentHash[:], err)
+		}
+
+		return nil, [32]byte{}, err
+	}
+
+	rbalLog.Infof("Moved %v from channel %v to channel %v, paying %v "+
+		"in fees", amt, outgoingChan, incomingChan, route.TotalFees)
+
+	// Finally, we'll record the rebalance within the forwarding log. From
+	// our perspective, the loop is a circuit that was forwarded from the
+	// incoming channel to the outgoing channel. As more left through the
+	// outgoing channel than came back, the fees paid would make for a
+	// negative forwarding fee, which the forwarding log reports as zero.
+	event := channeldb.ForwardingEvent{
+		Timestamp:      time.Now(),
+		IncomingChanID: incomingChan,
+		OutgoingChanID: outgoingChan,
+		AmtIn:          amt,
+		AmtOut:         route.TotalAmount,
+	}
+	err = r.cfg.AddForwardingEvents([]channeldb.ForwardingEvent{event})
+	if err != nil {
+		rbalLog.Errorf("Unable to log rebalance from %v to %v: %v",
+			outgoingChan, incomingChan, err)
+	}
+
+	return route, preimage, nil
+}
+
+// autoRebalancer periodically inspects the balances of our channels, and
+// attempts to even out any that have grown lopsided.
+//
+// NOTE: This MUST be run as a goroutine.
+func (r *rebalancer) autoRebalancer() {
+	defer r.wg.Done()
+
+	ticker := time.NewTicker(r.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.rebalanceChannels(); err != nil {
+				rbalLog.Errorf("Unable to rebalance channels: "+
+					"%v", err)
+			}
+
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+// rebalanceChannels attempts a rebalance for each pair of lopsided channels.
+func (r *rebalancer) rebalanceChannels() error {
+	channels, err := r.cfg.FetchAllOpenChannels()
+	if err != nil {
+		return err
+	}
+
+	for _, pair := range pairLopsidedChannels(channels, r.cfg.Threshold) {
+		feeLimit := lnwire.MilliSatoshi(
+			float64(pair.amt) * r.cfg.MaxFeePercent / 100,
+		)
+
+		_, _, err := r.Rebalance(
+			pair.outgoing, pair.incoming, pair.amt, feeLimit,
+		)
+		switch {
+		case err == ErrRebalancerShuttingDown:
+			return nil
+
+		// A failure to rebalance a single pair shouldn't prevent us
+		// from attempting the rest.
+		case err != nil:
+			rbalLog.Debugf("Unable to rebalance %v from %v to %v: "+
+				"%v", pair.amt, pair.outgoing, pair.incoming,
+				err)
+		}
+	}
+
+	return nil
+}
+
+// rebalancePair describes a rebalance from a channel with a surplus of local
+// balance to one that's depleted.
+type rebalancePair struct {
+	outgoing lnwire.ShortChannelID
+	incoming lnwire.ShortChannelID
+	amt      lnwire.MilliSatoshi
+}
+
+// localBalanceRatio returns the fraction of the channel's capacity that's on
+// our side of the channel.
+func localBalanceRatio(c *channeldb.OpenChannel) float64 {
+	capacity := float64(c.Capacity)
+	if capacity == 0 {
+		return 0
+	}
+
+	return float64(c.LocalCommitment.LocalBalance.ToSatoshis()) / capacity
+}
+
+// pairLopsidedChannels matches the channels whose local balance ratio exceeds
+// an even split by more than the threshold with those that fall short of it
+// by more than the threshold. The fullest channels are paired with the most
+// depleted ones, and the amount of each pair is chosen such that neither
+// channel is pushed past an even split.
+func pairLopsidedChannels(channels []*channeldb.OpenChannel,
+	threshold float64) []rebalancePair {
+
+	var surplus, depleted []*channeldb.OpenChannel
+	for _, c := range channels {
+		if c.IsPending {
+			continue
+		}
+
+		ratio := localBalanceRatio(c)
+		switch {
+		case ratio > 0.5+threshold:
+			surplus = append(surplus, c)
+		case ratio < 0.5-threshold:
+			depleted = append(depleted, c)
+		}
+	}
+
+	sort.Slice(surplus, func(i, j int) bool {
+		return localBalanceRatio(surplus[i]) >
+			localBalanceRatio(surplus[j])
+	})
+	sort.Slice(depleted, func(i, j int) bool {
+		return localBalanceRatio(depleted[i]) <
+			localBalanceRatio(depleted[j])
+	})
+
+	var pairs []rebalancePair
+	for i := 0; i < len(surplus) && i < len(depleted); i++ {
+		out, in := surplus[i], depleted[i]
+
+		outHalf := lnwire.NewMSatFromSatoshis(out.Capacity / 2)
+		inHalf := lnwire.NewMSatFromSatoshis(in.Capacity / 2)
+
+		amt := out.LocalCommitment.LocalBalance - outHalf
+		deficit := inHalf - in.LocalCommitment.LocalBalance
+		if deficit < amt {
+			amt = deficit
+		}
+
+		pairs = append(pairs, rebalancePair{
+			outgoing: out.ShortChanID(),
+			incoming: in.ShortChanID(),
+			amt:      amt,
+		})
+	}
+
+	return pairs
+}
@@ -0,0 +1,82 @@
+// +build !rpctest
+
+package main
+
+import (
+	"testing"
+
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// TestPairLopsidedChannels asserts that channels whose balances deviate from
+// an even split by more than the threshold are paired up for rebalancing,
+// fullest with most depleted, without pushing either past an even split.
+func TestPairLopsidedChannels(t *testing.T) {
+	t.Parallel()
+
+	newChannel := func(chanID uint64, capacity,
+		localBalance btcutil.Amount) *channeldb.OpenChannel {
+
+		return &channeldb.OpenChannel{
+			ShortChannelID: lnwire.NewShortChanIDFromInt(chanID),
+			Capacity:       capacity,
+			LocalCommitment: channeldb.ChannelCommitment{
+				LocalBalance: lnwire.NewMSatFromSatoshis(
+					localBalance,
+				),
+			},
+		}
+	}
+
+	pending := newChannel(6, 100000, 100000)
+	pending.IsPending = true
+
+	channels := []*channeldb.OpenChannel{
+		// Slightly full, but within the threshold.
+		newChannel(1, 100000, 70000),
+
+		// Full channels, with channel 3 being the fullest.
+		newChannel(2, 100000, 90000),
+		newChannel(3, 200000, 200000),
+
+		// Depleted channels, with channel 4 being the most depleted.
+		newChannel(4, 100000, 0),
+		newChannel(5, 100000, 15000),
+
+		// Pending channels should never be rebalanced.
+		pending,
+	}
+
+	pairs := pairLopsidedChannels(channels, 0.3)
+	if len(pairs) != 2 {
+		t.Fatalf("expected 2 pairs, instead got %v", len(pairs))
+	}
+
+	expected := []rebalancePair{
+		{
+			outgoing: lnwire.NewShortChanIDFromInt(3),
+			incoming: lnwire.NewShortChanIDFromInt(4),
+			amt:      lnwire.NewMSatFromSatoshis(50000),
+		},
+		{
+			outgoing: lnwire.NewShortChanIDFromInt(2),
+			incoming: lnwire.NewShortChanIDFromInt(5),
+			amt:      lnwire.NewMSatFromSatoshis(35000),
+		},
+	}
+	for i, pair := range pairs {
+		if pair != expected[i] {
+			t.Fatalf("pair #%v: expected %v, got %v", i,
+				expected[i], pair)
+		}
+	}
+
+	// If none of the channels exceed the threshold, then no pairs should
+	// be returned.
+	pairs = pairLopsidedChannels(channels[:1], 0.3)
+	if len(pairs) != 0 {
+		t.Fatalf("expected no pairs, instead got %v", len(pairs))
+	}
+}
@@ -1247,3 +1247,112 @@ func findPaths(tx *bolt.Tx, graph *channeldb.ChannelGraph,
 
 	return shortestPaths, nil
 }
+
+// findCircularPaths finds up to numPaths loops that leave the source node
+// through outgoingChan and return to it through incomingChan. As our modified
+// Dijkstra's terminates as soon as the source is reached, we can't search for
+// a path with the source as its own target directly. Instead, we use findPaths
+// to locate paths from the source to the peer at the far end of the incoming
+// channel, and then close each loop with the incoming edge itself. All of our
+// other local channels are masked out via the bandwidth hints so the paths
+// found are forced to leave through the outgoing channel.
+//
+// The returned paths include the "self-hop" inserted by findPaths, so they
+// can be passed directly to pathsToFeeSortedRoutes.
+func findCircularPaths(tx *bolt.Tx, graph *channeldb.ChannelGraph,
+	source *channeldb.LightningNode, outgoingChan uint64,
+	incomingEdge *channeldb.ChannelEdgeInfo,
+	incomingPolicy *channeldb.ChannelEdgePolicy, amt lnwire.MilliSatoshi,
+	feeLimit lnwire.MilliSatoshi, numPaths uint32,
+	bandwidthHints map[uint64]lnwire.MilliSatoshi) ([][]*ChannelHop, error) {
+
+	if outgoingChan == incomingEdge.ChannelID {
+		return nil, fmt.Errorf("outgoing and incoming channel must " +
+			"differ")
+	}
+
+	// The incoming policy must be the one our peer uses to forward to us,
+	// otherwise we'd be attempting to pay them rather than ourselves.
+	if incomingPolicy.Node.PubKeyBytes != source.PubKeyBytes {
+		return nil, fmt.Errorf("incoming channel %v doesn't lead to "+
+			"source node", incomingEdge.ChannelID)
+	}
+
+	// The last hop of our loop is the node on the other side of the
+	// incoming channel.
+	lastHopKey := incomingEdge.NodeKey1Bytes
+	if lastHopKey == source.PubKeyBytes {
+		lastHopKey = incomingEdge.NodeKey2Bytes
+	}
+	lastHop, err := btcec.ParsePubKey(lastHopKey[:], btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	// The last hop will charge us a fee for forwarding the payment back to
+	// ourselves, so this needs to be carried to it on top of the amount,
+	// and also counts against our fee limit.
+	lastHopFee := computeFee(amt, incomingPolicy)
+	if lastHopFee > feeLimit {
+		return nil, newErrf(ErrFeeLimitExceeded, "fee of %v for "+
+			"incoming channel %v exceeds fee limit of %v",
+			lastHopFee, incomingEdge.ChannelID, feeLimit)
+	}
+
+	// Mask out all of our local channels other than the outgoing one by
+	// setting their bandwidth to zero. This also ensures we won't attempt
+	// to route directly over the incoming channel.
+	hints := make(map[uint64]lnwire.MilliSatoshi, len(bandwidthHints))
+	for chanID, bandwidth := range bandwidthHints {
+		hints[chanID] = bandwidth
+	}
+	err = source.ForEachChannel(tx, func(_ *bolt.Tx,
+		edgeInfo *channeldb.ChannelEdgeInfo,
+		_, _ *channeldb.ChannelEdgePolicy) error {
+
+		if edgeInfo.ChannelID != outgoingChan {
+			hints[edgeInfo.ChannelID] = 0
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	paths, err := findPaths(
+		tx, graph, source, lastHop, amt+lastHopFee,
+		feeLimit-lastHopFee, numPaths, hints,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Finally, close each of the loops with the incoming edge. Since the
+	// self-hop is included in each path, the loop must still fit within
+	// the hop limit once it's been stripped off.
+	incomingHop := &ChannelHop{
+		ChannelEdgePolicy: incomingPolicy,
+		Bandwidth: lnwire.NewMSatFromSatoshis(
+			incomingEdge.Capacity,
+		),
+	}
+	circularPaths := make([][]*ChannelHop, 0, len(paths))
+	for _, path := range paths {
+		if len(path) > HopLimit {
+			continue
+		}
+
+		circularPath := make([]*ChannelHop, 0, len(path)+1)
+		circularPath = append(circularPath, path...)
+		circularPath = append(circularPath, incomingHop)
+
+		circularPaths = append(circularPaths, circularPath)
+	}
+
+	if len(circularPaths) == 0 {
+		return nil, newErr(ErrMaxHopsExceeded, "potential path has "+
+			"too many hops")
+	}
+
+	return circularPaths, nil
+}
@@ -681,6 +681,53 @@ func TestBasicGraphPathFinding(t *testing.T) {
 	}
 }
 
+// TestSnapshotGraphPathFinding asserts that path finding over a graph loaded
+// from a snapshot of the basic test graph yields the same paths as over the
+// original graph.
+func TestSnapshotGraphPathFinding(t *testing.T) {
+	t.Parallel()
+
+	graphInstance, err := parseTestGraph(basicGraphFilePath)
+	defer graphInstance.cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+
+	// We'll pass the snapshot through its binary encoding, to make sure
+	// no information needed for path finding is lost along the way.
+	snapshot, err := graphInstance.graph.Snapshot()
+	if err != nil {
+		t.Fatalf("unable to create snapshot: %v", err)
+	}
+	var b bytes.Buffer
+	err = snapshot.Encode(&b, channeldb.SnapshotFormatBinary)
+	if err != nil {
+		t.Fatalf("unable to encode snapshot: %v", err)
+	}
+	snapshot, err = channeldb.DecodeGraphSnapshot(&b)
+	if err != nil {
+		t.Fatalf("unable to decode snapshot: %v", err)
+	}
+
+	snapshotGraph, cleanUp, err := channeldb.OpenSnapshotGraph(snapshot)
+	if err != nil {
+		t.Fatalf("unable to load snapshot: %v", err)
+	}
+	defer cleanUp()
+
+	snapshotInstance := &testGraphInstance{
+		graph:    snapshotGraph,
+		aliasMap: graphInstance.aliasMap,
+	}
+	for _, testCase := range basicGraphPathFindingTests {
+		t.Run(testCase.target, func(subT *testing.T) {
+			testBasicGraphPathFindingCase(
+				subT, snapshotInstance, &testCase,
+			)
+		})
+	}
+}
+
 func testBasicGraphPathFindingCase(t *testing.T, graphInstance *testGraphInstance,
 	test *basicGraphPathFindingTestCase) {
 
@@ -954,6 +1001,119 @@ func TestKShortestPathFinding(t *testing.T) {
 	assertExpectedPath(t, paths[1], "roasbeef", "satoshi", "luoji")
 }
 
+// TestCircularPathFinding tests that we're able to find loops which leave the
+// source node through one of its channels and return through another.
+func TestCircularPathFinding(t *testing.T) {
+	t.Parallel()
+
+	graph, err := parseTestGraph(basicGraphFilePath)
+	defer graph.cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create graph: %v", err)
+	}
+
+	sourceNode, err := graph.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+
+	// fetchIncoming is a helper closure that returns the edge info and
+	// the policy leading back to roasbeef for the target channel.
+	fetchIncoming := func(chanID uint64) (*channeldb.ChannelEdgeInfo,
+		*channeldb.ChannelEdgePolicy) {
+
+		info, p1, p2, err := graph.graph.FetchChannelEdgesByID(chanID)
+		if err != nil {
+			t.Fatalf("unable to fetch channel %v: %v", chanID, err)
+		}
+		if p1.Node.PubKeyBytes == sourceNode.PubKeyBytes {
+			return info, p1
+		}
+		return info, p2
+	}
+
+	const (
+		roasbeefSatoshi   = 2340213491
+		roasbeefLuoji     = 689530843
+		roasbeefSongoku   = 12345
+		roasbeefPhamnuwen = 999991
+	)
+
+	paymentAmt := lnwire.NewMSatFromSatoshis(100)
+
+	// In our basic_graph.json, the only way to leave roasbeef through the
+	// channel with satoshi and return through the channel with luo ji is
+	// the loop roasbeef -> satoshi -> luo ji -> roasbeef.
+	info, policy := fetchIncoming(roasbeefLuoji)
+	paths, err := findCircularPaths(
+		nil, graph.graph, sourceNode, roasbeefSatoshi, info, policy,
+		paymentAmt, noFeeLimit, 100, nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to find circular paths: %v", err)
+	}
+	if len(paths) != 1 {
+		t.Fatalf("expected 1 path, instead found %v", len(paths))
+	}
+	assertExpectedPath(
+		t, paths[0], "roasbeef", "satoshi", "luoji", "roasbeef",
+	)
+
+	// Leaving through son goku and returning through pham nuwen should
+	// take us across sophon.
+	info, policy = fetchIncoming(roasbeefPhamnuwen)
+	paths, err = findCircularPaths(
+		nil, graph.graph, sourceNode, roasbeefSongoku, info, policy,
+		paymentAmt, noFeeLimit, 100, nil,
+	)
+	if err != nil {
+		t.Fatalf("unable to find circular paths: %v", err)
+	}
+	assertExpectedPath(
+		t, paths[0], "roasbeef", "songoku", "sophon", "phamnuwen",
+		"roasbeef",
+	)
+
+	// The paths should be convertible into routes that deliver the full
+	// amount back to ourselves.
+	routes, err := pathsToFeeSortedRoutes(
+		Vertex(sourceNode.PubKeyBytes), paths, DefaultFinalCLTVDelta,
+		paymentAmt, noFeeLimit, 100,
+	)
+	if err != nil {
+		t.Fatalf("unable to create routes: %v", err)
+	}
+	lastHop := routes[0].Hops[len(routes[0].Hops)-1]
+	if lastHop.Channel.Node.PubKeyBytes != sourceNode.PubKeyBytes {
+		t.Fatalf("route doesn't terminate at source node")
+	}
+	if lastHop.AmtToForward != paymentAmt {
+		t.Fatalf("expected final amount %v, got %v", paymentAmt,
+			lastHop.AmtToForward)
+	}
+
+	// Masking the outgoing channel should leave us without any loops.
+	hints := map[uint64]lnwire.MilliSatoshi{
+		roasbeefSongoku: 0,
+	}
+	_, err = findCircularPaths(
+		nil, graph.graph, sourceNode, roasbeefSongoku, info, policy,
+		paymentAmt, noFeeLimit, 100, hints,
+	)
+	if !IsError(err, ErrNoPathFound) {
+		t.Fatalf("expected ErrNoPathFound, instead got: %v", err)
+	}
+
+	// Finally, using the same channel for both directions is invalid.
+	_, err = findCircularPaths(
+		nil, graph.graph, sourceNode, roasbeefPhamnuwen, info, policy,
+		paymentAmt, noFeeLimit, 100, nil,
+	)
+	if err == nil {
+		t.Fatalf("expected failure when using the same channel")
+	}
+}
+
 // TestNewRoute tests whether the construction of hop payloads by newRoute
 // is executed correctly.
 func TestNewRoute(t *testing.T) {
@@ -0,0 +1,323 @@
+package routing
+
+import (
+	"bytes"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/htlcswitch"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// PaymentSubscription represents an intent to receive updates on the
+// lifecycle of a particular payment from the channel router.
+type PaymentSubscription struct {
+	// Updates is a receive only channel over which the full history of
+	// the payment is sent each time it changes. As each update supersedes
+	// the previous one, only the latest update is buffered, so a slow
+	// client will skip intermediate updates but never the final one.
+	Updates <-chan *channeldb.PaymentHistory
+
+	// Cancel is a function closure that should be executed when the client
+	// wishes to cancel their notification intent. Doing so allows the
+	// ChannelRouter to free up resources.
+	Cancel func()
+}
+
+// SubscribePayment returns the current history of the payment with the given
+// payment hash, along with a subscription that will be sent the updated
+// history each time the payment progresses. As the history is persisted,
+// payments initiated before a restart can be tracked as well.
+func (r *ChannelRouter) SubscribePayment(paymentHash [32]byte) (
+	*channeldb.PaymentHistory, *PaymentSubscription, error) {
+
+	// We'll hold the subscriber mutex while fetching the history, such
+	// that no update can slip in between the two.
+	r.paymentSubscribersMtx.Lock()
+	defer r.paymentSubscribersMtx.Unlock()
+
+	history, err := r.cfg.Graph.Database().FetchPaymentHistory(paymentHash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clientID := atomic.AddUint64(&r.ntfnClientCounter, 1)
+	updates := make(chan *channeldb.PaymentHistory, 1)
+
+	subscribers, ok := r.paymentSubscribers[paymentHash]
+	if !ok {
+		subscribers = make(map[uint64]chan *channeldb.PaymentHistory)
+		r.paymentSubscribers[paymentHash] = subscribers
+	}
+	subscribers[clientID] = updates
+
+	log.Debugf("New payment subscription for payment %x, client %v",
+		paymentHash, clientID)
+
+	return history, &PaymentSubscription{
+		Updates: updates,
+		Cancel: func() {
+			r.paymentSubscribersMtx.Lock()
+			defer r.paymentSubscribersMtx.Unlock()
+
+			subscribers := r.paymentSubscribers[paymentHash]
+			delete(subscribers, clientID)
+			if len(subscribers) == 0 {
+				delete(r.paymentSubscribers, paymentHash)
+			}
+		},
+	}, nil
+}
+
+// notifyPaymentUpdate sends the updated history of a payment to all of its
+// subscribers, replacing any update they have yet to receive.
+func (r *ChannelRouter) notifyPaymentUpdate(history *channeldb.PaymentHistory) {
+	r.paymentSubscribersMtx.Lock()
+	defer r.paymentSubscribersMtx.Unlock()
+
+	for _, updates := range r.paymentSubscribers[history.PaymentHash] {
+		// As we're the only sender, and hold the mutex, the channel is
+		// guaranteed to have room once any stale update is drained.
+		select {
+		case <-updates:
+		default:
+		}
+
+		updates <- history
+	}
+}
+
+// initPaymentHistory starts tracking the lifecycle of the payment within its
+// persisted history. False is returned if the payment shouldn't be tracked,
+// as its history couldn't be initialized.
+func (r *ChannelRouter) initPaymentHistory(payment *LightningPayment) bool {
+	var target [33]byte
+	copy(target[:], payment.Target.SerializeCompressed())
+
+	history, err := r.cfg.Graph.Database().InitPaymentHistory(
+		payment.PaymentHash, target, payment.Amount,
+	)
+	if err != nil {
+		log.Warnf("Unable to track history of payment %x: %v",
+			payment.PaymentHash, err)
+		return false
+	}
+
+	r.notifyPaymentUpdate(history)
+	return true
+}
+
+// registerPaymentAttempt records an attempt to complete the payment across
+// the given route.
+func (r *ChannelRouter) registerPaymentAttempt(paymentHash [32]byte,
+	route *Route) (*channeldb.PaymentAttempt, error) {
+
+	attempt := &channeldb.PaymentAttempt{
+		TotalAmount:   route.TotalAmount,
+		TotalFees:     route.TotalFees,
+		TotalTimeLock: route.TotalTimeLock,
+		AttemptTime:   time.Now(),
+		Hops:          make([]channeldb.AttemptHop, len(route.Hops)),
+	}
+	for i, hop := range route.Hops {
+		attempt.Hops[i] = channeldb.AttemptHop{
+			PubKey:           hop.Channel.Node.PubKeyBytes,
+			ChannelID:        hop.Channel.ChannelID,
+			AmtToForward:     hop.AmtToForward,
+			Fee:              hop.Fee,
+			OutgoingTimeLock: hop.OutgoingTimeLock,
+		}
+	}
+
+	history, err := r.cfg.Graph.Database().RegisterPaymentAttempt(
+		paymentHash, attempt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	r.notifyPaymentUpdate(history)
+	return attempt, nil
+}
+
+// resolvePaymentAttempt records the outcome of an attempt within the history
+// of the payment. A nil send error marks the attempt as succeeded.
+func (r *ChannelRouter) resolvePaymentAttempt(paymentHash [32]byte,
+	attempt *channeldb.PaymentAttempt, preimage [32]byte, sendErr error) {
+
+	db := r.cfg.Graph.Database()
+
+	var (
+		history *channeldb.PaymentHistory
+		err     error
+	)
+	if sendErr == nil {
+		history, err = db.SettlePaymentAttempt(
+			paymentHash, attempt.AttemptID, preimage,
+		)
+	} else {
+		sourceIdx, code := attemptFailure(attempt.Hops, sendErr)
+		history, err = db.FailPaymentAttempt(
+			paymentHash, attempt.AttemptID, sourceIdx, code,
+		)
+	}
+	if err != nil {
+		log.Errorf("Unable to record outcome of attempt %v of "+
+			"payment %x: %v", attempt.AttemptID, paymentHash, err)
+		return
+	}
+
+	r.notifyPaymentUpdate(history)
+}
+
+// failPaymentHistory marks the payment as failed, as no further attempts will
+// be made to complete it.
+func (r *ChannelRouter) failPaymentHistory(paymentHash [32]byte) {
+	history, err := r.cfg.Graph.Database().FailPaymentHistory(paymentHash)
+	if err != nil {
+		log.Errorf("Unable to mark payment %x as failed: %v",
+			paymentHash, err)
+		return
+	}
+
+	r.notifyPaymentUpdate(history)
+}
+
+// completePaymentHistory marks the payment as completed, as the switch
+// reported it was already paid.
+func (r *ChannelRouter) completePaymentHistory(paymentHash [32]byte) {
+	history, err := r.cfg.Graph.Database().CompletePaymentHistory(
+		paymentHash,
+	)
+	if err != nil {
+		log.Errorf("Unable to mark payment %x as completed: %v",
+			paymentHash, err)
+		return
+	}
+
+	r.notifyPaymentUpdate(history)
+}
+
+// ResolveOrphanedPayment records the outcome of an HTLC whose payment was
+// initiated before the daemon restarted. As the payment loop that dispatched
+// the HTLC no longer exists, the payment itself is resolved as well: it's
+// either completed, or marked as failed as it won't be retried.
+func (r *ChannelRouter) ResolveOrphanedPayment(paymentHash [32]byte,
+	preimage [32]byte, sendErr error) error {
+
+	history, err := r.cfg.Graph.Database().FetchPaymentHistory(paymentHash)
+	if err == channeldb.ErrPaymentHistoryNotFound {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	// The control tower only allows a single HTLC per payment hash in
+	// flight, so the orphaned HTLC must be the last attempt that hasn't
+	// been resolved.
+	var inFlight *channeldb.PaymentAttempt
+	for _, attempt := range history.Attempts {
+		if attempt.State == channeldb.AttemptInFlight {
+			inFlight = attempt
+		}
+	}
+	if inFlight == nil {
+		return errors.New("no attempt in flight")
+	}
+
+	log.Infof("Resolving attempt %v of payment %x dispatched before "+
+		"restart", inFlight.AttemptID, paymentHash)
+
+	r.resolvePaymentAttempt(paymentHash, inFlight, preimage, sendErr)
+	if sendErr != nil {
+		r.failPaymentHistory(paymentHash)
+	}
+
+	return nil
+}
+
+// resumePayments resolves the payments that were in flight when the daemon
+// last shut down. A payment whose HTLC is still in flight within the switch is
+// left to be resolved once the switch reports its outcome through
+// ResolveOrphanedPayment. Otherwise, no outcome will ever be reported, for
+// instance because the switch exited before the HTLC left our node, so its
+// in-flight attempts and the payment itself are marked as failed.
+func (r *ChannelRouter) resumePayments() error {
+	if r.cfg.PaymentInFlight == nil {
+		return nil
+	}
+
+	histories, err := r.cfg.Graph.Database().FetchAllPaymentHistories()
+	if err != nil {
+		return err
+	}
+
+	for _, history := range histories {
+		if history.Terminal() {
+			continue
+		}
+		if r.cfg.PaymentInFlight(history.PaymentHash) {
+			continue
+		}
+
+		log.Infof("Failing payment %x, which is no longer in flight "+
+			"since restart", history.PaymentHash)
+
+		for _, attempt := range history.Attempts {
+			if attempt.State != channeldb.AttemptInFlight {
+				continue
+			}
+
+			r.resolvePaymentAttempt(
+				history.PaymentHash, attempt, [32]byte{},
+				htlcswitch.ErrSwitchExiting,
+			)
+		}
+		r.failPaymentHistory(history.PaymentHash)
+	}
+
+	return nil
+}
+
+// attemptFailure extracts the index of the node within the route that
+// reported the failure of an attempt, along with the onion failure code. If
+// the failure wasn't reported through an onion failure, our own node is
+// deemed its source, and a zero failure code is returned.
+func attemptFailure(hops []channeldb.AttemptHop,
+	sendErr error) (uint32, lnwire.FailCode) {
+
+	fErr, ok := sendErr.(*htlcswitch.ForwardingError)
+	if !ok {
+		return 0, 0
+	}
+
+	var code lnwire.FailCode
+	if fErr.FailureMessage != nil {
+		code = fErr.FailureMessage.Code()
+	}
+
+	return failureSourceIdx(hops, fErr.ErrorSource), code
+}
+
+// failureSourceIdx returns the index of the given node within the route,
+// where zero denotes our own node, and i denotes the node reached by the i-th
+// hop. Nodes that aren't part of the route are deemed to be our own node.
+func failureSourceIdx(hops []channeldb.AttemptHop,
+	source *btcec.PublicKey) uint32 {
+
+	if source == nil {
+		return 0
+	}
+
+	sourceBytes := source.SerializeCompressed()
+	for i, hop := range hops {
+		if bytes.Equal(hop.PubKey[:], sourceBytes) {
+			return uint32(i + 1)
+		}
+	}
+
+	return 0
+}
@@ -119,6 +119,10 @@ type ChannelGraphSource interface {
 	IsStaleEdgePolicy(chanID lnwire.ShortChannelID, timestamp time.Time,
 		flags lnwire.ChanUpdateFlag) bool
 
+	// MarkEdgeLive clears an edge from our zombie index, deeming it as
+	// live.
+	MarkEdgeLive(chanID lnwire.ShortChannelID) error
+
 	// ForAllOutgoingChannels is used to iterate over all channels
 	// emanating from the "source" node which is the center of the
 	// star-graph.
@@ -223,6 +227,16 @@ type Config struct {
 	// returned.
 	QueryBandwidth func(edge *channeldb.ChannelEdgeInfo) lnwire.MilliSatoshi
 
+	// PaymentInFlight is a method that allows the router to query the lower
+	// link layer to determine whether an HTLC of the locally initiated
+	// payment with the given hash is still in flight. Upon startup, the
+	// router fails any payment whose HTLC is no longer in flight, as its
+	// outcome will never be reported.
+	//
+	// NOTE: This is optional, payments aren't resolved upon startup if
+	// unset.
+	PaymentInFlight func(paymentHash [32]byte) bool
+
 	// AssumeChannelValid toggles whether or not the router will check for
 	// spentness of channel outpoints. For neutrino, this saves long rescans
 	// from blocking initial usage of the wallet. This should only be
@@ -329,6 +343,12 @@ type ChannelRouter struct {
 	rejectMtx   sync.RWMutex
 	rejectCache map[uint64]struct{}
 
+	// paymentSubscribers maps the payment hash of a payment to the set of
+	// clients tracking its lifecycle, keyed by their unique notification
+	// ID.
+	paymentSubscribersMtx sync.Mutex
+	paymentSubscribers    map[[32]byte]map[uint64]chan *channeldb.PaymentHistory
+
 	sync.RWMutex
 
 	quit chan struct{}
@@ -361,6 +381,9 @@ func New(cfg Config) (*ChannelRouter, error) {
 		routeCache:        make(map[routeTuple][]*Route),
 		rejectCache:       make(map[uint64]struct{}),
 		quit:              make(chan struct{}),
+		paymentSubscribers: make(
+			map[[32]byte]map[uint64]chan *channeldb.PaymentHistory,
+		),
 	}
 
 	r.missionControl = newMissionControl(
@@ -584,6 +607,13 @@ func (r *ChannelRouter) Start() error {
 		return err
 	}
 
+	// The payments that were in flight when we last shut down no longer
+	// have a payment loop awaiting their outcome, so we'll resolve those
+	// that won't be completed by the switch.
+	if err := r.resumePayments(); err != nil {
+		return err
+	}
+
 	r.wg.Add(1)
 	go r.networkHandler()
 
@@ -1153,6 +1183,15 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 				"chan_id=%v", msg.ChannelID)
 		}
 
+		// If the channel was previously pruned as a zombie, then we'll
+		// ignore its announcement until it's been marked as live
+		// again, which saves us from validating it on-chain.
+		isZombie, _, _ := r.cfg.Graph.IsZombieEdge(msg.ChannelID)
+		if isZombie {
+			return newErrf(ErrIgnored, "Ignoring msg for zombie "+
+				"chan_id=%v", msg.ChannelID)
+		}
+
 		// Before we can add the channel to the channel graph, we need
 		// to obtain the full funding outpoint that's encoded within
 		// the channel ID.
@@ -1307,6 +1346,21 @@ func (r *ChannelRouter) processUpdate(msg interface{}) error {
 			}
 		}
 
+		// If the channel is marked as a zombie in our database, then
+		// we cannot apply the policy, as the edge itself no longer
+		// exists. Fresh updates resurrect the channel by marking it as
+		// live before reaching this point.
+		if !exists {
+			isZombie, _, _ := r.cfg.Graph.IsZombieEdge(
+				msg.ChannelID,
+			)
+			if isZombie {
+				return newErrf(ErrIgnored, "Ignoring update "+
+					"(flags=%v) for zombie chan_id=%v",
+					msg.Flags, msg.ChannelID)
+			}
+		}
+
 		if !exists && !r.cfg.AssumeChannelValid {
 			// Before we can update the channel information, we'll
 			// ensure that the target channel is still open by
@@ -1628,12 +1682,104 @@ func (r *ChannelRouter) FindRoutes(target *btcec.PublicKey,
 	return validRoutes, nil
 }
 
+// FindCircularRoutes attempts to find a bounded number of routes which leave
+// our node through outgoingChan and return back to us through incomingChan,
+// carrying amt to ourselves. Such self-payments can be used to shift
+// liquidity between our own channels without touching the chain. As with
+// FindRoutes, the returned routes are sorted by their total fees, and routes
+// whose fees exceed the fee limit are discarded.
+func (r *ChannelRouter) FindCircularRoutes(outgoingChan, incomingChan uint64,
+	amt, feeLimit lnwire.MilliSatoshi, numPaths uint32,
+	finalExpiry ...uint16) ([]*Route, error) {
+
+	var finalCLTVDelta uint16
+	if len(finalExpiry) == 0 {
+		finalCLTVDelta = DefaultFinalCLTVDelta
+	} else {
+		finalCLTVDelta = finalExpiry[0]
+	}
+
+	log.Debugf("Searching for circular route out through %v and in "+
+		"through %v, sending %v", outgoingChan, incomingChan, amt)
+
+	// First, we'll fetch the incoming channel along with the policy our
+	// peer uses to forward payments across it to us.
+	edgeInfo, policy1, policy2, err := r.cfg.Graph.FetchChannelEdgesByID(
+		incomingChan,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var incomingPolicy *channeldb.ChannelEdgePolicy
+	switch {
+	case policy1 != nil && policy1.Node.PubKeyBytes == r.selfNode.PubKeyBytes:
+		incomingPolicy = policy1
+	case policy2 != nil && policy2.Node.PubKeyBytes == r.selfNode.PubKeyBytes:
+		incomingPolicy = policy2
+	default:
+		return nil, newErrf(ErrNoPathFound, "no policy found for "+
+			"incoming channel %v", incomingChan)
+	}
+
+	// We'll also fetch the current block height so we can properly
+	// calculate the required HTLC time locks within the route.
+	_, currentHeight, err := r.cfg.Chain.GetBestBlock()
+	if err != nil {
+		return nil, err
+	}
+
+	// The bandwidth hints of our local channels will be used to ensure
+	// the outgoing channel is actually able to carry the payment.
+	bandwidthHints, err := generateBandwidthHints(
+		r.selfNode, r.cfg.QueryBandwidth,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := r.cfg.Graph.Database().Begin(false)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	circularPaths, err := findCircularPaths(
+		tx, r.cfg.Graph, r.selfNode, outgoingChan, edgeInfo,
+		incomingPolicy, amt, feeLimit, numPaths, bandwidthHints,
+	)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	tx.Rollback()
+
+	sourceVertex := Vertex(r.selfNode.PubKeyBytes)
+	validRoutes, err := pathsToFeeSortedRoutes(
+		sourceVertex, circularPaths, finalCLTVDelta, amt, feeLimit,
+		uint32(currentHeight),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	go log.Tracef("Obtained %v circular routes sending %v: %v",
+		len(validRoutes), amt, newLogClosure(func() string {
+			return spew.Sdump(validRoutes)
+		}),
+	)
+
+	return validRoutes, nil
+}
+
 // generateSphinxPacket generates then encodes a sphinx packet which encodes
 // the onion route specified by the passed layer 3 route. The blob returned
 // from this function can immediately be included within an HTLC add packet to
-// be sent to the first hop within the route.
-func generateSphinxPacket(route *Route, paymentHash []byte) ([]byte,
-	*sphinx.Circuit, error) {
+// be sent to the first hop within the route. If a payment secret is passed,
+// then it's included within the payload of the final hop.
+func generateSphinxPacket(route *Route, paymentHash []byte,
+	paymentSecret *[32]byte) ([]byte, *sphinx.Circuit, error) {
 
 	// As a sanity check, we'll ensure that the set of hops has been
 	// properly filled in, otherwise, we won't actually be able to
@@ -1666,6 +1812,14 @@ func generateSphinxPacket(route *Route, paymentHash []byte) ([]byte,
 	// properly forward the payment.
 	hopPayloads := route.ToHopPayloads()
 
+	// If the payee gave us a payment secret, we'll include it within the
+	// payload of the final hop, as it doesn't forward the payment any
+	// further.
+	if paymentSecret != nil {
+		secret := htlcswitch.NewPaymentSecret(*paymentSecret)
+		secret.Encode(&hopPayloads[len(hopPayloads)-1])
+	}
+
 	log.Tracef("Constructed per-hop payloads for payment_hash=%x: %v",
 		paymentHash[:], newLogClosure(func() string {
 			return spew.Sdump(hopPayloads)
@@ -1750,6 +1904,12 @@ type LightningPayment struct {
 	// destination successfully.
 	RouteHints [][]HopHint
 
+	// PaymentSecret is the payment secret of the invoice being paid,
+	// which will be included within the onion payload of the final hop.
+	//
+	// NOTE: This is optional unless required by the invoice.
+	PaymentSecret *[32]byte
+
 	// TODO(roasbeef): add e2e message?
 }
 
@@ -2007,6 +2167,11 @@ type SpiderRouteInfo struct {
 	dataMutex     *sync.Mutex
 	waitTime      float64
 	pathId        int
+
+	// amtSent is the total amount successfully sent over this path. It
+	// is guarded by statsMutex, and unlike the marking statistics never
+	// reset.
+	amtSent lnwire.MilliSatoshi
 }
 
 // startLPRoute handles a path.
@@ -2062,6 +2227,11 @@ func (r *ChannelRouter) startLPRoute(dest Vertex, route *Route, pathID uint32, n
 					// parallel.
 					go func(route *Route, payment SpiderPayment) {
 						preImage, route, err, _ := r.SendToRoute([]*Route{route}, payment.payment)
+						if err == nil {
+							path.statsMutex.Lock()
+							path.amtSent += payment.payment.Amount
+							path.statsMutex.Unlock()
+						}
 
 						// return result through the channel
 						result := SpiderPaymentResult{
@@ -2199,6 +2369,38 @@ func (r *ChannelRouter) handleDCTCPPaymentToDest(dest Vertex, payment SpiderPaym
 	return
 }
 
+// SpiderPathUsage returns, for each node on any of our Spider paths, the
+// total amount we successfully sent over the paths traversing it. The
+// destinations of the paths are included.
+func (r *ChannelRouter) SpiderPathUsage() map[Vertex]lnwire.MilliSatoshi {
+	r.missionControl.SpiderRouteInfoMutex.Lock()
+	defer r.missionControl.SpiderRouteInfoMutex.Unlock()
+
+	usage := make(map[Vertex]lnwire.MilliSatoshi)
+	for _, paths := range r.missionControl.SpiderRouteInfoPerDest {
+		for _, pathInfo := range *paths {
+			if pathInfo.route == nil {
+				continue
+			}
+
+			pathInfo.statsMutex.Lock()
+			amtSent := pathInfo.amtSent
+			pathInfo.statsMutex.Unlock()
+
+			if amtSent == 0 {
+				continue
+			}
+
+			for _, hop := range pathInfo.route.Hops {
+				node := Vertex(hop.Channel.Node.PubKeyBytes)
+				usage[node] += amtSent
+			}
+		}
+	}
+
+	return usage
+}
+
 // function to periodically log all window/inflight/marked packets info for this scheme
 // must be run as a goroutine
 func (r *ChannelRouter) periodicLogging() {
@@ -2236,6 +2438,9 @@ func (r *ChannelRouter) sendDCTCPPaymentOnPath(pathInfo *SpiderRouteInfo, paymen
 		pathInfo.markedPackets += 1
 	}
 	pathInfo.totalPackets += 1
+	if err == nil {
+		pathInfo.amtSent += payment.payment.Amount
+	}
 	pathInfo.statsMutex.Unlock()
 
 	// return result through the channel
@@ -2603,6 +2808,41 @@ func (r *ChannelRouter) getKShortestPaths(dest Vertex, payment *LightningPayment
 func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 	paySession *paymentSession) ([32]byte, *Route, error, uint32) {
 
+	// We'll track the lifecycle of the payment within its persisted
+	// history, such that every attempt made can be inspected later on.
+	tracked := r.initPaymentHistory(payment)
+
+	preImage, route, err, marked := r.dispatchPayment(
+		payment, paySession, tracked,
+	)
+	if err != nil && tracked {
+		switch err {
+		// If another payment to the same hash is still in flight, or
+		// the switch exited while our HTLC may still be in flight,
+		// then the outcome of the payment isn't known yet.
+		case htlcswitch.ErrPaymentInFlight, htlcswitch.ErrSwitchExiting:
+
+		// If it was already paid, then it certainly didn't fail, and
+		// there's nothing left in flight.
+		case htlcswitch.ErrAlreadyPaid:
+			r.completePaymentHistory(payment.PaymentHash)
+
+		default:
+			r.failPaymentHistory(payment.PaymentHash)
+		}
+	}
+
+	return preImage, route, err, marked
+}
+
+// dispatchPayment carries out the payment loop of sendPayment, attempting each
+// route offered by the payment session until the payment succeeds or a
+// critical error is encountered. If tracked is true, each attempt is recorded
+// within the history of the payment.
+func (r *ChannelRouter) dispatchPayment(payment *LightningPayment,
+	paySession *paymentSession, tracked bool) ([32]byte, *Route, error,
+	uint32) {
+
 	dest := NewVertex(payment.Target)
 	log.Errorf("Spider: info_type: payment_attempted, time: %d, sender: %v, dest: %v",
 		int32(time.Now().Unix()), r.nodeName, dest)
@@ -2710,7 +2950,7 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 		// with the htlcAdd message that we send directly to the
 		// switch.
 		onionBlob, circuit, err := generateSphinxPacket(
-			route, payment.PaymentHash[:],
+			route, payment.PaymentHash[:], payment.PaymentSecret,
 		)
 		if err != nil {
 			return preImage, nil, err, marked
@@ -2735,9 +2975,34 @@ func (r *ChannelRouter) sendPayment(payment *LightningPayment,
 		firstHop := lnwire.NewShortChanIDFromInt(
 			route.Hops[0].Channel.ChannelID,
 		)
+
+		// We'll record the attempt before dispatching it, such that
+		// it can still be resolved if we restart while it's in
+		// flight.
+		var attempt *channeldb.PaymentAttempt
+		if tracked {
+			attempt, err = r.registerPaymentAttempt(
+				payment.PaymentHash, route,
+			)
+			if err != nil {
+				log.Errorf("Unable to record attempt of "+
+					"payment %x: %v", payment.PaymentHash,
+					err)
+			}
+		}
+
 		preImage, sendError, marked = r.cfg.SendToSwitch(
 			firstHop, htlcAdd, circuit,
 		)
+
+		// If the switch is exiting, our HTLC may still be in flight,
+		// so we'll leave the attempt to be resolved after restart.
+		if attempt != nil && sendError != htlcswitch.ErrSwitchExiting {
+			r.resolvePaymentAttempt(
+				payment.PaymentHash, attempt, preImage,
+				sendError,
+			)
+		}
 		if sendError != nil {
 			// An error occurred when attempting to send the
 			// payment, depending on the error type, we'll either
@@ -3219,7 +3484,14 @@ func (r *ChannelRouter) IsStaleNode(node Vertex, timestamp time.Time) bool {
 // NOTE: This method is part of the ChannelGraphSource interface.
 func (r *ChannelRouter) IsKnownEdge(chanID lnwire.ShortChannelID) bool {
 	_, _, exists, _ := r.cfg.Graph.HasChannelEdge(chanID.ToUint64())
-	return exists
+	if exists {
+		return true
+	}
+
+	// Channels that were pruned as zombies are known to us as well, as we
+	// don't want to revalidate them until they've been marked as live.
+	isZombie, _, _ := r.cfg.Graph.IsZombieEdge(chanID.ToUint64())
+	return isZombie
 }
 
 // IsStaleEdgePolicy returns true if the graph soruce has a channel edge for
@@ -3238,8 +3510,15 @@ func (r *ChannelRouter) IsStaleEdgePolicy(chanID lnwire.ShortChannelID,
 	}
 
 	// If we don't know of the edge, then it means it's fresh (thus not
-	// stale).
+	// stale), unless we've previously pruned it as a zombie. In that case,
+	// only policies recent enough to not have been pruned are considered
+	// fresh, as they may resurrect the channel.
 	if !exists {
+		isZombie, _, _ := r.cfg.Graph.IsZombieEdge(chanID.ToUint64())
+		if isZombie {
+			return time.Since(timestamp) > r.cfg.ChannelPruneExpiry
+		}
+
 		return false
 	}
 
@@ -3262,3 +3541,17 @@ func (r *ChannelRouter) IsStaleEdgePolicy(chanID lnwire.ShortChannelID,
 
 	return false
 }
+
+// MarkEdgeLive clears an edge from our zombie index, deeming it as live.
+//
+// NOTE: This method is part of the ChannelGraphSource interface.
+func (r *ChannelRouter) MarkEdgeLive(chanID lnwire.ShortChannelID) error {
+	// As zombie channels are also added to our reject cache when pruned,
+	// we'll remove it from there as well, such that its announcement can
+	// be processed once again.
+	r.rejectMtx.Lock()
+	delete(r.rejectCache, chanID.ToUint64())
+	r.rejectMtx.Unlock()
+
+	return r.cfg.Graph.MarkEdgeLive(chanID.ToUint64())
+}
@@ -920,6 +920,322 @@ func TestSendPaymentErrorPathPruning(t *testing.T) {
 	}
 }
 
+// TestSendPaymentHistory asserts that each attempt made to complete a payment
+// is recorded within the history of the payment, along with its outcome.
+func TestSendPaymentHistory(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(startingBlockHeight, basicGraphFilePath)
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+
+	// Craft a LightningPayment struct that'll send a payment from roasbeef
+	// to luo ji for 1000 satoshis.
+	var payHash [32]byte
+	copy(payHash[:], bytes.Repeat([]byte{1}, 32))
+	paymentAmt := lnwire.NewMSatFromSatoshis(1000)
+	payment := LightningPayment{
+		Target:      ctx.aliases["luoji"],
+		Amount:      paymentAmt,
+		FeeLimit:    noFeeLimit,
+		PaymentHash: payHash,
+	}
+
+	var preImage [32]byte
+	copy(preImage[:], bytes.Repeat([]byte{9}, 32))
+
+	sourceNode, err := ctx.graph.SourceNode()
+	if err != nil {
+		t.Fatalf("unable to fetch source node: %v", err)
+	}
+
+	sourcePub, err := sourceNode.PubKey()
+	if err != nil {
+		t.Fatalf("unable to fetch source node pub: %v", err)
+	}
+
+	// We'll fail the direct channel to luo ji, forcing the payment to be
+	// retried through satoshi.
+	roasbeefLuoji := lnwire.NewShortChanIDFromInt(689530843)
+	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
+		_ *lnwire.UpdateAddHTLC,
+		_ *sphinx.Circuit) ([32]byte, error, uint32) {
+
+		if firstHop == roasbeefLuoji {
+			return [32]byte{}, &htlcswitch.ForwardingError{
+				ErrorSource:    sourcePub,
+				FailureMessage: &lnwire.FailUnknownNextPeer{},
+			}, 0
+		}
+
+		return preImage, nil, 0
+	}
+
+	// A tracking client subscribed before the payment is sent shouldn't
+	// be able to find it.
+	_, _, err = ctx.router.SubscribePayment(payHash)
+	if err != channeldb.ErrPaymentHistoryNotFound {
+		t.Fatalf("expected ErrPaymentHistoryNotFound, got %v", err)
+	}
+
+	if _, _, err := ctx.router.SendPayment(&payment); err != nil {
+		t.Fatalf("unable to send payment: %v", err)
+	}
+
+	history, subscription, err := ctx.router.SubscribePayment(payHash)
+	if err != nil {
+		t.Fatalf("unable to subscribe to payment: %v", err)
+	}
+	defer subscription.Cancel()
+
+	if history.Status != channeldb.StatusCompleted {
+		t.Fatalf("expected payment to be completed, instead it's %v",
+			history.Status)
+	}
+	if len(history.Attempts) != 2 {
+		t.Fatalf("expected 2 attempts, got %v", len(history.Attempts))
+	}
+
+	// The first attempt should have failed at our own node.
+	failed := history.Attempts[0]
+	if failed.State != channeldb.AttemptFailed {
+		t.Fatalf("expected first attempt to fail, instead it's %v",
+			failed.State)
+	}
+	if failed.FailureSourceIdx != 0 {
+		t.Fatalf("expected failure source index 0, got %v",
+			failed.FailureSourceIdx)
+	}
+	if failed.FailureCode != lnwire.CodeUnknownNextPeer {
+		t.Fatalf("expected failure code %v, got %v",
+			lnwire.CodeUnknownNextPeer, failed.FailureCode)
+	}
+	if failed.Hops[0].ChannelID != roasbeefLuoji.ToUint64() {
+		t.Fatalf("expected first attempt through channel %v, got %v",
+			roasbeefLuoji, failed.Hops[0].ChannelID)
+	}
+
+	// The second attempt should have succeeded through satoshi.
+	settled := history.Attempts[1]
+	if settled.State != channeldb.AttemptSucceeded {
+		t.Fatalf("expected second attempt to succeed, instead it's %v",
+			settled.State)
+	}
+	if settled.Preimage != preImage {
+		t.Fatalf("incorrect preimage recorded: expected %x got %x",
+			preImage[:], settled.Preimage[:])
+	}
+	if len(settled.Hops) != 2 {
+		t.Fatalf("incorrect route length: expected %v got %v", 2,
+			len(settled.Hops))
+	}
+	satoshiPub := ctx.aliases["satoshi"].SerializeCompressed()
+	if !bytes.Equal(settled.Hops[0].PubKey[:], satoshiPub) {
+		t.Fatalf("route should go through satoshi as first hop")
+	}
+}
+
+// TestSendPaymentHistoryFailed asserts that a payment is marked as failed
+// within its history once all attempts to complete it have failed.
+func TestSendPaymentHistoryFailed(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(startingBlockHeight, basicGraphFilePath)
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+
+	var payHash [32]byte
+	copy(payHash[:], bytes.Repeat([]byte{2}, 32))
+	payment := LightningPayment{
+		Target:      ctx.aliases["luoji"],
+		Amount:      lnwire.NewMSatFromSatoshis(1000),
+		FeeLimit:    noFeeLimit,
+		PaymentHash: payHash,
+	}
+
+	// We'll have luo ji reject the payment hash, which should cause the
+	// payment to fail after the very first attempt.
+	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
+		_ *lnwire.UpdateAddHTLC,
+		_ *sphinx.Circuit) ([32]byte, error, uint32) {
+
+		return [32]byte{}, &htlcswitch.ForwardingError{
+			ErrorSource:    ctx.aliases["luoji"],
+			FailureMessage: &lnwire.FailUnknownPaymentHash{},
+		}, 0
+	}
+
+	if _, _, err := ctx.router.SendPayment(&payment); err == nil {
+		t.Fatalf("payment didn't return error")
+	}
+
+	history, err := ctx.graph.Database().FetchPaymentHistory(payHash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment history: %v", err)
+	}
+	if history.Status != channeldb.StatusFailed {
+		t.Fatalf("expected payment to be failed, instead it's %v",
+			history.Status)
+	}
+	if len(history.Attempts) != 1 {
+		t.Fatalf("expected 1 attempt, got %v", len(history.Attempts))
+	}
+
+	// Luo ji is reached by the final hop of the direct route, so it should
+	// be recorded as the source of the failure.
+	attempt := history.Attempts[0]
+	if attempt.FailureSourceIdx != uint32(len(attempt.Hops)) {
+		t.Fatalf("expected failure source index %v, got %v",
+			len(attempt.Hops), attempt.FailureSourceIdx)
+	}
+	if attempt.FailureCode != lnwire.CodeUnknownPaymentHash {
+		t.Fatalf("expected failure code %v, got %v",
+			lnwire.CodeUnknownPaymentHash, attempt.FailureCode)
+	}
+}
+
+// TestSendPaymentHistoryAlreadyPaid asserts that a payment the switch reports
+// as already paid is marked as completed within its history, rather than being
+// left in flight.
+func TestSendPaymentHistoryAlreadyPaid(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(startingBlockHeight, basicGraphFilePath)
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+
+	var payHash [32]byte
+	copy(payHash[:], bytes.Repeat([]byte{3}, 32))
+	payment := LightningPayment{
+		Target:      ctx.aliases["luoji"],
+		Amount:      lnwire.NewMSatFromSatoshis(1000),
+		FeeLimit:    noFeeLimit,
+		PaymentHash: payHash,
+	}
+
+	ctx.router.cfg.SendToSwitch = func(firstHop lnwire.ShortChannelID,
+		_ *lnwire.UpdateAddHTLC,
+		_ *sphinx.Circuit) ([32]byte, error, uint32) {
+
+		return [32]byte{}, htlcswitch.ErrAlreadyPaid, 0
+	}
+
+	_, _, err = ctx.router.SendPayment(&payment)
+	if err != htlcswitch.ErrAlreadyPaid {
+		t.Fatalf("expected ErrAlreadyPaid, got %v", err)
+	}
+
+	history, err := ctx.graph.Database().FetchPaymentHistory(payHash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment history: %v", err)
+	}
+	if history.Status != channeldb.StatusCompleted {
+		t.Fatalf("expected payment to be completed, instead it's %v",
+			history.Status)
+	}
+}
+
+// TestResumePayments asserts that upon startup, payments whose HTLC is no
+// longer in flight within the switch are marked as failed, while those that
+// are still in flight are left to be resolved by the switch.
+func TestResumePayments(t *testing.T) {
+	t.Parallel()
+
+	const startingBlockHeight = 101
+	ctx, cleanUp, err := createTestCtxFromFile(startingBlockHeight, basicGraphFilePath)
+	defer cleanUp()
+	if err != nil {
+		t.Fatalf("unable to create router: %v", err)
+	}
+
+	// We'll track two payments, each with a single attempt in flight, as
+	// if the switch exited while they were being sent.
+	var lostHash, inFlightHash [32]byte
+	copy(lostHash[:], bytes.Repeat([]byte{3}, 32))
+	copy(inFlightHash[:], bytes.Repeat([]byte{4}, 32))
+
+	db := ctx.graph.Database()
+	for _, payHash := range [][32]byte{lostHash, inFlightHash} {
+		_, err := db.InitPaymentHistory(
+			payHash, [33]byte{}, lnwire.NewMSatFromSatoshis(1000),
+		)
+		if err != nil {
+			t.Fatalf("unable to init payment history: %v", err)
+		}
+
+		attempt := &channeldb.PaymentAttempt{
+			TotalAmount: lnwire.NewMSatFromSatoshis(1000),
+			AttemptTime: time.Now(),
+		}
+		_, err = db.RegisterPaymentAttempt(payHash, attempt)
+		if err != nil {
+			t.Fatalf("unable to register attempt: %v", err)
+		}
+	}
+
+	// Only the HTLC of the second payment made it out of our node.
+	ctx.router.cfg.PaymentInFlight = func(payHash [32]byte) bool {
+		return payHash == inFlightHash
+	}
+
+	if err := ctx.router.resumePayments(); err != nil {
+		t.Fatalf("unable to resume payments: %v", err)
+	}
+
+	history, err := db.FetchPaymentHistory(lostHash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment history: %v", err)
+	}
+	if history.Status != channeldb.StatusFailed {
+		t.Fatalf("expected lost payment to be failed, instead it's %v",
+			history.Status)
+	}
+	if history.Attempts[0].State != channeldb.AttemptFailed {
+		t.Fatalf("expected lost attempt to be failed, instead it's %v",
+			history.Attempts[0].State)
+	}
+
+	history, err = db.FetchPaymentHistory(inFlightHash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment history: %v", err)
+	}
+	if history.Status != channeldb.StatusInFlight {
+		t.Fatalf("expected payment to be in flight, instead it's %v",
+			history.Status)
+	}
+	if history.Attempts[0].State != channeldb.AttemptInFlight {
+		t.Fatalf("expected attempt to be in flight, instead it's %v",
+			history.Attempts[0].State)
+	}
+
+	// Once the switch reports the outcome of the remaining HTLC, the
+	// payment should complete.
+	var preimage [32]byte
+	copy(preimage[:], bytes.Repeat([]byte{5}, 32))
+	err = ctx.router.ResolveOrphanedPayment(inFlightHash, preimage, nil)
+	if err != nil {
+		t.Fatalf("unable to resolve orphaned payment: %v", err)
+	}
+
+	history, err = db.FetchPaymentHistory(inFlightHash)
+	if err != nil {
+		t.Fatalf("unable to fetch payment history: %v", err)
+	}
+	if history.Status != channeldb.StatusCompleted {
+		t.Fatalf("expected payment to be completed, instead it's %v",
+			history.Status)
+	}
+}
+
 // TestSendSpiderShortestPathSucceed checks that the function picks the shortest path and use it
 // to send payment
 func TestSendSpiderShortestPathSucceed(t *testing.T) {
@@ -2381,7 +2697,7 @@ func TestEmptyRoutesGenerateSphinxPacket(t *testing.T) {
 	t.Parallel()
 
 	emptyRoute := &Route{}
-	_, _, err := generateSphinxPacket(emptyRoute, testHash[:])
+	_, _, err := generateSphinxPacket(emptyRoute, testHash[:], nil)
 	if err != ErrNoRouteHopsProvided {
 		t.Fatalf("expected empty hops error: instead got: %v", err)
 	}
@@ -10,6 +10,7 @@ import (
 	"io"
 	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -24,13 +25,19 @@ import (
 	"github.com/btcsuite/btcwallet/waddrmgr"
 	"github.com/coreos/bbolt"
 	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/autopilot"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
+	"github.com/lightningnetwork/lnd/discovery"
 	"github.com/lightningnetwork/lnd/htlcswitch"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/macaroons"
 	"github.com/lightningnetwork/lnd/routing"
 	"github.com/lightningnetwork/lnd/signal"
+	"github.com/lightningnetwork/lnd/sweep"
 	"github.com/lightningnetwork/lnd/zpay32"
 	"github.com/tv42/zbase32"
 	"golang.org/x/net/context"
@@ -89,6 +96,10 @@ var (
 			Entity: "invoices",
 			Action: "read",
 		},
+		{
+			Entity: "macaroon",
+			Action: "read",
+		},
 	}
 
 	// writePermissions is a slice of all entities that allow write
@@ -122,6 +133,14 @@ var (
 			Entity: "invoices",
 			Action: "write",
 		},
+		{
+			Entity: "macaroon",
+			Action: "generate",
+		},
+		{
+			Entity: "macaroon",
+			Action: "write",
+		},
 	}
 
 	// invoicePermissions is a slice of all the entities that allows a user
@@ -156,6 +175,22 @@ var (
 			Entity: "onchain",
 			Action: "write",
 		}},
+		"/lnrpc.Lightning/EstimateFee": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/LeaseOutput": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ReleaseOutput": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ListLeases": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/NewAddress": {{
 			Entity: "address",
 			Action: "write",
@@ -209,6 +244,10 @@ var (
 			Entity: "peers",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/ListPeerAddresses": {{
+			Entity: "peers",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/WalletBalance": {{
 			Entity: "onchain",
 			Action: "read",
@@ -265,6 +304,10 @@ var (
 			Entity: "onchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/GetRecoveryInfo": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
 		"/lnrpc.Lightning/GetTransactions": {{
 			Entity: "onchain",
 			Action: "read",
@@ -325,6 +368,71 @@ var (
 			Entity: "offchain",
 			Action: "read",
 		}},
+		"/lnrpc.Lightning/Rebalance": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/TrackPayment": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/SubscribeHtlcEvents": {{
+			Entity: "offchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/HtlcInterceptor": {{
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/ChannelAcceptor": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/PendingSweeps": {{
+			Entity: "onchain",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/SweepOutputs": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/BumpFee": {{
+			Entity: "onchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/AutopilotStatus": {{
+			Entity: "info",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/ModifyAutopilotStatus": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/SetAutopilotScores": {{
+			Entity: "onchain",
+			Action: "write",
+		}, {
+			Entity: "offchain",
+			Action: "write",
+		}},
+		"/lnrpc.Lightning/BakeMacaroon": {{
+			Entity: "macaroon",
+			Action: "generate",
+		}},
+		"/lnrpc.Lightning/ListMacaroonIDs": {{
+			Entity: "macaroon",
+			Action: "read",
+		}},
+		"/lnrpc.Lightning/DeleteMacaroonID": {{
+			Entity: "macaroon",
+			Action: "write",
+		}},
 	}
 )
 
@@ -336,6 +444,13 @@ type rpcServer struct {
 
 	server *server
 
+	// pilot allows the autopilot agent to be managed at runtime.
+	pilot *autopilotManager
+
+	// macService is the macaroon service used to bake new macaroons. It's
+	// nil if macaroons are disabled.
+	macService *macaroons.Service
+
 	wg sync.WaitGroup
 
 	quit chan struct{}
@@ -346,10 +461,14 @@ type rpcServer struct {
 var _ lnrpc.LightningServer = (*rpcServer)(nil)
 
 // newRPCServer creates and returns a new instance of the rpcServer.
-func newRPCServer(s *server) *rpcServer {
+func newRPCServer(s *server, pilot *autopilotManager,
+	macService *macaroons.Service) *rpcServer {
+
 	return &rpcServer{
-		server: s,
-		quit:   make(chan struct{}, 1),
+		server:     s,
+		pilot:      pilot,
+		macService: macService,
+		quit:       make(chan struct{}, 1),
 	}
 }
 
@@ -398,16 +517,122 @@ func addrPairsToOutputs(addrPairs map[string]int64) ([]*wire.TxOut, error) {
 
 // sendCoinsOnChain makes an on-chain transaction in or to send coins to one or
 // more addresses specified in the passed payment map. The payment map maps an
-// address to a specified output value to be sent to that address.
+// address to a specified output value to be sent to that address. If any
+// outpoints are passed, then the transaction only spends from those, with its
+// inputs selected according to the passed coin selection strategy. Outpoints
+// leased under the passed lock ID may be spent as well.
 func (r *rpcServer) sendCoinsOnChain(paymentMap map[string]int64,
-	feeRate lnwallet.SatPerKWeight) (*chainhash.Hash, error) {
+	feeRate lnwallet.SatPerKWeight, outpoints []*lnrpc.OutPoint,
+	rpcLockID []byte,
+	rpcStrategy lnrpc.CoinSelectionStrategy) (*chainhash.Hash, error) {
 
 	outputs, err := addrPairsToOutputs(paymentMap)
 	if err != nil {
 		return nil, err
 	}
 
-	return r.server.cc.wallet.SendOutputs(outputs, feeRate)
+	ops, err := unmarshalOutPoints(outpoints)
+	if err != nil {
+		return nil, err
+	}
+	lockID, err := unmarshalOptionalLockID(rpcLockID, ops)
+	if err != nil {
+		return nil, err
+	}
+	strategy, err := unmarshalCoinSelectionStrategy(rpcStrategy)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unless the caller wishes to control coin selection, we'll let the
+	// wallet fund the transaction itself.
+	wallet := r.server.cc.wallet
+	if len(ops) == 0 && strategy == lnwallet.CoinSelectionDefault {
+		return wallet.SendOutputs(outputs, feeRate)
+	}
+
+	tx, err := wallet.SendOutputsWithCoinSelect(
+		outputs, feeRate, 1, ops, lockID, strategy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	txid := tx.TxHash()
+	return &txid, nil
+}
+
+// unmarshalOutPoint converts an outpoint from its RPC representation.
+func unmarshalOutPoint(op *lnrpc.OutPoint) (wire.OutPoint, error) {
+	if op == nil {
+		return wire.OutPoint{}, errors.New("outpoint not set")
+	}
+
+	var (
+		txid *chainhash.Hash
+		err  error
+	)
+	switch {
+	case len(op.TxidBytes) != 0:
+		txid, err = chainhash.NewHash(op.TxidBytes)
+	case op.TxidStr != "":
+		txid, err = chainhash.NewHashFromStr(op.TxidStr)
+	default:
+		err = errors.New("txid of outpoint not set")
+	}
+	if err != nil {
+		return wire.OutPoint{}, err
+	}
+
+	return wire.OutPoint{
+		Hash:  *txid,
+		Index: op.OutputIndex,
+	}, nil
+}
+
+// unmarshalOutPoints converts a set of outpoints from their RPC
+// representation.
+func unmarshalOutPoints(ops []*lnrpc.OutPoint) ([]wire.OutPoint, error) {
+	outpoints := make([]wire.OutPoint, 0, len(ops))
+	for _, op := range ops {
+		outpoint, err := unmarshalOutPoint(op)
+		if err != nil {
+			return nil, err
+		}
+		outpoints = append(outpoints, outpoint)
+	}
+
+	return outpoints, nil
+}
+
+// marshalOutPoint converts an outpoint to its RPC representation.
+func marshalOutPoint(op wire.OutPoint) *lnrpc.OutPoint {
+	return &lnrpc.OutPoint{
+		TxidBytes:   op.Hash[:],
+		TxidStr:     op.Hash.String(),
+		OutputIndex: op.Index,
+	}
+}
+
+// unmarshalCoinSelectionStrategy converts a coin selection strategy from its
+// RPC representation.
+func unmarshalCoinSelectionStrategy(
+	strategy lnrpc.CoinSelectionStrategy) (lnwallet.CoinSelectionStrategy,
+	error) {
+
+	switch strategy {
+	case lnrpc.CoinSelectionStrategy_DEFAULT:
+		return lnwallet.CoinSelectionDefault, nil
+	case lnrpc.CoinSelectionStrategy_LARGEST:
+		return lnwallet.CoinSelectionLargest, nil
+	case lnrpc.CoinSelectionStrategy_RANDOM:
+		return lnwallet.CoinSelectionRandom, nil
+	case lnrpc.CoinSelectionStrategy_BNB:
+		return lnwallet.CoinSelectionBnB, nil
+	default:
+		return 0, fmt.Errorf("unknown coin selection strategy: %v",
+			strategy)
+	}
 }
 
 // determineFeePerKw will determine the fee in sat/kw that should be paid given
@@ -474,7 +699,10 @@ func (r *rpcServer) SendCoins(ctx context.Context,
 		btcutil.Amount(in.Amount), int64(feePerKw))
 
 	paymentMap := map[string]int64{in.Addr: in.Amount}
-	txid, err := r.sendCoinsOnChain(paymentMap, feePerKw)
+	txid, err := r.sendCoinsOnChain(
+		paymentMap, feePerKw, in.Outpoints, in.LockId,
+		in.CoinSelectionStrategy,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -501,7 +729,10 @@ func (r *rpcServer) SendMany(ctx context.Context,
 	rpcsLog.Infof("[sendmany] outputs=%v, sat/kw=%v",
 		spew.Sdump(in.AddrToAmount), int64(feePerKw))
 
-	txid, err := r.sendCoinsOnChain(in.AddrToAmount, feePerKw)
+	txid, err := r.sendCoinsOnChain(
+		in.AddrToAmount, feePerKw, in.Outpoints, in.LockId,
+		in.CoinSelectionStrategy,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -816,6 +1047,24 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 	rpcsLog.Debugf("[openchannel]: using fee of %v sat/kw for funding tx",
 		int64(feeRate))
 
+	// Parse the outputs the funding transaction should be funded from, if
+	// any, along with the lock ID they're leased under and the coin
+	// selection strategy.
+	outpoints, err := unmarshalOutPoints(in.Outpoints)
+	if err != nil {
+		return err
+	}
+	lockID, err := unmarshalOptionalLockID(in.LockId, outpoints)
+	if err != nil {
+		return err
+	}
+	strategy, err := unmarshalCoinSelectionStrategy(
+		in.CoinSelectionStrategy,
+	)
+	if err != nil {
+		return err
+	}
+
 	// Instruct the server to trigger the necessary events to attempt to
 	// open a new channel. A stream is returned in place, this stream will
 	// be used to consume updates of the state of the pending channel.
@@ -829,6 +1078,10 @@ func (r *rpcServer) OpenChannel(in *lnrpc.OpenChannelRequest,
 		private:         in.Private,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        in.MinConfs,
+
+		outpoints:             outpoints,
+		lockID:                lockID,
+		coinSelectionStrategy: strategy,
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -961,6 +1214,24 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 	rpcsLog.Tracef("[openchannel] target sat/kw for funding tx: %v",
 		int64(feeRate))
 
+	// Parse the outputs the funding transaction should be funded from, if
+	// any, along with the lock ID they're leased under and the coin
+	// selection strategy.
+	outpoints, err := unmarshalOutPoints(in.Outpoints)
+	if err != nil {
+		return nil, err
+	}
+	lockID, err := unmarshalOptionalLockID(in.LockId, outpoints)
+	if err != nil {
+		return nil, err
+	}
+	strategy, err := unmarshalCoinSelectionStrategy(
+		in.CoinSelectionStrategy,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	req := &openChanReq{
 		targetPubkey:    nodepubKey,
 		chainHash:       *activeNetParams.GenesisHash,
@@ -971,6 +1242,10 @@ func (r *rpcServer) OpenChannelSync(ctx context.Context,
 		private:         in.Private,
 		remoteCsvDelay:  remoteCsvDelay,
 		minConfs:        in.MinConfs,
+
+		outpoints:             outpoints,
+		lockID:                lockID,
+		coinSelectionStrategy: strategy,
 	}
 
 	updateChan, errChan := r.server.OpenChannel(req)
@@ -1340,6 +1615,58 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 			PingTime:  serverPeer.PingTime(),
 		}
 
+		// Report the statistics of the encrypted connection, which
+		// help to debug the health of the connection.
+		if stats := serverPeer.ConnStats(); stats != nil {
+			handshakeDuration := stats.HandshakeDuration /
+				time.Microsecond
+
+			peer.ConnectionStats = &lnrpc.ConnectionStats{
+				BytesSent:           stats.BytesSent,
+				BytesRecv:           stats.BytesRecv,
+				MsgsSent:            stats.MsgsSent,
+				MsgsRecv:            stats.MsgsRecv,
+				SendKeyRotations:    stats.SendKeyRotations,
+				RecvKeyRotations:    stats.RecvKeyRotations,
+				DecryptFailures:     stats.DecryptFailures,
+				HandshakeDurationUs: int64(handshakeDuration),
+			}
+		}
+
+		for _, traffic := range serverPeer.MsgTraffic() {
+			class := classifyMsg(traffic.msgType)
+			peer.MessageStats = append(
+				peer.MessageStats, &lnrpc.MessageTypeStats{
+					Type:          traffic.msgType.String(),
+					TypeId:        uint32(traffic.msgType),
+					PriorityClass: class.String(),
+					MsgsSent:      traffic.msgsSent,
+					BytesSent:     traffic.bytesSent,
+					MsgsRecv:      traffic.msgsRecv,
+					BytesRecv:     traffic.bytesRecv,
+				},
+			)
+		}
+
+		// If the peer supports gossip queries, we'll also report the
+		// status of its gossip syncer.
+		syncMgr := r.server.authGossiper.SyncManager()
+		status, ok := syncMgr.SyncStatus(serverPeer.PubKey())
+		if ok {
+			switch status.SyncType {
+			case discovery.ActiveSync:
+				peer.SyncType = lnrpc.Peer_ACTIVE_SYNC
+			case discovery.PassiveSync:
+				peer.SyncType = lnrpc.Peer_PASSIVE_SYNC
+			}
+
+			peer.SyncState = status.State
+			if !status.LastHistoricalSync.IsZero() {
+				peer.LastHistoricalSync =
+					status.LastHistoricalSync.Unix()
+			}
+		}
+
 		resp.Peers = append(resp.Peers, peer)
 	}
 
@@ -1348,6 +1675,82 @@ func (r *rpcServer) ListPeers(ctx context.Context,
 	return resp, nil
 }
 
+// ListPeerAddresses returns the address book of each persistent peer we've
+// attempted to connect to, recording the outcome of the connection attempts
+// made to each of its addresses, along with the backoff used to reconnect to
+// the peer.
+func (r *rpcServer) ListPeerAddresses(ctx context.Context,
+	in *lnrpc.ListPeerAddressesRequest) (*lnrpc.ListPeerAddressesResponse,
+	error) {
+
+	rpcsLog.Tracef("[listpeeraddresses] request for pubkey %v", in.PubKey)
+
+	var addrBooks []*channeldb.PeerAddrBook
+	if in.PubKey != "" {
+		pubKeyBytes, err := hex.DecodeString(in.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		pubKey, err := btcec.ParsePubKey(pubKeyBytes, btcec.S256())
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse pubkey: %v",
+				err)
+		}
+
+		addrBook, err := r.server.chanDB.FetchPeerAddrBook(pubKey)
+		if err != nil {
+			return nil, err
+		}
+		addrBooks = append(addrBooks, addrBook)
+	} else {
+		var err error
+		addrBooks, err = r.server.chanDB.FetchAllPeerAddrBooks()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &lnrpc.ListPeerAddressesResponse{
+		Peers: make([]*lnrpc.PeerAddressBook, 0, len(addrBooks)),
+	}
+	for _, addrBook := range addrBooks {
+		rpcBook := &lnrpc.PeerAddressBook{
+			PubKey:    hex.EncodeToString(addrBook.PubKey[:]),
+			BackoffMs: int64(addrBook.Backoff / time.Millisecond),
+			Addresses: make(
+				[]*lnrpc.PeerAddress, 0, len(addrBook.Addrs),
+			),
+		}
+
+		for _, stats := range addrBook.Addrs {
+			latency := stats.Latency / time.Microsecond
+			rpcAddr := &lnrpc.PeerAddress{
+				Address:     stats.Address.String(),
+				Successes:   stats.Successes,
+				Failures:    stats.Failures,
+				LatencyUs:   int64(latency),
+				LastAttempt: stats.LastAttempt.Unix(),
+				Score:       scorePeerAddr(stats),
+			}
+			if !stats.LastSeen.IsZero() {
+				rpcAddr.LastSeen = stats.LastSeen.Unix()
+			}
+
+			rpcBook.Addresses = append(rpcBook.Addresses, rpcAddr)
+		}
+
+		// List the addresses from the most to the least reliable.
+		sort.SliceStable(rpcBook.Addresses, func(i, j int) bool {
+			return rpcBook.Addresses[i].Score >
+				rpcBook.Addresses[j].Score
+		})
+
+		resp.Peers = append(resp.Peers, rpcBook)
+	}
+
+	return resp, nil
+}
+
 // WalletBalance returns total unspent outputs(confirmed and unconfirmed), all
 // confirmed unspent outputs and all unconfirmed unspent outputs under control
 // by the wallet. This method can be modified by having the request specify
@@ -1870,6 +2273,7 @@ func validatePayReqExpiry(payReq *zpay32.Invoice) error {
 // execute sendPayment. We use this struct as a sort of bridge to enable code
 // re-use between SendPayment and SendToRoute.
 type paymentStream struct {
+	ctx  context.Context
 	recv func() (*rpcPaymentRequest, error)
 	send func(*lnrpc.SendResponse) error
 }
@@ -1908,6 +2312,7 @@ func calculateFeeLimit(feeLimit *lnrpc.FeeLimit,
 // Lightning Network with a single persistent connection.
 func (r *rpcServer) SendPayment(stream lnrpc.Lightning_SendPaymentServer) error {
 	return r.sendPayment(&paymentStream{
+		ctx: stream.Context(),
 		recv: func() (*rpcPaymentRequest, error) {
 			req, err := stream.Recv()
 			if err != nil {
@@ -1929,6 +2334,7 @@ func (r *rpcServer) SendPayment(stream lnrpc.Lightning_SendPaymentServer) error
 // connection.
 func (r *rpcServer) SendToRoute(stream lnrpc.Lightning_SendToRouteServer) error {
 	return r.sendPayment(&paymentStream{
+		ctx: stream.Context(),
 		recv: func() (*rpcPaymentRequest, error) {
 			req, err := stream.Recv()
 			if err != nil {
@@ -1980,6 +2386,20 @@ type rpcPaymentIntent struct {
 	// corresponding routing algorithms are defined in the beginning of
 	// routing/router.go. Zero means not using Spider.
 	spiderAlgo int
+
+	// paymentSecret is the payment secret of the invoice being paid, if
+	// any, which is included within the onion payload of the final hop.
+	paymentSecret *[32]byte
+
+	// payAttemptTimeout bounds the time spent attempting the payment. If
+	// zero, the router's default is used.
+	payAttemptTimeout time.Duration
+
+	// reservation, if set, is the amount reserved for the payment within
+	// the spend budgets of the caller's macaroon. It's settled to the
+	// amount actually spent once the payment succeeds, and released once
+	// it fails.
+	reservation *macaroons.SpendReservation
 }
 
 // extractPaymentIntent attempts to parse the complete details required to
@@ -2051,12 +2471,44 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 			rpcPayReq.FeeLimit, payIntent.msat,
 		)
 
+		// We'll refuse to pay invoices that require features we
+		// don't know of.
+		features := lnwire.NewFeatureVector(
+			payReq.Features, lnwire.InvoiceFeatures,
+		)
+		unknown := features.UnknownRequiredFeatures()
+		if len(unknown) != 0 {
+			return payIntent, fmt.Errorf("invoice requires "+
+				"unknown features: %v", unknown)
+		}
+
 		copy(payIntent.rHash[:], payReq.PaymentHash[:])
 		payIntent.dest = payReq.Destination
 		payIntent.cltvDelta = uint16(payReq.MinFinalCLTVExpiry())
 		payIntent.routeHints = payReq.RouteHints
+		payIntent.paymentSecret = payReq.PaymentSecret
 		payIntent.spiderAlgo = int(rpcPayReq.SpiderAlgo)
 
+		// If the payee expressed Spider routing preferences, then
+		// we'll use their algorithm unless the caller selected one,
+		// and stop attempting the payment once their deadline passes.
+		if hints := payReq.SpiderHints; hints != nil {
+			if payIntent.spiderAlgo == 0 {
+				payIntent.spiderAlgo = int(hints.Algo)
+			}
+
+			if !hints.Deadline.IsZero() {
+				timeout := time.Until(hints.Deadline)
+				if timeout <= 0 {
+					return payIntent, fmt.Errorf("payment "+
+						"deadline of invoice passed "+
+						"at %v", hints.Deadline)
+				}
+
+				payIntent.payAttemptTimeout = timeout
+			}
+		}
+
 		return payIntent, nil
 	}
 
@@ -2134,6 +2586,39 @@ func extractPaymentIntent(rpcPayReq *rpcPaymentRequest) (rpcPaymentIntent, error
 	return payIntent, nil
 }
 
+// validatePaymentCaveats checks the payment against the amount limits of the
+// macaroon the RPC call was made with. As the routing fees of the payment
+// aren't known until it completes, the most it may cost, including the fee
+// limit, is reserved within the spend budgets of the macaroon. The reservation
+// is reduced to the amount actually spent once the payment settles.
+func (r *rpcServer) validatePaymentCaveats(ctx context.Context,
+	payIntent *rpcPaymentIntent) error {
+
+	if r.macService == nil {
+		return nil
+	}
+
+	// If the payment is sent along a set of routes, then we'll use the
+	// largest total amount, including fees, sent along any of them.
+	amt := payIntent.msat + payIntent.feeLimit
+	if len(payIntent.routes) > 0 {
+		amt = 0
+		for _, route := range payIntent.routes {
+			if route.TotalAmount > amt {
+				amt = route.TotalAmount
+			}
+		}
+	}
+
+	reservation, err := r.macService.ValidatePayment(ctx, amt)
+	if err != nil {
+		return err
+	}
+	payIntent.reservation = reservation
+
+	return nil
+}
+
 type paymentIntentResponse struct {
 	Route    *routing.Route
 	Preimage [32]byte
@@ -2161,11 +2646,13 @@ func (r *rpcServer) dispatchPaymentIntent(
 	// router, otherwise we'll create a payment session to execute it.
 	if len(payIntent.routes) == 0 {
 		payment := &routing.LightningPayment{
-			Target:      payIntent.dest,
-			Amount:      payIntent.msat,
-			FeeLimit:    payIntent.feeLimit,
-			PaymentHash: payIntent.rHash,
-			RouteHints:  payIntent.routeHints,
+			Target:            payIntent.dest,
+			Amount:            payIntent.msat,
+			FeeLimit:          payIntent.feeLimit,
+			PaymentHash:       payIntent.rHash,
+			PayAttemptTimeout: payIntent.payAttemptTimeout,
+			RouteHints:        payIntent.routeHints,
+			PaymentSecret:     payIntent.paymentSecret,
 		}
 
 		// If the final CLTV value was specified, then we'll use that
@@ -2192,8 +2679,19 @@ func (r *rpcServer) dispatchPaymentIntent(
 	}
 
 	// If the route failed, then we'll return a nil save err, but a non-nil
-	// routing err.
+	// routing err. As nothing was paid, the payment no longer counts
+	// towards the spend budgets of the caller's macaroon, unless the
+	// switch exited while our HTLC may still be in flight.
 	if routerErr != nil {
+		if routerErr != htlcswitch.ErrSwitchExiting {
+			err := payIntent.reservation.Release()
+			if err != nil {
+				rpcsLog.Errorf("unable to release spend "+
+					"budget of payment %x: %v",
+					payIntent.rHash[:], err)
+			}
+		}
+
 		return &paymentIntentResponse{
 			Err: routerErr,
 		}, nil
@@ -2208,6 +2706,13 @@ func (r *rpcServer) dispatchPaymentIntent(
 		amt = payIntent.msat
 	}
 
+	// Only the amount actually sent, including the fees paid along the
+	// route, counts towards the spend budgets of the caller's macaroon.
+	if err := payIntent.reservation.Settle(route.TotalAmount); err != nil {
+		rpcsLog.Errorf("unable to settle spend budget of payment "+
+			"%x: %v", payIntent.rHash[:], err)
+	}
+
 	// Save the completed payment to the database for record keeping
 	// purposes.
 	err := r.savePayment(route, amt, preImage[:])
@@ -2287,9 +2792,15 @@ func (r *rpcServer) sendPayment(stream *paymentStream) error {
 				// Populate the next payment, either from the
 				// payment request, or from the explicitly set
 				// fields. If the payment proto wasn't well
-				// formed, then we'll send an error reply and
-				// wait for the next payment.
+				// formed, or the payment exceeds the limits
+				// of the caller's macaroon, then we'll send an
+				// error reply and wait for the next payment.
 				payIntent, err := extractPaymentIntent(nextPayment)
+				if err == nil {
+					err = r.validatePaymentCaveats(
+						stream.ctx, &payIntent,
+					)
+				}
 				if err != nil {
 					if err := stream.send(&lnrpc.SendResponse{
 						PaymentError: err.Error(),
@@ -2434,6 +2945,13 @@ func (r *rpcServer) sendPaymentSync(ctx context.Context,
 		return nil, err
 	}
 
+	// Before dispatching the payment, we'll make sure it's within the
+	// limits of the macaroon the caller authenticated with.
+	err = r.validatePaymentCaveats(ctx, &payIntent)
+	if err != nil {
+		return nil, err
+	}
+
 	// With the payment validated, we'll now attempt to dispatch the
 	// payment.
 	resp, saveErr := r.dispatchPaymentIntent(&payIntent)
@@ -2696,6 +3214,40 @@ func (r *rpcServer) AddInvoice(ctx context.Context,
 
 	}
 
+	// Each invoice carries a fresh payment secret, which prevents
+	// intermediate nodes from probing us with payments to its hash. Unless
+	// the caller requires payers to include it, it's only signaled as
+	// optional, such that payers unaware of it can still pay the invoice.
+	var paymentSecret [32]byte
+	if _, err := rand.Read(paymentSecret[:]); err != nil {
+		return nil, err
+	}
+	secretFeature := lnwire.PaymentSecretOptional
+	if invoice.RequirePaymentSecret {
+		secretFeature = lnwire.PaymentSecretRequired
+	}
+	options = append(options,
+		zpay32.Features(lnwire.NewRawFeatureVector(secretFeature)),
+		zpay32.PaymentSecret(paymentSecret),
+	)
+
+	// If Spider routing preferences were specified, then we'll include
+	// them as well.
+	if invoice.SpiderAlgo != 0 || invoice.SpiderDeadline != 0 {
+		if invoice.SpiderAlgo < 0 || invoice.SpiderDeadline < 0 {
+			return nil, fmt.Errorf("spider preferences must not " +
+				"be negative")
+		}
+
+		var deadline time.Time
+		if invoice.SpiderDeadline != 0 {
+			deadline = time.Unix(invoice.SpiderDeadline, 0)
+		}
+		options = append(options, zpay32.SpiderPreferences(
+			uint8(invoice.SpiderAlgo), deadline,
+		))
+	}
+
 	// Create and encode the payment request as a bech32 (zpay32) string.
 	creationDate := time.Now()
 	payReq, err := zpay32.NewInvoice(
@@ -2778,6 +3330,10 @@ func createRPCInvoice(invoice *channeldb.Invoice) (*lnrpc.Invoice, error) {
 	// Convert between the `lnrpc` and `routing` types.
 	routeHints := createRPCRouteHints(decoded.RouteHints)
 
+	requireSecret := decoded.Features != nil &&
+		decoded.Features.IsSet(lnwire.PaymentSecretRequired)
+	spiderAlgo, spiderDeadline := marshalSpiderHints(decoded.SpiderHints)
+
 	preimage := invoice.Terms.PaymentPreimage
 	satAmt := invoice.Terms.Value.ToSatoshis()
 	satAmtPaid := invoice.AmtPaid.ToSatoshis()
@@ -2802,9 +3358,29 @@ func createRPCInvoice(invoice *channeldb.Invoice) (*lnrpc.Invoice, error) {
 		AmtPaidSat:      int64(satAmtPaid),
 		AmtPaidMsat:     int64(invoice.AmtPaid),
 		AmtPaid:         int64(invoice.AmtPaid),
+
+		RequirePaymentSecret: requireSecret,
+		SpiderAlgo:           spiderAlgo,
+		SpiderDeadline:       spiderDeadline,
 	}, nil
 }
 
+// marshalSpiderHints converts the Spider hints of a payment request into the
+// algorithm and unix deadline used by the RPC types. Zero values denote no
+// preference.
+func marshalSpiderHints(hints *zpay32.SpiderHints) (int32, int64) {
+	if hints == nil {
+		return 0, 0
+	}
+
+	var deadline int64
+	if !hints.Deadline.IsZero() {
+		deadline = hints.Deadline.Unix()
+	}
+
+	return int32(hints.Algo), deadline
+}
+
 // createRPCRouteHints takes in the decoded form of an invoice's route hints
 // and converts them into the lnrpc type.
 func createRPCRouteHints(routeHints [][]routing.HopHint) []*lnrpc.RouteHint {
@@ -3008,9 +3584,64 @@ func (r *rpcServer) SubscribeTransactions(req *lnrpc.GetTransactionsRequest,
 	}
 }
 
-// GetTransactions returns a list of describing all the known transactions
-// relevant to the wallet.
-func (r *rpcServer) GetTransactions(ctx context.Context,
+// recoveryInfoInterval is the interval at which GetRecoveryInfo polls the
+// wallet for the progress of its recovery rescan.
+const recoveryInfoInterval = time.Second
+
+// GetRecoveryInfo returns a stream of updates on the progress of the wallet's
+// rescan for the funds of a restored seed. An update is sent each time the
+// rescan advances, and the stream is closed once the rescan has finished. If
+// the wallet wasn't started in recovery mode, then a single update reporting
+// so is sent.
+func (r *rpcServer) GetRecoveryInfo(req *lnrpc.GetRecoveryInfoRequest,
+	updateStream lnrpc.Lightning_GetRecoveryInfoServer) error {
+
+	ticker := time.NewTicker(recoveryInfoInterval)
+	defer ticker.Stop()
+
+	var lastProgress *lnwallet.RecoveryProgress
+	for {
+		progress, err := r.server.cc.wallet.RecoveryProgress()
+		if err != nil {
+			return err
+		}
+
+		// We'll only send an update if the rescan has advanced since
+		// the last one.
+		if lastProgress == nil || *progress != *lastProgress {
+			err := updateStream.Send(&lnrpc.RecoveryInfo{
+				RecoveryMode:     progress.RecoveryMode,
+				RecoveryFinished: progress.Finished,
+				Progress:         progress.Progress(),
+				StartHeight:      progress.StartHeight,
+				CurrentHeight:    progress.CurrentHeight,
+				BestHeight:       progress.BestHeight,
+				AddressesFound:   progress.AddressesFound,
+				RecoveryWindow:   progress.RecoveryWindow,
+			})
+			if err != nil {
+				return err
+			}
+			lastProgress = progress
+		}
+
+		if !progress.RecoveryMode || progress.Finished {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-updateStream.Context().Done():
+			return updateStream.Context().Err()
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// GetTransactions returns a list of describing all the known transactions
+// relevant to the wallet.
+func (r *rpcServer) GetTransactions(ctx context.Context,
 	_ *lnrpc.GetTransactionsRequest) (*lnrpc.TransactionDetails, error) {
 
 	// TODO(roasbeef): add pagination support
@@ -3342,6 +3973,9 @@ func marshallRoute(route *routing.Route) *lnrpc.Route {
 			Fee:              int64(hop.Fee.ToSatoshis()),
 			FeeMsat:          int64(hop.Fee),
 			Expiry:           uint32(hop.OutgoingTimeLock),
+			PubKey: hex.EncodeToString(
+				hop.Channel.Node.PubKeyBytes[:],
+			),
 		}
 	}
 
@@ -3766,6 +4400,22 @@ func (r *rpcServer) DecodePayReq(ctx context.Context,
 		amt = int64(payReq.MilliSat.ToSatoshis())
 	}
 
+	var features []uint32
+	if payReq.Features != nil {
+		for i := 0; i < payReq.Features.SerializeSize()*8; i++ {
+			if payReq.Features.IsSet(lnwire.FeatureBit(i)) {
+				features = append(features, uint32(i))
+			}
+		}
+	}
+
+	var paymentSecret string
+	if payReq.PaymentSecret != nil {
+		paymentSecret = hex.EncodeToString(payReq.PaymentSecret[:])
+	}
+
+	spiderAlgo, spiderDeadline := marshalSpiderHints(payReq.SpiderHints)
+
 	dest := payReq.Destination.SerializeCompressed()
 	return &lnrpc.PayReq{
 		Destination:     hex.EncodeToString(dest),
@@ -3778,6 +4428,10 @@ func (r *rpcServer) DecodePayReq(ctx context.Context,
 		Expiry:          expiry,
 		CltvExpiry:      int64(payReq.MinFinalCLTVExpiry()),
 		RouteHints:      routeHints,
+		Features:        features,
+		PaymentSecret:   paymentSecret,
+		SpiderAlgo:      spiderAlgo,
+		SpiderDeadline:  spiderDeadline,
 	}, nil
 }
 
@@ -3857,10 +4511,14 @@ func (r *rpcServer) FeeReport(ctx context.Context,
 			}
 
 			// Otherwise, we'll tally up an accumulate the total
-			// fees for this time slice.
+			// fees for this time slice. Rebalances paid fees
+			// rather than earning them, so they're skipped.
 			for _, event := range timeSlice.ForwardingEvents {
-				fee := event.AmtIn - event.AmtOut
-				totalFees += fee
+				if event.AmtIn <= event.AmtOut {
+					continue
+				}
+
+				totalFees += event.AmtIn - event.AmtOut
 			}
 
 			// We'll now take the last offset index returned as
@@ -4104,15 +4762,1312 @@ func (r *rpcServer) ForwardingHistory(ctx context.Context,
 		amtInSat := event.AmtIn.ToSatoshis()
 		amtOutSat := event.AmtOut.ToSatoshis()
 
+		// Rebalances are logged with an outgoing amount that exceeds
+		// the incoming amount, as we paid fees rather than earning
+		// them, so we'll report no fee for those.
+		var fee btcutil.Amount
+		if amtInSat > amtOutSat {
+			fee = amtInSat - amtOutSat
+		}
+
 		resp.ForwardingEvents[i] = &lnrpc.ForwardingEvent{
 			Timestamp: uint64(event.Timestamp.Unix()),
 			ChanIdIn:  event.IncomingChanID.ToUint64(),
 			ChanIdOut: event.OutgoingChanID.ToUint64(),
 			AmtIn:     uint64(amtInSat),
 			AmtOut:    uint64(amtOutSat),
-			Fee:       uint64(amtInSat - amtOutSat),
+			Fee:       uint64(fee),
 		}
 	}
 
 	return resp, nil
 }
+
+// Rebalance moves funds from one of our channels to another by sending a
+// circular payment to ourselves. The payment leaves through the outgoing
+// channel, and returns through the incoming channel.
+func (r *rpcServer) Rebalance(ctx context.Context,
+	req *lnrpc.RebalanceRequest) (*lnrpc.RebalanceResponse, error) {
+
+	// We don't allow rebalances while the daemon itself is still syncing
+	// for the same reasons we don't allow payments.
+	if !r.server.Started() {
+		return nil, fmt.Errorf("chain backend is still syncing, server " +
+			"not active yet")
+	}
+
+	switch {
+	case req.OutgoingChanId == 0 || req.IncomingChanId == 0:
+		return nil, fmt.Errorf("both an outgoing and incoming channel " +
+			"must be specified")
+
+	case req.OutgoingChanId == req.IncomingChanId:
+		return nil, fmt.Errorf("outgoing and incoming channel must " +
+			"differ")
+
+	case req.Amt <= 0:
+		return nil, fmt.Errorf("amount must be positive")
+	}
+
+	amt := lnwire.NewMSatFromSatoshis(btcutil.Amount(req.Amt))
+	if amt > maxPaymentMSat {
+		return nil, fmt.Errorf("rebalance of %v is too large, max "+
+			"payment allowed is %v", amt.ToSatoshis(),
+			maxPaymentMSat.ToSatoshis())
+	}
+	feeLimit := calculateFeeLimit(req.FeeLimit, amt)
+
+	// As a rebalance is a payment, we'll make sure it's within the limits
+	// of the macaroon the caller authenticated with. The circular amount
+	// returns to us, so only the routing fees are actually spent.
+	payIntent := rpcPaymentIntent{feeLimit: feeLimit}
+	if err := r.validatePaymentCaveats(ctx, &payIntent); err != nil {
+		return nil, err
+	}
+
+	outgoingChan := lnwire.NewShortChanIDFromInt(req.OutgoingChanId)
+	incomingChan := lnwire.NewShortChanIDFromInt(req.IncomingChanId)
+
+	rpcsLog.Debugf("[rebalance] amt=%v, outgoing=%v, incoming=%v, "+
+		"fee_limit=%v", amt, outgoingChan, incomingChan, feeLimit)
+
+	route, preimage, err := r.server.rebalancer.Rebalance(
+		outgoingChan, incomingChan, amt, feeLimit,
+	)
+	if err != nil {
+		// Unless our HTLC may still be in flight, the failed rebalance
+		// no longer counts towards the spend budgets of the macaroon.
+		if err != htlcswitch.ErrSwitchExiting {
+			if err := payIntent.reservation.Release(); err != nil {
+				rpcsLog.Errorf("unable to release spend "+
+					"budget of rebalance: %v", err)
+			}
+		}
+
+		return &lnrpc.RebalanceResponse{
+			PaymentError: err.Error(),
+		}, nil
+	}
+
+	if err := payIntent.reservation.Settle(route.TotalFees); err != nil {
+		rpcsLog.Errorf("unable to settle spend budget of rebalance: %v",
+			err)
+	}
+
+	return &lnrpc.RebalanceResponse{
+		PaymentPreimage: preimage[:],
+		PaymentRoute:    marshallRoute(route),
+	}, nil
+}
+
+// TrackPayment returns the full history of an outgoing payment, followed by an
+// updated history each time the payment progresses. The stream is closed once
+// the payment either succeeds or fails.
+func (r *rpcServer) TrackPayment(req *lnrpc.TrackPaymentRequest,
+	updateStream lnrpc.Lightning_TrackPaymentServer) error {
+
+	if len(req.PaymentHash) != 32 {
+		return fmt.Errorf("payment hash must be exactly 32 bytes, is "+
+			"instead %v", len(req.PaymentHash))
+	}
+
+	var paymentHash [32]byte
+	copy(paymentHash[:], req.PaymentHash)
+
+	history, subscription, err := r.server.chanRouter.SubscribePayment(
+		paymentHash,
+	)
+	if err != nil {
+		return err
+	}
+	defer subscription.Cancel()
+
+	for {
+		update := marshallPaymentHistory(history)
+		if err := updateStream.Send(update); err != nil {
+			return err
+		}
+
+		// Once the payment has either succeeded or failed, there will
+		// be no further updates.
+		if history.Terminal() {
+			return nil
+		}
+
+		select {
+		case history = <-subscription.Updates:
+
+		case <-updateStream.Context().Done():
+			return updateStream.Context().Err()
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// marshallPaymentHistory converts the history of a payment into its RPC
+// representation.
+func marshallPaymentHistory(
+	history *channeldb.PaymentHistory) *lnrpc.PaymentUpdate {
+
+	update := &lnrpc.PaymentUpdate{
+		PaymentHash:  history.PaymentHash[:],
+		Target:       hex.EncodeToString(history.Target[:]),
+		ValueMsat:    int64(history.Amount),
+		CreationDate: history.CreationTime.Unix(),
+	}
+
+	switch history.Status {
+	case channeldb.StatusCompleted:
+		update.State = lnrpc.PaymentUpdate_SUCCEEDED
+	case channeldb.StatusFailed:
+		update.State = lnrpc.PaymentUpdate_FAILED
+	default:
+		update.State = lnrpc.PaymentUpdate_IN_FLIGHT
+	}
+
+	for _, attempt := range history.Attempts {
+		update.Attempts = append(
+			update.Attempts, marshallPaymentAttempt(attempt),
+		)
+	}
+
+	return update
+}
+
+// marshallPaymentAttempt converts a single attempt of a payment into its RPC
+// representation.
+func marshallPaymentAttempt(
+	attempt *channeldb.PaymentAttempt) *lnrpc.PaymentAttempt {
+
+	route := &lnrpc.Route{
+		TotalTimeLock: attempt.TotalTimeLock,
+		TotalFees:     int64(attempt.TotalFees.ToSatoshis()),
+		TotalFeesMsat: int64(attempt.TotalFees),
+		TotalAmt:      int64(attempt.TotalAmount.ToSatoshis()),
+		TotalAmtMsat:  int64(attempt.TotalAmount),
+		Hops:          make([]*lnrpc.Hop, len(attempt.Hops)),
+	}
+	for i, hop := range attempt.Hops {
+		route.Hops[i] = &lnrpc.Hop{
+			ChanId:           hop.ChannelID,
+			AmtToForward:     int64(hop.AmtToForward.ToSatoshis()),
+			AmtToForwardMsat: int64(hop.AmtToForward),
+			Fee:              int64(hop.Fee.ToSatoshis()),
+			FeeMsat:          int64(hop.Fee),
+			Expiry:           hop.OutgoingTimeLock,
+			PubKey:           hex.EncodeToString(hop.PubKey[:]),
+		}
+	}
+
+	rpcAttempt := &lnrpc.PaymentAttempt{
+		AttemptId:          attempt.AttemptID,
+		Route:              route,
+		AttemptTimeNs:      attempt.AttemptTime.UnixNano(),
+		FailureSourceIndex: attempt.FailureSourceIdx,
+		FailureCode:        uint32(attempt.FailureCode),
+	}
+	if !attempt.ResolveTime.IsZero() {
+		rpcAttempt.ResolveTimeNs = attempt.ResolveTime.UnixNano()
+	}
+
+	switch attempt.State {
+	case channeldb.AttemptSucceeded:
+		rpcAttempt.State = lnrpc.PaymentAttempt_SUCCEEDED
+		rpcAttempt.Preimage = attempt.Preimage[:]
+	case channeldb.AttemptFailed:
+		rpcAttempt.State = lnrpc.PaymentAttempt_FAILED
+	default:
+		rpcAttempt.State = lnrpc.PaymentAttempt_IN_FLIGHT
+	}
+
+	return rpcAttempt
+}
+
+// SubscribeHtlcEvents creates a uni-directional stream from the server to the
+// client in which every HTLC event observed by the switch is sent.
+func (r *rpcServer) SubscribeHtlcEvents(req *lnrpc.SubscribeHtlcEventsRequest,
+	eventStream lnrpc.Lightning_SubscribeHtlcEventsServer) error {
+
+	subscription, err := r.server.htlcNotifier.SubscribeHtlcEvents()
+	if err != nil {
+		return err
+	}
+	defer subscription.Cancel()
+
+	for {
+		select {
+		case event := <-subscription.Events:
+			rpcEvent := marshallHtlcEvent(event)
+			if err := eventStream.Send(rpcEvent); err != nil {
+				return err
+			}
+
+		case <-eventStream.Context().Done():
+			return eventStream.Context().Err()
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// marshallHtlcEvent converts an HTLC event of the switch into its RPC
+// representation.
+func marshallHtlcEvent(event *htlcswitch.HtlcEvent) *lnrpc.HtlcEvent {
+	rpcEvent := &lnrpc.HtlcEvent{
+		IncomingChanId:  event.IncomingCircuit.ChanID.ToUint64(),
+		IncomingHtlcId:  event.IncomingCircuit.HtlcID,
+		OutgoingChanId:  event.OutgoingCircuit.ChanID.ToUint64(),
+		OutgoingHtlcId:  event.OutgoingCircuit.HtlcID,
+		PaymentHash:     event.PaymentHash[:],
+		IncomingAmtMsat: uint64(event.IncomingAmt),
+		OutgoingAmtMsat: uint64(event.OutgoingAmt),
+		TimestampNs:     uint64(event.Timestamp.UnixNano()),
+		FailureReason:   event.FailureReason,
+	}
+
+	switch event.Type {
+	case htlcswitch.HtlcEventAdd:
+		rpcEvent.EventType = lnrpc.HtlcEvent_ADD
+	case htlcswitch.HtlcEventQueue:
+		rpcEvent.EventType = lnrpc.HtlcEvent_QUEUE
+	case htlcswitch.HtlcEventDrop:
+		rpcEvent.EventType = lnrpc.HtlcEvent_DROP
+	case htlcswitch.HtlcEventSettle:
+		rpcEvent.EventType = lnrpc.HtlcEvent_SETTLE
+	case htlcswitch.HtlcEventFail:
+		rpcEvent.EventType = lnrpc.HtlcEvent_FAIL
+	case htlcswitch.HtlcEventLinkFail:
+		rpcEvent.EventType = lnrpc.HtlcEvent_LINK_FAIL
+	default:
+		rpcEvent.EventType = lnrpc.HtlcEvent_FORWARD
+	}
+
+	return rpcEvent
+}
+
+// HtlcInterceptor dispatches a bi-directional streaming RPC in which every
+// HTLC forwarded by the switch is held and sent to the client, which then
+// decides how the HTLC is resolved.
+func (r *rpcServer) HtlcInterceptor(
+	stream lnrpc.Lightning_HtlcInterceptorServer) error {
+
+	// As the switch mustn't block on the interceptor, intercepted forwards
+	// are queued until they can be sent to the client.
+	forwards := chainntnfs.NewConcurrentQueue(20)
+	forwards.Start()
+	defer forwards.Stop()
+
+	err := r.server.htlcSwitch.SetInterceptor(
+		func(fwd *htlcswitch.InterceptedForward) {
+			select {
+			case forwards.ChanIn() <- fwd:
+			case <-r.quit:
+			}
+		},
+	)
+	if err != nil {
+		return err
+	}
+	defer r.server.htlcSwitch.ClearInterceptor()
+
+	// We'll receive the resolutions of the client in a goroutine of its
+	// own, such that forwards can be sent concurrently.
+	errChan := make(chan error, 1)
+	go func() {
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			res, err := unmarshallFwdResolution(resp)
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			err = r.server.htlcSwitch.ResolveInterceptedForward(res)
+			if err != nil {
+				rpcsLog.Warnf("Unable to resolve forward "+
+					"%v: %v", res.IncomingCircuit, err)
+			}
+		}
+	}()
+
+	for {
+		select {
+		case item := <-forwards.ChanOut():
+			fwd := item.(*htlcswitch.InterceptedForward)
+			err := stream.Send(marshallInterceptedForward(fwd))
+			if err != nil {
+				return err
+			}
+
+		case err := <-errChan:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// marshallInterceptedForward converts a forward held by the switch into its
+// RPC representation.
+func marshallInterceptedForward(
+	fwd *htlcswitch.InterceptedForward) *lnrpc.ForwardHtlcInterceptRequest {
+
+	return &lnrpc.ForwardHtlcInterceptRequest{
+		IncomingCircuitKey: &lnrpc.CircuitKey{
+			ChanId: fwd.IncomingCircuit.ChanID.ToUint64(),
+			HtlcId: fwd.IncomingCircuit.HtlcID,
+		},
+		IncomingAmountMsat:      uint64(fwd.IncomingAmt),
+		IncomingExpiry:          fwd.IncomingExpiry,
+		PaymentHash:             fwd.PaymentHash[:],
+		OutgoingRequestedChanId: fwd.OutgoingChanID.ToUint64(),
+		OutgoingAmountMsat:      uint64(fwd.OutgoingAmt),
+		OutgoingExpiry:          fwd.OutgoingExpiry,
+		Deadline:                fwd.Deadline.Unix(),
+	}
+}
+
+// unmarshallFwdResolution converts the resolution of a held forward sent by
+// the client into the resolution understood by the switch.
+func unmarshallFwdResolution(
+	resp *lnrpc.ForwardHtlcInterceptResponse) (*htlcswitch.FwdResolution,
+	error) {
+
+	if resp.IncomingCircuitKey == nil {
+		return nil, errors.New("incoming circuit key must be set")
+	}
+
+	res := &htlcswitch.FwdResolution{
+		IncomingCircuit: htlcswitch.CircuitKey{
+			ChanID: lnwire.NewShortChanIDFromInt(
+				resp.IncomingCircuitKey.ChanId,
+			),
+			HtlcID: resp.IncomingCircuitKey.HtlcId,
+		},
+	}
+
+	switch resp.Action {
+	case lnrpc.ForwardHtlcInterceptResponse_RESUME:
+		res.Action = htlcswitch.FwdActionResume
+
+	case lnrpc.ForwardHtlcInterceptResponse_FAIL:
+		res.Action = htlcswitch.FwdActionFail
+
+	case lnrpc.ForwardHtlcInterceptResponse_SETTLE:
+		if len(resp.Preimage) != 32 {
+			return nil, fmt.Errorf("preimage must be exactly 32 "+
+				"bytes, is instead %v", len(resp.Preimage))
+		}
+		res.Action = htlcswitch.FwdActionSettle
+		copy(res.Preimage[:], resp.Preimage)
+
+	default:
+		return nil, fmt.Errorf("unknown action %v", resp.Action)
+	}
+
+	return res, nil
+}
+
+// ChannelAcceptor dispatches a bi-directional streaming RPC in which every
+// inbound channel request is sent to the client, which then decides whether
+// the channel is accepted.
+func (r *rpcServer) ChannelAcceptor(
+	stream lnrpc.Lightning_ChannelAcceptorServer) error {
+
+	// The acceptor will reject all undecided channels once we return.
+	quit := make(chan struct{})
+	defer close(quit)
+
+	acceptor := chanacceptor.NewRPCAcceptor(cfg.ChanAcceptor.Timeout, quit)
+	acceptorID := r.server.chanAcceptor.AddAcceptor(acceptor)
+	defer r.server.chanAcceptor.RemoveAcceptor(acceptorID)
+
+	// We'll receive the decisions of the client in a goroutine of its own,
+	// such that requests can be sent concurrently.
+	responses := make(chan *lnrpc.ChannelAcceptResponse)
+	errChan := make(chan error, 1)
+	go func() {
+		for {
+			resp, err := stream.Recv()
+			if err != nil {
+				errChan <- err
+				return
+			}
+
+			select {
+			case responses <- resp:
+			case <-quit:
+				return
+			}
+		}
+	}()
+
+	// We'll track the requests awaiting a decision of the client. Any
+	// request still undecided once the client goes away is rejected.
+	pendingRequests := make(map[[32]byte]*chanacceptor.RPCRequest)
+	defer func() {
+		for pendingID, req := range pendingRequests {
+			req.Reject("acceptor exiting")
+			delete(pendingRequests, pendingID)
+		}
+	}()
+
+	// Requests which time out before the client decides on them are sent
+	// over the expired channel, such that they can be removed.
+	expired := make(chan *chanacceptor.RPCRequest)
+	for {
+		select {
+		case req := <-acceptor.Requests():
+			pendingID := req.OpenChanMsg.PendingChannelID
+			pendingRequests[pendingID] = req
+
+			go func() {
+				select {
+				case <-req.Done():
+				case <-quit:
+					return
+				}
+
+				select {
+				case expired <- req:
+				case <-quit:
+				}
+			}()
+
+			rpcReq := marshallChannelAcceptRequest(
+				req.ChannelAcceptRequest,
+			)
+			if err := stream.Send(rpcReq); err != nil {
+				return err
+			}
+
+		case resp := <-responses:
+			var pendingID [32]byte
+			copy(pendingID[:], resp.PendingChanId)
+
+			req, ok := pendingRequests[pendingID]
+			if !ok {
+				rpcsLog.Warnf("Received decision for unknown "+
+					"channel request %x", pendingID)
+				continue
+			}
+			delete(pendingRequests, pendingID)
+
+			switch {
+			case resp.Accept:
+				req.Accept()
+			case resp.Error != "":
+				req.Reject(resp.Error)
+			default:
+				req.Reject("rejected by acceptor")
+			}
+
+		case req := <-expired:
+			// The request may have already been decided on, or
+			// replaced by a newer one with the same pending ID.
+			pendingID := req.OpenChanMsg.PendingChannelID
+			if pendingRequests[pendingID] == req {
+				delete(pendingRequests, pendingID)
+			}
+
+		case err := <-errChan:
+			if err == io.EOF {
+				return nil
+			}
+			return err
+
+		case <-r.quit:
+			return nil
+		}
+	}
+}
+
+// marshallChannelAcceptRequest converts an inbound channel request into its
+// RPC representation.
+func marshallChannelAcceptRequest(
+	req *chanacceptor.ChannelAcceptRequest) *lnrpc.ChannelAcceptRequest {
+
+	msg := req.OpenChanMsg
+	return &lnrpc.ChannelAcceptRequest{
+		NodePubkey:       req.Node.SerializeCompressed(),
+		ChainHash:        msg.ChainHash[:],
+		PendingChanId:    msg.PendingChannelID[:],
+		FundingAmt:       uint64(msg.FundingAmount),
+		PushAmt:          uint64(msg.PushAmount),
+		DustLimit:        uint64(msg.DustLimit),
+		MaxValueInFlight: uint64(msg.MaxValueInFlight),
+		ChannelReserve:   uint64(msg.ChannelReserve),
+		MinHtlc:          uint64(msg.HtlcMinimum),
+		FeePerKw:         uint64(msg.FeePerKiloWeight),
+		CsvDelay:         uint32(msg.CsvDelay),
+		MaxAcceptedHtlcs: uint32(msg.MaxAcceptedHTLCs),
+		ChannelFlags:     uint32(msg.ChannelFlags),
+	}
+}
+
+// PendingSweeps returns all outputs which are waiting to be swept back into
+// the wallet by the sweeper, along with the state of the sweep transactions
+// spending them.
+func (r *rpcServer) PendingSweeps(ctx context.Context,
+	in *lnrpc.PendingSweepsRequest) (*lnrpc.PendingSweepsResponse, error) {
+
+	pendingInputs, err := r.server.sweeper.PendingInputs()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.PendingSweepsResponse{
+		PendingSweeps: make(
+			[]*lnrpc.PendingSweep, 0, len(pendingInputs),
+		),
+	}
+	for _, input := range pendingInputs {
+		satPerByte := input.FeeRate.FeePerKVByte() / 1000
+
+		pendingSweep := &lnrpc.PendingSweep{
+			Outpoint:          input.OutPoint.String(),
+			WitnessType:       input.WitnessType.String(),
+			AmountSat:         int64(input.Amount),
+			TargetConf:        input.ConfTarget,
+			SatPerByte:        int64(satPerByte),
+			BroadcastAttempts: uint32(input.BroadcastAttempts),
+		}
+		if input.SweepTxid != nil {
+			pendingSweep.SweepTxid = input.SweepTxid.String()
+		}
+
+		resp.PendingSweeps = append(resp.PendingSweeps, pendingSweep)
+	}
+
+	return resp, nil
+}
+
+// SweepOutputs immediately sweeps all outputs which are waiting to be swept,
+// targeting confirmation within the given number of blocks.
+func (r *rpcServer) SweepOutputs(ctx context.Context,
+	in *lnrpc.SweepOutputsRequest) (*lnrpc.SweepOutputsResponse, error) {
+
+	if in.TargetConf < 0 {
+		return nil, fmt.Errorf("target_conf must be positive")
+	}
+
+	rpcsLog.Infof("[sweepoutputs] target_conf=%v", in.TargetConf)
+
+	sweepTxs, err := r.server.sweeper.SweepPendingInputs(
+		uint32(in.TargetConf),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &lnrpc.SweepOutputsResponse{
+		SweepTxids: make([]string, 0, len(sweepTxs)),
+	}
+	for _, sweepTx := range sweepTxs {
+		resp.SweepTxids = append(
+			resp.SweepTxids, sweepTx.TxHash().String(),
+		)
+	}
+
+	return resp, nil
+}
+
+// BumpFee bumps the fee of the unconfirmed transaction creating the given
+// output. If the output is waiting to be swept, its sweep transaction is
+// replaced by one paying a higher fee (RBF). Otherwise, if the output is an
+// unconfirmed p2wkh output of the wallet, it is spent by a child transaction
+// paying for its parent (CPFP), and leased until the child is resolved.
+func (r *rpcServer) BumpFee(ctx context.Context,
+	in *lnrpc.BumpFeeRequest) (*lnrpc.BumpFeeResponse, error) {
+
+	outpoint, err := parseOutPoint(in.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// Unless a fee rate or confirmation target is given, a pending sweep
+	// is bumped by the sweeper's default increment.
+	var feePerKw lnwallet.SatPerKWeight
+	if in.TargetConf != 0 || in.SatPerByte != 0 {
+		feePerKw, err = determineFeePerKw(
+			r.server.cc.feeEstimator, in.TargetConf, in.SatPerByte,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rpcsLog.Infof("[bumpfee] outpoint=%v, fee_rate=%v sat/kw", outpoint,
+		int64(feePerKw))
+
+	// If the output is being swept already, we'll replace its sweep
+	// transaction.
+	tx, err := r.server.sweeper.BumpFee(*outpoint, feePerKw)
+	switch {
+	case err == nil:
+		return &lnrpc.BumpFeeResponse{
+			Txid: tx.TxHash().String(),
+		}, nil
+
+	case err != sweep.ErrUnknownInput:
+		return nil, err
+	}
+
+	// Otherwise, the output must belong to an unconfirmed transaction of
+	// our wallet, which we'll pay for with a child spending the output.
+	if feePerKw == 0 {
+		feePerKw, err = determineFeePerKw(
+			r.server.cc.feeEstimator, sweep.DefaultConfTarget, 0,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	input, parent, err := r.fetchUnconfirmedWalletOutput(outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	// While the child is pending, we'll lease the output, such that coin
+	// selection won't try to spend it as well.
+	_, err = r.server.cc.wallet.LeaseOutput(
+		cpfpLockID, *outpoint, cpfpLeaseDuration,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	resultChan, err := r.server.sweeper.CPFP(input, parent, feePerKw)
+	if err != nil {
+		r.releaseCPFPOutput(*outpoint)
+		return nil, err
+	}
+
+	// Once the output has been spent, or the sweeper gave up on it, the
+	// lease is no longer needed.
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+
+		select {
+		case <-resultChan:
+			r.releaseCPFPOutput(*outpoint)
+		case <-r.quit:
+		}
+	}()
+
+	// The child is published before any later request is handled by the
+	// sweeper, so we can look it up right away.
+	pendingInputs, err := r.server.sweeper.PendingInputs()
+	if err != nil {
+		return nil, err
+	}
+	for _, pendingInput := range pendingInputs {
+		if pendingInput.OutPoint != *outpoint {
+			continue
+		}
+
+		if pendingInput.SweepTxid == nil {
+			break
+		}
+
+		return &lnrpc.BumpFeeResponse{
+			Txid: pendingInput.SweepTxid.String(),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unable to publish child transaction "+
+		"spending %v", outpoint)
+}
+
+// releaseCPFPOutput releases the lease taken on a wallet output while a child
+// transaction spending it was pending.
+func (r *rpcServer) releaseCPFPOutput(outpoint wire.OutPoint) {
+	err := r.server.cc.wallet.ReleaseOutput(cpfpLockID, outpoint)
+	if err != nil {
+		rpcsLog.Warnf("Unable to release lease on %v: %v", outpoint,
+			err)
+	}
+}
+
+// fetchUnconfirmedWalletOutput returns the given output of an unconfirmed
+// wallet transaction as a sweep input, along with its parent transaction.
+// Only p2wkh outputs are supported, as the sweeper can't produce a signature
+// script for nested outputs.
+func (r *rpcServer) fetchUnconfirmedWalletOutput(
+	outpoint *wire.OutPoint) (sweep.Input, *sweep.ParentTx, error) {
+
+	wallet := r.server.cc.wallet
+
+	// An output we bumped before is leased while its child is pending, so
+	// we'll also look among those, in case the child was lost.
+	utxos, err := wallet.ListUnspentWitness(0)
+	if err != nil {
+		return nil, nil, err
+	}
+	leasedUtxos, err := wallet.ListLeasedUnspentWitness(cpfpLockID, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	utxos = append(utxos, leasedUtxos...)
+
+	var utxo *lnwallet.Utxo
+	for _, u := range utxos {
+		if u.OutPoint == *outpoint {
+			utxo = u
+			break
+		}
+	}
+	if utxo == nil {
+		return nil, nil, fmt.Errorf("outpoint %v is neither pending "+
+			"sweep nor an unspent wallet output", outpoint)
+	}
+	if utxo.AddressType != lnwallet.WitnessPubKey {
+		return nil, nil, fmt.Errorf("unable to spend nested p2wkh "+
+			"output %v", outpoint)
+	}
+
+	txns, err := wallet.ListTransactionDetails()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var parentTx *wire.MsgTx
+	for _, txn := range txns {
+		if txn.Hash != outpoint.Hash {
+			continue
+		}
+
+		if txn.NumConfirmations > 0 {
+			return nil, nil, fmt.Errorf("transaction %v is "+
+				"confirmed already", outpoint.Hash)
+		}
+
+		parentTx = &wire.MsgTx{}
+		err := parentTx.Deserialize(bytes.NewReader(txn.RawTx))
+		if err != nil {
+			return nil, nil, err
+		}
+		break
+	}
+	if parentTx == nil {
+		return nil, nil, fmt.Errorf("unable to find transaction %v",
+			outpoint.Hash)
+	}
+
+	parentFee, err := walletTxFee(parentTx, txns)
+	if err != nil {
+		return nil, nil, err
+	}
+	parent := &sweep.ParentTx{
+		Tx:  parentTx,
+		Fee: parentFee,
+	}
+
+	signDesc := &lnwallet.SignDescriptor{
+		Output: &wire.TxOut{
+			PkScript: utxo.PkScript,
+			Value:    int64(utxo.Value),
+		},
+		HashType: txscript.SigHashAll,
+	}
+	input := sweep.NewBaseInput(outpoint, lnwallet.WitnessKeyHash, signDesc)
+
+	return input, parent, nil
+}
+
+// walletTxFee computes the fee paid by the given transaction from the values
+// of the outputs it spends. The fee reported by the wallet can't be used, as
+// it's zero for transactions we received. Therefore, all outputs spent by the
+// transaction must be known to the wallet, which isn't the case for most
+// transactions we received, as their fee can't be determined otherwise.
+func walletTxFee(tx *wire.MsgTx,
+	txns []*lnwallet.TransactionDetail) (btcutil.Amount, error) {
+
+	prevTxs := make(map[chainhash.Hash]*wire.MsgTx)
+	for _, txIn := range tx.TxIn {
+		prevTxs[txIn.PreviousOutPoint.Hash] = nil
+	}
+	for _, txn := range txns {
+		if _, ok := prevTxs[txn.Hash]; !ok {
+			continue
+		}
+
+		prevTx := &wire.MsgTx{}
+		err := prevTx.Deserialize(bytes.NewReader(txn.RawTx))
+		if err != nil {
+			return 0, err
+		}
+		prevTxs[txn.Hash] = prevTx
+	}
+
+	var fee btcutil.Amount
+	for _, txIn := range tx.TxIn {
+		prevOut := txIn.PreviousOutPoint
+		prevTx := prevTxs[prevOut.Hash]
+		if prevTx == nil || int(prevOut.Index) >= len(prevTx.TxOut) {
+			return 0, fmt.Errorf("unable to determine fee of "+
+				"transaction %v, as it spends output %v "+
+				"unknown to the wallet", tx.TxHash(), prevOut)
+		}
+
+		fee += btcutil.Amount(prevTx.TxOut[prevOut.Index].Value)
+	}
+	for _, txOut := range tx.TxOut {
+		fee -= btcutil.Amount(txOut.Value)
+	}
+
+	if fee < 0 {
+		return 0, fmt.Errorf("transaction %v spends less than it "+
+			"creates", tx.TxHash())
+	}
+
+	return fee, nil
+}
+
+// parseOutPoint parses an outpoint of the form txid:index.
+func parseOutPoint(s string) (*wire.OutPoint, error) {
+	parts := strings.Split(s, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("outpoint should be of the form "+
+			"txid:index: %v", s)
+	}
+
+	hash, err := chainhash.NewHashFromStr(parts[0])
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("invalid output index: %v", err)
+	}
+
+	return wire.NewOutPoint(hash, uint32(index)), nil
+}
+
+// AutopilotStatus returns whether the autopilot agent is active, along with
+// its current state and the channel attachments it proposes to make.
+func (r *rpcServer) AutopilotStatus(ctx context.Context,
+	in *lnrpc.AutopilotStatusRequest) (*lnrpc.AutopilotStatusResponse,
+	error) {
+
+	resp := &lnrpc.AutopilotStatusResponse{
+		Heuristic: r.pilot.cfg.Heuristic,
+	}
+
+	status, err := r.pilot.Status()
+	switch {
+	// If the agent isn't running, there's no further state to report.
+	case err == autopilot.ErrAgentNotActive:
+		return resp, nil
+
+	case err != nil:
+		return nil, err
+	}
+
+	resp.Active = true
+	resp.NumChannels = status.NumChannels
+	resp.NumPendingOpens = status.NumPendingOpens
+	resp.NumPendingConns = status.NumPendingConns
+	resp.TotalBalance = int64(status.TotalBalance)
+	resp.NeedMoreChans = status.NeedMoreChans
+	resp.AvailableFunds = int64(status.AvailableFunds)
+
+	for _, directive := range status.Proposals {
+		proposal := &lnrpc.AutopilotProposal{
+			PubKey: hex.EncodeToString(
+				directive.NodeKey.SerializeCompressed(),
+			),
+			ChanAmt: int64(directive.ChanAmt),
+		}
+		for _, addr := range directive.Addrs {
+			proposal.Addresses = append(
+				proposal.Addresses, addr.String(),
+			)
+		}
+
+		resp.Proposals = append(resp.Proposals, proposal)
+	}
+
+	return resp, nil
+}
+
+// ModifyAutopilotStatus enables or disables the autopilot agent at runtime.
+func (r *rpcServer) ModifyAutopilotStatus(ctx context.Context,
+	in *lnrpc.ModifyAutopilotStatusRequest) (
+	*lnrpc.ModifyAutopilotStatusResponse, error) {
+
+	// The agent opens channels through the server, so it can't be
+	// enabled before the server is active.
+	if !r.server.Started() {
+		return nil, fmt.Errorf("chain backend is still syncing, server " +
+			"not active yet")
+	}
+
+	rpcsLog.Infof("[modifyautopilotstatus] enable=%v", in.Enable)
+
+	var err error
+	if in.Enable {
+		err = r.pilot.Start()
+	} else {
+		err = r.pilot.Stop()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.ModifyAutopilotStatusResponse{}, nil
+}
+
+// SetAutopilotScores replaces the node scores used by the externalscore
+// autopilot heuristic.
+func (r *rpcServer) SetAutopilotScores(ctx context.Context,
+	in *lnrpc.SetAutopilotScoresRequest) (
+	*lnrpc.SetAutopilotScoresResponse, error) {
+
+	scores := make(map[autopilot.NodeID]float64, len(in.Scores))
+	for pubStr, score := range in.Scores {
+		pubBytes, err := hex.DecodeString(pubStr)
+		if err != nil {
+			return nil, err
+		}
+		pub, err := btcec.ParsePubKey(pubBytes, btcec.S256())
+		if err != nil {
+			return nil, err
+		}
+
+		scores[autopilot.NewNodeID(pub)] = score
+	}
+
+	rpcsLog.Infof("[setautopilotscores] scores for %v nodes", len(scores))
+
+	if err := r.pilot.SetExternalScores(scores); err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.SetAutopilotScoresResponse{}, nil
+}
+
+// defaultFeeEstimateTargets is the set of confirmation targets EstimateFee
+// returns fee rates for if none are requested.
+var defaultFeeEstimateTargets = []uint32{1, 2, 3, 6, 12, 24, 144}
+
+// EstimateFee returns the fee rates the wallet's fee estimator currently
+// estimates for each of the requested confirmation targets.
+func (r *rpcServer) EstimateFee(ctx context.Context,
+	in *lnrpc.EstimateFeeRequest) (*lnrpc.EstimateFeeResponse, error) {
+
+	targetConfs := in.TargetConfs
+	if len(targetConfs) == 0 {
+		targetConfs = defaultFeeEstimateTargets
+	}
+
+	rpcsLog.Debugf("[estimatefee] target_confs=%v", targetConfs)
+
+	feeEstimator := r.server.cc.feeEstimator
+	estimates := make([]*lnrpc.FeeEstimate, 0, len(targetConfs))
+	for _, targetConf := range targetConfs {
+		if targetConf == 0 {
+			return nil, fmt.Errorf("confirmation target must be " +
+				"greater than zero")
+		}
+
+		feePerKw, err := feeEstimator.EstimateFeePerKW(targetConf)
+		if err != nil {
+			return nil, fmt.Errorf("unable to query fee estimator "+
+				"for target_conf=%v: %v", targetConf, err)
+		}
+
+		estimates = append(estimates, &lnrpc.FeeEstimate{
+			TargetConf:  targetConf,
+			SatPerKw:    int64(feePerKw),
+			SatPerVbyte: int64(feePerKw.FeePerKVByte()) / 1000,
+		})
+	}
+
+	return &lnrpc.EstimateFeeResponse{
+		Estimates: estimates,
+	}, nil
+}
+
+// defaultLeaseDuration is the duration of an output lease if the caller of
+// LeaseOutput doesn't specify one.
+const defaultLeaseDuration = 10 * time.Minute
+
+// cpfpLeaseDuration is the duration of the lease BumpFee takes on a wallet
+// output while a child transaction spending it is pending. The lease is
+// released as soon as the output is spent, so this only bounds how long the
+// output stays locked if we restart in the meantime.
+const cpfpLeaseDuration = 24 * time.Hour
+
+// cpfpLockID is the lock ID BumpFee leases wallet outputs under while a child
+// transaction spending them is pending.
+var cpfpLockID = lnwallet.LockID(sha256.Sum256([]byte("lnd-bumpfee-cpfp")))
+
+// unmarshalLockID converts a lock ID from its RPC representation.
+func unmarshalLockID(id []byte) (lnwallet.LockID, error) {
+	var lockID lnwallet.LockID
+	if len(id) != len(lockID) {
+		return lockID, fmt.Errorf("lock ID must be %v bytes, got %v",
+			len(lockID), len(id))
+	}
+	copy(lockID[:], id)
+
+	return lockID, nil
+}
+
+// unmarshalOptionalLockID converts the lock ID under which any of the passed
+// outpoints are leased from its RPC representation. If no lock ID is set, nil
+// is returned. As leased outputs are only spent if they're passed explicitly,
+// a lock ID without any outpoints is rejected.
+func unmarshalOptionalLockID(id []byte,
+	outpoints []wire.OutPoint) (*lnwallet.LockID, error) {
+
+	if len(id) == 0 {
+		return nil, nil
+	}
+	if len(outpoints) == 0 {
+		return nil, errors.New("lock ID requires the leased outpoints " +
+			"to be passed explicitly")
+	}
+
+	lockID, err := unmarshalLockID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lockID, nil
+}
+
+// LeaseOutput locks an output of the wallet under the given lock ID for the
+// given duration, excluding it from coin selection until the lease is either
+// released or expires.
+func (r *rpcServer) LeaseOutput(ctx context.Context,
+	in *lnrpc.LeaseOutputRequest) (*lnrpc.LeaseOutputResponse, error) {
+
+	lockID, err := unmarshalLockID(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	outpoint, err := unmarshalOutPoint(in.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	duration := defaultLeaseDuration
+	if in.ExpirationSeconds != 0 {
+		duration = time.Duration(in.ExpirationSeconds) * time.Second
+	}
+
+	rpcsLog.Infof("[leaseoutput] outpoint=%v, id=%x, duration=%v",
+		outpoint, lockID[:], duration)
+
+	expiration, err := r.server.cc.wallet.LeaseOutput(
+		lockID, outpoint, duration,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.LeaseOutputResponse{
+		Expiration: uint64(expiration.Unix()),
+	}, nil
+}
+
+// ReleaseOutput releases the lease on an output, making it eligible for coin
+// selection once again.
+func (r *rpcServer) ReleaseOutput(ctx context.Context,
+	in *lnrpc.ReleaseOutputRequest) (*lnrpc.ReleaseOutputResponse, error) {
+
+	lockID, err := unmarshalLockID(in.Id)
+	if err != nil {
+		return nil, err
+	}
+	outpoint, err := unmarshalOutPoint(in.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcsLog.Infof("[releaseoutput] outpoint=%v, id=%x", outpoint,
+		lockID[:])
+
+	err = r.server.cc.wallet.ReleaseOutput(lockID, outpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.ReleaseOutputResponse{}, nil
+}
+
+// ListLeases lists all outputs of the wallet that are currently leased.
+func (r *rpcServer) ListLeases(ctx context.Context,
+	in *lnrpc.ListLeasesRequest) (*lnrpc.ListLeasesResponse, error) {
+
+	leased, err := r.server.cc.wallet.ListLeasedOutputs()
+	if err != nil {
+		return nil, err
+	}
+
+	leases := make([]*lnrpc.UtxoLease, 0, len(leased))
+	for _, lease := range leased {
+		id := lease.LockID
+		leases = append(leases, &lnrpc.UtxoLease{
+			Id:         id[:],
+			Outpoint:   marshalOutPoint(lease.OutPoint),
+			Expiration: uint64(lease.Expiration.Unix()),
+		})
+	}
+
+	return &lnrpc.ListLeasesResponse{
+		Leases: leases,
+	}, nil
+}
+
+// errMacaroonsDisabled is returned by the macaroon related RPCs if lnd was
+// started with macaroons disabled.
+var errMacaroonsDisabled = errors.New("macaroon authentication disabled, " +
+	"remove --no-macaroons flag to enable")
+
+// validMacaroonPermissions returns the set of all entity/action pairs
+// required by any of the RPC calls, which are the only permissions that may
+// be granted by a macaroon baked through BakeMacaroon.
+func validMacaroonPermissions() map[bakery.Op]struct{} {
+	validOps := make(map[bakery.Op]struct{})
+	for _, ops := range permissions {
+		for _, op := range ops {
+			validOps[op] = struct{}{}
+		}
+	}
+
+	return validOps
+}
+
+// marshalRootKeyID converts the ID of a macaroon root key into its database
+// representation, the decimal string of the ID.
+func marshalRootKeyID(id uint64) []byte {
+	return []byte(strconv.FormatUint(id, 10))
+}
+
+// BakeMacaroon allows the creation of a new macaroon with custom permissions.
+// The macaroon is baked with the root key of the requested ID, which is
+// created if it doesn't exist yet.
+func (r *rpcServer) BakeMacaroon(ctx context.Context,
+	in *lnrpc.BakeMacaroonRequest) (*lnrpc.BakeMacaroonResponse, error) {
+
+	if r.macService == nil {
+		return nil, errMacaroonsDisabled
+	}
+
+	if len(in.Permissions) == 0 {
+		return nil, fmt.Errorf("permission list cannot be empty")
+	}
+
+	// We'll only allow the entity/action pairs that are actually used by
+	// the RPC server to be baked into a macaroon.
+	validOps := validMacaroonPermissions()
+	ops := make([]bakery.Op, 0, len(in.Permissions))
+	for _, perm := range in.Permissions {
+		op := bakery.Op{
+			Entity: perm.Entity,
+			Action: perm.Action,
+		}
+		if _, ok := validOps[op]; !ok {
+			return nil, fmt.Errorf("invalid permission %v:%v",
+				op.Entity, op.Action)
+		}
+
+		ops = append(ops, op)
+	}
+
+	rpcsLog.Infof("[bakemacaroon] root_key_id=%v, permissions=%v",
+		in.RootKeyId, ops)
+
+	mac, err := r.macService.NewMacaroon(
+		ctx, marshalRootKeyID(in.RootKeyId), ops...,
+	)
+	if err != nil {
+		return nil, err
+	}
+	macBytes, err := mac.M().MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.BakeMacaroonResponse{
+		Macaroon: hex.EncodeToString(macBytes),
+	}, nil
+}
+
+// ListMacaroonIDs returns the IDs of all root keys macaroons have been baked
+// with.
+func (r *rpcServer) ListMacaroonIDs(ctx context.Context,
+	in *lnrpc.ListMacaroonIDsRequest) (*lnrpc.ListMacaroonIDsResponse,
+	error) {
+
+	if r.macService == nil {
+		return nil, errMacaroonsDisabled
+	}
+
+	rootKeyIDs, err := r.macService.ListMacaroonIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]uint64, 0, len(rootKeyIDs))
+	for _, rootKeyID := range rootKeyIDs {
+		id, err := strconv.ParseUint(string(rootKeyID), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid root key ID %q: %v",
+				rootKeyID, err)
+		}
+
+		ids = append(ids, id)
+	}
+
+	// The IDs are stored as strings, so we'll sort them numerically.
+	sort.Slice(ids, func(i, j int) bool {
+		return ids[i] < ids[j]
+	})
+
+	return &lnrpc.ListMacaroonIDsResponse{
+		RootKeyIds: ids,
+	}, nil
+}
+
+// DeleteMacaroonID deletes the root key of the given ID, revoking all
+// macaroons baked with it.
+func (r *rpcServer) DeleteMacaroonID(ctx context.Context,
+	in *lnrpc.DeleteMacaroonIDRequest) (*lnrpc.DeleteMacaroonIDResponse,
+	error) {
+
+	if r.macService == nil {
+		return nil, errMacaroonsDisabled
+	}
+
+	rpcsLog.Infof("[deletemacaroonid] root_key_id=%v", in.RootKeyId)
+
+	deletedID, err := r.macService.DeleteMacaroonID(
+		ctx, marshalRootKeyID(in.RootKeyId),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.DeleteMacaroonIDResponse{
+		Deleted: deletedID != nil,
+	}, nil
+}
@@ -0,0 +1,64 @@
+// +build !rpctest
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// TestWalletTxFee checks that the fee of a wallet transaction is computed
+// from the outputs it spends, and that transactions spending outputs unknown
+// to the wallet are rejected.
+func TestWalletTxFee(t *testing.T) {
+	t.Parallel()
+
+	walletTxn := func(tx *wire.MsgTx) *lnwallet.TransactionDetail {
+		var b bytes.Buffer
+		if err := tx.Serialize(&b); err != nil {
+			t.Fatalf("unable to serialize tx: %v", err)
+		}
+
+		return &lnwallet.TransactionDetail{
+			Hash:  tx.TxHash(),
+			RawTx: b.Bytes(),
+		}
+	}
+
+	prevTx := wire.NewMsgTx(2)
+	prevTx.AddTxIn(&wire.TxIn{})
+	prevTx.AddTxOut(&wire.TxOut{Value: 100000})
+	prevTx.AddTxOut(&wire.TxOut{Value: 50000})
+	prevHash := prevTx.TxHash()
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: prevHash, Index: 0},
+	})
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Hash: prevHash, Index: 1},
+	})
+	tx.AddTxOut(&wire.TxOut{Value: 140000})
+
+	txns := []*lnwallet.TransactionDetail{walletTxn(prevTx), walletTxn(tx)}
+	fee, err := walletTxFee(tx, txns)
+	if err != nil {
+		t.Fatalf("unable to compute fee: %v", err)
+	}
+	if fee != btcutil.Amount(10000) {
+		t.Fatalf("expected fee of 10000, got %v", fee)
+	}
+
+	// A transaction spending an output the wallet doesn't know, such as
+	// one we received, should be rejected.
+	tx.AddTxIn(&wire.TxIn{
+		PreviousOutPoint: wire.OutPoint{Index: 0},
+	})
+	if _, err := walletTxFee(tx, txns); err == nil {
+		t.Fatalf("expected error computing fee with unknown input")
+	}
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/lightningnetwork/lightning-onion"
 	"github.com/lightningnetwork/lnd/autopilot"
 	"github.com/lightningnetwork/lnd/brontide"
+	"github.com/lightningnetwork/lnd/chanacceptor"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/contractcourt"
 	"github.com/lightningnetwork/lnd/discovery"
@@ -36,8 +37,10 @@ import (
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/nat"
 	"github.com/lightningnetwork/lnd/routing"
+	"github.com/lightningnetwork/lnd/sweep"
 	"github.com/lightningnetwork/lnd/ticker"
 	"github.com/lightningnetwork/lnd/tor"
+	"github.com/lightningnetwork/lnd/zpay32"
 )
 
 const (
@@ -68,6 +71,11 @@ var (
 	// ErrServerShuttingDown indicates that the server is in the process of
 	// gracefully exiting.
 	ErrServerShuttingDown = errors.New("server is shutting down")
+
+	// ErrClearnetDisabled is returned when attempting to connect to a peer
+	// over clearnet while only onion service connections are allowed.
+	ErrClearnetDisabled = errors.New("clearnet connections are disabled " +
+		"in Tor onion-only mode")
 )
 
 // server is the main server of the Lightning Network Daemon. The server houses
@@ -96,6 +104,10 @@ type server struct {
 	// creating and setting up onion services, etc.
 	torController *tor.Controller
 
+	// torKeyPassword, if set, is the password the private key of our onion
+	// service is encrypted with at rest.
+	torKeyPassword []byte
+
 	// natTraversal is the specific NAT traversal technique used to
 	// automatically set up port forwarding rules in order to advertise to
 	// the network that the node is accepting inbound connections.
@@ -140,6 +152,13 @@ type server struct {
 
 	htlcSwitch *htlcswitch.Switch
 
+	htlcNotifier *htlcswitch.HtlcNotifier
+
+	// chanAcceptor decides whether inbound channels are accepted. It
+	// always enforces the configured rules, and RPC acceptors are added
+	// to it as clients connect.
+	chanAcceptor *chanacceptor.ChainedAcceptor
+
 	invoices *invoiceRegistry
 
 	witnessBeacon contractcourt.WitnessBeacon
@@ -148,10 +167,14 @@ type server struct {
 
 	chanRouter *routing.ChannelRouter
 
+	rebalancer *rebalancer
+
 	authGossiper *discovery.AuthenticatedGossiper
 
 	utxoNursery *utxoNursery
 
+	sweeper *sweep.UtxoSweeper
+
 	chainArb *contractcourt.ChainArbitrator
 
 	sphinx *htlcswitch.OnionProcessor
@@ -214,11 +237,45 @@ func parseAddr(address string) (net.Addr, error) {
 }
 
 // noiseDial is a factory function which creates a connmgr compliant dialing
-// function by returning a closure which includes the server's identity key.
-func noiseDial(idPriv *btcec.PrivateKey) func(net.Addr) (net.Conn, error) {
+// function by returning a closure which dials peers through the server.
+func noiseDial(s *server) func(net.Addr) (net.Conn, error) {
 	return func(a net.Addr) (net.Conn, error) {
-		lnAddr := a.(*lnwire.NetAddress)
-		return brontide.Dial(idPriv, lnAddr, cfg.net.Dial)
+		conn, err := s.dialPeer(a.(*lnwire.NetAddress))
+		if err != nil {
+			return nil, err
+		}
+		return conn, nil
+	}
+}
+
+// checkPeerAddr ensures that we're allowed to connect to the passed peer
+// address, which must be an onion service while Tor onion-only mode is active.
+func checkPeerAddr(addr *lnwire.NetAddress) error {
+	if !cfg.Tor.OnionOnly {
+		return nil
+	}
+
+	if _, ok := addr.Address.(*tor.OnionAddr); !ok {
+		return ErrClearnetDisabled
+	}
+
+	return nil
+}
+
+// peerDialer returns the function used to dial the passed peer. If Tor peer
+// isolation is active, connections to the peer will be isolated on a circuit
+// by its identity key.
+func peerDialer(
+	addr *lnwire.NetAddress) func(string, string) (net.Conn, error) {
+
+	proxyNet, ok := cfg.net.(*tor.ProxyNet)
+	if !ok || !cfg.Tor.PeerIsolation {
+		return cfg.net.Dial
+	}
+
+	isolationKey := addr.IdentityKey.SerializeCompressed()
+	return func(network, address string) (net.Conn, error) {
+		return proxyNet.DialIsolated(network, address, isolationKey)
 	}
 }
 
@@ -308,6 +365,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		return nil, err
 	}
 
+	s.htlcNotifier = htlcswitch.NewHtlcNotifier()
 	s.htlcSwitch, err = htlcswitch.New(htlcswitch.Config{
 		DB:      chanDB,
 		SelfKey: s.identityPriv.PubKey(),
@@ -342,6 +400,16 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			htlcswitch.DefaultFwdEventInterval),
 		LogEventTicker: ticker.New(
 			htlcswitch.DefaultLogInterval),
+		ResolveOrphanedPayment: func(paymentHash [32]byte,
+			preimage [32]byte, err error) error {
+
+			// The router is created after the switch, but will
+			// be set by the time the switch is started.
+			return s.chanRouter.ResolveOrphanedPayment(
+				paymentHash, preimage, err,
+			)
+		},
+		HtlcNotifier: s.htlcNotifier,
 	}, uint32(currentHeight))
 	if err != nil {
 		return nil, err
@@ -548,12 +616,31 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 			// for the available bandwidth for the link.
 			return link.Bandwidth()
 		},
+		PaymentInFlight:    s.htlcSwitch.LocalPaymentInFlight,
 		AssumeChannelValid: cfg.Routing.UseAssumeChannelValid(),
 	})
 	if err != nil {
 		return nil, fmt.Errorf("can't create router: %v", err)
 	}
 
+	s.rebalancer = newRebalancer(&rebalancerConfig{
+		SelfPub:            privKey.PubKey(),
+		ChainParams:        activeNetParams.Params,
+		FindCircularRoutes: s.chanRouter.FindCircularRoutes,
+		SendToRoute:        s.chanRouter.SendToRoute,
+		AddInvoice:         s.invoices.AddInvoice,
+		DeleteInvoice:      s.invoices.DeleteInvoice,
+		SignInvoice: zpay32.MessageSigner{
+			SignCompact: s.nodeSigner.SignDigestCompact,
+		},
+		FetchAllOpenChannels: chanDB.FetchAllOpenChannels,
+		AddForwardingEvents:  chanDB.ForwardingLog().AddForwardingEvents,
+		Auto:                 cfg.Rebalance.Auto,
+		Interval:             cfg.Rebalance.Interval,
+		Threshold:            cfg.Rebalance.Threshold,
+		MaxFeePercent:        cfg.Rebalance.MaxFeePercent,
+	})
+
 	s.authGossiper, err = discovery.New(discovery.Config{
 		Router:     s.chanRouter,
 		Notifier:   s.cc.chainNotifier,
@@ -570,6 +657,11 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		RetransmitDelay:  time.Minute * 30,
 		DB:               chanDB,
 		AnnSigner:        s.nodeSigner,
+		NumActiveSyncers: cfg.NumGraphSyncPeers,
+		RotateTicker: ticker.New(
+			discovery.DefaultSyncerRotationInterval,
+		),
+		HistoricalSyncTicker: ticker.New(cfg.HistoricalSyncInterval),
 	},
 		s.identityPriv.PubKey(),
 	)
@@ -583,18 +675,31 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		return nil, err
 	}
 
-	s.utxoNursery = newUtxoNursery(&NurseryConfig{
-		ChainIO:   cc.chainIO,
-		ConfDepth: 1,
-		DB:        chanDB,
-		Estimator: cc.feeEstimator,
+	s.sweeper = sweep.New(&sweep.UtxoSweeperConfig{
 		GenSweepScript: func() ([]byte, error) {
 			return newSweepPkScript(cc.wallet)
 		},
+		FeeEstimator:       cc.feeEstimator,
+		PublishTransaction: cc.wallet.PublishTransaction,
+		NewBatchTimer: func() <-chan time.Time {
+			return time.NewTimer(sweep.DefaultBatchWindowDuration).C
+		},
+		Notifier:       cc.chainNotifier,
+		ChainIO:        cc.chainIO,
+		Signer:         cc.wallet.Cfg.Signer,
+		MaxInputsPerTx: sweep.DefaultMaxInputsPerTx,
+		BumpInterval:   sweep.DefaultBumpInterval,
+		MaxFeeRate:     sweep.DefaultMaxFeeRate,
+	})
+
+	s.utxoNursery = newUtxoNursery(&NurseryConfig{
+		ChainIO:            cc.chainIO,
+		ConfDepth:          1,
+		DB:                 chanDB,
 		Notifier:           cc.chainNotifier,
 		PublishTransaction: cc.wallet.PublishTransaction,
-		Signer:             cc.wallet.Cfg.Signer,
 		Store:              utxnStore,
+		Sweeper:            s.sweeper,
 	})
 
 	// Construct a closure that wraps the htlcswitch's CloseLink method.
@@ -652,6 +757,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		Signer:       cc.wallet.Cfg.Signer,
 		FeeEstimator: cc.feeEstimator,
 		ChainIO:      cc.chainIO,
+		Sweeper:      s.sweeper,
 		MarkLinkInactive: func(chanPoint wire.OutPoint) error {
 			chanID := lnwire.NewChanIDFromOutPoint(&chanPoint)
 			s.htlcSwitch.RemoveLink(chanID)
@@ -720,6 +826,23 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 	if _, err := rand.Read(chanIDSeed[:]); err != nil {
 		return nil, err
 	}
+	// Before creating the funding manager, we'll set up the acceptor which
+	// applies the operator's policy to inbound channels.
+	acceptorRules, err := cfg.ChanAcceptor.rules()
+	if err != nil {
+		return nil, err
+	}
+	s.chanAcceptor = chanacceptor.NewChainedAcceptor()
+	s.chanAcceptor.AddAcceptor(chanacceptor.NewRuleAcceptor(
+		acceptorRules, func(node *btcec.PublicKey) (int, error) {
+			channels, err := chanDB.FetchOpenChannels(node)
+			if err != nil {
+				return 0, err
+			}
+			return len(channels), nil
+		},
+	))
+
 	s.fundingMgr, err = newFundingManager(fundingConfig{
 		IDKey:              privKey.PubKey(),
 		Wallet:             cc.wallet,
@@ -877,6 +1000,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		ZombieSweeperInterval: 2 * time.Minute,
 		ReservationTimeout:    10 * time.Minute,
 		MinChanSize:           btcutil.Amount(cfg.MinChanSize),
+		OpenChannelPredicate:  s.chanAcceptor,
 	})
 	if err != nil {
 		return nil, err
@@ -890,7 +1014,7 @@ func newServer(listenAddrs []net.Addr, chanDB *channeldb.DB, cc *chainControl,
 		OnAccept:       s.InboundPeerConnected,
 		RetryDuration:  time.Second * 5,
 		TargetOutbound: 100,
-		Dial:           noiseDial(s.identityPriv),
+		Dial:           noiseDial(s),
 		OnConnection:   s.OutboundPeerConnected,
 	})
 	if err != nil {
@@ -938,9 +1062,15 @@ func (s *server) Start() error {
 	if err := s.sphinx.Start(); err != nil {
 		return err
 	}
+	if err := s.htlcNotifier.Start(); err != nil {
+		return err
+	}
 	if err := s.htlcSwitch.Start(); err != nil {
 		return err
 	}
+	if err := s.sweeper.Start(); err != nil {
+		return err
+	}
 	if err := s.utxoNursery.Start(); err != nil {
 		return err
 	}
@@ -964,6 +1094,9 @@ func (s *server) Start() error {
 	if err := s.invoices.Start(); err != nil {
 		return err
 	}
+	if err := s.rebalancer.Start(); err != nil {
+		return err
+	}
 
 	// With all the relevant sub-systems started, we'll now attempt to
 	// establish persistent connections to our direct channel collaborators
@@ -1244,12 +1377,15 @@ func (s *server) Stop() error {
 	// Shutdown the wallet, funding manager, and the rpc server.
 	s.cc.chainNotifier.Stop()
 	s.chanRouter.Stop()
+	s.rebalancer.Stop()
 	s.htlcSwitch.Stop()
+	s.htlcNotifier.Stop()
 	s.sphinx.Stop()
 	s.utxoNursery.Stop()
 	s.breachArbiter.Stop()
 	s.authGossiper.Stop()
 	s.chainArb.Stop()
+	s.sweeper.Stop()
 	s.cc.wallet.Shutdown()
 	s.cc.chainView.Stop()
 	s.connMgr.Stop()
@@ -1460,8 +1596,9 @@ func initNetworkBootstrappers(s *server) ([]discovery.NetworkPeerBootstrapper, e
 	bootStrappers = append(bootStrappers, graphBootstrapper)
 
 	// If this isn't simnet mode, then one of our additional bootstrapping
-	// sources will be the set of running DNS seeds.
-	if !cfg.Bitcoin.SimNet || !cfg.Litecoin.SimNet {
+	// sources will be the set of running DNS seeds. They're skipped in Tor
+	// onion-only mode, as they'd mostly hand us clearnet addresses.
+	if (!cfg.Bitcoin.SimNet || !cfg.Litecoin.SimNet) && !cfg.Tor.OnionOnly {
 		dnsSeeds, ok := chainDNSSeeds[*activeNetParams.GenesisHash]
 
 		// If we have a set of DNS seeds for this chain, then we'll add
@@ -1711,9 +1848,10 @@ func (s *server) initTorController() error {
 	// create our onion service. The service's private key will be saved to
 	// disk in order to regain access to this service when restarting `lnd`.
 	onionCfg := tor.AddOnionConfig{
-		VirtualPort:    defaultPeerPort,
-		TargetPorts:    listenPorts,
-		PrivateKeyPath: cfg.Tor.PrivateKeyPath,
+		VirtualPort:        defaultPeerPort,
+		TargetPorts:        listenPorts,
+		PrivateKeyPath:     cfg.Tor.PrivateKeyPath,
+		PrivateKeyPassword: s.torKeyPassword,
 	}
 
 	switch {
@@ -1878,6 +2016,21 @@ func (s *server) establishPersistentConnections() error {
 		return err
 	}
 
+	// We'll also load the backoffs recorded for our peers by a previous
+	// run, so that unreliable peers aren't retried aggressively after a
+	// restart.
+	addrBooks, err := s.chanDB.FetchAllPeerAddrBooks()
+	if err != nil {
+		return err
+	}
+	persistedBackoffs := make(map[string]time.Duration)
+	for _, book := range addrBooks {
+		if book.Backoff != 0 {
+			pubStr := string(book.PubKey[:])
+			persistedBackoffs[pubStr] = book.Backoff
+		}
+	}
+
 	// Acquire and hold server lock until all persistent connection requests
 	// have been recorded and sent to the connection manager.
 	s.mu.Lock()
@@ -1890,7 +2043,11 @@ func (s *server) establishPersistentConnections() error {
 		// persistent connection with.
 		s.persistentPeers[pubStr] = struct{}{}
 		if _, ok := s.persistentPeersBackoff[pubStr]; !ok {
-			s.persistentPeersBackoff[pubStr] = defaultBackoff
+			backoff, ok := persistedBackoffs[pubStr]
+			if !ok {
+				backoff = defaultBackoff
+			}
+			s.persistentPeersBackoff[pubStr] = backoff
 		}
 
 		for _, address := range nodeAddr.addresses {
@@ -1901,6 +2058,13 @@ func (s *server) establishPersistentConnections() error {
 				IdentityKey: nodeAddr.pubKey,
 				Address:     address,
 			}
+
+			// Clearnet addresses are skipped in Tor onion-only
+			// mode, as we wouldn't be able to dial them.
+			if checkPeerAddr(lnAddr) != nil {
+				continue
+			}
+
 			srvrLog.Debugf("Attempting persistent connection to "+
 				"channel peer %v", lnAddr)
 
@@ -1936,6 +2100,19 @@ func (s *server) prunePersistentPeerConnection(compressedPubKey [33]byte) {
 	delete(s.persistentPeersBackoff, pubKeyStr)
 	s.cancelConnReqs(pubKeyStr, nil)
 	s.mu.Unlock()
+
+	// As we no longer maintain a connection with the peer, the backoff
+	// recorded within its address book is cleared as well.
+	pubKey, err := btcec.ParsePubKey(compressedPubKey[:], btcec.S256())
+	if err != nil {
+		srvrLog.Errorf("Unable to parse pubkey %x: %v",
+			compressedPubKey, err)
+		return
+	}
+	if err := s.chanDB.PutPeerBackoff(pubKey, 0); err != nil {
+		srvrLog.Errorf("Unable to clear backoff for peer %x: %v",
+			compressedPubKey, err)
+	}
 }
 
 // BroadcastMessage sends a request to the server to broadcast a set of
@@ -2641,6 +2818,34 @@ func (s *server) peerTerminationWatcher(p *peer, ready chan struct{}) {
 		p.server.htlcSwitch.RemoveLink(link.ChanID())
 	}
 
+	// If this is a persistent peer, then we'll look up the address to
+	// reconnect to it with before acquiring the server's mutex, as doing
+	// so requires reading from the database.
+	pubStr := string(pubKey.SerializeCompressed())
+	s.mu.RLock()
+	_, persistent := s.persistentPeers[pubStr]
+	s.mu.RUnlock()
+
+	var reconnectAddr net.Addr
+	if persistent {
+		reconnectAddr = s.persistentPeerAddr(p)
+	}
+
+	// If we end up scheduling a reconnection, the backoff of the peer will
+	// be persisted once the server's mutex has been released.
+	var backoff time.Duration
+	defer func() {
+		if backoff == 0 {
+			return
+		}
+
+		err := s.chanDB.PutPeerBackoff(pubKey, backoff)
+		if err != nil {
+			srvrLog.Errorf("Unable to persist backoff for peer "+
+				"%v: %v", p, err)
+		}
+	}()
+
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
@@ -2670,7 +2875,6 @@ func (s *server) peerTerminationWatcher(p *peer, ready chan struct{}) {
 	s.removePeer(p)
 
 	// Next, check to see if this is a persistent peer or not.
-	pubStr := string(pubKey.SerializeCompressed())
 	_, ok := s.persistentPeers[pubStr]
 	if ok {
 		// We'll only need to re-launch a connection request if one
@@ -2679,65 +2883,126 @@ func (s *server) peerTerminationWatcher(p *peer, ready chan struct{}) {
 			return
 		}
 
-		// We'll ensure that we locate an advertised address to use
-		// within the peer's address for reconnection purposes.
-		//
-		// TODO(roasbeef): use them all?
-		if p.inbound {
-			advertisedAddr, err := s.fetchNodeAdvertisedAddr(
-				pubKey,
-			)
-			if err != nil {
-				srvrLog.Errorf("Unable to retrieve advertised "+
-					"address for node %x: %v",
-					pubKey.SerializeCompressed(), err)
-			} else {
-				p.addr.Address = advertisedAddr
-			}
-		}
+		backoff = s.connectToPersistentPeer(p, reconnectAddr)
+	}
+}
 
-		// Otherwise, we'll launch a new connection request in order to
-		// attempt to maintain a persistent connection with this peer.
-		connReq := &connmgr.ConnReq{
-			Addr:      p.addr,
-			Permanent: true,
-		}
-		s.persistentConnReqs[pubStr] = append(
-			s.persistentConnReqs[pubStr], connReq)
-
-		// Record the computed backoff in the backoff map.
-		backoff := s.nextPeerBackoff(pubStr, p.StartTime())
-		s.persistentPeersBackoff[pubStr] = backoff
-
-		// Initialize a retry canceller for this peer if one does not
-		// exist.
-		cancelChan, ok := s.persistentRetryCancels[pubStr]
-		if !ok {
-			cancelChan = make(chan struct{})
-			s.persistentRetryCancels[pubStr] = cancelChan
+// connectToPersistentPeer schedules a connection request to the persistent
+// peer at the passed address once its backoff has elapsed, and returns the
+// backoff. If the address is nil, the address of the peer is reused. The
+// caller is expected to persist the backoff, such that it carries over across
+// restarts.
+//
+// NOTE: This MUST be called with the server's mutex held.
+func (s *server) connectToPersistentPeer(p *peer,
+	addr net.Addr) time.Duration {
+
+	pubKey := p.addr.IdentityKey
+	pubStr := string(pubKey.SerializeCompressed())
+
+	// We'll ensure that we use the best address we know of for
+	// reconnection purposes, as the peer may have connected to us, or we
+	// may have connected to it through a less reliable address.
+	if addr != nil {
+		p.addr.Address = addr
+	} else {
+		srvrLog.Errorf("Unable to find address to reconnect to node "+
+			"%x", pubKey.SerializeCompressed())
+	}
+
+	// Next, we'll launch a new connection request in order to attempt to
+	// maintain a persistent connection with this peer.
+	connReq := &connmgr.ConnReq{
+		Addr:      p.addr,
+		Permanent: true,
+	}
+	s.persistentConnReqs[pubStr] = append(
+		s.persistentConnReqs[pubStr], connReq)
+
+	// Record the computed backoff in the backoff map.
+	backoff := s.nextPeerBackoff(pubStr, p.StartTime())
+	s.persistentPeersBackoff[pubStr] = backoff
+
+	// Initialize a retry canceller for this peer if one does not exist.
+	cancelChan, ok := s.persistentRetryCancels[pubStr]
+	if !ok {
+		cancelChan = make(chan struct{})
+		s.persistentRetryCancels[pubStr] = cancelChan
+	}
+
+	// We choose not to wait group this go routine since the Connect call
+	// can stall for arbitrarily long if we shutdown while an outbound
+	// connection attempt is being made.
+	go func() {
+		srvrLog.Debugf("Scheduling connection re-establishment to "+
+			"persistent peer %v in %s", p, backoff)
+
+		select {
+		case <-time.After(backoff):
+		case <-cancelChan:
+			return
+		case <-s.quit:
+			return
 		}
 
-		// We choose not to wait group this go routine since the Connect
-		// call can stall for arbitrarily long if we shutdown while an
-		// outbound connection attempt is being made.
-		go func() {
-			srvrLog.Debugf("Scheduling connection re-establishment to "+
-				"persistent peer %v in %s", p, backoff)
+		srvrLog.Debugf("Attempting to re-establish persistent "+
+			"connection to peer %v", p)
 
-			select {
-			case <-time.After(backoff):
-			case <-cancelChan:
-				return
-			case <-s.quit:
-				return
-			}
+		s.connMgr.Connect(connReq)
+	}()
+
+	return backoff
+}
+
+// persistentPeerAddr returns the address with the highest score within the
+// address book of the persistent peer, among the addresses it advertises, the
+// ones recorded within its address book, and the one we were connected to if
+// the connection was outbound. Nil is returned if no address can be dialed.
+func (s *server) persistentPeerAddr(p *peer) net.Addr {
+	pubKey := p.addr.IdentityKey
+
+	var candidates []net.Addr
+	if !p.inbound {
+		candidates = append(candidates, p.addr.Address)
+	}
+
+	advertisedAddrs, err := s.fetchNodeAdvertisedAddrs(pubKey)
+	if err != nil && err != channeldb.ErrGraphNodeNotFound {
+		srvrLog.Errorf("Unable to retrieve advertised addresses for "+
+			"node %x: %v", pubKey.SerializeCompressed(), err)
+	}
+	candidates = append(candidates, advertisedAddrs...)
+
+	book, err := s.chanDB.FetchPeerAddrBook(pubKey)
+	if err != nil {
+		srvrLog.Errorf("Unable to retrieve address book for node "+
+			"%x: %v", pubKey.SerializeCompressed(), err)
+	} else {
+		for _, stats := range book.Addrs {
+			candidates = append(candidates, stats.Address)
+		}
+	}
 
-			srvrLog.Debugf("Attempting to re-establish persistent "+
-				"connection to peer %v", p)
+	// Only the addresses we're able to dial given our Tor configuration
+	// are considered.
+	var dialable []net.Addr
+	for _, addr := range candidates {
+		lnAddr := &lnwire.NetAddress{
+			IdentityKey: pubKey,
+			Address:     addr,
+		}
+		if checkPeerAddr(lnAddr) != nil {
+			continue
+		}
 
-			s.connMgr.Connect(connReq)
-		}()
+		if _, ok := addr.(*tor.OnionAddr); ok && !cfg.Tor.Active {
+			continue
+		}
+
+		dialable = append(dialable, addr)
 	}
+
+	return selectPeerAddr(dialable, book)
 }
 
 // removePeer removes the passed peer from the server's state of all active
@@ -2799,6 +3064,18 @@ type openChanReq struct {
 	// output selected to fund the channel should satisfy.
 	minConfs int32
 
+	// outpoints is an optional set of wallet outputs that the funding
+	// transaction should be funded from.
+	outpoints []wire.OutPoint
+
+	// lockID is the optional ID under which any of the outpoints are
+	// leased.
+	lockID *lnwallet.LockID
+
+	// coinSelectionStrategy is the strategy used to select the outputs
+	// that fund the channel.
+	coinSelectionStrategy lnwallet.CoinSelectionStrategy
+
 	// TODO(roasbeef): add ability to specify channel constraints as well
 
 	updates chan *lnrpc.OpenStatusUpdate
@@ -2811,6 +3088,11 @@ type openChanReq struct {
 //
 // NOTE: This function is safe for concurrent access.
 func (s *server) ConnectToPeer(addr *lnwire.NetAddress, perm bool) error {
+	// Refuse the connection request upfront if we're not allowed to dial
+	// the address, rather than having the connection manager retry it.
+	if err := checkPeerAddr(addr); err != nil {
+		return err
+	}
 
 	targetPub := string(addr.IdentityKey.SerializeCompressed())
 
@@ -2880,7 +3162,7 @@ func (s *server) ConnectToPeer(addr *lnwire.NetAddress, perm bool) error {
 // notify the caller if the connection attempt has failed. Otherwise, it will be
 // closed.
 func (s *server) connectToPeer(addr *lnwire.NetAddress, errChan chan<- error) {
-	conn, err := brontide.Dial(s.identityPriv, addr, cfg.net.Dial)
+	conn, err := s.dialPeer(addr)
 	if err != nil {
 		srvrLog.Errorf("Unable to connect to %v: %v", addr, err)
 		select {
@@ -2895,6 +3177,48 @@ func (s *server) connectToPeer(addr *lnwire.NetAddress, errChan chan<- error) {
 	s.OutboundPeerConnected(nil, conn)
 }
 
+// dialPeer establishes an encrypted and authenticated connection to the peer at
+// the passed address. If the peer is a persistent peer, the outcome of the
+// attempt is recorded within its address book.
+func (s *server) dialPeer(addr *lnwire.NetAddress) (*brontide.Conn, error) {
+	if err := checkPeerAddr(addr); err != nil {
+		return nil, err
+	}
+
+	// Only the addresses of our persistent peers are recorded, such that
+	// the address books don't grow with every peer we ever dial.
+	pubStr := string(addr.IdentityKey.SerializeCompressed())
+	s.mu.RLock()
+	_, persistent := s.persistentPeers[pubStr]
+	s.mu.RUnlock()
+
+	dialStart := time.Now()
+	conn, err := brontide.Dial(s.identityPriv, addr, peerDialer(addr))
+	if !persistent {
+		return conn, err
+	}
+	if err != nil {
+		dbErr := s.chanDB.RecordPeerAddrFailure(
+			addr.IdentityKey, addr.Address,
+		)
+		if dbErr != nil {
+			srvrLog.Errorf("Unable to record failed connection "+
+				"to %v: %v", addr, dbErr)
+		}
+		return nil, err
+	}
+
+	err = s.chanDB.RecordPeerAddrSuccess(
+		addr.IdentityKey, addr.Address, time.Since(dialStart),
+	)
+	if err != nil {
+		srvrLog.Errorf("Unable to record connection to %v: %v", addr,
+			err)
+	}
+
+	return conn, nil
+}
+
 // DisconnectPeer sends the request to server to close the connection with peer
 // identified by public key.
 //
@@ -2904,13 +3228,13 @@ func (s *server) DisconnectPeer(pubKey *btcec.PublicKey) error {
 	pubStr := string(pubBytes)
 
 	s.mu.Lock()
-	defer s.mu.Unlock()
 
 	// Check that were actually connected to this peer. If not, then we'll
 	// exit in an error as we can't disconnect from a peer that we're not
 	// currently connected to.
 	peer, err := s.findPeerByPubStr(pubStr)
 	if err == ErrPeerNotConnected {
+		s.mu.Unlock()
 		return fmt.Errorf("peer %x is not connected", pubBytes)
 	}
 
@@ -2929,6 +3253,14 @@ func (s *server) DisconnectPeer(pubKey *btcec.PublicKey) error {
 	// peer.
 	s.removePeer(peer)
 	s.ignorePeerTermination[peer] = struct{}{}
+	s.mu.Unlock()
+
+	// Finally, we'll clear the backoff recorded within the address book of
+	// the peer, now that we no longer hold the server's mutex.
+	if err := s.chanDB.PutPeerBackoff(pubKey, 0); err != nil {
+		srvrLog.Errorf("Unable to clear backoff for peer %x: %v",
+			pubBytes, err)
+	}
 
 	return nil
 }
@@ -3041,18 +3373,17 @@ func computeNextBackoff(currBackoff time.Duration) time.Duration {
 	return nextBackoff + (time.Duration(wiggle.Uint64()) - margin/2)
 }
 
-// fetchNodeAdvertisedAddr attempts to fetch an advertised address of a node.
-func (s *server) fetchNodeAdvertisedAddr(pub *btcec.PublicKey) (net.Addr, error) {
+// fetchNodeAdvertisedAddrs attempts to fetch the advertised addresses of a
+// node.
+func (s *server) fetchNodeAdvertisedAddrs(
+	pub *btcec.PublicKey) ([]net.Addr, error) {
+
 	node, err := s.chanDB.ChannelGraph().FetchLightningNode(pub)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(node.Addresses) == 0 {
-		return nil, errors.New("no advertised addresses found")
-	}
-
-	return node.Addresses[0], nil
+	return node.Addresses, nil
 }
 
 // announceChanStatus disables a channel if disabled=true, otherwise activates
@@ -0,0 +1,124 @@
+package sweep
+
+import (
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// Input contains all of the information needed to sign and spend an output we
+// control, and is the unit of work handed to the UtxoSweeper.
+type Input interface {
+	// OutPoint returns the reference to the output being spent, used to
+	// construct the corresponding transaction input.
+	OutPoint() *wire.OutPoint
+
+	// WitnessType returns an enum specifying the type of witness that must
+	// be generated in order to spend this output.
+	WitnessType() lnwallet.WitnessType
+
+	// SignDesc returns a reference to a spendable output's sign
+	// descriptor, which is used during signing to compute a valid witness
+	// that spends this output.
+	SignDesc() *lnwallet.SignDescriptor
+
+	// BuildWitness returns a valid witness allowing this output to be
+	// spent, the witness should be attached to the transaction at the
+	// location determined by the given `txinIdx`.
+	BuildWitness(signer lnwallet.Signer, txn *wire.MsgTx,
+		hashCache *txscript.TxSigHashes,
+		txinIdx int) ([][]byte, error)
+
+	// BlocksToMaturity returns the relative timelock, as a number of
+	// blocks, that must be built on top of the confirmation height before
+	// the output can be spent. For non-CSV locked inputs this is always
+	// zero.
+	BlocksToMaturity() uint32
+}
+
+// BaseInput contains all the information needed to sweep an output that can
+// be spent as soon as it confirmed.
+type BaseInput struct {
+	outpoint    wire.OutPoint
+	witnessType lnwallet.WitnessType
+	signDesc    lnwallet.SignDescriptor
+}
+
+// NewBaseInput creates a new BaseInput for the given outpoint, which is
+// spent with a witness of the given type.
+func NewBaseInput(outpoint *wire.OutPoint, witnessType lnwallet.WitnessType,
+	signDescriptor *lnwallet.SignDescriptor) *BaseInput {
+
+	return &BaseInput{
+		outpoint:    *outpoint,
+		witnessType: witnessType,
+		signDesc:    *signDescriptor,
+	}
+}
+
+// OutPoint returns the input's identifier that is to be included as a
+// transaction input.
+func (bi *BaseInput) OutPoint() *wire.OutPoint {
+	return &bi.outpoint
+}
+
+// WitnessType returns the type of witness that must be generated to spend the
+// input.
+func (bi *BaseInput) WitnessType() lnwallet.WitnessType {
+	return bi.witnessType
+}
+
+// SignDesc returns the input's SignDescriptor, which is used during signing
+// to compute the witness.
+func (bi *BaseInput) SignDesc() *lnwallet.SignDescriptor {
+	return &bi.signDesc
+}
+
+// BuildWitness computes a valid witness that allows us to spend from the
+// input, using the witness generation function of its witness type.
+func (bi *BaseInput) BuildWitness(signer lnwallet.Signer, txn *wire.MsgTx,
+	hashCache *txscript.TxSigHashes, txinIdx int) ([][]byte, error) {
+
+	witnessFunc := bi.witnessType.GenWitnessFunc(signer, bi.SignDesc())
+
+	return witnessFunc(txn, hashCache, txinIdx)
+}
+
+// BlocksToMaturity returns zero, as a BaseInput isn't CSV locked.
+func (bi *BaseInput) BlocksToMaturity() uint32 {
+	return 0
+}
+
+// CsvInput is an Input which can only be spent once a relative timelock has
+// passed since its confirmation.
+type CsvInput struct {
+	BaseInput
+
+	blocksToMaturity uint32
+}
+
+// NewCsvInput creates a new CsvInput for the given outpoint, which can be
+// spent once blocksToMaturity blocks have been built on top of its
+// confirmation.
+func NewCsvInput(outpoint *wire.OutPoint, witnessType lnwallet.WitnessType,
+	signDescriptor *lnwallet.SignDescriptor,
+	blocksToMaturity uint32) *CsvInput {
+
+	return &CsvInput{
+		BaseInput: BaseInput{
+			outpoint:    *outpoint,
+			witnessType: witnessType,
+			signDesc:    *signDescriptor,
+		},
+		blocksToMaturity: blocksToMaturity,
+	}
+}
+
+// BlocksToMaturity returns the relative timelock of the input.
+func (ci *CsvInput) BlocksToMaturity() uint32 {
+	return ci.blocksToMaturity
+}
+
+// Add compile-time constraints ensuring the concrete inputs implement Input.
+var _ Input = (*BaseInput)(nil)
+var _ Input = (*CsvInput)(nil)
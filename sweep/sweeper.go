@@ -0,0 +1,1043 @@
+package sweep
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/davecgh/go-spew/spew"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+const (
+	// DefaultConfTarget is the confirmation target used for inputs which
+	// are in no immediate danger.
+	DefaultConfTarget = 6
+
+	// DefaultBatchWindowDuration is the default duration the sweeper
+	// waits for more inputs to arrive, before sweeping the inputs it
+	// collected so far in a batch.
+	DefaultBatchWindowDuration = 30 * time.Second
+
+	// DefaultBumpInterval is the default number of blocks a sweep
+	// transaction may remain unconfirmed, before it is replaced by one
+	// paying a higher fee.
+	DefaultBumpInterval = 3
+
+	// DefaultMaxInputsPerTx is the default maximum number of inputs a
+	// single sweep transaction spends.
+	DefaultMaxInputsPerTx = 100
+
+	// DefaultMaxFeeRate is the default fee rate the sweeper will never
+	// exceed when bumping the fee of a sweep transaction. This amounts to
+	// 1000 sat/vbyte.
+	DefaultMaxFeeRate lnwallet.SatPerKWeight = 250000
+
+	// minFeeRateBump is the minimum increase of the fee rate of a
+	// replacement transaction, which corresponds to the default
+	// incremental relay fee of 1 sat/vbyte.
+	minFeeRateBump lnwallet.SatPerKWeight = 250
+)
+
+var (
+	// ErrRemoteSpend is returned in case an output that we try to sweep is
+	// confirmed in a tx of the remote party.
+	ErrRemoteSpend = errors.New("remote party swept utxo")
+
+	// ErrSweeperShuttingDown is returned when a request can't be handled
+	// because the sweeper is exiting.
+	ErrSweeperShuttingDown = errors.New("utxo sweeper shutting down")
+
+	// ErrUnknownInput is returned when the fee of an input is bumped that
+	// isn't being swept by the sweeper.
+	ErrUnknownInput = errors.New("unknown input")
+)
+
+// Result is the struct that is pushed through the result channel once an
+// input has been swept, or could not be swept.
+type Result struct {
+	// Err is the final result of the sweep. It is nil when the input is
+	// swept successfully by us. ErrRemoteSpend is returned when another
+	// party took the input.
+	Err error
+
+	// Tx is the transaction that spent the input.
+	Tx *wire.MsgTx
+}
+
+// PendingInput describes an input which is waiting to be swept.
+type PendingInput struct {
+	// OutPoint is the outpoint of the input.
+	OutPoint wire.OutPoint
+
+	// WitnessType is the type of witness used to spend the input.
+	WitnessType lnwallet.WitnessType
+
+	// Amount is the value of the input.
+	Amount btcutil.Amount
+
+	// ConfTarget is the confirmation target the input is swept with.
+	ConfTarget uint32
+
+	// FeeRate is the fee rate of the latest sweep transaction spending
+	// the input. It is zero if the input hasn't been swept yet.
+	FeeRate lnwallet.SatPerKWeight
+
+	// BroadcastAttempts is the number of sweep transactions spending the
+	// input we've broadcast so far.
+	BroadcastAttempts int
+
+	// SweepTxid is the txid of the latest sweep transaction spending the
+	// input. It is nil if the input hasn't been swept yet.
+	SweepTxid *chainhash.Hash
+}
+
+// UtxoSweeperConfig contains the dependencies of UtxoSweeper.
+type UtxoSweeperConfig struct {
+	// GenSweepScript generates a P2WKH script belonging to the wallet
+	// where funds can be swept.
+	GenSweepScript func() ([]byte, error)
+
+	// FeeEstimator is used when crafting sweep transactions to estimate
+	// the necessary fee relative to the expected size of the sweep
+	// transaction.
+	FeeEstimator lnwallet.FeeEstimator
+
+	// PublishTransaction facilitates the process of broadcasting a signed
+	// transaction to the appropriate network.
+	PublishTransaction func(*wire.MsgTx) error
+
+	// NewBatchTimer creates a channel that will be sent on when a certain
+	// time window has passed. During this time window, new inputs can
+	// still be added to the sweep tx that is about to be generated.
+	NewBatchTimer func() <-chan time.Time
+
+	// Notifier is an instance of a chain notifier we'll use to watch for
+	// new blocks, and the spends of the inputs we sweep.
+	Notifier chainntnfs.ChainNotifier
+
+	// ChainIO is used to determine the current block height.
+	ChainIO lnwallet.BlockChainIO
+
+	// Signer is used by the sweeper to generate valid witnesses for the
+	// inputs it sweeps.
+	Signer lnwallet.Signer
+
+	// MaxInputsPerTx specifies the default maximum number of inputs
+	// allowed in a single sweep tx. If more need to be swept, multiple
+	// txes are created and published.
+	MaxInputsPerTx int
+
+	// BumpInterval is the number of blocks a sweep transaction may remain
+	// unconfirmed, before it is replaced by one paying a higher fee.
+	BumpInterval uint32
+
+	// MaxFeeRate is the fee rate the sweeper will never exceed when
+	// bumping the fee of a sweep transaction.
+	MaxFeeRate lnwallet.SatPerKWeight
+}
+
+// sweepBatch is a set of inputs which are swept together in a single
+// transaction. Whenever the fee of the batch is bumped, the same set of inputs
+// is swept again, such that the new transaction replaces the old one.
+type sweepBatch struct {
+	// inputs are the outpoints of the inputs swept by the batch.
+	inputs []wire.OutPoint
+
+	// pkScript is the script the inputs are swept to. It is reused for
+	// each replacement.
+	pkScript []byte
+
+	// confTarget is the confirmation target of the batch, which is
+	// lowered each time its fee is bumped.
+	confTarget uint32
+
+	// feeRate is the fee rate of the latest sweep transaction.
+	feeRate lnwallet.SatPerKWeight
+
+	// tx is the latest sweep transaction of the batch.
+	tx *wire.MsgTx
+
+	// txids are the txids of all sweep transactions published for the
+	// batch.
+	txids []chainhash.Hash
+
+	// publishHeight is the height at which the latest sweep transaction
+	// was published.
+	publishHeight int32
+}
+
+// pendingInput is an input which is waiting to be swept, along with all the
+// clients waiting for the result.
+type pendingInput struct {
+	input Input
+
+	listeners []chan Result
+
+	confTarget uint32
+
+	// parent is the unconfirmed parent of the input, which the sweep
+	// transaction pays for. It is nil for regular sweeps.
+	parent *ParentTx
+
+	// batch is the batch the input is swept in. It is nil if the input
+	// hasn't been swept yet.
+	batch *sweepBatch
+}
+
+// sweepInputMessage is sent to the collector to add a new input.
+type sweepInputMessage struct {
+	input      Input
+	confTarget uint32
+	heightHint uint32
+	resultChan chan Result
+
+	// parent and feeRate are set for inputs that are swept right away to
+	// pay for their unconfirmed parent.
+	parent  *ParentTx
+	feeRate lnwallet.SatPerKWeight
+}
+
+// sweepRequest is sent to the collector to sweep all pending inputs right
+// away.
+type sweepRequest struct {
+	confTarget uint32
+	resp       chan sweepResponse
+}
+
+// sweepResponse is the response to a sweepRequest.
+type sweepResponse struct {
+	txs []*wire.MsgTx
+	err error
+}
+
+// bumpFeeRequest is sent to the collector to replace the sweep transaction of
+// an input with one paying a higher fee.
+type bumpFeeRequest struct {
+	outpoint wire.OutPoint
+	feeRate  lnwallet.SatPerKWeight
+	resp     chan bumpFeeResponse
+}
+
+// bumpFeeResponse is the response to a bumpFeeRequest.
+type bumpFeeResponse struct {
+	tx  *wire.MsgTx
+	err error
+}
+
+// UtxoSweeper is responsible for sweeping outputs back into the wallet. Inputs
+// handed to the sweeper are collected during a batch window, and then swept
+// together in shared transactions, grouped by confirmation target. If a sweep
+// transaction doesn't confirm in time, it is replaced by one paying a higher
+// fee.
+//
+// NOTE: The sweeper doesn't persist its inputs. Subsystems handing inputs to
+// the sweeper are expected to offer them again after a restart.
+//
+// NOTE: Justice transactions aren't swept through the sweeper, as the breach
+// arbiter needs them to spend all breached outputs immediately in a single
+// transaction with a fixed txid.
+type UtxoSweeper struct {
+	started uint32 // To be used atomically.
+	stopped uint32 // To be used atomically.
+
+	cfg *UtxoSweeperConfig
+
+	newInputs     chan *sweepInputMessage
+	spendChan     chan *chainntnfs.SpendDetail
+	sweepReqs     chan *sweepRequest
+	bumpReqs      chan *bumpFeeRequest
+	pendingReqs   chan chan []PendingInput
+	pendingInputs map[wire.OutPoint]*pendingInput
+
+	// sweepTxs maps the txids of all sweep transactions we published to
+	// their batch.
+	sweepTxs map[chainhash.Hash]*sweepBatch
+
+	currentHeight int32
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// New returns a new UtxoSweeper instance.
+func New(cfg *UtxoSweeperConfig) *UtxoSweeper {
+	return &UtxoSweeper{
+		cfg:           cfg,
+		newInputs:     make(chan *sweepInputMessage),
+		spendChan:     make(chan *chainntnfs.SpendDetail),
+		sweepReqs:     make(chan *sweepRequest),
+		bumpReqs:      make(chan *bumpFeeRequest),
+		pendingReqs:   make(chan chan []PendingInput),
+		pendingInputs: make(map[wire.OutPoint]*pendingInput),
+		sweepTxs:      make(map[chainhash.Hash]*sweepBatch),
+		quit:          make(chan struct{}),
+	}
+}
+
+// Start starts the process of constructing and publishing sweep txes.
+func (s *UtxoSweeper) Start() error {
+	if !atomic.CompareAndSwapUint32(&s.started, 0, 1) {
+		return nil
+	}
+
+	log.Tracef("Sweeper starting")
+
+	blockEpochs, err := s.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+	if err != nil {
+		return err
+	}
+
+	_, bestHeight, err := s.cfg.ChainIO.GetBestBlock()
+	if err != nil {
+		blockEpochs.Cancel()
+		return err
+	}
+	s.currentHeight = bestHeight
+
+	s.wg.Add(1)
+	go s.collector(blockEpochs)
+
+	return nil
+}
+
+// Stop stops the sweeper from listening to block epochs and constructing
+// sweep txes.
+func (s *UtxoSweeper) Stop() error {
+	if !atomic.CompareAndSwapUint32(&s.stopped, 0, 1) {
+		return nil
+	}
+
+	log.Debugf("Sweeper shutting down")
+
+	close(s.quit)
+	s.wg.Wait()
+
+	return nil
+}
+
+// SweepInput sweeps the given input back to our wallet, targeting
+// confirmation within confTarget blocks. The heightHint should be the height
+// at which the input was created. The returned result channel is sent on once
+// the input is spent, either by us or by a remote party. It is safe to offer
+// the same input multiple times; all callers are notified of the result.
+func (s *UtxoSweeper) SweepInput(input Input, confTarget,
+	heightHint uint32) (chan Result, error) {
+
+	if _, ok := witnessSize(input.WitnessType()); !ok {
+		return nil, fmt.Errorf("unable to sweep input %v with unknown "+
+			"witness type: %v", input.OutPoint(),
+			input.WitnessType())
+	}
+
+	if confTarget == 0 {
+		confTarget = DefaultConfTarget
+	}
+
+	log.Infof("Sweep request received: out_point=%v, witness_type=%v, "+
+		"conf_target=%v", input.OutPoint(), input.WitnessType(),
+		confTarget)
+
+	msg := &sweepInputMessage{
+		input:      input,
+		confTarget: confTarget,
+		heightHint: heightHint,
+		resultChan: make(chan Result, 1),
+	}
+
+	select {
+	case s.newInputs <- msg:
+		return msg.resultChan, nil
+	case <-s.quit:
+		return nil, ErrSweeperShuttingDown
+	}
+}
+
+// CPFP sweeps the given input right away, such that the sweep transaction
+// pays for its unconfirmed parent (child-pays-for-parent). The fee of the
+// sweep transaction is chosen such that the package of the parent and the
+// sweep transaction pays the given fee rate. As the parent is unconfirmed, the
+// input can't be CSV locked. The returned result channel is sent on once the
+// input is spent.
+func (s *UtxoSweeper) CPFP(input Input, parent *ParentTx,
+	feeRate lnwallet.SatPerKWeight) (chan Result, error) {
+
+	if _, ok := witnessSize(input.WitnessType()); !ok {
+		return nil, fmt.Errorf("unable to sweep input %v with unknown "+
+			"witness type: %v", input.OutPoint(),
+			input.WitnessType())
+	}
+
+	if input.BlocksToMaturity() > 0 {
+		return nil, fmt.Errorf("input %v is CSV locked, and can't be "+
+			"spent before its parent confirms", input.OutPoint())
+	}
+
+	log.Infof("CPFP request received: out_point=%v, parent=%v, "+
+		"fee_rate=%v sat/kw", input.OutPoint(), parent.Tx.TxHash(),
+		int64(feeRate))
+
+	msg := &sweepInputMessage{
+		input:      input,
+		confTarget: DefaultConfTarget,
+		resultChan: make(chan Result, 1),
+		parent:     parent,
+		feeRate:    feeRate,
+	}
+
+	select {
+	case s.newInputs <- msg:
+		return msg.resultChan, nil
+	case <-s.quit:
+		return nil, ErrSweeperShuttingDown
+	}
+}
+
+// BumpFee replaces the sweep transaction spending the given input with one
+// paying the given fee rate (replace-by-fee). If the input hasn't been swept
+// yet, it is swept right away at the given fee rate. A zero fee rate bumps the
+// current fee rate by the default increment. The published transaction is
+// returned.
+func (s *UtxoSweeper) BumpFee(outpoint wire.OutPoint,
+	feeRate lnwallet.SatPerKWeight) (*wire.MsgTx, error) {
+
+	req := &bumpFeeRequest{
+		outpoint: outpoint,
+		feeRate:  feeRate,
+		resp:     make(chan bumpFeeResponse, 1),
+	}
+
+	select {
+	case s.bumpReqs <- req:
+	case <-s.quit:
+		return nil, ErrSweeperShuttingDown
+	}
+
+	select {
+	case resp := <-req.resp:
+		return resp.tx, resp.err
+	case <-s.quit:
+		return nil, ErrSweeperShuttingDown
+	}
+}
+
+// SweepPendingInputs immediately sweeps all pending inputs, targeting
+// confirmation within confTarget blocks. Inputs which are already part of a
+// sweep transaction have their fee bumped if the new target requires a
+// higher fee. The published sweep transactions are returned.
+func (s *UtxoSweeper) SweepPendingInputs(
+	confTarget uint32) ([]*wire.MsgTx, error) {
+
+	req := &sweepRequest{
+		confTarget: confTarget,
+		resp:       make(chan sweepResponse, 1),
+	}
+
+	select {
+	case s.sweepReqs <- req:
+	case <-s.quit:
+		return nil, ErrSweeperShuttingDown
+	}
+
+	select {
+	case resp := <-req.resp:
+		return resp.txs, resp.err
+	case <-s.quit:
+		return nil, ErrSweeperShuttingDown
+	}
+}
+
+// PendingInputs returns all inputs which are waiting to be swept.
+func (s *UtxoSweeper) PendingInputs() ([]PendingInput, error) {
+	resp := make(chan []PendingInput, 1)
+
+	select {
+	case s.pendingReqs <- resp:
+	case <-s.quit:
+		return nil, ErrSweeperShuttingDown
+	}
+
+	select {
+	case pendingInputs := <-resp:
+		return pendingInputs, nil
+	case <-s.quit:
+		return nil, ErrSweeperShuttingDown
+	}
+}
+
+// collector is the sweeper main loop. It collects inputs, sweeps them in
+// batches once the batch window has passed, and bumps the fees of sweep
+// transactions which don't confirm in time.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *UtxoSweeper) collector(blockEpochs *chainntnfs.BlockEpochEvent) {
+	defer s.wg.Done()
+	defer blockEpochs.Cancel()
+
+	// batchTimer is non-nil while a batch window is running.
+	var batchTimer <-chan time.Time
+	startBatchTimer := func() {
+		if batchTimer == nil && s.hasUnsweptInputs() {
+			batchTimer = s.cfg.NewBatchTimer()
+		}
+	}
+
+	for {
+		select {
+		case msg := <-s.newInputs:
+			s.addPendingInput(msg)
+
+			// Inputs paying for their parent can't wait for the
+			// batch window, as the parent is stuck already.
+			if msg.parent != nil {
+				_, err := s.bumpInput(
+					*msg.input.OutPoint(), msg.feeRate,
+				)
+				if err != nil {
+					log.Errorf("Unable to CPFP parent %v: "+
+						"%v", msg.parent.Tx.TxHash(),
+						err)
+				}
+			}
+			startBatchTimer()
+
+		case spend := <-s.spendChan:
+			s.handleSpend(spend)
+			startBatchTimer()
+
+		case req := <-s.sweepReqs:
+			txs, err := s.sweepAll(req.confTarget)
+			req.resp <- sweepResponse{txs: txs, err: err}
+
+		case req := <-s.bumpReqs:
+			tx, err := s.bumpInput(req.outpoint, req.feeRate)
+			req.resp <- bumpFeeResponse{tx: tx, err: err}
+
+		case resp := <-s.pendingReqs:
+			resp <- s.listPendingInputs()
+
+		case <-batchTimer:
+			batchTimer = nil
+			s.sweepUnsweptInputs()
+
+			// If any of the batches failed, we'll retry once the
+			// next batch window has passed.
+			startBatchTimer()
+
+		case epoch, ok := <-blockEpochs.Epochs:
+			if !ok {
+				return
+			}
+
+			s.currentHeight = epoch.Height
+
+			log.Debugf("New block: height=%v, sweeping %v inputs",
+				epoch.Height, len(s.pendingInputs))
+
+			s.bumpStalledBatches()
+
+		case <-s.quit:
+			return
+		}
+	}
+}
+
+// addPendingInput adds the input of the message to the set of inputs to
+// sweep, and registers for its spend.
+func (s *UtxoSweeper) addPendingInput(msg *sweepInputMessage) {
+	outpoint := *msg.input.OutPoint()
+
+	// If the input is already pending, we'll only add the caller to the
+	// list of listeners.
+	if pending, ok := s.pendingInputs[outpoint]; ok {
+		pending.listeners = append(pending.listeners, msg.resultChan)
+		if msg.confTarget < pending.confTarget {
+			pending.confTarget = msg.confTarget
+		}
+		if msg.parent != nil {
+			pending.parent = msg.parent
+		}
+
+		return
+	}
+
+	// The parent of an input we CPFP is unconfirmed, so its spend can't
+	// happen before the current height.
+	heightHint := msg.heightHint
+	if msg.parent != nil {
+		heightHint = uint32(s.currentHeight)
+	}
+
+	spendEvent, err := s.cfg.Notifier.RegisterSpendNtfn(
+		&outpoint, msg.input.SignDesc().Output.PkScript, heightHint,
+	)
+	if err != nil {
+		msg.resultChan <- Result{Err: err}
+		return
+	}
+
+	s.pendingInputs[outpoint] = &pendingInput{
+		input:      msg.input,
+		listeners:  []chan Result{msg.resultChan},
+		confTarget: msg.confTarget,
+		parent:     msg.parent,
+	}
+
+	s.wg.Add(1)
+	go s.waitForSpend(spendEvent)
+}
+
+// waitForSpend forwards the spend of an input to the collector.
+//
+// NOTE: This MUST be run as a goroutine.
+func (s *UtxoSweeper) waitForSpend(spendEvent *chainntnfs.SpendEvent) {
+	defer s.wg.Done()
+
+	select {
+	case spend, ok := <-spendEvent.Spend:
+		if !ok {
+			return
+		}
+
+		select {
+		case s.spendChan <- spend:
+		case <-s.quit:
+		}
+
+	case <-s.quit:
+		spendEvent.Cancel()
+	}
+}
+
+// handleSpend signals the result to the listeners of all pending inputs spent
+// by the given transaction. If an input was spent by a transaction other than
+// the one of its batch, the remaining inputs of the batch are swept again.
+func (s *UtxoSweeper) handleSpend(spend *chainntnfs.SpendDetail) {
+	spendHash := *spend.SpenderTxHash
+	_, isOurTx := s.sweepTxs[spendHash]
+
+	for _, txIn := range spend.SpendingTx.TxIn {
+		outpoint := txIn.PreviousOutPoint
+		pending, ok := s.pendingInputs[outpoint]
+		if !ok {
+			continue
+		}
+
+		result := Result{Tx: spend.SpendingTx}
+		if !isOurTx {
+			log.Warnf("Input %v spent by remote tx %v", outpoint,
+				spendHash)
+
+			result.Err = ErrRemoteSpend
+		} else {
+			log.Infof("Input %v swept by tx %v", outpoint,
+				spendHash)
+		}
+
+		for _, listener := range pending.listeners {
+			listener <- result
+		}
+		delete(s.pendingInputs, outpoint)
+
+		batch := pending.batch
+		if batch == nil {
+			continue
+		}
+
+		// The batch is done, so we can forget about its sweep
+		// transactions.
+		for _, txid := range batch.txids {
+			delete(s.sweepTxs, txid)
+		}
+
+		// The inputs of the batch which weren't spent alongside this
+		// one need to be swept again.
+		for _, op := range batch.inputs {
+			other, ok := s.pendingInputs[op]
+			if ok && other.batch == batch {
+				other.batch = nil
+			}
+		}
+	}
+}
+
+// hasUnsweptInputs returns true if there are pending inputs which aren't part
+// of any sweep transaction yet.
+func (s *UtxoSweeper) hasUnsweptInputs() bool {
+	for _, pending := range s.pendingInputs {
+		if pending.batch == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sweepUnsweptInputs sweeps all pending inputs which aren't part of any sweep
+// transaction yet, and returns the published transactions. Inputs are grouped
+// by confirmation target, as each target requires a different fee rate.
+func (s *UtxoSweeper) sweepUnsweptInputs() []*wire.MsgTx {
+	groups := make(map[uint32][]wire.OutPoint)
+	for outpoint, pending := range s.pendingInputs {
+		if pending.batch != nil {
+			continue
+		}
+
+		groups[pending.confTarget] = append(
+			groups[pending.confTarget], outpoint,
+		)
+	}
+
+	maxInputs := s.cfg.MaxInputsPerTx
+	if maxInputs <= 0 {
+		maxInputs = DefaultMaxInputsPerTx
+	}
+
+	var txs []*wire.MsgTx
+	for confTarget, outpoints := range groups {
+		feeRate, err := s.feeRate(confTarget)
+		if err != nil {
+			log.Errorf("Unable to estimate fee rate for conf "+
+				"target %v: %v", confTarget, err)
+			continue
+		}
+
+		for len(outpoints) > 0 {
+			n := len(outpoints)
+			if n > maxInputs {
+				n = maxInputs
+			}
+
+			batch := &sweepBatch{
+				inputs:     outpoints[:n],
+				confTarget: confTarget,
+			}
+			outpoints = outpoints[n:]
+
+			batch.pkScript, err = s.cfg.GenSweepScript()
+			if err != nil {
+				log.Errorf("Unable to generate sweep script: "+
+					"%v", err)
+				return txs
+			}
+
+			if err := s.publishBatch(batch, feeRate); err != nil {
+				log.Errorf("Unable to sweep %v inputs: %v",
+					len(batch.inputs), err)
+				continue
+			}
+
+			txs = append(txs, batch.tx)
+		}
+	}
+
+	return txs
+}
+
+// batches returns all distinct batches of the pending inputs.
+func (s *UtxoSweeper) batches() []*sweepBatch {
+	seen := make(map[*sweepBatch]struct{})
+
+	var batches []*sweepBatch
+	for _, pending := range s.pendingInputs {
+		if pending.batch == nil {
+			continue
+		}
+		if _, ok := seen[pending.batch]; ok {
+			continue
+		}
+
+		seen[pending.batch] = struct{}{}
+		batches = append(batches, pending.batch)
+	}
+
+	return batches
+}
+
+// bumpStalledBatches replaces the sweep transactions which remained
+// unconfirmed for BumpInterval blocks with ones paying a higher fee.
+func (s *UtxoSweeper) bumpStalledBatches() {
+	bumpInterval := s.cfg.BumpInterval
+	if bumpInterval == 0 {
+		bumpInterval = DefaultBumpInterval
+	}
+
+	for _, batch := range s.batches() {
+		if s.currentHeight-batch.publishHeight < int32(bumpInterval) {
+			continue
+		}
+
+		// As the previous confirmation target wasn't met, we'll aim
+		// for a faster confirmation this time.
+		if batch.confTarget > 1 {
+			batch.confTarget /= 2
+		}
+
+		feeRate, err := s.feeRate(batch.confTarget)
+		if err != nil {
+			log.Errorf("Unable to estimate fee rate for conf "+
+				"target %v: %v", batch.confTarget, err)
+			continue
+		}
+
+		feeRate = s.bumpFeeRate(batch, feeRate)
+		if feeRate <= batch.feeRate {
+			log.Warnf("Unable to bump fee of sweep tx %v beyond "+
+				"%v sat/kw", batch.tx.TxHash(),
+				int64(batch.feeRate))
+			continue
+		}
+
+		log.Infof("Sweep tx %v unconfirmed after %v blocks, bumping "+
+			"fee rate from %v to %v sat/kw", batch.tx.TxHash(),
+			s.currentHeight-batch.publishHeight,
+			int64(batch.feeRate), int64(feeRate))
+
+		if err := s.publishBatch(batch, feeRate); err != nil {
+			log.Errorf("Unable to bump fee of sweep tx %v: %v",
+				batch.tx.TxHash(), err)
+		}
+	}
+}
+
+// sweepAll sweeps all pending inputs right away with the given confirmation
+// target, and returns the published transactions.
+func (s *UtxoSweeper) sweepAll(confTarget uint32) ([]*wire.MsgTx, error) {
+	if confTarget == 0 {
+		confTarget = DefaultConfTarget
+	}
+
+	for _, pending := range s.pendingInputs {
+		if confTarget < pending.confTarget {
+			pending.confTarget = confTarget
+		}
+	}
+
+	// Inputs which already are part of a sweep transaction are only swept
+	// again if the new target requires a higher fee.
+	var txs []*wire.MsgTx
+	for _, batch := range s.batches() {
+		if confTarget < batch.confTarget {
+			batch.confTarget = confTarget
+		}
+
+		feeRate, err := s.feeRate(batch.confTarget)
+		if err != nil {
+			return nil, err
+		}
+
+		if feeRate < batch.feeRate+minFeeRateBump {
+			txs = append(txs, batch.tx)
+			continue
+		}
+
+		if err := s.publishBatch(batch, feeRate); err != nil {
+			return nil, err
+		}
+		txs = append(txs, batch.tx)
+	}
+
+	return append(txs, s.sweepUnsweptInputs()...), nil
+}
+
+// bumpInput publishes a sweep transaction spending the given input at the
+// given fee rate. If the input already is part of a batch, the new transaction
+// replaces the previous one, and thus needs to pay at least the incremental
+// relay fee more. Otherwise, the input is swept on its own. A zero fee rate
+// bumps the current fee rate by the default increment.
+func (s *UtxoSweeper) bumpInput(outpoint wire.OutPoint,
+	feeRate lnwallet.SatPerKWeight) (*wire.MsgTx, error) {
+
+	pending, ok := s.pendingInputs[outpoint]
+	if !ok {
+		return nil, ErrUnknownInput
+	}
+
+	batch := pending.batch
+	if feeRate == 0 {
+		estimated, err := s.feeRate(pending.confTarget)
+		if err != nil {
+			return nil, err
+		}
+
+		feeRate = estimated
+		if batch != nil {
+			feeRate = s.bumpFeeRate(batch, estimated)
+		}
+	}
+
+	if feeRate > s.maxFeeRate() {
+		feeRate = s.maxFeeRate()
+	}
+
+	switch {
+	case batch == nil:
+		pkScript, err := s.cfg.GenSweepScript()
+		if err != nil {
+			return nil, err
+		}
+
+		batch = &sweepBatch{
+			inputs:     []wire.OutPoint{outpoint},
+			pkScript:   pkScript,
+			confTarget: pending.confTarget,
+		}
+
+	case feeRate < batch.feeRate+minFeeRateBump:
+		return nil, fmt.Errorf("fee rate of %v sat/kw must exceed the "+
+			"fee rate of sweep tx %v of %v sat/kw by at least %v "+
+			"sat/kw", int64(feeRate), batch.tx.TxHash(),
+			int64(batch.feeRate), int64(minFeeRateBump))
+	}
+
+	log.Infof("Bumping fee rate of input %v to %v sat/kw", outpoint,
+		int64(feeRate))
+
+	if err := s.publishBatch(batch, feeRate); err != nil {
+		return nil, err
+	}
+
+	return batch.tx, nil
+}
+
+// feeRate returns the fee rate to sweep with for the given confirmation
+// target, capped at the maximum fee rate.
+func (s *UtxoSweeper) feeRate(confTarget uint32) (lnwallet.SatPerKWeight,
+	error) {
+
+	feeRate, err := s.cfg.FeeEstimator.EstimateFeePerKW(confTarget)
+	if err != nil {
+		return 0, err
+	}
+
+	if feeRate > s.maxFeeRate() {
+		feeRate = s.maxFeeRate()
+	}
+
+	return feeRate, nil
+}
+
+// maxFeeRate returns the maximum fee rate of a sweep transaction.
+func (s *UtxoSweeper) maxFeeRate() lnwallet.SatPerKWeight {
+	if s.cfg.MaxFeeRate == 0 {
+		return DefaultMaxFeeRate
+	}
+
+	return s.cfg.MaxFeeRate
+}
+
+// bumpFeeRate returns the fee rate for a replacement of the sweep transaction
+// of the batch. It is the higher of the estimated fee rate, and the previous
+// fee rate increased by a quarter, but at least by the incremental relay fee.
+func (s *UtxoSweeper) bumpFeeRate(batch *sweepBatch,
+	estimated lnwallet.SatPerKWeight) lnwallet.SatPerKWeight {
+
+	bump := batch.feeRate / 4
+	if bump < minFeeRateBump {
+		bump = minFeeRateBump
+	}
+
+	feeRate := batch.feeRate + bump
+	if estimated > feeRate {
+		feeRate = estimated
+	}
+
+	if feeRate > s.maxFeeRate() {
+		feeRate = s.maxFeeRate()
+	}
+
+	return feeRate
+}
+
+// publishBatch creates and publishes a transaction sweeping all inputs of the
+// batch at the given fee rate. If the batch was swept before, the new
+// transaction replaces the previous one.
+func (s *UtxoSweeper) publishBatch(batch *sweepBatch,
+	feeRate lnwallet.SatPerKWeight) error {
+
+	inputs := make([]Input, 0, len(batch.inputs))
+	parents := make(map[chainhash.Hash]*ParentTx)
+	for _, outpoint := range batch.inputs {
+		pending := s.pendingInputs[outpoint]
+		inputs = append(inputs, pending.input)
+
+		if pending.parent != nil {
+			parents[outpoint.Hash] = pending.parent
+		}
+	}
+
+	parentTxs := make([]*ParentTx, 0, len(parents))
+	for _, parent := range parents {
+		parentTxs = append(parentTxs, parent)
+	}
+
+	tx, err := createSweepTx(
+		inputs, batch.pkScript, uint32(s.currentHeight), feeRate,
+		parentTxs, s.cfg.Signer,
+	)
+	if err != nil {
+		return err
+	}
+
+	log.Infof("Publishing sweep tx %v spending %v inputs at %v sat/kw: "+
+		"%v", tx.TxHash(), len(tx.TxIn), int64(feeRate),
+		newLogClosure(func() string {
+			return spew.Sdump(tx)
+		}),
+	)
+
+	// A double spend indicates one of the inputs already is spent by
+	// another transaction. In that case, we'll wait for the spend to
+	// confirm, or bump the fee once more.
+	err = s.cfg.PublishTransaction(tx)
+	if err != nil && err != lnwallet.ErrDoubleSpend {
+		return err
+	}
+
+	txid := tx.TxHash()
+	batch.tx = tx
+	batch.feeRate = feeRate
+	batch.publishHeight = s.currentHeight
+	batch.txids = append(batch.txids, txid)
+	s.sweepTxs[txid] = batch
+
+	for _, outpoint := range batch.inputs {
+		s.pendingInputs[outpoint].batch = batch
+	}
+
+	return nil
+}
+
+// listPendingInputs returns a description of all pending inputs.
+func (s *UtxoSweeper) listPendingInputs() []PendingInput {
+	pendingInputs := make([]PendingInput, 0, len(s.pendingInputs))
+	for outpoint, pending := range s.pendingInputs {
+		pendingInput := PendingInput{
+			OutPoint:    outpoint,
+			WitnessType: pending.input.WitnessType(),
+			Amount: btcutil.Amount(
+				pending.input.SignDesc().Output.Value,
+			),
+			ConfTarget: pending.confTarget,
+		}
+
+		if batch := pending.batch; batch != nil {
+			txid := batch.tx.TxHash()
+
+			pendingInput.ConfTarget = batch.confTarget
+			pendingInput.FeeRate = batch.feeRate
+			pendingInput.BroadcastAttempts = len(batch.txids)
+			pendingInput.SweepTxid = &txid
+		}
+
+		pendingInputs = append(pendingInputs, pendingInput)
+	}
+
+	return pendingInputs
+}
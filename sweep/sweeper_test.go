@@ -0,0 +1,741 @@
+package sweep
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/chainntnfs"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+var testPkScript = []byte{0x00, 0x14, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06,
+	0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11,
+	0x12, 0x13, 0x14}
+
+// mockInput is an Input with a dummy witness.
+type mockInput struct {
+	BaseInput
+}
+
+func newMockInput(index uint32, value int64) *mockInput {
+	return &mockInput{
+		BaseInput: BaseInput{
+			outpoint:    wire.OutPoint{Index: index},
+			witnessType: lnwallet.CommitmentNoDelay,
+			signDesc: lnwallet.SignDescriptor{
+				Output: &wire.TxOut{
+					PkScript: testPkScript,
+					Value:    value,
+				},
+			},
+		},
+	}
+}
+
+func (m *mockInput) BuildWitness(signer lnwallet.Signer, txn *wire.MsgTx,
+	hashCache *txscript.TxSigHashes, txinIdx int) ([][]byte, error) {
+
+	return [][]byte{{0x01}, {0x02}}, nil
+}
+
+// mockWalletSigner is a Signer which signs for the p2wkh outputs of a single
+// wallet key.
+type mockWalletSigner struct {
+	key *btcec.PrivateKey
+}
+
+func (m *mockWalletSigner) SignOutputRaw(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) ([]byte, error) {
+
+	sig, err := txscript.RawTxInWitnessSignature(
+		tx, signDesc.SigHashes, signDesc.InputIndex,
+		signDesc.Output.Value, signDesc.WitnessScript,
+		signDesc.HashType, m.key,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return sig[:len(sig)-1], nil
+}
+
+func (m *mockWalletSigner) ComputeInputScript(tx *wire.MsgTx,
+	signDesc *lnwallet.SignDescriptor) (*lnwallet.InputScript, error) {
+
+	witness, err := txscript.WitnessSignature(
+		tx, signDesc.SigHashes, signDesc.InputIndex,
+		signDesc.Output.Value, signDesc.Output.PkScript,
+		signDesc.HashType, m.key, true,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnwallet.InputScript{
+		Witness: witness,
+	}, nil
+}
+
+// mockNotifier is a ChainNotifier which lets the test dispatch block epochs
+// and spends.
+type mockNotifier struct {
+	mtx    sync.Mutex
+	epochs chan *chainntnfs.BlockEpoch
+	spends map[wire.OutPoint]chan *chainntnfs.SpendDetail
+}
+
+func newMockNotifier() *mockNotifier {
+	return &mockNotifier{
+		epochs: make(chan *chainntnfs.BlockEpoch),
+		spends: make(map[wire.OutPoint]chan *chainntnfs.SpendDetail),
+	}
+}
+
+func (m *mockNotifier) RegisterConfirmationsNtfn(txid *chainhash.Hash,
+	_ []byte, numConfs, heightHint uint32) (*chainntnfs.ConfirmationEvent,
+	error) {
+
+	return &chainntnfs.ConfirmationEvent{}, nil
+}
+
+func (m *mockNotifier) RegisterSpendNtfn(outpoint *wire.OutPoint,
+	_ []byte, heightHint uint32) (*chainntnfs.SpendEvent, error) {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	spendChan := make(chan *chainntnfs.SpendDetail, 1)
+	m.spends[*outpoint] = spendChan
+
+	return &chainntnfs.SpendEvent{
+		Spend:  spendChan,
+		Cancel: func() {},
+	}, nil
+}
+
+func (m *mockNotifier) RegisterBlockEpochNtfn(
+	bestBlock *chainntnfs.BlockEpoch) (*chainntnfs.BlockEpochEvent, error) {
+
+	return &chainntnfs.BlockEpochEvent{
+		Epochs: m.epochs,
+		Cancel: func() {},
+	}, nil
+}
+
+func (m *mockNotifier) Start() error {
+	return nil
+}
+
+func (m *mockNotifier) Stop() error {
+	return nil
+}
+
+// spendTx dispatches the spend of all inputs of the given transaction.
+func (m *mockNotifier) spendTx(tx *wire.MsgTx) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	txid := tx.TxHash()
+	for i, txIn := range tx.TxIn {
+		spendChan, ok := m.spends[txIn.PreviousOutPoint]
+		if !ok {
+			continue
+		}
+
+		outpoint := txIn.PreviousOutPoint
+		spendChan <- &chainntnfs.SpendDetail{
+			SpentOutPoint:     &outpoint,
+			SpenderTxHash:     &txid,
+			SpendingTx:        tx,
+			SpenderInputIndex: uint32(i),
+		}
+		delete(m.spends, outpoint)
+	}
+}
+
+// mockChainIO is a BlockChainIO which only knows about the best height.
+type mockChainIO struct {
+	bestHeight int32
+}
+
+func (m *mockChainIO) GetBestBlock() (*chainhash.Hash, int32, error) {
+	return &chainhash.Hash{}, m.bestHeight, nil
+}
+
+func (m *mockChainIO) GetUtxo(op *wire.OutPoint, pkScript []byte,
+	heightHint uint32) (*wire.TxOut, error) {
+
+	return nil, nil
+}
+
+func (m *mockChainIO) GetBlockHash(blockHeight int64) (*chainhash.Hash,
+	error) {
+
+	return nil, nil
+}
+
+func (m *mockChainIO) GetBlock(blockHash *chainhash.Hash) (*wire.MsgBlock,
+	error) {
+
+	return nil, nil
+}
+
+// mockFeeEstimator returns the fee rate of the given confirmation target, or
+// the default fee rate if the target is unknown.
+type mockFeeEstimator struct {
+	mtx         sync.Mutex
+	defaultRate lnwallet.SatPerKWeight
+	rates       map[uint32]lnwallet.SatPerKWeight
+}
+
+func (m *mockFeeEstimator) EstimateFeePerKW(
+	numBlocks uint32) (lnwallet.SatPerKWeight, error) {
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
+	if rate, ok := m.rates[numBlocks]; ok {
+		return rate, nil
+	}
+
+	return m.defaultRate, nil
+}
+
+func (m *mockFeeEstimator) Start() error {
+	return nil
+}
+
+func (m *mockFeeEstimator) Stop() error {
+	return nil
+}
+
+type sweeperTestContext struct {
+	t *testing.T
+
+	sweeper   *UtxoSweeper
+	notifier  *mockNotifier
+	estimator *mockFeeEstimator
+	signer    *mockWalletSigner
+	timer     chan time.Time
+	published chan *wire.MsgTx
+}
+
+func newSweeperTestContext(t *testing.T) *sweeperTestContext {
+	walletKey, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		t.Fatalf("unable to generate wallet key: %v", err)
+	}
+
+	ctx := &sweeperTestContext{
+		t:        t,
+		notifier: newMockNotifier(),
+		estimator: &mockFeeEstimator{
+			defaultRate: 1000,
+			rates:       make(map[uint32]lnwallet.SatPerKWeight),
+		},
+		signer:    &mockWalletSigner{key: walletKey},
+		timer:     make(chan time.Time),
+		published: make(chan *wire.MsgTx, 10),
+	}
+
+	ctx.sweeper = New(&UtxoSweeperConfig{
+		GenSweepScript: func() ([]byte, error) {
+			return testPkScript, nil
+		},
+		FeeEstimator: ctx.estimator,
+		Signer:       ctx.signer,
+		PublishTransaction: func(tx *wire.MsgTx) error {
+			ctx.published <- tx
+			return nil
+		},
+		NewBatchTimer: func() <-chan time.Time {
+			return ctx.timer
+		},
+		Notifier:       ctx.notifier,
+		ChainIO:        &mockChainIO{bestHeight: 100},
+		MaxInputsPerTx: 3,
+		BumpInterval:   2,
+	})
+
+	if err := ctx.sweeper.Start(); err != nil {
+		t.Fatalf("unable to start sweeper: %v", err)
+	}
+
+	return ctx
+}
+
+func (ctx *sweeperTestContext) finish() {
+	ctx.sweeper.Stop()
+}
+
+func (ctx *sweeperTestContext) sweepInput(input Input,
+	confTarget uint32) chan Result {
+
+	resultChan, err := ctx.sweeper.SweepInput(input, confTarget, 0)
+	if err != nil {
+		ctx.t.Fatalf("unable to sweep input: %v", err)
+	}
+
+	return resultChan
+}
+
+func (ctx *sweeperTestContext) tick() {
+	select {
+	case ctx.timer <- time.Time{}:
+	case <-time.After(5 * time.Second):
+		ctx.t.Fatalf("batch timer not started")
+	}
+}
+
+func (ctx *sweeperTestContext) notifyEpoch(height int32) {
+	select {
+	case ctx.notifier.epochs <- &chainntnfs.BlockEpoch{Height: height}:
+	case <-time.After(5 * time.Second):
+		ctx.t.Fatalf("block epoch not received")
+	}
+}
+
+func (ctx *sweeperTestContext) receiveTx() *wire.MsgTx {
+	select {
+	case tx := <-ctx.published:
+		return tx
+	case <-time.After(5 * time.Second):
+		ctx.t.Fatalf("no sweep tx published")
+	}
+
+	return nil
+}
+
+func (ctx *sweeperTestContext) assertNoTx() {
+	select {
+	case tx := <-ctx.published:
+		ctx.t.Fatalf("unexpected sweep tx published: %v", tx.TxHash())
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func (ctx *sweeperTestContext) expectResult(resultChan chan Result,
+	expected error) Result {
+
+	select {
+	case result := <-resultChan:
+		if result.Err != expected {
+			ctx.t.Fatalf("expected result error %v, got %v",
+				expected, result.Err)
+		}
+		return result
+	case <-time.After(5 * time.Second):
+		ctx.t.Fatalf("no sweep result received")
+	}
+
+	return Result{}
+}
+
+// assertSpends asserts that the transaction spends exactly the given inputs.
+func assertSpends(t *testing.T, tx *wire.MsgTx, inputs ...Input) {
+	if len(tx.TxIn) != len(inputs) {
+		t.Fatalf("expected %v inputs, got %v", len(inputs),
+			len(tx.TxIn))
+	}
+
+	spent := make(map[wire.OutPoint]struct{})
+	for _, txIn := range tx.TxIn {
+		spent[txIn.PreviousOutPoint] = struct{}{}
+	}
+	for _, input := range inputs {
+		if _, ok := spent[*input.OutPoint()]; !ok {
+			t.Fatalf("input %v not spent", input.OutPoint())
+		}
+	}
+}
+
+// TestSweeperBatch asserts that inputs offered during a batch window are swept
+// in a single transaction, and that all listeners are notified once it
+// confirms.
+func TestSweeperBatch(t *testing.T) {
+	t.Parallel()
+
+	ctx := newSweeperTestContext(t)
+	defer ctx.finish()
+
+	input1 := newMockInput(1, 10000)
+	input2 := newMockInput(2, 20000)
+
+	result1 := ctx.sweepInput(input1, 6)
+	result2 := ctx.sweepInput(input2, 6)
+
+	// Offering the same input twice shouldn't sweep it twice, but notify
+	// both callers.
+	result3 := ctx.sweepInput(input1, 6)
+
+	ctx.tick()
+	sweepTx := ctx.receiveTx()
+	assertSpends(t, sweepTx, input1, input2)
+
+	if sweepTx.LockTime != 100 {
+		t.Fatalf("expected lock time 100, got %v", sweepTx.LockTime)
+	}
+	if sweepTx.TxOut[0].Value >= 30000 {
+		t.Fatalf("sweep tx doesn't pay any fee")
+	}
+
+	ctx.notifier.spendTx(sweepTx)
+
+	for _, resultChan := range []chan Result{result1, result2, result3} {
+		result := ctx.expectResult(resultChan, nil)
+		if result.Tx.TxHash() != sweepTx.TxHash() {
+			t.Fatalf("unexpected sweep tx in result")
+		}
+	}
+}
+
+// TestSweeperConfTargets asserts that inputs are grouped by confirmation
+// target, and that no transaction exceeds the maximum number of inputs.
+func TestSweeperConfTargets(t *testing.T) {
+	t.Parallel()
+
+	ctx := newSweeperTestContext(t)
+	defer ctx.finish()
+
+	ctx.estimator.rates[2] = 5000
+
+	var urgent []Input
+	for i := uint32(0); i < 4; i++ {
+		input := newMockInput(i, 10000)
+		ctx.sweepInput(input, 2)
+		urgent = append(urgent, input)
+	}
+	lax := newMockInput(10, 10000)
+	ctx.sweepInput(lax, 6)
+
+	ctx.tick()
+
+	var urgentInputs int
+	for i := 0; i < 3; i++ {
+		tx := ctx.receiveTx()
+		if len(tx.TxIn) > 3 {
+			t.Fatalf("sweep tx exceeds max inputs: %v",
+				len(tx.TxIn))
+		}
+
+		if tx.TxIn[0].PreviousOutPoint == *lax.OutPoint() {
+			assertSpends(t, tx, lax)
+			continue
+		}
+		urgentInputs += len(tx.TxIn)
+	}
+	if urgentInputs != len(urgent) {
+		t.Fatalf("expected %v urgent inputs swept, got %v",
+			len(urgent), urgentInputs)
+	}
+	ctx.assertNoTx()
+}
+
+// TestSweeperFeeBump asserts that a sweep transaction which doesn't confirm in
+// time is replaced by one spending the same inputs at a higher fee.
+func TestSweeperFeeBump(t *testing.T) {
+	t.Parallel()
+
+	ctx := newSweeperTestContext(t)
+	defer ctx.finish()
+
+	input := newMockInput(1, 100000)
+	resultChan := ctx.sweepInput(input, 6)
+
+	ctx.tick()
+	sweepTx := ctx.receiveTx()
+
+	// Before the bump interval has passed, the fee isn't bumped.
+	ctx.notifyEpoch(101)
+	ctx.assertNoTx()
+
+	ctx.notifyEpoch(102)
+	bumpTx := ctx.receiveTx()
+	assertSpends(t, bumpTx, input)
+
+	if bumpTx.TxOut[0].Value >= sweepTx.TxOut[0].Value {
+		t.Fatalf("replacement doesn't pay a higher fee")
+	}
+
+	pending, err := ctx.sweeper.PendingInputs()
+	if err != nil {
+		t.Fatalf("unable to fetch pending inputs: %v", err)
+	}
+	if len(pending) != 1 || pending[0].BroadcastAttempts != 2 {
+		t.Fatalf("unexpected pending inputs: %v", pending)
+	}
+	if *pending[0].SweepTxid != bumpTx.TxHash() {
+		t.Fatalf("pending input doesn't report replacement")
+	}
+
+	// If the original transaction confirms after all, it is still
+	// recognized as ours.
+	ctx.notifier.spendTx(sweepTx)
+	ctx.expectResult(resultChan, nil)
+}
+
+// TestSweeperRemoteSpend asserts that listeners are notified if a remote
+// party spends an input, and that the remaining inputs of its batch are swept
+// again.
+func TestSweeperRemoteSpend(t *testing.T) {
+	t.Parallel()
+
+	ctx := newSweeperTestContext(t)
+	defer ctx.finish()
+
+	input1 := newMockInput(1, 10000)
+	input2 := newMockInput(2, 10000)
+	result1 := ctx.sweepInput(input1, 6)
+	result2 := ctx.sweepInput(input2, 6)
+
+	ctx.tick()
+	ctx.receiveTx()
+
+	remoteTx := wire.NewMsgTx(2)
+	remoteTx.AddTxIn(&wire.TxIn{PreviousOutPoint: *input1.OutPoint()})
+	remoteTx.AddTxOut(&wire.TxOut{Value: 9000})
+	ctx.notifier.spendTx(remoteTx)
+
+	ctx.expectResult(result1, ErrRemoteSpend)
+
+	ctx.tick()
+	sweepTx := ctx.receiveTx()
+	assertSpends(t, sweepTx, input2)
+
+	ctx.notifier.spendTx(sweepTx)
+	ctx.expectResult(result2, nil)
+}
+
+// TestSweepPendingInputs asserts that pending inputs can be swept right away
+// with a chosen confirmation target, bumping the fee of sweep transactions
+// which pay too little for it.
+func TestSweepPendingInputs(t *testing.T) {
+	t.Parallel()
+
+	ctx := newSweeperTestContext(t)
+	defer ctx.finish()
+
+	input1 := newMockInput(1, 100000)
+	ctx.sweepInput(input1, 6)
+
+	ctx.tick()
+	sweepTx := ctx.receiveTx()
+
+	input2 := newMockInput(2, 100000)
+	ctx.sweepInput(input2, 6)
+
+	ctx.estimator.mtx.Lock()
+	ctx.estimator.rates[1] = 10000
+	ctx.estimator.mtx.Unlock()
+
+	txs, err := ctx.sweeper.SweepPendingInputs(1)
+	if err != nil {
+		t.Fatalf("unable to sweep pending inputs: %v", err)
+	}
+	if len(txs) != 2 {
+		t.Fatalf("expected 2 sweep txs, got %v", len(txs))
+	}
+
+	bumpTx := ctx.receiveTx()
+	newTx := ctx.receiveTx()
+	if bumpTx.TxIn[0].PreviousOutPoint != *input1.OutPoint() {
+		bumpTx, newTx = newTx, bumpTx
+	}
+
+	assertSpends(t, bumpTx, input1)
+	assertSpends(t, newTx, input2)
+
+	if bumpTx.TxOut[0].Value >= sweepTx.TxOut[0].Value {
+		t.Fatalf("replacement doesn't pay a higher fee")
+	}
+
+	// The batch window started by the second input passes without
+	// anything left to sweep.
+	select {
+	case ctx.timer <- time.Time{}:
+	case <-time.After(50 * time.Millisecond):
+	}
+	ctx.assertNoTx()
+
+	if btcutil.Amount(newTx.TxOut[0].Value) >= 100000 {
+		t.Fatalf("sweep tx doesn't pay any fee")
+	}
+}
+
+// TestSweeperBumpFee asserts that the fee of a sweep transaction can be bumped
+// manually, and that replacements not paying at least the incremental relay
+// fee more are rejected.
+func TestSweeperBumpFee(t *testing.T) {
+	t.Parallel()
+
+	ctx := newSweeperTestContext(t)
+	defer ctx.finish()
+
+	input := newMockInput(1, 100000)
+	resultChan := ctx.sweepInput(input, 6)
+
+	// Bumping an input that hasn't been swept yet sweeps it right away.
+	sweepTx, err := ctx.sweeper.BumpFee(*input.OutPoint(), 2000)
+	if err != nil {
+		t.Fatalf("unable to bump fee: %v", err)
+	}
+	if ctx.receiveTx().TxHash() != sweepTx.TxHash() {
+		t.Fatalf("returned tx not published")
+	}
+	assertSpends(t, sweepTx, input)
+
+	// A replacement at a fee rate that isn't high enough is rejected.
+	_, err = ctx.sweeper.BumpFee(*input.OutPoint(), 2100)
+	if err == nil {
+		t.Fatalf("expected insufficient fee bump to fail")
+	}
+	ctx.assertNoTx()
+
+	// Without an explicit fee rate, the fee rate is bumped by the default
+	// increment.
+	bumpTx, err := ctx.sweeper.BumpFee(*input.OutPoint(), 0)
+	if err != nil {
+		t.Fatalf("unable to bump fee: %v", err)
+	}
+	ctx.receiveTx()
+
+	pending, err := ctx.sweeper.PendingInputs()
+	if err != nil {
+		t.Fatalf("unable to fetch pending inputs: %v", err)
+	}
+	if len(pending) != 1 || pending[0].FeeRate != 2500 {
+		t.Fatalf("unexpected pending inputs: %v", pending)
+	}
+
+	_, err = ctx.sweeper.BumpFee(wire.OutPoint{Index: 2}, 5000)
+	if err != ErrUnknownInput {
+		t.Fatalf("expected ErrUnknownInput, got %v", err)
+	}
+
+	ctx.notifier.spendTx(bumpTx)
+	ctx.expectResult(resultChan, nil)
+}
+
+// TestSweeperCPFP asserts that an input of an unconfirmed parent is swept right
+// away, paying for the weight of its parent.
+func TestSweeperCPFP(t *testing.T) {
+	t.Parallel()
+
+	ctx := newSweeperTestContext(t)
+	defer ctx.finish()
+
+	parentTx := wire.NewMsgTx(2)
+	parentTx.AddTxIn(&wire.TxIn{})
+	parentTx.AddTxOut(&wire.TxOut{PkScript: testPkScript, Value: 100000})
+	parent := &ParentTx{Tx: parentTx, Fee: 100}
+
+	input := newMockInput(0, 100000)
+	input.outpoint.Hash = parentTx.TxHash()
+
+	const feeRate = 5000
+	resultChan, err := ctx.sweeper.CPFP(input, parent, feeRate)
+	if err != nil {
+		t.Fatalf("unable to cpfp: %v", err)
+	}
+
+	// The child is published without waiting for the batch window.
+	childTx := ctx.receiveTx()
+	assertSpends(t, childTx, input)
+
+	_, childWeight := getWeightEstimate([]Input{input})
+	expectedFee := lnwallet.SatPerKWeight(feeRate).FeeForWeight(
+		childWeight+parent.weight(),
+	) - parent.Fee
+
+	fee := btcutil.Amount(100000 - childTx.TxOut[0].Value)
+	if fee != expectedFee {
+		t.Fatalf("expected child fee %v, got %v", expectedFee, fee)
+	}
+
+	// CSV locked inputs can't be spent before their parent confirms.
+	csvInput := NewCsvInput(
+		&wire.OutPoint{Hash: parentTx.TxHash(), Index: 1},
+		lnwallet.CommitmentTimeLock, &input.signDesc, 144,
+	)
+	if _, err := ctx.sweeper.CPFP(csvInput, parent, feeRate); err == nil {
+		t.Fatalf("expected cpfp of csv locked input to fail")
+	}
+
+	ctx.notifier.spendTx(childTx)
+	ctx.expectResult(resultChan, nil)
+}
+
+// TestSweeperCPFPWalletOutput asserts that a p2wkh output of our wallet on an
+// unconfirmed parent can be used to child-pays-for-parent, and that the child
+// transaction validly spends it.
+func TestSweeperCPFPWalletOutput(t *testing.T) {
+	t.Parallel()
+
+	ctx := newSweeperTestContext(t)
+	defer ctx.finish()
+
+	// The parent pays to a regular p2wkh output of our wallet, such as its
+	// change output.
+	pkScript, err := lnwallet.CommitScriptUnencumbered(
+		ctx.signer.key.PubKey(),
+	)
+	if err != nil {
+		t.Fatalf("unable to create wallet script: %v", err)
+	}
+
+	const value = 100000
+	parentTx := wire.NewMsgTx(2)
+	parentTx.AddTxIn(&wire.TxIn{})
+	parentTx.AddTxOut(&wire.TxOut{PkScript: pkScript, Value: value})
+	parent := &ParentTx{Tx: parentTx, Fee: 100}
+
+	input := NewBaseInput(
+		&wire.OutPoint{Hash: parentTx.TxHash(), Index: 0},
+		lnwallet.WitnessKeyHash, &lnwallet.SignDescriptor{
+			Output:   parentTx.TxOut[0],
+			HashType: txscript.SigHashAll,
+		},
+	)
+
+	const feeRate = 5000
+	resultChan, err := ctx.sweeper.CPFP(input, parent, feeRate)
+	if err != nil {
+		t.Fatalf("unable to cpfp wallet output: %v", err)
+	}
+
+	childTx := ctx.receiveTx()
+	assertSpends(t, childTx, input)
+
+	// The child should pay for the package at the requested fee rate,
+	// accounting for the p2wkh witness of the wallet input.
+	_, childWeight := getWeightEstimate([]Input{input})
+	expectedFee := lnwallet.SatPerKWeight(feeRate).FeeForWeight(
+		childWeight+parent.weight(),
+	) - parent.Fee
+
+	fee := btcutil.Amount(value - childTx.TxOut[0].Value)
+	if fee != expectedFee {
+		t.Fatalf("expected child fee %v, got %v", expectedFee, fee)
+	}
+
+	// Finally, the witness of the child must be valid.
+	vm, err := txscript.NewEngine(
+		pkScript, childTx, 0, txscript.StandardVerifyFlags, nil, nil,
+		value,
+	)
+	if err != nil {
+		t.Fatalf("unable to create engine: %v", err)
+	}
+	if err := vm.Execute(); err != nil {
+		t.Fatalf("child spend of wallet output is invalid: %v", err)
+	}
+
+	ctx.notifier.spendTx(childTx)
+	ctx.expectResult(resultChan, nil)
+}
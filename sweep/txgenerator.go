@@ -0,0 +1,208 @@
+package sweep
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/btcsuite/btcutil"
+	"github.com/lightningnetwork/lnd/lnwallet"
+)
+
+// witnessSize returns the estimated size of the witness required to spend an
+// input of the given witness type. False is returned if the witness type is
+// unknown, in which case the input can't be swept.
+func witnessSize(witnessType lnwallet.WitnessType) (int, bool) {
+	switch witnessType {
+
+	// Outputs on a past commitment transaction that pay directly to us,
+	// and second layer HTLC outputs that have confirmed and are now
+	// mature enough to sweep.
+	case lnwallet.CommitmentTimeLock,
+		lnwallet.HtlcOfferedTimeoutSecondLevel,
+		lnwallet.HtlcAcceptedSuccessSecondLevel:
+
+		return lnwallet.ToLocalTimeoutWitnessSize, true
+
+	// Outputs on the commitment transaction of the remote party which pay
+	// directly to us.
+	case lnwallet.CommitmentNoDelay:
+		return lnwallet.P2WKHWitnessSize, true
+
+	// An HTLC on the commitment transaction of the remote party, that has
+	// had its absolute timelock expire.
+	case lnwallet.HtlcOfferedRemoteTimeout:
+		return lnwallet.AcceptedHtlcTimeoutWitnessSize, true
+
+	// An HTLC on the commitment transaction of the remote party, for
+	// which we know the preimage.
+	case lnwallet.HtlcAcceptedRemoteSuccess:
+		return lnwallet.OfferedHtlcSuccessWitnessSize, true
+
+	// Outputs of a revoked commitment or second level transaction of the
+	// remote party, which we are entitled to due to their breach.
+	case lnwallet.CommitmentRevoke, lnwallet.HtlcSecondLevelRevoke:
+		return lnwallet.ToLocalPenaltyWitnessSize, true
+
+	case lnwallet.HtlcOfferedRevoke:
+		return lnwallet.OfferedHtlcPenaltyWitnessSize, true
+
+	case lnwallet.HtlcAcceptedRevoke:
+		return lnwallet.AcceptedHtlcPenaltyWitnessSize, true
+
+	// Regular p2wkh outputs under the control of our wallet, such as the
+	// change output of a transaction we'd like to child-pays-for-parent.
+	case lnwallet.WitnessKeyHash:
+		return lnwallet.P2WKHWitnessSize, true
+
+	default:
+		return 0, false
+	}
+}
+
+// getWeightEstimate returns the estimated weight of a transaction sweeping
+// the given inputs into a single p2wkh output, along with the subset of the
+// inputs which can be swept. Inputs with an unknown witness type are skipped.
+func getWeightEstimate(inputs []Input) ([]Input, int64) {
+	var weightEstimate lnwallet.TxWeightEstimator
+
+	// Our sweep transaction will pay to a single segwit p2wkh address,
+	// ensure it contributes to our weight estimate.
+	weightEstimate.AddP2WKHOutput()
+
+	sweepInputs := make([]Input, 0, len(inputs))
+	for _, input := range inputs {
+		size, ok := witnessSize(input.WitnessType())
+		if !ok {
+			log.Warnf("Skipping input %v with unknown witness "+
+				"type: %v", input.OutPoint(),
+				input.WitnessType())
+			continue
+		}
+
+		weightEstimate.AddWitnessInput(size)
+		sweepInputs = append(sweepInputs, input)
+	}
+
+	return sweepInputs, int64(weightEstimate.Weight())
+}
+
+// ParentTx describes an unconfirmed transaction that is the parent of an input
+// we sweep. The sweep transaction pays for the parent (CPFP), such that the
+// package of both transactions reaches the desired fee rate.
+type ParentTx struct {
+	// Tx is the unconfirmed parent transaction.
+	Tx *wire.MsgTx
+
+	// Fee is the fee paid by the parent transaction itself.
+	Fee btcutil.Amount
+}
+
+// weight returns the weight of the parent transaction.
+func (p *ParentTx) weight() int64 {
+	return blockchain.GetTransactionWeight(btcutil.NewTx(p.Tx))
+}
+
+// CreateSweepTx builds and signs a transaction which sweeps the given inputs
+// into a single output paying to outputPkScript, at the given fee rate. The
+// lock time of the transaction is set to currentHeight, which allows CLTV
+// locked inputs whose timelock has expired to be included. CSV locked inputs
+// have their sequence set to their relative timelock. As all sequence numbers
+// are below the final one, the transaction signals replaceability, which
+// allows the sweeper to bump its fee later on.
+func CreateSweepTx(inputs []Input, outputPkScript []byte,
+	currentHeight uint32, feePerKw lnwallet.SatPerKWeight,
+	signer lnwallet.Signer) (*wire.MsgTx, error) {
+
+	return createSweepTx(
+		inputs, outputPkScript, currentHeight, feePerKw, nil, signer,
+	)
+}
+
+// createSweepTx builds and signs a sweep transaction like CreateSweepTx. If
+// any unconfirmed parents are given, the fee of the sweep transaction is
+// raised such that the package consisting of the parents and the sweep
+// transaction pays the given fee rate.
+func createSweepTx(inputs []Input, outputPkScript []byte,
+	currentHeight uint32, feePerKw lnwallet.SatPerKWeight,
+	parents []*ParentTx, signer lnwallet.Signer) (*wire.MsgTx, error) {
+
+	inputs, txWeight := getWeightEstimate(inputs)
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("no sweepable inputs")
+	}
+
+	// Sum up the total value contained in the inputs.
+	var totalSum btcutil.Amount
+	for _, input := range inputs {
+		totalSum += btcutil.Amount(input.SignDesc().Output.Value)
+	}
+
+	// Sweep as much possible, after subtracting txn fees. The fee covers
+	// the weight of our parents as well, minus what they already pay
+	// themselves, but never drops below the fee for our own weight.
+	txFee := feePerKw.FeeForWeight(txWeight)
+	if len(parents) > 0 {
+		packageWeight := txWeight
+		var parentFees btcutil.Amount
+		for _, parent := range parents {
+			packageWeight += parent.weight()
+			parentFees += parent.Fee
+		}
+
+		packageFee := feePerKw.FeeForWeight(packageWeight) - parentFees
+		if packageFee > txFee {
+			txFee = packageFee
+		}
+	}
+
+	sweepAmt := int64(totalSum - txFee)
+	if sweepAmt <= 0 {
+		return nil, fmt.Errorf("inputs worth %v don't cover fee of %v",
+			totalSum, txFee)
+	}
+
+	// Create the sweep transaction that we will be building. We use
+	// version 2 as it is required for CSV. The txn will sweep the amount
+	// after fees to the pkscript given.
+	sweepTx := wire.NewMsgTx(2)
+	sweepTx.AddTxOut(&wire.TxOut{
+		PkScript: outputPkScript,
+		Value:    sweepAmt,
+	})
+	sweepTx.LockTime = currentHeight
+
+	// Add all inputs to the sweep transaction. Ensure that for each
+	// csv input, we set the sequence number properly.
+	for _, input := range inputs {
+		sweepTx.AddTxIn(&wire.TxIn{
+			PreviousOutPoint: *input.OutPoint(),
+			Sequence:         input.BlocksToMaturity(),
+		})
+	}
+
+	// Before signing the transaction, check to ensure that it meets some
+	// basic validity requirements.
+	btx := btcutil.NewTx(sweepTx)
+	if err := blockchain.CheckTransactionSanity(btx); err != nil {
+		return nil, err
+	}
+
+	hashCache := txscript.NewTxSigHashes(sweepTx)
+
+	// With all the inputs in place, use each output's unique witness
+	// function to generate the final witness required for spending.
+	for idx, input := range inputs {
+		witness, err := input.BuildWitness(
+			signer, sweepTx, hashCache, idx,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		sweepTx.TxIn[idx].Witness = witness
+	}
+
+	return sweepTx, nil
+}
@@ -97,3 +97,146 @@ func testTicker(t *testing.T, ticker ticker.Ticker) {
 	case <-time.After(2 * interval):
 	}
 }
+
+// TestVirtualTicker asserts that tickers created from a VirtualClock only fire
+// as virtual time is advanced past their deadlines.
+func TestVirtualTicker(t *testing.T) {
+	start := time.Unix(0, 0)
+	clock := ticker.NewVirtualClock(start)
+	vt := clock.NewTicker(interval)
+
+	assertNoTick := func(msg string) {
+		select {
+		case <-vt.Ticks():
+			t.Fatalf("ticker should not have ticked %v", msg)
+		default:
+		}
+	}
+	assertTick := func(expected time.Time) {
+		select {
+		case tick := <-vt.Ticks():
+			if !tick.Equal(expected) {
+				t.Fatalf("expected tick at %v, got %v",
+					expected, tick)
+			}
+		default:
+			t.Fatalf("ticker should have ticked at %v", expected)
+		}
+	}
+
+	// An inactive ticker shouldn't fire, no matter how much time passes.
+	clock.Advance(2 * interval)
+	assertNoTick("before calling Resume")
+
+	// Once resumed, the first tick is scheduled one interval later.
+	vt.Resume()
+	clock.Advance(interval - 1)
+	assertNoTick("before its deadline")
+
+	clock.Advance(1)
+	assertTick(start.Add(3 * interval))
+
+	// Ticks that aren't consumed are dropped, rather than blocking the
+	// clock.
+	clock.Advance(numActiveTicks * interval)
+	assertTick(start.Add(4 * interval))
+	assertNoTick("after dropping ticks")
+
+	if !clock.Now().Equal(start.Add((3 + numActiveTicks) * interval)) {
+		t.Fatalf("unexpected virtual time %v", clock.Now())
+	}
+
+	// Pause and Stop should both render the ticker inactive.
+	vt.Pause()
+	clock.Advance(2 * interval)
+	assertNoTick("after calling Pause")
+
+	vt.Resume()
+	vt.Stop()
+	clock.Advance(2 * interval)
+	assertNoTick("after calling Stop")
+}
+
+// TestVirtualTickerInterval asserts that a VirtualClock refuses to create
+// tickers with a non-positive interval, which would never let the clock
+// advance past their deadlines.
+func TestVirtualTickerInterval(t *testing.T) {
+	clock := ticker.NewVirtualClock(time.Unix(0, 0))
+
+	for _, interval := range []time.Duration{0, -interval} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Fatalf("expected ticker with interval "+
+						"%v to be rejected", interval)
+				}
+			}()
+
+			clock.NewTicker(interval)
+		}()
+	}
+
+	// As no ticker was created, advancing the clock should return.
+	clock.Advance(interval)
+}
+
+// TestVirtualClockStep asserts that stepping a VirtualClock fires a single
+// ticker at a time, and that the clock reports the ticks that haven't been
+// received yet.
+func TestVirtualClockStep(t *testing.T) {
+	start := time.Unix(0, 0)
+	clock := ticker.NewVirtualClock(start)
+
+	assertUnreceived := func(expected int) {
+		t.Helper()
+
+		unreceived := clock.UnreceivedTicks()
+		if unreceived != expected {
+			t.Fatalf("expected %v unreceived ticks, got %v",
+				expected, unreceived)
+		}
+	}
+
+	// Two tickers sharing a deadline should fire one step at a time, in
+	// the order they were created.
+	vt1 := clock.NewTicker(interval)
+	vt2 := clock.NewTicker(interval)
+	vt1.Resume()
+	vt2.Resume()
+
+	end := start.Add(interval)
+	if !clock.Step(end) {
+		t.Fatalf("expected first ticker to fire")
+	}
+	assertUnreceived(1)
+	select {
+	case <-vt1.Ticks():
+	default:
+		t.Fatalf("first ticker should have ticked")
+	}
+	select {
+	case <-vt2.Ticks():
+		t.Fatalf("second ticker shouldn't have ticked yet")
+	default:
+	}
+
+	// Once the tick is received, no tick is outstanding anymore.
+	assertUnreceived(0)
+
+	if !clock.Step(end) {
+		t.Fatalf("expected second ticker to fire")
+	}
+	assertUnreceived(1)
+
+	// Pausing a ticker drops its tick.
+	vt2.Pause()
+	assertUnreceived(0)
+
+	// Once no ticker is due, the clock moves to the end of the step.
+	if clock.Step(end) {
+		t.Fatalf("expected no ticker to fire")
+	}
+	if !clock.Now().Equal(end) {
+		t.Fatalf("expected virtual time %v, got %v", end, clock.Now())
+	}
+}
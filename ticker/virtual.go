@@ -0,0 +1,221 @@
+package ticker
+
+import (
+	"sync"
+	"time"
+)
+
+// VirtualClock is a clock whose time only moves forward when it is explicitly
+// advanced. Tickers created from the clock fire as virtual time passes their
+// deadlines, which allows subsystems that are driven by tickers to be run
+// deterministically and much faster than real time, e.g. in simulations.
+type VirtualClock struct {
+	mu sync.Mutex
+
+	// now is the current virtual time.
+	now time.Time
+
+	// tickers is the set of tickers that haven't been stopped yet.
+	tickers map[*Virtual]struct{}
+
+	// seq is the number of tickers created so far, used to order tickers
+	// that share a deadline.
+	seq uint64
+}
+
+// NewVirtualClock returns a new VirtualClock whose time starts at the passed
+// time.
+func NewVirtualClock(start time.Time) *VirtualClock {
+	return &VirtualClock{
+		now:     start,
+		tickers: make(map[*Virtual]struct{}),
+	}
+}
+
+// Now returns the current virtual time.
+func (c *VirtualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// UnreceivedTicks returns the number of ticks that have been delivered, but
+// not yet received by the receivers of their tickers. This allows the caller
+// to learn whether every subsystem driven by the clock has picked up its
+// latest tick.
+func (c *VirtualClock) UnreceivedTicks() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var unreceived int
+	for v := range c.tickers {
+		unreceived += len(v.ticks)
+	}
+
+	return unreceived
+}
+
+// NewTicker returns a new ticker that ticks every interval of virtual time
+// once resumed. Like the other tickers, it starts off inactive. Just like
+// time.NewTicker, it panics if the interval isn't positive, as the ticker's
+// deadline would never move past the clock's time.
+func (c *VirtualClock) NewTicker(interval time.Duration) *Virtual {
+	if interval <= 0 {
+		panic("non-positive interval for VirtualClock.NewTicker")
+	}
+
+	v := &Virtual{
+		clock:    c,
+		interval: interval,
+		ticks:    make(chan time.Time, 1),
+	}
+
+	c.mu.Lock()
+	c.seq++
+	v.seq = c.seq
+	c.tickers[v] = struct{}{}
+	c.mu.Unlock()
+
+	return v
+}
+
+// Advance moves the virtual time forward by the passed duration. Every active
+// ticker whose deadline falls within the elapsed period is fired, in order of
+// their deadlines. Just like a time.Ticker, ticks are dropped if the receiver
+// hasn't consumed the previous tick yet, so Advance never blocks.
+func (c *VirtualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	target := c.now.Add(d)
+	for c.fireNext(target) {
+	}
+
+	c.now = target
+}
+
+// Step fires the active ticker with the earliest deadline, if that deadline
+// doesn't lie beyond the passed time, moving the virtual time forward to it.
+// Otherwise, the virtual time is moved forward to the passed time, and false
+// is returned. Unlike Advance, this allows the receiver of each tick to be
+// given the chance to handle it before the next ticker fires.
+func (c *VirtualClock) Step(until time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.fireNext(until) {
+		return true
+	}
+
+	if until.After(c.now) {
+		c.now = until
+	}
+
+	return false
+}
+
+// fireNext fires the active ticker with the earliest deadline, as long as that
+// deadline doesn't lie beyond the passed time. It returns whether a ticker was
+// due.
+//
+// NOTE: This method MUST be called with the clock's mutex held.
+func (c *VirtualClock) fireNext(until time.Time) bool {
+	// Find the active ticker with the earliest deadline. Ties are broken
+	// by creation order, so that the order in which tickers fire doesn't
+	// depend on map iteration.
+	var next *Virtual
+	for v := range c.tickers {
+		if !v.active || v.deadline.After(until) {
+			continue
+		}
+
+		switch {
+		case next == nil:
+			next = v
+		case v.deadline.Before(next.deadline):
+			next = v
+		case v.deadline.Equal(next.deadline) && v.seq < next.seq:
+			next = v
+		}
+	}
+	if next == nil {
+		return false
+	}
+
+	c.now = next.deadline
+	next.deadline = next.deadline.Add(next.interval)
+
+	select {
+	case next.ticks <- c.now:
+	default:
+	}
+
+	return true
+}
+
+// Virtual is a Ticker driven by a VirtualClock.
+type Virtual struct {
+	clock    *VirtualClock
+	interval time.Duration
+	ticks    chan time.Time
+	seq      uint64
+
+	// The following fields are protected by the clock's mutex.
+	active   bool
+	deadline time.Time
+}
+
+// A compile time check to ensure Virtual implements the Ticker interface.
+var _ Ticker = (*Virtual)(nil)
+
+// Ticks returns a receive-only channel that delivers the virtual time at which
+// each tick was scheduled.
+//
+// NOTE: Part of the Ticker interface.
+func (v *Virtual) Ticks() <-chan time.Time {
+	return v.ticks
+}
+
+// Resume causes the ticker to begin delivering ticks, the first of which is
+// scheduled one interval of virtual time from now.
+//
+// NOTE: Part of the Ticker interface.
+func (v *Virtual) Resume() {
+	v.clock.mu.Lock()
+	defer v.clock.mu.Unlock()
+
+	if v.active {
+		return
+	}
+
+	v.active = true
+	v.deadline = v.clock.now.Add(v.interval)
+}
+
+// Pause suspends the ticker, such that Ticks() stops signaling. Any tick that
+// was already delivered but not yet consumed is dropped.
+//
+// NOTE: Part of the Ticker interface.
+func (v *Virtual) Pause() {
+	v.clock.mu.Lock()
+	defer v.clock.mu.Unlock()
+
+	v.active = false
+
+	select {
+	case <-v.ticks:
+	default:
+	}
+}
+
+// Stop suspends the ticker and removes it from its clock.
+//
+// NOTE: Part of the Ticker interface.
+func (v *Virtual) Stop() {
+	v.Pause()
+
+	v.clock.mu.Lock()
+	delete(v.clock.tickers, v)
+	v.clock.mu.Unlock()
+}
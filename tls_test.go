@@ -0,0 +1,95 @@
+// +build !rpctest
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestCertOutdated tests that a generated certificate is only considered
+// outdated once the configured extra IPs or domains differ from the ones it
+// was generated with.
+func TestCertOutdated(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "tlscert")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	oldCfg := cfg
+	defer func() {
+		cfg = oldCfg
+	}()
+
+	certPath := filepath.Join(tempDir, "tls.cert")
+	keyPath := filepath.Join(tempDir, "tls.key")
+
+	cfg = &config{
+		TLSExtraIPs:     []string{"1.2.3.4"},
+		TLSExtraDomains: []string{"example.com"},
+	}
+	if err := genCertPair(certPath, keyPath); err != nil {
+		t.Fatalf("unable to generate cert pair: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		ips      []string
+		domains  []string
+		outdated bool
+	}{
+		{
+			name:     "unchanged",
+			ips:      []string{"1.2.3.4"},
+			domains:  []string{"example.com"},
+			outdated: false,
+		},
+		{
+			name:     "host ip added",
+			ips:      []string{"1.2.3.4", "127.0.0.1"},
+			domains:  []string{"example.com", "localhost"},
+			outdated: false,
+		},
+		{
+			name:     "ip added",
+			ips:      []string{"1.2.3.4", "5.6.7.8"},
+			domains:  []string{"example.com"},
+			outdated: true,
+		},
+		{
+			name:     "ip removed",
+			domains:  []string{"example.com"},
+			outdated: true,
+		},
+		{
+			name:     "domain added",
+			ips:      []string{"1.2.3.4"},
+			domains:  []string{"example.com", "example.org"},
+			outdated: true,
+		},
+		{
+			name:     "domain removed",
+			ips:      []string{"1.2.3.4"},
+			outdated: true,
+		},
+	}
+
+	for _, test := range tests {
+		cfg = &config{
+			TLSExtraIPs:     test.ips,
+			TLSExtraDomains: test.domains,
+		}
+
+		outdated, err := certOutdated(certPath)
+		if err != nil {
+			t.Fatalf("%v: unable to check cert: %v", test.name, err)
+		}
+		if outdated != test.outdated {
+			t.Fatalf("%v: expected outdated=%v, got %v", test.name,
+				test.outdated, outdated)
+		}
+	}
+}
@@ -423,6 +423,11 @@ type AddOnionConfig struct {
 	// PrivateKeyPath is the full path to where the onion service's private
 	// key is stored. This can be used to restore an existing onion service.
 	PrivateKeyPath string
+
+	// PrivateKeyPassword, if set, is the password the private key is
+	// encrypted with at rest. A plaintext private key stored by a previous
+	// run is encrypted once the onion service has been restored.
+	PrivateKeyPassword []byte
 }
 
 // AddOnion creates an onion service and returns its onion address. Once
@@ -442,7 +447,10 @@ func (c *Controller) AddOnion(cfg AddOnionConfig) (*OnionAddr, error) {
 	// exists. If it does not, then we should request the server to create
 	// a new onion service and return its private key. Otherwise, we'll
 	// request the server to recreate the onion server from our private key.
-	var keyParam string
+	var (
+		keyParam     string
+		keyEncrypted bool
+	)
 	if _, err := os.Stat(cfg.PrivateKeyPath); os.IsNotExist(err) {
 		switch cfg.Type {
 		case V2:
@@ -451,11 +459,14 @@ func (c *Controller) AddOnion(cfg AddOnionConfig) (*OnionAddr, error) {
 			keyParam = "NEW:ED25519-V3"
 		}
 	} else {
-		privateKey, err := ioutil.ReadFile(cfg.PrivateKeyPath)
+		privateKey, encrypted, err := readPrivateKey(
+			cfg.PrivateKeyPath, cfg.PrivateKeyPassword,
+		)
 		if err != nil {
 			return nil, err
 		}
 		keyParam = string(privateKey)
+		keyEncrypted = encrypted
 	}
 
 	// Now, we'll create a mapping from the virtual port to each target
@@ -504,13 +515,25 @@ func (c *Controller) AddOnion(cfg AddOnionConfig) (*OnionAddr, error) {
 	// disk under strict permissions in the event that it needs to be
 	// recreated later on.
 	if privateKey, ok := replyParams["PrivateKey"]; ok {
-		err := ioutil.WriteFile(
-			cfg.PrivateKeyPath, []byte(privateKey), 0600,
+		err := writePrivateKey(
+			cfg.PrivateKeyPath, []byte(privateKey),
+			cfg.PrivateKeyPassword,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("unable to write private key "+
 				"to file: %v", err)
 		}
+	} else if cfg.PrivateKeyPassword != nil && !keyEncrypted {
+		// Otherwise, the service was restored from a plaintext private
+		// key, which we'll now encrypt as requested.
+		err := writePrivateKey(
+			cfg.PrivateKeyPath, []byte(keyParam),
+			cfg.PrivateKeyPassword,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encrypt private "+
+				"key: %v", err)
+		}
 	}
 
 	// Finally, we'll return the onion address composed of the service ID,
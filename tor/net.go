@@ -80,6 +80,20 @@ func (p *ProxyNet) Dial(network, address string) (net.Conn, error) {
 	return Dial(address, p.SOCKS, p.StreamIsolation)
 }
 
+// DialIsolated uses the Tor DialIsolated function in order to establish
+// connections through Tor, isolating them on a circuit by the passed key
+// rather than following StreamIsolation.
+func (p *ProxyNet) DialIsolated(network, address string,
+	isolationKey []byte) (net.Conn, error) {
+
+	switch network {
+	case "tcp", "tcp4", "tcp6":
+	default:
+		return nil, errors.New("cannot dial non-tcp network via Tor")
+	}
+	return DialIsolated(address, p.SOCKS, isolationKey)
+}
+
 // LookupHost uses the Tor LookupHost function in order to resolve hosts over
 // Tor.
 func (p *ProxyNet) LookupHost(host string) ([]string, error) {
@@ -0,0 +1,158 @@
+package tor
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/btcsuite/btcwallet/snacl"
+)
+
+var (
+	// encryptedKeyMagic prefixes the files of onion service private keys
+	// that are encrypted at rest, which tells them apart from the
+	// plaintext keys returned by the Tor server.
+	encryptedKeyMagic = []byte("lnd encrypted onion key\n")
+
+	// ErrEncryptedPrivateKey is returned when the private key of an onion
+	// service is encrypted, but no password was provided to decrypt it.
+	ErrEncryptedPrivateKey = errors.New("onion service private key is " +
+		"encrypted, but no password was provided")
+)
+
+// isEncryptedKey returns true if the passed private key file contents are
+// encrypted.
+func isEncryptedKey(keyFile []byte) bool {
+	return bytes.HasPrefix(keyFile, encryptedKeyMagic)
+}
+
+// encryptPrivateKey encrypts the private key of an onion service with a key
+// derived from the password through scrypt. The scrypt parameters are stored
+// along with the ciphertext, in the following format:
+//
+//	magic || uint16 len(params) || params || ciphertext
+func encryptPrivateKey(privateKey, password []byte) ([]byte, error) {
+	encKey, err := snacl.NewSecretKey(
+		&password, snacl.DefaultN, snacl.DefaultR, snacl.DefaultP,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer encKey.Zero()
+
+	cipherText, err := encKey.Encrypt(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	params := encKey.Marshal()
+
+	var b bytes.Buffer
+	b.Write(encryptedKeyMagic)
+	var paramsLen [2]byte
+	binary.BigEndian.PutUint16(paramsLen[:], uint16(len(params)))
+	b.Write(paramsLen[:])
+	b.Write(params)
+	b.Write(cipherText)
+
+	return b.Bytes(), nil
+}
+
+// decryptPrivateKey decrypts the private key of an onion service that was
+// encrypted with encryptPrivateKey.
+func decryptPrivateKey(keyFile, password []byte) ([]byte, error) {
+	if !isEncryptedKey(keyFile) {
+		return nil, errors.New("onion service private key isn't " +
+			"encrypted")
+	}
+	keyFile = keyFile[len(encryptedKeyMagic):]
+
+	if len(keyFile) < 2 {
+		return nil, errors.New("malformed encrypted private key")
+	}
+	paramsLen := int(binary.BigEndian.Uint16(keyFile[:2]))
+	keyFile = keyFile[2:]
+	if len(keyFile) < paramsLen {
+		return nil, errors.New("malformed encrypted private key")
+	}
+
+	var encKey snacl.SecretKey
+	if err := encKey.Unmarshal(keyFile[:paramsLen]); err != nil {
+		return nil, err
+	}
+	if err := encKey.DeriveKey(&password); err != nil {
+		return nil, fmt.Errorf("unable to decrypt onion service "+
+			"private key: %v", err)
+	}
+	defer encKey.Zero()
+
+	return encKey.Decrypt(keyFile[paramsLen:])
+}
+
+// readPrivateKey reads the private key of an onion service from the passed
+// path, decrypting it with the password if it's encrypted. The returned bool
+// reports whether the key was stored encrypted.
+func readPrivateKey(path string, password []byte) ([]byte, bool, error) {
+	keyFile, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !isEncryptedKey(keyFile) {
+		return keyFile, false, nil
+	}
+
+	if password == nil {
+		return nil, true, ErrEncryptedPrivateKey
+	}
+
+	privateKey, err := decryptPrivateKey(keyFile, password)
+	if err != nil {
+		return nil, true, err
+	}
+
+	return privateKey, true, nil
+}
+
+// writePrivateKey writes the private key of an onion service to the passed
+// path under strict permissions, encrypting it with the password if one is
+// provided. The key is written to a temporary file first, such that an
+// existing key is never left truncated.
+func writePrivateKey(path string, privateKey, password []byte) error {
+	keyFile := privateKey
+	if password != nil {
+		var err error
+		keyFile, err = encryptPrivateKey(privateKey, password)
+		if err != nil {
+			return err
+		}
+	}
+
+	tempPath := path + ".tmp"
+	if err := ioutil.WriteFile(tempPath, keyFile, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tempPath, path)
+}
+
+// ChangePrivateKeyPassword encrypts the private key of an onion service stored
+// at the passed path with the new password. A key that's already encrypted is
+// first decrypted with the old password. If no key exists at the path yet,
+// nothing is done.
+func ChangePrivateKeyPassword(path string, oldPassword,
+	newPassword []byte) error {
+
+	privateKey, _, err := readPrivateKey(path, oldPassword)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return writePrivateKey(path, privateKey, newPassword)
+}
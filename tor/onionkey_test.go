@@ -0,0 +1,148 @@
+package tor
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestPrivateKeyEncryption ensures that an onion service's private key can be
+// stored encrypted at rest and only be read back with the correct password.
+func TestPrivateKeyEncryption(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "onionkey")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	keyPath := filepath.Join(tempDir, "v3_onion_private_key")
+	privateKey := []byte("ED25519-V3:aGVsbG8gd29ybGQ=")
+	password := []byte("password")
+
+	// A key written without a password should be stored as is.
+	if err := writePrivateKey(keyPath, privateKey, nil); err != nil {
+		t.Fatalf("unable to write private key: %v", err)
+	}
+	key, encrypted, err := readPrivateKey(keyPath, password)
+	if err != nil {
+		t.Fatalf("unable to read private key: %v", err)
+	}
+	if encrypted || !bytes.Equal(key, privateKey) {
+		t.Fatalf("expected plaintext key %s, got %s (encrypted=%v)",
+			privateKey, key, encrypted)
+	}
+
+	// Once written with a password, the key should no longer be stored
+	// in plaintext.
+	if err := writePrivateKey(keyPath, privateKey, password); err != nil {
+		t.Fatalf("unable to write private key: %v", err)
+	}
+	keyFile, err := ioutil.ReadFile(keyPath)
+	if err != nil {
+		t.Fatalf("unable to read key file: %v", err)
+	}
+	if bytes.Contains(keyFile, privateKey) {
+		t.Fatalf("expected private key to be encrypted")
+	}
+
+	// Reading it back without a password or with the wrong one should
+	// fail.
+	_, _, err = readPrivateKey(keyPath, nil)
+	if err != ErrEncryptedPrivateKey {
+		t.Fatalf("expected ErrEncryptedPrivateKey, got %v", err)
+	}
+	if _, _, err := readPrivateKey(keyPath, []byte("wrong")); err == nil {
+		t.Fatalf("expected decryption with wrong password to fail")
+	}
+
+	// Finally, the correct password should return the original key.
+	key, encrypted, err = readPrivateKey(keyPath, password)
+	if err != nil {
+		t.Fatalf("unable to read private key: %v", err)
+	}
+	if !encrypted || !bytes.Equal(key, privateKey) {
+		t.Fatalf("expected encrypted key %s, got %s (encrypted=%v)",
+			privateKey, key, encrypted)
+	}
+}
+
+// TestChangePrivateKeyPassword ensures that an onion service's private key can
+// be re-encrypted with a new password, after which only the new password can
+// decrypt it.
+func TestChangePrivateKeyPassword(t *testing.T) {
+	t.Parallel()
+
+	tempDir, err := ioutil.TempDir("", "onionkey")
+	if err != nil {
+		t.Fatalf("unable to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	keyPath := filepath.Join(tempDir, "v3_onion_private_key")
+	privateKey := []byte("ED25519-V3:aGVsbG8gd29ybGQ=")
+	oldPassword := []byte("old-password")
+	newPassword := []byte("new-password")
+
+	// Without a key, there's nothing to change.
+	err = ChangePrivateKeyPassword(keyPath, oldPassword, newPassword)
+	if err != nil {
+		t.Fatalf("unable to change password of missing key: %v", err)
+	}
+	if _, err := os.Stat(keyPath); !os.IsNotExist(err) {
+		t.Fatalf("expected no key to be written")
+	}
+
+	err = writePrivateKey(keyPath, privateKey, oldPassword)
+	if err != nil {
+		t.Fatalf("unable to write private key: %v", err)
+	}
+
+	// Changing the password with the wrong old password should fail and
+	// leave the key untouched.
+	err = ChangePrivateKeyPassword(keyPath, []byte("wrong"), newPassword)
+	if err == nil {
+		t.Fatalf("expected password change with wrong password to fail")
+	}
+	if _, _, err := readPrivateKey(keyPath, oldPassword); err != nil {
+		t.Fatalf("unable to read private key: %v", err)
+	}
+
+	err = ChangePrivateKeyPassword(keyPath, oldPassword, newPassword)
+	if err != nil {
+		t.Fatalf("unable to change password: %v", err)
+	}
+
+	// The key should now only be readable with the new password.
+	if _, _, err := readPrivateKey(keyPath, oldPassword); err == nil {
+		t.Fatalf("expected decryption with old password to fail")
+	}
+	key, encrypted, err := readPrivateKey(keyPath, newPassword)
+	if err != nil {
+		t.Fatalf("unable to read private key: %v", err)
+	}
+	if !encrypted || !bytes.Equal(key, privateKey) {
+		t.Fatalf("expected encrypted key %s, got %s (encrypted=%v)",
+			privateKey, key, encrypted)
+	}
+}
+
+// TestIsolationCredentials ensures that the SOCKS credentials used for stream
+// isolation are stable for a key, and distinct across keys.
+func TestIsolationCredentials(t *testing.T) {
+	t.Parallel()
+
+	auth1 := isolationCredentials([]byte("peer1"))
+	auth2 := isolationCredentials([]byte("peer1"))
+	auth3 := isolationCredentials([]byte("peer2"))
+
+	if *auth1 != *auth2 {
+		t.Fatalf("expected identical credentials for the same key")
+	}
+	if auth1.User == auth3.User || auth1.Password == auth3.Password {
+		t.Fatalf("expected distinct credentials for distinct keys")
+	}
+}
@@ -2,6 +2,7 @@ package tor
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
 	"net"
@@ -74,6 +75,40 @@ func Dial(address, socksAddr string, streamIsolation bool) (net.Conn, error) {
 	}, nil
 }
 
+// DialIsolated is identical to Dial, except that the stream is isolated by the
+// passed key rather than at random: connections dialed with the same key may
+// share a circuit, while connections dialed with different keys never do.
+func DialIsolated(address, socksAddr string, isolationKey []byte) (net.Conn,
+	error) {
+
+	conn, err := dialWithAuth(
+		address, socksAddr, isolationCredentials(isolationKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteAddr, err := ParseAddr(address, socksAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proxyConn{
+		Conn:       conn,
+		remoteAddr: remoteAddr,
+	}, nil
+}
+
+// isolationCredentials derives the SOCKS credentials that isolate the streams
+// of the passed key. Tor uses a separate circuit for each set of credentials.
+func isolationCredentials(isolationKey []byte) *proxy.Auth {
+	h := sha256.Sum256(isolationKey)
+	return &proxy.Auth{
+		User:     hex.EncodeToString(h[:16]),
+		Password: hex.EncodeToString(h[16:]),
+	}
+}
+
 // dial establishes a connection to the address via Tor's SOCKS proxy. Only TCP
 // is supported over Tor. The final argument determines if we should force
 // stream isolation for this new connection. If we do, then this means this new
@@ -96,6 +131,14 @@ func dial(address, socksAddr string, streamIsolation bool) (net.Conn, error) {
 		}
 	}
 
+	return dialWithAuth(address, socksAddr, auth)
+}
+
+// dialWithAuth establishes a connection to the address via Tor's SOCKS proxy,
+// authenticating with the passed credentials if any.
+func dialWithAuth(address, socksAddr string, auth *proxy.Auth) (net.Conn,
+	error) {
+
 	// Establish the connection through Tor's SOCKS proxy.
 	dialer, err := proxy.SOCKS5("tcp", socksAddr, auth, proxy.Direct)
 	if err != nil {
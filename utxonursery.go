@@ -8,7 +8,7 @@ import (
 	"sync"
 	"sync/atomic"
 
-	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
@@ -16,6 +16,7 @@ import (
 	"github.com/lightningnetwork/lnd/chainntnfs"
 	"github.com/lightningnetwork/lnd/channeldb"
 	"github.com/lightningnetwork/lnd/lnwallet"
+	"github.com/lightningnetwork/lnd/sweep"
 )
 
 //                          SUMMARY OF OUTPUT STATES
@@ -168,6 +169,10 @@ var (
 	// ErrContractNotFound is returned when the nursery is unable to
 	// retrieve information about a queried contract.
 	ErrContractNotFound = fmt.Errorf("unable to locate contract")
+
+	// errNurseryShuttingDown is returned when the nursery exits while
+	// waiting for an output to be swept.
+	errNurseryShuttingDown = fmt.Errorf("utxo nursery shutting down")
 )
 
 // NurseryConfig abstracts the required subsystems used by the utxo nursery. An
@@ -185,14 +190,6 @@ type NurseryConfig struct {
 	// fully closed after incubation has concluded.
 	DB *channeldb.DB
 
-	// Estimator is used when crafting sweep transactions to estimate the
-	// necessary fee relative to the expected size of the sweep transaction.
-	Estimator lnwallet.FeeEstimator
-
-	// GenSweepScript generates a P2WKH script belonging to the wallet where
-	// funds can be swept.
-	GenSweepScript func() ([]byte, error)
-
 	// Notifier provides the utxo nursery the ability to subscribe to
 	// transaction confirmation events, which advance outputs through their
 	// persistence state transitions.
@@ -202,13 +199,12 @@ type NurseryConfig struct {
 	// transaction to the appropriate network.
 	PublishTransaction func(*wire.MsgTx) error
 
-	// Signer is used by the utxo nursery to generate valid witnesses at the
-	// time the incubated outputs need to be spent.
-	Signer lnwallet.Signer
-
 	// Store provides access to and modification of the persistent state
 	// maintained about the utxo nursery's incubating outputs.
 	Store NurseryStore
+
+	// Sweeper is used to sweep mature outputs back into the wallet.
+	Sweeper *sweep.UtxoSweeper
 }
 
 // utxoNursery is a system dedicated to incubating time-locked outputs created
@@ -722,25 +718,23 @@ func (u *utxoNursery) reloadClasses(lastGradHeight uint32) error {
 // transactions or signing are done as a result of this step.
 func (u *utxoNursery) regraduateClass(classHeight uint32) error {
 	// Fetch all information about the crib and kindergarten outputs at
-	// this height. In addition to the outputs, we also retrieve the
-	// finalized kindergarten sweep txn, which will be nil if we have not
-	// attempted this height before, or if no kindergarten outputs exist at
 	// this height.
-	finalTx, kgtnOutputs, cribOutputs, err := u.cfg.Store.FetchClass(
+	_, kgtnOutputs, cribOutputs, err := u.cfg.Store.FetchClass(
 		classHeight)
 	if err != nil {
 		return err
 	}
 
-	if finalTx != nil {
-		utxnLog.Infof("Re-registering confirmation for kindergarten "+
-			"sweep transaction at height=%d ", classHeight)
+	// As the sweeper doesn't persist its inputs, we hand the kindergarten
+	// outputs which haven't graduated yet to it once more.
+	if len(kgtnOutputs) > 0 {
+		utxnLog.Infof("Re-offering %d kindergarten outputs at "+
+			"height=%d to sweeper", len(kgtnOutputs), classHeight)
 
-		err = u.sweepMatureOutputs(classHeight, finalTx, kgtnOutputs)
+		err = u.sweepMatureOutputs(classHeight, kgtnOutputs)
 		if err != nil {
-			utxnLog.Errorf("Failed to re-register for kindergarten "+
-				"sweep transaction at height=%d: %v",
-				classHeight, err)
+			utxnLog.Errorf("Failed to re-offer kindergarten "+
+				"outputs at height=%d: %v", classHeight, err)
 			return err
 		}
 	}
@@ -824,11 +818,8 @@ func (u *utxoNursery) graduateClass(classHeight uint32) error {
 	u.bestHeight = classHeight
 
 	// Fetch all information about the crib and kindergarten outputs at
-	// this height. In addition to the outputs, we also retrieve the
-	// finalized kindergarten sweep txn, which will be nil if we have not
-	// attempted this height before, or if no kindergarten outputs exist at
 	// this height.
-	finalTx, kgtnOutputs, cribOutputs, err := u.cfg.Store.FetchClass(
+	_, kgtnOutputs, cribOutputs, err := u.cfg.Store.FetchClass(
 		classHeight)
 	if err != nil {
 		return err
@@ -837,55 +828,11 @@ func (u *utxoNursery) graduateClass(classHeight uint32) error {
 	utxnLog.Infof("Attempting to graduate height=%v: num_kids=%v, "+
 		"num_babies=%v", classHeight, len(kgtnOutputs), len(cribOutputs))
 
-	// Load the last finalized height, so we can determine if the
-	// kindergarten sweep txn should be crafted.
-	lastFinalizedHeight, err := u.cfg.Store.LastFinalizedHeight()
-	if err != nil {
-		return err
-	}
-
-	// If we haven't processed this height before, we finalize the
-	// graduating kindergarten outputs, by signing a sweep transaction that
-	// spends from them. This txn is persisted such that we never broadcast
-	// a different txn for the same height. This allows us to recover from
-	// failures, and watch for the correct txid.
-	if classHeight > lastFinalizedHeight {
-		// If this height has never been finalized, we have never
-		// generated a sweep txn for this height. Generate one if there
-		// are kindergarten outputs or cltv crib outputs to be spent.
-		if len(kgtnOutputs) > 0 {
-			finalTx, err = u.createSweepTx(kgtnOutputs, classHeight)
-			if err != nil {
-				utxnLog.Errorf("Failed to create sweep txn at "+
-					"height=%d", classHeight)
-				return err
-			}
-		}
-
-		// Persist the kindergarten sweep txn to the nursery store. It
-		// is safe to store a nil finalTx, which happens if there are
-		// no graduating kindergarten outputs.
-		err = u.cfg.Store.FinalizeKinder(classHeight, finalTx)
-		if err != nil {
-			utxnLog.Errorf("Failed to finalize kindergarten at "+
-				"height=%d", classHeight)
-
-			return err
-		}
-
-		// Log if the finalized transaction is non-trivial.
-		if finalTx != nil {
-			utxnLog.Infof("Finalized kindergarten at height=%d ",
-				classHeight)
-		}
-	}
-
-	// Now that the kindergarten sweep txn has either been finalized or
-	// restored, broadcast the txn, and set up notifications that will
-	// transition the swept kindergarten outputs and cltvCrib into
-	// graduated outputs.
-	if finalTx != nil {
-		err := u.sweepMatureOutputs(classHeight, finalTx, kgtnOutputs)
+	// Hand the graduating kindergarten outputs to the sweeper, and set up
+	// notifications that will transition them into graduated outputs once
+	// swept.
+	if len(kgtnOutputs) > 0 {
+		err := u.sweepMatureOutputs(classHeight, kgtnOutputs)
 		if err != nil {
 			utxnLog.Errorf("Failed to sweep %d kindergarten "+
 				"outputs at height=%d: %v",
@@ -910,280 +857,94 @@ func (u *utxoNursery) graduateClass(classHeight uint32) error {
 	return u.cfg.Store.GraduateHeight(classHeight)
 }
 
-// craftSweepTx accepts a list of kindergarten outputs, and baby
-// outputs which don't require a second-layer claim, and signs and generates a
-// signed txn that spends from them. This method also makes an accurate fee
-// estimate before generating the required witnesses.
-func (u *utxoNursery) createSweepTx(kgtnOutputs []kidOutput,
-	classHeight uint32) (*wire.MsgTx, error) {
-
-	// Create a transaction which sweeps all the newly mature outputs into
-	// an output controlled by the wallet.
-
-	// TODO(roasbeef): can be more intelligent about buffering outputs to
-	// be more efficient on-chain.
-
-	// Assemble the kindergarten class into a slice csv spendable outputs,
-	// and also a set of regular spendable outputs. The set of regular
-	// outputs are CLTV locked outputs that have had their timelocks
-	// expire.
-	var (
-		csvOutputs     []CsvSpendableOutput
-		cltvOutputs    []SpendableOutput
-		weightEstimate lnwallet.TxWeightEstimator
-	)
-
-	// Allocate enough room for both types of kindergarten outputs.
-	csvOutputs = make([]CsvSpendableOutput, 0, len(kgtnOutputs))
-	cltvOutputs = make([]SpendableOutput, 0, len(kgtnOutputs))
+// sweepMatureOutputs hands the kindergarten outputs of a class to the
+// sweeper, which transfers control of the funds from a prior channel
+// commitment transaction to the user's wallet. The outputs swept were
+// previously time locked (either absolute or relative), but are now mature
+// enough to sweep. A goroutine is spawned that graduates the class once all
+// of its outputs have been swept.
+func (u *utxoNursery) sweepMatureOutputs(classHeight uint32,
+	kgtnOutputs []kidOutput) error {
 
-	// Our sweep transaction will pay to a single segwit p2wkh address,
-	// ensure it contributes to our weight estimate.
-	weightEstimate.AddP2WKHOutput()
+	utxnLog.Infof("Sweeping %v CSV-delayed outputs at height=%d",
+		len(kgtnOutputs), classHeight)
 
-	// For each kindergarten output, use its witness type to determine the
-	// estimate weight of its witness, and add it to the proper set of
-	// spendable outputs.
+	resultChans := make([]chan sweep.Result, 0, len(kgtnOutputs))
 	for i := range kgtnOutputs {
-		input := &kgtnOutputs[i]
-
-		switch input.WitnessType() {
-
-		// Outputs on a past commitment transaction that pay directly
-		// to us.
-		case lnwallet.CommitmentTimeLock:
-			weightEstimate.AddWitnessInput(
-				lnwallet.ToLocalTimeoutWitnessSize,
-			)
-			csvOutputs = append(csvOutputs, input)
-
-		// Outgoing second layer HTLC's that have confirmed within the
-		// chain, and the output they produced is now mature enough to
-		// sweep.
-		case lnwallet.HtlcOfferedTimeoutSecondLevel:
-			weightEstimate.AddWitnessInput(
-				lnwallet.ToLocalTimeoutWitnessSize,
-			)
-			csvOutputs = append(csvOutputs, input)
-
-		// Incoming second layer HTLC's that have confirmed within the
-		// chain, and the output they produced is now mature enough to
-		// sweep.
-		case lnwallet.HtlcAcceptedSuccessSecondLevel:
-			weightEstimate.AddWitnessInput(
-				lnwallet.ToLocalTimeoutWitnessSize,
-			)
-			csvOutputs = append(csvOutputs, input)
-
-		// An HTLC on the commitment transaction of the remote party,
-		// that has had its absolute timelock expire.
-		case lnwallet.HtlcOfferedRemoteTimeout:
-			weightEstimate.AddWitnessInput(
-				lnwallet.AcceptedHtlcTimeoutWitnessSize,
-			)
-			cltvOutputs = append(cltvOutputs, input)
-
-		default:
-			utxnLog.Warnf("kindergarten output in nursery store "+
-				"contains unexpected witness type: %v",
-				input.WitnessType())
-			continue
-		}
-	}
-
-	utxnLog.Infof("Creating sweep transaction for %v CSV inputs, %v CLTV "+
-		"inputs", len(csvOutputs), len(cltvOutputs))
+		kid := &kgtnOutputs[i]
 
-	txWeight := int64(weightEstimate.Weight())
-	return u.populateSweepTx(txWeight, classHeight, csvOutputs, cltvOutputs)
-}
-
-// populateSweepTx populate the final sweeping transaction with all witnesses
-// in place for all inputs using the provided txn fee. The created transaction
-// has a single output sending all the funds back to the source wallet, after
-// accounting for the fee estimate.
-func (u *utxoNursery) populateSweepTx(txWeight int64, classHeight uint32,
-	csvInputs []CsvSpendableOutput,
-	cltvInputs []SpendableOutput) (*wire.MsgTx, error) {
-
-	// Generate the receiving script to which the funds will be swept.
-	pkScript, err := u.cfg.GenSweepScript()
-	if err != nil {
-		return nil, err
-	}
-
-	// Sum up the total value contained in the inputs.
-	var totalSum btcutil.Amount
-	for _, o := range csvInputs {
-		totalSum += o.Amount()
-	}
-	for _, o := range cltvInputs {
-		totalSum += o.Amount()
-	}
-
-	// Using the txn weight estimate, compute the required txn fee.
-	feePerKw, err := u.cfg.Estimator.EstimateFeePerKW(6)
-	if err != nil {
-		return nil, err
-	}
-	txFee := feePerKw.FeeForWeight(txWeight)
-
-	// Sweep as much possible, after subtracting txn fees.
-	sweepAmt := int64(totalSum - txFee)
-
-	// Create the sweep transaction that we will be building. We use
-	// version 2 as it is required for CSV. The txn will sweep the amount
-	// after fees to the pkscript generated above.
-	sweepTx := wire.NewMsgTx(2)
-	sweepTx.AddTxOut(&wire.TxOut{
-		PkScript: pkScript,
-		Value:    sweepAmt,
-	})
-
-	// We'll also ensure that the transaction has the required lock time if
-	// we're sweeping any cltvInputs.
-	if len(cltvInputs) > 0 {
-		sweepTx.LockTime = classHeight
-	}
-
-	// Add all inputs to the sweep transaction. Ensure that for each
-	// csvInput, we set the sequence number properly.
-	for _, input := range csvInputs {
-		sweepTx.AddTxIn(&wire.TxIn{
-			PreviousOutPoint: *input.OutPoint(),
-			Sequence:         input.BlocksToMaturity(),
-		})
-	}
-	for _, input := range cltvInputs {
-		sweepTx.AddTxIn(&wire.TxIn{
-			PreviousOutPoint: *input.OutPoint(),
-		})
-	}
-
-	// Before signing the transaction, check to ensure that it meets some
-	// basic validity requirements.
-	// TODO(conner): add more control to sanity checks, allowing us to delay
-	// spending "problem" outputs, e.g. possibly batching with other classes
-	// if fees are too low.
-	btx := btcutil.NewTx(sweepTx)
-	if err := blockchain.CheckTransactionSanity(btx); err != nil {
-		return nil, err
-	}
-
-	hashCache := txscript.NewTxSigHashes(sweepTx)
-
-	// With all the inputs in place, use each output's unique witness
-	// function to generate the final witness required for spending.
-	addWitness := func(idx int, tso SpendableOutput) error {
-		witness, err := tso.BuildWitness(
-			u.cfg.Signer, sweepTx, hashCache, idx,
+		resultChan, err := u.cfg.Sweeper.SweepInput(
+			kid, sweep.DefaultConfTarget, kid.ConfHeight(),
 		)
 		if err != nil {
 			return err
 		}
 
-		sweepTx.TxIn[idx].Witness = witness
-
-		return nil
-	}
-
-	// Finally we'll attach a valid witness to each csv and cltv input
-	// within the sweeping transaction.
-	for i, input := range csvInputs {
-		if err := addWitness(i, input); err != nil {
-			return nil, err
-		}
+		resultChans = append(resultChans, resultChan)
 	}
 
-	// Add offset to relative indexes so cltv witnesses don't overwrite csv
-	// witnesses.
-	offset := len(csvInputs)
-	for i, input := range cltvInputs {
-		if err := addWitness(offset+i, input); err != nil {
-			return nil, err
-		}
-	}
+	u.wg.Add(1)
+	go u.waitForSweepConf(classHeight, kgtnOutputs, resultChans)
 
-	return sweepTx, nil
+	return nil
 }
 
-// sweepMatureOutputs generates and broadcasts the transaction that transfers
-// control of funds from a prior channel commitment transaction to the user's
-// wallet. The outputs swept were previously time locked (either absolute or
-// relative), but are not mature enough to sweep into the wallet.
-func (u *utxoNursery) sweepMatureOutputs(classHeight uint32, finalTx *wire.MsgTx,
-	kgtnOutputs []kidOutput) error {
-
-	utxnLog.Infof("Sweeping %v CSV-delayed outputs with sweep tx "+
-		"(txid=%v): %v", len(kgtnOutputs),
-		finalTx.TxHash(), newLogClosure(func() string {
-			return spew.Sdump(finalTx)
-		}),
-	)
-
-	// With the sweep transaction fully signed, broadcast the transaction
-	// to the network. Additionally, we can stop tracking these outputs as
-	// they've just been swept.
-	err := u.cfg.PublishTransaction(finalTx)
-	if err != nil && err != lnwallet.ErrDoubleSpend {
-		utxnLog.Errorf("unable to broadcast sweep tx: %v, %v",
-			err, spew.Sdump(finalTx))
-		return err
-	}
-
-	return u.registerSweepConf(finalTx, kgtnOutputs, classHeight)
-}
+// waitForSweepConf waits until all kindergarten outputs of a class have been
+// swept, and watches for the confirmation of the sweep transactions. Once
+// confirmation has been received, the nursery will mark those outputs as fully
+// graduated, and proceed to mark any mature channels as fully closed in
+// channeldb.
+// NOTE(conner): this method MUST be called as a go routine.
+func (u *utxoNursery) waitForSweepConf(classHeight uint32,
+	kgtnOutputs []kidOutput, resultChans []chan sweep.Result) {
 
-// registerSweepConf is responsible for registering a finalized kindergarten
-// sweep transaction for confirmation notifications. If the confirmation was
-// successfully registered, a goroutine will be spawned that waits for the
-// confirmation, and graduates the provided kindergarten class within the
-// nursery store.
-func (u *utxoNursery) registerSweepConf(finalTx *wire.MsgTx,
-	kgtnOutputs []kidOutput, heightHint uint32) error {
+	defer u.wg.Done()
 
-	finalTxID := finalTx.TxHash()
+	// Collect the distinct transactions which swept the outputs, as the
+	// sweeper may have split them over several transactions.
+	sweepTxs := make(map[chainhash.Hash]*wire.MsgTx)
+	for i, resultChan := range resultChans {
+		sweepTx, err := u.waitForSweep(&kgtnOutputs[i], resultChan)
+		if err == errNurseryShuttingDown {
+			return
+		}
+		if err != nil {
+			utxnLog.Errorf("Unable to sweep kindergarten output "+
+				"%v: %v", kgtnOutputs[i].OutPoint(), err)
+			return
+		}
 
-	confChan, err := u.cfg.Notifier.RegisterConfirmationsNtfn(
-		&finalTxID, finalTx.TxOut[0].PkScript, u.cfg.ConfDepth,
-		heightHint,
-	)
-	if err != nil {
-		utxnLog.Errorf("unable to register notification for "+
-			"sweep confirmation: %v", finalTxID)
-		return err
+		sweepTxs[sweepTx.TxHash()] = sweepTx
 	}
 
-	utxnLog.Infof("Registering sweep tx %v for confs at height=%d",
-		finalTxID, heightHint)
-
-	u.wg.Add(1)
-	go u.waitForSweepConf(heightHint, kgtnOutputs, confChan)
+	for txid, sweepTx := range sweepTxs {
+		txid := txid
 
-	return nil
-}
+		confChan, err := u.cfg.Notifier.RegisterConfirmationsNtfn(
+			&txid, sweepTx.TxOut[0].PkScript, u.cfg.ConfDepth,
+			classHeight,
+		)
+		if err != nil {
+			utxnLog.Errorf("unable to register notification for "+
+				"sweep confirmation: %v", txid)
+			return
+		}
 
-// waitForSweepConf watches for the confirmation of a sweep transaction
-// containing a batch of kindergarten outputs. Once confirmation has been
-// received, the nursery will mark those outputs as fully graduated, and proceed
-// to mark any mature channels as fully closed in channeldb.
-// NOTE(conner): this method MUST be called as a go routine.
-func (u *utxoNursery) waitForSweepConf(classHeight uint32,
-	kgtnOutputs []kidOutput, confChan *chainntnfs.ConfirmationEvent) {
+		utxnLog.Infof("Registering sweep tx %v for confs at height=%d",
+			txid, classHeight)
 
-	defer u.wg.Done()
+		select {
+		case _, ok := <-confChan.Confirmed:
+			if !ok {
+				utxnLog.Errorf("Notification chan closed, "+
+					"can't advance %v graduating outputs",
+					len(kgtnOutputs))
+				return
+			}
 
-	select {
-	case _, ok := <-confChan.Confirmed:
-		if !ok {
-			utxnLog.Errorf("Notification chan closed, can't"+
-				" advance %v graduating outputs",
-				len(kgtnOutputs))
+		case <-u.quit:
 			return
 		}
-
-	case <-u.quit:
-		return
 	}
 
 	u.mu.Lock()
@@ -1220,6 +981,68 @@ func (u *utxoNursery) waitForSweepConf(classHeight uint32,
 	}
 }
 
+// waitForSweep waits for the sweeper to sweep the given kindergarten output,
+// and returns the transaction that spent it. If the sweeper gives up on the
+// output, it is offered to the sweeper once more at the next block, as the
+// output's class can't graduate without it.
+func (u *utxoNursery) waitForSweep(kid *kidOutput,
+	resultChan chan sweep.Result) (*wire.MsgTx, error) {
+
+	for {
+		var result sweep.Result
+		select {
+		case result = <-resultChan:
+		case <-u.quit:
+			return nil, errNurseryShuttingDown
+		}
+
+		switch result.Err {
+		case nil:
+			return result.Tx, nil
+
+		// The output was spent by a transaction not created by the
+		// sweeper, such as a sweep transaction finalized by a previous
+		// version of the nursery. Either way the output is gone, so
+		// we'll wait for that transaction instead.
+		case sweep.ErrRemoteSpend:
+			utxnLog.Warnf("Kindergarten output %v spent by "+
+				"foreign tx %v", kid.OutPoint(),
+				result.Tx.TxHash())
+
+			return result.Tx, nil
+		}
+
+		utxnLog.Errorf("Unable to sweep kindergarten output %v, "+
+			"retrying at next block: %v", kid.OutPoint(),
+			result.Err)
+
+		blockEpochs, err := u.cfg.Notifier.RegisterBlockEpochNtfn(nil)
+		if err != nil {
+			return nil, err
+		}
+
+		select {
+		case _, ok := <-blockEpochs.Epochs:
+			blockEpochs.Cancel()
+			if !ok {
+				return nil, fmt.Errorf("block epoch " +
+					"notifications closed")
+			}
+
+		case <-u.quit:
+			blockEpochs.Cancel()
+			return nil, errNurseryShuttingDown
+		}
+
+		resultChan, err = u.cfg.Sweeper.SweepInput(
+			kid, sweep.DefaultConfTarget, kid.ConfHeight(),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
 // sweepCribOutput broadcasts the crib output's htlc timeout txn, and sets up a
 // notification that will advance it to the kindergarten bucket upon
 // confirmation.
@@ -1788,7 +1611,7 @@ func (k *kidOutput) ConfHeight() uint32 {
 
 // Encode converts a KidOutput struct into a form suitable for on-disk database
 // storage. Note that the signDescriptor struct field is included so that the
-// output's witness can be generated by createSweepTx() when the output becomes
+// output's witness can be generated by the sweeper when the output becomes
 // spendable.
 func (k *kidOutput) Encode(w io.Writer) error {
 	var scratch [8]byte
@@ -14,6 +14,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet"
 	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+	"github.com/lightningnetwork/lnd/tor"
 	"golang.org/x/net/context"
 )
 
@@ -77,11 +78,14 @@ type UnlockerService struct {
 	chainDir      string
 	netParams     *chaincfg.Params
 	macaroonFiles []string
+	onionKeyPath  string
 }
 
-// New creates and returns a new UnlockerService.
-func New(chainDir string, params *chaincfg.Params,
-	macaroonFiles []string) *UnlockerService {
+// New creates and returns a new UnlockerService. If the private key of the
+// node's onion service is encrypted with the wallet's password, its path must
+// be passed, such that it's re-encrypted when the password changes.
+func New(chainDir string, params *chaincfg.Params, macaroonFiles []string,
+	onionKeyPath string) *UnlockerService {
 
 	return &UnlockerService{
 		InitMsgs:      make(chan *WalletInitMsg, 1),
@@ -89,6 +93,7 @@ func New(chainDir string, params *chaincfg.Params,
 		chainDir:      chainDir,
 		netParams:     params,
 		macaroonFiles: macaroonFiles,
+		onionKeyPath:  onionKeyPath,
 	}
 }
 
@@ -351,6 +356,20 @@ func (u *UnlockerService) ChangePassword(ctx context.Context,
 			"%v", err)
 	}
 
+	// The private key of our onion service may also be encrypted with the
+	// wallet's password. Unlike the macaroons, it can't be re-generated
+	// without changing our onion address, so we'll re-encrypt it with the
+	// new password instead.
+	if u.onionKeyPath != "" {
+		err := tor.ChangePrivateKeyPassword(
+			u.onionKeyPath, privatePw, in.NewPassword,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("unable to re-encrypt onion "+
+				"service private key: %v", err)
+		}
+	}
+
 	// Finally, send the new password across the UnlockPasswords channel to
 	// automatically unlock the wallet.
 	u.UnlockMsgs <- &WalletUnlockMsg{Passphrase: in.NewPassword}
@@ -358,6 +377,46 @@ func (u *UnlockerService) ChangePassword(ctx context.Context,
 	return &lnrpc.ChangePasswordResponse{}, nil
 }
 
+// ChangeSeedPassphrase re-enciphers the passed aezeed cipher seed mnemonic
+// under a new passphrase. As the underlying seed remains unchanged, the new
+// mnemonic restores the very same wallet, including its birthday. This
+// doesn't require a wallet to exist, nor does it alter an existing one.
+func (u *UnlockerService) ChangeSeedPassphrase(ctx context.Context,
+	in *lnrpc.ChangeSeedPassphraseRequest) (
+	*lnrpc.ChangeSeedPassphraseResponse, error) {
+
+	if len(in.CipherSeedMnemonic) != aezeed.NummnemonicWords {
+		return nil, fmt.Errorf("mnemonic must be %v words, got %v",
+			aezeed.NummnemonicWords, len(in.CipherSeedMnemonic))
+	}
+
+	var mnemonic aezeed.Mnemonic
+	copy(mnemonic[:], in.CipherSeedMnemonic[:])
+
+	// We'll first decipher the seed using the current passphrase, which
+	// fails if either the mnemonic or the passphrase is wrong.
+	cipherSeed, err := mnemonic.ToCipherSeed(in.CurrentAezeedPassphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	// With the seed deciphered, we'll re-encipher it under the new
+	// passphrase, obtaining both the mnemonic and the raw cipher text.
+	newMnemonic, err := cipherSeed.ToMnemonic(in.NewAezeedPassphrase)
+	if err != nil {
+		return nil, err
+	}
+	encipheredSeed, err := cipherSeed.Encipher(in.NewAezeedPassphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lnrpc.ChangeSeedPassphraseResponse{
+		CipherSeedMnemonic: []string(newMnemonic[:]),
+		EncipheredSeed:     encipheredSeed[:],
+	}, nil
+}
+
 // validatePassword assures the password meets all of our constraints.
 func validatePassword(password []byte) error {
 	// Passwords should have a length of at least 8 characters.
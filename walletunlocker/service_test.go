@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"github.com/lightningnetwork/lnd/keychain"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnwallet/btcwallet"
+	"github.com/lightningnetwork/lnd/tor"
 	"github.com/lightningnetwork/lnd/walletunlocker"
 	"golang.org/x/net/context"
 )
@@ -66,7 +68,7 @@ func TestGenSeed(t *testing.T) {
 	}
 	defer os.RemoveAll(testDir)
 
-	service := walletunlocker.New(testDir, testNetParams, nil)
+	service := walletunlocker.New(testDir, testNetParams, nil, "")
 
 	// Now that the service has been created, we'll ask it to generate a
 	// new seed for us given a test passphrase.
@@ -107,7 +109,7 @@ func TestGenSeedGenerateEntropy(t *testing.T) {
 	defer func() {
 		os.RemoveAll(testDir)
 	}()
-	service := walletunlocker.New(testDir, testNetParams, nil)
+	service := walletunlocker.New(testDir, testNetParams, nil, "")
 
 	// Now that the service has been created, we'll ask it to generate a
 	// new seed for us given a test passphrase. Note that we don't actually
@@ -147,7 +149,7 @@ func TestGenSeedInvalidEntropy(t *testing.T) {
 	defer func() {
 		os.RemoveAll(testDir)
 	}()
-	service := walletunlocker.New(testDir, testNetParams, nil)
+	service := walletunlocker.New(testDir, testNetParams, nil, "")
 
 	// Now that the service has been created, we'll ask it to generate a
 	// new seed for us given a test passphrase. However, we'll be using an
@@ -185,7 +187,7 @@ func TestInitWallet(t *testing.T) {
 	}()
 
 	// Create new UnlockerService.
-	service := walletunlocker.New(testDir, testNetParams, nil)
+	service := walletunlocker.New(testDir, testNetParams, nil, "")
 
 	// Once we have the unlocker service created, we'll now instantiate a
 	// new cipher seed instance.
@@ -286,7 +288,7 @@ func TestCreateWalletInvalidEntropy(t *testing.T) {
 	}()
 
 	// Create new UnlockerService.
-	service := walletunlocker.New(testDir, testNetParams, nil)
+	service := walletunlocker.New(testDir, testNetParams, nil, "")
 
 	// We'll attempt to init the wallet with an invalid cipher seed and
 	// passphrase.
@@ -319,7 +321,7 @@ func TestUnlockWallet(t *testing.T) {
 	}()
 
 	// Create new UnlockerService.
-	service := walletunlocker.New(testDir, testNetParams, nil)
+	service := walletunlocker.New(testDir, testNetParams, nil, "")
 
 	ctx := context.Background()
 	req := &lnrpc.UnlockWalletRequest{
@@ -392,8 +394,18 @@ func TestChangeWalletPassword(t *testing.T) {
 		file.Close()
 	}
 
+	// Create an onion service private key that should be encrypted with
+	// the new password after a password change is successful.
+	onionKey := []byte("ED25519-V3:aGVsbG8gd29ybGQ=")
+	onionKeyPath := filepath.Join(testDir, "v3_onion_private_key")
+	if err := ioutil.WriteFile(onionKeyPath, onionKey, 0600); err != nil {
+		t.Fatalf("unable to write onion key: %v", err)
+	}
+
 	// Create a new UnlockerService with our temp files.
-	service := walletunlocker.New(testDir, testNetParams, tempFiles)
+	service := walletunlocker.New(
+		testDir, testNetParams, tempFiles, onionKeyPath,
+	)
 
 	ctx := context.Background()
 	newPassword := []byte("hunter2???")
@@ -454,6 +466,30 @@ func TestChangeWalletPassword(t *testing.T) {
 		}
 	}
 
+	// The onion key should now be encrypted with the new password, so
+	// only the new password should be able to decrypt it.
+	keyFile, err := ioutil.ReadFile(onionKeyPath)
+	if err != nil {
+		t.Fatalf("unable to read onion key: %v", err)
+	}
+	if bytes.Contains(keyFile, onionKey) {
+		t.Fatal("expected onion key to be encrypted")
+	}
+	err = tor.ChangePrivateKeyPassword(
+		onionKeyPath, testPassword, testPassword,
+	)
+	if err == nil {
+		t.Fatal("expected onion key decryption with old password " +
+			"to fail")
+	}
+	err = tor.ChangePrivateKeyPassword(
+		onionKeyPath, newPassword, newPassword,
+	)
+	if err != nil {
+		t.Fatalf("unable to decrypt onion key with new password: %v",
+			err)
+	}
+
 	// The new password should be sent over the channel.
 	select {
 	case unlockMsg := <-service.UnlockMsgs:
@@ -465,3 +501,91 @@ func TestChangeWalletPassword(t *testing.T) {
 		t.Fatalf("password not received")
 	}
 }
+
+// TestChangeSeedPassphrase tests that the seed passphrase of a mnemonic can be
+// changed, resulting in a mnemonic that encodes the same seed, and that the
+// change is rejected if the current passphrase is wrong.
+func TestChangeSeedPassphrase(t *testing.T) {
+	t.Parallel()
+
+	testDir, err := ioutil.TempDir("", "testchangeseedpass")
+	if err != nil {
+		t.Fatalf("unable to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(testDir)
+
+	service := walletunlocker.New(testDir, testNetParams, nil, "")
+
+	// We'll start by creating a mnemonic enciphered with a test
+	// passphrase.
+	currentPass := []byte("kek")
+	birthday := time.Now()
+	cipherSeed, err := aezeed.New(
+		keychain.KeyDerivationVersion, &testEntropy, birthday,
+	)
+	if err != nil {
+		t.Fatalf("unable to create seed: %v", err)
+	}
+	mnemonic, err := cipherSeed.ToMnemonic(currentPass)
+	if err != nil {
+		t.Fatalf("unable to create mnemonic: %v", err)
+	}
+
+	// Attempting to change the passphrase with the wrong current
+	// passphrase should fail.
+	ctx := context.Background()
+	newPass := []byte("new-kek")
+	req := &lnrpc.ChangeSeedPassphraseRequest{
+		CipherSeedMnemonic:      mnemonic[:],
+		CurrentAezeedPassphrase: []byte("wrong"),
+		NewAezeedPassphrase:     newPass,
+	}
+	_, err = service.ChangeSeedPassphrase(ctx, req)
+	if err != aezeed.ErrInvalidPass {
+		t.Fatalf("expected ErrInvalidPass, got %v", err)
+	}
+
+	// So should passing an incomplete mnemonic.
+	req.CurrentAezeedPassphrase = currentPass
+	req.CipherSeedMnemonic = mnemonic[:aezeed.NummnemonicWords-1]
+	if _, err := service.ChangeSeedPassphrase(ctx, req); err == nil {
+		t.Fatalf("expected incomplete mnemonic to be rejected")
+	}
+
+	// With the right passphrase, the change should succeed.
+	req.CipherSeedMnemonic = mnemonic[:]
+	resp, err := service.ChangeSeedPassphrase(ctx, req)
+	if err != nil {
+		t.Fatalf("unable to change seed passphrase: %v", err)
+	}
+
+	// The new mnemonic should no longer be deciphered by the old
+	// passphrase, but by the new one, revealing the same seed.
+	var newMnemonic aezeed.Mnemonic
+	copy(newMnemonic[:], resp.CipherSeedMnemonic)
+	if _, err := newMnemonic.ToCipherSeed(currentPass); err == nil {
+		t.Fatalf("expected old passphrase to be rejected")
+	}
+	newCipherSeed, err := newMnemonic.ToCipherSeed(newPass)
+	if err != nil {
+		t.Fatalf("unable to decipher new mnemonic: %v", err)
+	}
+	if newCipherSeed.Entropy != cipherSeed.Entropy {
+		t.Fatalf("entropy mismatch: expected %x, got %x",
+			cipherSeed.Entropy, newCipherSeed.Entropy)
+	}
+	if newCipherSeed.Birthday != cipherSeed.Birthday {
+		t.Fatalf("birthday mismatch: expected %v, got %v",
+			cipherSeed.Birthday, newCipherSeed.Birthday)
+	}
+
+	// Finally, the raw enciphered seed should match the new mnemonic.
+	encipheredSeed, err := newCipherSeed.Encipher(newPass)
+	if err != nil {
+		t.Fatalf("unable to encipher seed: %v", err)
+	}
+	if !bytes.Equal(resp.EncipheredSeed, encipheredSeed[:]) {
+		t.Fatalf("enciphered seed mismatch: expected %x, got %x",
+			encipheredSeed, resp.EncipheredSeed)
+	}
+}
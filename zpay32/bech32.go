@@ -9,6 +9,27 @@ const charset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
 
 var gen = []int{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
 
+// ErrInvalidChecksum is returned when the bech32 checksum of an encoded
+// invoice doesn't match its contents, which usually means that the invoice was
+// mistyped or truncated.
+type ErrInvalidChecksum struct {
+	// Expected is the checksum matching the contents of the invoice.
+	Expected string
+
+	// Actual is the checksum the invoice ends with.
+	Actual string
+}
+
+// Error returns a human readable description of the checksum failure.
+func (e *ErrInvalidChecksum) Error() string {
+	if e.Expected == "" {
+		return "checksum failed."
+	}
+
+	return fmt.Sprintf("checksum failed. Expected %v, got %v.",
+		e.Expected, e.Actual)
+}
+
 // NOTE: This method it a slight modification of the method bech32.Decode found
 // btcutil, allowing strings to be more than 90 characters.
 
@@ -68,15 +89,13 @@ func decodeBech32(bech string) (string, []byte, error) {
 	}
 
 	if !bech32VerifyChecksum(hrp, decoded) {
-		moreInfo := ""
 		checksum := bech[len(bech)-6:]
-		expected, err := toChars(bech32Checksum(hrp,
+		expected, _ := toChars(bech32Checksum(hrp,
 			decoded[:len(decoded)-6]))
-		if err == nil {
-			moreInfo = fmt.Sprintf("Expected %v, got %v.",
-				expected, checksum)
+		return "", nil, &ErrInvalidChecksum{
+			Expected: expected,
+			Actual:   checksum,
 		}
-		return "", nil, fmt.Errorf("checksum failed. " + moreInfo)
 	}
 
 	// We exclude the last 6 bytes, which is the checksum.
@@ -41,6 +41,16 @@ const (
 	// single private route.
 	hopHintLen = 51
 
+	// spiderHintsLen is the number of bytes needed to encode the Spider
+	// hints of an invoice: the preferred routing algorithm, followed by
+	// the payment deadline in unix seconds.
+	spiderHintsLen = 1 + 8
+
+	// spiderHintsBase32Len is the number of 5-bit groups needed to encode
+	// the Spider hints. Note that the last group will be padded with
+	// zeroes.
+	spiderHintsBase32Len = 15
+
 	// The following byte values correspond to the supported field types.
 	// The field name is the character representing that 5-bit value in the
 	// bech32 string.
@@ -68,6 +78,18 @@ const (
 
 	// fieldTypeC contains an optional requested final CLTV delta.
 	fieldTypeC = 24
+
+	// fieldType9 contains the feature bits of the invoice.
+	fieldType9 = 5
+
+	// fieldTypeS contains the payment secret of the invoice.
+	fieldTypeS = 16
+
+	// fieldTypeK contains the Spider routing preferences of the payee.
+	//
+	// NOTE: This field is experimental and not part of BOLT-11. Readers
+	// that don't know of it will ignore it, as with any unknown field.
+	fieldTypeK = 22
 )
 
 // MessageSigner is passed to the Encode method to provide a signature
@@ -147,6 +169,36 @@ type Invoice struct {
 	//
 	// NOTE: This is optional.
 	RouteHints [][]routing.HopHint
+
+	// Features is the set of features the payee supports or requires
+	// from the payer, as defined by lnwire.InvoiceFeatures.
+	// Optional.
+	Features *lnwire.RawFeatureVector
+
+	// PaymentSecret is a secret known only to the payee and the payer,
+	// which the payer includes within the onion payload of the final hop.
+	// This prevents intermediate nodes from probing the payee with
+	// payments to the payment hash of the invoice.
+	// Optional. Must be set iff Features signals the payment secret.
+	PaymentSecret *[32]byte
+
+	// SpiderHints carries the Spider routing preferences of the payee.
+	//
+	// NOTE: This is optional and experimental.
+	SpiderHints *SpiderHints
+}
+
+// SpiderHints are the experimental Spider routing preferences the payee of an
+// invoice can express to the payer.
+type SpiderHints struct {
+	// Algo is the Spider routing algorithm the payee prefers the payment
+	// to be routed with, as defined within the routing package. A value
+	// of zero denotes no preference.
+	Algo uint8
+
+	// Deadline is the time after which the payee no longer expects the
+	// payment to be attempted. A zero value denotes no deadline.
+	Deadline time.Time
 }
 
 // Amount is a functional option that allows callers of NewInvoice to set the
@@ -220,6 +272,35 @@ func RouteHint(routeHint []routing.HopHint) func(*Invoice) {
 	}
 }
 
+// Features is a functional option that allows callers of NewInvoice to set the
+// feature bits of the created Invoice.
+func Features(features *lnwire.RawFeatureVector) func(*Invoice) {
+	return func(i *Invoice) {
+		i.Features = features
+	}
+}
+
+// PaymentSecret is a functional option that allows callers of NewInvoice to
+// set the payment secret of the created Invoice.
+//
+// NOTE: The payment secret feature bit must be set using Features as well.
+func PaymentSecret(secret [32]byte) func(*Invoice) {
+	return func(i *Invoice) {
+		i.PaymentSecret = &secret
+	}
+}
+
+// SpiderPreferences is a functional option that allows callers of NewInvoice
+// to set the Spider routing preferences of the created Invoice.
+func SpiderPreferences(algo uint8, deadline time.Time) func(*Invoice) {
+	return func(i *Invoice) {
+		i.SpiderHints = &SpiderHints{
+			Algo:     algo,
+			Deadline: deadline,
+		}
+	}
+}
+
 // NewInvoice creates a new Invoice object. The last parameter is a set of
 // variadic arguments for setting optional fields of the invoice.
 //
@@ -537,6 +618,35 @@ func validateInvoice(invoice *Invoice) error {
 			len(invoice.Destination.SerializeCompressed()))
 	}
 
+	// The payment secret feature bits must be set iff the invoice carries
+	// a payment secret.
+	var signalsSecret bool
+	if invoice.Features != nil {
+		signalsSecret = invoice.Features.IsSet(
+			lnwire.PaymentSecretRequired,
+		) || invoice.Features.IsSet(lnwire.PaymentSecretOptional)
+	}
+	switch {
+	case invoice.PaymentSecret != nil && !signalsSecret:
+		return fmt.Errorf("payment secret set without payment " +
+			"secret feature bit")
+	case invoice.PaymentSecret == nil && signalsSecret:
+		return fmt.Errorf("payment secret feature bit set without " +
+			"payment secret")
+	}
+
+	if invoice.SpiderHints != nil {
+		hints := invoice.SpiderHints
+		if hints.Algo > routing.DCTCP {
+			return fmt.Errorf("unknown spider algorithm: %d",
+				hints.Algo)
+		}
+		if hints.Algo == 0 && hints.Deadline.IsZero() {
+			return fmt.Errorf("spider hints carry neither an " +
+				"algorithm nor a deadline")
+		}
+	}
+
 	return nil
 }
 
@@ -569,9 +679,40 @@ func parseTimestamp(data []byte) (uint64, error) {
 	return base32ToUint64(data)
 }
 
-// parseTaggedFields takes the base32 encoded tagged fields of the invoice, and
-// fills the Invoice struct accordingly.
-func parseTaggedFields(invoice *Invoice, fields []byte, net *chaincfg.Params) error {
+// TaggedField is a raw tagged field of an encoded invoice.
+type TaggedField struct {
+	// Type is the 5-bit type of the field.
+	Type byte
+
+	// Data is the data of the field, encoded in base32.
+	Data []byte
+}
+
+// Name returns the bech32 character representing the type of the field, which
+// BOLT-11 uses to name the field.
+func (f *TaggedField) Name() string {
+	return string(charset[f.Type])
+}
+
+// Known returns whether the type of the field is understood by Decode. Fields
+// of unknown types are ignored when decoding an invoice.
+func (f *TaggedField) Known() bool {
+	switch f.Type {
+	case fieldTypeP, fieldTypeD, fieldTypeN, fieldTypeH, fieldTypeX,
+		fieldTypeF, fieldTypeR, fieldTypeC, fieldType9, fieldTypeS,
+		fieldTypeK:
+
+		return true
+	default:
+		return false
+	}
+}
+
+// splitTaggedFields splits the base32 encoded tagged fields of the invoice
+// into the individual fields, in the order they appear.
+func splitTaggedFields(fields []byte) ([]TaggedField, error) {
+	var tagged []TaggedField
+
 	index := 0
 	for {
 		// If there are less than 3 groups to read, there cannot be more
@@ -584,20 +725,40 @@ func parseTaggedFields(invoice *Invoice, fields []byte, net *chaincfg.Params) er
 		typ := fields[index]
 		dataLength, err := parseFieldDataLength(fields[index+1 : index+3])
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		// If we don't have enough field data left to read this length,
 		// return error.
 		if len(fields) < index+3+int(dataLength) {
-			return fmt.Errorf("invalid field length")
+			return nil, fmt.Errorf("invalid field length")
 		}
 		base32Data := fields[index+3 : index+3+int(dataLength)]
 
 		// Advance the index in preparation for the next iteration.
 		index += 3 + int(dataLength)
 
-		switch typ {
+		tagged = append(tagged, TaggedField{
+			Type: typ,
+			Data: base32Data,
+		})
+	}
+
+	return tagged, nil
+}
+
+// parseTaggedFields takes the base32 encoded tagged fields of the invoice, and
+// fills the Invoice struct accordingly.
+func parseTaggedFields(invoice *Invoice, fields []byte, net *chaincfg.Params) error {
+	tagged, err := splitTaggedFields(fields)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range tagged {
+		base32Data := field.Data
+
+		switch field.Type {
 		case fieldTypeP:
 			if invoice.PaymentHash != nil {
 				// We skip the field if we have already seen a
@@ -664,6 +825,34 @@ func parseTaggedFields(invoice *Invoice, fields []byte, net *chaincfg.Params) er
 			}
 
 			invoice.RouteHints = append(invoice.RouteHints, routeHint)
+		case fieldType9:
+			if invoice.Features != nil {
+				// We skip the field if we have already seen a
+				// supported one.
+				continue
+			}
+
+			invoice.Features = parseFeatures(base32Data)
+		case fieldTypeS:
+			if invoice.PaymentSecret != nil {
+				// We skip the field if we have already seen a
+				// supported one.
+				continue
+			}
+
+			// The payment secret shares the encoding of the
+			// payment hash.
+			invoice.PaymentSecret, err = parsePaymentHash(
+				base32Data,
+			)
+		case fieldTypeK:
+			if invoice.SpiderHints != nil {
+				// We skip the field if we have already seen a
+				// supported one.
+				continue
+			}
+
+			invoice.SpiderHints, err = parseSpiderHints(base32Data)
 		default:
 			// Ignore unknown type.
 		}
@@ -875,6 +1064,75 @@ func parseRouteHint(data []byte) ([]routing.HopHint, error) {
 	return routeHint, nil
 }
 
+// parseFeatures converts the data (encoded in base32) into a feature vector.
+// The bits are encoded big endian, such that bit 0 is the least significant
+// bit of the last 5-bit group.
+func parseFeatures(data []byte) *lnwire.RawFeatureVector {
+	features := lnwire.NewRawFeatureVector()
+	for i := 0; i < len(data)*5; i++ {
+		group := data[len(data)-1-i/5]
+		if (group>>uint(i%5))&1 == 1 {
+			features.Set(lnwire.FeatureBit(i))
+		}
+	}
+
+	return features
+}
+
+// parseSpiderHints converts the data (encoded in base32) into the Spider
+// routing preferences of the payee. As the hints are optional, hints we don't
+// understand are skipped rather than failing the invoice, in which case nil is
+// returned.
+func parseSpiderHints(data []byte) (*SpiderHints, error) {
+	// Just like fields of an unexpected length, we'll skip hints of a
+	// different size, as they may be of a newer version.
+	if len(data) != spiderHintsBase32Len {
+		return nil, nil
+	}
+
+	base256Data, err := bech32.ConvertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// We'll also skip hints preferring an algorithm we don't know, or
+	// carrying no preference at all.
+	algo := base256Data[0]
+	deadline := binary.BigEndian.Uint64(base256Data[1:])
+	if algo > routing.DCTCP || (algo == 0 && deadline == 0) {
+		return nil, nil
+	}
+
+	hints := &SpiderHints{
+		Algo: algo,
+	}
+	if deadline != 0 {
+		hints.Deadline = time.Unix(int64(deadline), 0)
+	}
+
+	return hints, nil
+}
+
+// featuresToBase32 converts the feature vector to base32, using as few 5-bit
+// groups as possible.
+func featuresToBase32(features *lnwire.RawFeatureVector) []byte {
+	var numBits int
+	for i := 0; i < features.SerializeSize()*8; i++ {
+		if features.IsSet(lnwire.FeatureBit(i)) {
+			numBits = i + 1
+		}
+	}
+
+	data := make([]byte, (numBits+4)/5)
+	for i := 0; i < numBits; i++ {
+		if features.IsSet(lnwire.FeatureBit(i)) {
+			data[len(data)-1-i/5] |= 1 << uint(i%5)
+		}
+	}
+
+	return data
+}
+
 // writeTaggedFields writes the non-nil tagged fields of the Invoice to the
 // base32 buffer.
 func writeTaggedFields(bufferBase32 *bytes.Buffer, invoice *Invoice) error {
@@ -1007,6 +1265,52 @@ func writeTaggedFields(bufferBase32 *bytes.Buffer, invoice *Invoice) error {
 		}
 	}
 
+	if invoice.Features != nil {
+		err := writeTaggedField(
+			bufferBase32, fieldType9,
+			featuresToBase32(invoice.Features),
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	if invoice.PaymentSecret != nil {
+		// Convert 32 byte secret to 52 5-bit groups.
+		secretBase32, err := bech32.ConvertBits(
+			invoice.PaymentSecret[:], 8, 5, true,
+		)
+		if err != nil {
+			return err
+		}
+
+		err = writeTaggedField(bufferBase32, fieldTypeS, secretBase32)
+		if err != nil {
+			return err
+		}
+	}
+
+	if invoice.SpiderHints != nil {
+		var hints [spiderHintsLen]byte
+		hints[0] = invoice.SpiderHints.Algo
+		if !invoice.SpiderHints.Deadline.IsZero() {
+			binary.BigEndian.PutUint64(
+				hints[1:],
+				uint64(invoice.SpiderHints.Deadline.Unix()),
+			)
+		}
+
+		hintsBase32, err := bech32.ConvertBits(hints[:], 8, 5, true)
+		if err != nil {
+			return err
+		}
+
+		err = writeTaggedField(bufferBase32, fieldTypeK, hintsBase32)
+		if err != nil {
+			return err
+		}
+	}
+
 	if invoice.Destination != nil {
 		// Convert 33 byte pubkey to 53 5-bit groups.
 		pubKeyBase32, err := bech32.ConvertBits(
@@ -778,3 +778,118 @@ func TestParseRouteHint(t *testing.T) {
 		}
 	}
 }
+
+// TestParseFeatures checks that the feature bits of the invoice are properly
+// parsed from, and encoded to, their base32 representation.
+func TestParseFeatures(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		data   []byte
+		result []lnwire.FeatureBit
+	}{
+		{
+			data:   []byte{},
+			result: nil,
+		},
+		{
+			// Bit 0 is the least significant bit of the last
+			// group.
+			data:   []byte{0x1, 0x3},
+			result: []lnwire.FeatureBit{0, 1, 5},
+		},
+		{
+			data: []byte{0x1, 0x0, 0x0, 0x0},
+			result: []lnwire.FeatureBit{
+				lnwire.PaymentSecretOptional,
+			},
+		},
+		{
+			data: []byte{0x10, 0x0, 0x0},
+			result: []lnwire.FeatureBit{
+				lnwire.PaymentSecretRequired,
+			},
+		},
+	}
+
+	for i, test := range tests {
+		features := parseFeatures(test.data)
+		expected := lnwire.NewRawFeatureVector(test.result...)
+		if !reflect.DeepEqual(expected, features) {
+			t.Fatalf("test %d: expected features %v, got %v", i,
+				expected, features)
+		}
+
+		data := featuresToBase32(features)
+		if !reflect.DeepEqual(test.data, data) {
+			t.Fatalf("test %d: expected encoding %v, got %v", i,
+				test.data, data)
+		}
+	}
+}
+
+// TestParseSpiderHints checks that the Spider hints are properly parsed.
+func TestParseSpiderHints(t *testing.T) {
+	t.Parallel()
+
+	hintsData := func(algo uint8, deadline uint64) []byte {
+		var b [spiderHintsLen]byte
+		b[0] = algo
+		binary.BigEndian.PutUint64(b[1:], deadline)
+		data, _ := bech32.ConvertBits(b[:], 8, 5, true)
+		return data
+	}
+
+	tests := []struct {
+		data   []byte
+		valid  bool
+		result *SpiderHints
+	}{
+		{
+			data:   []byte{0x0, 0x0},
+			valid:  true,
+			result: nil, // skip data too short
+		},
+		{
+			data:   append(hintsData(1, 0), 0x0, 0x0),
+			valid:  true,
+			result: nil, // skip data too long
+		},
+		{
+			data:   hintsData(routing.DCTCP+1, 1496314658),
+			valid:  true,
+			result: nil, // skip unknown algorithm
+		},
+		{
+			data:   hintsData(0, 0),
+			valid:  true,
+			result: nil, // skip empty hints
+		},
+		{
+			data:   hintsData(routing.ShortestPath, 0),
+			valid:  true,
+			result: &SpiderHints{Algo: routing.ShortestPath},
+		},
+		{
+			data:  hintsData(routing.DCTCP, 1496314658),
+			valid: true,
+			result: &SpiderHints{
+				Algo:     routing.DCTCP,
+				Deadline: time.Unix(1496314658, 0),
+			},
+		},
+	}
+
+	for i, test := range tests {
+		hints, err := parseSpiderHints(test.data)
+		if (err == nil) != test.valid {
+			t.Errorf("spider hints decoding test %d failed: %v",
+				i, err)
+			return
+		}
+		if test.valid && !reflect.DeepEqual(test.result, hints) {
+			t.Fatalf("test %d failed decoding spider hints: "+
+				"expected %v, got %v", i, test.result, hints)
+		}
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/btcsuite/btcd/wire"
 	"github.com/btcsuite/btcutil"
+	"github.com/btcsuite/btcutil/bech32"
 	"github.com/lightningnetwork/lnd/lnwire"
 	"github.com/lightningnetwork/lnd/routing"
 
@@ -757,6 +758,220 @@ func TestNewInvoice(t *testing.T) {
 	}
 }
 
+// TestPaymentSecretAndSpiderHints tests that invoices carrying feature bits, a
+// payment secret and Spider hints are validated, and survive an encoding round
+// trip.
+func TestPaymentSecretAndSpiderHints(t *testing.T) {
+	t.Parallel()
+
+	var testPaymentSecret [32]byte
+	copy(testPaymentSecret[:], bytes.Repeat([]byte{0x11}, 32))
+
+	optionalSecret := lnwire.NewRawFeatureVector(
+		lnwire.PaymentSecretOptional,
+	)
+	requiredSecret := lnwire.NewRawFeatureVector(
+		lnwire.PaymentSecretRequired,
+	)
+	deadline := time.Unix(1496318258, 0)
+
+	tests := []struct {
+		options []func(*Invoice)
+		valid   bool
+	}{
+		{
+			options: []func(*Invoice){
+				Features(optionalSecret),
+				PaymentSecret(testPaymentSecret),
+			},
+			valid: true,
+		},
+		{
+			options: []func(*Invoice){
+				Features(requiredSecret),
+				PaymentSecret(testPaymentSecret),
+				SpiderPreferences(
+					routing.Waterfilling, deadline,
+				),
+			},
+			valid: true,
+		},
+		{
+			// Spider hints without a deadline.
+			options: []func(*Invoice){
+				SpiderPreferences(routing.LP, time.Time{}),
+			},
+			valid: true,
+		},
+		{
+			// Payment secret without the feature bit.
+			options: []func(*Invoice){
+				PaymentSecret(testPaymentSecret),
+			},
+			valid: false,
+		},
+		{
+			// Feature bit without the payment secret.
+			options: []func(*Invoice){
+				Features(requiredSecret),
+			},
+			valid: false,
+		},
+		{
+			// Unknown Spider algorithm.
+			options: []func(*Invoice){
+				SpiderPreferences(routing.DCTCP+1, deadline),
+			},
+			valid: false,
+		},
+		{
+			// Spider hints carrying no preference.
+			options: []func(*Invoice){
+				SpiderPreferences(0, time.Time{}),
+			},
+			valid: false,
+		},
+	}
+
+	for i, test := range tests {
+		options := append([]func(*Invoice){
+			Amount(testMillisat20mBTC),
+			Description(testCupOfCoffee),
+		}, test.options...)
+
+		invoice, err := NewInvoice(
+			&chaincfg.MainNetParams, testPaymentHash,
+			time.Unix(1496314658, 0), options...,
+		)
+		if (err == nil) != test.valid {
+			t.Fatalf("test %d: expected valid=%v, got err=%v", i,
+				test.valid, err)
+		}
+		if !test.valid {
+			continue
+		}
+
+		encoded, err := invoice.Encode(testMessageSigner)
+		if err != nil {
+			t.Fatalf("test %d: unable to encode invoice: %v", i,
+				err)
+		}
+
+		decoded, err := Decode(encoded, &chaincfg.MainNetParams)
+		if err != nil {
+			t.Fatalf("test %d: unable to decode invoice: %v", i,
+				err)
+		}
+
+		// The destination is recovered from the signature when
+		// decoding.
+		invoice.Destination = testPubKey
+		if err := compareInvoices(invoice, decoded); err != nil {
+			t.Fatalf("test %d: invoice decoding mismatch: %v", i,
+				err)
+		}
+	}
+}
+
+// TestDecodeRaw tests that the raw structure of an invoice, including fields
+// of unknown types, is retained by DecodeRaw, and that the payee's pubkey can
+// be recovered from it.
+func TestDecodeRaw(t *testing.T) {
+	t.Parallel()
+
+	// We'll craft an invoice carrying a field of an unknown type in
+	// between the payment hash and the description, which Decode ignores.
+	var data bytes.Buffer
+	data.Write([]byte{0, 0, 0, 0, 0, 0, 1})
+
+	hashBase32, _ := bech32.ConvertBits(testPaymentHash[:], 8, 5, true)
+	descBase32, _ := bech32.ConvertBits([]byte(testCupOfCoffee), 8, 5, true)
+	unknownBase32 := []byte{1, 2, 3}
+
+	writeTaggedField(&data, fieldTypeP, hashBase32)
+	writeTaggedField(&data, 12, unknownBase32)
+	writeTaggedField(&data, fieldTypeD, descBase32)
+
+	hrp := "lnbc"
+	dataBase256, _ := bech32.ConvertBits(data.Bytes(), 5, 8, true)
+	hash := chainhash.HashB(append([]byte(hrp), dataBase256...))
+	sig, err := testMessageSigner.SignCompact(hash)
+	if err != nil {
+		t.Fatalf("unable to sign invoice: %v", err)
+	}
+	sigBase32, _ := bech32.ConvertBits(
+		append(sig[1:], sig[0]-27-4), 8, 5, true,
+	)
+	data.Write(sigBase32)
+
+	encoded, err := bech32.Encode(hrp, data.Bytes())
+	if err != nil {
+		t.Fatalf("unable to encode invoice: %v", err)
+	}
+
+	raw, err := DecodeRaw(encoded)
+	if err != nil {
+		t.Fatalf("unable to decode raw invoice: %v", err)
+	}
+
+	if raw.HRP != hrp {
+		t.Fatalf("expected hrp %v, got %v", hrp, raw.HRP)
+	}
+	if raw.Timestamp.Unix() != 1 {
+		t.Fatalf("expected timestamp 1, got %v", raw.Timestamp.Unix())
+	}
+
+	expectedFields := []TaggedField{
+		{Type: fieldTypeP, Data: hashBase32},
+		{Type: 12, Data: unknownBase32},
+		{Type: fieldTypeD, Data: descBase32},
+	}
+	if !reflect.DeepEqual(expectedFields, raw.Fields) {
+		t.Fatalf("expected fields %v, got %v", expectedFields,
+			raw.Fields)
+	}
+	if raw.Fields[1].Known() || raw.Fields[1].Name() != "v" {
+		t.Fatalf("expected unknown field v, got known=%v name=%v",
+			raw.Fields[1].Known(), raw.Fields[1].Name())
+	}
+
+	pubKey, err := raw.RecoverPubKey()
+	if err != nil {
+		t.Fatalf("unable to recover pubkey: %v", err)
+	}
+	if !pubKey.IsEqual(testPubKey) {
+		t.Fatalf("expected pubkey %x, got %x",
+			testPubKey.SerializeCompressed(),
+			pubKey.SerializeCompressed())
+	}
+
+	// The unknown field should be skipped by Decode.
+	invoice, err := Decode(encoded, &chaincfg.MainNetParams)
+	if err != nil {
+		t.Fatalf("unable to decode invoice: %v", err)
+	}
+	if *invoice.Description != testCupOfCoffee {
+		t.Fatalf("expected description %v, got %v", testCupOfCoffee,
+			*invoice.Description)
+	}
+
+	// Finally, altering the invoice should result in a checksum failure.
+	last := encoded[len(encoded)-1]
+	altered := encoded[:len(encoded)-1] + "q"
+	if last == 'q' {
+		altered = encoded[:len(encoded)-1] + "p"
+	}
+	_, err = DecodeRaw(altered)
+	checksumErr, ok := err.(*ErrInvalidChecksum)
+	if !ok {
+		t.Fatalf("expected ErrInvalidChecksum, got %v", err)
+	}
+	if checksumErr.Expected != encoded[len(encoded)-6:] {
+		t.Fatalf("expected checksum %v, got %v",
+			encoded[len(encoded)-6:], checksumErr.Expected)
+	}
+}
+
 func compareInvoices(expected, actual *Invoice) error {
 	if !reflect.DeepEqual(expected.Net, actual.Net) {
 		return fmt.Errorf("expected net %v, got %v",
@@ -815,6 +1030,21 @@ func compareInvoices(expected, actual *Invoice) error {
 		}
 	}
 
+	if !reflect.DeepEqual(expected.Features, actual.Features) {
+		return fmt.Errorf("expected features %v, got %v",
+			expected.Features, actual.Features)
+	}
+
+	if !compareHashes(expected.PaymentSecret, actual.PaymentSecret) {
+		return fmt.Errorf("expected payment secret %x, got %x",
+			expected.PaymentSecret, actual.PaymentSecret)
+	}
+
+	if !reflect.DeepEqual(expected.SpiderHints, actual.SpiderHints) {
+		return fmt.Errorf("expected spider hints %v, got %v",
+			expected.SpiderHints, actual.SpiderHints)
+	}
+
 	return nil
 }
 
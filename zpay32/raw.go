@@ -0,0 +1,111 @@
+package zpay32
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcutil/bech32"
+	"github.com/lightningnetwork/lnd/lnwire"
+)
+
+// RawInvoice is the structure of an encoded invoice, prior to interpreting its
+// tagged fields. Unlike Decode, DecodeRaw retains the fields of unknown types
+// as well as duplicate fields, and doesn't require the signature to be valid,
+// which makes it suitable to inspect invoices.
+type RawInvoice struct {
+	// HRP is the human-readable part of the invoice, encoding the network
+	// and optionally the amount of the invoice.
+	HRP string
+
+	// Timestamp is the time the invoice was created.
+	Timestamp time.Time
+
+	// Fields are the tagged fields of the invoice, in the order they
+	// appear.
+	Fields []TaggedField
+
+	// Signature is the signature of the invoice by the payee.
+	Signature lnwire.Sig
+
+	// RecoveryID is the recovery ID of the signature, which allows the
+	// public key of the payee to be recovered from it.
+	RecoveryID byte
+
+	// data is the base32 data of the invoice preceding the signature,
+	// which is covered by the signature.
+	data []byte
+}
+
+// DecodeRaw splits the provided encoded invoice into its human-readable part,
+// timestamp, tagged fields and signature. The network of the invoice isn't
+// checked, and neither is its signature.
+func DecodeRaw(invoice string) (*RawInvoice, error) {
+	hrp, data, err := decodeBech32(invoice)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < timestampBase32Len+signatureBase32Len {
+		return nil, fmt.Errorf("data too short: %d", len(data))
+	}
+
+	// Everything except the last 520 bits of the data encodes the
+	// invoice's timestamp and tagged fields.
+	invoiceData := data[:len(data)-signatureBase32Len]
+
+	t, err := parseTimestamp(invoiceData[:timestampBase32Len])
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := splitTaggedFields(invoiceData[timestampBase32Len:])
+	if err != nil {
+		return nil, err
+	}
+
+	// The last 520 bits (104 groups) make up the signature.
+	sigBase32 := data[len(data)-signatureBase32Len:]
+	sigBase256, err := bech32.ConvertBits(sigBase32, 5, 8, true)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := &RawInvoice{
+		HRP:        hrp,
+		Timestamp:  time.Unix(int64(t), 0),
+		Fields:     fields,
+		RecoveryID: sigBase256[64],
+		data:       invoiceData,
+	}
+	copy(raw.Signature[:], sigBase256[:64])
+
+	return raw, nil
+}
+
+// SigHash returns the hash signed by the payee: the single SHA-256 hash of the
+// human-readable part followed by the data of the invoice in base256.
+func (r *RawInvoice) SigHash() ([]byte, error) {
+	taggedDataBytes, err := bech32.ConvertBits(r.data, 5, 8, true)
+	if err != nil {
+		return nil, err
+	}
+
+	toSign := append([]byte(r.HRP), taggedDataBytes...)
+	return chainhash.HashB(toSign), nil
+}
+
+// RecoverPubKey recovers the public key that signed the invoice from its
+// signature and recovery ID.
+func (r *RawInvoice) RecoverPubKey() (*btcec.PublicKey, error) {
+	hash, err := r.SigHash()
+	if err != nil {
+		return nil, err
+	}
+
+	headerByte := r.RecoveryID + 27 + 4
+	compactSign := append([]byte{headerByte}, r.Signature[:]...)
+	pubKey, _, err := btcec.RecoverCompact(btcec.S256(), compactSign, hash)
+	return pubKey, err
+}